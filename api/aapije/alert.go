@@ -5,7 +5,9 @@
 package aapije
 
 import (
+	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -29,6 +31,20 @@ func (ra *RestApi) CreateAlert(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+
+	author, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidAPIKey)
+		return
+	}
+
 	s := services.NewAlertService(db)
 
 	params := &services.CreateAlertParams{
@@ -39,6 +55,7 @@ func (ra *RestApi) CreateAlert(w http.ResponseWriter, r *http.Request) {
 		Description: n.Description,
 		Origin:      n.Origin,
 		Value:       n.Value,
+		CreatedBy:   author,
 	}
 
 	if n.Status != nil {
@@ -96,6 +113,7 @@ func (ra *RestApi) FindAlerts(w http.ResponseWriter, r *http.Request, p rest.Fin
 		SeverityLe: (*rest.AlertSeverity)(p.SeverityLe),
 		SeverityGe: (*rest.AlertSeverity)(p.SeverityGe),
 		SeverityEq: (*rest.AlertSeverity)(p.Severity),
+		Silenced:   (*bool)(p.Silenced),
 	}
 
 	if p.Limit != nil {
@@ -183,6 +201,20 @@ func (ra *RestApi) UpdateAlertByUuid(w http.ResponseWriter, r *http.Request, id
 		return
 	}
 
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+
+	author, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidAPIKey)
+		return
+	}
+
 	svc := services.NewAlertService(db)
 	params := services.UpdateAlertByUuidParams{
 		Resource:    updAlert.Resource,
@@ -197,6 +229,7 @@ func (ra *RestApi) UpdateAlertByUuid(w http.ResponseWriter, r *http.Request, id
 		Tags:        updAlert.Tags,
 		Timeout:     updAlert.Timeout,
 		Rawdata:     updAlert.Rawdata,
+		UpdatedBy:   author,
 	}
 
 	count, err := svc.UpdateAlertByUuid(r.Context(), alertUUID, params)
@@ -238,3 +271,122 @@ func (ra *RestApi) DeleteAlertByUuid(w http.ResponseWriter, r *http.Request, id
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// changeAlertStatus handles the acknowledge, unacknowledge, shelve and unshelve operations
+func (ra *RestApi) changeAlertStatus(w http.ResponseWriter, r *http.Request, id rest.UuidParam, change func(*services.AlertService, context.Context, services.ChangeAlertStatusParams) (int64, error)) {
+	alertUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	// We expect an optional AlertAction object in the request body.
+	var action rest.AlertAction
+	if err := json.NewDecoder(r.Body).Decode(&action); err != nil && err != io.EOF {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+
+	author, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidAPIKey)
+		return
+	}
+
+	params := services.ChangeAlertStatusParams{
+		Uuid:      alertUUID,
+		Until:     action.Until,
+		ChangedBy: author,
+	}
+	if action.Note != nil {
+		params.Note = *action.Note
+	}
+
+	svc := services.NewAlertService(db)
+
+	count, err := change(svc, r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AcknowledgeAlert acknowledges an open alert
+func (ra *RestApi) AcknowledgeAlert(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	ra.changeAlertStatus(w, r, id, (*services.AlertService).AcknowledgeAlert)
+}
+
+// UnacknowledgeAlert sets an acknowledged alert back to open
+func (ra *RestApi) UnacknowledgeAlert(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	ra.changeAlertStatus(w, r, id, (*services.AlertService).UnacknowledgeAlert)
+}
+
+// ShelveAlert puts an alert on hold
+func (ra *RestApi) ShelveAlert(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	ra.changeAlertStatus(w, r, id, (*services.AlertService).ShelveAlert)
+}
+
+// UnshelveAlert sets a shelved alert back to open
+func (ra *RestApi) UnshelveAlert(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	ra.changeAlertStatus(w, r, id, (*services.AlertService).UnshelveAlert)
+}
+
+// FindAlertHistory lists the changes of an alert
+func (ra *RestApi) FindAlertHistory(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.FindAlertHistoryParams) {
+	alertUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.NewFindByUuidParams(
+		[]byte(domaintoken.Token),
+		alertUUID,
+		(*int64)(p.Limit),
+		(*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	svc := services.NewAlertService(db)
+	history, err := svc.FindHistory(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(history)
+}
@@ -10,8 +10,15 @@ package aapije
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"net/http"
+	"sync"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/pkg/blobstore"
+	"github.com/spf13/viper"
 )
 
 // Error struct
@@ -36,3 +43,98 @@ func (ra *RestApi) GetDB(r *http.Request) (*sql.DB, error) {
 	}
 	return db, nil
 }
+
+// domainStorage is where, and how, the dataset content of a domain is kept.
+type domainStorage struct {
+	store       blobstore.Store
+	compression string
+}
+
+var (
+	storesMu sync.Mutex
+	stores   = make(map[string]*domainStorage)
+)
+
+// getStorage reads the dataset content storage of the domain of the request.
+func getStorage(r *http.Request) (*domainStorage, error) {
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		return nil, errors.New("domain token missing from context")
+	}
+
+	return getDomainStorage(domaintoken.Domain)
+}
+
+// getDomainStorage reads the dataset content storage of the domain from the
+// "storage.<domain>" config. Domains without a config use the database.
+func getDomainStorage(domain string) (*domainStorage, error) {
+	storesMu.Lock()
+	defer storesMu.Unlock()
+
+	if s, ok := stores[domain]; ok {
+		return s, nil
+	}
+
+	var c blobstore.Config
+	err := viper.UnmarshalKey("storage."+domain, &c)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := blobstore.New(c)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &domainStorage{
+		store:       store,
+		compression: c.Compression,
+	}
+	stores[domain] = s
+
+	return s, nil
+}
+
+// DomainStore gets the dataset content store of a domain.
+func DomainStore(domain string) (blobstore.Store, error) {
+	s, err := getDomainStorage(domain)
+	if err != nil {
+		return nil, err
+	}
+	return s.store, nil
+}
+
+// GetStore gets the dataset content store of the domain.
+func (ra *RestApi) GetStore(r *http.Request) (blobstore.Store, error) {
+	s, err := getStorage(r)
+	if err != nil {
+		return nil, err
+	}
+	return s.store, nil
+}
+
+// GetCompression gets the compression of new dataset content of the domain.
+// Content is not compressed unless configured.
+func (ra *RestApi) GetCompression(r *http.Request) (string, error) {
+	s, err := getStorage(r)
+	if err != nil {
+		return "", err
+	}
+	return s.compression, nil
+}
+
+// parseAttributesFilter returns the attributes filter as a JSON object, or nil if unset
+func parseAttributesFilter(p *rest.AttributesFilterParam) ([]byte, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	var v map[string]interface{}
+	if err := json.Unmarshal([]byte(*p), &v); err != nil {
+		return nil, err
+	} else if v == nil {
+		return nil, errors.New("attributes filter must be a JSON object")
+	}
+
+	return []byte(*p), nil
+}
@@ -6,12 +6,18 @@ package aapije
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/self-host/self-host/api/aapije/rest"
 	ie "github.com/self-host/self-host/internal/errors"
 	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/pkg/blobstore"
 )
 
 // AddDatasets adds a new dataset
@@ -38,7 +44,19 @@ func (ra *RestApi) AddDatasets(w http.ResponseWriter, r *http.Request) {
 	u := services.NewUserService(db)
 	createdBy, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
 
-	s := services.NewDatasetService(db)
+	store, err := ra.GetStore(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	compression, err := ra.GetCompression(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	s := services.NewDatasetService(db).WithStore(store).WithCompression(compression)
 
 	params := &services.AddDatasetParams{
 		Name:      n.Name,
@@ -61,6 +79,10 @@ func (ra *RestApi) AddDatasets(w http.ResponseWriter, r *http.Request) {
 		params.Content = *n.Content
 	}
 
+	if n.MaxRevisions != nil {
+		params.MaxRevisions = *n.MaxRevisions
+	}
+
 	// Add the dataset
 	dataset, err := s.AddDataset(r.Context(), params)
 
@@ -168,6 +190,19 @@ func (ra *RestApi) UpdateDatasetByUuid(w http.ResponseWriter, r *http.Request, i
 		return
 	}
 
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+	updatedBy, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
 	// Allow max of 1 MB read from body
 	r.Body = http.MaxBytesReader(w, r.Body, 1048576)
 
@@ -178,11 +213,25 @@ func (ra *RestApi) UpdateDatasetByUuid(w http.ResponseWriter, r *http.Request, i
 		return
 	}
 
-	svc := services.NewDatasetService(db)
+	store, err := ra.GetStore(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	compression, err := ra.GetCompression(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewDatasetService(db).WithStore(store).WithCompression(compression)
 	params := services.UpdateDatasetByUuidParams{
-		Name:    updDataset.Name,
-		Content: updDataset.Content,
-		Tags:    updDataset.Tags,
+		Name:         updDataset.Name,
+		Content:      updDataset.Content,
+		Tags:         updDataset.Tags,
+		MaxRevisions: updDataset.MaxRevisions,
+		UpdatedBy:    updatedBy,
 	}
 
 	if updDataset.ThingUuid != nil {
@@ -225,43 +274,261 @@ func (ra *RestApi) GetRawDatasetByUuid(w http.ResponseWriter, r *http.Request, i
 		return
 	}
 
-	svc := services.NewDatasetService(db)
-	f, err := svc.GetDatasetContentByUuid(r.Context(), datasetUUID)
+	store, err := ra.GetStore(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewDatasetService(db).WithStore(store)
+	f, err := svc.OpenDatasetContentByUuid(r.Context(), datasetUUID)
 	if err != nil {
 		ie.SendHTTPError(w, ie.ParseDBError(err))
 		return
 	}
+	defer f.Close()
 
-	if f == nil {
+	// Change Content-Type based on Dataset type
+	w.Header().Set("Content-Type", datasetContentType(f.Format))
+
+	// HTTP-dates have a resolution of one second
+	lastModified := f.Updated.UTC().Truncate(time.Second)
+
+	// The ETag is the checksum of the uncompressed content, however sent
+	w.Header().Set("ETag", f.Checksum)
+	w.Header().Set("Last-Modified", lastModified.Format(http.TimeFormat))
+	w.Header().Set("Accept-Ranges", "bytes")
+	w.Header().Set("Vary", "Accept-Encoding")
+
+	if p.IfNoneMatch != nil {
+		if matchETag(string(*p.IfNoneMatch), f.Checksum) {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	} else if p.IfModifiedSince != nil {
+		t, err := http.ParseTime(string(*p.IfModifiedSince))
+		if err == nil && lastModified.After(t) == false {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+
+	if f.Size == 0 {
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
 
-	// Change Content-Type based on Dataset type
-	switch string(f.Format) {
+	status := http.StatusOK
+	start, length := int64(0), f.Size
+
+	// A stale If-Range means the client wants all of the new content
+	if p.Range != nil && (p.IfRange == nil || matchIfRange(string(*p.IfRange), f.Checksum, lastModified)) {
+		rStart, rLength, ok := parseByteRange(string(*p.Range), f.Size)
+		if ok == false {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", f.Size))
+			ie.SendHTTPError(w, ie.ErrorRangeNotSatisfiable)
+			return
+		} else if rLength > 0 {
+			status = http.StatusPartialContent
+			start, length = rStart, rLength
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, start+length-1, f.Size))
+		}
+	}
+
+	// Send compressed content as stored when the client accepts it. A range
+	// is always of the uncompressed content.
+	if status == http.StatusOK && f.Compression != blobstore.CompressionNone && acceptsEncoding(r.Header.Get("Accept-Encoding"), f.Compression) {
+		w.Header().Set("Content-Encoding", f.Compression)
+		w.Header().Set("Content-Length", strconv.FormatInt(f.StoredSize, 10))
+		w.WriteHeader(status)
+		io.CopyN(w, f.Stored, f.StoredSize)
+		return
+	}
+
+	if start > 0 {
+		if _, err := f.Seek(start, io.SeekStart); err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		}
+	}
+
+	w.Header().Set("Content-Length", strconv.FormatInt(length, 10))
+	w.WriteHeader(status)
+
+	// The status is sent, a failure can only cut the response short
+	io.CopyN(w, f, length)
+}
+
+// datasetContentType returns the Content-Type of a dataset format
+func datasetContentType(format string) string {
+	switch format {
 	case "csv":
-		w.Header().Set("Content-Type", "text/csv")
+		return "text/csv"
 	case "ini":
-		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		return "text/plain; charset=utf-8"
 	case "json":
-		w.Header().Set("Content-Type", "application/json")
+		return "application/json"
 	case "toml":
-		w.Header().Set("Content-Type", "application/toml")
+		return "application/toml"
 	case "xml":
-		w.Header().Set("Content-Type", "application/xml")
+		return "application/xml"
 	case "yaml":
-		w.Header().Set("Content-Type", "application/yaml")
-	default:
-		w.Header().Set("Content-Type", "application/octet-stream")
+		return "application/yaml"
 	}
+	return "application/octet-stream"
+}
 
-	w.Header().Set("ETag", f.Checksum)
+// acceptsEncoding reports if the Accept-Encoding header allows the content-coding
+func acceptsEncoding(header string, coding string) bool {
+	for _, e := range strings.Split(header, ",") {
+		params := strings.Split(e, ";")
+		name := strings.ToLower(strings.TrimSpace(params[0]))
+		if name != coding && name != "*" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range params[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, _ = strconv.ParseFloat(param[2:], 64)
+			}
+		}
+		return q > 0
+	}
+	return false
+}
+
+// matchETag compares an entity-tag, quoted or not, with the checksum
+func matchETag(etag string, checksum string) bool {
+	for _, e := range strings.Split(etag, ",") {
+		e = strings.TrimSpace(e)
+		e = strings.TrimPrefix(e, "W/")
+		if e == "*" || strings.Trim(e, `"`) == checksum {
+			return true
+		}
+	}
+	return false
+}
+
+// matchIfRange reports if the If-Range header, an entity-tag or an HTTP-date,
+// still matches the content
+func matchIfRange(ifRange string, checksum string, lastModified time.Time) bool {
+	if t, err := http.ParseTime(ifRange); err == nil {
+		return t.Equal(lastModified)
+	}
+	return strings.HasPrefix(ifRange, "W/") == false && strings.Trim(ifRange, `"`) == checksum
+}
+
+// parseByteRange parses a Range header with a single byte range. The length
+// is 0 when the header should be ignored, and ok is false when the range is
+// outside of the content.
+func parseByteRange(header string, size int64) (start int64, length int64, ok bool) {
+	const prefix = "bytes="
+	if strings.HasPrefix(header, prefix) == false {
+		return 0, 0, true
+	}
+
+	spec := strings.TrimSpace(header[len(prefix):])
+	if strings.Contains(spec, ",") {
+		// Several ranges, send all of the content
+		return 0, 0, true
+	}
+
+	i := strings.Index(spec, "-")
+	if i == -1 {
+		return 0, 0, true
+	}
+
+	first, last := strings.TrimSpace(spec[:i]), strings.TrimSpace(spec[i+1:])
+	if first == "" {
+		// The last N bytes
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || n < 0 {
+			return 0, 0, true
+		} else if n == 0 {
+			return 0, 0, false
+		} else if n > size {
+			n = size
+		}
+		return size - n, n, true
+	}
 
-	if p.IfNoneMatch != nil && (string)(*p.IfNoneMatch) == f.Checksum {
-		w.WriteHeader(http.StatusNotModified)
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, true
+	} else if start >= size {
+		return 0, 0, false
+	}
+
+	end := size - 1
+	if last != "" {
+		end, err = strconv.ParseInt(last, 10, 64)
+		if err != nil || end < start {
+			return 0, 0, true
+		} else if end >= size {
+			end = size - 1
+		}
+	}
+
+	return start, end - start + 1, true
+}
+
+// GetDatasetRevisions returns all content revisions for a dataset
+func (ra *RestApi) GetDatasetRevisions(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	datasetUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
 		return
 	}
 
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewDatasetService(db)
+	revisions, err := svc.FindAllRevisions(r.Context(), datasetUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(revisions)
+}
+
+// GetRawDatasetRevision gets the "file" content from a dataset as it was at a revision
+func (ra *RestApi) GetRawDatasetRevision(w http.ResponseWriter, r *http.Request, id rest.UuidParam, revision int) {
+	datasetUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	store, err := ra.GetStore(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewDatasetService(db).WithStore(store)
+	f, _, err := svc.GetDatasetContentAtRevision(r.Context(), datasetUUID, revision)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("ETag", f.Checksum)
+
 	if len(f.Content) == 0 {
 		w.WriteHeader(http.StatusNoContent)
 		return
@@ -272,6 +539,222 @@ func (ra *RestApi) GetRawDatasetByUuid(w http.ResponseWriter, r *http.Request, i
 	w.Write(f.Content)
 }
 
+// GetDatasetRevisionsDiff returns the difference between two content revisions of a dataset
+func (ra *RestApi) GetDatasetRevisionsDiff(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.GetDatasetRevisionsDiffParams) {
+	datasetUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	store, err := ra.GetStore(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewDatasetService(db).WithStore(store)
+	diff, err := svc.DiffDatasetAtRevisions(r.Context(), datasetUUID, p.RevA, p.RevB)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(diff))
+}
+
+// RollbackDatasetToRevision restores the content of a dataset from a revision
+func (ra *RestApi) RollbackDatasetToRevision(w http.ResponseWriter, r *http.Request, id rest.UuidParam, revision int) {
+	datasetUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+	updatedBy, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	store, err := ra.GetStore(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewDatasetService(db).WithStore(store)
+	count, err := svc.RollbackToRevision(r.Context(), datasetUUID, revision, updatedBy)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetDatasetDocument gets a part of a structured dataset, selected by a JSON pointer
+func (ra *RestApi) GetDatasetDocument(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.GetDatasetDocumentParams) {
+	datasetUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	store, err := ra.GetStore(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	var pointer, format string
+	if p.Pointer != nil {
+		pointer = *p.Pointer
+	}
+	if p.Format != nil {
+		format = *p.Format
+	}
+
+	svc := services.NewDatasetService(db).WithStore(store)
+	f, err := svc.GetDocument(r.Context(), datasetUUID, pointer, format)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", datasetContentType(f.Format))
+	w.WriteHeader(http.StatusOK)
+	w.Write(f.Content)
+}
+
+// GetDatasetSchema gets the JSON Schema of a dataset
+func (ra *RestApi) GetDatasetSchema(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	datasetUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewDatasetService(db)
+	schema, err := svc.GetSchema(r.Context(), datasetUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(schema)
+}
+
+// SetDatasetSchema attaches a JSON Schema to a dataset
+func (ra *RestApi) SetDatasetSchema(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	datasetUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	// We expect a JSON Schema object in the request body.
+	var obj map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	schema, err := json.Marshal(obj)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	store, err := ra.GetStore(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewDatasetService(db).WithStore(store)
+	count, err := svc.SetSchema(r.Context(), datasetUUID, schema)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteDatasetSchema removes the JSON Schema from a dataset
+func (ra *RestApi) DeleteDatasetSchema(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	datasetUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewDatasetService(db)
+	count, err := svc.DeleteSchema(r.Context(), datasetUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // InitializeDatasetUploadByUuid initiates the upload of a larger dataset
 func (ra *RestApi) InitializeDatasetUploadByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -313,7 +796,13 @@ func (ra *RestApi) DeleteDatasetByUuid(w http.ResponseWriter, r *http.Request, i
 		return
 	}
 
-	svc := services.NewDatasetService(db)
+	store, err := ra.GetStore(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewDatasetService(db).WithStore(store)
 
 	count, err := svc.DeleteDataset(r.Context(), datasetUUID)
 	if err != nil {
@@ -0,0 +1,408 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package aapije
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/internal/services"
+)
+
+// AddAlertGroup adds a new alert group
+func (ra *RestApi) AddAlertGroup(w http.ResponseWriter, r *http.Request) {
+	// We expect a NewAlertGroup object in the request body.
+	var n rest.NewAlertGroup
+	if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+
+	author, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidAPIKey)
+		return
+	}
+
+	params := &services.AddAlertGroupParams{
+		Name:      n.Name,
+		Keys:      n.Keys,
+		Enabled:   true,
+		CreatedBy: author,
+	}
+	if n.TagPrefix != nil {
+		params.TagPrefix = *n.TagPrefix
+	}
+	if n.Enabled != nil {
+		params.Enabled = *n.Enabled
+	}
+
+	svc := services.NewIncidentService(db)
+
+	group, err := svc.AddAlertGroup(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(group)
+}
+
+// FindAlertGroups lists all alert groups
+func (ra *RestApi) FindAlertGroups(w http.ResponseWriter, r *http.Request, p rest.FindAlertGroupsParams) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.PaginationParams{}
+	params.Limit.Scan((*int64)(p.Limit))
+	params.Offset.Scan((*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	svc := services.NewIncidentService(db)
+
+	groups, err := svc.FindAlertGroups(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(groups)
+}
+
+// FindAlertGroupByUuid returns a specific alert group by its UUID
+func (ra *RestApi) FindAlertGroupByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	groupUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewIncidentService(db)
+
+	group, err := svc.FindAlertGroupByUuid(r.Context(), groupUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(group)
+}
+
+// UpdateAlertGroupByUuid updates a specific alert group by its UUID
+func (ra *RestApi) UpdateAlertGroupByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	groupUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	// We expect a UpdateAlertGroup object in the request body.
+	var upd rest.UpdateAlertGroup
+	if err := json.NewDecoder(r.Body).Decode(&upd); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewIncidentService(db)
+
+	count, err := svc.UpdateAlertGroupByUuid(r.Context(), services.UpdateAlertGroupParams{
+		Uuid:      groupUUID,
+		Name:      upd.Name,
+		Keys:      upd.Keys,
+		TagPrefix: upd.TagPrefix,
+		Enabled:   upd.Enabled,
+	})
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteAlertGroupByUuid deletes a specific alert group by its UUID
+func (ra *RestApi) DeleteAlertGroupByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	groupUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewIncidentService(db)
+
+	count, err := svc.DeleteAlertGroup(r.Context(), groupUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// FindIncidents lists all incidents, newest first
+func (ra *RestApi) FindIncidents(w http.ResponseWriter, r *http.Request, p rest.FindIncidentsParams) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.FindIncidentsParams{
+		Status: p.Status,
+	}
+	params.Limit.Scan((*int64)(p.Limit))
+	params.Offset.Scan((*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	if p.Group != nil {
+		groupUUID, err := uuid.Parse(*p.Group)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+			return
+		}
+		params.Group = &groupUUID
+	}
+
+	svc := services.NewIncidentService(db)
+
+	incidents, err := svc.FindIncidents(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(incidents)
+}
+
+// FindIncidentByUuid returns a specific incident by its UUID
+func (ra *RestApi) FindIncidentByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	incidentUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewIncidentService(db)
+
+	incident, err := svc.FindIncidentByUuid(r.Context(), incidentUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(incident)
+}
+
+// FindIncidentAlerts lists the alerts of a specific incident
+func (ra *RestApi) FindIncidentAlerts(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.FindIncidentAlertsParams) {
+	incidentUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.NewFindByUuidParams(
+		[]byte(domaintoken.Token),
+		incidentUUID,
+		(*int64)(p.Limit),
+		(*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	svc := services.NewIncidentService(db)
+	alerts, err := svc.FindIncidentAlerts(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(alerts)
+}
+
+// FindIncidentHistory lists the timeline of a specific incident
+func (ra *RestApi) FindIncidentHistory(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.FindIncidentHistoryParams) {
+	incidentUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.NewFindByUuidParams(
+		[]byte(domaintoken.Token),
+		incidentUUID,
+		(*int64)(p.Limit),
+		(*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	svc := services.NewIncidentService(db)
+	history, err := svc.FindIncidentHistory(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(history)
+}
+
+func (ra *RestApi) changeIncidentStatus(w http.ResponseWriter, r *http.Request, id rest.UuidParam, change func(*services.IncidentService, context.Context, services.ChangeAlertStatusParams) (int64, error)) {
+	incidentUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	// We expect an optional AlertAction object in the request body.
+	var action rest.AlertAction
+	if err := json.NewDecoder(r.Body).Decode(&action); err != nil && err != io.EOF {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+
+	author, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidAPIKey)
+		return
+	}
+
+	params := services.ChangeAlertStatusParams{
+		Uuid:      incidentUUID,
+		ChangedBy: author,
+	}
+	if action.Note != nil {
+		params.Note = *action.Note
+	}
+
+	svc := services.NewIncidentService(db)
+
+	count, err := change(svc, r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AcknowledgeIncident acknowledges an open incident
+func (ra *RestApi) AcknowledgeIncident(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	ra.changeIncidentStatus(w, r, id, (*services.IncidentService).AcknowledgeIncident)
+}
+
+// CloseIncident closes an incident and its alerts
+func (ra *RestApi) CloseIncident(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	ra.changeIncidentStatus(w, r, id, (*services.IncidentService).CloseIncident)
+}
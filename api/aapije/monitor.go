@@ -0,0 +1,255 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package aapije
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/internal/services"
+)
+
+// AddMonitor adds a new monitor to a time series
+func (ra *RestApi) AddMonitor(w http.ResponseWriter, r *http.Request) {
+	// We expect a NewMonitor object in the request body.
+	var n rest.NewMonitor
+	if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	tsUUID, err := uuid.Parse(n.Timeseries)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+
+	author, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidAPIKey)
+		return
+	}
+
+	ts := services.NewTimeseriesService(db)
+
+	found, err := ts.Exists(r.Context(), tsUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if found == false {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	params := &services.AddMonitorParams{
+		Name:       n.Name,
+		Timeseries: tsUUID,
+		Kind:       n.Kind,
+		Threshold:  n.Threshold,
+		Aggregate:  rest.MonitorAggregateAvg,
+		Window:     300,
+		Evaluate:   rest.MonitorEvaluationIngest,
+		Interval:   60,
+		Severity:   rest.AlertSeverityMajor,
+		Enabled:    true,
+		CreatedBy:  author,
+	}
+	if n.Hysteresis != nil {
+		params.Hysteresis = *n.Hysteresis
+	}
+	if n.Aggregate != nil {
+		params.Aggregate = *n.Aggregate
+	}
+	if n.Window != nil {
+		params.Window = *n.Window
+	}
+	if n.Evaluate != nil {
+		params.Evaluate = *n.Evaluate
+	}
+	if n.Interval != nil {
+		params.Interval = *n.Interval
+	}
+	if n.Severity != nil {
+		params.Severity = *n.Severity
+	}
+	if n.Environment != nil {
+		params.Environment = *n.Environment
+	}
+	if n.Event != nil {
+		params.Event = *n.Event
+	}
+	if n.Enabled != nil {
+		params.Enabled = *n.Enabled
+	}
+
+	svc := services.NewMonitorService(db)
+
+	monitor, err := svc.AddMonitor(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(monitor)
+}
+
+// FindMonitors lists all time series monitors
+func (ra *RestApi) FindMonitors(w http.ResponseWriter, r *http.Request, p rest.FindMonitorsParams) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.FindMonitorsParams{}
+	params.Limit.Scan((*int64)(p.Limit))
+	params.Offset.Scan((*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	if p.Timeseries != nil {
+		tsUUID, err := uuid.Parse(*p.Timeseries)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+			return
+		}
+		params.Timeseries = &tsUUID
+	}
+
+	svc := services.NewMonitorService(db)
+
+	monitors, err := svc.FindMonitors(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(monitors)
+}
+
+// FindMonitorByUuid returns a specific time series monitor by its UUID
+func (ra *RestApi) FindMonitorByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	monitorUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewMonitorService(db)
+
+	monitor, err := svc.FindMonitorByUuid(r.Context(), monitorUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(monitor)
+}
+
+// UpdateMonitorByUuid updates a specific time series monitor by its UUID
+func (ra *RestApi) UpdateMonitorByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	monitorUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	// We expect a UpdateMonitor object in the request body.
+	var upd rest.UpdateMonitor
+	if err := json.NewDecoder(r.Body).Decode(&upd); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewMonitorService(db)
+
+	count, err := svc.UpdateMonitorByUuid(r.Context(), services.UpdateMonitorParams{
+		Uuid:        monitorUUID,
+		Name:        upd.Name,
+		Kind:        upd.Kind,
+		Threshold:   upd.Threshold,
+		Hysteresis:  upd.Hysteresis,
+		Aggregate:   upd.Aggregate,
+		Window:      upd.Window,
+		Evaluate:    upd.Evaluate,
+		Interval:    upd.Interval,
+		Severity:    upd.Severity,
+		Environment: upd.Environment,
+		Event:       upd.Event,
+		Enabled:     upd.Enabled,
+	})
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteMonitorByUuid deletes a specific time series monitor by its UUID
+func (ra *RestApi) DeleteMonitorByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	monitorUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewMonitorService(db)
+
+	count, err := svc.DeleteMonitor(r.Context(), monitorUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
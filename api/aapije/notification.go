@@ -0,0 +1,473 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package aapije
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/internal/services"
+)
+
+// AddNotificationChannel adds a new notification channel
+func (ra *RestApi) AddNotificationChannel(w http.ResponseWriter, r *http.Request) {
+	// We expect a NewNotificationChannel object in the request body.
+	var n rest.NewNotificationChannel
+	if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+
+	author, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidAPIKey)
+		return
+	}
+
+	params := &services.AddNotificationChannelParams{
+		Name:      n.Name,
+		Kind:      n.Kind,
+		Config:    n.Config,
+		Enabled:   true,
+		CreatedBy: author,
+	}
+	if n.Enabled != nil {
+		params.Enabled = *n.Enabled
+	}
+
+	svc := services.NewNotificationService(db)
+
+	channel, err := svc.AddChannel(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(channel)
+}
+
+// FindNotificationChannels lists all notification channels
+func (ra *RestApi) FindNotificationChannels(w http.ResponseWriter, r *http.Request, p rest.FindNotificationChannelsParams) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.NewFindAllParams(
+		[]byte(domaintoken.Token),
+		(*int64)(p.Limit),
+		(*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	svc := services.NewNotificationService(db)
+
+	channels, err := svc.FindChannels(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(channels)
+}
+
+// FindNotificationChannelByUuid returns a specific notification channel by its UUID
+func (ra *RestApi) FindNotificationChannelByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	channelUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewNotificationService(db)
+
+	channel, err := svc.FindChannelByUuid(r.Context(), channelUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(channel)
+}
+
+// UpdateNotificationChannelByUuid updates a specific notification channel by its UUID
+func (ra *RestApi) UpdateNotificationChannelByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	channelUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	// We expect a UpdateNotificationChannel object in the request body.
+	var upd rest.UpdateNotificationChannel
+	if err := json.NewDecoder(r.Body).Decode(&upd); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewNotificationService(db)
+
+	count, err := svc.UpdateChannelByUuid(r.Context(), services.UpdateNotificationChannelParams{
+		Uuid:    channelUUID,
+		Name:    upd.Name,
+		Kind:    upd.Kind,
+		Config:  upd.Config,
+		Enabled: upd.Enabled,
+	})
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteNotificationChannelByUuid deletes a specific notification channel by its UUID
+func (ra *RestApi) DeleteNotificationChannelByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	channelUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewNotificationService(db)
+
+	count, err := svc.DeleteChannel(r.Context(), channelUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddNotificationRule adds a new notification routing rule
+func (ra *RestApi) AddNotificationRule(w http.ResponseWriter, r *http.Request) {
+	// We expect a NewNotificationRule object in the request body.
+	var n rest.NewNotificationRule
+	if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	channelUUID, err := uuid.Parse(n.Channel)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+
+	author, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidAPIKey)
+		return
+	}
+
+	params := &services.AddNotificationRuleParams{
+		Name:      n.Name,
+		Channel:   channelUUID,
+		Enabled:   true,
+		CreatedBy: author,
+	}
+	if n.Triggers != nil {
+		params.Triggers = *n.Triggers
+	}
+	if n.Environment != nil {
+		params.Environment = *n.Environment
+	}
+	if n.Severity != nil {
+		params.Severity = *n.Severity
+	}
+	if n.Service != nil {
+		params.Service = *n.Service
+	}
+	if n.Tags != nil {
+		params.Tags = *n.Tags
+	}
+	if n.Resource != nil {
+		params.Resource = *n.Resource
+	}
+	if n.Enabled != nil {
+		params.Enabled = *n.Enabled
+	}
+
+	svc := services.NewNotificationService(db)
+
+	rule, err := svc.AddRule(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(rule)
+}
+
+// FindNotificationRules lists all notification routing rules
+func (ra *RestApi) FindNotificationRules(w http.ResponseWriter, r *http.Request, p rest.FindNotificationRulesParams) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.NewFindAllParams(
+		[]byte(domaintoken.Token),
+		(*int64)(p.Limit),
+		(*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	svc := services.NewNotificationService(db)
+
+	rules, err := svc.FindRules(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(rules)
+}
+
+// FindNotificationRuleByUuid returns a specific notification routing rule by its UUID
+func (ra *RestApi) FindNotificationRuleByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	ruleUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewNotificationService(db)
+
+	rule, err := svc.FindRuleByUuid(r.Context(), ruleUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(rule)
+}
+
+// UpdateNotificationRuleByUuid updates a specific notification routing rule by its UUID
+func (ra *RestApi) UpdateNotificationRuleByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	ruleUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	// We expect a UpdateNotificationRule object in the request body.
+	var upd rest.UpdateNotificationRule
+	if err := json.NewDecoder(r.Body).Decode(&upd); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	params := services.UpdateNotificationRuleParams{
+		Uuid:        ruleUUID,
+		Name:        upd.Name,
+		Triggers:    upd.Triggers,
+		Environment: upd.Environment,
+		Severity:    upd.Severity,
+		Service:     upd.Service,
+		Tags:        upd.Tags,
+		Resource:    upd.Resource,
+		Enabled:     upd.Enabled,
+	}
+
+	if upd.Channel != nil {
+		channelUUID, err := uuid.Parse(*upd.Channel)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+			return
+		}
+		params.Channel = &channelUUID
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewNotificationService(db)
+
+	count, err := svc.UpdateRuleByUuid(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteNotificationRuleByUuid deletes a specific notification routing rule by its UUID
+func (ra *RestApi) DeleteNotificationRuleByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	ruleUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewNotificationService(db)
+
+	count, err := svc.DeleteRule(r.Context(), ruleUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// FindNotificationDeliveries lists the delivery log of notifications
+func (ra *RestApi) FindNotificationDeliveries(w http.ResponseWriter, r *http.Request, p rest.FindNotificationDeliveriesParams) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.FindNotificationDeliveriesParams{
+		Status: p.Status,
+	}
+	params.Limit.Scan((*int64)(p.Limit))
+	params.Offset.Scan((*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	if p.Channel != nil {
+		channelUUID, err := uuid.Parse(*p.Channel)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+			return
+		}
+		params.Channel = &channelUUID
+	}
+	if p.Alert != nil {
+		alertUUID, err := uuid.Parse(*p.Alert)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+			return
+		}
+		params.Alert = &alertUUID
+	}
+	if p.Incident != nil {
+		incidentUUID, err := uuid.Parse(*p.Incident)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+			return
+		}
+		params.Incident = &incidentUUID
+	}
+
+	svc := services.NewNotificationService(db)
+
+	deliveries, err := svc.FindDeliveries(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(deliveries)
+}
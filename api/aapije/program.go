@@ -5,14 +5,17 @@
 package aapije
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/robfig/cron/v3"
+	"github.com/spf13/viper"
 
 	"github.com/self-host/self-host/api/aapije/rest"
 	ie "github.com/self-host/self-host/internal/errors"
@@ -448,3 +451,306 @@ func (ra *RestApi) DeleteProgramCodeRevisions(w http.ResponseWriter, r *http.Req
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// FindProgramRuns lists the runs of a program, the latest first
+func (ra *RestApi) FindProgramRuns(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.FindProgramRunsParams) {
+	programUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.FindProgramRunsParams{
+		Uuid:    programUUID,
+		Outcome: p.Outcome,
+		Start:   p.Start,
+		End:     p.End,
+	}
+	params.Limit.Scan((*int64)(p.Limit))
+	params.Offset.Scan((*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	s := services.NewProgramService(db)
+	runs, err := s.FindProgramRuns(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(runs)
+}
+
+// FindProgramRunById returns a specific run of a program
+func (ra *RestApi) FindProgramRunById(w http.ResponseWriter, r *http.Request, id rest.UuidParam, runID int64) {
+	programUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	s := services.NewProgramService(db)
+	run, err := s.FindProgramRunById(r.Context(), programUUID, runID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(run)
+}
+
+// FindProgramJobs lists the jobs of the program queue, the latest first
+func (ra *RestApi) FindProgramJobs(w http.ResponseWriter, r *http.Request, p rest.FindProgramJobsParams) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.FindProgramJobsParams{
+		Status: p.Status,
+	}
+	params.Limit.Scan((*int64)(p.Limit))
+	params.Offset.Scan((*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	if p.Program != nil {
+		programUUID, err := uuid.Parse(*p.Program)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+			return
+		}
+		params.Program = &programUUID
+	}
+
+	s := services.NewProgramService(db)
+	jobs, err := s.FindProgramJobs(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(jobs)
+}
+
+// FindProgramJobById returns a specific job of the program queue
+func (ra *RestApi) FindProgramJobById(w http.ResponseWriter, r *http.Request, jobID int64) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	s := services.NewProgramService(db)
+	job, err := s.FindProgramJobById(r.Context(), jobID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(job)
+}
+
+// FindProgramJobRuns returns the runs of a job of the program queue
+func (ra *RestApi) FindProgramJobRuns(w http.ResponseWriter, r *http.Request, jobID int64) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	s := services.NewProgramService(db)
+	runs, err := s.FindProgramJobRuns(r.Context(), jobID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(runs)
+}
+
+// RequeueProgramJob queues a dead job again
+func (ra *RestApi) RequeueProgramJob(w http.ResponseWriter, r *http.Request, jobID int64) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	s := services.NewProgramService(db)
+	count, err := s.RequeueProgramJob(r.Context(), jobID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RunProgram runs a routine now, either through the job queue or as a dry run
+func (ra *RestApi) RunProgram(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	programUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	// The request body is optional
+	var p rest.RunProgram
+	if err := json.NewDecoder(r.Body).Decode(&p); err != nil && err != io.EOF {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	s := services.NewProgramService(db)
+	t, err := s.FindProgramRunTask(r.Context(), programUUID, p.Revision)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	// Running code which is not signed is reserved for those who may change it
+	if t.Signed == false {
+		pc := services.NewPolicyCheckService(db)
+		access, err := pc.UserHasAccessViaToken(r.Context(), []byte(domaintoken.Token), "update", "programs/"+programUUID.String())
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		} else if access == false {
+			ie.SendHTTPError(w, ie.ErrorForbidden)
+			return
+		}
+	}
+
+	if p.DryRun != nil && *p.DryRun {
+		result, err := dryRunProgram(r, domaintoken.Domain, t)
+		if err != nil {
+			ie.SendHTTPError(w, &ie.HTTPError{
+				Code:    http.StatusBadGateway,
+				Message: err.Error(),
+			})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(result)
+		return
+	}
+
+	run, err := s.QueueProgramRun(r.Context(), t)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("/v2/programs/%v/runs/%d", run.Program, run.Id))
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(run)
+}
+
+// dryRunProgram hands the revision to the Program Manager, which runs it on a
+// worker and replies with the result.
+func dryRunProgram(r *http.Request, domain string, t *services.ProgramRunTask) (*rest.ProgramDryRun, error) {
+	requestBody, err := json.Marshal(map[string]interface{}{
+		"deadline":     t.Program.Deadline,
+		"domain":       domain,
+		"language":     t.Program.Language,
+		"program_uuid": t.Program.Uuid.String(),
+		"source_code":  t.Code,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uri := fmt.Sprintf("%v://%v/v1/dryruns",
+		viper.GetString("program_manager.scheme"),
+		viper.GetString("program_manager.authority"))
+
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodPost, uri, bytes.NewBuffer(requestBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Allow for the deadline of the program, and for the worker to get to it
+	client := &http.Client{
+		Timeout: time.Duration(t.Program.Deadline)*time.Millisecond + viper.GetDuration("program_manager.timeout"),
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			Message string `json:"message"`
+		}
+		json.NewDecoder(resp.Body).Decode(&e)
+		if e.Message == "" {
+			e.Message = resp.Status
+		}
+		return nil, fmt.Errorf("program manager error: %v", e.Message)
+	}
+
+	var result struct {
+		Outcome  string `json:"outcome"`
+		Error    string `json:"error"`
+		Output   string `json:"output"`
+		Duration int    `json:"duration"`
+		Worker   string `json:"worker"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &rest.ProgramDryRun{
+		Program:  t.Program.Uuid.String(),
+		Revision: int(t.Revision),
+		Worker:   result.Worker,
+		Outcome:  rest.ProgramRunOutcome(result.Outcome),
+		Error:    result.Error,
+		Output:   result.Output,
+		Duration: result.Duration,
+	}, nil
+}
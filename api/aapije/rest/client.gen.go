@@ -93,6 +93,25 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// FindAlertGroups request
+	FindAlertGroups(ctx context.Context, params *FindAlertGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddAlertGroup request with any body
+	AddAlertGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddAlertGroup(ctx context.Context, body AddAlertGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAlertGroupByUuid request
+	DeleteAlertGroupByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindAlertGroupByUuid request
+	FindAlertGroupByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAlertGroupByUuid request with any body
+	UpdateAlertGroupByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAlertGroupByUuid(ctx context.Context, uuid UuidParam, body UpdateAlertGroupByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FindAlerts request
 	FindAlerts(ctx context.Context, params *FindAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -112,6 +131,29 @@ type ClientInterface interface {
 
 	UpdateAlertByUuid(ctx context.Context, uuid UuidParam, body UpdateAlertByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AcknowledgeAlert request with any body
+	AcknowledgeAlertWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AcknowledgeAlert(ctx context.Context, uuid UuidParam, body AcknowledgeAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindAlertHistory request
+	FindAlertHistory(ctx context.Context, uuid UuidParam, params *FindAlertHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ShelveAlert request with any body
+	ShelveAlertWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ShelveAlert(ctx context.Context, uuid UuidParam, body ShelveAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnacknowledgeAlert request with any body
+	UnacknowledgeAlertWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UnacknowledgeAlert(ctx context.Context, uuid UuidParam, body UnacknowledgeAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnshelveAlert request with any body
+	UnshelveAlertWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UnshelveAlert(ctx context.Context, uuid UuidParam, body UnshelveAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FindDatasets request
 	FindDatasets(ctx context.Context, params *FindDatasetsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -134,6 +176,17 @@ type ClientInterface interface {
 	// AssembleDatasetPartsByKey request
 	AssembleDatasetPartsByKey(ctx context.Context, uuid UuidParam, params *AssembleDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetDatasetRevisionsDiff request
+	GetDatasetRevisionsDiff(ctx context.Context, uuid UuidParam, params *GetDatasetRevisionsDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDatasetDocument request
+	GetDatasetDocument(ctx context.Context, uuid UuidParam, params *GetDatasetDocumentParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportDatasetIntoTimeseries request with any body
+	ImportDatasetIntoTimeseriesWithBody(ctx context.Context, uuid UuidParam, params *ImportDatasetIntoTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportDatasetIntoTimeseries(ctx context.Context, uuid UuidParam, params *ImportDatasetIntoTimeseriesParams, body ImportDatasetIntoTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListDatasetPartsByKey request
 	ListDatasetPartsByKey(ctx context.Context, uuid UuidParam, params *ListDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -143,6 +196,26 @@ type ClientInterface interface {
 	// GetRawDatasetByUuid request
 	GetRawDatasetByUuid(ctx context.Context, uuid UuidParam, params *GetRawDatasetByUuidParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetDatasetRevisions request
+	GetDatasetRevisions(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRawDatasetRevision request
+	GetRawDatasetRevision(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RollbackDatasetToRevision request
+	RollbackDatasetToRevision(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteDatasetSchema request
+	DeleteDatasetSchema(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDatasetSchema request
+	GetDatasetSchema(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetDatasetSchema request with any body
+	SetDatasetSchemaWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetDatasetSchema(ctx context.Context, uuid UuidParam, body SetDatasetSchemaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteDatasetUploadByKey request
 	DeleteDatasetUploadByKey(ctx context.Context, uuid UuidParam, params *DeleteDatasetUploadByKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -171,6 +244,100 @@ type ClientInterface interface {
 	// FindPoliciesForGroup request
 	FindPoliciesForGroup(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// FindIncidents request
+	FindIncidents(ctx context.Context, params *FindIncidentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindIncidentByUuid request
+	FindIncidentByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AcknowledgeIncident request with any body
+	AcknowledgeIncidentWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AcknowledgeIncident(ctx context.Context, uuid UuidParam, body AcknowledgeIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindIncidentAlerts request
+	FindIncidentAlerts(ctx context.Context, uuid UuidParam, params *FindIncidentAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloseIncident request with any body
+	CloseIncidentWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CloseIncident(ctx context.Context, uuid UuidParam, body CloseIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindIncidentHistory request
+	FindIncidentHistory(ctx context.Context, uuid UuidParam, params *FindIncidentHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindProgramJobs request
+	FindProgramJobs(ctx context.Context, params *FindProgramJobsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindProgramJobById request
+	FindProgramJobById(ctx context.Context, jobId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequeueProgramJob request
+	RequeueProgramJob(ctx context.Context, jobId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindProgramJobRuns request
+	FindProgramJobRuns(ctx context.Context, jobId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindMonitors request
+	FindMonitors(ctx context.Context, params *FindMonitorsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddMonitor request with any body
+	AddMonitorWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddMonitor(ctx context.Context, body AddMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteMonitorByUuid request
+	DeleteMonitorByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindMonitorByUuid request
+	FindMonitorByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateMonitorByUuid request with any body
+	UpdateMonitorByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateMonitorByUuid(ctx context.Context, uuid UuidParam, body UpdateMonitorByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindNotificationChannels request
+	FindNotificationChannels(ctx context.Context, params *FindNotificationChannelsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddNotificationChannel request with any body
+	AddNotificationChannelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddNotificationChannel(ctx context.Context, body AddNotificationChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteNotificationChannelByUuid request
+	DeleteNotificationChannelByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindNotificationChannelByUuid request
+	FindNotificationChannelByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateNotificationChannelByUuid request with any body
+	UpdateNotificationChannelByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateNotificationChannelByUuid(ctx context.Context, uuid UuidParam, body UpdateNotificationChannelByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindNotificationDeliveries request
+	FindNotificationDeliveries(ctx context.Context, params *FindNotificationDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindNotificationRules request
+	FindNotificationRules(ctx context.Context, params *FindNotificationRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddNotificationRule request with any body
+	AddNotificationRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddNotificationRule(ctx context.Context, body AddNotificationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteNotificationRuleByUuid request
+	DeleteNotificationRuleByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindNotificationRuleByUuid request
+	FindNotificationRuleByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateNotificationRuleByUuid request with any body
+	UpdateNotificationRuleByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateNotificationRuleByUuid(ctx context.Context, uuid UuidParam, body UpdateNotificationRuleByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FindPolicies request
 	FindPolicies(ctx context.Context, params *FindPoliciesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -227,9 +394,61 @@ type ClientInterface interface {
 	// SignProgramCodeRevisions request
 	SignProgramCodeRevisions(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RunProgram request with any body
+	RunProgramWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RunProgram(ctx context.Context, uuid UuidParam, body RunProgramJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindProgramRuns request
+	FindProgramRuns(ctx context.Context, uuid UuidParam, params *FindProgramRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindProgramRunById request
+	FindProgramRunById(ctx context.Context, uuid UuidParam, runId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ExecuteProgramWebhook request
 	ExecuteProgramWebhook(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// FindSilences request
+	FindSilences(ctx context.Context, params *FindSilencesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddSilence request with any body
+	AddSilenceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddSilence(ctx context.Context, body AddSilenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSilenceByUuid request
+	DeleteSilenceByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindSilenceByUuid request
+	FindSilenceByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSilenceByUuid request with any body
+	UpdateSilenceByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSilenceByUuid(ctx context.Context, uuid UuidParam, body UpdateSilenceByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindThingTemplates request
+	FindThingTemplates(ctx context.Context, params *FindThingTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddThingTemplate request with any body
+	AddThingTemplateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddThingTemplate(ctx context.Context, body AddThingTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteThingTemplateByUuid request
+	DeleteThingTemplateByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindThingTemplateByUuid request
+	FindThingTemplateByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateThingTemplateByUuid request with any body
+	UpdateThingTemplateByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateThingTemplateByUuid(ctx context.Context, uuid UuidParam, body UpdateThingTemplateByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApplyThingTemplate request
+	ApplyThingTemplate(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FindThings request
 	FindThings(ctx context.Context, params *FindThingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -249,12 +468,60 @@ type ClientInterface interface {
 
 	UpdateThingByUuid(ctx context.Context, uuid UuidParam, body UpdateThingByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// FindAncestorsForThing request
+	FindAncestorsForThing(ctx context.Context, uuid UuidParam, params *FindAncestorsForThingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindChildrenForThing request
+	FindChildrenForThing(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddChildToThing request with any body
+	AddChildToThingWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddChildToThing(ctx context.Context, uuid UuidParam, body AddChildToThingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveChildFromThing request
+	RemoveChildFromThing(ctx context.Context, uuid UuidParam, childUuid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FindDatasetsForThing request
 	FindDatasetsForThing(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// FindDescendantsForThing request
+	FindDescendantsForThing(ctx context.Context, uuid UuidParam, params *FindDescendantsForThingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindStateHistoryForThing request
+	FindStateHistoryForThing(ctx context.Context, uuid UuidParam, params *FindStateHistoryForThingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindParentsForThing request
+	FindParentsForThing(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindSubtreeForThing request
+	FindSubtreeForThing(ctx context.Context, uuid UuidParam, params *FindSubtreeForThingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FindTimeSeriesForThing request
 	FindTimeSeriesForThing(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteThingTypeSchema request
+	DeleteThingTypeSchema(ctx context.Context, params *DeleteThingTypeSchemaParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindThingTypeSchemas request
+	FindThingTypeSchemas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetThingTypeSchema request with any body
+	SetThingTypeSchemaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetThingTypeSchema(ctx context.Context, body SetThingTypeSchemaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteThingStateTransitions request
+	DeleteThingStateTransitions(ctx context.Context, params *DeleteThingStateTransitionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindThingStateTransitions request
+	FindThingStateTransitions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetThingStateTransitions request with any body
+	SetThingStateTransitionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetThingStateTransitions(ctx context.Context, body SetThingStateTransitionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FindTimeSeries request
 	FindTimeSeries(ctx context.Context, params *FindTimeSeriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -285,6 +552,37 @@ type ClientInterface interface {
 
 	AddDataToTimeseries(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, body AddDataToTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// FindTimeseriesDataHistory request
+	FindTimeseriesDataHistory(ctx context.Context, uuid UuidParam, params *FindTimeseriesDataHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UndoTimeseriesDataChange request
+	UndoTimeseriesDataChange(ctx context.Context, uuid UuidParam, changeId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindTimeseriesHealth request
+	FindTimeseriesHealth(ctx context.Context, params *FindTimeseriesHealthParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindHealthRules request
+	FindHealthRules(ctx context.Context, params *FindHealthRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddHealthRule request with any body
+	AddHealthRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddHealthRule(ctx context.Context, body AddHealthRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteHealthRuleByUuid request
+	DeleteHealthRuleByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FindHealthRuleByUuid request
+	FindHealthRuleByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateHealthRuleByUuid request with any body
+	UpdateHealthRuleByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateHealthRuleByUuid(ctx context.Context, uuid UuidParam, body UpdateHealthRuleByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportTsdata request with any body
+	ImportTsdataWithBody(ctx context.Context, params *ImportTsdataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FindTsdataByQuery request
 	FindTsdataByQuery(ctx context.Context, params *FindTsdataByQueryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -330,8 +628,8 @@ type ClientInterface interface {
 	DeleteTokenForUser(ctx context.Context, uuid UuidParam, tokenUuid string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) FindAlerts(ctx context.Context, params *FindAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindAlertsRequest(c.Server, params)
+func (c *Client) FindAlertGroups(ctx context.Context, params *FindAlertGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindAlertGroupsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -342,8 +640,8 @@ func (c *Client) FindAlerts(ctx context.Context, params *FindAlertsParams, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateAlertWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateAlertRequestWithBody(c.Server, contentType, body)
+func (c *Client) AddAlertGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddAlertGroupRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -354,8 +652,8 @@ func (c *Client) CreateAlertWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateAlert(ctx context.Context, body CreateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateAlertRequest(c.Server, body)
+func (c *Client) AddAlertGroup(ctx context.Context, body AddAlertGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddAlertGroupRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -366,8 +664,8 @@ func (c *Client) CreateAlert(ctx context.Context, body CreateAlertJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteAlertByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteAlertByUuidRequest(c.Server, uuid)
+func (c *Client) DeleteAlertGroupByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAlertGroupByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -378,8 +676,8 @@ func (c *Client) DeleteAlertByUuid(ctx context.Context, uuid UuidParam, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindAlertByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindAlertByUuidRequest(c.Server, uuid)
+func (c *Client) FindAlertGroupByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindAlertGroupByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -390,8 +688,8 @@ func (c *Client) FindAlertByUuid(ctx context.Context, uuid UuidParam, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateAlertByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateAlertByUuidRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) UpdateAlertGroupByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAlertGroupByUuidRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -402,8 +700,8 @@ func (c *Client) UpdateAlertByUuidWithBody(ctx context.Context, uuid UuidParam,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateAlertByUuid(ctx context.Context, uuid UuidParam, body UpdateAlertByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateAlertByUuidRequest(c.Server, uuid, body)
+func (c *Client) UpdateAlertGroupByUuid(ctx context.Context, uuid UuidParam, body UpdateAlertGroupByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAlertGroupByUuidRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -414,8 +712,8 @@ func (c *Client) UpdateAlertByUuid(ctx context.Context, uuid UuidParam, body Upd
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindDatasets(ctx context.Context, params *FindDatasetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindDatasetsRequest(c.Server, params)
+func (c *Client) FindAlerts(ctx context.Context, params *FindAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindAlertsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -426,8 +724,8 @@ func (c *Client) FindDatasets(ctx context.Context, params *FindDatasetsParams, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddDatasetsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddDatasetsRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateAlertWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAlertRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -438,8 +736,8 @@ func (c *Client) AddDatasetsWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddDatasets(ctx context.Context, body AddDatasetsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddDatasetsRequest(c.Server, body)
+func (c *Client) CreateAlert(ctx context.Context, body CreateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAlertRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -450,8 +748,8 @@ func (c *Client) AddDatasets(ctx context.Context, body AddDatasetsJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDatasetByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDatasetByUuidRequest(c.Server, uuid)
+func (c *Client) DeleteAlertByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAlertByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -462,8 +760,8 @@ func (c *Client) DeleteDatasetByUuid(ctx context.Context, uuid UuidParam, reqEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindDatasetByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindDatasetByUuidRequest(c.Server, uuid)
+func (c *Client) FindAlertByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindAlertByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -474,8 +772,8 @@ func (c *Client) FindDatasetByUuid(ctx context.Context, uuid UuidParam, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatasetByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatasetByUuidRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) UpdateAlertByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAlertByUuidRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -486,8 +784,8 @@ func (c *Client) UpdateDatasetByUuidWithBody(ctx context.Context, uuid UuidParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatasetByUuid(ctx context.Context, uuid UuidParam, body UpdateDatasetByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatasetByUuidRequest(c.Server, uuid, body)
+func (c *Client) UpdateAlertByUuid(ctx context.Context, uuid UuidParam, body UpdateAlertByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAlertByUuidRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -498,8 +796,8 @@ func (c *Client) UpdateDatasetByUuid(ctx context.Context, uuid UuidParam, body U
 	return c.Client.Do(req)
 }
 
-func (c *Client) AssembleDatasetPartsByKey(ctx context.Context, uuid UuidParam, params *AssembleDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAssembleDatasetPartsByKeyRequest(c.Server, uuid, params)
+func (c *Client) AcknowledgeAlertWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcknowledgeAlertRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -510,8 +808,8 @@ func (c *Client) AssembleDatasetPartsByKey(ctx context.Context, uuid UuidParam,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDatasetPartsByKey(ctx context.Context, uuid UuidParam, params *ListDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDatasetPartsByKeyRequest(c.Server, uuid, params)
+func (c *Client) AcknowledgeAlert(ctx context.Context, uuid UuidParam, body AcknowledgeAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcknowledgeAlertRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -522,8 +820,8 @@ func (c *Client) ListDatasetPartsByKey(ctx context.Context, uuid UuidParam, para
 	return c.Client.Do(req)
 }
 
-func (c *Client) UploadDatasetContentByKey(ctx context.Context, uuid UuidParam, params *UploadDatasetContentByKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUploadDatasetContentByKeyRequest(c.Server, uuid, params)
+func (c *Client) FindAlertHistory(ctx context.Context, uuid UuidParam, params *FindAlertHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindAlertHistoryRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
@@ -534,8 +832,8 @@ func (c *Client) UploadDatasetContentByKey(ctx context.Context, uuid UuidParam,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetRawDatasetByUuid(ctx context.Context, uuid UuidParam, params *GetRawDatasetByUuidParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetRawDatasetByUuidRequest(c.Server, uuid, params)
+func (c *Client) ShelveAlertWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewShelveAlertRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -546,8 +844,8 @@ func (c *Client) GetRawDatasetByUuid(ctx context.Context, uuid UuidParam, params
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDatasetUploadByKey(ctx context.Context, uuid UuidParam, params *DeleteDatasetUploadByKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDatasetUploadByKeyRequest(c.Server, uuid, params)
+func (c *Client) ShelveAlert(ctx context.Context, uuid UuidParam, body ShelveAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewShelveAlertRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -558,8 +856,8 @@ func (c *Client) DeleteDatasetUploadByKey(ctx context.Context, uuid UuidParam, p
 	return c.Client.Do(req)
 }
 
-func (c *Client) InitializeDatasetUploadByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewInitializeDatasetUploadByUuidRequest(c.Server, uuid)
+func (c *Client) UnacknowledgeAlertWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnacknowledgeAlertRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -570,8 +868,8 @@ func (c *Client) InitializeDatasetUploadByUuid(ctx context.Context, uuid UuidPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindGroups(ctx context.Context, params *FindGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindGroupsRequest(c.Server, params)
+func (c *Client) UnacknowledgeAlert(ctx context.Context, uuid UuidParam, body UnacknowledgeAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnacknowledgeAlertRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -582,8 +880,8 @@ func (c *Client) FindGroups(ctx context.Context, params *FindGroupsParams, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddGroupRequestWithBody(c.Server, contentType, body)
+func (c *Client) UnshelveAlertWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnshelveAlertRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -594,8 +892,8 @@ func (c *Client) AddGroupWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddGroup(ctx context.Context, body AddGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddGroupRequest(c.Server, body)
+func (c *Client) UnshelveAlert(ctx context.Context, uuid UuidParam, body UnshelveAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnshelveAlertRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -606,8 +904,8 @@ func (c *Client) AddGroup(ctx context.Context, body AddGroupJSONRequestBody, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteGroupByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteGroupByUuidRequest(c.Server, uuid)
+func (c *Client) FindDatasets(ctx context.Context, params *FindDatasetsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindDatasetsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -618,8 +916,8 @@ func (c *Client) DeleteGroupByUuid(ctx context.Context, uuid UuidParam, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindGroupByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindGroupByUuidRequest(c.Server, uuid)
+func (c *Client) AddDatasetsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddDatasetsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -630,8 +928,8 @@ func (c *Client) FindGroupByUuid(ctx context.Context, uuid UuidParam, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateGroupByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateGroupByUuidRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) AddDatasets(ctx context.Context, body AddDatasetsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddDatasetsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -642,8 +940,8 @@ func (c *Client) UpdateGroupByUuidWithBody(ctx context.Context, uuid UuidParam,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateGroupByUuid(ctx context.Context, uuid UuidParam, body UpdateGroupByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateGroupByUuidRequest(c.Server, uuid, body)
+func (c *Client) DeleteDatasetByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatasetByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -654,8 +952,8 @@ func (c *Client) UpdateGroupByUuid(ctx context.Context, uuid UuidParam, body Upd
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindPoliciesForGroup(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindPoliciesForGroupRequest(c.Server, uuid)
+func (c *Client) FindDatasetByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindDatasetByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -666,8 +964,8 @@ func (c *Client) FindPoliciesForGroup(ctx context.Context, uuid UuidParam, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindPolicies(ctx context.Context, params *FindPoliciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindPoliciesRequest(c.Server, params)
+func (c *Client) UpdateDatasetByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatasetByUuidRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -678,8 +976,8 @@ func (c *Client) FindPolicies(ctx context.Context, params *FindPoliciesParams, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddPolicyRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateDatasetByUuid(ctx context.Context, uuid UuidParam, body UpdateDatasetByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatasetByUuidRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -690,8 +988,8 @@ func (c *Client) AddPolicyWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddPolicy(ctx context.Context, body AddPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddPolicyRequest(c.Server, body)
+func (c *Client) AssembleDatasetPartsByKey(ctx context.Context, uuid UuidParam, params *AssembleDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssembleDatasetPartsByKeyRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
@@ -702,8 +1000,8 @@ func (c *Client) AddPolicy(ctx context.Context, body AddPolicyJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePolicyByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePolicyByUuidRequest(c.Server, uuid)
+func (c *Client) GetDatasetRevisionsDiff(ctx context.Context, uuid UuidParam, params *GetDatasetRevisionsDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatasetRevisionsDiffRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
@@ -714,8 +1012,8 @@ func (c *Client) DeletePolicyByUuid(ctx context.Context, uuid UuidParam, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindPolicyByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindPolicyByUuidRequest(c.Server, uuid)
+func (c *Client) GetDatasetDocument(ctx context.Context, uuid UuidParam, params *GetDatasetDocumentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatasetDocumentRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
@@ -726,8 +1024,8 @@ func (c *Client) FindPolicyByUuid(ctx context.Context, uuid UuidParam, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePolicyByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePolicyByUuidRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) ImportDatasetIntoTimeseriesWithBody(ctx context.Context, uuid UuidParam, params *ImportDatasetIntoTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportDatasetIntoTimeseriesRequestWithBody(c.Server, uuid, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -738,8 +1036,8 @@ func (c *Client) UpdatePolicyByUuidWithBody(ctx context.Context, uuid UuidParam,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePolicyByUuid(ctx context.Context, uuid UuidParam, body UpdatePolicyByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePolicyByUuidRequest(c.Server, uuid, body)
+func (c *Client) ImportDatasetIntoTimeseries(ctx context.Context, uuid UuidParam, params *ImportDatasetIntoTimeseriesParams, body ImportDatasetIntoTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportDatasetIntoTimeseriesRequest(c.Server, uuid, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -750,8 +1048,8 @@ func (c *Client) UpdatePolicyByUuid(ctx context.Context, uuid UuidParam, body Up
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindPrograms(ctx context.Context, params *FindProgramsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindProgramsRequest(c.Server, params)
+func (c *Client) ListDatasetPartsByKey(ctx context.Context, uuid UuidParam, params *ListDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatasetPartsByKeyRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
@@ -762,8 +1060,8 @@ func (c *Client) FindPrograms(ctx context.Context, params *FindProgramsParams, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddProgramWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddProgramRequestWithBody(c.Server, contentType, body)
+func (c *Client) UploadDatasetContentByKey(ctx context.Context, uuid UuidParam, params *UploadDatasetContentByKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadDatasetContentByKeyRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
@@ -774,8 +1072,8 @@ func (c *Client) AddProgramWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddProgram(ctx context.Context, body AddProgramJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddProgramRequest(c.Server, body)
+func (c *Client) GetRawDatasetByUuid(ctx context.Context, uuid UuidParam, params *GetRawDatasetByUuidParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRawDatasetByUuidRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
@@ -786,8 +1084,8 @@ func (c *Client) AddProgram(ctx context.Context, body AddProgramJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteProgramByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteProgramByUuidRequest(c.Server, uuid)
+func (c *Client) GetDatasetRevisions(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatasetRevisionsRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -798,8 +1096,8 @@ func (c *Client) DeleteProgramByUuid(ctx context.Context, uuid UuidParam, reqEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindProgramByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindProgramByUuidRequest(c.Server, uuid)
+func (c *Client) GetRawDatasetRevision(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRawDatasetRevisionRequest(c.Server, uuid, revisionId)
 	if err != nil {
 		return nil, err
 	}
@@ -810,8 +1108,8 @@ func (c *Client) FindProgramByUuid(ctx context.Context, uuid UuidParam, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateProgramByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateProgramByUuidRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) RollbackDatasetToRevision(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRollbackDatasetToRevisionRequest(c.Server, uuid, revisionId)
 	if err != nil {
 		return nil, err
 	}
@@ -822,8 +1120,8 @@ func (c *Client) UpdateProgramByUuidWithBody(ctx context.Context, uuid UuidParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateProgramByUuid(ctx context.Context, uuid UuidParam, body UpdateProgramByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateProgramByUuidRequest(c.Server, uuid, body)
+func (c *Client) DeleteDatasetSchema(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatasetSchemaRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -834,8 +1132,8 @@ func (c *Client) UpdateProgramByUuid(ctx context.Context, uuid UuidParam, body U
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCodeFromProgram(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCodeFromProgramRequest(c.Server, uuid)
+func (c *Client) GetDatasetSchema(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatasetSchemaRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -846,8 +1144,8 @@ func (c *Client) GetCodeFromProgram(ctx context.Context, uuid UuidParam, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddProgramCodeRevisionWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddProgramCodeRevisionRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) SetDatasetSchemaWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetDatasetSchemaRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -858,8 +1156,8 @@ func (c *Client) AddProgramCodeRevisionWithBody(ctx context.Context, uuid UuidPa
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetProgramCodeRevisionsDiff(ctx context.Context, uuid UuidParam, params *GetProgramCodeRevisionsDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetProgramCodeRevisionsDiffRequest(c.Server, uuid, params)
+func (c *Client) SetDatasetSchema(ctx context.Context, uuid UuidParam, body SetDatasetSchemaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetDatasetSchemaRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -870,8 +1168,8 @@ func (c *Client) GetProgramCodeRevisionsDiff(ctx context.Context, uuid UuidParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetProgramCodeRevisions(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetProgramCodeRevisionsRequest(c.Server, uuid)
+func (c *Client) DeleteDatasetUploadByKey(ctx context.Context, uuid UuidParam, params *DeleteDatasetUploadByKeyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatasetUploadByKeyRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
@@ -882,8 +1180,8 @@ func (c *Client) GetProgramCodeRevisions(ctx context.Context, uuid UuidParam, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteProgramCodeRevisions(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteProgramCodeRevisionsRequest(c.Server, uuid, revisionId)
+func (c *Client) InitializeDatasetUploadByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInitializeDatasetUploadByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -894,8 +1192,8 @@ func (c *Client) DeleteProgramCodeRevisions(ctx context.Context, uuid UuidParam,
 	return c.Client.Do(req)
 }
 
-func (c *Client) SignProgramCodeRevisions(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSignProgramCodeRevisionsRequest(c.Server, uuid, revisionId)
+func (c *Client) FindGroups(ctx context.Context, params *FindGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindGroupsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -906,8 +1204,8 @@ func (c *Client) SignProgramCodeRevisions(ctx context.Context, uuid UuidParam, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) ExecuteProgramWebhook(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewExecuteProgramWebhookRequest(c.Server, uuid)
+func (c *Client) AddGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddGroupRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -918,8 +1216,8 @@ func (c *Client) ExecuteProgramWebhook(ctx context.Context, uuid UuidParam, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindThings(ctx context.Context, params *FindThingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindThingsRequest(c.Server, params)
+func (c *Client) AddGroup(ctx context.Context, body AddGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddGroupRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -930,8 +1228,8 @@ func (c *Client) FindThings(ctx context.Context, params *FindThingsParams, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddThingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddThingRequestWithBody(c.Server, contentType, body)
+func (c *Client) DeleteGroupByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteGroupByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -942,8 +1240,8 @@ func (c *Client) AddThingWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddThing(ctx context.Context, body AddThingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddThingRequest(c.Server, body)
+func (c *Client) FindGroupByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindGroupByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -954,8 +1252,8 @@ func (c *Client) AddThing(ctx context.Context, body AddThingJSONRequestBody, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteThingByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteThingByUuidRequest(c.Server, uuid)
+func (c *Client) UpdateGroupByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateGroupByUuidRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -966,8 +1264,8 @@ func (c *Client) DeleteThingByUuid(ctx context.Context, uuid UuidParam, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindThingByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindThingByUuidRequest(c.Server, uuid)
+func (c *Client) UpdateGroupByUuid(ctx context.Context, uuid UuidParam, body UpdateGroupByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateGroupByUuidRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -978,8 +1276,8 @@ func (c *Client) FindThingByUuid(ctx context.Context, uuid UuidParam, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateThingByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateThingByUuidRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) FindPoliciesForGroup(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindPoliciesForGroupRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -990,8 +1288,8 @@ func (c *Client) UpdateThingByUuidWithBody(ctx context.Context, uuid UuidParam,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateThingByUuid(ctx context.Context, uuid UuidParam, body UpdateThingByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateThingByUuidRequest(c.Server, uuid, body)
+func (c *Client) FindIncidents(ctx context.Context, params *FindIncidentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindIncidentsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1002,8 +1300,8 @@ func (c *Client) UpdateThingByUuid(ctx context.Context, uuid UuidParam, body Upd
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindDatasetsForThing(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindDatasetsForThingRequest(c.Server, uuid)
+func (c *Client) FindIncidentByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindIncidentByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -1014,8 +1312,8 @@ func (c *Client) FindDatasetsForThing(ctx context.Context, uuid UuidParam, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindTimeSeriesForThing(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindTimeSeriesForThingRequest(c.Server, uuid)
+func (c *Client) AcknowledgeIncidentWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcknowledgeIncidentRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1026,8 +1324,8 @@ func (c *Client) FindTimeSeriesForThing(ctx context.Context, uuid UuidParam, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindTimeSeries(ctx context.Context, params *FindTimeSeriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindTimeSeriesRequest(c.Server, params)
+func (c *Client) AcknowledgeIncident(ctx context.Context, uuid UuidParam, body AcknowledgeIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcknowledgeIncidentRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1038,8 +1336,8 @@ func (c *Client) FindTimeSeries(ctx context.Context, params *FindTimeSeriesParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddTimeSeriesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddTimeSeriesRequestWithBody(c.Server, contentType, body)
+func (c *Client) FindIncidentAlerts(ctx context.Context, uuid UuidParam, params *FindIncidentAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindIncidentAlertsRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1050,8 +1348,8 @@ func (c *Client) AddTimeSeriesWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddTimeSeries(ctx context.Context, body AddTimeSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddTimeSeriesRequest(c.Server, body)
+func (c *Client) CloseIncidentWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloseIncidentRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1062,8 +1360,8 @@ func (c *Client) AddTimeSeries(ctx context.Context, body AddTimeSeriesJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTimeSeriesByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTimeSeriesByUuidRequest(c.Server, uuid)
+func (c *Client) CloseIncident(ctx context.Context, uuid UuidParam, body CloseIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloseIncidentRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1074,8 +1372,8 @@ func (c *Client) DeleteTimeSeriesByUuid(ctx context.Context, uuid UuidParam, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindTimeSeriesByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindTimeSeriesByUuidRequest(c.Server, uuid)
+func (c *Client) FindIncidentHistory(ctx context.Context, uuid UuidParam, params *FindIncidentHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindIncidentHistoryRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1086,8 +1384,8 @@ func (c *Client) FindTimeSeriesByUuid(ctx context.Context, uuid UuidParam, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTimeseriesByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTimeseriesByUuidRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) FindProgramJobs(ctx context.Context, params *FindProgramJobsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindProgramJobsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1098,8 +1396,8 @@ func (c *Client) UpdateTimeseriesByUuidWithBody(ctx context.Context, uuid UuidPa
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTimeseriesByUuid(ctx context.Context, uuid UuidParam, body UpdateTimeseriesByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTimeseriesByUuidRequest(c.Server, uuid, body)
+func (c *Client) FindProgramJobById(ctx context.Context, jobId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindProgramJobByIdRequest(c.Server, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -1110,8 +1408,8 @@ func (c *Client) UpdateTimeseriesByUuid(ctx context.Context, uuid UuidParam, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDataFromTimeSeries(ctx context.Context, uuid UuidParam, params *DeleteDataFromTimeSeriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDataFromTimeSeriesRequest(c.Server, uuid, params)
+func (c *Client) RequeueProgramJob(ctx context.Context, jobId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequeueProgramJobRequest(c.Server, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -1122,8 +1420,8 @@ func (c *Client) DeleteDataFromTimeSeries(ctx context.Context, uuid UuidParam, p
 	return c.Client.Do(req)
 }
 
-func (c *Client) QueryTimeseriesForData(ctx context.Context, uuid UuidParam, params *QueryTimeseriesForDataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQueryTimeseriesForDataRequest(c.Server, uuid, params)
+func (c *Client) FindProgramJobRuns(ctx context.Context, jobId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindProgramJobRunsRequest(c.Server, jobId)
 	if err != nil {
 		return nil, err
 	}
@@ -1134,8 +1432,8 @@ func (c *Client) QueryTimeseriesForData(ctx context.Context, uuid UuidParam, par
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddDataToTimeseriesWithBody(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddDataToTimeseriesRequestWithBody(c.Server, uuid, params, contentType, body)
+func (c *Client) FindMonitors(ctx context.Context, params *FindMonitorsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindMonitorsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1146,8 +1444,8 @@ func (c *Client) AddDataToTimeseriesWithBody(ctx context.Context, uuid UuidParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddDataToTimeseries(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, body AddDataToTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddDataToTimeseriesRequest(c.Server, uuid, params, body)
+func (c *Client) AddMonitorWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddMonitorRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1158,8 +1456,8 @@ func (c *Client) AddDataToTimeseries(ctx context.Context, uuid UuidParam, params
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindTsdataByQuery(ctx context.Context, params *FindTsdataByQueryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindTsdataByQueryRequest(c.Server, params)
+func (c *Client) AddMonitor(ctx context.Context, body AddMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddMonitorRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1170,8 +1468,8 @@ func (c *Client) FindTsdataByQuery(ctx context.Context, params *FindTsdataByQuer
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindUsers(ctx context.Context, params *FindUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindUsersRequest(c.Server, params)
+func (c *Client) DeleteMonitorByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMonitorByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -1182,8 +1480,8 @@ func (c *Client) FindUsers(ctx context.Context, params *FindUsersParams, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddUserRequestWithBody(c.Server, contentType, body)
+func (c *Client) FindMonitorByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindMonitorByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -1194,8 +1492,8 @@ func (c *Client) AddUserWithBody(ctx context.Context, contentType string, body i
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddUser(ctx context.Context, body AddUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddUserRequest(c.Server, body)
+func (c *Client) UpdateMonitorByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMonitorByUuidRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1206,8 +1504,8 @@ func (c *Client) AddUser(ctx context.Context, body AddUserJSONRequestBody, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) Whoami(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewWhoamiRequest(c.Server)
+func (c *Client) UpdateMonitorByUuid(ctx context.Context, uuid UuidParam, body UpdateMonitorByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMonitorByUuidRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1218,8 +1516,8 @@ func (c *Client) Whoami(ctx context.Context, reqEditors ...RequestEditorFn) (*ht
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteUserByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteUserByUuidRequest(c.Server, uuid)
+func (c *Client) FindNotificationChannels(ctx context.Context, params *FindNotificationChannelsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindNotificationChannelsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1230,8 +1528,8 @@ func (c *Client) DeleteUserByUuid(ctx context.Context, uuid UuidParam, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindUserByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindUserByUuidRequest(c.Server, uuid)
+func (c *Client) AddNotificationChannelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddNotificationChannelRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1242,8 +1540,8 @@ func (c *Client) FindUserByUuid(ctx context.Context, uuid UuidParam, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateUserByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateUserByUuidRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) AddNotificationChannel(ctx context.Context, body AddNotificationChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddNotificationChannelRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1254,8 +1552,8 @@ func (c *Client) UpdateUserByUuidWithBody(ctx context.Context, uuid UuidParam, c
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateUserByUuid(ctx context.Context, uuid UuidParam, body UpdateUserByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateUserByUuidRequest(c.Server, uuid, body)
+func (c *Client) DeleteNotificationChannelByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteNotificationChannelByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -1266,8 +1564,8 @@ func (c *Client) UpdateUserByUuid(ctx context.Context, uuid UuidParam, body Upda
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindPoliciesForUser(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindPoliciesForUserRequest(c.Server, uuid)
+func (c *Client) FindNotificationChannelByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindNotificationChannelByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
@@ -1278,8 +1576,8 @@ func (c *Client) FindPoliciesForUser(ctx context.Context, uuid UuidParam, reqEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetRequestRateForUserWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetRequestRateForUserRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) UpdateNotificationChannelByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNotificationChannelByUuidRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1290,8 +1588,8 @@ func (c *Client) SetRequestRateForUserWithBody(ctx context.Context, uuid UuidPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetRequestRateForUser(ctx context.Context, uuid UuidParam, body SetRequestRateForUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetRequestRateForUserRequest(c.Server, uuid, body)
+func (c *Client) UpdateNotificationChannelByUuid(ctx context.Context, uuid UuidParam, body UpdateNotificationChannelByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNotificationChannelByUuidRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1302,8 +1600,8 @@ func (c *Client) SetRequestRateForUser(ctx context.Context, uuid UuidParam, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) FindTokensForUser(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFindTokensForUserRequest(c.Server, uuid)
+func (c *Client) FindNotificationDeliveries(ctx context.Context, params *FindNotificationDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindNotificationDeliveriesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1314,8 +1612,8 @@ func (c *Client) FindTokensForUser(ctx context.Context, uuid UuidParam, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddNewTokenToUserWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddNewTokenToUserRequestWithBody(c.Server, uuid, contentType, body)
+func (c *Client) FindNotificationRules(ctx context.Context, params *FindNotificationRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindNotificationRulesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1326,8 +1624,8 @@ func (c *Client) AddNewTokenToUserWithBody(ctx context.Context, uuid UuidParam,
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddNewTokenToUser(ctx context.Context, uuid UuidParam, body AddNewTokenToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddNewTokenToUserRequest(c.Server, uuid, body)
+func (c *Client) AddNotificationRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddNotificationRuleRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1338,8 +1636,8 @@ func (c *Client) AddNewTokenToUser(ctx context.Context, uuid UuidParam, body Add
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTokenForUser(ctx context.Context, uuid UuidParam, tokenUuid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTokenForUserRequest(c.Server, uuid, tokenUuid)
+func (c *Client) AddNotificationRule(ctx context.Context, body AddNotificationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddNotificationRuleRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1350,1427 +1648,1304 @@ func (c *Client) DeleteTokenForUser(ctx context.Context, uuid UuidParam, tokenUu
 	return c.Client.Do(req)
 }
 
-// NewFindAlertsRequest generates requests for FindAlerts
-func NewFindAlertsRequest(server string, params *FindAlertsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteNotificationRuleByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteNotificationRuleByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/alerts")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) FindNotificationRuleByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindNotificationRuleByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if params.Offset != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Limit != nil {
+func (c *Client) UpdateNotificationRuleByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNotificationRuleByUuidRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+func (c *Client) UpdateNotificationRuleByUuid(ctx context.Context, uuid UuidParam, body UpdateNotificationRuleByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNotificationRuleByUuidRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
+func (c *Client) FindPolicies(ctx context.Context, params *FindPoliciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindPoliciesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Resource != nil {
+func (c *Client) AddPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddPolicyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resource", runtime.ParamLocationQuery, *params.Resource); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+func (c *Client) AddPolicy(ctx context.Context, body AddPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddPolicyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
+func (c *Client) DeletePolicyByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePolicyByUuidRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Environment != nil {
+func (c *Client) FindPolicyByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindPolicyByUuidRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment", runtime.ParamLocationQuery, *params.Environment); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+func (c *Client) UpdatePolicyByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePolicyByUuidRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
+func (c *Client) UpdatePolicyByUuid(ctx context.Context, uuid UuidParam, body UpdatePolicyByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePolicyByUuidRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Event != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "event", runtime.ParamLocationQuery, *params.Event); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+func (c *Client) FindPrograms(ctx context.Context, params *FindProgramsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindProgramsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
+func (c *Client) AddProgramWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddProgramRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.Origin != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "origin", runtime.ParamLocationQuery, *params.Origin); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Status != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) AddProgram(ctx context.Context, body AddProgramJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddProgramRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.SeverityLe != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "severity_le", runtime.ParamLocationQuery, *params.SeverityLe); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.SeverityGe != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "severity_ge", runtime.ParamLocationQuery, *params.SeverityGe); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) DeleteProgramByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProgramByUuidRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.Severity != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "severity", runtime.ParamLocationQuery, *params.Severity); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Tags != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) FindProgramByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindProgramByUuidRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.Service != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "service", runtime.ParamLocationQuery, *params.Service); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) UpdateProgramByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProgramByUuidRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateAlertRequest calls the generic CreateAlert builder with application/json body
-func NewCreateAlertRequest(server string, body CreateAlertJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateProgramByUuid(ctx context.Context, uuid UuidParam, body UpdateProgramByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProgramByUuidRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateAlertRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateAlertRequestWithBody generates requests for CreateAlert with any type of body
-func NewCreateAlertRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetCodeFromProgram(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCodeFromProgramRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/alerts")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddProgramCodeRevisionWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddProgramCodeRevisionRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteAlertByUuidRequest generates requests for DeleteAlertByUuid
-func NewDeleteAlertByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) GetProgramCodeRevisionsDiff(ctx context.Context, uuid UuidParam, params *GetProgramCodeRevisionsDiffParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProgramCodeRevisionsDiffRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetProgramCodeRevisions(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProgramCodeRevisionsRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/alerts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteProgramCodeRevisions(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteProgramCodeRevisionsRequest(c.Server, uuid, revisionId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewFindAlertByUuidRequest generates requests for FindAlertByUuid
-func NewFindAlertByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) SignProgramCodeRevisions(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignProgramCodeRevisionsRequest(c.Server, uuid, revisionId)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v2/alerts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RunProgramWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunProgramRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateAlertByUuidRequest calls the generic UpdateAlertByUuid builder with application/json body
-func NewUpdateAlertByUuidRequest(server string, uuid UuidParam, body UpdateAlertByUuidJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) RunProgram(ctx context.Context, uuid UuidParam, body RunProgramJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunProgramRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateAlertByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateAlertByUuidRequestWithBody generates requests for UpdateAlertByUuid with any type of body
-func NewUpdateAlertByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) FindProgramRuns(ctx context.Context, uuid UuidParam, params *FindProgramRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindProgramRunsRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) FindProgramRunById(ctx context.Context, uuid UuidParam, runId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindProgramRunByIdRequest(c.Server, uuid, runId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/alerts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ExecuteProgramWebhook(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExecuteProgramWebhookRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewFindDatasetsRequest generates requests for FindDatasets
-func NewFindDatasetsRequest(server string, params *FindDatasetsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) FindSilences(ctx context.Context, params *FindSilencesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindSilencesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/datasets")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddSilenceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddSilenceRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if params.Offset != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Limit != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) AddSilence(ctx context.Context, body AddSilenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddSilenceRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.Tags != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) DeleteSilenceByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSilenceByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAddDatasetsRequest calls the generic AddDatasets builder with application/json body
-func NewAddDatasetsRequest(server string, body AddDatasetsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) FindSilenceByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindSilenceByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddDatasetsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAddDatasetsRequestWithBody generates requests for AddDatasets with any type of body
-func NewAddDatasetsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateSilenceByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSilenceByUuidRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/datasets")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateSilenceByUuid(ctx context.Context, uuid UuidParam, body UpdateSilenceByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSilenceByUuidRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteDatasetByUuidRequest generates requests for DeleteDatasetByUuid
-func NewDeleteDatasetByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) FindThingTemplates(ctx context.Context, params *FindThingTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindThingTemplatesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) AddThingTemplateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddThingTemplateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/datasets/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddThingTemplate(ctx context.Context, body AddThingTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddThingTemplateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewFindDatasetByUuidRequest generates requests for FindDatasetByUuid
-func NewFindDatasetByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) DeleteThingTemplateByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteThingTemplateByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) FindThingTemplateByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindThingTemplateByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/datasets/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateThingTemplateByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateThingTemplateByUuidRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateDatasetByUuidRequest calls the generic UpdateDatasetByUuid builder with application/json body
-func NewUpdateDatasetByUuidRequest(server string, uuid UuidParam, body UpdateDatasetByUuidJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateThingTemplateByUuid(ctx context.Context, uuid UuidParam, body UpdateThingTemplateByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateThingTemplateByUuidRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDatasetByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateDatasetByUuidRequestWithBody generates requests for UpdateDatasetByUuid with any type of body
-func NewUpdateDatasetByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) ApplyThingTemplate(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyThingTemplateRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) FindThings(ctx context.Context, params *FindThingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindThingsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/datasets/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddThingWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddThingRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAssembleDatasetPartsByKeyRequest generates requests for AssembleDatasetPartsByKey
-func NewAssembleDatasetPartsByKeyRequest(server string, uuid UuidParam, params *AssembleDatasetPartsByKeyParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) AddThing(ctx context.Context, body AddThingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddThingRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteThingByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteThingByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/datasets/%s/assemble", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) FindThingByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindThingByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "partNumber", runtime.ParamLocationQuery, params.PartNumber); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
 	}
+	return c.Client.Do(req)
+}
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uploadId", runtime.ParamLocationQuery, params.UploadId); err != nil {
+func (c *Client) UpdateThingByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateThingByUuidRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
 		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) UpdateThingByUuid(ctx context.Context, uuid UuidParam, body UpdateThingByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateThingByUuidRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var headerParam0 string
-
-	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Content-MD5", runtime.ParamLocationHeader, params.ContentMD5)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Set("Content-MD5", headerParam0)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListDatasetPartsByKeyRequest generates requests for ListDatasetPartsByKey
-func NewListDatasetPartsByKeyRequest(server string, uuid UuidParam, params *ListDatasetPartsByKeyParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) FindAncestorsForThing(ctx context.Context, uuid UuidParam, params *FindAncestorsForThingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindAncestorsForThingRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) FindChildrenForThing(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindChildrenForThingRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/datasets/%s/parts", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddChildToThingWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddChildToThingRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key", runtime.ParamLocationQuery, params.Key); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) AddChildToThing(ctx context.Context, uuid UuidParam, body AddChildToThingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddChildToThingRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUploadDatasetContentByKeyRequest generates requests for UploadDatasetContentByKey
-func NewUploadDatasetContentByKeyRequest(server string, uuid UuidParam, params *UploadDatasetContentByKeyParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) RemoveChildFromThing(ctx context.Context, uuid UuidParam, childUuid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveChildFromThingRequest(c.Server, uuid, childUuid)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) FindDatasetsForThing(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindDatasetsForThingRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/datasets/%s/parts", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) FindDescendantsForThing(ctx context.Context, uuid UuidParam, params *FindDescendantsForThingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindDescendantsForThingRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "partNumber", runtime.ParamLocationQuery, params.PartNumber); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
 	}
+	return c.Client.Do(req)
+}
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uploadId", runtime.ParamLocationQuery, params.UploadId); err != nil {
+func (c *Client) FindStateHistoryForThing(ctx context.Context, uuid UuidParam, params *FindStateHistoryForThingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindStateHistoryForThingRequest(c.Server, uuid, params)
+	if err != nil {
 		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+func (c *Client) FindParentsForThing(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindParentsForThingRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	var headerParam0 string
-
-	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Content-MD5", runtime.ParamLocationHeader, params.ContentMD5)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Set("Content-MD5", headerParam0)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetRawDatasetByUuidRequest generates requests for GetRawDatasetByUuid
-func NewGetRawDatasetByUuidRequest(server string, uuid UuidParam, params *GetRawDatasetByUuidParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) FindSubtreeForThing(ctx context.Context, uuid UuidParam, params *FindSubtreeForThingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindSubtreeForThingRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) FindTimeSeriesForThing(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindTimeSeriesForThingRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/datasets/%s/raw", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteThingTypeSchema(ctx context.Context, params *DeleteThingTypeSchemaParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteThingTypeSchemaRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) FindThingTypeSchemas(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindThingTypeSchemasRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	if params.IfNoneMatch != nil {
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("If-None-Match", headerParam0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteDatasetUploadByKeyRequest generates requests for DeleteDatasetUploadByKey
-func NewDeleteDatasetUploadByKeyRequest(server string, uuid UuidParam, params *DeleteDatasetUploadByKeyParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) SetThingTypeSchemaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetThingTypeSchemaRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SetThingTypeSchema(ctx context.Context, body SetThingTypeSchemaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetThingTypeSchemaRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/datasets/%s/uploads", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteThingStateTransitions(ctx context.Context, params *DeleteThingStateTransitionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteThingStateTransitionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key", runtime.ParamLocationQuery, params.Key); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
-			}
-		}
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) FindThingStateTransitions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindThingStateTransitionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewInitializeDatasetUploadByUuidRequest generates requests for InitializeDatasetUploadByUuid
-func NewInitializeDatasetUploadByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) SetThingStateTransitionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetThingStateTransitionsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SetThingStateTransitions(ctx context.Context, body SetThingStateTransitionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetThingStateTransitionsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/datasets/%s/uploads", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) FindTimeSeries(ctx context.Context, params *FindTimeSeriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindTimeSeriesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewFindGroupsRequest generates requests for FindGroups
-func NewFindGroupsRequest(server string, params *FindGroupsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) AddTimeSeriesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTimeSeriesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/groups")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddTimeSeries(ctx context.Context, body AddTimeSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTimeSeriesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if params.Limit != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Offset != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) DeleteTimeSeriesByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTimeSeriesByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAddGroupRequest calls the generic AddGroup builder with application/json body
-func NewAddGroupRequest(server string, body AddGroupJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) FindTimeSeriesByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindTimeSeriesByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddGroupRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAddGroupRequestWithBody generates requests for AddGroup with any type of body
-func NewAddGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateTimeseriesByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTimeseriesByUuidRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/groups")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateTimeseriesByUuid(ctx context.Context, uuid UuidParam, body UpdateTimeseriesByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTimeseriesByUuidRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteGroupByUuidRequest generates requests for DeleteGroupByUuid
-func NewDeleteGroupByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) DeleteDataFromTimeSeries(ctx context.Context, uuid UuidParam, params *DeleteDataFromTimeSeriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDataFromTimeSeriesRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) QueryTimeseriesForData(ctx context.Context, uuid UuidParam, params *QueryTimeseriesForDataParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryTimeseriesForDataRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/groups/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddDataToTimeseriesWithBody(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddDataToTimeseriesRequestWithBody(c.Server, uuid, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewFindGroupByUuidRequest generates requests for FindGroupByUuid
-func NewFindGroupByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) AddDataToTimeseries(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, body AddDataToTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddDataToTimeseriesRequest(c.Server, uuid, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v2/groups/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) FindTimeseriesDataHistory(ctx context.Context, uuid UuidParam, params *FindTimeseriesDataHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindTimeseriesDataHistoryRequest(c.Server, uuid, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateGroupByUuidRequest calls the generic UpdateGroupByUuid builder with application/json body
-func NewUpdateGroupByUuidRequest(server string, uuid UuidParam, body UpdateGroupByUuidJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UndoTimeseriesDataChange(ctx context.Context, uuid UuidParam, changeId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUndoTimeseriesDataChangeRequest(c.Server, uuid, changeId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateGroupByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateGroupByUuidRequestWithBody generates requests for UpdateGroupByUuid with any type of body
-func NewUpdateGroupByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) FindTimeseriesHealth(ctx context.Context, params *FindTimeseriesHealthParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindTimeseriesHealthRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) FindHealthRules(ctx context.Context, params *FindHealthRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindHealthRulesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/groups/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddHealthRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddHealthRuleRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewFindPoliciesForGroupRequest generates requests for FindPoliciesForGroup
-func NewFindPoliciesForGroupRequest(server string, uuid UuidParam) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) AddHealthRule(ctx context.Context, body AddHealthRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddHealthRuleRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteHealthRuleByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteHealthRuleByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/groups/%s/policies", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) FindHealthRuleByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindHealthRuleByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewFindPoliciesRequest generates requests for FindPolicies
-func NewFindPoliciesRequest(server string, params *FindPoliciesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateHealthRuleByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateHealthRuleByUuidRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/policies")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateHealthRuleByUuid(ctx context.Context, uuid UuidParam, body UpdateHealthRuleByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateHealthRuleByUuidRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
-
-	queryValues := queryURL.Query()
-
-	if params.Offset != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	if params.Limit != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+func (c *Client) ImportTsdataWithBody(ctx context.Context, params *ImportTsdataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportTsdataRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-
-	if params.GroupUuids != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_uuids", runtime.ParamLocationQuery, *params.GroupUuids); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) FindTsdataByQuery(ctx context.Context, params *FindTsdataByQueryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindTsdataByQueryRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAddPolicyRequest calls the generic AddPolicy builder with application/json body
-func NewAddPolicyRequest(server string, body AddPolicyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) FindUsers(ctx context.Context, params *FindUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindUsersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddPolicyRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAddPolicyRequestWithBody generates requests for AddPolicy with any type of body
-func NewAddPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) AddUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/policies")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddUser(ctx context.Context, body AddUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddUserRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeletePolicyByUuidRequest generates requests for DeletePolicyByUuid
-func NewDeletePolicyByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) Whoami(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWhoamiRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v2/policies/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteUserByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) FindUserByUuid(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindUserByUuidRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewFindPolicyByUuidRequest generates requests for FindPolicyByUuid
-func NewFindPolicyByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) UpdateUserByUuidWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserByUuidRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateUserByUuid(ctx context.Context, uuid UuidParam, body UpdateUserByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserByUuidRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/policies/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) FindPoliciesForUser(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindPoliciesForUserRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) SetRequestRateForUserWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetRequestRateForUserRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdatePolicyByUuidRequest calls the generic UpdatePolicyByUuid builder with application/json body
-func NewUpdatePolicyByUuidRequest(server string, uuid UuidParam, body UpdatePolicyByUuidJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) SetRequestRateForUser(ctx context.Context, uuid UuidParam, body SetRequestRateForUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetRequestRateForUserRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePolicyByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdatePolicyByUuidRequestWithBody generates requests for UpdatePolicyByUuid with any type of body
-func NewUpdatePolicyByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+func (c *Client) FindTokensForUser(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindTokensForUserRequest(c.Server, uuid)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) AddNewTokenToUserWithBody(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddNewTokenToUserRequestWithBody(c.Server, uuid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v2/policies/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddNewTokenToUser(ctx context.Context, uuid UuidParam, body AddNewTokenToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddNewTokenToUserRequest(c.Server, uuid, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) DeleteTokenForUser(ctx context.Context, uuid UuidParam, tokenUuid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTokenForUserRequest(c.Server, uuid, tokenUuid)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewFindProgramsRequest generates requests for FindPrograms
-func NewFindProgramsRequest(server string, params *FindProgramsParams) (*http.Request, error) {
+// NewFindAlertGroupsRequest generates requests for FindAlertGroups
+func NewFindAlertGroupsRequest(server string, params *FindAlertGroupsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2778,7 +2953,7 @@ func NewFindProgramsRequest(server string, params *FindProgramsParams) (*http.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/programs")
+	operationPath := fmt.Sprintf("/v2/alertgroups")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2790,22 +2965,6 @@ func NewFindProgramsRequest(server string, params *FindProgramsParams) (*http.Re
 
 	queryValues := queryURL.Query()
 
-	if params.Offset != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
 	if params.Limit != nil {
 
 		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
@@ -2822,9 +2981,9 @@ func NewFindProgramsRequest(server string, params *FindProgramsParams) (*http.Re
 
 	}
 
-	if params.Tags != nil {
+	if params.Offset != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -2848,19 +3007,19 @@ func NewFindProgramsRequest(server string, params *FindProgramsParams) (*http.Re
 	return req, nil
 }
 
-// NewAddProgramRequest calls the generic AddProgram builder with application/json body
-func NewAddProgramRequest(server string, body AddProgramJSONRequestBody) (*http.Request, error) {
+// NewAddAlertGroupRequest calls the generic AddAlertGroup builder with application/json body
+func NewAddAlertGroupRequest(server string, body AddAlertGroupJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAddProgramRequestWithBody(server, "application/json", bodyReader)
+	return NewAddAlertGroupRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewAddProgramRequestWithBody generates requests for AddProgram with any type of body
-func NewAddProgramRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewAddAlertGroupRequestWithBody generates requests for AddAlertGroup with any type of body
+func NewAddAlertGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2868,7 +3027,7 @@ func NewAddProgramRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/programs")
+	operationPath := fmt.Sprintf("/v2/alertgroups")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2888,8 +3047,8 @@ func NewAddProgramRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewDeleteProgramByUuidRequest generates requests for DeleteProgramByUuid
-func NewDeleteProgramByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewDeleteAlertGroupByUuidRequest generates requests for DeleteAlertGroupByUuid
+func NewDeleteAlertGroupByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2904,7 +3063,7 @@ func NewDeleteProgramByUuidRequest(server string, uuid UuidParam) (*http.Request
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/programs/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/alertgroups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2922,8 +3081,8 @@ func NewDeleteProgramByUuidRequest(server string, uuid UuidParam) (*http.Request
 	return req, nil
 }
 
-// NewFindProgramByUuidRequest generates requests for FindProgramByUuid
-func NewFindProgramByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewFindAlertGroupByUuidRequest generates requests for FindAlertGroupByUuid
+func NewFindAlertGroupByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2938,7 +3097,7 @@ func NewFindProgramByUuidRequest(server string, uuid UuidParam) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/programs/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/alertgroups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2956,19 +3115,19 @@ func NewFindProgramByUuidRequest(server string, uuid UuidParam) (*http.Request,
 	return req, nil
 }
 
-// NewUpdateProgramByUuidRequest calls the generic UpdateProgramByUuid builder with application/json body
-func NewUpdateProgramByUuidRequest(server string, uuid UuidParam, body UpdateProgramByUuidJSONRequestBody) (*http.Request, error) {
+// NewUpdateAlertGroupByUuidRequest calls the generic UpdateAlertGroupByUuid builder with application/json body
+func NewUpdateAlertGroupByUuidRequest(server string, uuid UuidParam, body UpdateAlertGroupByUuidJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProgramByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+	return NewUpdateAlertGroupByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
 }
 
-// NewUpdateProgramByUuidRequestWithBody generates requests for UpdateProgramByUuid with any type of body
-func NewUpdateProgramByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateAlertGroupByUuidRequestWithBody generates requests for UpdateAlertGroupByUuid with any type of body
+func NewUpdateAlertGroupByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2983,7 +3142,7 @@ func NewUpdateProgramByUuidRequestWithBody(server string, uuid UuidParam, conten
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/programs/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/alertgroups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3003,23 +3162,16 @@ func NewUpdateProgramByUuidRequestWithBody(server string, uuid UuidParam, conten
 	return req, nil
 }
 
-// NewGetCodeFromProgramRequest generates requests for GetCodeFromProgram
-func NewGetCodeFromProgramRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewFindAlertsRequest generates requests for FindAlerts
+func NewFindAlertsRequest(server string, params *FindAlertsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/programs/%s/code", pathParam0)
+	operationPath := fmt.Sprintf("/v2/alerts")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3029,100 +3181,214 @@ func NewGetCodeFromProgramRequest(server string, uuid UuidParam) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
+	queryValues := queryURL.Query()
 
-// NewAddProgramCodeRevisionRequestWithBody generates requests for AddProgramCodeRevision with any type of body
-func NewAddProgramCodeRevisionRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	if params.Offset != nil {
 
-	var pathParam0 string
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
-	if err != nil {
-		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	if params.Limit != nil {
 
-	operationPath := fmt.Sprintf("/v2/programs/%s/code", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
+	if params.Resource != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resource", runtime.ParamLocationQuery, *params.Resource); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	if params.Environment != nil {
 
-	return req, nil
-}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "environment", runtime.ParamLocationQuery, *params.Environment); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-// NewGetProgramCodeRevisionsDiffRequest generates requests for GetProgramCodeRevisionsDiff
-func NewGetProgramCodeRevisionsDiffRequest(server string, uuid UuidParam, params *GetProgramCodeRevisionsDiffParams) (*http.Request, error) {
-	var err error
+	}
 
-	var pathParam0 string
+	if params.Event != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "event", runtime.ParamLocationQuery, *params.Event); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
-	if err != nil {
-		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	if params.Origin != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "origin", runtime.ParamLocationQuery, *params.Origin); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
 
-	operationPath := fmt.Sprintf("/v2/programs/%s/diff", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	if params.Status != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	if params.SeverityLe != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "severity_le", runtime.ParamLocationQuery, *params.SeverityLe); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
 	}
 
-	queryValues := queryURL.Query()
+	if params.SeverityGe != nil {
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rev_a", runtime.ParamLocationQuery, params.RevA); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "severity_ge", runtime.ParamLocationQuery, *params.SeverityGe); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
 			}
 		}
+
 	}
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rev_b", runtime.ParamLocationQuery, params.RevB); err != nil {
-		return nil, err
-	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-		return nil, err
-	} else {
-		for k, v := range parsed {
-			for _, v2 := range v {
-				queryValues.Add(k, v2)
+	if params.Severity != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "severity", runtime.ParamLocationQuery, *params.Severity); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Tags != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Service != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "service", runtime.ParamLocationQuery, *params.Service); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Silenced != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "silenced", runtime.ParamLocationQuery, *params.Silenced); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
 			}
 		}
+
 	}
 
 	queryURL.RawQuery = queryValues.Encode()
@@ -3135,23 +3401,27 @@ func NewGetProgramCodeRevisionsDiffRequest(server string, uuid UuidParam, params
 	return req, nil
 }
 
-// NewGetProgramCodeRevisionsRequest generates requests for GetProgramCodeRevisions
-func NewGetProgramCodeRevisionsRequest(server string, uuid UuidParam) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+// NewCreateAlertRequest calls the generic CreateAlert builder with application/json body
+func NewCreateAlertRequest(server string, body CreateAlertJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAlertRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateAlertRequestWithBody generates requests for CreateAlert with any type of body
+func NewCreateAlertRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/programs/%s/revisions", pathParam0)
+	operationPath := fmt.Sprintf("/v2/alerts")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3161,16 +3431,18 @@ func NewGetProgramCodeRevisionsRequest(server string, uuid UuidParam) (*http.Req
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDeleteProgramCodeRevisionsRequest generates requests for DeleteProgramCodeRevisions
-func NewDeleteProgramCodeRevisionsRequest(server string, uuid UuidParam, revisionId int) (*http.Request, error) {
+// NewDeleteAlertByUuidRequest generates requests for DeleteAlertByUuid
+func NewDeleteAlertByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3180,19 +3452,12 @@ func NewDeleteProgramCodeRevisionsRequest(server string, uuid UuidParam, revisio
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "revision_id", runtime.ParamLocationPath, revisionId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/programs/%s/revisions/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v2/alerts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3210,8 +3475,8 @@ func NewDeleteProgramCodeRevisionsRequest(server string, uuid UuidParam, revisio
 	return req, nil
 }
 
-// NewSignProgramCodeRevisionsRequest generates requests for SignProgramCodeRevisions
-func NewSignProgramCodeRevisionsRequest(server string, uuid UuidParam, revisionId int) (*http.Request, error) {
+// NewFindAlertByUuidRequest generates requests for FindAlertByUuid
+func NewFindAlertByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3221,19 +3486,12 @@ func NewSignProgramCodeRevisionsRequest(server string, uuid UuidParam, revisionI
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "revision_id", runtime.ParamLocationPath, revisionId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/programs/%s/revisions/%s/sign", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v2/alerts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3243,7 +3501,7 @@ func NewSignProgramCodeRevisionsRequest(server string, uuid UuidParam, revisionI
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3251,8 +3509,19 @@ func NewSignProgramCodeRevisionsRequest(server string, uuid UuidParam, revisionI
 	return req, nil
 }
 
-// NewExecuteProgramWebhookRequest generates requests for ExecuteProgramWebhook
-func NewExecuteProgramWebhookRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewUpdateAlertByUuidRequest calls the generic UpdateAlertByUuid builder with application/json body
+func NewUpdateAlertByUuidRequest(server string, uuid UuidParam, body UpdateAlertByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAlertByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateAlertByUuidRequestWithBody generates requests for UpdateAlertByUuid with any type of body
+func NewUpdateAlertByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3267,7 +3536,7 @@ func NewExecuteProgramWebhookRequest(server string, uuid UuidParam) (*http.Reque
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/programs/%s/webhook", pathParam0)
+	operationPath := fmt.Sprintf("/v2/alerts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3277,114 +3546,44 @@ func NewExecuteProgramWebhookRequest(server string, uuid UuidParam) (*http.Reque
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewFindThingsRequest generates requests for FindThings
-func NewFindThingsRequest(server string, params *FindThingsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+// NewAcknowledgeAlertRequest calls the generic AcknowledgeAlert builder with application/json body
+func NewAcknowledgeAlertRequest(server string, uuid UuidParam, body AcknowledgeAlertJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAcknowledgeAlertRequestWithBody(server, uuid, "application/json", bodyReader)
+}
 
-	operationPath := fmt.Sprintf("/v2/things")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	queryValues := queryURL.Query()
-
-	if params.Limit != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Offset != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	if params.Tags != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+// NewAcknowledgeAlertRequestWithBody generates requests for AcknowledgeAlert with any type of body
+func NewAcknowledgeAlertRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	return req, nil
-}
+	var pathParam0 string
 
-// NewAddThingRequest calls the generic AddThing builder with application/json body
-func NewAddThingRequest(server string, body AddThingJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddThingRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewAddThingRequestWithBody generates requests for AddThing with any type of body
-func NewAddThingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/things")
+	operationPath := fmt.Sprintf("/v2/alerts/%s/acknowledge", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3404,8 +3603,8 @@ func NewAddThingRequestWithBody(server string, contentType string, body io.Reade
 	return req, nil
 }
 
-// NewDeleteThingByUuidRequest generates requests for DeleteThingByUuid
-func NewDeleteThingByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewFindAlertHistoryRequest generates requests for FindAlertHistory
+func NewFindAlertHistoryRequest(server string, uuid UuidParam, params *FindAlertHistoryParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3420,7 +3619,7 @@ func NewDeleteThingByUuidRequest(server string, uuid UuidParam) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/things/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/alerts/%s/history", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3430,40 +3629,42 @@ func NewDeleteThingByUuidRequest(server string, uuid UuidParam) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
+	queryValues := queryURL.Query()
 
-// NewFindThingByUuidRequest generates requests for FindThingByUuid
-func NewFindThingByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
-	var err error
+	if params.Limit != nil {
 
-	var pathParam0 string
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
-	if err != nil {
-		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	if params.Offset != nil {
 
-	operationPath := fmt.Sprintf("/v2/things/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
 	}
 
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3472,19 +3673,19 @@ func NewFindThingByUuidRequest(server string, uuid UuidParam) (*http.Request, er
 	return req, nil
 }
 
-// NewUpdateThingByUuidRequest calls the generic UpdateThingByUuid builder with application/json body
-func NewUpdateThingByUuidRequest(server string, uuid UuidParam, body UpdateThingByUuidJSONRequestBody) (*http.Request, error) {
+// NewShelveAlertRequest calls the generic ShelveAlert builder with application/json body
+func NewShelveAlertRequest(server string, uuid UuidParam, body ShelveAlertJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateThingByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+	return NewShelveAlertRequestWithBody(server, uuid, "application/json", bodyReader)
 }
 
-// NewUpdateThingByUuidRequestWithBody generates requests for UpdateThingByUuid with any type of body
-func NewUpdateThingByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+// NewShelveAlertRequestWithBody generates requests for ShelveAlert with any type of body
+func NewShelveAlertRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3499,7 +3700,7 @@ func NewUpdateThingByUuidRequestWithBody(server string, uuid UuidParam, contentT
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/things/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/alerts/%s/shelve", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3509,7 +3710,7 @@ func NewUpdateThingByUuidRequestWithBody(server string, uuid UuidParam, contentT
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3519,8 +3720,19 @@ func NewUpdateThingByUuidRequestWithBody(server string, uuid UuidParam, contentT
 	return req, nil
 }
 
-// NewFindDatasetsForThingRequest generates requests for FindDatasetsForThing
-func NewFindDatasetsForThingRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewUnacknowledgeAlertRequest calls the generic UnacknowledgeAlert builder with application/json body
+func NewUnacknowledgeAlertRequest(server string, uuid UuidParam, body UnacknowledgeAlertJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUnacknowledgeAlertRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUnacknowledgeAlertRequestWithBody generates requests for UnacknowledgeAlert with any type of body
+func NewUnacknowledgeAlertRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3535,7 +3747,7 @@ func NewFindDatasetsForThingRequest(server string, uuid UuidParam) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/things/%s/datasets", pathParam0)
+	operationPath := fmt.Sprintf("/v2/alerts/%s/unacknowledge", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3545,16 +3757,29 @@ func NewFindDatasetsForThingRequest(server string, uuid UuidParam) (*http.Reques
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewFindTimeSeriesForThingRequest generates requests for FindTimeSeriesForThing
-func NewFindTimeSeriesForThingRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewUnshelveAlertRequest calls the generic UnshelveAlert builder with application/json body
+func NewUnshelveAlertRequest(server string, uuid UuidParam, body UnshelveAlertJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUnshelveAlertRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUnshelveAlertRequestWithBody generates requests for UnshelveAlert with any type of body
+func NewUnshelveAlertRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3569,7 +3794,7 @@ func NewFindTimeSeriesForThingRequest(server string, uuid UuidParam) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/things/%s/timeseries", pathParam0)
+	operationPath := fmt.Sprintf("/v2/alerts/%s/unshelve", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3579,16 +3804,18 @@ func NewFindTimeSeriesForThingRequest(server string, uuid UuidParam) (*http.Requ
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewFindTimeSeriesRequest generates requests for FindTimeSeries
-func NewFindTimeSeriesRequest(server string, params *FindTimeSeriesParams) (*http.Request, error) {
+// NewFindDatasetsRequest generates requests for FindDatasets
+func NewFindDatasetsRequest(server string, params *FindDatasetsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3596,7 +3823,7 @@ func NewFindTimeSeriesRequest(server string, params *FindTimeSeriesParams) (*htt
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/timeseries")
+	operationPath := fmt.Sprintf("/v2/datasets")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3608,9 +3835,9 @@ func NewFindTimeSeriesRequest(server string, params *FindTimeSeriesParams) (*htt
 
 	queryValues := queryURL.Query()
 
-	if params.Limit != nil {
+	if params.Offset != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -3624,9 +3851,9 @@ func NewFindTimeSeriesRequest(server string, params *FindTimeSeriesParams) (*htt
 
 	}
 
-	if params.Offset != nil {
+	if params.Limit != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -3666,19 +3893,19 @@ func NewFindTimeSeriesRequest(server string, params *FindTimeSeriesParams) (*htt
 	return req, nil
 }
 
-// NewAddTimeSeriesRequest calls the generic AddTimeSeries builder with application/json body
-func NewAddTimeSeriesRequest(server string, body AddTimeSeriesJSONRequestBody) (*http.Request, error) {
+// NewAddDatasetsRequest calls the generic AddDatasets builder with application/json body
+func NewAddDatasetsRequest(server string, body AddDatasetsJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAddTimeSeriesRequestWithBody(server, "application/json", bodyReader)
+	return NewAddDatasetsRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewAddTimeSeriesRequestWithBody generates requests for AddTimeSeries with any type of body
-func NewAddTimeSeriesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewAddDatasetsRequestWithBody generates requests for AddDatasets with any type of body
+func NewAddDatasetsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3686,7 +3913,7 @@ func NewAddTimeSeriesRequestWithBody(server string, contentType string, body io.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/timeseries")
+	operationPath := fmt.Sprintf("/v2/datasets")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3706,8 +3933,8 @@ func NewAddTimeSeriesRequestWithBody(server string, contentType string, body io.
 	return req, nil
 }
 
-// NewDeleteTimeSeriesByUuidRequest generates requests for DeleteTimeSeriesByUuid
-func NewDeleteTimeSeriesByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewDeleteDatasetByUuidRequest generates requests for DeleteDatasetByUuid
+func NewDeleteDatasetByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3722,7 +3949,7 @@ func NewDeleteTimeSeriesByUuidRequest(server string, uuid UuidParam) (*http.Requ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/timeseries/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/datasets/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3740,8 +3967,8 @@ func NewDeleteTimeSeriesByUuidRequest(server string, uuid UuidParam) (*http.Requ
 	return req, nil
 }
 
-// NewFindTimeSeriesByUuidRequest generates requests for FindTimeSeriesByUuid
-func NewFindTimeSeriesByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewFindDatasetByUuidRequest generates requests for FindDatasetByUuid
+func NewFindDatasetByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3756,7 +3983,7 @@ func NewFindTimeSeriesByUuidRequest(server string, uuid UuidParam) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/timeseries/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/datasets/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3774,19 +4001,19 @@ func NewFindTimeSeriesByUuidRequest(server string, uuid UuidParam) (*http.Reques
 	return req, nil
 }
 
-// NewUpdateTimeseriesByUuidRequest calls the generic UpdateTimeseriesByUuid builder with application/json body
-func NewUpdateTimeseriesByUuidRequest(server string, uuid UuidParam, body UpdateTimeseriesByUuidJSONRequestBody) (*http.Request, error) {
+// NewUpdateDatasetByUuidRequest calls the generic UpdateDatasetByUuid builder with application/json body
+func NewUpdateDatasetByUuidRequest(server string, uuid UuidParam, body UpdateDatasetByUuidJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTimeseriesByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+	return NewUpdateDatasetByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
 }
 
-// NewUpdateTimeseriesByUuidRequestWithBody generates requests for UpdateTimeseriesByUuid with any type of body
-func NewUpdateTimeseriesByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateDatasetByUuidRequestWithBody generates requests for UpdateDatasetByUuid with any type of body
+func NewUpdateDatasetByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3801,7 +4028,7 @@ func NewUpdateTimeseriesByUuidRequestWithBody(server string, uuid UuidParam, con
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/timeseries/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/datasets/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3821,8 +4048,8 @@ func NewUpdateTimeseriesByUuidRequestWithBody(server string, uuid UuidParam, con
 	return req, nil
 }
 
-// NewDeleteDataFromTimeSeriesRequest generates requests for DeleteDataFromTimeSeries
-func NewDeleteDataFromTimeSeriesRequest(server string, uuid UuidParam, params *DeleteDataFromTimeSeriesParams) (*http.Request, error) {
+// NewAssembleDatasetPartsByKeyRequest generates requests for AssembleDatasetPartsByKey
+func NewAssembleDatasetPartsByKeyRequest(server string, uuid UuidParam, params *AssembleDatasetPartsByKeyParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3837,7 +4064,7 @@ func NewDeleteDataFromTimeSeriesRequest(server string, uuid UuidParam, params *D
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/timeseries/%s/data", pathParam0)
+	operationPath := fmt.Sprintf("/v2/datasets/%s/assemble", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3849,7 +4076,7 @@ func NewDeleteDataFromTimeSeriesRequest(server string, uuid UuidParam, params *D
 
 	queryValues := queryURL.Query()
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "partNumber", runtime.ParamLocationQuery, params.PartNumber); err != nil {
 		return nil, err
 	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 		return nil, err
@@ -3861,7 +4088,7 @@ func NewDeleteDataFromTimeSeriesRequest(server string, uuid UuidParam, params *D
 		}
 	}
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, params.End); err != nil {
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uploadId", runtime.ParamLocationQuery, params.UploadId); err != nil {
 		return nil, err
 	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 		return nil, err
@@ -3873,50 +4100,27 @@ func NewDeleteDataFromTimeSeriesRequest(server string, uuid UuidParam, params *D
 		}
 	}
 
-	if params.Ge != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ge", runtime.ParamLocationQuery, *params.Ge); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	queryURL.RawQuery = queryValues.Encode()
 
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Le != nil {
+	var headerParam0 string
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "le", runtime.ParamLocationQuery, *params.Le); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
-
-	queryURL.RawQuery = queryValues.Encode()
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Content-MD5", runtime.ParamLocationHeader, params.ContentMD5)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Set("Content-MD5", headerParam0)
+
 	return req, nil
 }
 
-// NewQueryTimeseriesForDataRequest generates requests for QueryTimeseriesForData
-func NewQueryTimeseriesForDataRequest(server string, uuid UuidParam, params *QueryTimeseriesForDataParams) (*http.Request, error) {
+// NewGetDatasetRevisionsDiffRequest generates requests for GetDatasetRevisionsDiff
+func NewGetDatasetRevisionsDiffRequest(server string, uuid UuidParam, params *GetDatasetRevisionsDiffParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3931,7 +4135,7 @@ func NewQueryTimeseriesForDataRequest(server string, uuid UuidParam, params *Que
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/timeseries/%s/data", pathParam0)
+	operationPath := fmt.Sprintf("/v2/datasets/%s/diff", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3943,7 +4147,7 @@ func NewQueryTimeseriesForDataRequest(server string, uuid UuidParam, params *Que
 
 	queryValues := queryURL.Query()
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rev_a", runtime.ParamLocationQuery, params.RevA); err != nil {
 		return nil, err
 	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 		return nil, err
@@ -3955,7 +4159,7 @@ func NewQueryTimeseriesForDataRequest(server string, uuid UuidParam, params *Que
 		}
 	}
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, params.End); err != nil {
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rev_b", runtime.ParamLocationQuery, params.RevB); err != nil {
 		return nil, err
 	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 		return nil, err
@@ -3967,73 +4171,47 @@ func NewQueryTimeseriesForDataRequest(server string, uuid UuidParam, params *Que
 		}
 	}
 
-	if params.Unit != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unit", runtime.ParamLocationQuery, *params.Unit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	queryURL.RawQuery = queryValues.Encode()
 
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Ge != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ge", runtime.ParamLocationQuery, *params.Ge); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-	}
+	return req, nil
+}
 
-	if params.Le != nil {
+// NewGetDatasetDocumentRequest generates requests for GetDatasetDocument
+func NewGetDatasetDocumentRequest(server string, uuid UuidParam, params *GetDatasetDocumentParams) (*http.Request, error) {
+	var err error
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "le", runtime.ParamLocationQuery, *params.Le); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Precision != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "precision", runtime.ParamLocationQuery, *params.Precision); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	operationPath := fmt.Sprintf("/v2/datasets/%s/document", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Aggregate != nil {
+	queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "aggregate", runtime.ParamLocationQuery, *params.Aggregate); err != nil {
+	if params.Pointer != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pointer", runtime.ParamLocationQuery, *params.Pointer); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -4047,9 +4225,9 @@ func NewQueryTimeseriesForDataRequest(server string, uuid UuidParam, params *Que
 
 	}
 
-	if params.Timezone != nil {
+	if params.Format != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -4073,19 +4251,19 @@ func NewQueryTimeseriesForDataRequest(server string, uuid UuidParam, params *Que
 	return req, nil
 }
 
-// NewAddDataToTimeseriesRequest calls the generic AddDataToTimeseries builder with application/json body
-func NewAddDataToTimeseriesRequest(server string, uuid UuidParam, params *AddDataToTimeseriesParams, body AddDataToTimeseriesJSONRequestBody) (*http.Request, error) {
+// NewImportDatasetIntoTimeseriesRequest calls the generic ImportDatasetIntoTimeseries builder with application/json body
+func NewImportDatasetIntoTimeseriesRequest(server string, uuid UuidParam, params *ImportDatasetIntoTimeseriesParams, body ImportDatasetIntoTimeseriesJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAddDataToTimeseriesRequestWithBody(server, uuid, params, "application/json", bodyReader)
+	return NewImportDatasetIntoTimeseriesRequestWithBody(server, uuid, params, "application/json", bodyReader)
 }
 
-// NewAddDataToTimeseriesRequestWithBody generates requests for AddDataToTimeseries with any type of body
-func NewAddDataToTimeseriesRequestWithBody(server string, uuid UuidParam, params *AddDataToTimeseriesParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewImportDatasetIntoTimeseriesRequestWithBody generates requests for ImportDatasetIntoTimeseries with any type of body
+func NewImportDatasetIntoTimeseriesRequestWithBody(server string, uuid UuidParam, params *ImportDatasetIntoTimeseriesParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4100,7 +4278,7 @@ func NewAddDataToTimeseriesRequestWithBody(server string, uuid UuidParam, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/timeseries/%s/data", pathParam0)
+	operationPath := fmt.Sprintf("/v2/datasets/%s/import", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4112,9 +4290,9 @@ func NewAddDataToTimeseriesRequestWithBody(server string, uuid UuidParam, params
 
 	queryValues := queryURL.Query()
 
-	if params.Unit != nil {
+	if params.DryRun != nil {
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unit", runtime.ParamLocationQuery, *params.Unit); err != nil {
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -4140,16 +4318,23 @@ func NewAddDataToTimeseriesRequestWithBody(server string, uuid UuidParam, params
 	return req, nil
 }
 
-// NewFindTsdataByQueryRequest generates requests for FindTsdataByQuery
-func NewFindTsdataByQueryRequest(server string, params *FindTsdataByQueryParams) (*http.Request, error) {
+// NewListDatasetPartsByKeyRequest generates requests for ListDatasetPartsByKey
+func NewListDatasetPartsByKeyRequest(server string, uuid UuidParam, params *ListDatasetPartsByKeyParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/tsquery")
+	operationPath := fmt.Sprintf("/v2/datasets/%s/parts", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4161,7 +4346,7 @@ func NewFindTsdataByQueryRequest(server string, params *FindTsdataByQueryParams)
 
 	queryValues := queryURL.Query()
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uuids", runtime.ParamLocationQuery, params.Uuids); err != nil {
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key", runtime.ParamLocationQuery, params.Key); err != nil {
 		return nil, err
 	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 		return nil, err
@@ -4173,7 +4358,45 @@ func NewFindTsdataByQueryRequest(server string, params *FindTsdataByQueryParams)
 		}
 	}
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadDatasetContentByKeyRequest generates requests for UploadDatasetContentByKey
+func NewUploadDatasetContentByKeyRequest(server string, uuid UuidParam, params *UploadDatasetContentByKeyParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/datasets/%s/parts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "partNumber", runtime.ParamLocationQuery, params.PartNumber); err != nil {
 		return nil, err
 	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 		return nil, err
@@ -4185,7 +4408,7 @@ func NewFindTsdataByQueryRequest(server string, params *FindTsdataByQueryParams)
 		}
 	}
 
-	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, params.End); err != nil {
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uploadId", runtime.ParamLocationQuery, params.UploadId); err != nil {
 		return nil, err
 	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 		return nil, err
@@ -4197,106 +4420,120 @@ func NewFindTsdataByQueryRequest(server string, params *FindTsdataByQueryParams)
 		}
 	}
 
-	if params.Ge != nil {
+	queryURL.RawQuery = queryValues.Encode()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ge", runtime.ParamLocationQuery, *params.Ge); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var headerParam0 string
+
+	headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Content-MD5", runtime.ParamLocationHeader, params.ContentMD5)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-MD5", headerParam0)
+
+	return req, nil
+}
+
+// NewGetRawDatasetByUuidRequest generates requests for GetRawDatasetByUuid
+func NewGetRawDatasetByUuidRequest(server string, uuid UuidParam, params *GetRawDatasetByUuidParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/datasets/%s/raw", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IfNoneMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+		if err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
 
+		req.Header.Set("If-None-Match", headerParam0)
 	}
 
-	if params.Le != nil {
+	if params.IfModifiedSince != nil {
+		var headerParam1 string
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "le", runtime.ParamLocationQuery, *params.Le); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "If-Modified-Since", runtime.ParamLocationHeader, *params.IfModifiedSince)
+		if err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
 
+		req.Header.Set("If-Modified-Since", headerParam1)
 	}
 
-	if params.Precision != nil {
+	if params.Range != nil {
+		var headerParam2 string
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "precision", runtime.ParamLocationQuery, *params.Precision); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		headerParam2, err = runtime.StyleParamWithLocation("simple", false, "Range", runtime.ParamLocationHeader, *params.Range)
+		if err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
 
+		req.Header.Set("Range", headerParam2)
 	}
 
-	if params.Aggregate != nil {
+	if params.IfRange != nil {
+		var headerParam3 string
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "aggregate", runtime.ParamLocationQuery, *params.Aggregate); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		headerParam3, err = runtime.StyleParamWithLocation("simple", false, "If-Range", runtime.ParamLocationHeader, *params.IfRange)
+		if err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
 
+		req.Header.Set("If-Range", headerParam3)
 	}
 
-	if params.Timezone != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	return req, nil
+}
 
-	}
+// NewGetDatasetRevisionsRequest generates requests for GetDatasetRevisions
+func NewGetDatasetRevisionsRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
 
-	queryURL.RawQuery = queryValues.Encode()
+	var pathParam0 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewFindUsersRequest generates requests for FindUsers
-func NewFindUsersRequest(server string, params *FindUsersParams) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users")
+	operationPath := fmt.Sprintf("/v2/datasets/%s/revisions", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4306,71 +4543,38 @@ func NewFindUsersRequest(server string, params *FindUsersParams) (*http.Request,
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
-
-	if params.Limit != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Offset != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	return req, nil
+}
 
-	}
+// NewGetRawDatasetRevisionRequest generates requests for GetRawDatasetRevision
+func NewGetRawDatasetRevisionRequest(server string, uuid UuidParam, revisionId int) (*http.Request, error) {
+	var err error
 
-	queryURL.RawQuery = queryValues.Encode()
+	var pathParam0 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	var pathParam1 string
 
-// NewAddUserRequest calls the generic AddUser builder with application/json body
-func NewAddUserRequest(server string, body AddUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "revision_id", runtime.ParamLocationPath, revisionId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddUserRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewAddUserRequestWithBody generates requests for AddUser with any type of body
-func NewAddUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users")
+	operationPath := fmt.Sprintf("/v2/datasets/%s/revisions/%s/raw", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4380,26 +4584,38 @@ func NewAddUserRequestWithBody(server string, contentType string, body io.Reader
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewWhoamiRequest generates requests for Whoami
-func NewWhoamiRequest(server string) (*http.Request, error) {
+// NewRollbackDatasetToRevisionRequest generates requests for RollbackDatasetToRevision
+func NewRollbackDatasetToRevisionRequest(server string, uuid UuidParam, revisionId int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "revision_id", runtime.ParamLocationPath, revisionId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/me")
+	operationPath := fmt.Sprintf("/v2/datasets/%s/revisions/%s/rollback", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4409,7 +4625,7 @@ func NewWhoamiRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4417,8 +4633,8 @@ func NewWhoamiRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewDeleteUserByUuidRequest generates requests for DeleteUserByUuid
-func NewDeleteUserByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewDeleteDatasetSchemaRequest generates requests for DeleteDatasetSchema
+func NewDeleteDatasetSchemaRequest(server string, uuid UuidParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4433,7 +4649,7 @@ func NewDeleteUserByUuidRequest(server string, uuid UuidParam) (*http.Request, e
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/datasets/%s/schema", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4451,8 +4667,8 @@ func NewDeleteUserByUuidRequest(server string, uuid UuidParam) (*http.Request, e
 	return req, nil
 }
 
-// NewFindUserByUuidRequest generates requests for FindUserByUuid
-func NewFindUserByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewGetDatasetSchemaRequest generates requests for GetDatasetSchema
+func NewGetDatasetSchemaRequest(server string, uuid UuidParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4467,7 +4683,7 @@ func NewFindUserByUuidRequest(server string, uuid UuidParam) (*http.Request, err
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/datasets/%s/schema", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4485,19 +4701,19 @@ func NewFindUserByUuidRequest(server string, uuid UuidParam) (*http.Request, err
 	return req, nil
 }
 
-// NewUpdateUserByUuidRequest calls the generic UpdateUserByUuid builder with application/json body
-func NewUpdateUserByUuidRequest(server string, uuid UuidParam, body UpdateUserByUuidJSONRequestBody) (*http.Request, error) {
+// NewSetDatasetSchemaRequest calls the generic SetDatasetSchema builder with application/json body
+func NewSetDatasetSchemaRequest(server string, uuid UuidParam, body SetDatasetSchemaJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateUserByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+	return NewSetDatasetSchemaRequestWithBody(server, uuid, "application/json", bodyReader)
 }
 
-// NewUpdateUserByUuidRequestWithBody generates requests for UpdateUserByUuid with any type of body
-func NewUpdateUserByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+// NewSetDatasetSchemaRequestWithBody generates requests for SetDatasetSchema with any type of body
+func NewSetDatasetSchemaRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4512,7 +4728,7 @@ func NewUpdateUserByUuidRequestWithBody(server string, uuid UuidParam, contentTy
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v2/datasets/%s/schema", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4532,8 +4748,8 @@ func NewUpdateUserByUuidRequestWithBody(server string, uuid UuidParam, contentTy
 	return req, nil
 }
 
-// NewFindPoliciesForUserRequest generates requests for FindPoliciesForUser
-func NewFindPoliciesForUserRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewDeleteDatasetUploadByKeyRequest generates requests for DeleteDatasetUploadByKey
+func NewDeleteDatasetUploadByKeyRequest(server string, uuid UuidParam, params *DeleteDatasetUploadByKeyParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4548,7 +4764,7 @@ func NewFindPoliciesForUserRequest(server string, uuid UuidParam) (*http.Request
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/%s/policies", pathParam0)
+	operationPath := fmt.Sprintf("/v2/datasets/%s/uploads", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4558,27 +4774,32 @@ func NewFindPoliciesForUserRequest(server string, uuid UuidParam) (*http.Request
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key", runtime.ParamLocationQuery, params.Key); err != nil {
 		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
 
-	return req, nil
-}
+	queryURL.RawQuery = queryValues.Encode()
 
-// NewSetRequestRateForUserRequest calls the generic SetRequestRateForUser builder with application/json body
-func NewSetRequestRateForUserRequest(server string, uuid UuidParam, body SetRequestRateForUserJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSetRequestRateForUserRequestWithBody(server, uuid, "application/json", bodyReader)
+
+	return req, nil
 }
 
-// NewSetRequestRateForUserRequestWithBody generates requests for SetRequestRateForUser with any type of body
-func NewSetRequestRateForUserRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+// NewInitializeDatasetUploadByUuidRequest generates requests for InitializeDatasetUploadByUuid
+func NewInitializeDatasetUploadByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4593,7 +4814,7 @@ func NewSetRequestRateForUserRequestWithBody(server string, uuid UuidParam, cont
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/%s/rate", pathParam0)
+	operationPath := fmt.Sprintf("/v2/datasets/%s/uploads", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4603,33 +4824,24 @@ func NewSetRequestRateForUserRequestWithBody(server string, uuid UuidParam, cont
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewFindTokensForUserRequest generates requests for FindTokensForUser
-func NewFindTokensForUserRequest(server string, uuid UuidParam) (*http.Request, error) {
+// NewFindGroupsRequest generates requests for FindGroups
+func NewFindGroupsRequest(server string, params *FindGroupsParams) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/%s/tokens", pathParam0)
+	operationPath := fmt.Sprintf("/v2/groups")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4639,6 +4851,42 @@ func NewFindTokensForUserRequest(server string, uuid UuidParam) (*http.Request,
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -4647,34 +4895,27 @@ func NewFindTokensForUserRequest(server string, uuid UuidParam) (*http.Request,
 	return req, nil
 }
 
-// NewAddNewTokenToUserRequest calls the generic AddNewTokenToUser builder with application/json body
-func NewAddNewTokenToUserRequest(server string, uuid UuidParam, body AddNewTokenToUserJSONRequestBody) (*http.Request, error) {
+// NewAddGroupRequest calls the generic AddGroup builder with application/json body
+func NewAddGroupRequest(server string, body AddGroupJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAddNewTokenToUserRequestWithBody(server, uuid, "application/json", bodyReader)
+	return NewAddGroupRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewAddNewTokenToUserRequestWithBody generates requests for AddNewTokenToUser with any type of body
-func NewAddNewTokenToUserRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+// NewAddGroupRequestWithBody generates requests for AddGroup with any type of body
+func NewAddGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/%s/tokens", pathParam0)
+	operationPath := fmt.Sprintf("/v2/groups")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4694,8 +4935,8 @@ func NewAddNewTokenToUserRequestWithBody(server string, uuid UuidParam, contentT
 	return req, nil
 }
 
-// NewDeleteTokenForUserRequest generates requests for DeleteTokenForUser
-func NewDeleteTokenForUserRequest(server string, uuid UuidParam, tokenUuid string) (*http.Request, error) {
+// NewDeleteGroupByUuidRequest generates requests for DeleteGroupByUuid
+func NewDeleteGroupByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4705,19 +4946,12 @@ func NewDeleteTokenForUserRequest(server string, uuid UuidParam, tokenUuid strin
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "token_uuid", runtime.ParamLocationPath, tokenUuid)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v2/users/%s/tokens/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/v2/groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4735,2482 +4969,12910 @@ func NewDeleteTokenForUserRequest(server string, uuid UuidParam, tokenUuid strin
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewFindGroupByUuidRequest generates requests for FindGroupByUuid
+func NewFindGroupByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/v2/groups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
+
+	return req, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// FindAlerts request
-	FindAlertsWithResponse(ctx context.Context, params *FindAlertsParams, reqEditors ...RequestEditorFn) (*FindAlertsResponse, error)
+// NewUpdateGroupByUuidRequest calls the generic UpdateGroupByUuid builder with application/json body
+func NewUpdateGroupByUuidRequest(server string, uuid UuidParam, body UpdateGroupByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateGroupByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
 
-	// CreateAlert request with any body
-	CreateAlertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error)
+// NewUpdateGroupByUuidRequestWithBody generates requests for UpdateGroupByUuid with any type of body
+func NewUpdateGroupByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	CreateAlertWithResponse(ctx context.Context, body CreateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error)
+	var pathParam0 string
 
-	// DeleteAlertByUuid request
-	DeleteAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteAlertByUuidResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
 
-	// FindAlertByUuid request
-	FindAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindAlertByUuidResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateAlertByUuid request with any body
-	UpdateAlertByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertByUuidResponse, error)
+	operationPath := fmt.Sprintf("/v2/groups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	UpdateAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateAlertByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertByUuidResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// FindDatasets request
-	FindDatasetsWithResponse(ctx context.Context, params *FindDatasetsParams, reqEditors ...RequestEditorFn) (*FindDatasetsResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// AddDatasets request with any body
-	AddDatasetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDatasetsResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	AddDatasetsWithResponse(ctx context.Context, body AddDatasetsJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDatasetsResponse, error)
+	return req, nil
+}
 
-	// DeleteDatasetByUuid request
-	DeleteDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteDatasetByUuidResponse, error)
+// NewFindPoliciesForGroupRequest generates requests for FindPoliciesForGroup
+func NewFindPoliciesForGroupRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
 
-	// FindDatasetByUuid request
-	FindDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindDatasetByUuidResponse, error)
+	var pathParam0 string
 
-	// UpdateDatasetByUuid request with any body
-	UpdateDatasetByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatasetByUuidResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateDatasetByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatasetByUuidResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AssembleDatasetPartsByKey request
-	AssembleDatasetPartsByKeyWithResponse(ctx context.Context, uuid UuidParam, params *AssembleDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*AssembleDatasetPartsByKeyResponse, error)
+	operationPath := fmt.Sprintf("/v2/groups/%s/policies", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListDatasetPartsByKey request
-	ListDatasetPartsByKeyWithResponse(ctx context.Context, uuid UuidParam, params *ListDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*ListDatasetPartsByKeyResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UploadDatasetContentByKey request
-	UploadDatasetContentByKeyWithResponse(ctx context.Context, uuid UuidParam, params *UploadDatasetContentByKeyParams, reqEditors ...RequestEditorFn) (*UploadDatasetContentByKeyResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetRawDatasetByUuid request
-	GetRawDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, params *GetRawDatasetByUuidParams, reqEditors ...RequestEditorFn) (*GetRawDatasetByUuidResponse, error)
+	return req, nil
+}
 
-	// DeleteDatasetUploadByKey request
-	DeleteDatasetUploadByKeyWithResponse(ctx context.Context, uuid UuidParam, params *DeleteDatasetUploadByKeyParams, reqEditors ...RequestEditorFn) (*DeleteDatasetUploadByKeyResponse, error)
+// NewFindIncidentsRequest generates requests for FindIncidents
+func NewFindIncidentsRequest(server string, params *FindIncidentsParams) (*http.Request, error) {
+	var err error
 
-	// InitializeDatasetUploadByUuid request
-	InitializeDatasetUploadByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*InitializeDatasetUploadByUuidResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// FindGroups request
-	FindGroupsWithResponse(ctx context.Context, params *FindGroupsParams, reqEditors ...RequestEditorFn) (*FindGroupsResponse, error)
+	operationPath := fmt.Sprintf("/v2/incidents")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AddGroup request with any body
-	AddGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddGroupResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	AddGroupWithResponse(ctx context.Context, body AddGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AddGroupResponse, error)
+	queryValues := queryURL.Query()
 
-	// DeleteGroupByUuid request
-	DeleteGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteGroupByUuidResponse, error)
+	if params.Limit != nil {
 
-	// FindGroupByUuid request
-	FindGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindGroupByUuidResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// UpdateGroupByUuid request with any body
-	UpdateGroupByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGroupByUuidResponse, error)
+	}
 
-	UpdateGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateGroupByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGroupByUuidResponse, error)
+	if params.Offset != nil {
 
-	// FindPoliciesForGroup request
-	FindPoliciesForGroupWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPoliciesForGroupResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// FindPolicies request
-	FindPoliciesWithResponse(ctx context.Context, params *FindPoliciesParams, reqEditors ...RequestEditorFn) (*FindPoliciesResponse, error)
+	}
 
-	// AddPolicy request with any body
-	AddPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPolicyResponse, error)
+	if params.Status != nil {
 
-	AddPolicyWithResponse(ctx context.Context, body AddPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPolicyResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// DeletePolicyByUuid request
-	DeletePolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeletePolicyByUuidResponse, error)
+	}
 
-	// FindPolicyByUuid request
-	FindPolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPolicyByUuidResponse, error)
+	if params.Group != nil {
 
-	// UpdatePolicyByUuid request with any body
-	UpdatePolicyByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePolicyByUuidResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group", runtime.ParamLocationQuery, *params.Group); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	UpdatePolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdatePolicyByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePolicyByUuidResponse, error)
+	}
 
-	// FindPrograms request
-	FindProgramsWithResponse(ctx context.Context, params *FindProgramsParams, reqEditors ...RequestEditorFn) (*FindProgramsResponse, error)
+	queryURL.RawQuery = queryValues.Encode()
 
-	// AddProgram request with any body
-	AddProgramWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProgramResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	AddProgramWithResponse(ctx context.Context, body AddProgramJSONRequestBody, reqEditors ...RequestEditorFn) (*AddProgramResponse, error)
+	return req, nil
+}
 
-	// DeleteProgramByUuid request
-	DeleteProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteProgramByUuidResponse, error)
+// NewFindIncidentByUuidRequest generates requests for FindIncidentByUuid
+func NewFindIncidentByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
 
-	// FindProgramByUuid request
-	FindProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindProgramByUuidResponse, error)
+	var pathParam0 string
 
-	// UpdateProgramByUuid request with any body
-	UpdateProgramByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProgramByUuidResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateProgramByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProgramByUuidResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetCodeFromProgram request
-	GetCodeFromProgramWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetCodeFromProgramResponse, error)
+	operationPath := fmt.Sprintf("/v2/incidents/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// AddProgramCodeRevision request with any body
-	AddProgramCodeRevisionWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProgramCodeRevisionResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetProgramCodeRevisionsDiff request
-	GetProgramCodeRevisionsDiffWithResponse(ctx context.Context, uuid UuidParam, params *GetProgramCodeRevisionsDiffParams, reqEditors ...RequestEditorFn) (*GetProgramCodeRevisionsDiffResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetProgramCodeRevisions request
-	GetProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetProgramCodeRevisionsResponse, error)
+	return req, nil
+}
 
-	// DeleteProgramCodeRevisions request
-	DeleteProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*DeleteProgramCodeRevisionsResponse, error)
+// NewAcknowledgeIncidentRequest calls the generic AcknowledgeIncident builder with application/json body
+func NewAcknowledgeIncidentRequest(server string, uuid UuidParam, body AcknowledgeIncidentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAcknowledgeIncidentRequestWithBody(server, uuid, "application/json", bodyReader)
+}
 
-	// SignProgramCodeRevisions request
-	SignProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*SignProgramCodeRevisionsResponse, error)
+// NewAcknowledgeIncidentRequestWithBody generates requests for AcknowledgeIncident with any type of body
+func NewAcknowledgeIncidentRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// ExecuteProgramWebhook request
-	ExecuteProgramWebhookWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*ExecuteProgramWebhookResponse, error)
+	var pathParam0 string
 
-	// FindThings request
-	FindThingsWithResponse(ctx context.Context, params *FindThingsParams, reqEditors ...RequestEditorFn) (*FindThingsResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
 
-	// AddThing request with any body
-	AddThingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddThingResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	AddThingWithResponse(ctx context.Context, body AddThingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddThingResponse, error)
+	operationPath := fmt.Sprintf("/v2/incidents/%s/acknowledge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteThingByUuid request
-	DeleteThingByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteThingByUuidResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// FindThingByUuid request
-	FindThingByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindThingByUuidResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateThingByUuid request with any body
-	UpdateThingByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateThingByUuidResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	UpdateThingByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateThingByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateThingByUuidResponse, error)
+	return req, nil
+}
 
-	// FindDatasetsForThing request
-	FindDatasetsForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindDatasetsForThingResponse, error)
+// NewFindIncidentAlertsRequest generates requests for FindIncidentAlerts
+func NewFindIncidentAlertsRequest(server string, uuid UuidParam, params *FindIncidentAlertsParams) (*http.Request, error) {
+	var err error
 
-	// FindTimeSeriesForThing request
-	FindTimeSeriesForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTimeSeriesForThingResponse, error)
+	var pathParam0 string
 
-	// FindTimeSeries request
-	FindTimeSeriesWithResponse(ctx context.Context, params *FindTimeSeriesParams, reqEditors ...RequestEditorFn) (*FindTimeSeriesResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
 
-	// AddTimeSeries request with any body
-	AddTimeSeriesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTimeSeriesResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	AddTimeSeriesWithResponse(ctx context.Context, body AddTimeSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTimeSeriesResponse, error)
+	operationPath := fmt.Sprintf("/v2/incidents/%s/alerts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteTimeSeriesByUuid request
-	DeleteTimeSeriesByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteTimeSeriesByUuidResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// FindTimeSeriesByUuid request
-	FindTimeSeriesByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTimeSeriesByUuidResponse, error)
+	queryValues := queryURL.Query()
 
-	// UpdateTimeseriesByUuid request with any body
-	UpdateTimeseriesByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error)
+	if params.Limit != nil {
 
-	UpdateTimeseriesByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateTimeseriesByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// DeleteDataFromTimeSeries request
-	DeleteDataFromTimeSeriesWithResponse(ctx context.Context, uuid UuidParam, params *DeleteDataFromTimeSeriesParams, reqEditors ...RequestEditorFn) (*DeleteDataFromTimeSeriesResponse, error)
+	}
 
-	// QueryTimeseriesForData request
-	QueryTimeseriesForDataWithResponse(ctx context.Context, uuid UuidParam, params *QueryTimeseriesForDataParams, reqEditors ...RequestEditorFn) (*QueryTimeseriesForDataResponse, error)
+	if params.Offset != nil {
 
-	// AddDataToTimeseries request with any body
-	AddDataToTimeseriesWithBodyWithResponse(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDataToTimeseriesResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	AddDataToTimeseriesWithResponse(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, body AddDataToTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDataToTimeseriesResponse, error)
+	}
 
-	// FindTsdataByQuery request
-	FindTsdataByQueryWithResponse(ctx context.Context, params *FindTsdataByQueryParams, reqEditors ...RequestEditorFn) (*FindTsdataByQueryResponse, error)
+	queryURL.RawQuery = queryValues.Encode()
 
-	// FindUsers request
-	FindUsersWithResponse(ctx context.Context, params *FindUsersParams, reqEditors ...RequestEditorFn) (*FindUsersResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// AddUser request with any body
-	AddUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddUserResponse, error)
+	return req, nil
+}
 
-	AddUserWithResponse(ctx context.Context, body AddUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddUserResponse, error)
+// NewCloseIncidentRequest calls the generic CloseIncident builder with application/json body
+func NewCloseIncidentRequest(server string, uuid UuidParam, body CloseIncidentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCloseIncidentRequestWithBody(server, uuid, "application/json", bodyReader)
+}
 
-	// Whoami request
-	WhoamiWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WhoamiResponse, error)
+// NewCloseIncidentRequestWithBody generates requests for CloseIncident with any type of body
+func NewCloseIncidentRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// DeleteUserByUuid request
-	DeleteUserByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteUserByUuidResponse, error)
+	var pathParam0 string
 
-	// FindUserByUuid request
-	FindUserByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindUserByUuidResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateUserByUuid request with any body
-	UpdateUserByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserByUuidResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateUserByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateUserByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserByUuidResponse, error)
+	operationPath := fmt.Sprintf("/v2/incidents/%s/close", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// FindPoliciesForUser request
-	FindPoliciesForUserWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPoliciesForUserResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// SetRequestRateForUser request with any body
-	SetRequestRateForUserWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetRequestRateForUserResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	SetRequestRateForUserWithResponse(ctx context.Context, uuid UuidParam, body SetRequestRateForUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SetRequestRateForUserResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// FindTokensForUser request
-	FindTokensForUserWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTokensForUserResponse, error)
+	return req, nil
+}
 
-	// AddNewTokenToUser request with any body
-	AddNewTokenToUserWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddNewTokenToUserResponse, error)
+// NewFindIncidentHistoryRequest generates requests for FindIncidentHistory
+func NewFindIncidentHistoryRequest(server string, uuid UuidParam, params *FindIncidentHistoryParams) (*http.Request, error) {
+	var err error
 
-	AddNewTokenToUserWithResponse(ctx context.Context, uuid UuidParam, body AddNewTokenToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddNewTokenToUserResponse, error)
+	var pathParam0 string
 
-	// DeleteTokenForUser request
-	DeleteTokenForUserWithResponse(ctx context.Context, uuid UuidParam, tokenUuid string, reqEditors ...RequestEditorFn) (*DeleteTokenForUserResponse, error)
-}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
 
-type FindAlertsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Alert
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r FindAlertsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/v2/incidents/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindAlertsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateAlertResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *NewAlertReply
-}
+	queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r CreateAlertResponse) Status() string {
-	if r.HTTPResponse != nil {
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindProgramJobsRequest generates requests for FindProgramJobs
+func NewFindProgramJobsRequest(server string, params *FindProgramJobsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/jobs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Status != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Program != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "program", runtime.ParamLocationQuery, *params.Program); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindProgramJobByIdRequest generates requests for FindProgramJobById
+func NewFindProgramJobByIdRequest(server string, jobId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRequeueProgramJobRequest generates requests for RequeueProgramJob
+func NewRequeueProgramJobRequest(server string, jobId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/jobs/%s/requeue", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindProgramJobRunsRequest generates requests for FindProgramJobRuns
+func NewFindProgramJobRunsRequest(server string, jobId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "job_id", runtime.ParamLocationPath, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/jobs/%s/runs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindMonitorsRequest generates requests for FindMonitors
+func NewFindMonitorsRequest(server string, params *FindMonitorsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/monitors")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Timeseries != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timeseries", runtime.ParamLocationQuery, *params.Timeseries); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddMonitorRequest calls the generic AddMonitor builder with application/json body
+func NewAddMonitorRequest(server string, body AddMonitorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddMonitorRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddMonitorRequestWithBody generates requests for AddMonitor with any type of body
+func NewAddMonitorRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/monitors")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteMonitorByUuidRequest generates requests for DeleteMonitorByUuid
+func NewDeleteMonitorByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/monitors/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindMonitorByUuidRequest generates requests for FindMonitorByUuid
+func NewFindMonitorByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/monitors/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateMonitorByUuidRequest calls the generic UpdateMonitorByUuid builder with application/json body
+func NewUpdateMonitorByUuidRequest(server string, uuid UuidParam, body UpdateMonitorByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMonitorByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateMonitorByUuidRequestWithBody generates requests for UpdateMonitorByUuid with any type of body
+func NewUpdateMonitorByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/monitors/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindNotificationChannelsRequest generates requests for FindNotificationChannels
+func NewFindNotificationChannelsRequest(server string, params *FindNotificationChannelsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/notifications/channels")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddNotificationChannelRequest calls the generic AddNotificationChannel builder with application/json body
+func NewAddNotificationChannelRequest(server string, body AddNotificationChannelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddNotificationChannelRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddNotificationChannelRequestWithBody generates requests for AddNotificationChannel with any type of body
+func NewAddNotificationChannelRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/notifications/channels")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteNotificationChannelByUuidRequest generates requests for DeleteNotificationChannelByUuid
+func NewDeleteNotificationChannelByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/notifications/channels/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindNotificationChannelByUuidRequest generates requests for FindNotificationChannelByUuid
+func NewFindNotificationChannelByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/notifications/channels/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateNotificationChannelByUuidRequest calls the generic UpdateNotificationChannelByUuid builder with application/json body
+func NewUpdateNotificationChannelByUuidRequest(server string, uuid UuidParam, body UpdateNotificationChannelByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateNotificationChannelByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateNotificationChannelByUuidRequestWithBody generates requests for UpdateNotificationChannelByUuid with any type of body
+func NewUpdateNotificationChannelByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/notifications/channels/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindNotificationDeliveriesRequest generates requests for FindNotificationDeliveries
+func NewFindNotificationDeliveriesRequest(server string, params *FindNotificationDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/notifications/deliveries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Channel != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "channel", runtime.ParamLocationQuery, *params.Channel); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Alert != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "alert", runtime.ParamLocationQuery, *params.Alert); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Status != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Incident != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "incident", runtime.ParamLocationQuery, *params.Incident); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindNotificationRulesRequest generates requests for FindNotificationRules
+func NewFindNotificationRulesRequest(server string, params *FindNotificationRulesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/notifications/rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddNotificationRuleRequest calls the generic AddNotificationRule builder with application/json body
+func NewAddNotificationRuleRequest(server string, body AddNotificationRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddNotificationRuleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddNotificationRuleRequestWithBody generates requests for AddNotificationRule with any type of body
+func NewAddNotificationRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/notifications/rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteNotificationRuleByUuidRequest generates requests for DeleteNotificationRuleByUuid
+func NewDeleteNotificationRuleByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/notifications/rules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindNotificationRuleByUuidRequest generates requests for FindNotificationRuleByUuid
+func NewFindNotificationRuleByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/notifications/rules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateNotificationRuleByUuidRequest calls the generic UpdateNotificationRuleByUuid builder with application/json body
+func NewUpdateNotificationRuleByUuidRequest(server string, uuid UuidParam, body UpdateNotificationRuleByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateNotificationRuleByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateNotificationRuleByUuidRequestWithBody generates requests for UpdateNotificationRuleByUuid with any type of body
+func NewUpdateNotificationRuleByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/notifications/rules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindPoliciesRequest generates requests for FindPolicies
+func NewFindPoliciesRequest(server string, params *FindPoliciesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.GroupUuids != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_uuids", runtime.ParamLocationQuery, *params.GroupUuids); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddPolicyRequest calls the generic AddPolicy builder with application/json body
+func NewAddPolicyRequest(server string, body AddPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddPolicyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddPolicyRequestWithBody generates requests for AddPolicy with any type of body
+func NewAddPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePolicyByUuidRequest generates requests for DeletePolicyByUuid
+func NewDeletePolicyByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindPolicyByUuidRequest generates requests for FindPolicyByUuid
+func NewFindPolicyByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePolicyByUuidRequest calls the generic UpdatePolicyByUuid builder with application/json body
+func NewUpdatePolicyByUuidRequest(server string, uuid UuidParam, body UpdatePolicyByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePolicyByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdatePolicyByUuidRequestWithBody generates requests for UpdatePolicyByUuid with any type of body
+func NewUpdatePolicyByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindProgramsRequest generates requests for FindPrograms
+func NewFindProgramsRequest(server string, params *FindProgramsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Tags != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddProgramRequest calls the generic AddProgram builder with application/json body
+func NewAddProgramRequest(server string, body AddProgramJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddProgramRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddProgramRequestWithBody generates requests for AddProgram with any type of body
+func NewAddProgramRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteProgramByUuidRequest generates requests for DeleteProgramByUuid
+func NewDeleteProgramByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindProgramByUuidRequest generates requests for FindProgramByUuid
+func NewFindProgramByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProgramByUuidRequest calls the generic UpdateProgramByUuid builder with application/json body
+func NewUpdateProgramByUuidRequest(server string, uuid UuidParam, body UpdateProgramByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProgramByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateProgramByUuidRequestWithBody generates requests for UpdateProgramByUuid with any type of body
+func NewUpdateProgramByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCodeFromProgramRequest generates requests for GetCodeFromProgram
+func NewGetCodeFromProgramRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs/%s/code", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddProgramCodeRevisionRequestWithBody generates requests for AddProgramCodeRevision with any type of body
+func NewAddProgramCodeRevisionRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs/%s/code", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetProgramCodeRevisionsDiffRequest generates requests for GetProgramCodeRevisionsDiff
+func NewGetProgramCodeRevisionsDiffRequest(server string, uuid UuidParam, params *GetProgramCodeRevisionsDiffParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs/%s/diff", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rev_a", runtime.ParamLocationQuery, params.RevA); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rev_b", runtime.ParamLocationQuery, params.RevB); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetProgramCodeRevisionsRequest generates requests for GetProgramCodeRevisions
+func NewGetProgramCodeRevisionsRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs/%s/revisions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteProgramCodeRevisionsRequest generates requests for DeleteProgramCodeRevisions
+func NewDeleteProgramCodeRevisionsRequest(server string, uuid UuidParam, revisionId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "revision_id", runtime.ParamLocationPath, revisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs/%s/revisions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSignProgramCodeRevisionsRequest generates requests for SignProgramCodeRevisions
+func NewSignProgramCodeRevisionsRequest(server string, uuid UuidParam, revisionId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "revision_id", runtime.ParamLocationPath, revisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs/%s/revisions/%s/sign", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRunProgramRequest calls the generic RunProgram builder with application/json body
+func NewRunProgramRequest(server string, uuid UuidParam, body RunProgramJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRunProgramRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewRunProgramRequestWithBody generates requests for RunProgram with any type of body
+func NewRunProgramRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs/%s/run", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindProgramRunsRequest generates requests for FindProgramRuns
+func NewFindProgramRunsRequest(server string, uuid UuidParam, params *FindProgramRunsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs/%s/runs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Outcome != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "outcome", runtime.ParamLocationQuery, *params.Outcome); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Start != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.End != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindProgramRunByIdRequest generates requests for FindProgramRunById
+func NewFindProgramRunByIdRequest(server string, uuid UuidParam, runId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "run_id", runtime.ParamLocationPath, runId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs/%s/runs/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExecuteProgramWebhookRequest generates requests for ExecuteProgramWebhook
+func NewExecuteProgramWebhookRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/programs/%s/webhook", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindSilencesRequest generates requests for FindSilences
+func NewFindSilencesRequest(server string, params *FindSilencesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/silences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Expired != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expired", runtime.ParamLocationQuery, *params.Expired); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddSilenceRequest calls the generic AddSilence builder with application/json body
+func NewAddSilenceRequest(server string, body AddSilenceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddSilenceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddSilenceRequestWithBody generates requests for AddSilence with any type of body
+func NewAddSilenceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/silences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSilenceByUuidRequest generates requests for DeleteSilenceByUuid
+func NewDeleteSilenceByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/silences/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindSilenceByUuidRequest generates requests for FindSilenceByUuid
+func NewFindSilenceByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/silences/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSilenceByUuidRequest calls the generic UpdateSilenceByUuid builder with application/json body
+func NewUpdateSilenceByUuidRequest(server string, uuid UuidParam, body UpdateSilenceByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSilenceByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateSilenceByUuidRequestWithBody generates requests for UpdateSilenceByUuid with any type of body
+func NewUpdateSilenceByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/silences/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindThingTemplatesRequest generates requests for FindThingTemplates
+func NewFindThingTemplatesRequest(server string, params *FindThingTemplatesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddThingTemplateRequest calls the generic AddThingTemplate builder with application/json body
+func NewAddThingTemplateRequest(server string, body AddThingTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddThingTemplateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddThingTemplateRequestWithBody generates requests for AddThingTemplate with any type of body
+func NewAddThingTemplateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteThingTemplateByUuidRequest generates requests for DeleteThingTemplateByUuid
+func NewDeleteThingTemplateByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindThingTemplateByUuidRequest generates requests for FindThingTemplateByUuid
+func NewFindThingTemplateByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateThingTemplateByUuidRequest calls the generic UpdateThingTemplateByUuid builder with application/json body
+func NewUpdateThingTemplateByUuidRequest(server string, uuid UuidParam, body UpdateThingTemplateByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateThingTemplateByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateThingTemplateByUuidRequestWithBody generates requests for UpdateThingTemplateByUuid with any type of body
+func NewUpdateThingTemplateByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApplyThingTemplateRequest generates requests for ApplyThingTemplate
+func NewApplyThingTemplateRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/templates/%s/apply", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindThingsRequest generates requests for FindThings
+func NewFindThingsRequest(server string, params *FindThingsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Tags != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Attributes != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "attributes", runtime.ParamLocationQuery, *params.Attributes); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Bbox != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "bbox", runtime.ParamLocationQuery, *params.Bbox); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Near != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", false, "near", runtime.ParamLocationQuery, *params.Near); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Radius != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "radius", runtime.ParamLocationQuery, *params.Radius); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Format != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddThingRequest calls the generic AddThing builder with application/json body
+func NewAddThingRequest(server string, body AddThingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddThingRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddThingRequestWithBody generates requests for AddThing with any type of body
+func NewAddThingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteThingByUuidRequest generates requests for DeleteThingByUuid
+func NewDeleteThingByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindThingByUuidRequest generates requests for FindThingByUuid
+func NewFindThingByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateThingByUuidRequest calls the generic UpdateThingByUuid builder with application/json body
+func NewUpdateThingByUuidRequest(server string, uuid UuidParam, body UpdateThingByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateThingByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateThingByUuidRequestWithBody generates requests for UpdateThingByUuid with any type of body
+func NewUpdateThingByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindAncestorsForThingRequest generates requests for FindAncestorsForThing
+func NewFindAncestorsForThingRequest(server string, uuid UuidParam, params *FindAncestorsForThingParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things/%s/ancestors", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.MaxDepth != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_depth", runtime.ParamLocationQuery, *params.MaxDepth); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindChildrenForThingRequest generates requests for FindChildrenForThing
+func NewFindChildrenForThingRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things/%s/children", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddChildToThingRequest calls the generic AddChildToThing builder with application/json body
+func NewAddChildToThingRequest(server string, uuid UuidParam, body AddChildToThingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddChildToThingRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewAddChildToThingRequestWithBody generates requests for AddChildToThing with any type of body
+func NewAddChildToThingRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things/%s/children", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveChildFromThingRequest generates requests for RemoveChildFromThing
+func NewRemoveChildFromThingRequest(server string, uuid UuidParam, childUuid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "child_uuid", runtime.ParamLocationPath, childUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things/%s/children/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindDatasetsForThingRequest generates requests for FindDatasetsForThing
+func NewFindDatasetsForThingRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things/%s/datasets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindDescendantsForThingRequest generates requests for FindDescendantsForThing
+func NewFindDescendantsForThingRequest(server string, uuid UuidParam, params *FindDescendantsForThingParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things/%s/descendants", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.MaxDepth != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_depth", runtime.ParamLocationQuery, *params.MaxDepth); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindStateHistoryForThingRequest generates requests for FindStateHistoryForThing
+func NewFindStateHistoryForThingRequest(server string, uuid UuidParam, params *FindStateHistoryForThingParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindParentsForThingRequest generates requests for FindParentsForThing
+func NewFindParentsForThingRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things/%s/parents", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindSubtreeForThingRequest generates requests for FindSubtreeForThing
+func NewFindSubtreeForThingRequest(server string, uuid UuidParam, params *FindSubtreeForThingParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things/%s/subtree", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.MaxDepth != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_depth", runtime.ParamLocationQuery, *params.MaxDepth); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindTimeSeriesForThingRequest generates requests for FindTimeSeriesForThing
+func NewFindTimeSeriesForThingRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/things/%s/timeseries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteThingTypeSchemaRequest generates requests for DeleteThingTypeSchema
+func NewDeleteThingTypeSchemaRequest(server string, params *DeleteThingTypeSchemaParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/thingschemas")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, params.Type); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindThingTypeSchemasRequest generates requests for FindThingTypeSchemas
+func NewFindThingTypeSchemasRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/thingschemas")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetThingTypeSchemaRequest calls the generic SetThingTypeSchema builder with application/json body
+func NewSetThingTypeSchemaRequest(server string, body SetThingTypeSchemaJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetThingTypeSchemaRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetThingTypeSchemaRequestWithBody generates requests for SetThingTypeSchema with any type of body
+func NewSetThingTypeSchemaRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/thingschemas")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteThingStateTransitionsRequest generates requests for DeleteThingStateTransitions
+func NewDeleteThingStateTransitionsRequest(server string, params *DeleteThingStateTransitionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/thingstates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, params.Type); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindThingStateTransitionsRequest generates requests for FindThingStateTransitions
+func NewFindThingStateTransitionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/thingstates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetThingStateTransitionsRequest calls the generic SetThingStateTransitions builder with application/json body
+func NewSetThingStateTransitionsRequest(server string, body SetThingStateTransitionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetThingStateTransitionsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetThingStateTransitionsRequestWithBody generates requests for SetThingStateTransitions with any type of body
+func NewSetThingStateTransitionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/thingstates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindTimeSeriesRequest generates requests for FindTimeSeries
+func NewFindTimeSeriesRequest(server string, params *FindTimeSeriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/timeseries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Tags != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tags", runtime.ParamLocationQuery, *params.Tags); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Attributes != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "attributes", runtime.ParamLocationQuery, *params.Attributes); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddTimeSeriesRequest calls the generic AddTimeSeries builder with application/json body
+func NewAddTimeSeriesRequest(server string, body AddTimeSeriesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddTimeSeriesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddTimeSeriesRequestWithBody generates requests for AddTimeSeries with any type of body
+func NewAddTimeSeriesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/timeseries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTimeSeriesByUuidRequest generates requests for DeleteTimeSeriesByUuid
+func NewDeleteTimeSeriesByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/timeseries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindTimeSeriesByUuidRequest generates requests for FindTimeSeriesByUuid
+func NewFindTimeSeriesByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/timeseries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTimeseriesByUuidRequest calls the generic UpdateTimeseriesByUuid builder with application/json body
+func NewUpdateTimeseriesByUuidRequest(server string, uuid UuidParam, body UpdateTimeseriesByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTimeseriesByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateTimeseriesByUuidRequestWithBody generates requests for UpdateTimeseriesByUuid with any type of body
+func NewUpdateTimeseriesByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/timeseries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDataFromTimeSeriesRequest generates requests for DeleteDataFromTimeSeries
+func NewDeleteDataFromTimeSeriesRequest(server string, uuid UuidParam, params *DeleteDataFromTimeSeriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/timeseries/%s/data", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, params.End); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Ge != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ge", runtime.ParamLocationQuery, *params.Ge); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Le != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "le", runtime.ParamLocationQuery, *params.Le); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewQueryTimeseriesForDataRequest generates requests for QueryTimeseriesForData
+func NewQueryTimeseriesForDataRequest(server string, uuid UuidParam, params *QueryTimeseriesForDataParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/timeseries/%s/data", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, params.End); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Unit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unit", runtime.ParamLocationQuery, *params.Unit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Ge != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ge", runtime.ParamLocationQuery, *params.Ge); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Le != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "le", runtime.ParamLocationQuery, *params.Le); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Precision != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "precision", runtime.ParamLocationQuery, *params.Precision); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Aggregate != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "aggregate", runtime.ParamLocationQuery, *params.Aggregate); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Timezone != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddDataToTimeseriesRequest calls the generic AddDataToTimeseries builder with application/json body
+func NewAddDataToTimeseriesRequest(server string, uuid UuidParam, params *AddDataToTimeseriesParams, body AddDataToTimeseriesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddDataToTimeseriesRequestWithBody(server, uuid, params, "application/json", bodyReader)
+}
+
+// NewAddDataToTimeseriesRequestWithBody generates requests for AddDataToTimeseries with any type of body
+func NewAddDataToTimeseriesRequestWithBody(server string, uuid UuidParam, params *AddDataToTimeseriesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/timeseries/%s/data", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Unit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unit", runtime.ParamLocationQuery, *params.Unit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Overwrite != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "overwrite", runtime.ParamLocationQuery, *params.Overwrite); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindTimeseriesDataHistoryRequest generates requests for FindTimeseriesDataHistory
+func NewFindTimeseriesDataHistoryRequest(server string, uuid UuidParam, params *FindTimeseriesDataHistoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/timeseries/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, params.End); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUndoTimeseriesDataChangeRequest generates requests for UndoTimeseriesDataChange
+func NewUndoTimeseriesDataChangeRequest(server string, uuid UuidParam, changeId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "change_id", runtime.ParamLocationPath, changeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/timeseries/%s/history/%s/undo", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindTimeseriesHealthRequest generates requests for FindTimeseriesHealth
+func NewFindTimeseriesHealthRequest(server string, params *FindTimeseriesHealthParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/tshealth")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.State != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Thing != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "thing", runtime.ParamLocationQuery, *params.Thing); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindHealthRulesRequest generates requests for FindHealthRules
+func NewFindHealthRulesRequest(server string, params *FindHealthRulesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/tshealth/rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddHealthRuleRequest calls the generic AddHealthRule builder with application/json body
+func NewAddHealthRuleRequest(server string, body AddHealthRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddHealthRuleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddHealthRuleRequestWithBody generates requests for AddHealthRule with any type of body
+func NewAddHealthRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/tshealth/rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteHealthRuleByUuidRequest generates requests for DeleteHealthRuleByUuid
+func NewDeleteHealthRuleByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/tshealth/rules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindHealthRuleByUuidRequest generates requests for FindHealthRuleByUuid
+func NewFindHealthRuleByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/tshealth/rules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateHealthRuleByUuidRequest calls the generic UpdateHealthRuleByUuid builder with application/json body
+func NewUpdateHealthRuleByUuidRequest(server string, uuid UuidParam, body UpdateHealthRuleByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateHealthRuleByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateHealthRuleByUuidRequestWithBody generates requests for UpdateHealthRuleByUuid with any type of body
+func NewUpdateHealthRuleByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/tshealth/rules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportTsdataRequestWithBody generates requests for ImportTsdata with any type of body
+func NewImportTsdataRequestWithBody(server string, params *ImportTsdataParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/tsimport")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.DryRun != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindTsdataByQueryRequest generates requests for FindTsdataByQuery
+func NewFindTsdataByQueryRequest(server string, params *FindTsdataByQueryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/tsquery")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "uuids", runtime.ParamLocationQuery, params.Uuids); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, params.Start); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, params.End); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Ge != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ge", runtime.ParamLocationQuery, *params.Ge); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Le != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "le", runtime.ParamLocationQuery, *params.Le); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Precision != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "precision", runtime.ParamLocationQuery, *params.Precision); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Aggregate != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "aggregate", runtime.ParamLocationQuery, *params.Aggregate); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Timezone != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindUsersRequest generates requests for FindUsers
+func NewFindUsersRequest(server string, params *FindUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddUserRequest calls the generic AddUser builder with application/json body
+func NewAddUserRequest(server string, body AddUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddUserRequestWithBody generates requests for AddUser with any type of body
+func NewAddUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWhoamiRequest generates requests for Whoami
+func NewWhoamiRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteUserByUuidRequest generates requests for DeleteUserByUuid
+func NewDeleteUserByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFindUserByUuidRequest generates requests for FindUserByUuid
+func NewFindUserByUuidRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateUserByUuidRequest calls the generic UpdateUserByUuid builder with application/json body
+func NewUpdateUserByUuidRequest(server string, uuid UuidParam, body UpdateUserByUuidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserByUuidRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateUserByUuidRequestWithBody generates requests for UpdateUserByUuid with any type of body
+func NewUpdateUserByUuidRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindPoliciesForUserRequest generates requests for FindPoliciesForUser
+func NewFindPoliciesForUserRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s/policies", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetRequestRateForUserRequest calls the generic SetRequestRateForUser builder with application/json body
+func NewSetRequestRateForUserRequest(server string, uuid UuidParam, body SetRequestRateForUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetRequestRateForUserRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewSetRequestRateForUserRequestWithBody generates requests for SetRequestRateForUser with any type of body
+func NewSetRequestRateForUserRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s/rate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFindTokensForUserRequest generates requests for FindTokensForUser
+func NewFindTokensForUserRequest(server string, uuid UuidParam) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s/tokens", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddNewTokenToUserRequest calls the generic AddNewTokenToUser builder with application/json body
+func NewAddNewTokenToUserRequest(server string, uuid UuidParam, body AddNewTokenToUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddNewTokenToUserRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewAddNewTokenToUserRequestWithBody generates requests for AddNewTokenToUser with any type of body
+func NewAddNewTokenToUserRequestWithBody(server string, uuid UuidParam, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s/tokens", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTokenForUserRequest generates requests for DeleteTokenForUser
+func NewDeleteTokenForUserRequest(server string, uuid UuidParam, tokenUuid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "token_uuid", runtime.ParamLocationPath, tokenUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s/tokens/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// FindAlertGroups request
+	FindAlertGroupsWithResponse(ctx context.Context, params *FindAlertGroupsParams, reqEditors ...RequestEditorFn) (*FindAlertGroupsResponse, error)
+
+	// AddAlertGroup request with any body
+	AddAlertGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddAlertGroupResponse, error)
+
+	AddAlertGroupWithResponse(ctx context.Context, body AddAlertGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AddAlertGroupResponse, error)
+
+	// DeleteAlertGroupByUuid request
+	DeleteAlertGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteAlertGroupByUuidResponse, error)
+
+	// FindAlertGroupByUuid request
+	FindAlertGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindAlertGroupByUuidResponse, error)
+
+	// UpdateAlertGroupByUuid request with any body
+	UpdateAlertGroupByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertGroupByUuidResponse, error)
+
+	UpdateAlertGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateAlertGroupByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertGroupByUuidResponse, error)
+
+	// FindAlerts request
+	FindAlertsWithResponse(ctx context.Context, params *FindAlertsParams, reqEditors ...RequestEditorFn) (*FindAlertsResponse, error)
+
+	// CreateAlert request with any body
+	CreateAlertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error)
+
+	CreateAlertWithResponse(ctx context.Context, body CreateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error)
+
+	// DeleteAlertByUuid request
+	DeleteAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteAlertByUuidResponse, error)
+
+	// FindAlertByUuid request
+	FindAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindAlertByUuidResponse, error)
+
+	// UpdateAlertByUuid request with any body
+	UpdateAlertByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertByUuidResponse, error)
+
+	UpdateAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateAlertByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertByUuidResponse, error)
+
+	// AcknowledgeAlert request with any body
+	AcknowledgeAlertWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AcknowledgeAlertResponse, error)
+
+	AcknowledgeAlertWithResponse(ctx context.Context, uuid UuidParam, body AcknowledgeAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*AcknowledgeAlertResponse, error)
+
+	// FindAlertHistory request
+	FindAlertHistoryWithResponse(ctx context.Context, uuid UuidParam, params *FindAlertHistoryParams, reqEditors ...RequestEditorFn) (*FindAlertHistoryResponse, error)
+
+	// ShelveAlert request with any body
+	ShelveAlertWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShelveAlertResponse, error)
+
+	ShelveAlertWithResponse(ctx context.Context, uuid UuidParam, body ShelveAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*ShelveAlertResponse, error)
+
+	// UnacknowledgeAlert request with any body
+	UnacknowledgeAlertWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnacknowledgeAlertResponse, error)
+
+	UnacknowledgeAlertWithResponse(ctx context.Context, uuid UuidParam, body UnacknowledgeAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*UnacknowledgeAlertResponse, error)
+
+	// UnshelveAlert request with any body
+	UnshelveAlertWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnshelveAlertResponse, error)
+
+	UnshelveAlertWithResponse(ctx context.Context, uuid UuidParam, body UnshelveAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*UnshelveAlertResponse, error)
+
+	// FindDatasets request
+	FindDatasetsWithResponse(ctx context.Context, params *FindDatasetsParams, reqEditors ...RequestEditorFn) (*FindDatasetsResponse, error)
+
+	// AddDatasets request with any body
+	AddDatasetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDatasetsResponse, error)
+
+	AddDatasetsWithResponse(ctx context.Context, body AddDatasetsJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDatasetsResponse, error)
+
+	// DeleteDatasetByUuid request
+	DeleteDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteDatasetByUuidResponse, error)
+
+	// FindDatasetByUuid request
+	FindDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindDatasetByUuidResponse, error)
+
+	// UpdateDatasetByUuid request with any body
+	UpdateDatasetByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatasetByUuidResponse, error)
+
+	UpdateDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateDatasetByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatasetByUuidResponse, error)
+
+	// AssembleDatasetPartsByKey request
+	AssembleDatasetPartsByKeyWithResponse(ctx context.Context, uuid UuidParam, params *AssembleDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*AssembleDatasetPartsByKeyResponse, error)
+
+	// GetDatasetRevisionsDiff request
+	GetDatasetRevisionsDiffWithResponse(ctx context.Context, uuid UuidParam, params *GetDatasetRevisionsDiffParams, reqEditors ...RequestEditorFn) (*GetDatasetRevisionsDiffResponse, error)
+
+	// GetDatasetDocument request
+	GetDatasetDocumentWithResponse(ctx context.Context, uuid UuidParam, params *GetDatasetDocumentParams, reqEditors ...RequestEditorFn) (*GetDatasetDocumentResponse, error)
+
+	// ImportDatasetIntoTimeseries request with any body
+	ImportDatasetIntoTimeseriesWithBodyWithResponse(ctx context.Context, uuid UuidParam, params *ImportDatasetIntoTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDatasetIntoTimeseriesResponse, error)
+
+	ImportDatasetIntoTimeseriesWithResponse(ctx context.Context, uuid UuidParam, params *ImportDatasetIntoTimeseriesParams, body ImportDatasetIntoTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportDatasetIntoTimeseriesResponse, error)
+
+	// ListDatasetPartsByKey request
+	ListDatasetPartsByKeyWithResponse(ctx context.Context, uuid UuidParam, params *ListDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*ListDatasetPartsByKeyResponse, error)
+
+	// UploadDatasetContentByKey request
+	UploadDatasetContentByKeyWithResponse(ctx context.Context, uuid UuidParam, params *UploadDatasetContentByKeyParams, reqEditors ...RequestEditorFn) (*UploadDatasetContentByKeyResponse, error)
+
+	// GetRawDatasetByUuid request
+	GetRawDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, params *GetRawDatasetByUuidParams, reqEditors ...RequestEditorFn) (*GetRawDatasetByUuidResponse, error)
+
+	// GetDatasetRevisions request
+	GetDatasetRevisionsWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetDatasetRevisionsResponse, error)
+
+	// GetRawDatasetRevision request
+	GetRawDatasetRevisionWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*GetRawDatasetRevisionResponse, error)
+
+	// RollbackDatasetToRevision request
+	RollbackDatasetToRevisionWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*RollbackDatasetToRevisionResponse, error)
+
+	// DeleteDatasetSchema request
+	DeleteDatasetSchemaWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteDatasetSchemaResponse, error)
+
+	// GetDatasetSchema request
+	GetDatasetSchemaWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetDatasetSchemaResponse, error)
+
+	// SetDatasetSchema request with any body
+	SetDatasetSchemaWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetDatasetSchemaResponse, error)
+
+	SetDatasetSchemaWithResponse(ctx context.Context, uuid UuidParam, body SetDatasetSchemaJSONRequestBody, reqEditors ...RequestEditorFn) (*SetDatasetSchemaResponse, error)
+
+	// DeleteDatasetUploadByKey request
+	DeleteDatasetUploadByKeyWithResponse(ctx context.Context, uuid UuidParam, params *DeleteDatasetUploadByKeyParams, reqEditors ...RequestEditorFn) (*DeleteDatasetUploadByKeyResponse, error)
+
+	// InitializeDatasetUploadByUuid request
+	InitializeDatasetUploadByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*InitializeDatasetUploadByUuidResponse, error)
+
+	// FindGroups request
+	FindGroupsWithResponse(ctx context.Context, params *FindGroupsParams, reqEditors ...RequestEditorFn) (*FindGroupsResponse, error)
+
+	// AddGroup request with any body
+	AddGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddGroupResponse, error)
+
+	AddGroupWithResponse(ctx context.Context, body AddGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AddGroupResponse, error)
+
+	// DeleteGroupByUuid request
+	DeleteGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteGroupByUuidResponse, error)
+
+	// FindGroupByUuid request
+	FindGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindGroupByUuidResponse, error)
+
+	// UpdateGroupByUuid request with any body
+	UpdateGroupByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGroupByUuidResponse, error)
+
+	UpdateGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateGroupByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGroupByUuidResponse, error)
+
+	// FindPoliciesForGroup request
+	FindPoliciesForGroupWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPoliciesForGroupResponse, error)
+
+	// FindIncidents request
+	FindIncidentsWithResponse(ctx context.Context, params *FindIncidentsParams, reqEditors ...RequestEditorFn) (*FindIncidentsResponse, error)
+
+	// FindIncidentByUuid request
+	FindIncidentByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindIncidentByUuidResponse, error)
+
+	// AcknowledgeIncident request with any body
+	AcknowledgeIncidentWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AcknowledgeIncidentResponse, error)
+
+	AcknowledgeIncidentWithResponse(ctx context.Context, uuid UuidParam, body AcknowledgeIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*AcknowledgeIncidentResponse, error)
+
+	// FindIncidentAlerts request
+	FindIncidentAlertsWithResponse(ctx context.Context, uuid UuidParam, params *FindIncidentAlertsParams, reqEditors ...RequestEditorFn) (*FindIncidentAlertsResponse, error)
+
+	// CloseIncident request with any body
+	CloseIncidentWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloseIncidentResponse, error)
+
+	CloseIncidentWithResponse(ctx context.Context, uuid UuidParam, body CloseIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*CloseIncidentResponse, error)
+
+	// FindIncidentHistory request
+	FindIncidentHistoryWithResponse(ctx context.Context, uuid UuidParam, params *FindIncidentHistoryParams, reqEditors ...RequestEditorFn) (*FindIncidentHistoryResponse, error)
+
+	// FindProgramJobs request
+	FindProgramJobsWithResponse(ctx context.Context, params *FindProgramJobsParams, reqEditors ...RequestEditorFn) (*FindProgramJobsResponse, error)
+
+	// FindProgramJobById request
+	FindProgramJobByIdWithResponse(ctx context.Context, jobId int64, reqEditors ...RequestEditorFn) (*FindProgramJobByIdResponse, error)
+
+	// RequeueProgramJob request
+	RequeueProgramJobWithResponse(ctx context.Context, jobId int64, reqEditors ...RequestEditorFn) (*RequeueProgramJobResponse, error)
+
+	// FindProgramJobRuns request
+	FindProgramJobRunsWithResponse(ctx context.Context, jobId int64, reqEditors ...RequestEditorFn) (*FindProgramJobRunsResponse, error)
+
+	// FindMonitors request
+	FindMonitorsWithResponse(ctx context.Context, params *FindMonitorsParams, reqEditors ...RequestEditorFn) (*FindMonitorsResponse, error)
+
+	// AddMonitor request with any body
+	AddMonitorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddMonitorResponse, error)
+
+	AddMonitorWithResponse(ctx context.Context, body AddMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*AddMonitorResponse, error)
+
+	// DeleteMonitorByUuid request
+	DeleteMonitorByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteMonitorByUuidResponse, error)
+
+	// FindMonitorByUuid request
+	FindMonitorByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindMonitorByUuidResponse, error)
+
+	// UpdateMonitorByUuid request with any body
+	UpdateMonitorByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonitorByUuidResponse, error)
+
+	UpdateMonitorByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateMonitorByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitorByUuidResponse, error)
+
+	// FindNotificationChannels request
+	FindNotificationChannelsWithResponse(ctx context.Context, params *FindNotificationChannelsParams, reqEditors ...RequestEditorFn) (*FindNotificationChannelsResponse, error)
+
+	// AddNotificationChannel request with any body
+	AddNotificationChannelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddNotificationChannelResponse, error)
+
+	AddNotificationChannelWithResponse(ctx context.Context, body AddNotificationChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*AddNotificationChannelResponse, error)
+
+	// DeleteNotificationChannelByUuid request
+	DeleteNotificationChannelByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteNotificationChannelByUuidResponse, error)
+
+	// FindNotificationChannelByUuid request
+	FindNotificationChannelByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindNotificationChannelByUuidResponse, error)
+
+	// UpdateNotificationChannelByUuid request with any body
+	UpdateNotificationChannelByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNotificationChannelByUuidResponse, error)
+
+	UpdateNotificationChannelByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateNotificationChannelByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNotificationChannelByUuidResponse, error)
+
+	// FindNotificationDeliveries request
+	FindNotificationDeliveriesWithResponse(ctx context.Context, params *FindNotificationDeliveriesParams, reqEditors ...RequestEditorFn) (*FindNotificationDeliveriesResponse, error)
+
+	// FindNotificationRules request
+	FindNotificationRulesWithResponse(ctx context.Context, params *FindNotificationRulesParams, reqEditors ...RequestEditorFn) (*FindNotificationRulesResponse, error)
+
+	// AddNotificationRule request with any body
+	AddNotificationRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddNotificationRuleResponse, error)
+
+	AddNotificationRuleWithResponse(ctx context.Context, body AddNotificationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AddNotificationRuleResponse, error)
+
+	// DeleteNotificationRuleByUuid request
+	DeleteNotificationRuleByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteNotificationRuleByUuidResponse, error)
+
+	// FindNotificationRuleByUuid request
+	FindNotificationRuleByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindNotificationRuleByUuidResponse, error)
+
+	// UpdateNotificationRuleByUuid request with any body
+	UpdateNotificationRuleByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNotificationRuleByUuidResponse, error)
+
+	UpdateNotificationRuleByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateNotificationRuleByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNotificationRuleByUuidResponse, error)
+
+	// FindPolicies request
+	FindPoliciesWithResponse(ctx context.Context, params *FindPoliciesParams, reqEditors ...RequestEditorFn) (*FindPoliciesResponse, error)
+
+	// AddPolicy request with any body
+	AddPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPolicyResponse, error)
+
+	AddPolicyWithResponse(ctx context.Context, body AddPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPolicyResponse, error)
+
+	// DeletePolicyByUuid request
+	DeletePolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeletePolicyByUuidResponse, error)
+
+	// FindPolicyByUuid request
+	FindPolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPolicyByUuidResponse, error)
+
+	// UpdatePolicyByUuid request with any body
+	UpdatePolicyByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePolicyByUuidResponse, error)
+
+	UpdatePolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdatePolicyByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePolicyByUuidResponse, error)
+
+	// FindPrograms request
+	FindProgramsWithResponse(ctx context.Context, params *FindProgramsParams, reqEditors ...RequestEditorFn) (*FindProgramsResponse, error)
+
+	// AddProgram request with any body
+	AddProgramWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProgramResponse, error)
+
+	AddProgramWithResponse(ctx context.Context, body AddProgramJSONRequestBody, reqEditors ...RequestEditorFn) (*AddProgramResponse, error)
+
+	// DeleteProgramByUuid request
+	DeleteProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteProgramByUuidResponse, error)
+
+	// FindProgramByUuid request
+	FindProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindProgramByUuidResponse, error)
+
+	// UpdateProgramByUuid request with any body
+	UpdateProgramByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProgramByUuidResponse, error)
+
+	UpdateProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateProgramByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProgramByUuidResponse, error)
+
+	// GetCodeFromProgram request
+	GetCodeFromProgramWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetCodeFromProgramResponse, error)
+
+	// AddProgramCodeRevision request with any body
+	AddProgramCodeRevisionWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProgramCodeRevisionResponse, error)
+
+	// GetProgramCodeRevisionsDiff request
+	GetProgramCodeRevisionsDiffWithResponse(ctx context.Context, uuid UuidParam, params *GetProgramCodeRevisionsDiffParams, reqEditors ...RequestEditorFn) (*GetProgramCodeRevisionsDiffResponse, error)
+
+	// GetProgramCodeRevisions request
+	GetProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetProgramCodeRevisionsResponse, error)
+
+	// DeleteProgramCodeRevisions request
+	DeleteProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*DeleteProgramCodeRevisionsResponse, error)
+
+	// SignProgramCodeRevisions request
+	SignProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*SignProgramCodeRevisionsResponse, error)
+
+	// RunProgram request with any body
+	RunProgramWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunProgramResponse, error)
+
+	RunProgramWithResponse(ctx context.Context, uuid UuidParam, body RunProgramJSONRequestBody, reqEditors ...RequestEditorFn) (*RunProgramResponse, error)
+
+	// FindProgramRuns request
+	FindProgramRunsWithResponse(ctx context.Context, uuid UuidParam, params *FindProgramRunsParams, reqEditors ...RequestEditorFn) (*FindProgramRunsResponse, error)
+
+	// FindProgramRunById request
+	FindProgramRunByIdWithResponse(ctx context.Context, uuid UuidParam, runId int64, reqEditors ...RequestEditorFn) (*FindProgramRunByIdResponse, error)
+
+	// ExecuteProgramWebhook request
+	ExecuteProgramWebhookWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*ExecuteProgramWebhookResponse, error)
+
+	// FindSilences request
+	FindSilencesWithResponse(ctx context.Context, params *FindSilencesParams, reqEditors ...RequestEditorFn) (*FindSilencesResponse, error)
+
+	// AddSilence request with any body
+	AddSilenceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddSilenceResponse, error)
+
+	AddSilenceWithResponse(ctx context.Context, body AddSilenceJSONRequestBody, reqEditors ...RequestEditorFn) (*AddSilenceResponse, error)
+
+	// DeleteSilenceByUuid request
+	DeleteSilenceByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteSilenceByUuidResponse, error)
+
+	// FindSilenceByUuid request
+	FindSilenceByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindSilenceByUuidResponse, error)
+
+	// UpdateSilenceByUuid request with any body
+	UpdateSilenceByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSilenceByUuidResponse, error)
+
+	UpdateSilenceByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateSilenceByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSilenceByUuidResponse, error)
+
+	// FindThingTemplates request
+	FindThingTemplatesWithResponse(ctx context.Context, params *FindThingTemplatesParams, reqEditors ...RequestEditorFn) (*FindThingTemplatesResponse, error)
+
+	// AddThingTemplate request with any body
+	AddThingTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddThingTemplateResponse, error)
+
+	AddThingTemplateWithResponse(ctx context.Context, body AddThingTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*AddThingTemplateResponse, error)
+
+	// DeleteThingTemplateByUuid request
+	DeleteThingTemplateByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteThingTemplateByUuidResponse, error)
+
+	// FindThingTemplateByUuid request
+	FindThingTemplateByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindThingTemplateByUuidResponse, error)
+
+	// UpdateThingTemplateByUuid request with any body
+	UpdateThingTemplateByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateThingTemplateByUuidResponse, error)
+
+	UpdateThingTemplateByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateThingTemplateByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateThingTemplateByUuidResponse, error)
+
+	// ApplyThingTemplate request
+	ApplyThingTemplateWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*ApplyThingTemplateResponse, error)
+
+	// FindThings request
+	FindThingsWithResponse(ctx context.Context, params *FindThingsParams, reqEditors ...RequestEditorFn) (*FindThingsResponse, error)
+
+	// AddThing request with any body
+	AddThingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddThingResponse, error)
+
+	AddThingWithResponse(ctx context.Context, body AddThingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddThingResponse, error)
+
+	// DeleteThingByUuid request
+	DeleteThingByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteThingByUuidResponse, error)
+
+	// FindThingByUuid request
+	FindThingByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindThingByUuidResponse, error)
+
+	// UpdateThingByUuid request with any body
+	UpdateThingByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateThingByUuidResponse, error)
+
+	UpdateThingByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateThingByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateThingByUuidResponse, error)
+
+	// FindAncestorsForThing request
+	FindAncestorsForThingWithResponse(ctx context.Context, uuid UuidParam, params *FindAncestorsForThingParams, reqEditors ...RequestEditorFn) (*FindAncestorsForThingResponse, error)
+
+	// FindChildrenForThing request
+	FindChildrenForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindChildrenForThingResponse, error)
+
+	// AddChildToThing request with any body
+	AddChildToThingWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddChildToThingResponse, error)
+
+	AddChildToThingWithResponse(ctx context.Context, uuid UuidParam, body AddChildToThingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddChildToThingResponse, error)
+
+	// RemoveChildFromThing request
+	RemoveChildFromThingWithResponse(ctx context.Context, uuid UuidParam, childUuid string, reqEditors ...RequestEditorFn) (*RemoveChildFromThingResponse, error)
+
+	// FindDatasetsForThing request
+	FindDatasetsForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindDatasetsForThingResponse, error)
+
+	// FindDescendantsForThing request
+	FindDescendantsForThingWithResponse(ctx context.Context, uuid UuidParam, params *FindDescendantsForThingParams, reqEditors ...RequestEditorFn) (*FindDescendantsForThingResponse, error)
+
+	// FindStateHistoryForThing request
+	FindStateHistoryForThingWithResponse(ctx context.Context, uuid UuidParam, params *FindStateHistoryForThingParams, reqEditors ...RequestEditorFn) (*FindStateHistoryForThingResponse, error)
+
+	// FindParentsForThing request
+	FindParentsForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindParentsForThingResponse, error)
+
+	// FindSubtreeForThing request
+	FindSubtreeForThingWithResponse(ctx context.Context, uuid UuidParam, params *FindSubtreeForThingParams, reqEditors ...RequestEditorFn) (*FindSubtreeForThingResponse, error)
+
+	// FindTimeSeriesForThing request
+	FindTimeSeriesForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTimeSeriesForThingResponse, error)
+
+	// DeleteThingTypeSchema request
+	DeleteThingTypeSchemaWithResponse(ctx context.Context, params *DeleteThingTypeSchemaParams, reqEditors ...RequestEditorFn) (*DeleteThingTypeSchemaResponse, error)
+
+	// FindThingTypeSchemas request
+	FindThingTypeSchemasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FindThingTypeSchemasResponse, error)
+
+	// SetThingTypeSchema request with any body
+	SetThingTypeSchemaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetThingTypeSchemaResponse, error)
+
+	SetThingTypeSchemaWithResponse(ctx context.Context, body SetThingTypeSchemaJSONRequestBody, reqEditors ...RequestEditorFn) (*SetThingTypeSchemaResponse, error)
+
+	// DeleteThingStateTransitions request
+	DeleteThingStateTransitionsWithResponse(ctx context.Context, params *DeleteThingStateTransitionsParams, reqEditors ...RequestEditorFn) (*DeleteThingStateTransitionsResponse, error)
+
+	// FindThingStateTransitions request
+	FindThingStateTransitionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FindThingStateTransitionsResponse, error)
+
+	// SetThingStateTransitions request with any body
+	SetThingStateTransitionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetThingStateTransitionsResponse, error)
+
+	SetThingStateTransitionsWithResponse(ctx context.Context, body SetThingStateTransitionsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetThingStateTransitionsResponse, error)
+
+	// FindTimeSeries request
+	FindTimeSeriesWithResponse(ctx context.Context, params *FindTimeSeriesParams, reqEditors ...RequestEditorFn) (*FindTimeSeriesResponse, error)
+
+	// AddTimeSeries request with any body
+	AddTimeSeriesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTimeSeriesResponse, error)
+
+	AddTimeSeriesWithResponse(ctx context.Context, body AddTimeSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTimeSeriesResponse, error)
+
+	// DeleteTimeSeriesByUuid request
+	DeleteTimeSeriesByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteTimeSeriesByUuidResponse, error)
+
+	// FindTimeSeriesByUuid request
+	FindTimeSeriesByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTimeSeriesByUuidResponse, error)
+
+	// UpdateTimeseriesByUuid request with any body
+	UpdateTimeseriesByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error)
+
+	UpdateTimeseriesByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateTimeseriesByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error)
+
+	// DeleteDataFromTimeSeries request
+	DeleteDataFromTimeSeriesWithResponse(ctx context.Context, uuid UuidParam, params *DeleteDataFromTimeSeriesParams, reqEditors ...RequestEditorFn) (*DeleteDataFromTimeSeriesResponse, error)
+
+	// QueryTimeseriesForData request
+	QueryTimeseriesForDataWithResponse(ctx context.Context, uuid UuidParam, params *QueryTimeseriesForDataParams, reqEditors ...RequestEditorFn) (*QueryTimeseriesForDataResponse, error)
+
+	// AddDataToTimeseries request with any body
+	AddDataToTimeseriesWithBodyWithResponse(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDataToTimeseriesResponse, error)
+
+	AddDataToTimeseriesWithResponse(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, body AddDataToTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDataToTimeseriesResponse, error)
+
+	// FindTimeseriesDataHistory request
+	FindTimeseriesDataHistoryWithResponse(ctx context.Context, uuid UuidParam, params *FindTimeseriesDataHistoryParams, reqEditors ...RequestEditorFn) (*FindTimeseriesDataHistoryResponse, error)
+
+	// UndoTimeseriesDataChange request
+	UndoTimeseriesDataChangeWithResponse(ctx context.Context, uuid UuidParam, changeId int64, reqEditors ...RequestEditorFn) (*UndoTimeseriesDataChangeResponse, error)
+
+	// FindTimeseriesHealth request
+	FindTimeseriesHealthWithResponse(ctx context.Context, params *FindTimeseriesHealthParams, reqEditors ...RequestEditorFn) (*FindTimeseriesHealthResponse, error)
+
+	// FindHealthRules request
+	FindHealthRulesWithResponse(ctx context.Context, params *FindHealthRulesParams, reqEditors ...RequestEditorFn) (*FindHealthRulesResponse, error)
+
+	// AddHealthRule request with any body
+	AddHealthRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddHealthRuleResponse, error)
+
+	AddHealthRuleWithResponse(ctx context.Context, body AddHealthRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AddHealthRuleResponse, error)
+
+	// DeleteHealthRuleByUuid request
+	DeleteHealthRuleByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteHealthRuleByUuidResponse, error)
+
+	// FindHealthRuleByUuid request
+	FindHealthRuleByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindHealthRuleByUuidResponse, error)
+
+	// UpdateHealthRuleByUuid request with any body
+	UpdateHealthRuleByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateHealthRuleByUuidResponse, error)
+
+	UpdateHealthRuleByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateHealthRuleByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateHealthRuleByUuidResponse, error)
+
+	// ImportTsdata request with any body
+	ImportTsdataWithBodyWithResponse(ctx context.Context, params *ImportTsdataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTsdataResponse, error)
+
+	// FindTsdataByQuery request
+	FindTsdataByQueryWithResponse(ctx context.Context, params *FindTsdataByQueryParams, reqEditors ...RequestEditorFn) (*FindTsdataByQueryResponse, error)
+
+	// FindUsers request
+	FindUsersWithResponse(ctx context.Context, params *FindUsersParams, reqEditors ...RequestEditorFn) (*FindUsersResponse, error)
+
+	// AddUser request with any body
+	AddUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddUserResponse, error)
+
+	AddUserWithResponse(ctx context.Context, body AddUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddUserResponse, error)
+
+	// Whoami request
+	WhoamiWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WhoamiResponse, error)
+
+	// DeleteUserByUuid request
+	DeleteUserByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteUserByUuidResponse, error)
+
+	// FindUserByUuid request
+	FindUserByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindUserByUuidResponse, error)
+
+	// UpdateUserByUuid request with any body
+	UpdateUserByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserByUuidResponse, error)
+
+	UpdateUserByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateUserByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserByUuidResponse, error)
+
+	// FindPoliciesForUser request
+	FindPoliciesForUserWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPoliciesForUserResponse, error)
+
+	// SetRequestRateForUser request with any body
+	SetRequestRateForUserWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetRequestRateForUserResponse, error)
+
+	SetRequestRateForUserWithResponse(ctx context.Context, uuid UuidParam, body SetRequestRateForUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SetRequestRateForUserResponse, error)
+
+	// FindTokensForUser request
+	FindTokensForUserWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTokensForUserResponse, error)
+
+	// AddNewTokenToUser request with any body
+	AddNewTokenToUserWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddNewTokenToUserResponse, error)
+
+	AddNewTokenToUserWithResponse(ctx context.Context, uuid UuidParam, body AddNewTokenToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddNewTokenToUserResponse, error)
+
+	// DeleteTokenForUser request
+	DeleteTokenForUserWithResponse(ctx context.Context, uuid UuidParam, tokenUuid string, reqEditors ...RequestEditorFn) (*DeleteTokenForUserResponse, error)
+}
+
+type FindAlertGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AlertGroup
+}
+
+// Status returns HTTPResponse.Status
+func (r FindAlertGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindAlertGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddAlertGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AlertGroup
+}
+
+// Status returns HTTPResponse.Status
+func (r AddAlertGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddAlertGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAlertGroupByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAlertGroupByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAlertGroupByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindAlertGroupByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertGroup
+}
+
+// Status returns HTTPResponse.Status
+func (r FindAlertGroupByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindAlertGroupByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAlertGroupByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAlertGroupByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAlertGroupByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindAlertsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Alert
+}
+
+// Status returns HTTPResponse.Status
+func (r FindAlertsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindAlertsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAlertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *NewAlertReply
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAlertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAlertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAlertByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAlertByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAlertByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindAlertByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Alert
+}
+
+// Status returns HTTPResponse.Status
+func (r FindAlertByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindAlertByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAlertByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAlertByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAlertByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AcknowledgeAlertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AcknowledgeAlertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AcknowledgeAlertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindAlertHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AlertHistoryEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r FindAlertHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindAlertHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ShelveAlertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ShelveAlertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ShelveAlertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnacknowledgeAlertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UnacknowledgeAlertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnacknowledgeAlertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnshelveAlertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UnshelveAlertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnshelveAlertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindDatasetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Dataset
+}
+
+// Status returns HTTPResponse.Status
+func (r FindDatasetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindDatasetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddDatasetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Dataset
+}
+
+// Status returns HTTPResponse.Status
+func (r AddDatasetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddDatasetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatasetByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatasetByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatasetByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindDatasetByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Dataset
+}
+
+// Status returns HTTPResponse.Status
+func (r FindDatasetByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindDatasetByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatasetByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatasetByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatasetByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AssembleDatasetPartsByKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *struct {
+		Message string `json:"message"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AssembleDatasetPartsByKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AssembleDatasetPartsByKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatasetRevisionsDiffResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatasetRevisionsDiffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatasetRevisionsDiffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatasetDocumentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *string
+	YAML200      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatasetDocumentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatasetDocumentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportDatasetIntoTimeseriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TsImportResult
+	JSON201      *TsImportResult
+	JSON422      *TsImportResult
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportDatasetIntoTimeseriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportDatasetIntoTimeseriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatasetPartsByKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatasetPartsByKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatasetPartsByKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadDatasetContentByKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message string `json:"message"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadDatasetContentByKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadDatasetContentByKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRawDatasetByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *string
+	XML200       *string
+	YAML200      *string
+	JSON206      *string
+	XML206       *string
+	YAML206      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRawDatasetByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRawDatasetByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatasetRevisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DatasetRevision
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatasetRevisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatasetRevisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRawDatasetRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRawDatasetRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRawDatasetRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RollbackDatasetToRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RollbackDatasetToRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RollbackDatasetToRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatasetSchemaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatasetSchemaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatasetSchemaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatasetSchemaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DatasetSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatasetSchemaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatasetSchemaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetDatasetSchemaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SetDatasetSchemaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetDatasetSchemaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatasetUploadByKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatasetUploadByKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatasetUploadByKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InitializeDatasetUploadByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		UploadId *string `json:"uploadId,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r InitializeDatasetUploadByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InitializeDatasetUploadByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Group
+}
+
+// Status returns HTTPResponse.Status
+func (r FindGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Group
+}
+
+// Status returns HTTPResponse.Status
+func (r AddGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteGroupByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteGroupByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteGroupByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindGroupByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Group
+}
+
+// Status returns HTTPResponse.Status
+func (r FindGroupByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindGroupByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateGroupByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateGroupByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateGroupByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindPoliciesForGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Policy
+}
+
+// Status returns HTTPResponse.Status
+func (r FindPoliciesForGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindPoliciesForGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindIncidentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Incident
+}
+
+// Status returns HTTPResponse.Status
+func (r FindIncidentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindIncidentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindIncidentByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Incident
+}
+
+// Status returns HTTPResponse.Status
+func (r FindIncidentByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindIncidentByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AcknowledgeIncidentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AcknowledgeIncidentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AcknowledgeIncidentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindIncidentAlertsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Alert
+}
+
+// Status returns HTTPResponse.Status
+func (r FindIncidentAlertsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindIncidentAlertsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloseIncidentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CloseIncidentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloseIncidentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindIncidentHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]IncidentHistoryEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r FindIncidentHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindIncidentHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindProgramJobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProgramJob
+}
+
+// Status returns HTTPResponse.Status
+func (r FindProgramJobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindProgramJobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindProgramJobByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProgramJob
+}
+
+// Status returns HTTPResponse.Status
+func (r FindProgramJobByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindProgramJobByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequeueProgramJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RequeueProgramJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequeueProgramJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindProgramJobRunsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProgramRun
+}
+
+// Status returns HTTPResponse.Status
+func (r FindProgramJobRunsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindProgramJobRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindMonitorsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Monitor
+}
+
+// Status returns HTTPResponse.Status
+func (r FindMonitorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindMonitorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddMonitorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Monitor
+}
+
+// Status returns HTTPResponse.Status
+func (r AddMonitorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddMonitorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMonitorByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMonitorByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMonitorByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindMonitorByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Monitor
+}
+
+// Status returns HTTPResponse.Status
+func (r FindMonitorByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindMonitorByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMonitorByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMonitorByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMonitorByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindNotificationChannelsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]NotificationChannel
+}
+
+// Status returns HTTPResponse.Status
+func (r FindNotificationChannelsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindNotificationChannelsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddNotificationChannelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *NotificationChannel
+}
+
+// Status returns HTTPResponse.Status
+func (r AddNotificationChannelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddNotificationChannelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteNotificationChannelByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteNotificationChannelByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteNotificationChannelByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindNotificationChannelByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationChannel
+}
+
+// Status returns HTTPResponse.Status
+func (r FindNotificationChannelByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindNotificationChannelByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateNotificationChannelByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateNotificationChannelByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateNotificationChannelByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindNotificationDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]NotificationDelivery
+}
+
+// Status returns HTTPResponse.Status
+func (r FindNotificationDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindNotificationDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindNotificationRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]NotificationRule
+}
+
+// Status returns HTTPResponse.Status
+func (r FindNotificationRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindNotificationRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddNotificationRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *NotificationRule
+}
+
+// Status returns HTTPResponse.Status
+func (r AddNotificationRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddNotificationRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteNotificationRuleByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteNotificationRuleByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteNotificationRuleByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindNotificationRuleByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationRule
+}
+
+// Status returns HTTPResponse.Status
+func (r FindNotificationRuleByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindNotificationRuleByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateNotificationRuleByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateNotificationRuleByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateNotificationRuleByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindPoliciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Policy
+}
+
+// Status returns HTTPResponse.Status
+func (r FindPoliciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindPoliciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Policy
+}
+
+// Status returns HTTPResponse.Status
+func (r AddPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePolicyByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePolicyByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePolicyByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindPolicyByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Policy
+}
+
+// Status returns HTTPResponse.Status
+func (r FindPolicyByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindPolicyByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePolicyByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePolicyByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePolicyByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindProgramsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Program
+}
+
+// Status returns HTTPResponse.Status
+func (r FindProgramsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindProgramsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddProgramResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Program
+}
+
+// Status returns HTTPResponse.Status
+func (r AddProgramResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddProgramResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProgramByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProgramByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProgramByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindProgramByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Program
+}
+
+// Status returns HTTPResponse.Status
+func (r FindProgramByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindProgramByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateProgramByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProgramByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProgramByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCodeFromProgramResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Program
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCodeFromProgramResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCodeFromProgramResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddProgramCodeRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CodeRevision
+}
+
+// Status returns HTTPResponse.Status
+func (r AddProgramCodeRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddProgramCodeRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProgramCodeRevisionsDiffResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProgramCodeRevisionsDiffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProgramCodeRevisionsDiffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProgramCodeRevisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CodeRevision
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProgramCodeRevisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProgramCodeRevisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteProgramCodeRevisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteProgramCodeRevisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteProgramCodeRevisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SignProgramCodeRevisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SignProgramCodeRevisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SignProgramCodeRevisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RunProgramResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProgramDryRun
+	JSON202      *ProgramRun
+}
+
+// Status returns HTTPResponse.Status
+func (r RunProgramResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RunProgramResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindProgramRunsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ProgramRun
+}
+
+// Status returns HTTPResponse.Status
+func (r FindProgramRunsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindProgramRunsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindProgramRunByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProgramRun
+}
+
+// Status returns HTTPResponse.Status
+func (r FindProgramRunByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindProgramRunByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExecuteProgramWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ExecuteProgramWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExecuteProgramWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindSilencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AlertSilence
+}
+
+// Status returns HTTPResponse.Status
+func (r FindSilencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindSilencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddSilenceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AlertSilence
+}
+
+// Status returns HTTPResponse.Status
+func (r AddSilenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddSilenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSilenceByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSilenceByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSilenceByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindSilenceByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertSilence
+}
+
+// Status returns HTTPResponse.Status
+func (r FindSilenceByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindSilenceByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSilenceByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSilenceByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSilenceByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindThingTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ThingTemplate
+}
+
+// Status returns HTTPResponse.Status
+func (r FindThingTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindThingTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddThingTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ThingTemplate
+}
+
+// Status returns HTTPResponse.Status
+func (r AddThingTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddThingTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteThingTemplateByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteThingTemplateByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteThingTemplateByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindThingTemplateByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ThingTemplate
+}
+
+// Status returns HTTPResponse.Status
+func (r FindThingTemplateByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindThingTemplateByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateThingTemplateByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateThingTemplateByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateThingTemplateByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApplyThingTemplateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ThingTemplateApplyResult
+}
+
+// Status returns HTTPResponse.Status
+func (r ApplyThingTemplateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApplyThingTemplateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindThingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Thing
+}
+
+// Status returns HTTPResponse.Status
+func (r FindThingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindThingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddThingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Thing
+}
+
+// Status returns HTTPResponse.Status
+func (r AddThingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddThingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteThingByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteThingByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteThingByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindThingByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Thing
+}
+
+// Status returns HTTPResponse.Status
+func (r FindThingByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindThingByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateThingByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateThingByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateThingByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindAncestorsForThingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ThingRelative
+}
+
+// Status returns HTTPResponse.Status
+func (r FindAncestorsForThingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindAncestorsForThingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindChildrenForThingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ThingRelative
+}
+
+// Status returns HTTPResponse.Status
+func (r FindChildrenForThingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindChildrenForThingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddChildToThingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AddChildToThingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddChildToThingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveChildFromThingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveChildFromThingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveChildFromThingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindDatasetsForThingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Timeseries
+}
+
+// Status returns HTTPResponse.Status
+func (r FindDatasetsForThingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindDatasetsForThingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindDescendantsForThingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ThingRelative
+}
+
+// Status returns HTTPResponse.Status
+func (r FindDescendantsForThingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindDescendantsForThingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindStateHistoryForThingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ThingStateChange
+}
+
+// Status returns HTTPResponse.Status
+func (r FindStateHistoryForThingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindStateHistoryForThingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindParentsForThingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ThingRelative
+}
+
+// Status returns HTTPResponse.Status
+func (r FindParentsForThingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindParentsForThingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindSubtreeForThingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ThingTree
+}
+
+// Status returns HTTPResponse.Status
+func (r FindSubtreeForThingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindSubtreeForThingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindTimeSeriesForThingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Timeseries
+}
+
+// Status returns HTTPResponse.Status
+func (r FindTimeSeriesForThingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindTimeSeriesForThingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteThingTypeSchemaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteThingTypeSchemaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteThingTypeSchemaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindThingTypeSchemasResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ThingTypeSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r FindThingTypeSchemasResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindThingTypeSchemasResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetThingTypeSchemaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ThingTypeSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r SetThingTypeSchemaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetThingTypeSchemaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteThingStateTransitionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteThingStateTransitionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteThingStateTransitionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindThingStateTransitionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ThingStateTransitions
+}
+
+// Status returns HTTPResponse.Status
+func (r FindThingStateTransitionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindThingStateTransitionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetThingStateTransitionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ThingStateTransitions
+}
+
+// Status returns HTTPResponse.Status
+func (r SetThingStateTransitionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetThingStateTransitionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindTimeSeriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Timeseries
+}
+
+// Status returns HTTPResponse.Status
+func (r FindTimeSeriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindTimeSeriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddTimeSeriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Timeseries
+}
+
+// Status returns HTTPResponse.Status
+func (r AddTimeSeriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddTimeSeriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTimeSeriesByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTimeSeriesByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTimeSeriesByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindTimeSeriesByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Timeseries
+}
+
+// Status returns HTTPResponse.Status
+func (r FindTimeSeriesByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindTimeSeriesByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTimeseriesByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTimeseriesByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTimeseriesByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDataFromTimeSeriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDataFromTimeSeriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDataFromTimeSeriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryTimeseriesForDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TsRow
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryTimeseriesForDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryTimeseriesForDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddDataToTimeseriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AddDataToTimeseriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddDataToTimeseriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindTimeseriesDataHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TsDataChange
+}
+
+// Status returns HTTPResponse.Status
+func (r FindTimeseriesDataHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindTimeseriesDataHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UndoTimeseriesDataChangeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UndoTimeseriesDataChangeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UndoTimeseriesDataChangeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindTimeseriesHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeseriesHealth
+}
+
+// Status returns HTTPResponse.Status
+func (r FindTimeseriesHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindTimeseriesHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindHealthRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TimeseriesHealthRule
+}
+
+// Status returns HTTPResponse.Status
+func (r FindHealthRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindHealthRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddHealthRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TimeseriesHealthRule
+}
+
+// Status returns HTTPResponse.Status
+func (r AddHealthRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddHealthRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteHealthRuleByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteHealthRuleByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteHealthRuleByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindHealthRuleByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TimeseriesHealthRule
+}
+
+// Status returns HTTPResponse.Status
+func (r FindHealthRuleByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindHealthRuleByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateHealthRuleByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateHealthRuleByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateHealthRuleByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportTsdataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TsImportResult
+	JSON201      *TsImportResult
+	JSON422      *TsImportResult
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportTsdataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportTsdataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindTsdataByQueryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TsResults
+}
+
+// Status returns HTTPResponse.Status
+func (r FindTsdataByQueryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindTsdataByQueryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]User
+}
+
+// Status returns HTTPResponse.Status
+func (r FindUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r AddUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WhoamiResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]User
+}
+
+// Status returns HTTPResponse.Status
+func (r WhoamiResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WhoamiResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUserByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindUserByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r FindUserByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindUserByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateUserByUuidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserByUuidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserByUuidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindPoliciesForUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Policy
+}
+
+// Status returns HTTPResponse.Status
+func (r FindPoliciesForUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindPoliciesForUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetRequestRateForUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SetRequestRateForUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetRequestRateForUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindTokensForUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Token
+}
+
+// Status returns HTTPResponse.Status
+func (r FindTokensForUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindTokensForUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddNewTokenToUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TokenWithSecret
+}
+
+// Status returns HTTPResponse.Status
+func (r AddNewTokenToUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddNewTokenToUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTokenForUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTokenForUserResponse) Status() string {
+	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTokenForUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// FindAlertGroupsWithResponse request returning *FindAlertGroupsResponse
+func (c *ClientWithResponses) FindAlertGroupsWithResponse(ctx context.Context, params *FindAlertGroupsParams, reqEditors ...RequestEditorFn) (*FindAlertGroupsResponse, error) {
+	rsp, err := c.FindAlertGroups(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindAlertGroupsResponse(rsp)
+}
+
+// AddAlertGroupWithBodyWithResponse request with arbitrary body returning *AddAlertGroupResponse
+func (c *ClientWithResponses) AddAlertGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddAlertGroupResponse, error) {
+	rsp, err := c.AddAlertGroupWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddAlertGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddAlertGroupWithResponse(ctx context.Context, body AddAlertGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AddAlertGroupResponse, error) {
+	rsp, err := c.AddAlertGroup(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddAlertGroupResponse(rsp)
+}
+
+// DeleteAlertGroupByUuidWithResponse request returning *DeleteAlertGroupByUuidResponse
+func (c *ClientWithResponses) DeleteAlertGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteAlertGroupByUuidResponse, error) {
+	rsp, err := c.DeleteAlertGroupByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAlertGroupByUuidResponse(rsp)
+}
+
+// FindAlertGroupByUuidWithResponse request returning *FindAlertGroupByUuidResponse
+func (c *ClientWithResponses) FindAlertGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindAlertGroupByUuidResponse, error) {
+	rsp, err := c.FindAlertGroupByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindAlertGroupByUuidResponse(rsp)
+}
+
+// UpdateAlertGroupByUuidWithBodyWithResponse request with arbitrary body returning *UpdateAlertGroupByUuidResponse
+func (c *ClientWithResponses) UpdateAlertGroupByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertGroupByUuidResponse, error) {
+	rsp, err := c.UpdateAlertGroupByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAlertGroupByUuidResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAlertGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateAlertGroupByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertGroupByUuidResponse, error) {
+	rsp, err := c.UpdateAlertGroupByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAlertGroupByUuidResponse(rsp)
+}
+
+// FindAlertsWithResponse request returning *FindAlertsResponse
+func (c *ClientWithResponses) FindAlertsWithResponse(ctx context.Context, params *FindAlertsParams, reqEditors ...RequestEditorFn) (*FindAlertsResponse, error) {
+	rsp, err := c.FindAlerts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindAlertsResponse(rsp)
+}
+
+// CreateAlertWithBodyWithResponse request with arbitrary body returning *CreateAlertResponse
+func (c *ClientWithResponses) CreateAlertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error) {
+	rsp, err := c.CreateAlertWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAlertResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAlertWithResponse(ctx context.Context, body CreateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error) {
+	rsp, err := c.CreateAlert(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAlertResponse(rsp)
+}
+
+// DeleteAlertByUuidWithResponse request returning *DeleteAlertByUuidResponse
+func (c *ClientWithResponses) DeleteAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteAlertByUuidResponse, error) {
+	rsp, err := c.DeleteAlertByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAlertByUuidResponse(rsp)
+}
+
+// FindAlertByUuidWithResponse request returning *FindAlertByUuidResponse
+func (c *ClientWithResponses) FindAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindAlertByUuidResponse, error) {
+	rsp, err := c.FindAlertByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindAlertByUuidResponse(rsp)
+}
+
+// UpdateAlertByUuidWithBodyWithResponse request with arbitrary body returning *UpdateAlertByUuidResponse
+func (c *ClientWithResponses) UpdateAlertByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertByUuidResponse, error) {
+	rsp, err := c.UpdateAlertByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAlertByUuidResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateAlertByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertByUuidResponse, error) {
+	rsp, err := c.UpdateAlertByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAlertByUuidResponse(rsp)
+}
+
+// AcknowledgeAlertWithBodyWithResponse request with arbitrary body returning *AcknowledgeAlertResponse
+func (c *ClientWithResponses) AcknowledgeAlertWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AcknowledgeAlertResponse, error) {
+	rsp, err := c.AcknowledgeAlertWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcknowledgeAlertResponse(rsp)
+}
+
+func (c *ClientWithResponses) AcknowledgeAlertWithResponse(ctx context.Context, uuid UuidParam, body AcknowledgeAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*AcknowledgeAlertResponse, error) {
+	rsp, err := c.AcknowledgeAlert(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcknowledgeAlertResponse(rsp)
+}
+
+// FindAlertHistoryWithResponse request returning *FindAlertHistoryResponse
+func (c *ClientWithResponses) FindAlertHistoryWithResponse(ctx context.Context, uuid UuidParam, params *FindAlertHistoryParams, reqEditors ...RequestEditorFn) (*FindAlertHistoryResponse, error) {
+	rsp, err := c.FindAlertHistory(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindAlertHistoryResponse(rsp)
+}
+
+// ShelveAlertWithBodyWithResponse request with arbitrary body returning *ShelveAlertResponse
+func (c *ClientWithResponses) ShelveAlertWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShelveAlertResponse, error) {
+	rsp, err := c.ShelveAlertWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShelveAlertResponse(rsp)
+}
+
+func (c *ClientWithResponses) ShelveAlertWithResponse(ctx context.Context, uuid UuidParam, body ShelveAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*ShelveAlertResponse, error) {
+	rsp, err := c.ShelveAlert(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShelveAlertResponse(rsp)
+}
+
+// UnacknowledgeAlertWithBodyWithResponse request with arbitrary body returning *UnacknowledgeAlertResponse
+func (c *ClientWithResponses) UnacknowledgeAlertWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnacknowledgeAlertResponse, error) {
+	rsp, err := c.UnacknowledgeAlertWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnacknowledgeAlertResponse(rsp)
+}
+
+func (c *ClientWithResponses) UnacknowledgeAlertWithResponse(ctx context.Context, uuid UuidParam, body UnacknowledgeAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*UnacknowledgeAlertResponse, error) {
+	rsp, err := c.UnacknowledgeAlert(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnacknowledgeAlertResponse(rsp)
+}
+
+// UnshelveAlertWithBodyWithResponse request with arbitrary body returning *UnshelveAlertResponse
+func (c *ClientWithResponses) UnshelveAlertWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnshelveAlertResponse, error) {
+	rsp, err := c.UnshelveAlertWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnshelveAlertResponse(rsp)
+}
+
+func (c *ClientWithResponses) UnshelveAlertWithResponse(ctx context.Context, uuid UuidParam, body UnshelveAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*UnshelveAlertResponse, error) {
+	rsp, err := c.UnshelveAlert(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnshelveAlertResponse(rsp)
+}
+
+// FindDatasetsWithResponse request returning *FindDatasetsResponse
+func (c *ClientWithResponses) FindDatasetsWithResponse(ctx context.Context, params *FindDatasetsParams, reqEditors ...RequestEditorFn) (*FindDatasetsResponse, error) {
+	rsp, err := c.FindDatasets(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindDatasetsResponse(rsp)
+}
+
+// AddDatasetsWithBodyWithResponse request with arbitrary body returning *AddDatasetsResponse
+func (c *ClientWithResponses) AddDatasetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDatasetsResponse, error) {
+	rsp, err := c.AddDatasetsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddDatasetsResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddDatasetsWithResponse(ctx context.Context, body AddDatasetsJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDatasetsResponse, error) {
+	rsp, err := c.AddDatasets(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddDatasetsResponse(rsp)
+}
+
+// DeleteDatasetByUuidWithResponse request returning *DeleteDatasetByUuidResponse
+func (c *ClientWithResponses) DeleteDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteDatasetByUuidResponse, error) {
+	rsp, err := c.DeleteDatasetByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatasetByUuidResponse(rsp)
+}
+
+// FindDatasetByUuidWithResponse request returning *FindDatasetByUuidResponse
+func (c *ClientWithResponses) FindDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindDatasetByUuidResponse, error) {
+	rsp, err := c.FindDatasetByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindDatasetByUuidResponse(rsp)
+}
+
+// UpdateDatasetByUuidWithBodyWithResponse request with arbitrary body returning *UpdateDatasetByUuidResponse
+func (c *ClientWithResponses) UpdateDatasetByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatasetByUuidResponse, error) {
+	rsp, err := c.UpdateDatasetByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatasetByUuidResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateDatasetByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatasetByUuidResponse, error) {
+	rsp, err := c.UpdateDatasetByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatasetByUuidResponse(rsp)
+}
+
+// AssembleDatasetPartsByKeyWithResponse request returning *AssembleDatasetPartsByKeyResponse
+func (c *ClientWithResponses) AssembleDatasetPartsByKeyWithResponse(ctx context.Context, uuid UuidParam, params *AssembleDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*AssembleDatasetPartsByKeyResponse, error) {
+	rsp, err := c.AssembleDatasetPartsByKey(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssembleDatasetPartsByKeyResponse(rsp)
+}
+
+// GetDatasetRevisionsDiffWithResponse request returning *GetDatasetRevisionsDiffResponse
+func (c *ClientWithResponses) GetDatasetRevisionsDiffWithResponse(ctx context.Context, uuid UuidParam, params *GetDatasetRevisionsDiffParams, reqEditors ...RequestEditorFn) (*GetDatasetRevisionsDiffResponse, error) {
+	rsp, err := c.GetDatasetRevisionsDiff(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatasetRevisionsDiffResponse(rsp)
+}
+
+// GetDatasetDocumentWithResponse request returning *GetDatasetDocumentResponse
+func (c *ClientWithResponses) GetDatasetDocumentWithResponse(ctx context.Context, uuid UuidParam, params *GetDatasetDocumentParams, reqEditors ...RequestEditorFn) (*GetDatasetDocumentResponse, error) {
+	rsp, err := c.GetDatasetDocument(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatasetDocumentResponse(rsp)
+}
+
+// ImportDatasetIntoTimeseriesWithBodyWithResponse request with arbitrary body returning *ImportDatasetIntoTimeseriesResponse
+func (c *ClientWithResponses) ImportDatasetIntoTimeseriesWithBodyWithResponse(ctx context.Context, uuid UuidParam, params *ImportDatasetIntoTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDatasetIntoTimeseriesResponse, error) {
+	rsp, err := c.ImportDatasetIntoTimeseriesWithBody(ctx, uuid, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportDatasetIntoTimeseriesResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportDatasetIntoTimeseriesWithResponse(ctx context.Context, uuid UuidParam, params *ImportDatasetIntoTimeseriesParams, body ImportDatasetIntoTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportDatasetIntoTimeseriesResponse, error) {
+	rsp, err := c.ImportDatasetIntoTimeseries(ctx, uuid, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportDatasetIntoTimeseriesResponse(rsp)
+}
+
+// ListDatasetPartsByKeyWithResponse request returning *ListDatasetPartsByKeyResponse
+func (c *ClientWithResponses) ListDatasetPartsByKeyWithResponse(ctx context.Context, uuid UuidParam, params *ListDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*ListDatasetPartsByKeyResponse, error) {
+	rsp, err := c.ListDatasetPartsByKey(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatasetPartsByKeyResponse(rsp)
+}
+
+// UploadDatasetContentByKeyWithResponse request returning *UploadDatasetContentByKeyResponse
+func (c *ClientWithResponses) UploadDatasetContentByKeyWithResponse(ctx context.Context, uuid UuidParam, params *UploadDatasetContentByKeyParams, reqEditors ...RequestEditorFn) (*UploadDatasetContentByKeyResponse, error) {
+	rsp, err := c.UploadDatasetContentByKey(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadDatasetContentByKeyResponse(rsp)
+}
+
+// GetRawDatasetByUuidWithResponse request returning *GetRawDatasetByUuidResponse
+func (c *ClientWithResponses) GetRawDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, params *GetRawDatasetByUuidParams, reqEditors ...RequestEditorFn) (*GetRawDatasetByUuidResponse, error) {
+	rsp, err := c.GetRawDatasetByUuid(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRawDatasetByUuidResponse(rsp)
+}
+
+// GetDatasetRevisionsWithResponse request returning *GetDatasetRevisionsResponse
+func (c *ClientWithResponses) GetDatasetRevisionsWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetDatasetRevisionsResponse, error) {
+	rsp, err := c.GetDatasetRevisions(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatasetRevisionsResponse(rsp)
+}
+
+// GetRawDatasetRevisionWithResponse request returning *GetRawDatasetRevisionResponse
+func (c *ClientWithResponses) GetRawDatasetRevisionWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*GetRawDatasetRevisionResponse, error) {
+	rsp, err := c.GetRawDatasetRevision(ctx, uuid, revisionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRawDatasetRevisionResponse(rsp)
+}
+
+// RollbackDatasetToRevisionWithResponse request returning *RollbackDatasetToRevisionResponse
+func (c *ClientWithResponses) RollbackDatasetToRevisionWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*RollbackDatasetToRevisionResponse, error) {
+	rsp, err := c.RollbackDatasetToRevision(ctx, uuid, revisionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRollbackDatasetToRevisionResponse(rsp)
+}
+
+// DeleteDatasetSchemaWithResponse request returning *DeleteDatasetSchemaResponse
+func (c *ClientWithResponses) DeleteDatasetSchemaWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteDatasetSchemaResponse, error) {
+	rsp, err := c.DeleteDatasetSchema(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatasetSchemaResponse(rsp)
+}
+
+// GetDatasetSchemaWithResponse request returning *GetDatasetSchemaResponse
+func (c *ClientWithResponses) GetDatasetSchemaWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetDatasetSchemaResponse, error) {
+	rsp, err := c.GetDatasetSchema(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatasetSchemaResponse(rsp)
+}
+
+// SetDatasetSchemaWithBodyWithResponse request with arbitrary body returning *SetDatasetSchemaResponse
+func (c *ClientWithResponses) SetDatasetSchemaWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetDatasetSchemaResponse, error) {
+	rsp, err := c.SetDatasetSchemaWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetDatasetSchemaResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetDatasetSchemaWithResponse(ctx context.Context, uuid UuidParam, body SetDatasetSchemaJSONRequestBody, reqEditors ...RequestEditorFn) (*SetDatasetSchemaResponse, error) {
+	rsp, err := c.SetDatasetSchema(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetDatasetSchemaResponse(rsp)
+}
+
+// DeleteDatasetUploadByKeyWithResponse request returning *DeleteDatasetUploadByKeyResponse
+func (c *ClientWithResponses) DeleteDatasetUploadByKeyWithResponse(ctx context.Context, uuid UuidParam, params *DeleteDatasetUploadByKeyParams, reqEditors ...RequestEditorFn) (*DeleteDatasetUploadByKeyResponse, error) {
+	rsp, err := c.DeleteDatasetUploadByKey(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatasetUploadByKeyResponse(rsp)
+}
+
+// InitializeDatasetUploadByUuidWithResponse request returning *InitializeDatasetUploadByUuidResponse
+func (c *ClientWithResponses) InitializeDatasetUploadByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*InitializeDatasetUploadByUuidResponse, error) {
+	rsp, err := c.InitializeDatasetUploadByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInitializeDatasetUploadByUuidResponse(rsp)
+}
+
+// FindGroupsWithResponse request returning *FindGroupsResponse
+func (c *ClientWithResponses) FindGroupsWithResponse(ctx context.Context, params *FindGroupsParams, reqEditors ...RequestEditorFn) (*FindGroupsResponse, error) {
+	rsp, err := c.FindGroups(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindGroupsResponse(rsp)
+}
+
+// AddGroupWithBodyWithResponse request with arbitrary body returning *AddGroupResponse
+func (c *ClientWithResponses) AddGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddGroupResponse, error) {
+	rsp, err := c.AddGroupWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddGroupResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddGroupWithResponse(ctx context.Context, body AddGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AddGroupResponse, error) {
+	rsp, err := c.AddGroup(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddGroupResponse(rsp)
+}
+
+// DeleteGroupByUuidWithResponse request returning *DeleteGroupByUuidResponse
+func (c *ClientWithResponses) DeleteGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteGroupByUuidResponse, error) {
+	rsp, err := c.DeleteGroupByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteGroupByUuidResponse(rsp)
+}
+
+// FindGroupByUuidWithResponse request returning *FindGroupByUuidResponse
+func (c *ClientWithResponses) FindGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindGroupByUuidResponse, error) {
+	rsp, err := c.FindGroupByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindGroupByUuidResponse(rsp)
+}
+
+// UpdateGroupByUuidWithBodyWithResponse request with arbitrary body returning *UpdateGroupByUuidResponse
+func (c *ClientWithResponses) UpdateGroupByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGroupByUuidResponse, error) {
+	rsp, err := c.UpdateGroupByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateGroupByUuidResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateGroupByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGroupByUuidResponse, error) {
+	rsp, err := c.UpdateGroupByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateGroupByUuidResponse(rsp)
+}
+
+// FindPoliciesForGroupWithResponse request returning *FindPoliciesForGroupResponse
+func (c *ClientWithResponses) FindPoliciesForGroupWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPoliciesForGroupResponse, error) {
+	rsp, err := c.FindPoliciesForGroup(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindPoliciesForGroupResponse(rsp)
+}
+
+// FindIncidentsWithResponse request returning *FindIncidentsResponse
+func (c *ClientWithResponses) FindIncidentsWithResponse(ctx context.Context, params *FindIncidentsParams, reqEditors ...RequestEditorFn) (*FindIncidentsResponse, error) {
+	rsp, err := c.FindIncidents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindIncidentsResponse(rsp)
+}
+
+// FindIncidentByUuidWithResponse request returning *FindIncidentByUuidResponse
+func (c *ClientWithResponses) FindIncidentByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindIncidentByUuidResponse, error) {
+	rsp, err := c.FindIncidentByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindIncidentByUuidResponse(rsp)
+}
+
+// AcknowledgeIncidentWithBodyWithResponse request with arbitrary body returning *AcknowledgeIncidentResponse
+func (c *ClientWithResponses) AcknowledgeIncidentWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AcknowledgeIncidentResponse, error) {
+	rsp, err := c.AcknowledgeIncidentWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcknowledgeIncidentResponse(rsp)
+}
+
+func (c *ClientWithResponses) AcknowledgeIncidentWithResponse(ctx context.Context, uuid UuidParam, body AcknowledgeIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*AcknowledgeIncidentResponse, error) {
+	rsp, err := c.AcknowledgeIncident(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcknowledgeIncidentResponse(rsp)
+}
+
+// FindIncidentAlertsWithResponse request returning *FindIncidentAlertsResponse
+func (c *ClientWithResponses) FindIncidentAlertsWithResponse(ctx context.Context, uuid UuidParam, params *FindIncidentAlertsParams, reqEditors ...RequestEditorFn) (*FindIncidentAlertsResponse, error) {
+	rsp, err := c.FindIncidentAlerts(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindIncidentAlertsResponse(rsp)
+}
+
+// CloseIncidentWithBodyWithResponse request with arbitrary body returning *CloseIncidentResponse
+func (c *ClientWithResponses) CloseIncidentWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloseIncidentResponse, error) {
+	rsp, err := c.CloseIncidentWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloseIncidentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CloseIncidentWithResponse(ctx context.Context, uuid UuidParam, body CloseIncidentJSONRequestBody, reqEditors ...RequestEditorFn) (*CloseIncidentResponse, error) {
+	rsp, err := c.CloseIncident(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloseIncidentResponse(rsp)
+}
+
+// FindIncidentHistoryWithResponse request returning *FindIncidentHistoryResponse
+func (c *ClientWithResponses) FindIncidentHistoryWithResponse(ctx context.Context, uuid UuidParam, params *FindIncidentHistoryParams, reqEditors ...RequestEditorFn) (*FindIncidentHistoryResponse, error) {
+	rsp, err := c.FindIncidentHistory(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindIncidentHistoryResponse(rsp)
+}
+
+// FindProgramJobsWithResponse request returning *FindProgramJobsResponse
+func (c *ClientWithResponses) FindProgramJobsWithResponse(ctx context.Context, params *FindProgramJobsParams, reqEditors ...RequestEditorFn) (*FindProgramJobsResponse, error) {
+	rsp, err := c.FindProgramJobs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindProgramJobsResponse(rsp)
+}
+
+// FindProgramJobByIdWithResponse request returning *FindProgramJobByIdResponse
+func (c *ClientWithResponses) FindProgramJobByIdWithResponse(ctx context.Context, jobId int64, reqEditors ...RequestEditorFn) (*FindProgramJobByIdResponse, error) {
+	rsp, err := c.FindProgramJobById(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindProgramJobByIdResponse(rsp)
+}
+
+// RequeueProgramJobWithResponse request returning *RequeueProgramJobResponse
+func (c *ClientWithResponses) RequeueProgramJobWithResponse(ctx context.Context, jobId int64, reqEditors ...RequestEditorFn) (*RequeueProgramJobResponse, error) {
+	rsp, err := c.RequeueProgramJob(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequeueProgramJobResponse(rsp)
+}
+
+// FindProgramJobRunsWithResponse request returning *FindProgramJobRunsResponse
+func (c *ClientWithResponses) FindProgramJobRunsWithResponse(ctx context.Context, jobId int64, reqEditors ...RequestEditorFn) (*FindProgramJobRunsResponse, error) {
+	rsp, err := c.FindProgramJobRuns(ctx, jobId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindProgramJobRunsResponse(rsp)
+}
+
+// FindMonitorsWithResponse request returning *FindMonitorsResponse
+func (c *ClientWithResponses) FindMonitorsWithResponse(ctx context.Context, params *FindMonitorsParams, reqEditors ...RequestEditorFn) (*FindMonitorsResponse, error) {
+	rsp, err := c.FindMonitors(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindMonitorsResponse(rsp)
+}
+
+// AddMonitorWithBodyWithResponse request with arbitrary body returning *AddMonitorResponse
+func (c *ClientWithResponses) AddMonitorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddMonitorResponse, error) {
+	rsp, err := c.AddMonitorWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddMonitorResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddMonitorWithResponse(ctx context.Context, body AddMonitorJSONRequestBody, reqEditors ...RequestEditorFn) (*AddMonitorResponse, error) {
+	rsp, err := c.AddMonitor(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddMonitorResponse(rsp)
+}
+
+// DeleteMonitorByUuidWithResponse request returning *DeleteMonitorByUuidResponse
+func (c *ClientWithResponses) DeleteMonitorByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteMonitorByUuidResponse, error) {
+	rsp, err := c.DeleteMonitorByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMonitorByUuidResponse(rsp)
+}
+
+// FindMonitorByUuidWithResponse request returning *FindMonitorByUuidResponse
+func (c *ClientWithResponses) FindMonitorByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindMonitorByUuidResponse, error) {
+	rsp, err := c.FindMonitorByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindMonitorByUuidResponse(rsp)
+}
+
+// UpdateMonitorByUuidWithBodyWithResponse request with arbitrary body returning *UpdateMonitorByUuidResponse
+func (c *ClientWithResponses) UpdateMonitorByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMonitorByUuidResponse, error) {
+	rsp, err := c.UpdateMonitorByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMonitorByUuidResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMonitorByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateMonitorByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMonitorByUuidResponse, error) {
+	rsp, err := c.UpdateMonitorByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMonitorByUuidResponse(rsp)
+}
+
+// FindNotificationChannelsWithResponse request returning *FindNotificationChannelsResponse
+func (c *ClientWithResponses) FindNotificationChannelsWithResponse(ctx context.Context, params *FindNotificationChannelsParams, reqEditors ...RequestEditorFn) (*FindNotificationChannelsResponse, error) {
+	rsp, err := c.FindNotificationChannels(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindNotificationChannelsResponse(rsp)
+}
+
+// AddNotificationChannelWithBodyWithResponse request with arbitrary body returning *AddNotificationChannelResponse
+func (c *ClientWithResponses) AddNotificationChannelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddNotificationChannelResponse, error) {
+	rsp, err := c.AddNotificationChannelWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddNotificationChannelResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddNotificationChannelWithResponse(ctx context.Context, body AddNotificationChannelJSONRequestBody, reqEditors ...RequestEditorFn) (*AddNotificationChannelResponse, error) {
+	rsp, err := c.AddNotificationChannel(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddNotificationChannelResponse(rsp)
+}
+
+// DeleteNotificationChannelByUuidWithResponse request returning *DeleteNotificationChannelByUuidResponse
+func (c *ClientWithResponses) DeleteNotificationChannelByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteNotificationChannelByUuidResponse, error) {
+	rsp, err := c.DeleteNotificationChannelByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteNotificationChannelByUuidResponse(rsp)
+}
+
+// FindNotificationChannelByUuidWithResponse request returning *FindNotificationChannelByUuidResponse
+func (c *ClientWithResponses) FindNotificationChannelByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindNotificationChannelByUuidResponse, error) {
+	rsp, err := c.FindNotificationChannelByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindNotificationChannelByUuidResponse(rsp)
+}
+
+// UpdateNotificationChannelByUuidWithBodyWithResponse request with arbitrary body returning *UpdateNotificationChannelByUuidResponse
+func (c *ClientWithResponses) UpdateNotificationChannelByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNotificationChannelByUuidResponse, error) {
+	rsp, err := c.UpdateNotificationChannelByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNotificationChannelByUuidResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateNotificationChannelByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateNotificationChannelByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNotificationChannelByUuidResponse, error) {
+	rsp, err := c.UpdateNotificationChannelByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNotificationChannelByUuidResponse(rsp)
+}
+
+// FindNotificationDeliveriesWithResponse request returning *FindNotificationDeliveriesResponse
+func (c *ClientWithResponses) FindNotificationDeliveriesWithResponse(ctx context.Context, params *FindNotificationDeliveriesParams, reqEditors ...RequestEditorFn) (*FindNotificationDeliveriesResponse, error) {
+	rsp, err := c.FindNotificationDeliveries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindNotificationDeliveriesResponse(rsp)
+}
+
+// FindNotificationRulesWithResponse request returning *FindNotificationRulesResponse
+func (c *ClientWithResponses) FindNotificationRulesWithResponse(ctx context.Context, params *FindNotificationRulesParams, reqEditors ...RequestEditorFn) (*FindNotificationRulesResponse, error) {
+	rsp, err := c.FindNotificationRules(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindNotificationRulesResponse(rsp)
+}
+
+// AddNotificationRuleWithBodyWithResponse request with arbitrary body returning *AddNotificationRuleResponse
+func (c *ClientWithResponses) AddNotificationRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddNotificationRuleResponse, error) {
+	rsp, err := c.AddNotificationRuleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddNotificationRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddNotificationRuleWithResponse(ctx context.Context, body AddNotificationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AddNotificationRuleResponse, error) {
+	rsp, err := c.AddNotificationRule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddNotificationRuleResponse(rsp)
+}
+
+// DeleteNotificationRuleByUuidWithResponse request returning *DeleteNotificationRuleByUuidResponse
+func (c *ClientWithResponses) DeleteNotificationRuleByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteNotificationRuleByUuidResponse, error) {
+	rsp, err := c.DeleteNotificationRuleByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteNotificationRuleByUuidResponse(rsp)
+}
+
+// FindNotificationRuleByUuidWithResponse request returning *FindNotificationRuleByUuidResponse
+func (c *ClientWithResponses) FindNotificationRuleByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindNotificationRuleByUuidResponse, error) {
+	rsp, err := c.FindNotificationRuleByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindNotificationRuleByUuidResponse(rsp)
+}
+
+// UpdateNotificationRuleByUuidWithBodyWithResponse request with arbitrary body returning *UpdateNotificationRuleByUuidResponse
+func (c *ClientWithResponses) UpdateNotificationRuleByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNotificationRuleByUuidResponse, error) {
+	rsp, err := c.UpdateNotificationRuleByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNotificationRuleByUuidResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateNotificationRuleByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateNotificationRuleByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNotificationRuleByUuidResponse, error) {
+	rsp, err := c.UpdateNotificationRuleByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNotificationRuleByUuidResponse(rsp)
+}
+
+// FindPoliciesWithResponse request returning *FindPoliciesResponse
+func (c *ClientWithResponses) FindPoliciesWithResponse(ctx context.Context, params *FindPoliciesParams, reqEditors ...RequestEditorFn) (*FindPoliciesResponse, error) {
+	rsp, err := c.FindPolicies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindPoliciesResponse(rsp)
+}
+
+// AddPolicyWithBodyWithResponse request with arbitrary body returning *AddPolicyResponse
+func (c *ClientWithResponses) AddPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPolicyResponse, error) {
+	rsp, err := c.AddPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddPolicyWithResponse(ctx context.Context, body AddPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPolicyResponse, error) {
+	rsp, err := c.AddPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddPolicyResponse(rsp)
+}
+
+// DeletePolicyByUuidWithResponse request returning *DeletePolicyByUuidResponse
+func (c *ClientWithResponses) DeletePolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeletePolicyByUuidResponse, error) {
+	rsp, err := c.DeletePolicyByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePolicyByUuidResponse(rsp)
+}
+
+// FindPolicyByUuidWithResponse request returning *FindPolicyByUuidResponse
+func (c *ClientWithResponses) FindPolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPolicyByUuidResponse, error) {
+	rsp, err := c.FindPolicyByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindPolicyByUuidResponse(rsp)
+}
+
+// UpdatePolicyByUuidWithBodyWithResponse request with arbitrary body returning *UpdatePolicyByUuidResponse
+func (c *ClientWithResponses) UpdatePolicyByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePolicyByUuidResponse, error) {
+	rsp, err := c.UpdatePolicyByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePolicyByUuidResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdatePolicyByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePolicyByUuidResponse, error) {
+	rsp, err := c.UpdatePolicyByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePolicyByUuidResponse(rsp)
+}
+
+// FindProgramsWithResponse request returning *FindProgramsResponse
+func (c *ClientWithResponses) FindProgramsWithResponse(ctx context.Context, params *FindProgramsParams, reqEditors ...RequestEditorFn) (*FindProgramsResponse, error) {
+	rsp, err := c.FindPrograms(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindProgramsResponse(rsp)
+}
+
+// AddProgramWithBodyWithResponse request with arbitrary body returning *AddProgramResponse
+func (c *ClientWithResponses) AddProgramWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProgramResponse, error) {
+	rsp, err := c.AddProgramWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddProgramResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddProgramWithResponse(ctx context.Context, body AddProgramJSONRequestBody, reqEditors ...RequestEditorFn) (*AddProgramResponse, error) {
+	rsp, err := c.AddProgram(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddProgramResponse(rsp)
+}
+
+// DeleteProgramByUuidWithResponse request returning *DeleteProgramByUuidResponse
+func (c *ClientWithResponses) DeleteProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteProgramByUuidResponse, error) {
+	rsp, err := c.DeleteProgramByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProgramByUuidResponse(rsp)
+}
+
+// FindProgramByUuidWithResponse request returning *FindProgramByUuidResponse
+func (c *ClientWithResponses) FindProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindProgramByUuidResponse, error) {
+	rsp, err := c.FindProgramByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindProgramByUuidResponse(rsp)
+}
+
+// UpdateProgramByUuidWithBodyWithResponse request with arbitrary body returning *UpdateProgramByUuidResponse
+func (c *ClientWithResponses) UpdateProgramByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProgramByUuidResponse, error) {
+	rsp, err := c.UpdateProgramByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProgramByUuidResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateProgramByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProgramByUuidResponse, error) {
+	rsp, err := c.UpdateProgramByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProgramByUuidResponse(rsp)
+}
+
+// GetCodeFromProgramWithResponse request returning *GetCodeFromProgramResponse
+func (c *ClientWithResponses) GetCodeFromProgramWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetCodeFromProgramResponse, error) {
+	rsp, err := c.GetCodeFromProgram(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCodeFromProgramResponse(rsp)
+}
+
+// AddProgramCodeRevisionWithBodyWithResponse request with arbitrary body returning *AddProgramCodeRevisionResponse
+func (c *ClientWithResponses) AddProgramCodeRevisionWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProgramCodeRevisionResponse, error) {
+	rsp, err := c.AddProgramCodeRevisionWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddProgramCodeRevisionResponse(rsp)
+}
+
+// GetProgramCodeRevisionsDiffWithResponse request returning *GetProgramCodeRevisionsDiffResponse
+func (c *ClientWithResponses) GetProgramCodeRevisionsDiffWithResponse(ctx context.Context, uuid UuidParam, params *GetProgramCodeRevisionsDiffParams, reqEditors ...RequestEditorFn) (*GetProgramCodeRevisionsDiffResponse, error) {
+	rsp, err := c.GetProgramCodeRevisionsDiff(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProgramCodeRevisionsDiffResponse(rsp)
+}
+
+// GetProgramCodeRevisionsWithResponse request returning *GetProgramCodeRevisionsResponse
+func (c *ClientWithResponses) GetProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetProgramCodeRevisionsResponse, error) {
+	rsp, err := c.GetProgramCodeRevisions(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProgramCodeRevisionsResponse(rsp)
+}
+
+// DeleteProgramCodeRevisionsWithResponse request returning *DeleteProgramCodeRevisionsResponse
+func (c *ClientWithResponses) DeleteProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*DeleteProgramCodeRevisionsResponse, error) {
+	rsp, err := c.DeleteProgramCodeRevisions(ctx, uuid, revisionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteProgramCodeRevisionsResponse(rsp)
+}
+
+// SignProgramCodeRevisionsWithResponse request returning *SignProgramCodeRevisionsResponse
+func (c *ClientWithResponses) SignProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*SignProgramCodeRevisionsResponse, error) {
+	rsp, err := c.SignProgramCodeRevisions(ctx, uuid, revisionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSignProgramCodeRevisionsResponse(rsp)
+}
+
+// RunProgramWithBodyWithResponse request with arbitrary body returning *RunProgramResponse
+func (c *ClientWithResponses) RunProgramWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunProgramResponse, error) {
+	rsp, err := c.RunProgramWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunProgramResponse(rsp)
+}
+
+func (c *ClientWithResponses) RunProgramWithResponse(ctx context.Context, uuid UuidParam, body RunProgramJSONRequestBody, reqEditors ...RequestEditorFn) (*RunProgramResponse, error) {
+	rsp, err := c.RunProgram(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunProgramResponse(rsp)
+}
+
+// FindProgramRunsWithResponse request returning *FindProgramRunsResponse
+func (c *ClientWithResponses) FindProgramRunsWithResponse(ctx context.Context, uuid UuidParam, params *FindProgramRunsParams, reqEditors ...RequestEditorFn) (*FindProgramRunsResponse, error) {
+	rsp, err := c.FindProgramRuns(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindProgramRunsResponse(rsp)
+}
+
+// FindProgramRunByIdWithResponse request returning *FindProgramRunByIdResponse
+func (c *ClientWithResponses) FindProgramRunByIdWithResponse(ctx context.Context, uuid UuidParam, runId int64, reqEditors ...RequestEditorFn) (*FindProgramRunByIdResponse, error) {
+	rsp, err := c.FindProgramRunById(ctx, uuid, runId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindProgramRunByIdResponse(rsp)
+}
+
+// ExecuteProgramWebhookWithResponse request returning *ExecuteProgramWebhookResponse
+func (c *ClientWithResponses) ExecuteProgramWebhookWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*ExecuteProgramWebhookResponse, error) {
+	rsp, err := c.ExecuteProgramWebhook(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExecuteProgramWebhookResponse(rsp)
+}
+
+// FindSilencesWithResponse request returning *FindSilencesResponse
+func (c *ClientWithResponses) FindSilencesWithResponse(ctx context.Context, params *FindSilencesParams, reqEditors ...RequestEditorFn) (*FindSilencesResponse, error) {
+	rsp, err := c.FindSilences(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindSilencesResponse(rsp)
+}
+
+// AddSilenceWithBodyWithResponse request with arbitrary body returning *AddSilenceResponse
+func (c *ClientWithResponses) AddSilenceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddSilenceResponse, error) {
+	rsp, err := c.AddSilenceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddSilenceResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddSilenceWithResponse(ctx context.Context, body AddSilenceJSONRequestBody, reqEditors ...RequestEditorFn) (*AddSilenceResponse, error) {
+	rsp, err := c.AddSilence(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddSilenceResponse(rsp)
+}
+
+// DeleteSilenceByUuidWithResponse request returning *DeleteSilenceByUuidResponse
+func (c *ClientWithResponses) DeleteSilenceByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteSilenceByUuidResponse, error) {
+	rsp, err := c.DeleteSilenceByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSilenceByUuidResponse(rsp)
+}
+
+// FindSilenceByUuidWithResponse request returning *FindSilenceByUuidResponse
+func (c *ClientWithResponses) FindSilenceByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindSilenceByUuidResponse, error) {
+	rsp, err := c.FindSilenceByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindSilenceByUuidResponse(rsp)
+}
+
+// UpdateSilenceByUuidWithBodyWithResponse request with arbitrary body returning *UpdateSilenceByUuidResponse
+func (c *ClientWithResponses) UpdateSilenceByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSilenceByUuidResponse, error) {
+	rsp, err := c.UpdateSilenceByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSilenceByUuidResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSilenceByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateSilenceByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSilenceByUuidResponse, error) {
+	rsp, err := c.UpdateSilenceByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSilenceByUuidResponse(rsp)
+}
+
+// FindThingTemplatesWithResponse request returning *FindThingTemplatesResponse
+func (c *ClientWithResponses) FindThingTemplatesWithResponse(ctx context.Context, params *FindThingTemplatesParams, reqEditors ...RequestEditorFn) (*FindThingTemplatesResponse, error) {
+	rsp, err := c.FindThingTemplates(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindThingTemplatesResponse(rsp)
+}
+
+// AddThingTemplateWithBodyWithResponse request with arbitrary body returning *AddThingTemplateResponse
+func (c *ClientWithResponses) AddThingTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddThingTemplateResponse, error) {
+	rsp, err := c.AddThingTemplateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddThingTemplateResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddThingTemplateWithResponse(ctx context.Context, body AddThingTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*AddThingTemplateResponse, error) {
+	rsp, err := c.AddThingTemplate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddThingTemplateResponse(rsp)
+}
+
+// DeleteThingTemplateByUuidWithResponse request returning *DeleteThingTemplateByUuidResponse
+func (c *ClientWithResponses) DeleteThingTemplateByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteThingTemplateByUuidResponse, error) {
+	rsp, err := c.DeleteThingTemplateByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteThingTemplateByUuidResponse(rsp)
+}
+
+// FindThingTemplateByUuidWithResponse request returning *FindThingTemplateByUuidResponse
+func (c *ClientWithResponses) FindThingTemplateByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindThingTemplateByUuidResponse, error) {
+	rsp, err := c.FindThingTemplateByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindThingTemplateByUuidResponse(rsp)
+}
+
+// UpdateThingTemplateByUuidWithBodyWithResponse request with arbitrary body returning *UpdateThingTemplateByUuidResponse
+func (c *ClientWithResponses) UpdateThingTemplateByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateThingTemplateByUuidResponse, error) {
+	rsp, err := c.UpdateThingTemplateByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateThingTemplateByUuidResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateThingTemplateByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateThingTemplateByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateThingTemplateByUuidResponse, error) {
+	rsp, err := c.UpdateThingTemplateByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateThingTemplateByUuidResponse(rsp)
+}
+
+// ApplyThingTemplateWithResponse request returning *ApplyThingTemplateResponse
+func (c *ClientWithResponses) ApplyThingTemplateWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*ApplyThingTemplateResponse, error) {
+	rsp, err := c.ApplyThingTemplate(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplyThingTemplateResponse(rsp)
+}
+
+// FindThingsWithResponse request returning *FindThingsResponse
+func (c *ClientWithResponses) FindThingsWithResponse(ctx context.Context, params *FindThingsParams, reqEditors ...RequestEditorFn) (*FindThingsResponse, error) {
+	rsp, err := c.FindThings(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindThingsResponse(rsp)
+}
+
+// AddThingWithBodyWithResponse request with arbitrary body returning *AddThingResponse
+func (c *ClientWithResponses) AddThingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddThingResponse, error) {
+	rsp, err := c.AddThingWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddThingResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddThingWithResponse(ctx context.Context, body AddThingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddThingResponse, error) {
+	rsp, err := c.AddThing(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddThingResponse(rsp)
+}
+
+// DeleteThingByUuidWithResponse request returning *DeleteThingByUuidResponse
+func (c *ClientWithResponses) DeleteThingByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteThingByUuidResponse, error) {
+	rsp, err := c.DeleteThingByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteThingByUuidResponse(rsp)
+}
+
+// FindThingByUuidWithResponse request returning *FindThingByUuidResponse
+func (c *ClientWithResponses) FindThingByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindThingByUuidResponse, error) {
+	rsp, err := c.FindThingByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindThingByUuidResponse(rsp)
+}
+
+// UpdateThingByUuidWithBodyWithResponse request with arbitrary body returning *UpdateThingByUuidResponse
+func (c *ClientWithResponses) UpdateThingByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateThingByUuidResponse, error) {
+	rsp, err := c.UpdateThingByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateThingByUuidResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateThingByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateThingByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateThingByUuidResponse, error) {
+	rsp, err := c.UpdateThingByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateThingByUuidResponse(rsp)
+}
+
+// FindAncestorsForThingWithResponse request returning *FindAncestorsForThingResponse
+func (c *ClientWithResponses) FindAncestorsForThingWithResponse(ctx context.Context, uuid UuidParam, params *FindAncestorsForThingParams, reqEditors ...RequestEditorFn) (*FindAncestorsForThingResponse, error) {
+	rsp, err := c.FindAncestorsForThing(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindAncestorsForThingResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateAlertResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FindChildrenForThingWithResponse request returning *FindChildrenForThingResponse
+func (c *ClientWithResponses) FindChildrenForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindChildrenForThingResponse, error) {
+	rsp, err := c.FindChildrenForThing(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFindChildrenForThingResponse(rsp)
 }
 
-type DeleteAlertByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// AddChildToThingWithBodyWithResponse request with arbitrary body returning *AddChildToThingResponse
+func (c *ClientWithResponses) AddChildToThingWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddChildToThingResponse, error) {
+	rsp, err := c.AddChildToThingWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddChildToThingResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteAlertByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) AddChildToThingWithResponse(ctx context.Context, uuid UuidParam, body AddChildToThingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddChildToThingResponse, error) {
+	rsp, err := c.AddChildToThing(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseAddChildToThingResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAlertByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// RemoveChildFromThingWithResponse request returning *RemoveChildFromThingResponse
+func (c *ClientWithResponses) RemoveChildFromThingWithResponse(ctx context.Context, uuid UuidParam, childUuid string, reqEditors ...RequestEditorFn) (*RemoveChildFromThingResponse, error) {
+	rsp, err := c.RemoveChildFromThing(ctx, uuid, childUuid, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseRemoveChildFromThingResponse(rsp)
 }
 
-type FindAlertByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Alert
+// FindDatasetsForThingWithResponse request returning *FindDatasetsForThingResponse
+func (c *ClientWithResponses) FindDatasetsForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindDatasetsForThingResponse, error) {
+	rsp, err := c.FindDatasetsForThing(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindDatasetsForThingResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r FindAlertByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FindDescendantsForThingWithResponse request returning *FindDescendantsForThingResponse
+func (c *ClientWithResponses) FindDescendantsForThingWithResponse(ctx context.Context, uuid UuidParam, params *FindDescendantsForThingParams, reqEditors ...RequestEditorFn) (*FindDescendantsForThingResponse, error) {
+	rsp, err := c.FindDescendantsForThing(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFindDescendantsForThingResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindAlertByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FindStateHistoryForThingWithResponse request returning *FindStateHistoryForThingResponse
+func (c *ClientWithResponses) FindStateHistoryForThingWithResponse(ctx context.Context, uuid UuidParam, params *FindStateHistoryForThingParams, reqEditors ...RequestEditorFn) (*FindStateHistoryForThingResponse, error) {
+	rsp, err := c.FindStateHistoryForThing(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFindStateHistoryForThingResponse(rsp)
 }
 
-type UpdateAlertByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// FindParentsForThingWithResponse request returning *FindParentsForThingResponse
+func (c *ClientWithResponses) FindParentsForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindParentsForThingResponse, error) {
+	rsp, err := c.FindParentsForThing(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindParentsForThingResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateAlertByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FindSubtreeForThingWithResponse request returning *FindSubtreeForThingResponse
+func (c *ClientWithResponses) FindSubtreeForThingWithResponse(ctx context.Context, uuid UuidParam, params *FindSubtreeForThingParams, reqEditors ...RequestEditorFn) (*FindSubtreeForThingResponse, error) {
+	rsp, err := c.FindSubtreeForThing(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFindSubtreeForThingResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAlertByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FindTimeSeriesForThingWithResponse request returning *FindTimeSeriesForThingResponse
+func (c *ClientWithResponses) FindTimeSeriesForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTimeSeriesForThingResponse, error) {
+	rsp, err := c.FindTimeSeriesForThing(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFindTimeSeriesForThingResponse(rsp)
 }
 
-type FindDatasetsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Dataset
+// DeleteThingTypeSchemaWithResponse request returning *DeleteThingTypeSchemaResponse
+func (c *ClientWithResponses) DeleteThingTypeSchemaWithResponse(ctx context.Context, params *DeleteThingTypeSchemaParams, reqEditors ...RequestEditorFn) (*DeleteThingTypeSchemaResponse, error) {
+	rsp, err := c.DeleteThingTypeSchema(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteThingTypeSchemaResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r FindDatasetsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FindThingTypeSchemasWithResponse request returning *FindThingTypeSchemasResponse
+func (c *ClientWithResponses) FindThingTypeSchemasWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FindThingTypeSchemasResponse, error) {
+	rsp, err := c.FindThingTypeSchemas(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFindThingTypeSchemasResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindDatasetsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// SetThingTypeSchemaWithBodyWithResponse request with arbitrary body returning *SetThingTypeSchemaResponse
+func (c *ClientWithResponses) SetThingTypeSchemaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetThingTypeSchemaResponse, error) {
+	rsp, err := c.SetThingTypeSchemaWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSetThingTypeSchemaResponse(rsp)
 }
 
-type AddDatasetsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Dataset
+func (c *ClientWithResponses) SetThingTypeSchemaWithResponse(ctx context.Context, body SetThingTypeSchemaJSONRequestBody, reqEditors ...RequestEditorFn) (*SetThingTypeSchemaResponse, error) {
+	rsp, err := c.SetThingTypeSchema(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetThingTypeSchemaResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r AddDatasetsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteThingStateTransitionsWithResponse request returning *DeleteThingStateTransitionsResponse
+func (c *ClientWithResponses) DeleteThingStateTransitionsWithResponse(ctx context.Context, params *DeleteThingStateTransitionsParams, reqEditors ...RequestEditorFn) (*DeleteThingStateTransitionsResponse, error) {
+	rsp, err := c.DeleteThingStateTransitions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteThingStateTransitionsResponse(rsp)
+}
+
+// FindThingStateTransitionsWithResponse request returning *FindThingStateTransitionsResponse
+func (c *ClientWithResponses) FindThingStateTransitionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*FindThingStateTransitionsResponse, error) {
+	rsp, err := c.FindThingStateTransitions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindThingStateTransitionsResponse(rsp)
+}
+
+// SetThingStateTransitionsWithBodyWithResponse request with arbitrary body returning *SetThingStateTransitionsResponse
+func (c *ClientWithResponses) SetThingStateTransitionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetThingStateTransitionsResponse, error) {
+	rsp, err := c.SetThingStateTransitionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetThingStateTransitionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetThingStateTransitionsWithResponse(ctx context.Context, body SetThingStateTransitionsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetThingStateTransitionsResponse, error) {
+	rsp, err := c.SetThingStateTransitions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetThingStateTransitionsResponse(rsp)
+}
+
+// FindTimeSeriesWithResponse request returning *FindTimeSeriesResponse
+func (c *ClientWithResponses) FindTimeSeriesWithResponse(ctx context.Context, params *FindTimeSeriesParams, reqEditors ...RequestEditorFn) (*FindTimeSeriesResponse, error) {
+	rsp, err := c.FindTimeSeries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindTimeSeriesResponse(rsp)
+}
+
+// AddTimeSeriesWithBodyWithResponse request with arbitrary body returning *AddTimeSeriesResponse
+func (c *ClientWithResponses) AddTimeSeriesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTimeSeriesResponse, error) {
+	rsp, err := c.AddTimeSeriesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTimeSeriesResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddTimeSeriesWithResponse(ctx context.Context, body AddTimeSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTimeSeriesResponse, error) {
+	rsp, err := c.AddTimeSeries(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTimeSeriesResponse(rsp)
+}
+
+// DeleteTimeSeriesByUuidWithResponse request returning *DeleteTimeSeriesByUuidResponse
+func (c *ClientWithResponses) DeleteTimeSeriesByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteTimeSeriesByUuidResponse, error) {
+	rsp, err := c.DeleteTimeSeriesByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTimeSeriesByUuidResponse(rsp)
+}
+
+// FindTimeSeriesByUuidWithResponse request returning *FindTimeSeriesByUuidResponse
+func (c *ClientWithResponses) FindTimeSeriesByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTimeSeriesByUuidResponse, error) {
+	rsp, err := c.FindTimeSeriesByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindTimeSeriesByUuidResponse(rsp)
+}
+
+// UpdateTimeseriesByUuidWithBodyWithResponse request with arbitrary body returning *UpdateTimeseriesByUuidResponse
+func (c *ClientWithResponses) UpdateTimeseriesByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error) {
+	rsp, err := c.UpdateTimeseriesByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTimeseriesByUuidResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTimeseriesByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateTimeseriesByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error) {
+	rsp, err := c.UpdateTimeseriesByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTimeseriesByUuidResponse(rsp)
+}
+
+// DeleteDataFromTimeSeriesWithResponse request returning *DeleteDataFromTimeSeriesResponse
+func (c *ClientWithResponses) DeleteDataFromTimeSeriesWithResponse(ctx context.Context, uuid UuidParam, params *DeleteDataFromTimeSeriesParams, reqEditors ...RequestEditorFn) (*DeleteDataFromTimeSeriesResponse, error) {
+	rsp, err := c.DeleteDataFromTimeSeries(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDataFromTimeSeriesResponse(rsp)
+}
+
+// QueryTimeseriesForDataWithResponse request returning *QueryTimeseriesForDataResponse
+func (c *ClientWithResponses) QueryTimeseriesForDataWithResponse(ctx context.Context, uuid UuidParam, params *QueryTimeseriesForDataParams, reqEditors ...RequestEditorFn) (*QueryTimeseriesForDataResponse, error) {
+	rsp, err := c.QueryTimeseriesForData(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryTimeseriesForDataResponse(rsp)
+}
+
+// AddDataToTimeseriesWithBodyWithResponse request with arbitrary body returning *AddDataToTimeseriesResponse
+func (c *ClientWithResponses) AddDataToTimeseriesWithBodyWithResponse(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDataToTimeseriesResponse, error) {
+	rsp, err := c.AddDataToTimeseriesWithBody(ctx, uuid, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddDataToTimeseriesResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddDataToTimeseriesWithResponse(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, body AddDataToTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDataToTimeseriesResponse, error) {
+	rsp, err := c.AddDataToTimeseries(ctx, uuid, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddDataToTimeseriesResponse(rsp)
+}
+
+// FindTimeseriesDataHistoryWithResponse request returning *FindTimeseriesDataHistoryResponse
+func (c *ClientWithResponses) FindTimeseriesDataHistoryWithResponse(ctx context.Context, uuid UuidParam, params *FindTimeseriesDataHistoryParams, reqEditors ...RequestEditorFn) (*FindTimeseriesDataHistoryResponse, error) {
+	rsp, err := c.FindTimeseriesDataHistory(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindTimeseriesDataHistoryResponse(rsp)
+}
+
+// UndoTimeseriesDataChangeWithResponse request returning *UndoTimeseriesDataChangeResponse
+func (c *ClientWithResponses) UndoTimeseriesDataChangeWithResponse(ctx context.Context, uuid UuidParam, changeId int64, reqEditors ...RequestEditorFn) (*UndoTimeseriesDataChangeResponse, error) {
+	rsp, err := c.UndoTimeseriesDataChange(ctx, uuid, changeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUndoTimeseriesDataChangeResponse(rsp)
+}
+
+// FindTimeseriesHealthWithResponse request returning *FindTimeseriesHealthResponse
+func (c *ClientWithResponses) FindTimeseriesHealthWithResponse(ctx context.Context, params *FindTimeseriesHealthParams, reqEditors ...RequestEditorFn) (*FindTimeseriesHealthResponse, error) {
+	rsp, err := c.FindTimeseriesHealth(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindTimeseriesHealthResponse(rsp)
+}
+
+// FindHealthRulesWithResponse request returning *FindHealthRulesResponse
+func (c *ClientWithResponses) FindHealthRulesWithResponse(ctx context.Context, params *FindHealthRulesParams, reqEditors ...RequestEditorFn) (*FindHealthRulesResponse, error) {
+	rsp, err := c.FindHealthRules(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindHealthRulesResponse(rsp)
+}
+
+// AddHealthRuleWithBodyWithResponse request with arbitrary body returning *AddHealthRuleResponse
+func (c *ClientWithResponses) AddHealthRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddHealthRuleResponse, error) {
+	rsp, err := c.AddHealthRuleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddHealthRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddHealthRuleWithResponse(ctx context.Context, body AddHealthRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*AddHealthRuleResponse, error) {
+	rsp, err := c.AddHealthRule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseAddHealthRuleResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddDatasetsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// DeleteHealthRuleByUuidWithResponse request returning *DeleteHealthRuleByUuidResponse
+func (c *ClientWithResponses) DeleteHealthRuleByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteHealthRuleByUuidResponse, error) {
+	rsp, err := c.DeleteHealthRuleByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseDeleteHealthRuleByUuidResponse(rsp)
 }
 
-type DeleteDatasetByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// FindHealthRuleByUuidWithResponse request returning *FindHealthRuleByUuidResponse
+func (c *ClientWithResponses) FindHealthRuleByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindHealthRuleByUuidResponse, error) {
+	rsp, err := c.FindHealthRuleByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindHealthRuleByUuidResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteDatasetByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateHealthRuleByUuidWithBodyWithResponse request with arbitrary body returning *UpdateHealthRuleByUuidResponse
+func (c *ClientWithResponses) UpdateHealthRuleByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateHealthRuleByUuidResponse, error) {
+	rsp, err := c.UpdateHealthRuleByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseUpdateHealthRuleByUuidResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatasetByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) UpdateHealthRuleByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateHealthRuleByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateHealthRuleByUuidResponse, error) {
+	rsp, err := c.UpdateHealthRuleByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateHealthRuleByUuidResponse(rsp)
 }
 
-type FindDatasetByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Dataset
+// ImportTsdataWithBodyWithResponse request with arbitrary body returning *ImportTsdataResponse
+func (c *ClientWithResponses) ImportTsdataWithBodyWithResponse(ctx context.Context, params *ImportTsdataParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTsdataResponse, error) {
+	rsp, err := c.ImportTsdataWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportTsdataResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r FindDatasetByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FindTsdataByQueryWithResponse request returning *FindTsdataByQueryResponse
+func (c *ClientWithResponses) FindTsdataByQueryWithResponse(ctx context.Context, params *FindTsdataByQueryParams, reqEditors ...RequestEditorFn) (*FindTsdataByQueryResponse, error) {
+	rsp, err := c.FindTsdataByQuery(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFindTsdataByQueryResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindDatasetByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// FindUsersWithResponse request returning *FindUsersResponse
+func (c *ClientWithResponses) FindUsersWithResponse(ctx context.Context, params *FindUsersParams, reqEditors ...RequestEditorFn) (*FindUsersResponse, error) {
+	rsp, err := c.FindUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseFindUsersResponse(rsp)
 }
 
-type UpdateDatasetByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// AddUserWithBodyWithResponse request with arbitrary body returning *AddUserResponse
+func (c *ClientWithResponses) AddUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddUserResponse, error) {
+	rsp, err := c.AddUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddUserResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateDatasetByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) AddUserWithResponse(ctx context.Context, body AddUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddUserResponse, error) {
+	rsp, err := c.AddUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseAddUserResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDatasetByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// WhoamiWithResponse request returning *WhoamiResponse
+func (c *ClientWithResponses) WhoamiWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WhoamiResponse, error) {
+	rsp, err := c.Whoami(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseWhoamiResponse(rsp)
 }
 
-type AssembleDatasetPartsByKeyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *struct {
-		Message string `json:"message"`
+// DeleteUserByUuidWithResponse request returning *DeleteUserByUuidResponse
+func (c *ClientWithResponses) DeleteUserByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteUserByUuidResponse, error) {
+	rsp, err := c.DeleteUserByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeleteUserByUuidResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r AssembleDatasetPartsByKeyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FindUserByUuidWithResponse request returning *FindUserByUuidResponse
+func (c *ClientWithResponses) FindUserByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindUserByUuidResponse, error) {
+	rsp, err := c.FindUserByUuid(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFindUserByUuidResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AssembleDatasetPartsByKeyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdateUserByUuidWithBodyWithResponse request with arbitrary body returning *UpdateUserByUuidResponse
+func (c *ClientWithResponses) UpdateUserByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserByUuidResponse, error) {
+	rsp, err := c.UpdateUserByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseUpdateUserByUuidResponse(rsp)
 }
 
-type ListDatasetPartsByKeyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+func (c *ClientWithResponses) UpdateUserByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateUserByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserByUuidResponse, error) {
+	rsp, err := c.UpdateUserByUuid(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserByUuidResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r ListDatasetPartsByKeyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FindPoliciesForUserWithResponse request returning *FindPoliciesForUserResponse
+func (c *ClientWithResponses) FindPoliciesForUserWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPoliciesForUserResponse, error) {
+	rsp, err := c.FindPoliciesForUser(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFindPoliciesForUserResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatasetPartsByKeyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// SetRequestRateForUserWithBodyWithResponse request with arbitrary body returning *SetRequestRateForUserResponse
+func (c *ClientWithResponses) SetRequestRateForUserWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetRequestRateForUserResponse, error) {
+	rsp, err := c.SetRequestRateForUserWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseSetRequestRateForUserResponse(rsp)
 }
 
-type UploadDatasetContentByKeyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Message string `json:"message"`
+func (c *ClientWithResponses) SetRequestRateForUserWithResponse(ctx context.Context, uuid UuidParam, body SetRequestRateForUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SetRequestRateForUserResponse, error) {
+	rsp, err := c.SetRequestRateForUser(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseSetRequestRateForUserResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r UploadDatasetContentByKeyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// FindTokensForUserWithResponse request returning *FindTokensForUserResponse
+func (c *ClientWithResponses) FindTokensForUserWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTokensForUserResponse, error) {
+	rsp, err := c.FindTokensForUser(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseFindTokensForUserResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UploadDatasetContentByKeyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// AddNewTokenToUserWithBodyWithResponse request with arbitrary body returning *AddNewTokenToUserResponse
+func (c *ClientWithResponses) AddNewTokenToUserWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddNewTokenToUserResponse, error) {
+	rsp, err := c.AddNewTokenToUserWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseAddNewTokenToUserResponse(rsp)
 }
 
-type GetRawDatasetByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *string
-	XML200       *string
-	YAML200      *string
+func (c *ClientWithResponses) AddNewTokenToUserWithResponse(ctx context.Context, uuid UuidParam, body AddNewTokenToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddNewTokenToUserResponse, error) {
+	rsp, err := c.AddNewTokenToUser(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddNewTokenToUserResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetRawDatasetByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// DeleteTokenForUserWithResponse request returning *DeleteTokenForUserResponse
+func (c *ClientWithResponses) DeleteTokenForUserWithResponse(ctx context.Context, uuid UuidParam, tokenUuid string, reqEditors ...RequestEditorFn) (*DeleteTokenForUserResponse, error) {
+	rsp, err := c.DeleteTokenForUser(ctx, uuid, tokenUuid, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseDeleteTokenForUserResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetRawDatasetByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseFindAlertGroupsResponse parses an HTTP response from a FindAlertGroupsWithResponse call
+func ParseFindAlertGroupsResponse(rsp *http.Response) (*FindAlertGroupsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteDatasetUploadByKeyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	response := &FindAlertGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AlertGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteDatasetUploadByKeyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatasetUploadByKeyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseAddAlertGroupResponse parses an HTTP response from a AddAlertGroupWithResponse call
+func ParseAddAlertGroupResponse(rsp *http.Response) (*AddAlertGroupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type InitializeDatasetUploadByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		UploadId *string `json:"uploadId,omitempty"`
+	response := &AddAlertGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AlertGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r InitializeDatasetUploadByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteAlertGroupByUuidResponse parses an HTTP response from a DeleteAlertGroupByUuidWithResponse call
+func ParseDeleteAlertGroupByUuidResponse(rsp *http.Response) (*DeleteAlertGroupByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r InitializeDatasetUploadByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteAlertGroupByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type FindGroupsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Group
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r FindGroupsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseFindAlertGroupByUuidResponse parses an HTTP response from a FindAlertGroupByUuidWithResponse call
+func ParseFindAlertGroupByUuidResponse(rsp *http.Response) (*FindAlertGroupByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindGroupsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &FindAlertGroupByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type AddGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Group
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r AddGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUpdateAlertGroupByUuidResponse parses an HTTP response from a UpdateAlertGroupByUuidWithResponse call
+func ParseUpdateAlertGroupByUuidResponse(rsp *http.Response) (*UpdateAlertGroupByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteGroupByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	response := &UpdateAlertGroupByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteGroupByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseFindAlertsResponse parses an HTTP response from a FindAlertsWithResponse call
+func ParseFindAlertsResponse(rsp *http.Response) (*FindAlertsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteGroupByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &FindAlertsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type FindGroupByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Group
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Alert
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r FindGroupByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindGroupByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type UpdateGroupByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// ParseCreateAlertResponse parses an HTTP response from a CreateAlertWithResponse call
+func ParseCreateAlertResponse(rsp *http.Response) (*CreateAlertResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateGroupByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &CreateAlertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateGroupByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest NewAlertReply
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return 0
-}
 
-type FindPoliciesForGroupResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Policy
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r FindPoliciesForGroupResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteAlertByUuidResponse parses an HTTP response from a DeleteAlertByUuidWithResponse call
+func ParseDeleteAlertByUuidResponse(rsp *http.Response) (*DeleteAlertByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindPoliciesForGroupResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteAlertByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type FindPoliciesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Policy
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r FindPoliciesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseFindAlertByUuidResponse parses an HTTP response from a FindAlertByUuidWithResponse call
+func ParseFindAlertByUuidResponse(rsp *http.Response) (*FindAlertByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindPoliciesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &FindAlertByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type AddPolicyResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Policy
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Alert
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r AddPolicyResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddPolicyResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUpdateAlertByUuidResponse parses an HTTP response from a UpdateAlertByUuidWithResponse call
+func ParseUpdateAlertByUuidResponse(rsp *http.Response) (*UpdateAlertByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAlertByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeletePolicyByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeletePolicyByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseAcknowledgeAlertResponse parses an HTTP response from a AcknowledgeAlertWithResponse call
+func ParseAcknowledgeAlertResponse(rsp *http.Response) (*AcknowledgeAlertResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeletePolicyByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &AcknowledgeAlertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type FindPolicyByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Policy
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r FindPolicyByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseFindAlertHistoryResponse parses an HTTP response from a FindAlertHistoryWithResponse call
+func ParseFindAlertHistoryResponse(rsp *http.Response) (*FindAlertHistoryResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindPolicyByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &FindAlertHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdatePolicyByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AlertHistoryEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdatePolicyByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePolicyByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseShelveAlertResponse parses an HTTP response from a ShelveAlertWithResponse call
+func ParseShelveAlertResponse(rsp *http.Response) (*ShelveAlertResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type FindProgramsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Program
+	response := &ShelveAlertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r FindProgramsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUnacknowledgeAlertResponse parses an HTTP response from a UnacknowledgeAlertWithResponse call
+func ParseUnacknowledgeAlertResponse(rsp *http.Response) (*UnacknowledgeAlertResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindProgramsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UnacknowledgeAlertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type AddProgramResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Program
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AddProgramResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUnshelveAlertResponse parses an HTTP response from a UnshelveAlertWithResponse call
+func ParseUnshelveAlertResponse(rsp *http.Response) (*UnshelveAlertResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddProgramResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UnshelveAlertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteProgramByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteProgramByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseFindDatasetsResponse parses an HTTP response from a FindDatasetsWithResponse call
+func ParseFindDatasetsResponse(rsp *http.Response) (*FindDatasetsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProgramByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &FindDatasetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type FindProgramByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Program
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Dataset
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r FindProgramByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindProgramByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseAddDatasetsResponse parses an HTTP response from a AddDatasetsWithResponse call
+func ParseAddDatasetsResponse(rsp *http.Response) (*AddDatasetsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateProgramByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	response := &AddDatasetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Dataset
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateProgramByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateProgramByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDeleteDatasetByUuidResponse parses an HTTP response from a DeleteDatasetByUuidWithResponse call
+func ParseDeleteDatasetByUuidResponse(rsp *http.Response) (*DeleteDatasetByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetCodeFromProgramResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Program
+	response := &DeleteDatasetByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetCodeFromProgramResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseFindDatasetByUuidResponse parses an HTTP response from a FindDatasetByUuidWithResponse call
+func ParseFindDatasetByUuidResponse(rsp *http.Response) (*FindDatasetByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCodeFromProgramResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &FindDatasetByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type AddProgramCodeRevisionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CodeRevision
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Dataset
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r AddProgramCodeRevisionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddProgramCodeRevisionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUpdateDatasetByUuidResponse parses an HTTP response from a UpdateDatasetByUuidWithResponse call
+func ParseUpdateDatasetByUuidResponse(rsp *http.Response) (*UpdateDatasetByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetProgramCodeRevisionsDiffResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	response := &UpdateDatasetByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetProgramCodeRevisionsDiffResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseAssembleDatasetPartsByKeyResponse parses an HTTP response from a AssembleDatasetPartsByKeyWithResponse call
+func ParseAssembleDatasetPartsByKeyResponse(rsp *http.Response) (*AssembleDatasetPartsByKeyResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProgramCodeRevisionsDiffResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &AssembleDatasetPartsByKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetProgramCodeRevisionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]CodeRevision
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetProgramCodeRevisionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProgramCodeRevisionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseGetDatasetRevisionsDiffResponse parses an HTTP response from a GetDatasetRevisionsDiffWithResponse call
+func ParseGetDatasetRevisionsDiffResponse(rsp *http.Response) (*GetDatasetRevisionsDiffResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteProgramCodeRevisionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	response := &GetDatasetRevisionsDiffResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteProgramCodeRevisionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetDatasetDocumentResponse parses an HTTP response from a GetDatasetDocumentWithResponse call
+func ParseGetDatasetDocumentResponse(rsp *http.Response) (*GetDatasetDocumentResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteProgramCodeRevisionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetDatasetDocumentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type SignProgramCodeRevisionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r SignProgramCodeRevisionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest string
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/toml) unsupported
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SignProgramCodeRevisionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type ExecuteProgramWebhookResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ExecuteProgramWebhookResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseImportDatasetIntoTimeseriesResponse parses an HTTP response from a ImportDatasetIntoTimeseriesWithResponse call
+func ParseImportDatasetIntoTimeseriesResponse(rsp *http.Response) (*ImportDatasetIntoTimeseriesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ExecuteProgramWebhookResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ImportDatasetIntoTimeseriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type FindThingsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Thing
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TsImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r FindThingsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TsImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest TsImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindThingsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type AddThingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Thing
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AddThingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListDatasetPartsByKeyResponse parses an HTTP response from a ListDatasetPartsByKeyWithResponse call
+func ParseListDatasetPartsByKeyResponse(rsp *http.Response) (*ListDatasetPartsByKeyResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddThingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListDatasetPartsByKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteThingByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteThingByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUploadDatasetContentByKeyResponse parses an HTTP response from a UploadDatasetContentByKeyWithResponse call
+func ParseUploadDatasetContentByKeyResponse(rsp *http.Response) (*UploadDatasetContentByKeyResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteThingByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UploadDatasetContentByKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type FindThingByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Thing
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r FindThingByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetRawDatasetByUuidResponse parses an HTTP response from a GetRawDatasetByUuidWithResponse call
+func ParseGetRawDatasetByUuidResponse(rsp *http.Response) (*GetRawDatasetByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindThingByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetRawDatasetByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateThingByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateThingByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 206:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON206 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateThingByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
+		var dest string
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML200 = &dest
 
-type FindDatasetsForThingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Timeseries
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 206:
+		var dest string
+		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.XML206 = &dest
 
-// Status returns HTTPResponse.Status
-func (r FindDatasetsForThingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest string
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindDatasetsForThingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 206:
+		var dest string
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML206 = &dest
 
-type FindTimeSeriesForThingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Timeseries
-}
+	case rsp.StatusCode == 200:
+	// Content-type (text/plain; charset=utf-8) unsupported
 
-// Status returns HTTPResponse.Status
-func (r FindTimeSeriesForThingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case rsp.StatusCode == 206:
+		// Content-type (text/plain; charset=utf-8) unsupported
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindTimeSeriesForThingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type FindTimeSeriesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Timeseries
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r FindTimeSeriesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetDatasetRevisionsResponse parses an HTTP response from a GetDatasetRevisionsWithResponse call
+func ParseGetDatasetRevisionsResponse(rsp *http.Response) (*GetDatasetRevisionsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindTimeSeriesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetDatasetRevisionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type AddTimeSeriesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Timeseries
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DatasetRevision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r AddTimeSeriesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddTimeSeriesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseGetRawDatasetRevisionResponse parses an HTTP response from a GetRawDatasetRevisionWithResponse call
+func ParseGetRawDatasetRevisionResponse(rsp *http.Response) (*GetRawDatasetRevisionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteTimeSeriesByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	response := &GetRawDatasetRevisionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteTimeSeriesByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseRollbackDatasetToRevisionResponse parses an HTTP response from a RollbackDatasetToRevisionWithResponse call
+func ParseRollbackDatasetToRevisionResponse(rsp *http.Response) (*RollbackDatasetToRevisionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTimeSeriesByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &RollbackDatasetToRevisionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type FindTimeSeriesByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Timeseries
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r FindTimeSeriesByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteDatasetSchemaResponse parses an HTTP response from a DeleteDatasetSchemaWithResponse call
+func ParseDeleteDatasetSchemaResponse(rsp *http.Response) (*DeleteDatasetSchemaResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindTimeSeriesByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteDatasetSchemaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateTimeseriesByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateTimeseriesByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetDatasetSchemaResponse parses an HTTP response from a GetDatasetSchemaWithResponse call
+func ParseGetDatasetSchemaResponse(rsp *http.Response) (*GetDatasetSchemaResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTimeseriesByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetDatasetSchemaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteDataFromTimeSeriesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DatasetSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteDataFromTimeSeriesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseSetDatasetSchemaResponse parses an HTTP response from a SetDatasetSchemaWithResponse call
+func ParseSetDatasetSchemaResponse(rsp *http.Response) (*SetDatasetSchemaResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDataFromTimeSeriesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &SetDatasetSchemaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type QueryTimeseriesForDataResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]TsRow
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r QueryTimeseriesForDataResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteDatasetUploadByKeyResponse parses an HTTP response from a DeleteDatasetUploadByKeyWithResponse call
+func ParseDeleteDatasetUploadByKeyResponse(rsp *http.Response) (*DeleteDatasetUploadByKeyResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryTimeseriesForDataResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteDatasetUploadByKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type AddDataToTimeseriesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AddDataToTimeseriesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseInitializeDatasetUploadByUuidResponse parses an HTTP response from a InitializeDatasetUploadByUuidWithResponse call
+func ParseInitializeDatasetUploadByUuidResponse(rsp *http.Response) (*InitializeDatasetUploadByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddDataToTimeseriesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &InitializeDatasetUploadByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type FindTsdataByQueryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]TsResults
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			UploadId *string `json:"uploadId,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r FindTsdataByQueryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindTsdataByQueryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseFindGroupsResponse parses an HTTP response from a FindGroupsWithResponse call
+func ParseFindGroupsResponse(rsp *http.Response) (*FindGroupsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type FindUsersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]User
-}
+	response := &FindGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r FindUsersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindUsersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseAddGroupResponse parses an HTTP response from a AddGroupWithResponse call
+func ParseAddGroupResponse(rsp *http.Response) (*AddGroupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type AddUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *User
-}
+	response := &AddGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r AddUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDeleteGroupByUuidResponse parses an HTTP response from a DeleteGroupByUuidWithResponse call
+func ParseDeleteGroupByUuidResponse(rsp *http.Response) (*DeleteGroupByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type WhoamiResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]User
+	response := &DeleteGroupByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r WhoamiResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseFindGroupByUuidResponse parses an HTTP response from a FindGroupByUuidWithResponse call
+func ParseFindGroupByUuidResponse(rsp *http.Response) (*FindGroupByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r WhoamiResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &FindGroupByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteUserByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteUserByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteUserByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUpdateGroupByUuidResponse parses an HTTP response from a UpdateGroupByUuidWithResponse call
+func ParseUpdateGroupByUuidResponse(rsp *http.Response) (*UpdateGroupByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type FindUserByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
+	response := &UpdateGroupByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r FindUserByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseFindPoliciesForGroupResponse parses an HTTP response from a FindPoliciesForGroupWithResponse call
+func ParseFindPoliciesForGroupResponse(rsp *http.Response) (*FindPoliciesForGroupResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindUserByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &FindPoliciesForGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Policy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type UpdateUserByUuidResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateUserByUuidResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseFindIncidentsResponse parses an HTTP response from a FindIncidentsWithResponse call
+func ParseFindIncidentsResponse(rsp *http.Response) (*FindIncidentsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateUserByUuidResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &FindIncidentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type FindPoliciesForUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Policy
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Incident
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r FindPoliciesForUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindPoliciesForUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type SetRequestRateForUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// ParseFindIncidentByUuidResponse parses an HTTP response from a FindIncidentByUuidWithResponse call
+func ParseFindIncidentByUuidResponse(rsp *http.Response) (*FindIncidentByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r SetRequestRateForUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &FindIncidentByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SetRequestRateForUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Incident
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type FindTokensForUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Token
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r FindTokensForUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseAcknowledgeIncidentResponse parses an HTTP response from a AcknowledgeIncidentWithResponse call
+func ParseAcknowledgeIncidentResponse(rsp *http.Response) (*AcknowledgeIncidentResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FindTokensForUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &AcknowledgeIncidentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type AddNewTokenToUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *TokenWithSecret
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AddNewTokenToUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseFindIncidentAlertsResponse parses an HTTP response from a FindIncidentAlertsWithResponse call
+func ParseFindIncidentAlertsResponse(rsp *http.Response) (*FindIncidentAlertsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddNewTokenToUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &FindIncidentAlertsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteTokenForUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Alert
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteTokenForUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTokenForUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// FindAlertsWithResponse request returning *FindAlertsResponse
-func (c *ClientWithResponses) FindAlertsWithResponse(ctx context.Context, params *FindAlertsParams, reqEditors ...RequestEditorFn) (*FindAlertsResponse, error) {
-	rsp, err := c.FindAlerts(ctx, params, reqEditors...)
+// ParseCloseIncidentResponse parses an HTTP response from a CloseIncidentWithResponse call
+func ParseCloseIncidentResponse(rsp *http.Response) (*CloseIncidentResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindAlertsResponse(rsp)
-}
 
-// CreateAlertWithBodyWithResponse request with arbitrary body returning *CreateAlertResponse
-func (c *ClientWithResponses) CreateAlertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error) {
-	rsp, err := c.CreateAlertWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CloseIncidentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateAlertResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateAlertWithResponse(ctx context.Context, body CreateAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertResponse, error) {
-	rsp, err := c.CreateAlert(ctx, body, reqEditors...)
+// ParseFindIncidentHistoryResponse parses an HTTP response from a FindIncidentHistoryWithResponse call
+func ParseFindIncidentHistoryResponse(rsp *http.Response) (*FindIncidentHistoryResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAlertResponse(rsp)
-}
 
-// DeleteAlertByUuidWithResponse request returning *DeleteAlertByUuidResponse
-func (c *ClientWithResponses) DeleteAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteAlertByUuidResponse, error) {
-	rsp, err := c.DeleteAlertByUuid(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindIncidentHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteAlertByUuidResponse(rsp)
-}
 
-// FindAlertByUuidWithResponse request returning *FindAlertByUuidResponse
-func (c *ClientWithResponses) FindAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindAlertByUuidResponse, error) {
-	rsp, err := c.FindAlertByUuid(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []IncidentHistoryEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseFindAlertByUuidResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateAlertByUuidWithBodyWithResponse request with arbitrary body returning *UpdateAlertByUuidResponse
-func (c *ClientWithResponses) UpdateAlertByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertByUuidResponse, error) {
-	rsp, err := c.UpdateAlertByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+// ParseFindProgramJobsResponse parses an HTTP response from a FindProgramJobsWithResponse call
+func ParseFindProgramJobsResponse(rsp *http.Response) (*FindProgramJobsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAlertByUuidResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateAlertByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateAlertByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertByUuidResponse, error) {
-	rsp, err := c.UpdateAlertByUuid(ctx, uuid, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindProgramJobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateAlertByUuidResponse(rsp)
-}
 
-// FindDatasetsWithResponse request returning *FindDatasetsResponse
-func (c *ClientWithResponses) FindDatasetsWithResponse(ctx context.Context, params *FindDatasetsParams, reqEditors ...RequestEditorFn) (*FindDatasetsResponse, error) {
-	rsp, err := c.FindDatasets(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProgramJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseFindDatasetsResponse(rsp)
+
+	return response, nil
 }
 
-// AddDatasetsWithBodyWithResponse request with arbitrary body returning *AddDatasetsResponse
-func (c *ClientWithResponses) AddDatasetsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDatasetsResponse, error) {
-	rsp, err := c.AddDatasetsWithBody(ctx, contentType, body, reqEditors...)
+// ParseFindProgramJobByIdResponse parses an HTTP response from a FindProgramJobByIdWithResponse call
+func ParseFindProgramJobByIdResponse(rsp *http.Response) (*FindProgramJobByIdResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddDatasetsResponse(rsp)
+
+	response := &FindProgramJobByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProgramJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) AddDatasetsWithResponse(ctx context.Context, body AddDatasetsJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDatasetsResponse, error) {
-	rsp, err := c.AddDatasets(ctx, body, reqEditors...)
+// ParseRequeueProgramJobResponse parses an HTTP response from a RequeueProgramJobWithResponse call
+func ParseRequeueProgramJobResponse(rsp *http.Response) (*RequeueProgramJobResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddDatasetsResponse(rsp)
-}
 
-// DeleteDatasetByUuidWithResponse request returning *DeleteDatasetByUuidResponse
-func (c *ClientWithResponses) DeleteDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteDatasetByUuidResponse, error) {
-	rsp, err := c.DeleteDatasetByUuid(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &RequeueProgramJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteDatasetByUuidResponse(rsp)
+
+	return response, nil
 }
 
-// FindDatasetByUuidWithResponse request returning *FindDatasetByUuidResponse
-func (c *ClientWithResponses) FindDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindDatasetByUuidResponse, error) {
-	rsp, err := c.FindDatasetByUuid(ctx, uuid, reqEditors...)
+// ParseFindProgramJobRunsResponse parses an HTTP response from a FindProgramJobRunsWithResponse call
+func ParseFindProgramJobRunsResponse(rsp *http.Response) (*FindProgramJobRunsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindDatasetByUuidResponse(rsp)
-}
 
-// UpdateDatasetByUuidWithBodyWithResponse request with arbitrary body returning *UpdateDatasetByUuidResponse
-func (c *ClientWithResponses) UpdateDatasetByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatasetByUuidResponse, error) {
-	rsp, err := c.UpdateDatasetByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindProgramJobRunsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateDatasetByUuidResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateDatasetByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatasetByUuidResponse, error) {
-	rsp, err := c.UpdateDatasetByUuid(ctx, uuid, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProgramRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateDatasetByUuidResponse(rsp)
+
+	return response, nil
 }
 
-// AssembleDatasetPartsByKeyWithResponse request returning *AssembleDatasetPartsByKeyResponse
-func (c *ClientWithResponses) AssembleDatasetPartsByKeyWithResponse(ctx context.Context, uuid UuidParam, params *AssembleDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*AssembleDatasetPartsByKeyResponse, error) {
-	rsp, err := c.AssembleDatasetPartsByKey(ctx, uuid, params, reqEditors...)
+// ParseFindMonitorsResponse parses an HTTP response from a FindMonitorsWithResponse call
+func ParseFindMonitorsResponse(rsp *http.Response) (*FindMonitorsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAssembleDatasetPartsByKeyResponse(rsp)
-}
 
-// ListDatasetPartsByKeyWithResponse request returning *ListDatasetPartsByKeyResponse
-func (c *ClientWithResponses) ListDatasetPartsByKeyWithResponse(ctx context.Context, uuid UuidParam, params *ListDatasetPartsByKeyParams, reqEditors ...RequestEditorFn) (*ListDatasetPartsByKeyResponse, error) {
-	rsp, err := c.ListDatasetPartsByKey(ctx, uuid, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindMonitorsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListDatasetPartsByKeyResponse(rsp)
-}
 
-// UploadDatasetContentByKeyWithResponse request returning *UploadDatasetContentByKeyResponse
-func (c *ClientWithResponses) UploadDatasetContentByKeyWithResponse(ctx context.Context, uuid UuidParam, params *UploadDatasetContentByKeyParams, reqEditors ...RequestEditorFn) (*UploadDatasetContentByKeyResponse, error) {
-	rsp, err := c.UploadDatasetContentByKey(ctx, uuid, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Monitor
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUploadDatasetContentByKeyResponse(rsp)
+
+	return response, nil
 }
 
-// GetRawDatasetByUuidWithResponse request returning *GetRawDatasetByUuidResponse
-func (c *ClientWithResponses) GetRawDatasetByUuidWithResponse(ctx context.Context, uuid UuidParam, params *GetRawDatasetByUuidParams, reqEditors ...RequestEditorFn) (*GetRawDatasetByUuidResponse, error) {
-	rsp, err := c.GetRawDatasetByUuid(ctx, uuid, params, reqEditors...)
+// ParseAddMonitorResponse parses an HTTP response from a AddMonitorWithResponse call
+func ParseAddMonitorResponse(rsp *http.Response) (*AddMonitorResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRawDatasetByUuidResponse(rsp)
-}
 
-// DeleteDatasetUploadByKeyWithResponse request returning *DeleteDatasetUploadByKeyResponse
-func (c *ClientWithResponses) DeleteDatasetUploadByKeyWithResponse(ctx context.Context, uuid UuidParam, params *DeleteDatasetUploadByKeyParams, reqEditors ...RequestEditorFn) (*DeleteDatasetUploadByKeyResponse, error) {
-	rsp, err := c.DeleteDatasetUploadByKey(ctx, uuid, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AddMonitorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteDatasetUploadByKeyResponse(rsp)
-}
 
-// InitializeDatasetUploadByUuidWithResponse request returning *InitializeDatasetUploadByUuidResponse
-func (c *ClientWithResponses) InitializeDatasetUploadByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*InitializeDatasetUploadByUuidResponse, error) {
-	rsp, err := c.InitializeDatasetUploadByUuid(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Monitor
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ParseInitializeDatasetUploadByUuidResponse(rsp)
+
+	return response, nil
 }
 
-// FindGroupsWithResponse request returning *FindGroupsResponse
-func (c *ClientWithResponses) FindGroupsWithResponse(ctx context.Context, params *FindGroupsParams, reqEditors ...RequestEditorFn) (*FindGroupsResponse, error) {
-	rsp, err := c.FindGroups(ctx, params, reqEditors...)
+// ParseDeleteMonitorByUuidResponse parses an HTTP response from a DeleteMonitorByUuidWithResponse call
+func ParseDeleteMonitorByUuidResponse(rsp *http.Response) (*DeleteMonitorByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindGroupsResponse(rsp)
-}
 
-// AddGroupWithBodyWithResponse request with arbitrary body returning *AddGroupResponse
-func (c *ClientWithResponses) AddGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddGroupResponse, error) {
-	rsp, err := c.AddGroupWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteMonitorByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAddGroupResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) AddGroupWithResponse(ctx context.Context, body AddGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*AddGroupResponse, error) {
-	rsp, err := c.AddGroup(ctx, body, reqEditors...)
+// ParseFindMonitorByUuidResponse parses an HTTP response from a FindMonitorByUuidWithResponse call
+func ParseFindMonitorByUuidResponse(rsp *http.Response) (*FindMonitorByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddGroupResponse(rsp)
-}
 
-// DeleteGroupByUuidWithResponse request returning *DeleteGroupByUuidResponse
-func (c *ClientWithResponses) DeleteGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteGroupByUuidResponse, error) {
-	rsp, err := c.DeleteGroupByUuid(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindMonitorByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteGroupByUuidResponse(rsp)
-}
 
-// FindGroupByUuidWithResponse request returning *FindGroupByUuidResponse
-func (c *ClientWithResponses) FindGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindGroupByUuidResponse, error) {
-	rsp, err := c.FindGroupByUuid(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Monitor
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseFindGroupByUuidResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateGroupByUuidWithBodyWithResponse request with arbitrary body returning *UpdateGroupByUuidResponse
-func (c *ClientWithResponses) UpdateGroupByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateGroupByUuidResponse, error) {
-	rsp, err := c.UpdateGroupByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+// ParseUpdateMonitorByUuidResponse parses an HTTP response from a UpdateMonitorByUuidWithResponse call
+func ParseUpdateMonitorByUuidResponse(rsp *http.Response) (*UpdateMonitorByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateGroupByUuidResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateGroupByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateGroupByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateGroupByUuidResponse, error) {
-	rsp, err := c.UpdateGroupByUuid(ctx, uuid, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateMonitorByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateGroupByUuidResponse(rsp)
+
+	return response, nil
 }
 
-// FindPoliciesForGroupWithResponse request returning *FindPoliciesForGroupResponse
-func (c *ClientWithResponses) FindPoliciesForGroupWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPoliciesForGroupResponse, error) {
-	rsp, err := c.FindPoliciesForGroup(ctx, uuid, reqEditors...)
+// ParseFindNotificationChannelsResponse parses an HTTP response from a FindNotificationChannelsWithResponse call
+func ParseFindNotificationChannelsResponse(rsp *http.Response) (*FindNotificationChannelsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindPoliciesForGroupResponse(rsp)
-}
 
-// FindPoliciesWithResponse request returning *FindPoliciesResponse
-func (c *ClientWithResponses) FindPoliciesWithResponse(ctx context.Context, params *FindPoliciesParams, reqEditors ...RequestEditorFn) (*FindPoliciesResponse, error) {
-	rsp, err := c.FindPolicies(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindNotificationChannelsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseFindPoliciesResponse(rsp)
-}
 
-// AddPolicyWithBodyWithResponse request with arbitrary body returning *AddPolicyResponse
-func (c *ClientWithResponses) AddPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddPolicyResponse, error) {
-	rsp, err := c.AddPolicyWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []NotificationChannel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseAddPolicyResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) AddPolicyWithResponse(ctx context.Context, body AddPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*AddPolicyResponse, error) {
-	rsp, err := c.AddPolicy(ctx, body, reqEditors...)
+// ParseAddNotificationChannelResponse parses an HTTP response from a AddNotificationChannelWithResponse call
+func ParseAddNotificationChannelResponse(rsp *http.Response) (*AddNotificationChannelResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddPolicyResponse(rsp)
-}
 
-// DeletePolicyByUuidWithResponse request returning *DeletePolicyByUuidResponse
-func (c *ClientWithResponses) DeletePolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeletePolicyByUuidResponse, error) {
-	rsp, err := c.DeletePolicyByUuid(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AddNotificationChannelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeletePolicyByUuidResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest NotificationChannel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// FindPolicyByUuidWithResponse request returning *FindPolicyByUuidResponse
-func (c *ClientWithResponses) FindPolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPolicyByUuidResponse, error) {
-	rsp, err := c.FindPolicyByUuid(ctx, uuid, reqEditors...)
+// ParseDeleteNotificationChannelByUuidResponse parses an HTTP response from a DeleteNotificationChannelByUuidWithResponse call
+func ParseDeleteNotificationChannelByUuidResponse(rsp *http.Response) (*DeleteNotificationChannelByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindPolicyByUuidResponse(rsp)
+
+	response := &DeleteNotificationChannelByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// UpdatePolicyByUuidWithBodyWithResponse request with arbitrary body returning *UpdatePolicyByUuidResponse
-func (c *ClientWithResponses) UpdatePolicyByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePolicyByUuidResponse, error) {
-	rsp, err := c.UpdatePolicyByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+// ParseFindNotificationChannelByUuidResponse parses an HTTP response from a FindNotificationChannelByUuidWithResponse call
+func ParseFindNotificationChannelByUuidResponse(rsp *http.Response) (*FindNotificationChannelByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePolicyByUuidResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdatePolicyByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdatePolicyByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePolicyByUuidResponse, error) {
-	rsp, err := c.UpdatePolicyByUuid(ctx, uuid, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindNotificationChannelByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationChannel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdatePolicyByUuidResponse(rsp)
-}
 
-// FindProgramsWithResponse request returning *FindProgramsResponse
-func (c *ClientWithResponses) FindProgramsWithResponse(ctx context.Context, params *FindProgramsParams, reqEditors ...RequestEditorFn) (*FindProgramsResponse, error) {
-	rsp, err := c.FindPrograms(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseFindProgramsResponse(rsp)
+	return response, nil
 }
 
-// AddProgramWithBodyWithResponse request with arbitrary body returning *AddProgramResponse
-func (c *ClientWithResponses) AddProgramWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProgramResponse, error) {
-	rsp, err := c.AddProgramWithBody(ctx, contentType, body, reqEditors...)
+// ParseUpdateNotificationChannelByUuidResponse parses an HTTP response from a UpdateNotificationChannelByUuidWithResponse call
+func ParseUpdateNotificationChannelByUuidResponse(rsp *http.Response) (*UpdateNotificationChannelByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddProgramResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddProgramWithResponse(ctx context.Context, body AddProgramJSONRequestBody, reqEditors ...RequestEditorFn) (*AddProgramResponse, error) {
-	rsp, err := c.AddProgram(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateNotificationChannelByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAddProgramResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteProgramByUuidWithResponse request returning *DeleteProgramByUuidResponse
-func (c *ClientWithResponses) DeleteProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteProgramByUuidResponse, error) {
-	rsp, err := c.DeleteProgramByUuid(ctx, uuid, reqEditors...)
+// ParseFindNotificationDeliveriesResponse parses an HTTP response from a FindNotificationDeliveriesWithResponse call
+func ParseFindNotificationDeliveriesResponse(rsp *http.Response) (*FindNotificationDeliveriesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteProgramByUuidResponse(rsp)
-}
 
-// FindProgramByUuidWithResponse request returning *FindProgramByUuidResponse
-func (c *ClientWithResponses) FindProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindProgramByUuidResponse, error) {
-	rsp, err := c.FindProgramByUuid(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindNotificationDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseFindProgramByUuidResponse(rsp)
-}
 
-// UpdateProgramByUuidWithBodyWithResponse request with arbitrary body returning *UpdateProgramByUuidResponse
-func (c *ClientWithResponses) UpdateProgramByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProgramByUuidResponse, error) {
-	rsp, err := c.UpdateProgramByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []NotificationDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateProgramByUuidResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateProgramByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateProgramByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProgramByUuidResponse, error) {
-	rsp, err := c.UpdateProgramByUuid(ctx, uuid, body, reqEditors...)
+// ParseFindNotificationRulesResponse parses an HTTP response from a FindNotificationRulesWithResponse call
+func ParseFindNotificationRulesResponse(rsp *http.Response) (*FindNotificationRulesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateProgramByUuidResponse(rsp)
-}
 
-// GetCodeFromProgramWithResponse request returning *GetCodeFromProgramResponse
-func (c *ClientWithResponses) GetCodeFromProgramWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetCodeFromProgramResponse, error) {
-	rsp, err := c.GetCodeFromProgram(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindNotificationRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetCodeFromProgramResponse(rsp)
-}
 
-// AddProgramCodeRevisionWithBodyWithResponse request with arbitrary body returning *AddProgramCodeRevisionResponse
-func (c *ClientWithResponses) AddProgramCodeRevisionWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddProgramCodeRevisionResponse, error) {
-	rsp, err := c.AddProgramCodeRevisionWithBody(ctx, uuid, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []NotificationRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseAddProgramCodeRevisionResponse(rsp)
+
+	return response, nil
 }
 
-// GetProgramCodeRevisionsDiffWithResponse request returning *GetProgramCodeRevisionsDiffResponse
-func (c *ClientWithResponses) GetProgramCodeRevisionsDiffWithResponse(ctx context.Context, uuid UuidParam, params *GetProgramCodeRevisionsDiffParams, reqEditors ...RequestEditorFn) (*GetProgramCodeRevisionsDiffResponse, error) {
-	rsp, err := c.GetProgramCodeRevisionsDiff(ctx, uuid, params, reqEditors...)
+// ParseAddNotificationRuleResponse parses an HTTP response from a AddNotificationRuleWithResponse call
+func ParseAddNotificationRuleResponse(rsp *http.Response) (*AddNotificationRuleResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetProgramCodeRevisionsDiffResponse(rsp)
-}
 
-// GetProgramCodeRevisionsWithResponse request returning *GetProgramCodeRevisionsResponse
-func (c *ClientWithResponses) GetProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*GetProgramCodeRevisionsResponse, error) {
-	rsp, err := c.GetProgramCodeRevisions(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AddNotificationRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetProgramCodeRevisionsResponse(rsp)
-}
 
-// DeleteProgramCodeRevisionsWithResponse request returning *DeleteProgramCodeRevisionsResponse
-func (c *ClientWithResponses) DeleteProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*DeleteProgramCodeRevisionsResponse, error) {
-	rsp, err := c.DeleteProgramCodeRevisions(ctx, uuid, revisionId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest NotificationRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ParseDeleteProgramCodeRevisionsResponse(rsp)
+
+	return response, nil
 }
 
-// SignProgramCodeRevisionsWithResponse request returning *SignProgramCodeRevisionsResponse
-func (c *ClientWithResponses) SignProgramCodeRevisionsWithResponse(ctx context.Context, uuid UuidParam, revisionId int, reqEditors ...RequestEditorFn) (*SignProgramCodeRevisionsResponse, error) {
-	rsp, err := c.SignProgramCodeRevisions(ctx, uuid, revisionId, reqEditors...)
+// ParseDeleteNotificationRuleByUuidResponse parses an HTTP response from a DeleteNotificationRuleByUuidWithResponse call
+func ParseDeleteNotificationRuleByUuidResponse(rsp *http.Response) (*DeleteNotificationRuleByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSignProgramCodeRevisionsResponse(rsp)
-}
 
-// ExecuteProgramWebhookWithResponse request returning *ExecuteProgramWebhookResponse
-func (c *ClientWithResponses) ExecuteProgramWebhookWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*ExecuteProgramWebhookResponse, error) {
-	rsp, err := c.ExecuteProgramWebhook(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteNotificationRuleByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseExecuteProgramWebhookResponse(rsp)
+
+	return response, nil
 }
 
-// FindThingsWithResponse request returning *FindThingsResponse
-func (c *ClientWithResponses) FindThingsWithResponse(ctx context.Context, params *FindThingsParams, reqEditors ...RequestEditorFn) (*FindThingsResponse, error) {
-	rsp, err := c.FindThings(ctx, params, reqEditors...)
+// ParseFindNotificationRuleByUuidResponse parses an HTTP response from a FindNotificationRuleByUuidWithResponse call
+func ParseFindNotificationRuleByUuidResponse(rsp *http.Response) (*FindNotificationRuleByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindThingsResponse(rsp)
-}
 
-// AddThingWithBodyWithResponse request with arbitrary body returning *AddThingResponse
-func (c *ClientWithResponses) AddThingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddThingResponse, error) {
-	rsp, err := c.AddThingWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindNotificationRuleByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAddThingResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddThingWithResponse(ctx context.Context, body AddThingJSONRequestBody, reqEditors ...RequestEditorFn) (*AddThingResponse, error) {
-	rsp, err := c.AddThing(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseAddThingResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteThingByUuidWithResponse request returning *DeleteThingByUuidResponse
-func (c *ClientWithResponses) DeleteThingByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteThingByUuidResponse, error) {
-	rsp, err := c.DeleteThingByUuid(ctx, uuid, reqEditors...)
+// ParseUpdateNotificationRuleByUuidResponse parses an HTTP response from a UpdateNotificationRuleByUuidWithResponse call
+func ParseUpdateNotificationRuleByUuidResponse(rsp *http.Response) (*UpdateNotificationRuleByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteThingByUuidResponse(rsp)
-}
 
-// FindThingByUuidWithResponse request returning *FindThingByUuidResponse
-func (c *ClientWithResponses) FindThingByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindThingByUuidResponse, error) {
-	rsp, err := c.FindThingByUuid(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateNotificationRuleByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseFindThingByUuidResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateThingByUuidWithBodyWithResponse request with arbitrary body returning *UpdateThingByUuidResponse
-func (c *ClientWithResponses) UpdateThingByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateThingByUuidResponse, error) {
-	rsp, err := c.UpdateThingByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
+// ParseFindPoliciesResponse parses an HTTP response from a FindPoliciesWithResponse call
+func ParseFindPoliciesResponse(rsp *http.Response) (*FindPoliciesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateThingByUuidResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateThingByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateThingByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateThingByUuidResponse, error) {
-	rsp, err := c.UpdateThingByUuid(ctx, uuid, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindPoliciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateThingByUuidResponse(rsp)
-}
 
-// FindDatasetsForThingWithResponse request returning *FindDatasetsForThingResponse
-func (c *ClientWithResponses) FindDatasetsForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindDatasetsForThingResponse, error) {
-	rsp, err := c.FindDatasetsForThing(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Policy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseFindDatasetsForThingResponse(rsp)
+
+	return response, nil
 }
 
-// FindTimeSeriesForThingWithResponse request returning *FindTimeSeriesForThingResponse
-func (c *ClientWithResponses) FindTimeSeriesForThingWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTimeSeriesForThingResponse, error) {
-	rsp, err := c.FindTimeSeriesForThing(ctx, uuid, reqEditors...)
+// ParseAddPolicyResponse parses an HTTP response from a AddPolicyWithResponse call
+func ParseAddPolicyResponse(rsp *http.Response) (*AddPolicyResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindTimeSeriesForThingResponse(rsp)
-}
 
-// FindTimeSeriesWithResponse request returning *FindTimeSeriesResponse
-func (c *ClientWithResponses) FindTimeSeriesWithResponse(ctx context.Context, params *FindTimeSeriesParams, reqEditors ...RequestEditorFn) (*FindTimeSeriesResponse, error) {
-	rsp, err := c.FindTimeSeries(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AddPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseFindTimeSeriesResponse(rsp)
-}
 
-// AddTimeSeriesWithBodyWithResponse request with arbitrary body returning *AddTimeSeriesResponse
-func (c *ClientWithResponses) AddTimeSeriesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTimeSeriesResponse, error) {
-	rsp, err := c.AddTimeSeriesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Policy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ParseAddTimeSeriesResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) AddTimeSeriesWithResponse(ctx context.Context, body AddTimeSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTimeSeriesResponse, error) {
-	rsp, err := c.AddTimeSeries(ctx, body, reqEditors...)
+// ParseDeletePolicyByUuidResponse parses an HTTP response from a DeletePolicyByUuidWithResponse call
+func ParseDeletePolicyByUuidResponse(rsp *http.Response) (*DeletePolicyByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddTimeSeriesResponse(rsp)
-}
 
-// DeleteTimeSeriesByUuidWithResponse request returning *DeleteTimeSeriesByUuidResponse
-func (c *ClientWithResponses) DeleteTimeSeriesByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteTimeSeriesByUuidResponse, error) {
-	rsp, err := c.DeleteTimeSeriesByUuid(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeletePolicyByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteTimeSeriesByUuidResponse(rsp)
+
+	return response, nil
 }
 
-// FindTimeSeriesByUuidWithResponse request returning *FindTimeSeriesByUuidResponse
-func (c *ClientWithResponses) FindTimeSeriesByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTimeSeriesByUuidResponse, error) {
-	rsp, err := c.FindTimeSeriesByUuid(ctx, uuid, reqEditors...)
+// ParseFindPolicyByUuidResponse parses an HTTP response from a FindPolicyByUuidWithResponse call
+func ParseFindPolicyByUuidResponse(rsp *http.Response) (*FindPolicyByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindTimeSeriesByUuidResponse(rsp)
-}
 
-// UpdateTimeseriesByUuidWithBodyWithResponse request with arbitrary body returning *UpdateTimeseriesByUuidResponse
-func (c *ClientWithResponses) UpdateTimeseriesByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error) {
-	rsp, err := c.UpdateTimeseriesByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindPolicyByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateTimeseriesByUuidResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateTimeseriesByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateTimeseriesByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTimeseriesByUuidResponse, error) {
-	rsp, err := c.UpdateTimeseriesByUuid(ctx, uuid, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Policy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateTimeseriesByUuidResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteDataFromTimeSeriesWithResponse request returning *DeleteDataFromTimeSeriesResponse
-func (c *ClientWithResponses) DeleteDataFromTimeSeriesWithResponse(ctx context.Context, uuid UuidParam, params *DeleteDataFromTimeSeriesParams, reqEditors ...RequestEditorFn) (*DeleteDataFromTimeSeriesResponse, error) {
-	rsp, err := c.DeleteDataFromTimeSeries(ctx, uuid, params, reqEditors...)
+// ParseUpdatePolicyByUuidResponse parses an HTTP response from a UpdatePolicyByUuidWithResponse call
+func ParseUpdatePolicyByUuidResponse(rsp *http.Response) (*UpdatePolicyByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteDataFromTimeSeriesResponse(rsp)
-}
 
-// QueryTimeseriesForDataWithResponse request returning *QueryTimeseriesForDataResponse
-func (c *ClientWithResponses) QueryTimeseriesForDataWithResponse(ctx context.Context, uuid UuidParam, params *QueryTimeseriesForDataParams, reqEditors ...RequestEditorFn) (*QueryTimeseriesForDataResponse, error) {
-	rsp, err := c.QueryTimeseriesForData(ctx, uuid, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdatePolicyByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseQueryTimeseriesForDataResponse(rsp)
+
+	return response, nil
 }
 
-// AddDataToTimeseriesWithBodyWithResponse request with arbitrary body returning *AddDataToTimeseriesResponse
-func (c *ClientWithResponses) AddDataToTimeseriesWithBodyWithResponse(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddDataToTimeseriesResponse, error) {
-	rsp, err := c.AddDataToTimeseriesWithBody(ctx, uuid, params, contentType, body, reqEditors...)
+// ParseFindProgramsResponse parses an HTTP response from a FindProgramsWithResponse call
+func ParseFindProgramsResponse(rsp *http.Response) (*FindProgramsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddDataToTimeseriesResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddDataToTimeseriesWithResponse(ctx context.Context, uuid UuidParam, params *AddDataToTimeseriesParams, body AddDataToTimeseriesJSONRequestBody, reqEditors ...RequestEditorFn) (*AddDataToTimeseriesResponse, error) {
-	rsp, err := c.AddDataToTimeseries(ctx, uuid, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindProgramsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAddDataToTimeseriesResponse(rsp)
-}
 
-// FindTsdataByQueryWithResponse request returning *FindTsdataByQueryResponse
-func (c *ClientWithResponses) FindTsdataByQueryWithResponse(ctx context.Context, params *FindTsdataByQueryParams, reqEditors ...RequestEditorFn) (*FindTsdataByQueryResponse, error) {
-	rsp, err := c.FindTsdataByQuery(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Program
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseFindTsdataByQueryResponse(rsp)
+
+	return response, nil
 }
 
-// FindUsersWithResponse request returning *FindUsersResponse
-func (c *ClientWithResponses) FindUsersWithResponse(ctx context.Context, params *FindUsersParams, reqEditors ...RequestEditorFn) (*FindUsersResponse, error) {
-	rsp, err := c.FindUsers(ctx, params, reqEditors...)
+// ParseAddProgramResponse parses an HTTP response from a AddProgramWithResponse call
+func ParseAddProgramResponse(rsp *http.Response) (*AddProgramResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindUsersResponse(rsp)
-}
 
-// AddUserWithBodyWithResponse request with arbitrary body returning *AddUserResponse
-func (c *ClientWithResponses) AddUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddUserResponse, error) {
-	rsp, err := c.AddUserWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AddProgramResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAddUserResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddUserWithResponse(ctx context.Context, body AddUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddUserResponse, error) {
-	rsp, err := c.AddUser(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Program
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ParseAddUserResponse(rsp)
+
+	return response, nil
 }
 
-// WhoamiWithResponse request returning *WhoamiResponse
-func (c *ClientWithResponses) WhoamiWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WhoamiResponse, error) {
-	rsp, err := c.Whoami(ctx, reqEditors...)
+// ParseDeleteProgramByUuidResponse parses an HTTP response from a DeleteProgramByUuidWithResponse call
+func ParseDeleteProgramByUuidResponse(rsp *http.Response) (*DeleteProgramByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseWhoamiResponse(rsp)
-}
 
-// DeleteUserByUuidWithResponse request returning *DeleteUserByUuidResponse
-func (c *ClientWithResponses) DeleteUserByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*DeleteUserByUuidResponse, error) {
-	rsp, err := c.DeleteUserByUuid(ctx, uuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteProgramByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteUserByUuidResponse(rsp)
+
+	return response, nil
 }
 
-// FindUserByUuidWithResponse request returning *FindUserByUuidResponse
-func (c *ClientWithResponses) FindUserByUuidWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindUserByUuidResponse, error) {
-	rsp, err := c.FindUserByUuid(ctx, uuid, reqEditors...)
+// ParseFindProgramByUuidResponse parses an HTTP response from a FindProgramByUuidWithResponse call
+func ParseFindProgramByUuidResponse(rsp *http.Response) (*FindProgramByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindUserByUuidResponse(rsp)
-}
 
-// UpdateUserByUuidWithBodyWithResponse request with arbitrary body returning *UpdateUserByUuidResponse
-func (c *ClientWithResponses) UpdateUserByUuidWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserByUuidResponse, error) {
-	rsp, err := c.UpdateUserByUuidWithBody(ctx, uuid, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindProgramByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateUserByUuidResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateUserByUuidWithResponse(ctx context.Context, uuid UuidParam, body UpdateUserByUuidJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserByUuidResponse, error) {
-	rsp, err := c.UpdateUserByUuid(ctx, uuid, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Program
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdateUserByUuidResponse(rsp)
+
+	return response, nil
 }
 
-// FindPoliciesForUserWithResponse request returning *FindPoliciesForUserResponse
-func (c *ClientWithResponses) FindPoliciesForUserWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindPoliciesForUserResponse, error) {
-	rsp, err := c.FindPoliciesForUser(ctx, uuid, reqEditors...)
+// ParseUpdateProgramByUuidResponse parses an HTTP response from a UpdateProgramByUuidWithResponse call
+func ParseUpdateProgramByUuidResponse(rsp *http.Response) (*UpdateProgramByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindPoliciesForUserResponse(rsp)
-}
 
-// SetRequestRateForUserWithBodyWithResponse request with arbitrary body returning *SetRequestRateForUserResponse
-func (c *ClientWithResponses) SetRequestRateForUserWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetRequestRateForUserResponse, error) {
-	rsp, err := c.SetRequestRateForUserWithBody(ctx, uuid, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateProgramByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSetRequestRateForUserResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) SetRequestRateForUserWithResponse(ctx context.Context, uuid UuidParam, body SetRequestRateForUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SetRequestRateForUserResponse, error) {
-	rsp, err := c.SetRequestRateForUser(ctx, uuid, body, reqEditors...)
+// ParseGetCodeFromProgramResponse parses an HTTP response from a GetCodeFromProgramWithResponse call
+func ParseGetCodeFromProgramResponse(rsp *http.Response) (*GetCodeFromProgramResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSetRequestRateForUserResponse(rsp)
+
+	response := &GetCodeFromProgramResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Program
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// FindTokensForUserWithResponse request returning *FindTokensForUserResponse
-func (c *ClientWithResponses) FindTokensForUserWithResponse(ctx context.Context, uuid UuidParam, reqEditors ...RequestEditorFn) (*FindTokensForUserResponse, error) {
-	rsp, err := c.FindTokensForUser(ctx, uuid, reqEditors...)
+// ParseAddProgramCodeRevisionResponse parses an HTTP response from a AddProgramCodeRevisionWithResponse call
+func ParseAddProgramCodeRevisionResponse(rsp *http.Response) (*AddProgramCodeRevisionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFindTokensForUserResponse(rsp)
-}
 
-// AddNewTokenToUserWithBodyWithResponse request with arbitrary body returning *AddNewTokenToUserResponse
-func (c *ClientWithResponses) AddNewTokenToUserWithBodyWithResponse(ctx context.Context, uuid UuidParam, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddNewTokenToUserResponse, error) {
-	rsp, err := c.AddNewTokenToUserWithBody(ctx, uuid, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AddProgramCodeRevisionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CodeRevision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ParseAddNewTokenToUserResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) AddNewTokenToUserWithResponse(ctx context.Context, uuid UuidParam, body AddNewTokenToUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AddNewTokenToUserResponse, error) {
-	rsp, err := c.AddNewTokenToUser(ctx, uuid, body, reqEditors...)
+// ParseGetProgramCodeRevisionsDiffResponse parses an HTTP response from a GetProgramCodeRevisionsDiffWithResponse call
+func ParseGetProgramCodeRevisionsDiffResponse(rsp *http.Response) (*GetProgramCodeRevisionsDiffResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddNewTokenToUserResponse(rsp)
-}
 
-// DeleteTokenForUserWithResponse request returning *DeleteTokenForUserResponse
-func (c *ClientWithResponses) DeleteTokenForUserWithResponse(ctx context.Context, uuid UuidParam, tokenUuid string, reqEditors ...RequestEditorFn) (*DeleteTokenForUserResponse, error) {
-	rsp, err := c.DeleteTokenForUser(ctx, uuid, tokenUuid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetProgramCodeRevisionsDiffResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteTokenForUserResponse(rsp)
+
+	return response, nil
 }
 
-// ParseFindAlertsResponse parses an HTTP response from a FindAlertsWithResponse call
-func ParseFindAlertsResponse(rsp *http.Response) (*FindAlertsResponse, error) {
+// ParseGetProgramCodeRevisionsResponse parses an HTTP response from a GetProgramCodeRevisionsWithResponse call
+func ParseGetProgramCodeRevisionsResponse(rsp *http.Response) (*GetProgramCodeRevisionsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindAlertsResponse{
+	response := &GetProgramCodeRevisionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Alert
+		var dest []CodeRevision
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7221,64 +17883,113 @@ func ParseFindAlertsResponse(rsp *http.Response) (*FindAlertsResponse, error) {
 	return response, nil
 }
 
-// ParseCreateAlertResponse parses an HTTP response from a CreateAlertWithResponse call
-func ParseCreateAlertResponse(rsp *http.Response) (*CreateAlertResponse, error) {
+// ParseDeleteProgramCodeRevisionsResponse parses an HTTP response from a DeleteProgramCodeRevisionsWithResponse call
+func ParseDeleteProgramCodeRevisionsResponse(rsp *http.Response) (*DeleteProgramCodeRevisionsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateAlertResponse{
+	response := &DeleteProgramCodeRevisionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseSignProgramCodeRevisionsResponse parses an HTTP response from a SignProgramCodeRevisionsWithResponse call
+func ParseSignProgramCodeRevisionsResponse(rsp *http.Response) (*SignProgramCodeRevisionsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SignProgramCodeRevisionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRunProgramResponse parses an HTTP response from a RunProgramWithResponse call
+func ParseRunProgramResponse(rsp *http.Response) (*RunProgramResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RunProgramResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest NewAlertReply
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProgramDryRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ProgramRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteAlertByUuidResponse parses an HTTP response from a DeleteAlertByUuidWithResponse call
-func ParseDeleteAlertByUuidResponse(rsp *http.Response) (*DeleteAlertByUuidResponse, error) {
+// ParseFindProgramRunsResponse parses an HTTP response from a FindProgramRunsWithResponse call
+func ParseFindProgramRunsResponse(rsp *http.Response) (*FindProgramRunsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAlertByUuidResponse{
+	response := &FindProgramRunsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ProgramRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseFindAlertByUuidResponse parses an HTTP response from a FindAlertByUuidWithResponse call
-func ParseFindAlertByUuidResponse(rsp *http.Response) (*FindAlertByUuidResponse, error) {
+// ParseFindProgramRunByIdResponse parses an HTTP response from a FindProgramRunByIdWithResponse call
+func ParseFindProgramRunByIdResponse(rsp *http.Response) (*FindProgramRunByIdResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindAlertByUuidResponse{
+	response := &FindProgramRunByIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Alert
+		var dest ProgramRun
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7289,15 +18000,15 @@ func ParseFindAlertByUuidResponse(rsp *http.Response) (*FindAlertByUuidResponse,
 	return response, nil
 }
 
-// ParseUpdateAlertByUuidResponse parses an HTTP response from a UpdateAlertByUuidWithResponse call
-func ParseUpdateAlertByUuidResponse(rsp *http.Response) (*UpdateAlertByUuidResponse, error) {
+// ParseExecuteProgramWebhookResponse parses an HTTP response from a ExecuteProgramWebhookWithResponse call
+func ParseExecuteProgramWebhookResponse(rsp *http.Response) (*ExecuteProgramWebhookResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateAlertByUuidResponse{
+	response := &ExecuteProgramWebhookResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -7305,22 +18016,22 @@ func ParseUpdateAlertByUuidResponse(rsp *http.Response) (*UpdateAlertByUuidRespo
 	return response, nil
 }
 
-// ParseFindDatasetsResponse parses an HTTP response from a FindDatasetsWithResponse call
-func ParseFindDatasetsResponse(rsp *http.Response) (*FindDatasetsResponse, error) {
+// ParseFindSilencesResponse parses an HTTP response from a FindSilencesWithResponse call
+func ParseFindSilencesResponse(rsp *http.Response) (*FindSilencesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindDatasetsResponse{
+	response := &FindSilencesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Dataset
+		var dest []AlertSilence
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7331,22 +18042,22 @@ func ParseFindDatasetsResponse(rsp *http.Response) (*FindDatasetsResponse, error
 	return response, nil
 }
 
-// ParseAddDatasetsResponse parses an HTTP response from a AddDatasetsWithResponse call
-func ParseAddDatasetsResponse(rsp *http.Response) (*AddDatasetsResponse, error) {
+// ParseAddSilenceResponse parses an HTTP response from a AddSilenceWithResponse call
+func ParseAddSilenceResponse(rsp *http.Response) (*AddSilenceResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddDatasetsResponse{
+	response := &AddSilenceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Dataset
+		var dest AlertSilence
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7357,15 +18068,15 @@ func ParseAddDatasetsResponse(rsp *http.Response) (*AddDatasetsResponse, error)
 	return response, nil
 }
 
-// ParseDeleteDatasetByUuidResponse parses an HTTP response from a DeleteDatasetByUuidWithResponse call
-func ParseDeleteDatasetByUuidResponse(rsp *http.Response) (*DeleteDatasetByUuidResponse, error) {
+// ParseDeleteSilenceByUuidResponse parses an HTTP response from a DeleteSilenceByUuidWithResponse call
+func ParseDeleteSilenceByUuidResponse(rsp *http.Response) (*DeleteSilenceByUuidResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatasetByUuidResponse{
+	response := &DeleteSilenceByUuidResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -7373,22 +18084,22 @@ func ParseDeleteDatasetByUuidResponse(rsp *http.Response) (*DeleteDatasetByUuidR
 	return response, nil
 }
 
-// ParseFindDatasetByUuidResponse parses an HTTP response from a FindDatasetByUuidWithResponse call
-func ParseFindDatasetByUuidResponse(rsp *http.Response) (*FindDatasetByUuidResponse, error) {
+// ParseFindSilenceByUuidResponse parses an HTTP response from a FindSilenceByUuidWithResponse call
+func ParseFindSilenceByUuidResponse(rsp *http.Response) (*FindSilenceByUuidResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindDatasetByUuidResponse{
+	response := &FindSilenceByUuidResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Dataset
+		var dest AlertSilence
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7399,15 +18110,15 @@ func ParseFindDatasetByUuidResponse(rsp *http.Response) (*FindDatasetByUuidRespo
 	return response, nil
 }
 
-// ParseUpdateDatasetByUuidResponse parses an HTTP response from a UpdateDatasetByUuidWithResponse call
-func ParseUpdateDatasetByUuidResponse(rsp *http.Response) (*UpdateDatasetByUuidResponse, error) {
+// ParseUpdateSilenceByUuidResponse parses an HTTP response from a UpdateSilenceByUuidWithResponse call
+func ParseUpdateSilenceByUuidResponse(rsp *http.Response) (*UpdateSilenceByUuidResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDatasetByUuidResponse{
+	response := &UpdateSilenceByUuidResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -7415,130 +18126,109 @@ func ParseUpdateDatasetByUuidResponse(rsp *http.Response) (*UpdateDatasetByUuidR
 	return response, nil
 }
 
-// ParseAssembleDatasetPartsByKeyResponse parses an HTTP response from a AssembleDatasetPartsByKeyWithResponse call
-func ParseAssembleDatasetPartsByKeyResponse(rsp *http.Response) (*AssembleDatasetPartsByKeyResponse, error) {
+// ParseFindThingTemplatesResponse parses an HTTP response from a FindThingTemplatesWithResponse call
+func ParseFindThingTemplatesResponse(rsp *http.Response) (*FindThingTemplatesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AssembleDatasetPartsByKeyResponse{
+	response := &FindThingTemplatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest struct {
-			Message string `json:"message"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ThingTemplate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListDatasetPartsByKeyResponse parses an HTTP response from a ListDatasetPartsByKeyWithResponse call
-func ParseListDatasetPartsByKeyResponse(rsp *http.Response) (*ListDatasetPartsByKeyResponse, error) {
+// ParseAddThingTemplateResponse parses an HTTP response from a AddThingTemplateWithResponse call
+func ParseAddThingTemplateResponse(rsp *http.Response) (*AddThingTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDatasetPartsByKeyResponse{
+	response := &AddThingTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ThingTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseUploadDatasetContentByKeyResponse parses an HTTP response from a UploadDatasetContentByKeyWithResponse call
-func ParseUploadDatasetContentByKeyResponse(rsp *http.Response) (*UploadDatasetContentByKeyResponse, error) {
+// ParseDeleteThingTemplateByUuidResponse parses an HTTP response from a DeleteThingTemplateByUuidWithResponse call
+func ParseDeleteThingTemplateByUuidResponse(rsp *http.Response) (*DeleteThingTemplateByUuidResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UploadDatasetContentByKeyResponse{
+	response := &DeleteThingTemplateByUuidResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Message string `json:"message"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseGetRawDatasetByUuidResponse parses an HTTP response from a GetRawDatasetByUuidWithResponse call
-func ParseGetRawDatasetByUuidResponse(rsp *http.Response) (*GetRawDatasetByUuidResponse, error) {
+// ParseFindThingTemplateByUuidResponse parses an HTTP response from a FindThingTemplateByUuidWithResponse call
+func ParseFindThingTemplateByUuidResponse(rsp *http.Response) (*FindThingTemplateByUuidResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRawDatasetByUuidResponse{
+	response := &FindThingTemplateByUuidResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest string
+		var dest ThingTemplate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "xml") && rsp.StatusCode == 200:
-		var dest string
-		if err := xml.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.XML200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
-		var dest string
-		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.YAML200 = &dest
-
-	case rsp.StatusCode == 200:
-		// Content-type (text/plain; charset=utf-8) unsupported
-
 	}
 
 	return response, nil
 }
 
-// ParseDeleteDatasetUploadByKeyResponse parses an HTTP response from a DeleteDatasetUploadByKeyWithResponse call
-func ParseDeleteDatasetUploadByKeyResponse(rsp *http.Response) (*DeleteDatasetUploadByKeyResponse, error) {
+// ParseUpdateThingTemplateByUuidResponse parses an HTTP response from a UpdateThingTemplateByUuidWithResponse call
+func ParseUpdateThingTemplateByUuidResponse(rsp *http.Response) (*UpdateThingTemplateByUuidResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDatasetUploadByKeyResponse{
+	response := &UpdateThingTemplateByUuidResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -7546,24 +18236,22 @@ func ParseDeleteDatasetUploadByKeyResponse(rsp *http.Response) (*DeleteDatasetUp
 	return response, nil
 }
 
-// ParseInitializeDatasetUploadByUuidResponse parses an HTTP response from a InitializeDatasetUploadByUuidWithResponse call
-func ParseInitializeDatasetUploadByUuidResponse(rsp *http.Response) (*InitializeDatasetUploadByUuidResponse, error) {
+// ParseApplyThingTemplateResponse parses an HTTP response from a ApplyThingTemplateWithResponse call
+func ParseApplyThingTemplateResponse(rsp *http.Response) (*ApplyThingTemplateResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &InitializeDatasetUploadByUuidResponse{
+	response := &ApplyThingTemplateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			UploadId *string `json:"uploadId,omitempty"`
-		}
+		var dest ThingTemplateApplyResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7574,48 +18262,51 @@ func ParseInitializeDatasetUploadByUuidResponse(rsp *http.Response) (*Initialize
 	return response, nil
 }
 
-// ParseFindGroupsResponse parses an HTTP response from a FindGroupsWithResponse call
-func ParseFindGroupsResponse(rsp *http.Response) (*FindGroupsResponse, error) {
+// ParseFindThingsResponse parses an HTTP response from a FindThingsWithResponse call
+func ParseFindThingsResponse(rsp *http.Response) (*FindThingsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindGroupsResponse{
+	response := &FindThingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Group
+		var dest []Thing
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case rsp.StatusCode == 200:
+		// Content-type (application/geo+json) unsupported
+
 	}
 
 	return response, nil
 }
 
-// ParseAddGroupResponse parses an HTTP response from a AddGroupWithResponse call
-func ParseAddGroupResponse(rsp *http.Response) (*AddGroupResponse, error) {
+// ParseAddThingResponse parses an HTTP response from a AddThingWithResponse call
+func ParseAddThingResponse(rsp *http.Response) (*AddThingResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddGroupResponse{
+	response := &AddThingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Group
+		var dest Thing
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7626,15 +18317,15 @@ func ParseAddGroupResponse(rsp *http.Response) (*AddGroupResponse, error) {
 	return response, nil
 }
 
-// ParseDeleteGroupByUuidResponse parses an HTTP response from a DeleteGroupByUuidWithResponse call
-func ParseDeleteGroupByUuidResponse(rsp *http.Response) (*DeleteGroupByUuidResponse, error) {
+// ParseDeleteThingByUuidResponse parses an HTTP response from a DeleteThingByUuidWithResponse call
+func ParseDeleteThingByUuidResponse(rsp *http.Response) (*DeleteThingByUuidResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteGroupByUuidResponse{
+	response := &DeleteThingByUuidResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -7642,22 +18333,22 @@ func ParseDeleteGroupByUuidResponse(rsp *http.Response) (*DeleteGroupByUuidRespo
 	return response, nil
 }
 
-// ParseFindGroupByUuidResponse parses an HTTP response from a FindGroupByUuidWithResponse call
-func ParseFindGroupByUuidResponse(rsp *http.Response) (*FindGroupByUuidResponse, error) {
+// ParseFindThingByUuidResponse parses an HTTP response from a FindThingByUuidWithResponse call
+func ParseFindThingByUuidResponse(rsp *http.Response) (*FindThingByUuidResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindGroupByUuidResponse{
+	response := &FindThingByUuidResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Group
+		var dest Thing
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7668,15 +18359,15 @@ func ParseFindGroupByUuidResponse(rsp *http.Response) (*FindGroupByUuidResponse,
 	return response, nil
 }
 
-// ParseUpdateGroupByUuidResponse parses an HTTP response from a UpdateGroupByUuidWithResponse call
-func ParseUpdateGroupByUuidResponse(rsp *http.Response) (*UpdateGroupByUuidResponse, error) {
+// ParseUpdateThingByUuidResponse parses an HTTP response from a UpdateThingByUuidWithResponse call
+func ParseUpdateThingByUuidResponse(rsp *http.Response) (*UpdateThingByUuidResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateGroupByUuidResponse{
+	response := &UpdateThingByUuidResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -7684,22 +18375,22 @@ func ParseUpdateGroupByUuidResponse(rsp *http.Response) (*UpdateGroupByUuidRespo
 	return response, nil
 }
 
-// ParseFindPoliciesForGroupResponse parses an HTTP response from a FindPoliciesForGroupWithResponse call
-func ParseFindPoliciesForGroupResponse(rsp *http.Response) (*FindPoliciesForGroupResponse, error) {
+// ParseFindAncestorsForThingResponse parses an HTTP response from a FindAncestorsForThingWithResponse call
+func ParseFindAncestorsForThingResponse(rsp *http.Response) (*FindAncestorsForThingResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindPoliciesForGroupResponse{
+	response := &FindAncestorsForThingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Policy
+		var dest []ThingRelative
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7710,22 +18401,22 @@ func ParseFindPoliciesForGroupResponse(rsp *http.Response) (*FindPoliciesForGrou
 	return response, nil
 }
 
-// ParseFindPoliciesResponse parses an HTTP response from a FindPoliciesWithResponse call
-func ParseFindPoliciesResponse(rsp *http.Response) (*FindPoliciesResponse, error) {
+// ParseFindChildrenForThingResponse parses an HTTP response from a FindChildrenForThingWithResponse call
+func ParseFindChildrenForThingResponse(rsp *http.Response) (*FindChildrenForThingResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindPoliciesResponse{
+	response := &FindChildrenForThingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Policy
+		var dest []ThingRelative
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7736,41 +18427,31 @@ func ParseFindPoliciesResponse(rsp *http.Response) (*FindPoliciesResponse, error
 	return response, nil
 }
 
-// ParseAddPolicyResponse parses an HTTP response from a AddPolicyWithResponse call
-func ParseAddPolicyResponse(rsp *http.Response) (*AddPolicyResponse, error) {
+// ParseAddChildToThingResponse parses an HTTP response from a AddChildToThingWithResponse call
+func ParseAddChildToThingResponse(rsp *http.Response) (*AddChildToThingResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddPolicyResponse{
+	response := &AddChildToThingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Policy
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseDeletePolicyByUuidResponse parses an HTTP response from a DeletePolicyByUuidWithResponse call
-func ParseDeletePolicyByUuidResponse(rsp *http.Response) (*DeletePolicyByUuidResponse, error) {
+// ParseRemoveChildFromThingResponse parses an HTTP response from a RemoveChildFromThingWithResponse call
+func ParseRemoveChildFromThingResponse(rsp *http.Response) (*RemoveChildFromThingResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeletePolicyByUuidResponse{
+	response := &RemoveChildFromThingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -7778,22 +18459,22 @@ func ParseDeletePolicyByUuidResponse(rsp *http.Response) (*DeletePolicyByUuidRes
 	return response, nil
 }
 
-// ParseFindPolicyByUuidResponse parses an HTTP response from a FindPolicyByUuidWithResponse call
-func ParseFindPolicyByUuidResponse(rsp *http.Response) (*FindPolicyByUuidResponse, error) {
+// ParseFindDatasetsForThingResponse parses an HTTP response from a FindDatasetsForThingWithResponse call
+func ParseFindDatasetsForThingResponse(rsp *http.Response) (*FindDatasetsForThingResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindPolicyByUuidResponse{
+	response := &FindDatasetsForThingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Policy
+		var dest []Timeseries
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7804,38 +18485,48 @@ func ParseFindPolicyByUuidResponse(rsp *http.Response) (*FindPolicyByUuidRespons
 	return response, nil
 }
 
-// ParseUpdatePolicyByUuidResponse parses an HTTP response from a UpdatePolicyByUuidWithResponse call
-func ParseUpdatePolicyByUuidResponse(rsp *http.Response) (*UpdatePolicyByUuidResponse, error) {
+// ParseFindDescendantsForThingResponse parses an HTTP response from a FindDescendantsForThingWithResponse call
+func ParseFindDescendantsForThingResponse(rsp *http.Response) (*FindDescendantsForThingResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdatePolicyByUuidResponse{
+	response := &FindDescendantsForThingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ThingRelative
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseFindProgramsResponse parses an HTTP response from a FindProgramsWithResponse call
-func ParseFindProgramsResponse(rsp *http.Response) (*FindProgramsResponse, error) {
+// ParseFindStateHistoryForThingResponse parses an HTTP response from a FindStateHistoryForThingWithResponse call
+func ParseFindStateHistoryForThingResponse(rsp *http.Response) (*FindStateHistoryForThingResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindProgramsResponse{
+	response := &FindStateHistoryForThingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Program
+		var dest []ThingStateChange
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7846,64 +18537,74 @@ func ParseFindProgramsResponse(rsp *http.Response) (*FindProgramsResponse, error
 	return response, nil
 }
 
-// ParseAddProgramResponse parses an HTTP response from a AddProgramWithResponse call
-func ParseAddProgramResponse(rsp *http.Response) (*AddProgramResponse, error) {
+// ParseFindParentsForThingResponse parses an HTTP response from a FindParentsForThingWithResponse call
+func ParseFindParentsForThingResponse(rsp *http.Response) (*FindParentsForThingResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddProgramResponse{
+	response := &FindParentsForThingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Program
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ThingRelative
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteProgramByUuidResponse parses an HTTP response from a DeleteProgramByUuidWithResponse call
-func ParseDeleteProgramByUuidResponse(rsp *http.Response) (*DeleteProgramByUuidResponse, error) {
+// ParseFindSubtreeForThingResponse parses an HTTP response from a FindSubtreeForThingWithResponse call
+func ParseFindSubtreeForThingResponse(rsp *http.Response) (*FindSubtreeForThingResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProgramByUuidResponse{
+	response := &FindSubtreeForThingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ThingTree
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseFindProgramByUuidResponse parses an HTTP response from a FindProgramByUuidWithResponse call
-func ParseFindProgramByUuidResponse(rsp *http.Response) (*FindProgramByUuidResponse, error) {
+// ParseFindTimeSeriesForThingResponse parses an HTTP response from a FindTimeSeriesForThingWithResponse call
+func ParseFindTimeSeriesForThingResponse(rsp *http.Response) (*FindTimeSeriesForThingResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindProgramByUuidResponse{
+	response := &FindTimeSeriesForThingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Program
+		var dest []Timeseries
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7914,15 +18615,15 @@ func ParseFindProgramByUuidResponse(rsp *http.Response) (*FindProgramByUuidRespo
 	return response, nil
 }
 
-// ParseUpdateProgramByUuidResponse parses an HTTP response from a UpdateProgramByUuidWithResponse call
-func ParseUpdateProgramByUuidResponse(rsp *http.Response) (*UpdateProgramByUuidResponse, error) {
+// ParseDeleteThingTypeSchemaResponse parses an HTTP response from a DeleteThingTypeSchemaWithResponse call
+func ParseDeleteThingTypeSchemaResponse(rsp *http.Response) (*DeleteThingTypeSchemaResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateProgramByUuidResponse{
+	response := &DeleteThingTypeSchemaResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -7930,22 +18631,22 @@ func ParseUpdateProgramByUuidResponse(rsp *http.Response) (*UpdateProgramByUuidR
 	return response, nil
 }
 
-// ParseGetCodeFromProgramResponse parses an HTTP response from a GetCodeFromProgramWithResponse call
-func ParseGetCodeFromProgramResponse(rsp *http.Response) (*GetCodeFromProgramResponse, error) {
+// ParseFindThingTypeSchemasResponse parses an HTTP response from a FindThingTypeSchemasWithResponse call
+func ParseFindThingTypeSchemasResponse(rsp *http.Response) (*FindThingTypeSchemasResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCodeFromProgramResponse{
+	response := &FindThingTypeSchemasResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Program
+		var dest []ThingTypeSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -7956,41 +18657,41 @@ func ParseGetCodeFromProgramResponse(rsp *http.Response) (*GetCodeFromProgramRes
 	return response, nil
 }
 
-// ParseAddProgramCodeRevisionResponse parses an HTTP response from a AddProgramCodeRevisionWithResponse call
-func ParseAddProgramCodeRevisionResponse(rsp *http.Response) (*AddProgramCodeRevisionResponse, error) {
+// ParseSetThingTypeSchemaResponse parses an HTTP response from a SetThingTypeSchemaWithResponse call
+func ParseSetThingTypeSchemaResponse(rsp *http.Response) (*SetThingTypeSchemaResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddProgramCodeRevisionResponse{
+	response := &SetThingTypeSchemaResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CodeRevision
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ThingTypeSchema
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetProgramCodeRevisionsDiffResponse parses an HTTP response from a GetProgramCodeRevisionsDiffWithResponse call
-func ParseGetProgramCodeRevisionsDiffResponse(rsp *http.Response) (*GetProgramCodeRevisionsDiffResponse, error) {
+// ParseDeleteThingStateTransitionsResponse parses an HTTP response from a DeleteThingStateTransitionsWithResponse call
+func ParseDeleteThingStateTransitionsResponse(rsp *http.Response) (*DeleteThingStateTransitionsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProgramCodeRevisionsDiffResponse{
+	response := &DeleteThingStateTransitionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -7998,22 +18699,22 @@ func ParseGetProgramCodeRevisionsDiffResponse(rsp *http.Response) (*GetProgramCo
 	return response, nil
 }
 
-// ParseGetProgramCodeRevisionsResponse parses an HTTP response from a GetProgramCodeRevisionsWithResponse call
-func ParseGetProgramCodeRevisionsResponse(rsp *http.Response) (*GetProgramCodeRevisionsResponse, error) {
+// ParseFindThingStateTransitionsResponse parses an HTTP response from a FindThingStateTransitionsWithResponse call
+func ParseFindThingStateTransitionsResponse(rsp *http.Response) (*FindThingStateTransitionsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProgramCodeRevisionsResponse{
+	response := &FindThingStateTransitionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []CodeRevision
+		var dest []ThingStateTransitions
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8024,70 +18725,116 @@ func ParseGetProgramCodeRevisionsResponse(rsp *http.Response) (*GetProgramCodeRe
 	return response, nil
 }
 
-// ParseDeleteProgramCodeRevisionsResponse parses an HTTP response from a DeleteProgramCodeRevisionsWithResponse call
-func ParseDeleteProgramCodeRevisionsResponse(rsp *http.Response) (*DeleteProgramCodeRevisionsResponse, error) {
+// ParseSetThingStateTransitionsResponse parses an HTTP response from a SetThingStateTransitionsWithResponse call
+func ParseSetThingStateTransitionsResponse(rsp *http.Response) (*SetThingStateTransitionsResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteProgramCodeRevisionsResponse{
+	response := &SetThingStateTransitionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ThingStateTransitions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseSignProgramCodeRevisionsResponse parses an HTTP response from a SignProgramCodeRevisionsWithResponse call
-func ParseSignProgramCodeRevisionsResponse(rsp *http.Response) (*SignProgramCodeRevisionsResponse, error) {
+// ParseFindTimeSeriesResponse parses an HTTP response from a FindTimeSeriesWithResponse call
+func ParseFindTimeSeriesResponse(rsp *http.Response) (*FindTimeSeriesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SignProgramCodeRevisionsResponse{
+	response := &FindTimeSeriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Timeseries
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseExecuteProgramWebhookResponse parses an HTTP response from a ExecuteProgramWebhookWithResponse call
-func ParseExecuteProgramWebhookResponse(rsp *http.Response) (*ExecuteProgramWebhookResponse, error) {
+// ParseAddTimeSeriesResponse parses an HTTP response from a AddTimeSeriesWithResponse call
+func ParseAddTimeSeriesResponse(rsp *http.Response) (*AddTimeSeriesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ExecuteProgramWebhookResponse{
+	response := &AddTimeSeriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Timeseries
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseFindThingsResponse parses an HTTP response from a FindThingsWithResponse call
-func ParseFindThingsResponse(rsp *http.Response) (*FindThingsResponse, error) {
+// ParseDeleteTimeSeriesByUuidResponse parses an HTTP response from a DeleteTimeSeriesByUuidWithResponse call
+func ParseDeleteTimeSeriesByUuidResponse(rsp *http.Response) (*DeleteTimeSeriesByUuidResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindThingsResponse{
+	response := &DeleteTimeSeriesByUuidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseFindTimeSeriesByUuidResponse parses an HTTP response from a FindTimeSeriesByUuidWithResponse call
+func ParseFindTimeSeriesByUuidResponse(rsp *http.Response) (*FindTimeSeriesByUuidResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindTimeSeriesByUuidResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Thing
+		var dest Timeseries
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8098,41 +18845,31 @@ func ParseFindThingsResponse(rsp *http.Response) (*FindThingsResponse, error) {
 	return response, nil
 }
 
-// ParseAddThingResponse parses an HTTP response from a AddThingWithResponse call
-func ParseAddThingResponse(rsp *http.Response) (*AddThingResponse, error) {
+// ParseUpdateTimeseriesByUuidResponse parses an HTTP response from a UpdateTimeseriesByUuidWithResponse call
+func ParseUpdateTimeseriesByUuidResponse(rsp *http.Response) (*UpdateTimeseriesByUuidResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddThingResponse{
+	response := &UpdateTimeseriesByUuidResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Thing
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseDeleteThingByUuidResponse parses an HTTP response from a DeleteThingByUuidWithResponse call
-func ParseDeleteThingByUuidResponse(rsp *http.Response) (*DeleteThingByUuidResponse, error) {
+// ParseDeleteDataFromTimeSeriesResponse parses an HTTP response from a DeleteDataFromTimeSeriesWithResponse call
+func ParseDeleteDataFromTimeSeriesResponse(rsp *http.Response) (*DeleteDataFromTimeSeriesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteThingByUuidResponse{
+	response := &DeleteDataFromTimeSeriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -8140,22 +18877,22 @@ func ParseDeleteThingByUuidResponse(rsp *http.Response) (*DeleteThingByUuidRespo
 	return response, nil
 }
 
-// ParseFindThingByUuidResponse parses an HTTP response from a FindThingByUuidWithResponse call
-func ParseFindThingByUuidResponse(rsp *http.Response) (*FindThingByUuidResponse, error) {
+// ParseQueryTimeseriesForDataResponse parses an HTTP response from a QueryTimeseriesForDataWithResponse call
+func ParseQueryTimeseriesForDataResponse(rsp *http.Response) (*QueryTimeseriesForDataResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindThingByUuidResponse{
+	response := &QueryTimeseriesForDataResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Thing
+		var dest []TsRow
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8166,15 +18903,15 @@ func ParseFindThingByUuidResponse(rsp *http.Response) (*FindThingByUuidResponse,
 	return response, nil
 }
 
-// ParseUpdateThingByUuidResponse parses an HTTP response from a UpdateThingByUuidWithResponse call
-func ParseUpdateThingByUuidResponse(rsp *http.Response) (*UpdateThingByUuidResponse, error) {
+// ParseAddDataToTimeseriesResponse parses an HTTP response from a AddDataToTimeseriesWithResponse call
+func ParseAddDataToTimeseriesResponse(rsp *http.Response) (*AddDataToTimeseriesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateThingByUuidResponse{
+	response := &AddDataToTimeseriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -8182,22 +18919,22 @@ func ParseUpdateThingByUuidResponse(rsp *http.Response) (*UpdateThingByUuidRespo
 	return response, nil
 }
 
-// ParseFindDatasetsForThingResponse parses an HTTP response from a FindDatasetsForThingWithResponse call
-func ParseFindDatasetsForThingResponse(rsp *http.Response) (*FindDatasetsForThingResponse, error) {
+// ParseFindTimeseriesDataHistoryResponse parses an HTTP response from a FindTimeseriesDataHistoryWithResponse call
+func ParseFindTimeseriesDataHistoryResponse(rsp *http.Response) (*FindTimeseriesDataHistoryResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindDatasetsForThingResponse{
+	response := &FindTimeseriesDataHistoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Timeseries
+		var dest []TsDataChange
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8208,22 +18945,38 @@ func ParseFindDatasetsForThingResponse(rsp *http.Response) (*FindDatasetsForThin
 	return response, nil
 }
 
-// ParseFindTimeSeriesForThingResponse parses an HTTP response from a FindTimeSeriesForThingWithResponse call
-func ParseFindTimeSeriesForThingResponse(rsp *http.Response) (*FindTimeSeriesForThingResponse, error) {
+// ParseUndoTimeseriesDataChangeResponse parses an HTTP response from a UndoTimeseriesDataChangeWithResponse call
+func ParseUndoTimeseriesDataChangeResponse(rsp *http.Response) (*UndoTimeseriesDataChangeResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindTimeSeriesForThingResponse{
+	response := &UndoTimeseriesDataChangeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseFindTimeseriesHealthResponse parses an HTTP response from a FindTimeseriesHealthWithResponse call
+func ParseFindTimeseriesHealthResponse(rsp *http.Response) (*FindTimeseriesHealthResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &FindTimeseriesHealthResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Timeseries
+		var dest []TimeseriesHealth
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8234,22 +18987,22 @@ func ParseFindTimeSeriesForThingResponse(rsp *http.Response) (*FindTimeSeriesFor
 	return response, nil
 }
 
-// ParseFindTimeSeriesResponse parses an HTTP response from a FindTimeSeriesWithResponse call
-func ParseFindTimeSeriesResponse(rsp *http.Response) (*FindTimeSeriesResponse, error) {
+// ParseFindHealthRulesResponse parses an HTTP response from a FindHealthRulesWithResponse call
+func ParseFindHealthRulesResponse(rsp *http.Response) (*FindHealthRulesResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindTimeSeriesResponse{
+	response := &FindHealthRulesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Timeseries
+		var dest []TimeseriesHealthRule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8260,22 +19013,22 @@ func ParseFindTimeSeriesResponse(rsp *http.Response) (*FindTimeSeriesResponse, e
 	return response, nil
 }
 
-// ParseAddTimeSeriesResponse parses an HTTP response from a AddTimeSeriesWithResponse call
-func ParseAddTimeSeriesResponse(rsp *http.Response) (*AddTimeSeriesResponse, error) {
+// ParseAddHealthRuleResponse parses an HTTP response from a AddHealthRuleWithResponse call
+func ParseAddHealthRuleResponse(rsp *http.Response) (*AddHealthRuleResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddTimeSeriesResponse{
+	response := &AddHealthRuleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Timeseries
+		var dest TimeseriesHealthRule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8286,15 +19039,15 @@ func ParseAddTimeSeriesResponse(rsp *http.Response) (*AddTimeSeriesResponse, err
 	return response, nil
 }
 
-// ParseDeleteTimeSeriesByUuidResponse parses an HTTP response from a DeleteTimeSeriesByUuidWithResponse call
-func ParseDeleteTimeSeriesByUuidResponse(rsp *http.Response) (*DeleteTimeSeriesByUuidResponse, error) {
+// ParseDeleteHealthRuleByUuidResponse parses an HTTP response from a DeleteHealthRuleByUuidWithResponse call
+func ParseDeleteHealthRuleByUuidResponse(rsp *http.Response) (*DeleteHealthRuleByUuidResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteTimeSeriesByUuidResponse{
+	response := &DeleteHealthRuleByUuidResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -8302,22 +19055,22 @@ func ParseDeleteTimeSeriesByUuidResponse(rsp *http.Response) (*DeleteTimeSeriesB
 	return response, nil
 }
 
-// ParseFindTimeSeriesByUuidResponse parses an HTTP response from a FindTimeSeriesByUuidWithResponse call
-func ParseFindTimeSeriesByUuidResponse(rsp *http.Response) (*FindTimeSeriesByUuidResponse, error) {
+// ParseFindHealthRuleByUuidResponse parses an HTTP response from a FindHealthRuleByUuidWithResponse call
+func ParseFindHealthRuleByUuidResponse(rsp *http.Response) (*FindHealthRuleByUuidResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FindTimeSeriesByUuidResponse{
+	response := &FindHealthRuleByUuidResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Timeseries
+		var dest TimeseriesHealthRule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8328,31 +19081,15 @@ func ParseFindTimeSeriesByUuidResponse(rsp *http.Response) (*FindTimeSeriesByUui
 	return response, nil
 }
 
-// ParseUpdateTimeseriesByUuidResponse parses an HTTP response from a UpdateTimeseriesByUuidWithResponse call
-func ParseUpdateTimeseriesByUuidResponse(rsp *http.Response) (*UpdateTimeseriesByUuidResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &UpdateTimeseriesByUuidResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
-// ParseDeleteDataFromTimeSeriesResponse parses an HTTP response from a DeleteDataFromTimeSeriesWithResponse call
-func ParseDeleteDataFromTimeSeriesResponse(rsp *http.Response) (*DeleteDataFromTimeSeriesResponse, error) {
+// ParseUpdateHealthRuleByUuidResponse parses an HTTP response from a UpdateHealthRuleByUuidWithResponse call
+func ParseUpdateHealthRuleByUuidResponse(rsp *http.Response) (*UpdateHealthRuleByUuidResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDataFromTimeSeriesResponse{
+	response := &UpdateHealthRuleByUuidResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -8360,43 +19097,41 @@ func ParseDeleteDataFromTimeSeriesResponse(rsp *http.Response) (*DeleteDataFromT
 	return response, nil
 }
 
-// ParseQueryTimeseriesForDataResponse parses an HTTP response from a QueryTimeseriesForDataWithResponse call
-func ParseQueryTimeseriesForDataResponse(rsp *http.Response) (*QueryTimeseriesForDataResponse, error) {
+// ParseImportTsdataResponse parses an HTTP response from a ImportTsdataWithResponse call
+func ParseImportTsdataResponse(rsp *http.Response) (*ImportTsdataResponse, error) {
 	bodyBytes, err := ioutil.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &QueryTimeseriesForDataResponse{
+	response := &ImportTsdataResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []TsRow
+		var dest TsImportResult
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	}
-
-	return response, nil
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TsImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// ParseAddDataToTimeseriesResponse parses an HTTP response from a AddDataToTimeseriesWithResponse call
-func ParseAddDataToTimeseriesResponse(rsp *http.Response) (*AddDataToTimeseriesResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest TsImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
 
-	response := &AddDataToTimeseriesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
 	return response, nil
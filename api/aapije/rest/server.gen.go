@@ -21,6 +21,21 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+
+	// (GET /v2/alertgroups)
+	FindAlertGroups(w http.ResponseWriter, r *http.Request, params FindAlertGroupsParams)
+
+	// (POST /v2/alertgroups)
+	AddAlertGroup(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /v2/alertgroups/{uuid})
+	DeleteAlertGroupByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/alertgroups/{uuid})
+	FindAlertGroupByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (PUT /v2/alertgroups/{uuid})
+	UpdateAlertGroupByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
 	// Get alerts.
 	// (GET /v2/alerts)
 	FindAlerts(w http.ResponseWriter, r *http.Request, params FindAlertsParams)
@@ -36,6 +51,21 @@ type ServerInterface interface {
 	// Update a specific alert.
 	// (PUT /v2/alerts/{uuid})
 	UpdateAlertByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// Acknowledge an alert.
+	// (POST /v2/alerts/{uuid}/acknowledge)
+	AcknowledgeAlert(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// List the changes of an alert.
+	// (GET /v2/alerts/{uuid}/history)
+	FindAlertHistory(w http.ResponseWriter, r *http.Request, uuid UuidParam, params FindAlertHistoryParams)
+	// Shelve an alert.
+	// (POST /v2/alerts/{uuid}/shelve)
+	ShelveAlert(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// Unacknowledge an alert.
+	// (POST /v2/alerts/{uuid}/unacknowledge)
+	UnacknowledgeAlert(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// Unshelve an alert.
+	// (POST /v2/alerts/{uuid}/unshelve)
+	UnshelveAlert(w http.ResponseWriter, r *http.Request, uuid UuidParam)
 	// Get datasets.
 	// (GET /v2/datasets)
 	FindDatasets(w http.ResponseWriter, r *http.Request, params FindDatasetsParams)
@@ -54,6 +84,15 @@ type ServerInterface interface {
 	// Assemble the uploaded parts. TBD.
 	// (POST /v2/datasets/{uuid}/assemble)
 	AssembleDatasetPartsByKey(w http.ResponseWriter, r *http.Request, uuid UuidParam, params AssembleDatasetPartsByKeyParams)
+
+	// (GET /v2/datasets/{uuid}/diff)
+	GetDatasetRevisionsDiff(w http.ResponseWriter, r *http.Request, uuid UuidParam, params GetDatasetRevisionsDiffParams)
+	// Read a part of a structured dataset
+	// (GET /v2/datasets/{uuid}/document)
+	GetDatasetDocument(w http.ResponseWriter, r *http.Request, uuid UuidParam, params GetDatasetDocumentParams)
+	// Import a CSV dataset into Time series
+	// (POST /v2/datasets/{uuid}/import)
+	ImportDatasetIntoTimeseries(w http.ResponseWriter, r *http.Request, uuid UuidParam, params ImportDatasetIntoTimeseriesParams)
 	// List parts. TBD.
 	// (GET /v2/datasets/{uuid}/parts)
 	ListDatasetPartsByKey(w http.ResponseWriter, r *http.Request, uuid UuidParam, params ListDatasetPartsByKeyParams)
@@ -63,6 +102,24 @@ type ServerInterface interface {
 	// Download dataset content
 	// (GET /v2/datasets/{uuid}/raw)
 	GetRawDatasetByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam, params GetRawDatasetByUuidParams)
+
+	// (GET /v2/datasets/{uuid}/revisions)
+	GetDatasetRevisions(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// Download dataset content at a revision
+	// (GET /v2/datasets/{uuid}/revisions/{revision_id}/raw)
+	GetRawDatasetRevision(w http.ResponseWriter, r *http.Request, uuid UuidParam, revisionId int)
+
+	// (POST /v2/datasets/{uuid}/revisions/{revision_id}/rollback)
+	RollbackDatasetToRevision(w http.ResponseWriter, r *http.Request, uuid UuidParam, revisionId int)
+
+	// (DELETE /v2/datasets/{uuid}/schema)
+	DeleteDatasetSchema(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/datasets/{uuid}/schema)
+	GetDatasetSchema(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (PUT /v2/datasets/{uuid}/schema)
+	SetDatasetSchema(w http.ResponseWriter, r *http.Request, uuid UuidParam)
 	// Cancel content upload. TBD.
 	// (DELETE /v2/datasets/{uuid}/uploads)
 	DeleteDatasetUploadByKey(w http.ResponseWriter, r *http.Request, uuid UuidParam, params DeleteDatasetUploadByKeyParams)
@@ -87,6 +144,84 @@ type ServerInterface interface {
 	// List Policies for a Group
 	// (GET /v2/groups/{uuid}/policies)
 	FindPoliciesForGroup(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/incidents)
+	FindIncidents(w http.ResponseWriter, r *http.Request, params FindIncidentsParams)
+
+	// (GET /v2/incidents/{uuid})
+	FindIncidentByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (POST /v2/incidents/{uuid}/acknowledge)
+	AcknowledgeIncident(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/incidents/{uuid}/alerts)
+	FindIncidentAlerts(w http.ResponseWriter, r *http.Request, uuid UuidParam, params FindIncidentAlertsParams)
+
+	// (POST /v2/incidents/{uuid}/close)
+	CloseIncident(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/incidents/{uuid}/history)
+	FindIncidentHistory(w http.ResponseWriter, r *http.Request, uuid UuidParam, params FindIncidentHistoryParams)
+
+	// (GET /v2/jobs)
+	FindProgramJobs(w http.ResponseWriter, r *http.Request, params FindProgramJobsParams)
+
+	// (GET /v2/jobs/{job_id})
+	FindProgramJobById(w http.ResponseWriter, r *http.Request, jobId int64)
+
+	// (POST /v2/jobs/{job_id}/requeue)
+	RequeueProgramJob(w http.ResponseWriter, r *http.Request, jobId int64)
+
+	// (GET /v2/jobs/{job_id}/runs)
+	FindProgramJobRuns(w http.ResponseWriter, r *http.Request, jobId int64)
+
+	// (GET /v2/monitors)
+	FindMonitors(w http.ResponseWriter, r *http.Request, params FindMonitorsParams)
+
+	// (POST /v2/monitors)
+	AddMonitor(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /v2/monitors/{uuid})
+	DeleteMonitorByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/monitors/{uuid})
+	FindMonitorByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (PUT /v2/monitors/{uuid})
+	UpdateMonitorByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/notifications/channels)
+	FindNotificationChannels(w http.ResponseWriter, r *http.Request, params FindNotificationChannelsParams)
+
+	// (POST /v2/notifications/channels)
+	AddNotificationChannel(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /v2/notifications/channels/{uuid})
+	DeleteNotificationChannelByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/notifications/channels/{uuid})
+	FindNotificationChannelByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (PUT /v2/notifications/channels/{uuid})
+	UpdateNotificationChannelByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/notifications/deliveries)
+	FindNotificationDeliveries(w http.ResponseWriter, r *http.Request, params FindNotificationDeliveriesParams)
+
+	// (GET /v2/notifications/rules)
+	FindNotificationRules(w http.ResponseWriter, r *http.Request, params FindNotificationRulesParams)
+
+	// (POST /v2/notifications/rules)
+	AddNotificationRule(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /v2/notifications/rules/{uuid})
+	DeleteNotificationRuleByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/notifications/rules/{uuid})
+	FindNotificationRuleByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (PUT /v2/notifications/rules/{uuid})
+	UpdateNotificationRuleByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
 	// List Policies
 	// (GET /v2/policies)
 	FindPolicies(w http.ResponseWriter, r *http.Request, params FindPoliciesParams)
@@ -135,10 +270,52 @@ type ServerInterface interface {
 
 	// (PUT /v2/programs/{uuid}/revisions/{revision_id}/sign)
 	SignProgramCodeRevisions(w http.ResponseWriter, r *http.Request, uuid UuidParam, revisionId int)
+
+	// (POST /v2/programs/{uuid}/run)
+	RunProgram(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/programs/{uuid}/runs)
+	FindProgramRuns(w http.ResponseWriter, r *http.Request, uuid UuidParam, params FindProgramRunsParams)
+
+	// (GET /v2/programs/{uuid}/runs/{run_id})
+	FindProgramRunById(w http.ResponseWriter, r *http.Request, uuid UuidParam, runId int64)
 	// TBD
 	// (POST /v2/programs/{uuid}/webhook)
 	ExecuteProgramWebhook(w http.ResponseWriter, r *http.Request, uuid UuidParam)
 
+	// (GET /v2/silences)
+	FindSilences(w http.ResponseWriter, r *http.Request, params FindSilencesParams)
+
+	// (POST /v2/silences)
+	AddSilence(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /v2/silences/{uuid})
+	DeleteSilenceByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/silences/{uuid})
+	FindSilenceByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (PUT /v2/silences/{uuid})
+	UpdateSilenceByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/templates)
+	FindThingTemplates(w http.ResponseWriter, r *http.Request, params FindThingTemplatesParams)
+
+	// (POST /v2/templates)
+	AddThingTemplate(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /v2/templates/{uuid})
+	DeleteThingTemplateByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/templates/{uuid})
+	FindThingTemplateByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (PUT /v2/templates/{uuid})
+	UpdateThingTemplateByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (POST /v2/templates/{uuid}/apply)
+	ApplyThingTemplate(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
 	// (GET /v2/things)
 	FindThings(w http.ResponseWriter, r *http.Request, params FindThingsParams)
 
@@ -153,13 +330,55 @@ type ServerInterface interface {
 	// Update Thing.
 	// (PUT /v2/things/{uuid})
 	UpdateThingByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// List ancestors of a Thing.
+	// (GET /v2/things/{uuid}/ancestors)
+	FindAncestorsForThing(w http.ResponseWriter, r *http.Request, uuid UuidParam, params FindAncestorsForThingParams)
+	// List children of a Thing.
+	// (GET /v2/things/{uuid}/children)
+	FindChildrenForThing(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// Attach a child to a Thing.
+	// (POST /v2/things/{uuid}/children)
+	AddChildToThing(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// Detach a child from a Thing.
+	// (DELETE /v2/things/{uuid}/children/{child_uuid})
+	RemoveChildFromThing(w http.ResponseWriter, r *http.Request, uuid UuidParam, childUuid string)
 	// List Datasets assigned to a Thing.
 	// (GET /v2/things/{uuid}/datasets)
 	FindDatasetsForThing(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// List descendants of a Thing.
+	// (GET /v2/things/{uuid}/descendants)
+	FindDescendantsForThing(w http.ResponseWriter, r *http.Request, uuid UuidParam, params FindDescendantsForThingParams)
+	// List state changes of a Thing.
+	// (GET /v2/things/{uuid}/history)
+	FindStateHistoryForThing(w http.ResponseWriter, r *http.Request, uuid UuidParam, params FindStateHistoryForThingParams)
+	// List parents of a Thing.
+	// (GET /v2/things/{uuid}/parents)
+	FindParentsForThing(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// Get the subtree of a Thing.
+	// (GET /v2/things/{uuid}/subtree)
+	FindSubtreeForThing(w http.ResponseWriter, r *http.Request, uuid UuidParam, params FindSubtreeForThingParams)
 	// List Timeseries assigned to a Thing.
 	// (GET /v2/things/{uuid}/timeseries)
 	FindTimeSeriesForThing(w http.ResponseWriter, r *http.Request, uuid UuidParam)
 
+	// (DELETE /v2/thingschemas)
+	DeleteThingTypeSchema(w http.ResponseWriter, r *http.Request, params DeleteThingTypeSchemaParams)
+
+	// (GET /v2/thingschemas)
+	FindThingTypeSchemas(w http.ResponseWriter, r *http.Request)
+
+	// (PUT /v2/thingschemas)
+	SetThingTypeSchema(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /v2/thingstates)
+	DeleteThingStateTransitions(w http.ResponseWriter, r *http.Request, params DeleteThingStateTransitionsParams)
+
+	// (GET /v2/thingstates)
+	FindThingStateTransitions(w http.ResponseWriter, r *http.Request)
+
+	// (PUT /v2/thingstates)
+	SetThingStateTransitions(w http.ResponseWriter, r *http.Request)
+
 	// (GET /v2/timeseries)
 	FindTimeSeries(w http.ResponseWriter, r *http.Request, params FindTimeSeriesParams)
 
@@ -183,6 +402,33 @@ type ServerInterface interface {
 	// Add data to Timeseries
 	// (POST /v2/timeseries/{uuid}/data)
 	AddDataToTimeseries(w http.ResponseWriter, r *http.Request, uuid UuidParam, params AddDataToTimeseriesParams)
+	// Get the change history of Timeseries data.
+	// (GET /v2/timeseries/{uuid}/history)
+	FindTimeseriesDataHistory(w http.ResponseWriter, r *http.Request, uuid UuidParam, params FindTimeseriesDataHistoryParams)
+	// Undo a change to Timeseries data.
+	// (POST /v2/timeseries/{uuid}/history/{change_id}/undo)
+	UndoTimeseriesDataChange(w http.ResponseWriter, r *http.Request, uuid UuidParam, changeId int64)
+
+	// (GET /v2/tshealth)
+	FindTimeseriesHealth(w http.ResponseWriter, r *http.Request, params FindTimeseriesHealthParams)
+
+	// (GET /v2/tshealth/rules)
+	FindHealthRules(w http.ResponseWriter, r *http.Request, params FindHealthRulesParams)
+
+	// (POST /v2/tshealth/rules)
+	AddHealthRule(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /v2/tshealth/rules/{uuid})
+	DeleteHealthRuleByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (GET /v2/tshealth/rules/{uuid})
+	FindHealthRuleByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+
+	// (PUT /v2/tshealth/rules/{uuid})
+	UpdateHealthRuleByUuid(w http.ResponseWriter, r *http.Request, uuid UuidParam)
+	// Import a CSV file into Time series
+	// (POST /v2/tsimport)
+	ImportTsdata(w http.ResponseWriter, r *http.Request, params ImportTsdataParams)
 	// Query for data from Time series.
 	// (GET /v2/tsquery)
 	FindTsdataByQuery(w http.ResponseWriter, r *http.Request, params FindTsdataByQueryParams)
@@ -230,6 +476,151 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
 
+// FindAlertGroups operation middleware
+func (siw *ServerInterfaceWrapper) FindAlertGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:alertgroups"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindAlertGroupsParams
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindAlertGroups(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddAlertGroup operation middleware
+func (siw *ServerInterfaceWrapper) AddAlertGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:alertgroups"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddAlertGroup(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteAlertGroupByUuid operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAlertGroupByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:alertgroups/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAlertGroupByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindAlertGroupByUuid operation middleware
+func (siw *ServerInterfaceWrapper) FindAlertGroupByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:alertgroups/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindAlertGroupByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateAlertGroupByUuid operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAlertGroupByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:alertgroups/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateAlertGroupByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // FindAlerts operation middleware
 func (siw *ServerInterfaceWrapper) FindAlerts(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -373,6 +764,17 @@ func (siw *ServerInterfaceWrapper) FindAlerts(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Optional query parameter "silenced" -------------
+	if paramValue := r.URL.Query().Get("silenced"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "silenced", r.URL.Query(), &params.Silenced)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "silenced", Err: err})
+		return
+	}
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.FindAlerts(w, r, params)
 	}
@@ -485,28 +887,54 @@ func (siw *ServerInterfaceWrapper) UpdateAlertByUuid(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
-// FindDatasets operation middleware
-func (siw *ServerInterfaceWrapper) FindDatasets(w http.ResponseWriter, r *http.Request) {
+// AcknowledgeAlert operation middleware
+func (siw *ServerInterfaceWrapper) AcknowledgeAlert(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:datasets"})
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params FindDatasetsParams
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
 
-	// ------------- Optional query parameter "offset" -------------
-	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:alerts/{uuid}"})
 
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcknowledgeAlert(w, r, uuid)
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindAlertHistory operation middleware
+func (siw *ServerInterfaceWrapper) FindAlertHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:alerts/{uuid}"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindAlertHistoryParams
+
 	// ------------- Optional query parameter "limit" -------------
 	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
 
@@ -518,19 +946,19 @@ func (siw *ServerInterfaceWrapper) FindDatasets(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// ------------- Optional query parameter "tags" -------------
-	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindDatasets(w, r, params)
+		siw.Handler.FindAlertHistory(w, r, uuid, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -540,18 +968,157 @@ func (siw *ServerInterfaceWrapper) FindDatasets(w http.ResponseWriter, r *http.R
 	handler(w, r.WithContext(ctx))
 }
 
-// AddDatasets operation middleware
-func (siw *ServerInterfaceWrapper) AddDatasets(w http.ResponseWriter, r *http.Request) {
+// ShelveAlert operation middleware
+func (siw *ServerInterfaceWrapper) ShelveAlert(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:datasets"})
+	var err error
 
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddDatasets(w, r)
-	}
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:alerts/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ShelveAlert(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UnacknowledgeAlert operation middleware
+func (siw *ServerInterfaceWrapper) UnacknowledgeAlert(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:alerts/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnacknowledgeAlert(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UnshelveAlert operation middleware
+func (siw *ServerInterfaceWrapper) UnshelveAlert(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:alerts/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnshelveAlert(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindDatasets operation middleware
+func (siw *ServerInterfaceWrapper) FindDatasets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:datasets"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindDatasetsParams
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindDatasets(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddDatasets operation middleware
+func (siw *ServerInterfaceWrapper) AddDatasets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:datasets"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddDatasets(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
 	handler(w, r.WithContext(ctx))
@@ -725,8 +1292,8 @@ func (siw *ServerInterfaceWrapper) AssembleDatasetPartsByKey(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
-// ListDatasetPartsByKey operation middleware
-func (siw *ServerInterfaceWrapper) ListDatasetPartsByKey(w http.ResponseWriter, r *http.Request) {
+// GetDatasetRevisionsDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetDatasetRevisionsDiff(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -740,27 +1307,41 @@ func (siw *ServerInterfaceWrapper) ListDatasetPartsByKey(w http.ResponseWriter,
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:datasets/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:datasets/{uuid}/diff"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListDatasetPartsByKeyParams
+	var params GetDatasetRevisionsDiffParams
 
-	// ------------- Required query parameter "key" -------------
-	if paramValue := r.URL.Query().Get("key"); paramValue != "" {
+	// ------------- Required query parameter "rev_a" -------------
+	if paramValue := r.URL.Query().Get("rev_a"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "key"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "rev_a"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "key", r.URL.Query(), &params.Key)
+	err = runtime.BindQueryParameter("form", true, true, "rev_a", r.URL.Query(), &params.RevA)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rev_a", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "rev_b" -------------
+	if paramValue := r.URL.Query().Get("rev_b"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "rev_b"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "rev_b", r.URL.Query(), &params.RevB)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rev_b", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListDatasetPartsByKey(w, r, uuid, params)
+		siw.Handler.GetDatasetRevisionsDiff(w, r, uuid, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -770,8 +1351,8 @@ func (siw *ServerInterfaceWrapper) ListDatasetPartsByKey(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// UploadDatasetContentByKey operation middleware
-func (siw *ServerInterfaceWrapper) UploadDatasetContentByKey(w http.ResponseWriter, r *http.Request) {
+// GetDatasetDocument operation middleware
+func (siw *ServerInterfaceWrapper) GetDatasetDocument(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -785,66 +1366,35 @@ func (siw *ServerInterfaceWrapper) UploadDatasetContentByKey(w http.ResponseWrit
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:datasets/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:datasets/{uuid}/document"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params UploadDatasetContentByKeyParams
+	var params GetDatasetDocumentParams
 
-	// ------------- Required query parameter "partNumber" -------------
-	if paramValue := r.URL.Query().Get("partNumber"); paramValue != "" {
+	// ------------- Optional query parameter "pointer" -------------
+	if paramValue := r.URL.Query().Get("pointer"); paramValue != "" {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "partNumber"})
-		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "partNumber", r.URL.Query(), &params.PartNumber)
+	err = runtime.BindQueryParameter("form", true, false, "pointer", r.URL.Query(), &params.Pointer)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "partNumber", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pointer", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "uploadId" -------------
-	if paramValue := r.URL.Query().Get("uploadId"); paramValue != "" {
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "uploadId"})
-		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "uploadId", r.URL.Query(), &params.UploadId)
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadId", Err: err})
-		return
-	}
-
-	headers := r.Header
-
-	// ------------- Required header parameter "Content-MD5" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("Content-MD5")]; found {
-		var ContentMD5 string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Content-MD5", Count: n})
-			return
-		}
-
-		err = runtime.BindStyledParameterWithLocation("simple", false, "Content-MD5", runtime.ParamLocationHeader, valueList[0], &ContentMD5)
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Content-MD5", Err: err})
-			return
-		}
-
-		params.ContentMD5 = ContentMD5
-
-	} else {
-		err := fmt.Errorf("Header parameter Content-MD5 is required, but not found")
-		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Content-MD5", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadDatasetContentByKey(w, r, uuid, params)
+		siw.Handler.GetDatasetDocument(w, r, uuid, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -854,8 +1404,8 @@ func (siw *ServerInterfaceWrapper) UploadDatasetContentByKey(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
-// GetRawDatasetByUuid operation middleware
-func (siw *ServerInterfaceWrapper) GetRawDatasetByUuid(w http.ResponseWriter, r *http.Request) {
+// ImportDatasetIntoTimeseries operation middleware
+func (siw *ServerInterfaceWrapper) ImportDatasetIntoTimeseries(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -869,34 +1419,24 @@ func (siw *ServerInterfaceWrapper) GetRawDatasetByUuid(w http.ResponseWriter, r
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:datasets/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:datasets/{uuid}", "create:tsimport"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetRawDatasetByUuidParams
+	var params ImportDatasetIntoTimeseriesParams
 
-	headers := r.Header
-
-	// ------------- Optional header parameter "If-None-Match" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
-		var IfNoneMatch IfNoneMatchParam
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
-			return
-		}
-
-		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
-			return
-		}
+	// ------------- Optional query parameter "dry_run" -------------
+	if paramValue := r.URL.Query().Get("dry_run"); paramValue != "" {
 
-		params.IfNoneMatch = &IfNoneMatch
+	}
 
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRawDatasetByUuid(w, r, uuid, params)
+		siw.Handler.ImportDatasetIntoTimeseries(w, r, uuid, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -906,8 +1446,8 @@ func (siw *ServerInterfaceWrapper) GetRawDatasetByUuid(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// DeleteDatasetUploadByKey operation middleware
-func (siw *ServerInterfaceWrapper) DeleteDatasetUploadByKey(w http.ResponseWriter, r *http.Request) {
+// ListDatasetPartsByKey operation middleware
+func (siw *ServerInterfaceWrapper) ListDatasetPartsByKey(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -921,10 +1461,10 @@ func (siw *ServerInterfaceWrapper) DeleteDatasetUploadByKey(w http.ResponseWrite
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:datasets/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:datasets/{uuid}"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteDatasetUploadByKeyParams
+	var params ListDatasetPartsByKeyParams
 
 	// ------------- Required query parameter "key" -------------
 	if paramValue := r.URL.Query().Get("key"); paramValue != "" {
@@ -941,7 +1481,7 @@ func (siw *ServerInterfaceWrapper) DeleteDatasetUploadByKey(w http.ResponseWrite
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteDatasetUploadByKey(w, r, uuid, params)
+		siw.Handler.ListDatasetPartsByKey(w, r, uuid, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -951,8 +1491,8 @@ func (siw *ServerInterfaceWrapper) DeleteDatasetUploadByKey(w http.ResponseWrite
 	handler(w, r.WithContext(ctx))
 }
 
-// InitializeDatasetUploadByUuid operation middleware
-func (siw *ServerInterfaceWrapper) InitializeDatasetUploadByUuid(w http.ResponseWriter, r *http.Request) {
+// UploadDatasetContentByKey operation middleware
+func (siw *ServerInterfaceWrapper) UploadDatasetContentByKey(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -968,69 +1508,64 @@ func (siw *ServerInterfaceWrapper) InitializeDatasetUploadByUuid(w http.Response
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:datasets/{uuid}"})
 
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.InitializeDatasetUploadByUuid(w, r, uuid)
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadDatasetContentByKeyParams
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Required query parameter "partNumber" -------------
+	if paramValue := r.URL.Query().Get("partNumber"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "partNumber"})
+		return
 	}
 
-	handler(w, r.WithContext(ctx))
-}
-
-// FindGroups operation middleware
-func (siw *ServerInterfaceWrapper) FindGroups(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:groups"})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params FindGroupsParams
-
-	// ------------- Optional query parameter "limit" -------------
-	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
-
-	}
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, true, "partNumber", r.URL.Query(), &params.PartNumber)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "partNumber", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
-	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+	// ------------- Required query parameter "uploadId" -------------
+	if paramValue := r.URL.Query().Get("uploadId"); paramValue != "" {
 
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "uploadId"})
+		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, true, "uploadId", r.URL.Query(), &params.UploadId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadId", Err: err})
 		return
 	}
 
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindGroups(w, r, params)
-	}
+	headers := r.Header
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ------------- Required header parameter "Content-MD5" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Content-MD5")]; found {
+		var ContentMD5 string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Content-MD5", Count: n})
+			return
+		}
 
-	handler(w, r.WithContext(ctx))
-}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Content-MD5", runtime.ParamLocationHeader, valueList[0], &ContentMD5)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Content-MD5", Err: err})
+			return
+		}
 
-// AddGroup operation middleware
-func (siw *ServerInterfaceWrapper) AddGroup(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+		params.ContentMD5 = ContentMD5
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:groups"})
+	} else {
+		err := fmt.Errorf("Header parameter Content-MD5 is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Content-MD5", Err: err})
+		return
+	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddGroup(w, r)
+		siw.Handler.UploadDatasetContentByKey(w, r, uuid, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1040,8 +1575,8 @@ func (siw *ServerInterfaceWrapper) AddGroup(w http.ResponseWriter, r *http.Reque
 	handler(w, r.WithContext(ctx))
 }
 
-// DeleteGroupByUuid operation middleware
-func (siw *ServerInterfaceWrapper) DeleteGroupByUuid(w http.ResponseWriter, r *http.Request) {
+// GetRawDatasetByUuid operation middleware
+func (siw *ServerInterfaceWrapper) GetRawDatasetByUuid(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1055,38 +1590,91 @@ func (siw *ServerInterfaceWrapper) DeleteGroupByUuid(w http.ResponseWriter, r *h
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:groups/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:datasets/{uuid}"})
 
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteGroupByUuid(w, r, uuid)
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRawDatasetByUuidParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatchParam
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
 	}
 
-	handler(w, r.WithContext(ctx))
-}
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSinceParam
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
 
-// FindGroupByUuid operation middleware
-func (siw *ServerInterfaceWrapper) FindGroupByUuid(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Modified-Since", runtime.ParamLocationHeader, valueList[0], &IfModifiedSince)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
 
-	var err error
+		params.IfModifiedSince = &IfModifiedSince
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid UuidParam
+	}
+
+	// ------------- Optional header parameter "Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Range")]; found {
+		var Range RangeParam
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Range", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Range", runtime.ParamLocationHeader, valueList[0], &Range)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Range", Err: err})
+			return
+		}
+
+		params.Range = &Range
 
-	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
-		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:groups/{uuid}"})
+	// ------------- Optional header parameter "If-Range" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Range")]; found {
+		var IfRange IfRangeParam
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Range", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Range", runtime.ParamLocationHeader, valueList[0], &IfRange)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Range", Err: err})
+			return
+		}
+
+		params.IfRange = &IfRange
+
+	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindGroupByUuid(w, r, uuid)
+		siw.Handler.GetRawDatasetByUuid(w, r, uuid, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1096,8 +1684,8 @@ func (siw *ServerInterfaceWrapper) FindGroupByUuid(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
-// UpdateGroupByUuid operation middleware
-func (siw *ServerInterfaceWrapper) UpdateGroupByUuid(w http.ResponseWriter, r *http.Request) {
+// GetDatasetRevisions operation middleware
+func (siw *ServerInterfaceWrapper) GetDatasetRevisions(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1111,10 +1699,10 @@ func (siw *ServerInterfaceWrapper) UpdateGroupByUuid(w http.ResponseWriter, r *h
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:groups/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:datasets/{uuid}/revisions"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateGroupByUuid(w, r, uuid)
+		siw.Handler.GetDatasetRevisions(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1124,8 +1712,8 @@ func (siw *ServerInterfaceWrapper) UpdateGroupByUuid(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
-// FindPoliciesForGroup operation middleware
-func (siw *ServerInterfaceWrapper) FindPoliciesForGroup(w http.ResponseWriter, r *http.Request) {
+// GetRawDatasetRevision operation middleware
+func (siw *ServerInterfaceWrapper) GetRawDatasetRevision(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1139,10 +1727,19 @@ func (siw *ServerInterfaceWrapper) FindPoliciesForGroup(w http.ResponseWriter, r
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:groups/{uuid}"})
+	// ------------- Path parameter "revision_id" -------------
+	var revisionId int
+
+	err = runtime.BindStyledParameter("simple", false, "revision_id", chi.URLParam(r, "revision_id"), &revisionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:datasets/{uuid}/revisions/{revision_id}"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindPoliciesForGroup(w, r, uuid)
+		siw.Handler.GetRawDatasetRevision(w, r, uuid, revisionId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1152,52 +1749,34 @@ func (siw *ServerInterfaceWrapper) FindPoliciesForGroup(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// FindPolicies operation middleware
-func (siw *ServerInterfaceWrapper) FindPolicies(w http.ResponseWriter, r *http.Request) {
+// RollbackDatasetToRevision operation middleware
+func (siw *ServerInterfaceWrapper) RollbackDatasetToRevision(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:policies"})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params FindPoliciesParams
-
-	// ------------- Optional query parameter "offset" -------------
-	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
-
-	}
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
-	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
-
-	}
+	// ------------- Path parameter "revision_id" -------------
+	var revisionId int
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindStyledParameter("simple", false, "revision_id", chi.URLParam(r, "revision_id"), &revisionId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "group_uuids" -------------
-	if paramValue := r.URL.Query().Get("group_uuids"); paramValue != "" {
-
-	}
-
-	err = runtime.BindQueryParameter("form", true, false, "group_uuids", r.URL.Query(), &params.GroupUuids)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_uuids", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:datasets/{uuid}"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindPolicies(w, r, params)
+		siw.Handler.RollbackDatasetToRevision(w, r, uuid, revisionId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1207,14 +1786,25 @@ func (siw *ServerInterfaceWrapper) FindPolicies(w http.ResponseWriter, r *http.R
 	handler(w, r.WithContext(ctx))
 }
 
-// AddPolicy operation middleware
-func (siw *ServerInterfaceWrapper) AddPolicy(w http.ResponseWriter, r *http.Request) {
+// DeleteDatasetSchema operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDatasetSchema(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:policies"})
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:datasets/{uuid}/schema"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddPolicy(w, r)
+		siw.Handler.DeleteDatasetSchema(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1224,8 +1814,8 @@ func (siw *ServerInterfaceWrapper) AddPolicy(w http.ResponseWriter, r *http.Requ
 	handler(w, r.WithContext(ctx))
 }
 
-// DeletePolicyByUuid operation middleware
-func (siw *ServerInterfaceWrapper) DeletePolicyByUuid(w http.ResponseWriter, r *http.Request) {
+// GetDatasetSchema operation middleware
+func (siw *ServerInterfaceWrapper) GetDatasetSchema(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1239,10 +1829,10 @@ func (siw *ServerInterfaceWrapper) DeletePolicyByUuid(w http.ResponseWriter, r *
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:policies/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:datasets/{uuid}/schema"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeletePolicyByUuid(w, r, uuid)
+		siw.Handler.GetDatasetSchema(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1252,8 +1842,8 @@ func (siw *ServerInterfaceWrapper) DeletePolicyByUuid(w http.ResponseWriter, r *
 	handler(w, r.WithContext(ctx))
 }
 
-// FindPolicyByUuid operation middleware
-func (siw *ServerInterfaceWrapper) FindPolicyByUuid(w http.ResponseWriter, r *http.Request) {
+// SetDatasetSchema operation middleware
+func (siw *ServerInterfaceWrapper) SetDatasetSchema(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1267,10 +1857,10 @@ func (siw *ServerInterfaceWrapper) FindPolicyByUuid(w http.ResponseWriter, r *ht
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:policies/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:datasets/{uuid}/schema"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindPolicyByUuid(w, r, uuid)
+		siw.Handler.SetDatasetSchema(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1280,8 +1870,8 @@ func (siw *ServerInterfaceWrapper) FindPolicyByUuid(w http.ResponseWriter, r *ht
 	handler(w, r.WithContext(ctx))
 }
 
-// UpdatePolicyByUuid operation middleware
-func (siw *ServerInterfaceWrapper) UpdatePolicyByUuid(w http.ResponseWriter, r *http.Request) {
+// DeleteDatasetUploadByKey operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDatasetUploadByKey(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1295,10 +1885,27 @@ func (siw *ServerInterfaceWrapper) UpdatePolicyByUuid(w http.ResponseWriter, r *
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:policies/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:datasets/{uuid}"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDatasetUploadByKeyParams
+
+	// ------------- Required query parameter "key" -------------
+	if paramValue := r.URL.Query().Get("key"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "key"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "key", r.URL.Query(), &params.Key)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdatePolicyByUuid(w, r, uuid)
+		siw.Handler.DeleteDatasetUploadByKey(w, r, uuid, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1308,28 +1915,45 @@ func (siw *ServerInterfaceWrapper) UpdatePolicyByUuid(w http.ResponseWriter, r *
 	handler(w, r.WithContext(ctx))
 }
 
-// FindPrograms operation middleware
-func (siw *ServerInterfaceWrapper) FindPrograms(w http.ResponseWriter, r *http.Request) {
+// InitializeDatasetUploadByUuid operation middleware
+func (siw *ServerInterfaceWrapper) InitializeDatasetUploadByUuid(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:programs"})
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params FindProgramsParams
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
 
-	// ------------- Optional query parameter "offset" -------------
-	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:datasets/{uuid}"})
 
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InitializeDatasetUploadByUuid(w, r, uuid)
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler(w, r.WithContext(ctx))
+}
+
+// FindGroups operation middleware
+func (siw *ServerInterfaceWrapper) FindGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:groups"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindGroupsParams
+
 	// ------------- Optional query parameter "limit" -------------
 	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
 
@@ -1341,19 +1965,19 @@ func (siw *ServerInterfaceWrapper) FindPrograms(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// ------------- Optional query parameter "tags" -------------
-	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindPrograms(w, r, params)
+		siw.Handler.FindGroups(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1363,14 +1987,14 @@ func (siw *ServerInterfaceWrapper) FindPrograms(w http.ResponseWriter, r *http.R
 	handler(w, r.WithContext(ctx))
 }
 
-// AddProgram operation middleware
-func (siw *ServerInterfaceWrapper) AddProgram(w http.ResponseWriter, r *http.Request) {
+// AddGroup operation middleware
+func (siw *ServerInterfaceWrapper) AddGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:programs"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:groups"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddProgram(w, r)
+		siw.Handler.AddGroup(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1380,8 +2004,8 @@ func (siw *ServerInterfaceWrapper) AddProgram(w http.ResponseWriter, r *http.Req
 	handler(w, r.WithContext(ctx))
 }
 
-// DeleteProgramByUuid operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProgramByUuid(w http.ResponseWriter, r *http.Request) {
+// DeleteGroupByUuid operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGroupByUuid(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1395,10 +2019,10 @@ func (siw *ServerInterfaceWrapper) DeleteProgramByUuid(w http.ResponseWriter, r
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:programs/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:groups/{uuid}"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteProgramByUuid(w, r, uuid)
+		siw.Handler.DeleteGroupByUuid(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1408,8 +2032,8 @@ func (siw *ServerInterfaceWrapper) DeleteProgramByUuid(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// FindProgramByUuid operation middleware
-func (siw *ServerInterfaceWrapper) FindProgramByUuid(w http.ResponseWriter, r *http.Request) {
+// FindGroupByUuid operation middleware
+func (siw *ServerInterfaceWrapper) FindGroupByUuid(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1423,10 +2047,10 @@ func (siw *ServerInterfaceWrapper) FindProgramByUuid(w http.ResponseWriter, r *h
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:programs/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:groups/{uuid}"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindProgramByUuid(w, r, uuid)
+		siw.Handler.FindGroupByUuid(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1436,8 +2060,8 @@ func (siw *ServerInterfaceWrapper) FindProgramByUuid(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
-// UpdateProgramByUuid operation middleware
-func (siw *ServerInterfaceWrapper) UpdateProgramByUuid(w http.ResponseWriter, r *http.Request) {
+// UpdateGroupByUuid operation middleware
+func (siw *ServerInterfaceWrapper) UpdateGroupByUuid(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1451,10 +2075,10 @@ func (siw *ServerInterfaceWrapper) UpdateProgramByUuid(w http.ResponseWriter, r
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:programs/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:groups/{uuid}"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateProgramByUuid(w, r, uuid)
+		siw.Handler.UpdateGroupByUuid(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1464,8 +2088,8 @@ func (siw *ServerInterfaceWrapper) UpdateProgramByUuid(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// GetCodeFromProgram operation middleware
-func (siw *ServerInterfaceWrapper) GetCodeFromProgram(w http.ResponseWriter, r *http.Request) {
+// FindPoliciesForGroup operation middleware
+func (siw *ServerInterfaceWrapper) FindPoliciesForGroup(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1479,10 +2103,10 @@ func (siw *ServerInterfaceWrapper) GetCodeFromProgram(w http.ResponseWriter, r *
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:programs/{uuid}/code"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:groups/{uuid}"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCodeFromProgram(w, r, uuid)
+		siw.Handler.FindPoliciesForGroup(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1492,84 +2116,63 @@ func (siw *ServerInterfaceWrapper) GetCodeFromProgram(w http.ResponseWriter, r *
 	handler(w, r.WithContext(ctx))
 }
 
-// AddProgramCodeRevision operation middleware
-func (siw *ServerInterfaceWrapper) AddProgramCodeRevision(w http.ResponseWriter, r *http.Request) {
+// FindIncidents operation middleware
+func (siw *ServerInterfaceWrapper) FindIncidents(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid UuidParam
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:incidents"})
 
-	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
-		return
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindIncidentsParams
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:programs/{uuid}/code"})
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
 
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddProgramCodeRevision(w, r, uuid)
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
 	}
 
-	handler(w, r.WithContext(ctx))
-}
-
-// GetProgramCodeRevisionsDiff operation middleware
-func (siw *ServerInterfaceWrapper) GetProgramCodeRevisionsDiff(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid UuidParam
+	}
 
-	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:programs/{uuid}/revisions/diff"})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetProgramCodeRevisionsDiffParams
-
-	// ------------- Required query parameter "rev_a" -------------
-	if paramValue := r.URL.Query().Get("rev_a"); paramValue != "" {
+	// ------------- Optional query parameter "status" -------------
+	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "rev_a"})
-		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "rev_a", r.URL.Query(), &params.RevA)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rev_a", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "rev_b" -------------
-	if paramValue := r.URL.Query().Get("rev_b"); paramValue != "" {
+	// ------------- Optional query parameter "group" -------------
+	if paramValue := r.URL.Query().Get("group"); paramValue != "" {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "rev_b"})
-		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "rev_b", r.URL.Query(), &params.RevB)
+	err = runtime.BindQueryParameter("form", true, false, "group", r.URL.Query(), &params.Group)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rev_b", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProgramCodeRevisionsDiff(w, r, uuid, params)
+		siw.Handler.FindIncidents(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1579,8 +2182,8 @@ func (siw *ServerInterfaceWrapper) GetProgramCodeRevisionsDiff(w http.ResponseWr
 	handler(w, r.WithContext(ctx))
 }
 
-// GetProgramCodeRevisions operation middleware
-func (siw *ServerInterfaceWrapper) GetProgramCodeRevisions(w http.ResponseWriter, r *http.Request) {
+// FindIncidentByUuid operation middleware
+func (siw *ServerInterfaceWrapper) FindIncidentByUuid(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1594,10 +2197,10 @@ func (siw *ServerInterfaceWrapper) GetProgramCodeRevisions(w http.ResponseWriter
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:programs/{uuid}/revisions"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:incidents/{uuid}"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProgramCodeRevisions(w, r, uuid)
+		siw.Handler.FindIncidentByUuid(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1607,8 +2210,8 @@ func (siw *ServerInterfaceWrapper) GetProgramCodeRevisions(w http.ResponseWriter
 	handler(w, r.WithContext(ctx))
 }
 
-// DeleteProgramCodeRevisions operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProgramCodeRevisions(w http.ResponseWriter, r *http.Request) {
+// AcknowledgeIncident operation middleware
+func (siw *ServerInterfaceWrapper) AcknowledgeIncident(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1622,19 +2225,10 @@ func (siw *ServerInterfaceWrapper) DeleteProgramCodeRevisions(w http.ResponseWri
 		return
 	}
 
-	// ------------- Path parameter "revision_id" -------------
-	var revisionId int
-
-	err = runtime.BindStyledParameter("simple", false, "revision_id", chi.URLParam(r, "revision_id"), &revisionId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision_id", Err: err})
-		return
-	}
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:programs/{uuid}/revisions/{revision_id}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:incidents/{uuid}"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteProgramCodeRevisions(w, r, uuid, revisionId)
+		siw.Handler.AcknowledgeIncident(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1644,8 +2238,8 @@ func (siw *ServerInterfaceWrapper) DeleteProgramCodeRevisions(w http.ResponseWri
 	handler(w, r.WithContext(ctx))
 }
 
-// SignProgramCodeRevisions operation middleware
-func (siw *ServerInterfaceWrapper) SignProgramCodeRevisions(w http.ResponseWriter, r *http.Request) {
+// FindIncidentAlerts operation middleware
+func (siw *ServerInterfaceWrapper) FindIncidentAlerts(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1659,19 +2253,35 @@ func (siw *ServerInterfaceWrapper) SignProgramCodeRevisions(w http.ResponseWrite
 		return
 	}
 
-	// ------------- Path parameter "revision_id" -------------
-	var revisionId int
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:incidents/{uuid}"})
 
-	err = runtime.BindStyledParameter("simple", false, "revision_id", chi.URLParam(r, "revision_id"), &revisionId)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindIncidentAlertsParams
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:programs/{uuid}/revisions/{revision_id}"})
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SignProgramCodeRevisions(w, r, uuid, revisionId)
+		siw.Handler.FindIncidentAlerts(w, r, uuid, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1681,8 +2291,8 @@ func (siw *ServerInterfaceWrapper) SignProgramCodeRevisions(w http.ResponseWrite
 	handler(w, r.WithContext(ctx))
 }
 
-// ExecuteProgramWebhook operation middleware
-func (siw *ServerInterfaceWrapper) ExecuteProgramWebhook(w http.ResponseWriter, r *http.Request) {
+// CloseIncident operation middleware
+func (siw *ServerInterfaceWrapper) CloseIncident(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1696,10 +2306,10 @@ func (siw *ServerInterfaceWrapper) ExecuteProgramWebhook(w http.ResponseWriter,
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:programs/{uuid}/webhook"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:incidents/{uuid}"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExecuteProgramWebhook(w, r, uuid)
+		siw.Handler.CloseIncident(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1709,16 +2319,25 @@ func (siw *ServerInterfaceWrapper) ExecuteProgramWebhook(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// FindThings operation middleware
-func (siw *ServerInterfaceWrapper) FindThings(w http.ResponseWriter, r *http.Request) {
+// FindIncidentHistory operation middleware
+func (siw *ServerInterfaceWrapper) FindIncidentHistory(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things"})
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:incidents/{uuid}"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params FindThingsParams
+	var params FindIncidentHistoryParams
 
 	// ------------- Optional query parameter "limit" -------------
 	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
@@ -1742,19 +2361,8 @@ func (siw *ServerInterfaceWrapper) FindThings(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// ------------- Optional query parameter "tags" -------------
-	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
-
-	}
-
-	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
-		return
-	}
-
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindThings(w, r, params)
+		siw.Handler.FindIncidentHistory(w, r, uuid, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1764,70 +2372,63 @@ func (siw *ServerInterfaceWrapper) FindThings(w http.ResponseWriter, r *http.Req
 	handler(w, r.WithContext(ctx))
 }
 
-// AddThing operation middleware
-func (siw *ServerInterfaceWrapper) AddThing(w http.ResponseWriter, r *http.Request) {
+// FindProgramJobs operation middleware
+func (siw *ServerInterfaceWrapper) FindProgramJobs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:things"})
-
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddThing(w, r)
-	}
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	var err error
 
-	handler(w, r.WithContext(ctx))
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:jobs"})
 
-// DeleteThingByUuid operation middleware
-func (siw *ServerInterfaceWrapper) DeleteThingByUuid(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindProgramJobsParams
 
-	var err error
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid UuidParam
+	}
 
-	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:things/{uuid}"})
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
 
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteThingByUuid(w, r, uuid)
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
 	}
 
-	handler(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "status" -------------
+	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
 
-// FindThingByUuid operation middleware
-func (siw *ServerInterfaceWrapper) FindThingByUuid(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	}
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid UuidParam
+	// ------------- Optional query parameter "program" -------------
+	if paramValue := r.URL.Query().Get("program"); paramValue != "" {
 
-	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "program", r.URL.Query(), &params.Program)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "program", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things/{uuid}"})
-
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindThingByUuid(w, r, uuid)
+		siw.Handler.FindProgramJobs(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1837,25 +2438,25 @@ func (siw *ServerInterfaceWrapper) FindThingByUuid(w http.ResponseWriter, r *htt
 	handler(w, r.WithContext(ctx))
 }
 
-// UpdateThingByUuid operation middleware
-func (siw *ServerInterfaceWrapper) UpdateThingByUuid(w http.ResponseWriter, r *http.Request) {
+// FindProgramJobById operation middleware
+func (siw *ServerInterfaceWrapper) FindProgramJobById(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid UuidParam
+	// ------------- Path parameter "job_id" -------------
+	var jobId int64
 
-	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	err = runtime.BindStyledParameter("simple", false, "job_id", chi.URLParam(r, "job_id"), &jobId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "job_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:things/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:jobs/{job_id}"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateThingByUuid(w, r, uuid)
+		siw.Handler.FindProgramJobById(w, r, jobId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1865,25 +2466,25 @@ func (siw *ServerInterfaceWrapper) UpdateThingByUuid(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
-// FindDatasetsForThing operation middleware
-func (siw *ServerInterfaceWrapper) FindDatasetsForThing(w http.ResponseWriter, r *http.Request) {
+// RequeueProgramJob operation middleware
+func (siw *ServerInterfaceWrapper) RequeueProgramJob(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid UuidParam
+	// ------------- Path parameter "job_id" -------------
+	var jobId int64
 
-	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	err = runtime.BindStyledParameter("simple", false, "job_id", chi.URLParam(r, "job_id"), &jobId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "job_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things/{uuid}/datasets"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:jobs/{job_id}"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindDatasetsForThing(w, r, uuid)
+		siw.Handler.RequeueProgramJob(w, r, jobId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1893,25 +2494,25 @@ func (siw *ServerInterfaceWrapper) FindDatasetsForThing(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// FindTimeSeriesForThing operation middleware
-func (siw *ServerInterfaceWrapper) FindTimeSeriesForThing(w http.ResponseWriter, r *http.Request) {
+// FindProgramJobRuns operation middleware
+func (siw *ServerInterfaceWrapper) FindProgramJobRuns(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid UuidParam
+	// ------------- Path parameter "job_id" -------------
+	var jobId int64
 
-	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	err = runtime.BindStyledParameter("simple", false, "job_id", chi.URLParam(r, "job_id"), &jobId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "job_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:jobs/{job_id}/runs"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindTimeSeriesForThing(w, r, uuid)
+		siw.Handler.FindProgramJobRuns(w, r, jobId)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1921,16 +2522,16 @@ func (siw *ServerInterfaceWrapper) FindTimeSeriesForThing(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// FindTimeSeries operation middleware
-func (siw *ServerInterfaceWrapper) FindTimeSeries(w http.ResponseWriter, r *http.Request) {
+// FindMonitors operation middleware
+func (siw *ServerInterfaceWrapper) FindMonitors(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:timeseries"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:monitors"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params FindTimeSeriesParams
+	var params FindMonitorsParams
 
 	// ------------- Optional query parameter "limit" -------------
 	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
@@ -1954,19 +2555,19 @@ func (siw *ServerInterfaceWrapper) FindTimeSeries(w http.ResponseWriter, r *http
 		return
 	}
 
-	// ------------- Optional query parameter "tags" -------------
-	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+	// ------------- Optional query parameter "timeseries" -------------
+	if paramValue := r.URL.Query().Get("timeseries"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	err = runtime.BindQueryParameter("form", true, false, "timeseries", r.URL.Query(), &params.Timeseries)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timeseries", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindTimeSeries(w, r, params)
+		siw.Handler.FindMonitors(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1976,14 +2577,14 @@ func (siw *ServerInterfaceWrapper) FindTimeSeries(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// AddTimeSeries operation middleware
-func (siw *ServerInterfaceWrapper) AddTimeSeries(w http.ResponseWriter, r *http.Request) {
+// AddMonitor operation middleware
+func (siw *ServerInterfaceWrapper) AddMonitor(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:timeseries"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:monitors"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddTimeSeries(w, r)
+		siw.Handler.AddMonitor(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1993,8 +2594,8 @@ func (siw *ServerInterfaceWrapper) AddTimeSeries(w http.ResponseWriter, r *http.
 	handler(w, r.WithContext(ctx))
 }
 
-// DeleteTimeSeriesByUuid operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTimeSeriesByUuid(w http.ResponseWriter, r *http.Request) {
+// DeleteMonitorByUuid operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMonitorByUuid(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2008,10 +2609,10 @@ func (siw *ServerInterfaceWrapper) DeleteTimeSeriesByUuid(w http.ResponseWriter,
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:timeseries/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:monitors/{uuid}"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTimeSeriesByUuid(w, r, uuid)
+		siw.Handler.DeleteMonitorByUuid(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2021,8 +2622,8 @@ func (siw *ServerInterfaceWrapper) DeleteTimeSeriesByUuid(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// FindTimeSeriesByUuid operation middleware
-func (siw *ServerInterfaceWrapper) FindTimeSeriesByUuid(w http.ResponseWriter, r *http.Request) {
+// FindMonitorByUuid operation middleware
+func (siw *ServerInterfaceWrapper) FindMonitorByUuid(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2036,10 +2637,10 @@ func (siw *ServerInterfaceWrapper) FindTimeSeriesByUuid(w http.ResponseWriter, r
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:timeseries/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:monitors/{uuid}"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindTimeSeriesByUuid(w, r, uuid)
+		siw.Handler.FindMonitorByUuid(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2049,8 +2650,8 @@ func (siw *ServerInterfaceWrapper) FindTimeSeriesByUuid(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// UpdateTimeseriesByUuid operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTimeseriesByUuid(w http.ResponseWriter, r *http.Request) {
+// UpdateMonitorByUuid operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMonitorByUuid(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2064,10 +2665,10 @@ func (siw *ServerInterfaceWrapper) UpdateTimeseriesByUuid(w http.ResponseWriter,
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:timeseries/{uuid}"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:monitors/{uuid}"})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTimeseriesByUuid(w, r, uuid)
+		siw.Handler.UpdateMonitorByUuid(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2077,78 +2678,114 @@ func (siw *ServerInterfaceWrapper) UpdateTimeseriesByUuid(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// DeleteDataFromTimeSeries operation middleware
-func (siw *ServerInterfaceWrapper) DeleteDataFromTimeSeries(w http.ResponseWriter, r *http.Request) {
+// FindNotificationChannels operation middleware
+func (siw *ServerInterfaceWrapper) FindNotificationChannels(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "uuid" -------------
-	var uuid UuidParam
-
-	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
-		return
-	}
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:timeseries/{uuid}/data"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:notifications"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteDataFromTimeSeriesParams
+	var params FindNotificationChannelsParams
 
-	// ------------- Required query parameter "start" -------------
-	if paramValue := r.URL.Query().Get("start"); paramValue != "" {
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "start"})
-		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "start", r.URL.Query(), &params.Start)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "end" -------------
-	if paramValue := r.URL.Query().Get("end"); paramValue != "" {
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "end"})
-		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "end", r.URL.Query(), &params.End)
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "ge" -------------
-	if paramValue := r.URL.Query().Get("ge"); paramValue != "" {
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindNotificationChannels(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddNotificationChannel operation middleware
+func (siw *ServerInterfaceWrapper) AddNotificationChannel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:notifications"})
 
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddNotificationChannel(w, r)
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "ge", r.URL.Query(), &params.Ge)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteNotificationChannelByUuid operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNotificationChannelByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ge", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "le" -------------
-	if paramValue := r.URL.Query().Get("le"); paramValue != "" {
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:notifications/{uuid}"})
 
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteNotificationChannelByUuid(w, r, uuid)
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "le", r.URL.Query(), &params.Le)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindNotificationChannelByUuid operation middleware
+func (siw *ServerInterfaceWrapper) FindNotificationChannelByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "le", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:notifications/{uuid}"})
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteDataFromTimeSeries(w, r, uuid, params)
+		siw.Handler.FindNotificationChannelByUuid(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2158,8 +2795,8 @@ func (siw *ServerInterfaceWrapper) DeleteDataFromTimeSeries(w http.ResponseWrite
 	handler(w, r.WithContext(ctx))
 }
 
-// QueryTimeseriesForData operation middleware
-func (siw *ServerInterfaceWrapper) QueryTimeseriesForData(w http.ResponseWriter, r *http.Request) {
+// UpdateNotificationChannelByUuid operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNotificationChannelByUuid(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2173,107 +2810,2714 @@ func (siw *ServerInterfaceWrapper) QueryTimeseriesForData(w http.ResponseWriter,
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:timeseries/{uuid}/data"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:notifications/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateNotificationChannelByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindNotificationDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) FindNotificationDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:notifications"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params QueryTimeseriesForDataParams
+	var params FindNotificationDeliveriesParams
 
-	// ------------- Required query parameter "start" -------------
-	if paramValue := r.URL.Query().Get("start"); paramValue != "" {
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "start"})
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "start", r.URL.Query(), &params.Start)
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "end" -------------
-	if paramValue := r.URL.Query().Get("end"); paramValue != "" {
+	// ------------- Optional query parameter "channel" -------------
+	if paramValue := r.URL.Query().Get("channel"); paramValue != "" {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "end"})
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "channel", r.URL.Query(), &params.Channel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "channel", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "end", r.URL.Query(), &params.End)
+	// ------------- Optional query parameter "alert" -------------
+	if paramValue := r.URL.Query().Get("alert"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "alert", r.URL.Query(), &params.Alert)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "alert", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "unit" -------------
-	if paramValue := r.URL.Query().Get("unit"); paramValue != "" {
+	// ------------- Optional query parameter "status" -------------
+	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "unit", r.URL.Query(), &params.Unit)
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "incident" -------------
+	if paramValue := r.URL.Query().Get("incident"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "incident", r.URL.Query(), &params.Incident)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "incident", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindNotificationDeliveries(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindNotificationRules operation middleware
+func (siw *ServerInterfaceWrapper) FindNotificationRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:notifications"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindNotificationRulesParams
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindNotificationRules(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddNotificationRule operation middleware
+func (siw *ServerInterfaceWrapper) AddNotificationRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:notifications"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddNotificationRule(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteNotificationRuleByUuid operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNotificationRuleByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:notifications/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteNotificationRuleByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindNotificationRuleByUuid operation middleware
+func (siw *ServerInterfaceWrapper) FindNotificationRuleByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:notifications/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindNotificationRuleByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateNotificationRuleByUuid operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNotificationRuleByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:notifications/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateNotificationRuleByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindPolicies operation middleware
+func (siw *ServerInterfaceWrapper) FindPolicies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:policies"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindPoliciesParams
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "group_uuids" -------------
+	if paramValue := r.URL.Query().Get("group_uuids"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "group_uuids", r.URL.Query(), &params.GroupUuids)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_uuids", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindPolicies(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddPolicy operation middleware
+func (siw *ServerInterfaceWrapper) AddPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:policies"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddPolicy(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeletePolicyByUuid operation middleware
+func (siw *ServerInterfaceWrapper) DeletePolicyByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:policies/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePolicyByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindPolicyByUuid operation middleware
+func (siw *ServerInterfaceWrapper) FindPolicyByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:policies/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindPolicyByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdatePolicyByUuid operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePolicyByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:policies/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePolicyByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindPrograms operation middleware
+func (siw *ServerInterfaceWrapper) FindPrograms(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:programs"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindProgramsParams
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindPrograms(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddProgram operation middleware
+func (siw *ServerInterfaceWrapper) AddProgram(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:programs"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddProgram(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteProgramByUuid operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProgramByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:programs/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProgramByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindProgramByUuid operation middleware
+func (siw *ServerInterfaceWrapper) FindProgramByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:programs/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindProgramByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateProgramByUuid operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProgramByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:programs/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProgramByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetCodeFromProgram operation middleware
+func (siw *ServerInterfaceWrapper) GetCodeFromProgram(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:programs/{uuid}/code"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCodeFromProgram(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddProgramCodeRevision operation middleware
+func (siw *ServerInterfaceWrapper) AddProgramCodeRevision(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:programs/{uuid}/code"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddProgramCodeRevision(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetProgramCodeRevisionsDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetProgramCodeRevisionsDiff(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:programs/{uuid}/revisions/diff"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProgramCodeRevisionsDiffParams
+
+	// ------------- Required query parameter "rev_a" -------------
+	if paramValue := r.URL.Query().Get("rev_a"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "rev_a"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "rev_a", r.URL.Query(), &params.RevA)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rev_a", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "rev_b" -------------
+	if paramValue := r.URL.Query().Get("rev_b"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "rev_b"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "rev_b", r.URL.Query(), &params.RevB)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rev_b", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProgramCodeRevisionsDiff(w, r, uuid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetProgramCodeRevisions operation middleware
+func (siw *ServerInterfaceWrapper) GetProgramCodeRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:programs/{uuid}/revisions"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProgramCodeRevisions(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteProgramCodeRevisions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProgramCodeRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "revision_id" -------------
+	var revisionId int
+
+	err = runtime.BindStyledParameter("simple", false, "revision_id", chi.URLParam(r, "revision_id"), &revisionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:programs/{uuid}/revisions/{revision_id}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProgramCodeRevisions(w, r, uuid, revisionId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// SignProgramCodeRevisions operation middleware
+func (siw *ServerInterfaceWrapper) SignProgramCodeRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "revision_id" -------------
+	var revisionId int
+
+	err = runtime.BindStyledParameter("simple", false, "revision_id", chi.URLParam(r, "revision_id"), &revisionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:programs/{uuid}/revisions/{revision_id}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SignProgramCodeRevisions(w, r, uuid, revisionId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RunProgram operation middleware
+func (siw *ServerInterfaceWrapper) RunProgram(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:programs/{uuid}/runs"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunProgram(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindProgramRuns operation middleware
+func (siw *ServerInterfaceWrapper) FindProgramRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:programs/{uuid}/runs"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindProgramRunsParams
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "outcome" -------------
+	if paramValue := r.URL.Query().Get("outcome"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "outcome", r.URL.Query(), &params.Outcome)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "outcome", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "start" -------------
+	if paramValue := r.URL.Query().Get("start"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "end" -------------
+	if paramValue := r.URL.Query().Get("end"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindProgramRuns(w, r, uuid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindProgramRunById operation middleware
+func (siw *ServerInterfaceWrapper) FindProgramRunById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "run_id" -------------
+	var runId int64
+
+	err = runtime.BindStyledParameter("simple", false, "run_id", chi.URLParam(r, "run_id"), &runId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:programs/{uuid}/runs"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindProgramRunById(w, r, uuid, runId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ExecuteProgramWebhook operation middleware
+func (siw *ServerInterfaceWrapper) ExecuteProgramWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:programs/{uuid}/webhook"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExecuteProgramWebhook(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindSilences operation middleware
+func (siw *ServerInterfaceWrapper) FindSilences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:silences"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindSilencesParams
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "expired" -------------
+	if paramValue := r.URL.Query().Get("expired"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "expired", r.URL.Query(), &params.Expired)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expired", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindSilences(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddSilence operation middleware
+func (siw *ServerInterfaceWrapper) AddSilence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:silences"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddSilence(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteSilenceByUuid operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSilenceByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:silences/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSilenceByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindSilenceByUuid operation middleware
+func (siw *ServerInterfaceWrapper) FindSilenceByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:silences/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindSilenceByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateSilenceByUuid operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSilenceByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:silences/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSilenceByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindThingTemplates operation middleware
+func (siw *ServerInterfaceWrapper) FindThingTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:templates"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindThingTemplatesParams
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindThingTemplates(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddThingTemplate operation middleware
+func (siw *ServerInterfaceWrapper) AddThingTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:templates"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddThingTemplate(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteThingTemplateByUuid operation middleware
+func (siw *ServerInterfaceWrapper) DeleteThingTemplateByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:templates/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteThingTemplateByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindThingTemplateByUuid operation middleware
+func (siw *ServerInterfaceWrapper) FindThingTemplateByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:templates/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindThingTemplateByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateThingTemplateByUuid operation middleware
+func (siw *ServerInterfaceWrapper) UpdateThingTemplateByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:templates/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateThingTemplateByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ApplyThingTemplate operation middleware
+func (siw *ServerInterfaceWrapper) ApplyThingTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:templates/{uuid}", "create:timeseries", "create:datasets"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyThingTemplate(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindThings operation middleware
+func (siw *ServerInterfaceWrapper) FindThings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindThingsParams
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "attributes" -------------
+	if paramValue := r.URL.Query().Get("attributes"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "attributes", r.URL.Query(), &params.Attributes)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attributes", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "bbox" -------------
+	if paramValue := r.URL.Query().Get("bbox"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", false, false, "bbox", r.URL.Query(), &params.Bbox)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bbox", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "near" -------------
+	if paramValue := r.URL.Query().Get("near"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", false, false, "near", r.URL.Query(), &params.Near)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "near", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "radius" -------------
+	if paramValue := r.URL.Query().Get("radius"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "radius", r.URL.Query(), &params.Radius)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "radius", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindThings(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddThing operation middleware
+func (siw *ServerInterfaceWrapper) AddThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:things"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddThing(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteThingByUuid operation middleware
+func (siw *ServerInterfaceWrapper) DeleteThingByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:things/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteThingByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindThingByUuid operation middleware
+func (siw *ServerInterfaceWrapper) FindThingByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindThingByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateThingByUuid operation middleware
+func (siw *ServerInterfaceWrapper) UpdateThingByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:things/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateThingByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindAncestorsForThing operation middleware
+func (siw *ServerInterfaceWrapper) FindAncestorsForThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things/{uuid}"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindAncestorsForThingParams
+
+	// ------------- Optional query parameter "max_depth" -------------
+	if paramValue := r.URL.Query().Get("max_depth"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max_depth", r.URL.Query(), &params.MaxDepth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_depth", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindAncestorsForThing(w, r, uuid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindChildrenForThing operation middleware
+func (siw *ServerInterfaceWrapper) FindChildrenForThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindChildrenForThing(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddChildToThing operation middleware
+func (siw *ServerInterfaceWrapper) AddChildToThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:things/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddChildToThing(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// RemoveChildFromThing operation middleware
+func (siw *ServerInterfaceWrapper) RemoveChildFromThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "child_uuid" -------------
+	var childUuid string
+
+	err = runtime.BindStyledParameter("simple", false, "child_uuid", chi.URLParam(r, "child_uuid"), &childUuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "child_uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:things/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveChildFromThing(w, r, uuid, childUuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindDatasetsForThing operation middleware
+func (siw *ServerInterfaceWrapper) FindDatasetsForThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things/{uuid}/datasets"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindDatasetsForThing(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindDescendantsForThing operation middleware
+func (siw *ServerInterfaceWrapper) FindDescendantsForThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things/{uuid}"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindDescendantsForThingParams
+
+	// ------------- Optional query parameter "max_depth" -------------
+	if paramValue := r.URL.Query().Get("max_depth"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max_depth", r.URL.Query(), &params.MaxDepth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_depth", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindDescendantsForThing(w, r, uuid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindStateHistoryForThing operation middleware
+func (siw *ServerInterfaceWrapper) FindStateHistoryForThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things/{uuid}"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindStateHistoryForThingParams
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindStateHistoryForThing(w, r, uuid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindParentsForThing operation middleware
+func (siw *ServerInterfaceWrapper) FindParentsForThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindParentsForThing(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindSubtreeForThing operation middleware
+func (siw *ServerInterfaceWrapper) FindSubtreeForThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things/{uuid}"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindSubtreeForThingParams
+
+	// ------------- Optional query parameter "max_depth" -------------
+	if paramValue := r.URL.Query().Get("max_depth"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max_depth", r.URL.Query(), &params.MaxDepth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_depth", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindSubtreeForThing(w, r, uuid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindTimeSeriesForThing operation middleware
+func (siw *ServerInterfaceWrapper) FindTimeSeriesForThing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:things/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindTimeSeriesForThing(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteThingTypeSchema operation middleware
+func (siw *ServerInterfaceWrapper) DeleteThingTypeSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:thingschemas"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteThingTypeSchemaParams
+
+	// ------------- Required query parameter "type" -------------
+	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "type"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteThingTypeSchema(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindThingTypeSchemas operation middleware
+func (siw *ServerInterfaceWrapper) FindThingTypeSchemas(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:thingschemas"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindThingTypeSchemas(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// SetThingTypeSchema operation middleware
+func (siw *ServerInterfaceWrapper) SetThingTypeSchema(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:thingschemas"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetThingTypeSchema(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteThingStateTransitions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteThingStateTransitions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:thingstates"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteThingStateTransitionsParams
+
+	// ------------- Required query parameter "type" -------------
+	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "type"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteThingStateTransitions(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindThingStateTransitions operation middleware
+func (siw *ServerInterfaceWrapper) FindThingStateTransitions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:thingstates"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindThingStateTransitions(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// SetThingStateTransitions operation middleware
+func (siw *ServerInterfaceWrapper) SetThingStateTransitions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:thingstates"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetThingStateTransitions(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindTimeSeries operation middleware
+func (siw *ServerInterfaceWrapper) FindTimeSeries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:timeseries"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindTimeSeriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "attributes" -------------
+	if paramValue := r.URL.Query().Get("attributes"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "attributes", r.URL.Query(), &params.Attributes)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attributes", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindTimeSeries(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddTimeSeries operation middleware
+func (siw *ServerInterfaceWrapper) AddTimeSeries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:timeseries"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddTimeSeries(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTimeSeriesByUuid operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTimeSeriesByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:timeseries/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTimeSeriesByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindTimeSeriesByUuid operation middleware
+func (siw *ServerInterfaceWrapper) FindTimeSeriesByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:timeseries/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindTimeSeriesByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UpdateTimeseriesByUuid operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTimeseriesByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:timeseries/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTimeseriesByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteDataFromTimeSeries operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDataFromTimeSeries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:timeseries/{uuid}/data"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDataFromTimeSeriesParams
+
+	// ------------- Required query parameter "start" -------------
+	if paramValue := r.URL.Query().Get("start"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "start"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "end" -------------
+	if paramValue := r.URL.Query().Get("end"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "end"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ge" -------------
+	if paramValue := r.URL.Query().Get("ge"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "ge", r.URL.Query(), &params.Ge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ge", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "le" -------------
+	if paramValue := r.URL.Query().Get("le"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "le", r.URL.Query(), &params.Le)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "le", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDataFromTimeSeries(w, r, uuid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// QueryTimeseriesForData operation middleware
+func (siw *ServerInterfaceWrapper) QueryTimeseriesForData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:timeseries/{uuid}/data"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params QueryTimeseriesForDataParams
+
+	// ------------- Required query parameter "start" -------------
+	if paramValue := r.URL.Query().Get("start"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "start"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "end" -------------
+	if paramValue := r.URL.Query().Get("end"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "end"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "unit" -------------
+	if paramValue := r.URL.Query().Get("unit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "unit", r.URL.Query(), &params.Unit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ge" -------------
+	if paramValue := r.URL.Query().Get("ge"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "ge", r.URL.Query(), &params.Ge)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ge", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "le" -------------
+	if paramValue := r.URL.Query().Get("le"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "le", r.URL.Query(), &params.Le)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "le", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "precision" -------------
+	if paramValue := r.URL.Query().Get("precision"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "precision", r.URL.Query(), &params.Precision)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "precision", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "aggregate" -------------
+	if paramValue := r.URL.Query().Get("aggregate"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "aggregate", r.URL.Query(), &params.Aggregate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "aggregate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "timezone" -------------
+	if paramValue := r.URL.Query().Get("timezone"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "timezone", r.URL.Query(), &params.Timezone)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timezone", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryTimeseriesForData(w, r, uuid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// AddDataToTimeseries operation middleware
+func (siw *ServerInterfaceWrapper) AddDataToTimeseries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:timeseries/{uuid}/data"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddDataToTimeseriesParams
+
+	// ------------- Optional query parameter "unit" -------------
+	if paramValue := r.URL.Query().Get("unit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "unit", r.URL.Query(), &params.Unit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "overwrite" -------------
+	if paramValue := r.URL.Query().Get("overwrite"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "overwrite", r.URL.Query(), &params.Overwrite)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "overwrite", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddDataToTimeseries(w, r, uuid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindTimeseriesDataHistory operation middleware
+func (siw *ServerInterfaceWrapper) FindTimeseriesDataHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:timeseries/{uuid}/data"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindTimeseriesDataHistoryParams
+
+	// ------------- Required query parameter "start" -------------
+	if paramValue := r.URL.Query().Get("start"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "start"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "end" -------------
+	if paramValue := r.URL.Query().Get("end"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "end"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindTimeseriesDataHistory(w, r, uuid, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// UndoTimeseriesDataChange operation middleware
+func (siw *ServerInterfaceWrapper) UndoTimeseriesDataChange(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "change_id" -------------
+	var changeId int64
+
+	err = runtime.BindStyledParameter("simple", false, "change_id", chi.URLParam(r, "change_id"), &changeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "change_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:timeseries/{uuid}/data"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UndoTimeseriesDataChange(w, r, uuid, changeId)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindTimeseriesHealth operation middleware
+func (siw *ServerInterfaceWrapper) FindTimeseriesHealth(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:tshealth"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindTimeseriesHealthParams
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "thing" -------------
+	if paramValue := r.URL.Query().Get("thing"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "thing", r.URL.Query(), &params.Thing)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "thing", Err: err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindTimeseriesHealth(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// FindHealthRules operation middleware
+func (siw *ServerInterfaceWrapper) FindHealthRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:tshealth/rules"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindHealthRulesParams
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "ge" -------------
-	if paramValue := r.URL.Query().Get("ge"); paramValue != "" {
-
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindHealthRules(w, r, params)
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "ge", r.URL.Query(), &params.Ge)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ge", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "le" -------------
-	if paramValue := r.URL.Query().Get("le"); paramValue != "" {
+	handler(w, r.WithContext(ctx))
+}
+
+// AddHealthRule operation middleware
+func (siw *ServerInterfaceWrapper) AddHealthRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:tshealth/rules"})
 
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddHealthRule(w, r)
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "le", r.URL.Query(), &params.Le)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "le", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "precision" -------------
-	if paramValue := r.URL.Query().Get("precision"); paramValue != "" {
+	handler(w, r.WithContext(ctx))
+}
 
-	}
+// DeleteHealthRuleByUuid operation middleware
+func (siw *ServerInterfaceWrapper) DeleteHealthRuleByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "precision", r.URL.Query(), &params.Precision)
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "precision", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "aggregate" -------------
-	if paramValue := r.URL.Query().Get("aggregate"); paramValue != "" {
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"delete:tshealth/rules/{uuid}"})
 
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteHealthRuleByUuid(w, r, uuid)
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "aggregate", r.URL.Query(), &params.Aggregate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "aggregate", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "timezone" -------------
-	if paramValue := r.URL.Query().Get("timezone"); paramValue != "" {
+	handler(w, r.WithContext(ctx))
+}
 
-	}
+// FindHealthRuleByUuid operation middleware
+func (siw *ServerInterfaceWrapper) FindHealthRuleByUuid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "timezone", r.URL.Query(), &params.Timezone)
+	var err error
+
+	// ------------- Path parameter "uuid" -------------
+	var uuid UuidParam
+
+	err = runtime.BindStyledParameter("simple", false, "uuid", chi.URLParam(r, "uuid"), &uuid)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timezone", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uuid", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"read:tshealth/rules/{uuid}"})
+
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.QueryTimeseriesForData(w, r, uuid, params)
+		siw.Handler.FindHealthRuleByUuid(w, r, uuid)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2283,8 +5527,8 @@ func (siw *ServerInterfaceWrapper) QueryTimeseriesForData(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// AddDataToTimeseries operation middleware
-func (siw *ServerInterfaceWrapper) AddDataToTimeseries(w http.ResponseWriter, r *http.Request) {
+// UpdateHealthRuleByUuid operation middleware
+func (siw *ServerInterfaceWrapper) UpdateHealthRuleByUuid(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2298,24 +5542,43 @@ func (siw *ServerInterfaceWrapper) AddDataToTimeseries(w http.ResponseWriter, r
 		return
 	}
 
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:timeseries/{uuid}/data"})
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"update:tshealth/rules/{uuid}"})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateHealthRuleByUuid(w, r, uuid)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ImportTsdata operation middleware
+func (siw *ServerInterfaceWrapper) ImportTsdata(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{"create:tsimport"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params AddDataToTimeseriesParams
+	var params ImportTsdataParams
 
-	// ------------- Optional query parameter "unit" -------------
-	if paramValue := r.URL.Query().Get("unit"); paramValue != "" {
+	// ------------- Optional query parameter "dry_run" -------------
+	if paramValue := r.URL.Query().Get("dry_run"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "unit", r.URL.Query(), &params.Unit)
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddDataToTimeseries(w, r, uuid, params)
+		siw.Handler.ImportTsdata(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2868,6 +6131,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/alertgroups", wrapper.FindAlertGroups)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/alertgroups", wrapper.AddAlertGroup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/alertgroups/{uuid}", wrapper.DeleteAlertGroupByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/alertgroups/{uuid}", wrapper.FindAlertGroupByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/alertgroups/{uuid}", wrapper.UpdateAlertGroupByUuid)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/alerts", wrapper.FindAlerts)
 	})
@@ -2883,6 +6161,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/v2/alerts/{uuid}", wrapper.UpdateAlertByUuid)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/alerts/{uuid}/acknowledge", wrapper.AcknowledgeAlert)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/alerts/{uuid}/history", wrapper.FindAlertHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/alerts/{uuid}/shelve", wrapper.ShelveAlert)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/alerts/{uuid}/unacknowledge", wrapper.UnacknowledgeAlert)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/alerts/{uuid}/unshelve", wrapper.UnshelveAlert)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/datasets", wrapper.FindDatasets)
 	})
@@ -2901,6 +6194,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v2/datasets/{uuid}/assemble", wrapper.AssembleDatasetPartsByKey)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/datasets/{uuid}/diff", wrapper.GetDatasetRevisionsDiff)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/datasets/{uuid}/document", wrapper.GetDatasetDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/datasets/{uuid}/import", wrapper.ImportDatasetIntoTimeseries)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/datasets/{uuid}/parts", wrapper.ListDatasetPartsByKey)
 	})
@@ -2910,6 +6212,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/datasets/{uuid}/raw", wrapper.GetRawDatasetByUuid)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/datasets/{uuid}/revisions", wrapper.GetDatasetRevisions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/datasets/{uuid}/revisions/{revision_id}/raw", wrapper.GetRawDatasetRevision)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/datasets/{uuid}/revisions/{revision_id}/rollback", wrapper.RollbackDatasetToRevision)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/datasets/{uuid}/schema", wrapper.DeleteDatasetSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/datasets/{uuid}/schema", wrapper.GetDatasetSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/datasets/{uuid}/schema", wrapper.SetDatasetSchema)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/v2/datasets/{uuid}/uploads", wrapper.DeleteDatasetUploadByKey)
 	})
@@ -2934,6 +6254,84 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/groups/{uuid}/policies", wrapper.FindPoliciesForGroup)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/incidents", wrapper.FindIncidents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/incidents/{uuid}", wrapper.FindIncidentByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/incidents/{uuid}/acknowledge", wrapper.AcknowledgeIncident)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/incidents/{uuid}/alerts", wrapper.FindIncidentAlerts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/incidents/{uuid}/close", wrapper.CloseIncident)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/incidents/{uuid}/history", wrapper.FindIncidentHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/jobs", wrapper.FindProgramJobs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/jobs/{job_id}", wrapper.FindProgramJobById)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/jobs/{job_id}/requeue", wrapper.RequeueProgramJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/jobs/{job_id}/runs", wrapper.FindProgramJobRuns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/monitors", wrapper.FindMonitors)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/monitors", wrapper.AddMonitor)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/monitors/{uuid}", wrapper.DeleteMonitorByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/monitors/{uuid}", wrapper.FindMonitorByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/monitors/{uuid}", wrapper.UpdateMonitorByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/notifications/channels", wrapper.FindNotificationChannels)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/notifications/channels", wrapper.AddNotificationChannel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/notifications/channels/{uuid}", wrapper.DeleteNotificationChannelByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/notifications/channels/{uuid}", wrapper.FindNotificationChannelByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/notifications/channels/{uuid}", wrapper.UpdateNotificationChannelByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/notifications/deliveries", wrapper.FindNotificationDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/notifications/rules", wrapper.FindNotificationRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/notifications/rules", wrapper.AddNotificationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/notifications/rules/{uuid}", wrapper.DeleteNotificationRuleByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/notifications/rules/{uuid}", wrapper.FindNotificationRuleByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/notifications/rules/{uuid}", wrapper.UpdateNotificationRuleByUuid)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/policies", wrapper.FindPolicies)
 	})
@@ -2982,9 +6380,51 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/v2/programs/{uuid}/revisions/{revision_id}/sign", wrapper.SignProgramCodeRevisions)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/programs/{uuid}/run", wrapper.RunProgram)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/programs/{uuid}/runs", wrapper.FindProgramRuns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/programs/{uuid}/runs/{run_id}", wrapper.FindProgramRunById)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v2/programs/{uuid}/webhook", wrapper.ExecuteProgramWebhook)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/silences", wrapper.FindSilences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/silences", wrapper.AddSilence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/silences/{uuid}", wrapper.DeleteSilenceByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/silences/{uuid}", wrapper.FindSilenceByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/silences/{uuid}", wrapper.UpdateSilenceByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/templates", wrapper.FindThingTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/templates", wrapper.AddThingTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/templates/{uuid}", wrapper.DeleteThingTemplateByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/templates/{uuid}", wrapper.FindThingTemplateByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/templates/{uuid}", wrapper.UpdateThingTemplateByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/templates/{uuid}/apply", wrapper.ApplyThingTemplate)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/things", wrapper.FindThings)
 	})
@@ -3000,12 +6440,54 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/v2/things/{uuid}", wrapper.UpdateThingByUuid)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/things/{uuid}/ancestors", wrapper.FindAncestorsForThing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/things/{uuid}/children", wrapper.FindChildrenForThing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/things/{uuid}/children", wrapper.AddChildToThing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/things/{uuid}/children/{child_uuid}", wrapper.RemoveChildFromThing)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/things/{uuid}/datasets", wrapper.FindDatasetsForThing)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/things/{uuid}/descendants", wrapper.FindDescendantsForThing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/things/{uuid}/history", wrapper.FindStateHistoryForThing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/things/{uuid}/parents", wrapper.FindParentsForThing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/things/{uuid}/subtree", wrapper.FindSubtreeForThing)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/things/{uuid}/timeseries", wrapper.FindTimeSeriesForThing)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/thingschemas", wrapper.DeleteThingTypeSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/thingschemas", wrapper.FindThingTypeSchemas)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/thingschemas", wrapper.SetThingTypeSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/thingstates", wrapper.DeleteThingStateTransitions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/thingstates", wrapper.FindThingStateTransitions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/thingstates", wrapper.SetThingStateTransitions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/timeseries", wrapper.FindTimeSeries)
 	})
@@ -3030,6 +6512,33 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v2/timeseries/{uuid}/data", wrapper.AddDataToTimeseries)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/timeseries/{uuid}/history", wrapper.FindTimeseriesDataHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/timeseries/{uuid}/history/{change_id}/undo", wrapper.UndoTimeseriesDataChange)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/tshealth", wrapper.FindTimeseriesHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/tshealth/rules", wrapper.FindHealthRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/tshealth/rules", wrapper.AddHealthRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/tshealth/rules/{uuid}", wrapper.DeleteHealthRuleByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/tshealth/rules/{uuid}", wrapper.FindHealthRuleByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/tshealth/rules/{uuid}", wrapper.UpdateHealthRuleByUuid)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/tsimport", wrapper.ImportTsdata)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v2/tsquery", wrapper.FindTsdataByQuery)
 	})
@@ -3073,185 +6582,435 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9eXPbOLbvV0Gp36uX5IkyJVGbp+YPZ+lM3s02iTN957ZTMUgcSphQgBoAbSspf/dX",
-	"WEiREqnFttxOWlVdHVnCjt9ZcXDwvRHx6YwzYEo2jr83JoAJCPPxhcJj/S8BGQk6U5SzxnHjdALow6/P",
-	"Bp1uB704xWNka6CYQkIQZQgjAXLGmQQ0E/yCEpBITQBFqRDAFAKmqJp7Z0zhMYq5MD9KSCBSQHRdnooI",
-	"WuiEZUV1QSoRZojP8B8pIEr0LzHV3XJxxgiNYzCNX4CQlDOJeGwHYhpD/AIEUnQKTSRgjAVJQEp0OQE1",
-	"AYGmaaLoLIEzllfHAtAFTihBWNkB4imYFpYHFnEmqVS2x2yEZ+yPlOvpSCUoGzfRjEtJw2SOZgJiegUE",
-	"hXOE0SXgr0wPhTJCI6y4aJ2xRrMBV3g6S6Bx3BgQPMCDztCLR23fa7eh742CDvb6w3jQGUbtEA/8RrMh",
-	"owlMsd4tNZ/perbjxvV1s/Hf3ges4DWdUuWZ/69u6gf4IwWpUKJ/RjMQaMJTURxI2/creqFMwRhE41r3",
-	"M8MCT0E59ODxWC+1gvf669Uuf5sAQ6mkbIzOZwIiqhf+vIU+GiQgNdE7nrWB4pRFuiKiTCrARK+23hYC",
-	"MU4Thc7xxfhcbyhDGs+p0u3qAgJkmqgWes5BIsbVRP9gyhV61ehiXCEJqtVoNqge3x8piHmj2WB4qmea",
-	"D6W02MDSaeP49wa+GDeajSnVezfFV7pMOm00GxFPmWp8blbsCrCLX2miQNSsz0kCQhPLBRWcTYGpmoGV",
-	"S9TioNmQam4QFXMx1X/DBTC1zRAu1nR+sXO3YwFYgXgnXvxR0+2/cJICkhOeJgSFgFwNxAWCP1KcIMXR",
-	"o7PU97vw98cGKHWbNoaqsbF0GhrMNhs0fssZvMEqmtQM5tQwJ6H5hwYWFhk3Sygw9X+k5YGPpGY+l1RN",
-	"0KvY0216ptHH9rszpquYkprhUSVzbug4TobnjGM1EWYE0RiFXE00J0pBnrGpbhM9UhOsEJXNUg00wRbE",
-	"0QSzMZDHTcdYzdglMCJRiKOvZwyjrh+gt1yhN5xoLqrZFFapbJrR8lQhjEJO5k10OaHRBClIkuKszXwc",
-	"X4xwNAFSMQ0rAahEUtEkQWPOid64VAJ6FAuQk8fLrG7Y68bxqDvod7DfJySMB51OFEAII0JIv0+Gcb9L",
-	"CAY8GsS9TjvqQhR1fIIH0WjQ9zt+BgIrkBYoKO3IBl6p5cIO0DRiZBWX0QZcJptwafjwGkTaokbmUAVT",
-	"qbsWoFLBars0jL/Yq2OdjeOO3zTUiZXl6P3AcjE61czNMP4pZe6v5irrbzZ4HEvYPN7ScOVXOkMhxFyA",
-	"hp+wHJujiCdOAGTMex1btj1Xz6tyWtlE/OqJCDqmbAumaAvWDSr7cQe2mAujulUUKYu0JMRJYvQQqfB0",
-	"Jg2bmIHQzRTEJZ+BwMoqQsws5VjwdEbZuG4h8/4r5duURoJLiDgj0qxiktDFn/aTXd3USEj7oZd/avuL",
-	"j4tvO4tvu/qj0zkI1uO6BPiqf+ZMaaKdAza/QYSJ7iECplIzAT0YYIziajkrNDd8wUjNur5gpEC0mn3R",
-	"KegVpZy0kMau/YweGZBqhAIjj1GEGXryhHH15AmCqwiAoDbSg2yh5xaABuXnjF+et2pFt140AX+kVABp",
-	"HCuRQmn1c9bY8Tttz+95fvvU94/Nf//X7xz7etVyjBOswNPDb9Suw0c9h5qVML8VBOv9roVpcfvV8G+7",
-	"Gk5qbkHqWdGagRd+3oHctVimm7oXAs/1NrjCZhGtCsDrWI8rWhqMYbmVo5riq9fAxmrSOO7lq4R1t9Vj",
-	"vgBB1XyLNcuK1o4y/3kxzP8lIG4cN345WhikR/ZXeWRa/ZjVWjO2l7DD6NDLhS5q5feG8X5ZUijvZMiv",
-	"dxrya6egbDfe5C7HSz+xtUrJx1coZVQVdEBjeZ2gCEutHCcJ4lGUCq3W6gIhlmBrOPu9Vl/ShRrVvOBZ",
-	"JXlbfXabdTUF63mS/XGXFbR1KtZP4bHcjt51yS1oXRfbC6FrrvmNszrL/SRSCEtroRsJocsusfpPp89q",
-	"WX3W/AZVPE0pWYO23O759OnV85Id0R6O+n4wjLyQRCMv6EaBh+Og7QV4FPTDEe4G7ZyZz7DRLjKcpXS9",
-	"RF4e5bUtDFI95YSCWfy3cGmQoD9HnCltIR9/b+DZLKGRUcqO/iP1NL4XGp4JrbIp10RptkW0vxc80iYH",
-	"0UYbSUGvdcIv0RSm3Czxys4X3QOlpmaCk9T4VCqrXaxUeM4vK4s6bbdU9lIjF0RrzI+jCURf0et2p1tV",
-	"WeBLghVe3eKnWEI/QMAiToAggS+RLtgq7TR++S8ZvhzKV/8gF9H06uurf/K/F3WAcK6gstdMZpcHDWEs",
-	"zIaRqkqZaC3W+V1X0npnPemtEFvGYnfnx4az7MaEDI8ojzimV0YpYlx52COCJsluM9D0y1NVsrW6fX/J",
-	"3Op2GqsmVrNhXBnldX/37k2NjpaR4e9FLavs8Mo9UAuVogik5sIYsz1/NkRbJQoUR5gYH4Xxm8ylgukK",
-	"M7huavp+jhXWZufNKbxQrTyWZ/aHZX/QJtz/vQr3LE0SHOoalo+t7GxWYWHoRfLCsEbaaDbMHLR9JSO9",
-	"P3yaNJqNK/P/OZ4a0CyGZKus9GAZa3G3Y86t1ZcJpG5FtQy2S/vEEM5lJFwplOAQEoke6eKP7VmCwNFX",
-	"ysbGLo6NtNV/zVIx49IqGIuh/H6m9yGm49Qay2eNJjprwJUCwXDiOYI/a3xu7EQeE8rGX4woWZ0BEmBO",
-	"KiLDujE61YVLg+oFnVGv3+l6UQ+6XuAPe97Qj2KvF3S63WHYDiNjK2/Y2yXyMduQ73czh18VNThw70IP",
-	"LwVPZ7eghgwl5YG8xVPI6MC4L0rrZF0cXGwCU9VKVE3bzGGXSb/nCY3mt5g1jnIBn1GfsUdMf1jLoHRG",
-	"7N8EElBQpjhXZlV0xzFEJaLGScIvTStsXm4j+2WlEbPeOYgLPtq2T7rDMPT6eAheQLp9Lxz2ut6g2/PD",
-	"/iAK/aBd1d5MUJ5JvcKZUpWEqBbOxuUFgoI8+t/lLW9v2vLCXAoDyReqmW1EoesqgNj93gkhgo+d/npj",
-	"RRCThLIK4ng1ZlwAMUzvDSdpAtKcTBLHy9AjylDRT/cY4ViBcC59jNzg0CPBU0UZNNElhBPOvz5GcmKc",
-	"jCCmlGEFTTPnC04JSjgbI5EyZpiqbWGJqfaMG2Z1WxPMxikeQxGYCtiYlxFpv9pKkryZZ0OoVNeiCehl",
-	"2WrpjLj4zc5fryN69uHdW5Q1kflQ1XxGI5yg382vlpl+fjRRaiaPj46AtS7pVzoDQnGLi/GR/uvomeDs",
-	"cRPNwR0PyXQ240KZzt3OlNfPR0EPdbroCXqC+pUTU5onFMk7UvTCWjT5xxjTBEiFR/QeZet0rrfHClV8",
-	"CZJPd5el5u+V02qLWHtKD1cQpQrMQT1mSCNOXOCklW+nKRXhJAHizpv1Xn548fEUnbx/1VpAQABKpT2Z",
-	"X/RQwIXxd15p80C3QEV+KI0TquZm+pm73DSpuYJtx7izTSNLLDz/eSvxbQplACggvLngEwU6q+Rhjuh3",
-	"YGJWQ9mnbFcrOtCbea4YPRRFMUxpQihzcOZxfBPNsBLNZqaatwAiECXY8u9S/1nnR7bfu1J5XM87YCEX",
-	"wrcARMIvQXwJecrKuoXXK1qQhKdhUqCM7HC0uQ2g6NQcfOsOl2Glf/qY/bQBXJJ+MZ7Htd5OLCUdM3AL",
-	"mLnEbO9lFD3bpLcU4ezs6t8/L+Hw5Wm3fdZonjXePT+9S8vk3cxysmUDZZU4odPG0Bv1vHYP97wgbre9",
-	"4WjU8Uak2+37fhS1YSvjM53NKnGwFQyqGWS2YZVgX2zLTpDnX4Hthf0dGaaUR8DZjpbgGlMhldYThDHI",
-	"bIk7of0TQhBGDC5ts3azU2niJirXQT53frqtFyIH5jp/1an8wC9Xwbp+/4zLsHqcn/QU9imt3BoVbag7",
-	"ZMl6+FvD85MxDw/O5oOz+eBsvp2zuYoSDXFpkwIbAqulv5s4g1fViSm+QsYbAwRJ+i1nN3rVE1CA3DmT",
-	"CcxDVKK2Hwx7gz7SsJPoURu9efq4hd7bUCCj2OZVjOGJkXMte5ZLuZhorbHYqF9zQOsirkwYd+D7TTTF",
-	"iW7QRGbb1kCILEx5S5/2Enm5ci30STorXE61eSZQOks4XjaIX3/01TP69GvY+dR/9ez/TV69/JD8z3+/",
-	"kq9evhj/z/Rf6t+/XSXuO/qMPr3Ep3z8Zh5cvX3+ov1uSxq9Q0e4+WZbT3jLlT64w/fsDl/j5+bhfyCy",
-	"Dpnc31pD6nfl515MbzrPPNt36MTeYUZ/thM7L/xXcWNrgMuNDux697Pb2zRjnRs3+OCDPvigDz7ogw96",
-	"dx/03TEhd73sgwPNDRmRcNWLVxNKlxP8VTdRxRw+Qna5wOqzuln0aHFDwX0v82twFnnWO9Kqn+R+HOXm",
-	"9kTB/WB6ad3QW56TbcUdJ2XuDRQ88kUwVZH3DEtpP2ERTeiFJfWCUM4K/oxO+5PCEDVNcjHGTJttZiek",
-	"ZgEcLa6l6kaWhrfq07+BDms9+LuS4z7c+Ct7yTOHsimITEHNH63stzfaDL/DUQQzwzwZQVJpsdhC/7K/",
-	"P0lAyidITTCzpqoxUkNNvP8x94WX7pDVnCHUrOyuZwqe8+ov3Vt79w39G7QGip4KGn1FHzgmTfSRp2qC",
-	"XjAlMIvgb+gUpiZiJRWVNFF71uDOGZY7ffanEpZaTMbS1suT0xfmYELL2Ytxe3IfZxOWFy4tTN8ftUe9",
-	"YOD5cTD0guHI90Z+GHntXjhox532KG6HNzieqMe3KXhTfLu7rTtA/EYIv65xqDt/+q4M5JZOdmOVVQA1",
-	"k8xa0NqIL9nSmyxRfrUcUc1bzU1cRcMErEJ7bgt/wYScm2XOvhAw5RdwbpcwB+OSo+/Tq+eaNNyompux",
-	"uuitAhmELOZgPfgSbjKZPQ3arkhVBgD9/WLoseDThzP4jD9v47fVo98W0NZON1eVzTifYuJ01SV4a755",
-	"NEswZX9D0QQLCervqYq9YRnn65zlL4TgovJkq6CMEpemAMXcSBQ5g4jGjrBaeimeGY5B6i5FOJ02uxxx",
-	"iSWyPhZiaj83Pphdaluvja39KxchJWTlQHSfq/OWq9wxrnh+11UD1fqezMheMeul/Giuu9vG7m+MWe/Z",
-	"bXuwBZt68L9mEuQe0eRQU0inYrbS4ipldjPfcpXd/99wwybLLBACMDTN6lw3G6ecv8Fs7khG3ucsOUdT",
-	"zOY5Zql1j+RIKdxcbTSLWW0qs6FUjcHVOVqtYMbzieFUTbig34DcK9RcWppUTYApxxk0jZucODiRZnOt",
-	"nN6Fzi2L1NC4zm48mfXKj5eXDnsWbKh4Mbc98PyB12mftgfH3c5xZ7jTxdzm8mH06u+pVTOMNNjiBHDp",
-	"RLr+6HnllwRL9UVABPQCvpjh3m6qGxXOxdF2hdsZLihP5Zcbn+cWjr53OrBedzD90I+hb3TIvAWmMiNl",
-	"pdn8uHn96U1+y3D7K02Lm6j5/W7bWe1tJ3c5NCPTxRwXWChSUxXGqmjg83WzUd6lghNYQpS6mpGgmjeZ",
-	"s038n+yWhvn3EgtmvVWU2dU2dpSZSpjq77U1ap1NBPITgOVzo7z9lW0owqEwOj4z0VNRwqVZ86sZNaa4",
-	"nEBi3VjRV8YvEyDmlnfK9F+s3K1rY6XLZ5zAB7iwCTRWeeUEoq8ynS6dQQ2iEMIYIIz8XjyIegGORt1u",
-	"PwrCIAwhGnbbnc4A94P2qNfGQUhgAIT0+n7Hj4e9kd8oXevtByXvn/5zZZR74tmu2S/hvELV19r4yg3d",
-	"OO4NMSFtrzPCxAt63cALB/HQGwWDMI6gT3AYVHOmxRJXiTX7q8s2U+wxWJ/5pdmwMZwlBrAT87b1Ny7B",
-	"bre28ukW6biw2vmwi/03F3DTxFqITqkHZYUGOcGdXh9lhRbRKFbJueO0TeuQunJobzfF5Xqz5ZrIGCox",
-	"Ze7o5NdnqNvtjppIgk0b12v1y06se4L9wmVV7j7u9ofdIA69IRn1vSDy217oQ+D5IdG03Q+jTm99oEq5",
-	"w19pAu40ME+NhxXOUift+3pnvd90kc/RZOAzWWLQBF8YF15o8j/8kS4tzpvX2sqABM1Pxxf/PfhW7S/9",
-	"VneSUYqesrdpKSukoDIRU61GRXaoVb5wA11ijSOzjIiCE1PjF6NLbK6XGWes2T5Pgs2nYMMGWtv5Lckm",
-	"0uEx0pLdZYr7U4jHjfJ+iadmUwwCCzlFl2IJwO+MIhJ7QQzgBR3S8UbtUd/DcUjikIQjMow3XuBxKt/K",
-	"NdyMBzs8F/l8to8lRC2x/8IqOqhqlp97P5YyXemv0RSkxGMoTXH5l5WFy+OeNoUzbRULvdiIQppTGAw7",
-	"3SjygiDGXuB3iaflikd6EQRD7PsdCHZa5c82HNzogh9glsxrwi4Nn7Gp84BYoYIZshkBSs6z5WjH1Tng",
-	"UacdjIa+14mGIy/oQOBhf0i8Qbs/HOF42A/7g+3moAe/iMw63BpeCbfawkrb6hrxFsjsRdAj3Yh4cTwa",
-	"eUE36Hi4PQIvJmE77A39Xnsw3BaZN7qJ3GwUYrgOoVmH0Kz7Cc06BEhtCpCq4hbBgGDch9ALSTvyghEB",
-	"bzQYdrw2jIJOB3f8ftzbUVvY7dZvQQ/IA5IqPbeVqteHsm76afmuU48Mo06XDLwuHgy9oN0beRgHvgdd",
-	"iLtkFMbQ621NnbsGLe03GGl3vBfcQiaE5ygL6dlKTV+BDun0usNRMPJGPoy8oN0ZeMNOr+0N+gEO8CDo",
-	"9KNdFc0MMw5CJd1xAZNSNNA6rKz6yMsxQLcIvFkXD3MXW1YyynaN/bjBvGp8xNW7Va/gL91rLa94eZzF",
-	"Hc3urG51ZNPxPb/r+aNTf3QcDI+7fsvv9nY05yrpu/Ly6haE0B4EftyGwCOdqO8Fo6DrjUaDvjeK47YP",
-	"OBz5YWdHQsimnq/Ob1RNPpqRbWPWbD0ZmTe5qGy/80yd1r9xEJLgG+6//PYc49OgS2bJH8Vl1ozskgvy",
-	"py2Vm4JZKfnBXAerYAzZYdJt7vjWGeRLQqjAn0pux/aw3e90Iw9DOPQCDF1viHHPG3R8Mgr8YXvUhW2n",
-	"bmbjZswvV2er5J17Irejq4uq0LN5hYO722oHmy7JX+geLIfIArjq4rK22lbrFVgTrXMHfoEeROGQhJE3",
-	"CgexFwDW+lTY8QZRZ9iHaDQgw/6OAHez/Hxt6dWcWH3UU8pCgCSNTlI9RHdYb6J19beLjrRSb0/nKYt5",
-	"dvyPrQHtkom+pGqShmhmtbJUJK6eNgbG5rdWxKdHEpLYm3CpFp9WDsIbv/yCfoMk4lPIboUblYPiBBEe",
-	"pVNgypp1zu/59t3zE/QRklg3ZzToM3bGTiegP5eerBmiCCsYc01fx7qQZ7Q+qT+YDTafjDOCgvlsI5zN",
-	"p5wy9V/upMGWd7af/mx8KRI9On36/LHu4MUFiLnR9ZHbJInmPHX+4EJcgwl9PGO//PILOilFO5i58FJR",
-	"04I2Dsbc5TRhoM0N519G5zgyd9m/wvzcGFeAowk6J3yKKTs3tS+pnBiOY0rmC5aXMVH41L6DcZ5KEPqL",
-	"czTDQmVv/whCGRZz9I/T0/coB1IWb2Ef5CiNJGsu4/rn+Yzt+SWKONGre5IkNqhocTshu64744xY25Ez",
-	"QDzN/f82gkyvhiy05fY48H30FOeXelv2uzYqRrW4L+1TITZuyH4zQs84ixMauXqdEVqOx5Hml57vo8rY",
-	"KDPNN8XyaIrnCCeS33xOHd9HH9Ns9/Tf7exv5C2CXTKPni0SVBVxftVmFvyGuEBMj2yWzLNMCHmUtGlo",
-	"5UUVrxRdc1QZQmWDJDVrZBKKnOP9a6/b8j3OkvkK6+AzYO5IhYvxkastj1wlG86gDPPMuYCXsYFGs+Fe",
-	"amkcN/xW25bXTeIZbRw3ui2/5RtzSk0MNzy66ByZ++9WQEDF0dNrKlUhbtxelzdei/wVilfEHFExYnlB",
-	"o/xW1O/VYmZR5Kj4vsh1c2PxwuspW5Suegpgi2pL7zdtU2P5waUt6qw+SLJFpdXs59tUqkxHv0PFlzet",
-	"uGO15TzqW/W08trC9eel0N+O7+8U0r4xIKkqei9P8e5o6rrZCPx2XXP5+I5KwYamUndzpUW0rq7RGW2u",
-	"sRzPed00/tqN9aqib4vqlaHxgmL1uzmGOHaL8FnvhUynUyzmmvuBKvAQa+v/3sjLNhszLm/Bhmz89Ekh",
-	"p4fN3j6vn2YhwftRnt39egU/7TvDT/ngqgJHzzJrw55caYGYBdXb0Pe/LrKseK/Bll03l/jKFKnE2HWz",
-	"IPiOvmv74doizhyordqC5nuJsG3TvGxBsmMBvTGrMLRVzC4/nX/KIwKLeAo2L08Wzm82bovlLNxv+MsC",
-	"xG7icXlzl3Bi1xXh/P7FGrA0q9WiD4YyF5CY1wAhV4vqYHAfYsklOSoxjwOcdpVkNWAyAm07JO2mFi9e",
-	"SNEdztIKFC5lscpguILCQiq5Ag53lI3FfHTX1eys6uGFLNLlYaNuG3acX/cxFXqV70dSYj01cBWB/frh",
-	"YdruyHpUZ8jaBthOnhLnIdralMwqtM7YSfZH9hK0veFHTQY9d1pvsiUqLvDYxLiVklnZ27cuz5h9IhjI",
-	"IsjO3W40B9MxjoyjJ3vSbl0fCRb6gxmMRDKNJgjLv5mHVtOZbKIpjiaUAUrARr3bgCPZRHSKxyCb6IIS",
-	"4F6U0JlEoKIWem1ajGkCEj2JMHuCQnDXhhGWNsQCW7eRiXTMbwxqQBF7gxOHkiepMlnl6DSd2pI2zdsj",
-	"Op1xd4L+nks1FvDxn6/Nc39P2i+fPmmhf/BLbZk10aVuHWGibSeEx5gyqQqn8yfvX7kby3ieDUkJzOSU",
-	"Spkv+fJa2ZlN8dxGgWrORC5A6CWfznCktNrkLvlhpvs1J/mCp+NZ6m7BrwrQzPf4sDwLK5bqbW3Ordzy",
-	"WcT3FjlVDb3xOKezg+DfVfDnK1ch83PuVWCJhfJ1huwiM2+xgTLmT0gR8jcxYgso2ZsZm/dRa8AeALe7",
-	"ZVsHOY0b91sN4pbE8E6GbSZ9tzZt3eYfjNs/w7hd3uKN5u164GwycXNwrDNyNwDCvw+2s9AiD5bu7QTe",
-	"drbuJljtzd5dhmSNwbuKyRuZvPXCNKgMHDEjO5i9D9Ts3QDxVcP3JlL3CEsJ09AGoC+RgXmZ1qafWLxN",
-	"m2XvfvO8t/aJ2sKd4G6nHHHTqQiU+V75RO8MC/U2CzJa01d2p7a9GtJf17TN8f1qt3d2m7vyBqdZL2nN",
-	"bskdBb7HQsmn8/+C+bI0CnaURuUwquwiVSmQ6QVTVM1POf84xUmyMWQpa6MqyfM7ZszpR67M47+dMYQ8",
-	"9KTcxZNj9MksNaIyd3y4LGOA3M7l+VKcO0XSb9BCL3A0sXEt01QqE5WjUAJYKtRDb54iykzBpiPm3C9i",
-	"LhXqei03IpesRC/0k2Nkxi3QlIv8fuYiUY2uJlHE04S4QIk85GS5qXeCgHhyjE51l86CtdWzJDeUISwj",
-	"YMRkR9TFW6a0LWXqZDNbjIAyW1SLDDN5G0bXOmMPmt/+iCw0I0SbbsygNINAC50+fb4bJzX1NvgUkyR/",
-	"v6XU3YpeoItX8YcqFr3E2b46RrIvplbFon4KleHHU3MNqDbitVZHNWwZci5buLDvac0weyCjQmnVNR08",
-	"nUKwDqAHHeKuyK3zoDUCzalcEi/L3H42o+LHtBO2JPPdJZ7Al7Xy7mWW3hxf5ukvsmSeRUulzFlegvqA",
-	"L+/URbPIM2aCoisBXmyBRwqUJ5UAe9X55i2ZXCa3auHqtg2YDCo7t2ASB0by4oY1N6cc3NzUqrXxX+X8",
-	"iS8UHm9KmWjKmLa6W5L6m0JWyQPj+jNVm+f8khnGlXnQFjmf7taFt1ke0/gtZ/AGq2iSieUahugeDlt3",
-	"lPEMswiSnCXaGhsOLywLr9GwdprpXdgLn3/eQ5QfnK62O3WpRuB6+6HyhPgVo4rihH4DhDcCelF4CdRO",
-	"xt/GBX+HKnKu0m+X5buwBNM0UdQoWLYN94bhjRB/B2rfus3ZrOktrmCuOXGTCOeOL5ecvfLE7WWWX323",
-	"Pd4tyKUUQXMvAS41907rw1vcoh7O+nbUFPKbsitHfAvUZVDOy9YxrVLovbuVaSpVRre8dK8i3iS0JcfH",
-	"3gJbXA+HsJY7DGupBtsiGCrHygriSqxzi6AWkge1SMrGiYPhamSLScaUUJODr0pJNCj46eNbfg7VrAyO",
-	"unCYjOtUMLV1ATAWPyYTQK0Y3n/YSy1TOkHFZ2EPcLsnubkmQkZDBeGQp2o96PYXHpO9OlwVFLOM1xuF",
-	"xNQJ4S2299PPGRjzIH3Ya6Gao6UWolWi92jmkoXsYMXgJEFZNYSl5BHVELA5ILQ8rsar5q5ZapJfuVgo",
-	"jfs2QVxy1i1sEJdd4gDmP5frGlMwg4p7z3UvjNdRxA1oIK+yDuX3fMGl4nX5R/LxIv0JMslqq5ybi1S3",
-	"stGsIq8NOfvux5Xws9HxAyTLHNbrKLJAhYXym2/IuP2rcCDkv9zEg7CAxd5cCFkXBx/CHfoQ6rBWAZgK",
-	"uC2x7p2ux9QA0RawPx48BT+Ep2B5+w2UKpnT+jsx7qn8uvsHuVCf798zUM9rDtrpfYvBzbDan9Ffw6Ts",
-	"7ytgvJHZXys5/7p2/49/IWZb7GYC1CX/3MX2yapUssnFj3/5y/3ZUyAHi2WPrDrDWxnni2832yWLh0dW",
-	"DZP8pxtZJov9359pkvVxsE3u0jbZhKol7rm1+YFwLdyc+WF/PdgfP4b9sbT/9UyoUrY+B4VpIvPTpTpo",
-	"FATrPRgg9RzlYIHct1jbDKz9WSB1aHTGwwoeb2aD1MrIw+Hjw7IrtkRktWQ8ijiBjddgplwqFKVCAFPo",
-	"kX0m+TFySdAXz8QSqLwT84wT+FXwaVFpO/DIvwyPtBDbE6OsNCHcpTFtQ+i+0SNrT2QvgT+2T+I4rLTW",
-	"2BelF+lXWGkBsfu7OeRum+7VVilN84c1WH5w0lmycLYinhqeTmgcb+TpupBNOnHJLZlk9CGrmHgFRcjn",
-	"up+N3Hx/tHFg6X8WS8+hYrG2B+beXPV32i7RSU2whICLL3jtXbCaBl2KEpPc5AInKaBHXvsxEjATIPUQ",
-	"Db3848XJ8yZySVoYXIJUOcW07Ktc9gq+V3MHv6b3p2umEz7U6Xyu4TwLFrKO/dhXq1zJovroYopqJXMN",
-	"H7qXaLWykDzErP0AzGkvSucm5B99zz5+2dbzWJK+rfUOyA3AP/ghH7IfshYl9yFATzMmm/WMjH8oz6US",
-	"ODk0w2pSEkPZMLfLQOPfRFyUl+NI0jGrSOn3g06+xp/3kY7ZMvGv0L4udGeUf3DL/WluuZ0pv4Zissf1",
-	"b0MctX6TE4aAkRmnTKFHrqfH6HJCo4nWzC6xIFZ5dI6QDX6UF1cQpbng+s2NvFpXO+hOD8XhkCFsKfrz",
-	"9OnzxjqgmufXN0WrFIJVXPmqE7XT7Kd7u3H/UANVzEocwlT2aDs4GJa4cP7d5hAVU7TKgXzqfrhJeEq+",
-	"63tz+LoeDqEpd8hH1yKpxCR3iok3W1UbAG3LmTKHNyL+DAOvvKN1bGS9SFRrtziXiPuPMKllCwd97H7l",
-	"0SY87S+2xECgVRNasgzDGwWW1Em3g/36oOzXCiCu3GnPwbKVvNv8cuGKkVB4nqv+ubpfuVhoW3vXyOkU",
-	"JAgK8nAG8GD55lHd617mumyGG4SljWSybox6MN/BaUF5eGoBot3sZaRrIle1UlGgU/hofj5QxYEq1jNx",
-	"QwyLnbtXctiWAIrXmwqV1kP/4Dn6OSnyIRLYYpnLunrx+y3cSGvY+gkpQ/tGHqUSGvbnVip0c/At3aVv",
-	"aRuYrfDWm2RxLCBx91yOC5werkn9EOEJq1hZx8U2eLGKyFnny9oIEv+eGNJBD71/MbkNzvbo3co7qnVx",
-	"5SVu7edaJ3MPzq6H5eyqxueqw6uEn52ksPFJbBUNKDAbm7cddQ376lAJuWfsjD158pYrePLkGL1iJpIf",
-	"BLAItOmmhTX8kdILnABT6OWL0ybiLJmj8zGgs9T3u9Hf0VX+KYFzRGX2hGULfTB5/ykbm/cf3WDOKZOU",
-	"wHkWq3tJGeGXrTO25uWPXwWf3sIm2+mNEzPKjwoLtVuVF2z7PsZGFRPvxIs/tk+GAVIWKtz+7ZEDUd9C",
-	"ubEkWJe2Oie7gktEV6il9BqF6J8piHmJYl3TMRe2QU3Av/zyC3ppEYW40ASLE4QZQa9BysU30QSir1JX",
-	"OJ2ABPc3AhtZhXCswEbw4/FYwFjzKL2IqTIU2XSxW1PATCI1wQpxBijCbJF50sXe6zqQvfrhrg2EqTIP",
-	"wbpClM1SJdGYW+bgWE1lx2aKOb8BlMAxKnGfdx+WWJCe+nmSVfg7Gi/XKBUWgEKuJpu4Fk9VBdsyfa3n",
-	"bHodZhApepHMq7ic2ePFBv/KheZ4Pz6Pk/QT28ETdgcscXOFmYDIhEtuXSOH5PY+OjqFb5zBvXro5Ad+",
-	"eXCXP2gzpVJimBtMNxEX9V5Ao+mZuFvpvO/1vu4TYk4+T3mpzB4ZT4kpfL6hA1IaBlnjfFy/mQW/oVOY",
-	"ygv4ltv1o0yC0EKMpLBIruxCa0KNOyzmToIeiOlevZbryCnHv+Jl2K81r6S9LVl3emSVMG7fypdwAQIn",
-	"pvXMQ3VQxn4aZcw4FaXezKfzf7o7tEvscPkpFweC0qGi8Vga9isBC3vjoXhD6PdGezjq+8Ew8kISjbyg",
-	"GwUejoO2F+BR0A9HuBu0ofG5+jZvlsO9/g5RfU73wkPibX9FX/hprOO/tCpoSOYQPfGw1EEnZJYElpUt",
-	"mQCxDrqCaNnkGUylewO6UnC1WtWvUH4ytX62Ryj1rA7xCXuEsAXbEoBXQ2t0MfeyWQm/WfXNEQy6ZJWp",
-	"8sl+fxObIQPH3sIVbAf1gQrNRkLZV9Ot9RLqCk/n5mTz+PvSXK3T0a5kOEfp6rvA340S0DjWY7IzaoWc",
-	"zH8x1pjZzIzQn871/6v7iSkjt+vFnmWsm4s9FblNL9cHSt3ZXCrQ6jL9FUXH0RQ2Bs0ZM8Kl6zO7+GjO",
-	"08cr9PnbhOMpbTxYTv/XZtt6o5c4928TjvAUvWpsgMgOWY8/VTHuErs7BPI8/LOu0rbXnXC5rV4V7hsC",
-	"cDM5UBvSsw4o/t7F9cEgul+2VBXBU1AU9xa8U8mpSsrMreJ1atTNG0XqlGfwgqoJCHRun0Y816RElYQk",
-	"Rjz/9gsmxDjejgrfCZjyC+diyxxHLfROIMmngLhpFfTmtQ5vt+w1Pmgde13G5xaC+c4fd60hjqWXXXNb",
-	"7PAg5F+bdx+Vn/i7SyZehXaBrQZ656Lh2cQcG5iTD3v4kGcm0n26bJKVromPoNzKf8AKisRxI+FRaOsQ",
-	"7fnQoz1Xwbk219E6Rq74V2C7snEJkQCFbN1dePmpqXGfnNz0eGDkD5aRO/wtH6mbvXAAa9y5lr7pMpvu",
-	"NotElnOpYGqTETvcX9IkQSGgMTANcCDmDFgYUgFSmY7/LVwaJJ7yW/iTcyzv7/6b7uE3qiYfzUwPqfQf",
-	"gEN1PaW8dBh00MUFwmntJAKOvpt/v2zveLNkYlUUjeq6vL8GVLU8/+CHe7B+uEpk1PjmNuDurtMAG0xl",
-	"/rw8wqUR9gdk5A/aXtAPRl5AIPAwjrEX4gEZkXAQdkncqEyUu5ji2hiX5RcdPq9dVLtWZgvsrFORNI4b",
-	"32eCKx7x5Pr46Oi7/f260WxcYEFxmFjKyMpYAoxxmqjGcWOi1KyxzJLfZ0WbDWDpVK+7K6f/sctveyk3",
-	"1u4MWn7Lb7WPh/6ot9KsxQ769OG1lgMLM2s1GumTOaHBUcRTph4jKnNnjwkGddiYADp5/6oQUWSwsbq/",
-	"L43vyPiMihkdTACB/jAT/IKSHHOCjieqtWjWup4q2n2fOx/EonKagDTCfb7SoR1HoeXc6Fxt+8SluaPS",
-	"JEROEohU9rJUIbIC/TbBClGF5ISnCVk8aYAIzIARiThDc54WOnVZKyq7LIQD0sIVaBPVIZUAm9M2a6h4",
-	"nW+FqecpZYQ5NjULIBXXS2J1G0HhYtF0GqlUgERTXUKTcAJXSE0wK0/3GWcxHadWJKCYJmCiEeUUJwmI",
-	"RaCgbtbL+x9zTpAj6uL650lxKvbWpXM19U1CagnjqXkrwkU3EgTWi4klmmFhbRlmXZDFCujRlJM0gcdN",
-	"XTJ/q87GO4qUSQSaKiRHPFbA0CNX4LGemK7BEFxZ5jtHStDxGDQdRNpuylMSF0GVJaJdndRHxQUeA0p4",
-	"5BZQd5GAULKFTlCoOQ0K0+irscXQFLOxLq7ZCE+lLYkYVzR22mBxMW07jevP1/8/AAD//2Iuj5n8NQEA",
+	"H4sIAAAAAAAC/+z9CXPbtt4ojH8VjM69809yRVmrbbnTmb+zNs/Jdmynfe7T5I0hEpJQU4AKgLbVvvnu",
+	"72AjQRIUKXmJk2rmTI8jYscPv335uxXSxZISRARvHf3dmiMYIab+PA5DtBTBCSQzpH6IEA8ZXgpMSeuo",
+	"dTZHICFYADoFYo4AU+0AVL1QBCYr9bPqDhj6M0FcAD18p9VuoWu4WMaoddSarATirXaLh3O0gHIisVrK",
+	"D1wwTGatr1/brWeUCERE8IKENJI/epcjt8IQ55gSu6pQd2yDqzkigCMiAOSAC8pQlF/G7C+8bLgKtSf/",
+	"EpaQicLcABMA1QcMY8AQX1LCURtAEqlm0ySOAcd/Ic+xgG7Q6/YHe73h/qg3qlneCwErDubk5bOD/qAP",
+	"XpzBmbkDMMUojvTa7JrAktFLHCGul58wJpePiMBiFXwiAs7AlDL1kaMYhfKaGeI0YSHqgGNim8qGmANI",
+	"AF3CPxMEcCS/TLGclrJPJMLTKVKDXyImr4vLM4PpYIBeIgYEXqA2YGgGWRQjzuUdijliYJHEAi9j9Imk",
+	"3SFD4BLGOAJQ6AXCBVIjFBcWUsIxF3pGu8JP5M+Eyu3o42yDJeUcT+IVWDI0xdcaniG4QvCCyKVgEuEQ",
+	"Cso6n0ju2g4ieAAP+ofBdNzrBr0e2g/Gwz4M9g+nB/3DsDeBB92ae3wDuQje0kgeWFS+0N8kJLvwdQU5",
+	"iCEXYGH6tCWMQwJ+OTv7EERQFCDrN9mi3wPvQwH63d4IdA+O+odH3S549fasZm2/Qrbyw1j+iWsQSgEr",
+	"QktEIg4oyS/F4Jj0Wa+f/L+DEyjQG7zAIlD/La/kxKwilp/BEjEwpwlz5+x1u55ZMBFohljrq5xnCRlc",
+	"IGHwIJzNJAwK9EH+XHEfCcdkBs6XDIVYQuR5B5yqJwLEXD4FOwaYJiSUHQEmXCAYWWQRoSlMYgHO4eXs",
+	"XGMric4SIcc1Z5nEogOeU8QBoWIuP6h2zqzy2REqAEdCHjSW6/szQWzVarcIXMidpkvJHTYiyaJ19HsL",
+	"XspLWGAi/wuvZZtk0Wq3QpoQ0frc9twKFILhSSIQf4ljgVjFMR2D/zp9/w7QyR/6VBDIOoJFwoWCZ4gJ",
+	"EBQsoAjnOUj5+1NrGlPKPrWOBl+rtpYOWANIkwm9rljmexKvACZhnEQIYIEWHMQ0hBI3XGF56ACCCU2I",
+	"BFcwoddghi8RkQ9ugQmIKZlhkUSorf8Jhf0XvM59hNfpR/Dot1en4HD4uIBKfu8ddrr99mjcGbR7h52e",
+	"+mv0WbZYxjRCraMpjDnyH4XcYe4Q1FbkH1PKFlC0jloRTSYxaqVXSpLFRL4AdfGvdfOhgoXsH6YpZAyu",
+	"ZEsuVup25KDy3xFbnSSk4mh/lfhZvgHhoAtJBRlaUiYcKFdHTRMBFvBCnjMkKxDOFYNRBdYRW31hCfFd",
+	"/ITSGEGibh6Ry/VQGiMmACKXmFGyQERUTJdvUQlr5RNCl4iIJku4XDP55cbTzhB9qS6+YtJTJOS7O58h",
+	"+geXiERQwJBImIT3V4iqt/sSQZEw9IzGErNhSjTE+pZooMxdo8FwraOWnKHVTnGO+aeZ2o9kZgxBgdh7",
+	"9uLPauhKEOBzmsQRmCBgegDKAPozgbHc0aNPSbc7QD8/Vli5CpRmyHe29nl8bbfw1FLnU0zCKrpw7BJg",
+	"cKaYJib5Gq5I4QSGFwCCQXcI3lEB7IiACygSrjG7mKNPJGWK5lAj9wlCJKX1gMsldMDrGZE8re73ehq8",
+	"owQFbyUalTRB0oNPZCMuQJ2MJubZ0byepoxJoPZeg2jxVK5DLaPilJxzkfQOMst9xhgR8f/jmmd9pDh3",
+	"iRXye3usf/tEZJcXZ4ZBxYKn3KvhEFMpxRymZr/xFEyomEvOMUH8E1FkBzwScygA5u1cj/T4NSKKHreB",
+	"yNae3eknUnmp7RSvSRoSraRUgsM5ECiO3V2r/Rg+NoThHEWebWjGSt6twHEMZpRGEsQTjsCjKUN8XqQn",
+	"rcPRYDodDw72+7C7H0WT6UG/Hw7RBI2jKNrfjw6n+4MoggiOD6ajfi8coDDsdyN4EI4P9rv97hqgyG6k",
+	"FiCU8FT9ZNQlUgZg6fWojnLDVJLohFtgd1lhdRbmHhFvAyrmiF1hjjJJyzZVr4KIezwktYGa85FyzgZI",
+	"Tjb3YLiwBsPFdRhOsc9rXqxuqmQozSOl5KJySjminx70u+2MK8FE7A8184kXycLw6wtMzL/aZY5dNX6O",
+	"lmIdjjHjmZXLhcfoEsVq5YJB+b5QBzzXa1K/EqqliKodLeD1l0jOmttVzUoJgmxrzvOcwQgn/Bxo4UTL",
+	"zEvKsRwhY0QdPrMRj3mgOMtBQ85S7uAWOMu+y1n26zlLOp1yVA+SOYjkF3gJJmhKGZIYmGlZioJQMzAu",
+	"w7lGYNIz+0HXC7kWArpeCKAMzzBpwAHqhlWLsh834AGlUuWK4UpB9r39rg5G0UR5nOgac3VyERQS3DAR",
+	"XJPdnJ6FC7hYtl2xdgpxnEmvit2vPGM7dR3/noq6VZDAEiLfDIBxnC2LK2q/REwehSOM0yViUGj9kyYk",
+	"M0aTJSazqoWm83ul5wUOGeUopCTiChLiGGf/1H+p30kiUPrHKP2r183+zH7tZ78O5J9GoxFBua4rhC7k",
+	"Z0oUIlrp1xmhEEZyhhARkbCVWQwiBEM/g61RS6V0AFk4B7oNwMQgoLamwoLOtF5OAcU5QZCdV52fHsL/",
+	"mHrdbrftwSKeB+WQKqV3fkGiiqW/IJFDFCX7hBdIggKmkeYr9N/gkcIQEj0gEj0GISTgyRNCxZMnAF2H",
+	"CEWgB+Tp5onEOaFXlZtF6rYl6GOGotaRYAnKgU3KdfS7/V7QHQXd3lm3e6T+93+6/aNut+UeCBQokMtv",
+	"+S9wDV8lpYRZjIBUKmtNfVFJnRJwpTzEBHzSGuiftQb6U6v9idifet3+MPjUkoyH/SkY9fqfWpJ1RloX",
+	"Oer11Wz8cQcYpZzGGp8IR5eIwVivg4MZEiXurMiVuUup4rCasFdqylN50VWQLr85cuL9AowasTnIdG8K",
+	"Mka0aUCMbNOqZ5193oAgSdkJ100vGQJ5DaYxT9WDgFYRR9PUz6WUVrWA128QmYl562hUz4co4MVi1eDM",
+	"bNPKVaafs2X+L4amraPWv/Yys9ye/sr31Kintteatb1CG6wOvMpUK1qIqFnvlxm6/SW/2WjJb4yU1Gy9",
+	"8W2uF38kayWj09d5q6jR2h+DUGLFKymq0zBMGMC6wQRyY0c1RrFKoU02avlxwTPv8+Y4RiRE0dqTVVKH",
+	"bQmg3L9VPknUI3E8of4GSkioOnzToY6f04qRJnevGlbjTZHwDW9Z9/HcsYAz3gwnyZYN8JFsdifISBmB",
+	"zlZLtAYgz+aKB5cj5ShqgmNpw9ij0ymuxOumWzU9KkOdwAv0FyWVrEioLPDKKiabAtm2QCI/nj2rJJF2",
+	"+BpCnyQ4WnMoqVLv48fXz3Pn0jsc73eHh2EwicJxMByEwwBOh71gCMfD/ckYDoa99LCWUMyzlckpNzqr",
+	"r7ox4uIpjbD2sVCgeRzqpf7dMuyQ/BMul7E0OWNK9pSe/OhvZ+wlo0vEhBmFUIHyGOINpcqSgomgQOGR",
+	"EuglROC4wrwJAZ+j+NIiAKWFWyIi/z2DmHTA+7xOTrXGZPZTqvHExuZnH/OK2yHzFmHFCfe6QffwrLuv",
+	"2Zr/acrRqCPNr/4dFUbXq2cD6HqJ2aqtdSdalav+dpHM13brHbpSV3GDS8gtxL2LD4yGiHMQYRSBKEES",
+	"5mN6BRZoQdmqvK92ztqUG2rJaJRoaPF1uyx1eE6vvE2NPiHX9kppUVlnRo/COQovwJtef+DrzOCV1A2U",
+	"Yecp5Gh/CJA076MIMHillAj5G4evfuWTV4f89S/RZbi4vnj9H/qze+OS9/fOannO/KLRZMrUhUW+TpY1",
+	"dPv8LjtJqbgaLZcQsWURNucnFIRtRqAU/civeIqv1RMgVAQwiBiO4812IJ8QTUROAB/sdwsKrUG/VVZi",
+	"tVtKN5Q/9/fv31bIGBYd/u5KCXn7aWrQzFhiF5DambpLz/zZ89I1myAogJEyhCjl1IoLtCghZed9v5L6",
+	"nhs8ckTgJEZR7hg13i8yO+3WBVoVrzF/DEvIEBG5e6yFErWBfyN1p5lCtVe+cE2l3Cs7TSYSHJVZKRFw",
+	"ZnQtlv/oeZ6PgLMv2iPK73qDmdFWq+M/F3B2Di7Q6if1zylmXEieybheaZxrWmNuXK0kbcGCa/1jHlPw",
+	"dMFHtbCmtmvOvBpclLpvY6A51cztDcAmpIsyMv+4nDEYpZqZKzQx1kXupwkRL2kE+pJ09rqpRqAp6awm",
+	"Mb+3PmQ0ZiMMk1Kf5l0Y0mbbEDWCfXMPJ1mvKrKgUHy3p/Slk25/U2Sf0owNOgnIRJkPaL2jV9KV0CIL",
+	"H+uz1f1ZGtF0hYXnoqDJ907MGW/yRp5DATkSN3oeabf8aowHbtGaX8dQ/OxjKEgSxxJ5FxB2dmC2Q6bf",
+	"D/ml4v1xq229VhaYh/I+6CJutVvX6r8ruFDUOFuS7uKT9r4wdKkMCrzOrGU1tWkHeRkXCC074H8Q039y",
+	"ICnoKm3TyXs+rjdO+WjElNI8VRisgb2SMR+mgjK6FiCGE2lwfSSbP9aexAyGxsErAlMlcst/LRO2pFxr",
+	"QrKl/P5JwsUUzxJts/nUaoNPLXQtECMwDswr/dT63NqID5Li8Rclu5V3ABhSfsoG/rUsnVvUaNgfj/b7",
+	"gyAcoUEw7B6OgsNuOA1Gw/5gcDjpTcJBtx7W/LQr9aGyz8H3Ps1j2+J9nqaPr/ErXYeR86N6VqpcyPTn",
+	"nOXBOsCajShXUA4F5tNVxQZuyrRZMC9gZrhIaa/iC3IXrU2FlNW9Bt9V+u7t1aacx1tKsJx/+21nnr81",
+	"V2mmOk7bK/6gOaNbKa5+qBFXYZw0X94L3VwO5nIbZRSqPtmbVUyndt0izoUv9Jhae4EWS7Hq+JY4lzfE",
+	"EMcefPcLvQJTyLRj3wStqInvEHOG+JzGkWNWV0A+o2k4BbTCi6Ro8WWeoPU3NIq2FUpnlzD2WXKVNRpM",
+	"kLhCcqvpISoT+Lk84yiJ0XluBfs+sXCdp0u7dYFJ1PAi/y2b+iUU7VzHKF0AQSmYU1Evo2wtm6cX5Qcj",
+	"HVJg4EVdo9KOK4cTDpjyJ5haldJS+QTKs1Z3fC4/58904LV1l65S+TAg5tMptU4K1AkvEDBtc/xQvwfR",
+	"aDwKeiM4CobTXi84HI/7wTgaSIk/DHvIy1JeYRLRq2oYolPtEiInt6iisMVN4cZPCJ0zMIDlXpYPuxrI",
+	"cvAr1NrmdBgfin1HBZ4abPpsDglB8c2Y2Cme1UGhZ85nuuOGSLfJg/NMVv343mfOMVdoMqf0ou7tVYjg",
+	"+sLMcXz2K2nTZakHRFC8CWl0+58k8Y0E8+za17414llyXpiDvcnhZBgGPTgcBMNoOAkOoz4KDqaDaTfs",
+	"TvbhqOeXw29OZ7eX2ctQ8IxhgUMYA0xATttcg4VdMbyAPuYojsESCoEYybtYK7KojGltgDqzDjhfIIFY",
+	"8OS8U6PM3U5165wYl0oErFSPodnyZoq4HCUpzLmpcN5uCYZnMxOA5gl01bEw2ktV4KmUsTrgOI4NeVo4",
+	"KoZOq+Eu3Fd0pqevVRuYJ24fQO3jZlQHtLEk3kil8IHGOFzdhPdNTWqpLM+QDoJjCEr8lCwj/e8IxUig",
+	"vPxu2pRf63SKwpyKAMYxvVKjkFV+DPulNIgSNlIR1HFF73WjweFkEuzDQxQMo8F+MDkcDYKDwag72T8I",
+	"J92hF4UsGaYlCO95BX6/DSWjt3v/e0Oc7+zFWUh6UG17Ec7UPqDR970RhDA6M+bere11MIox8WAsG1sj",
+	"Obm3VLLHXEUVR0YTAR5hAlxnz8cATgViJrwDArM48EjDP2pbivoY8LnyVEVsgQkUqK32fElxpNy4AUsI",
+	"USoRPUJBJTJS3l7la40hmSVwhlzAFIjMaB4i9U8eCCqTgbcruwRfeys1NDo6pez5Te9fniN4dvL+HbBD",
+	"WEdcsVoqsvO7+qqZyM+P5kIs+dHeHiKdK3yBlyjCsEPZbE/+a+8Zo+RxG6yQsSrwZKkCC+Xk5mby59cF",
+	"wxHoD8AT8ATsezcmoMidogTfS+0AkP4p/ZxR5HGrvUfN2GIlr0erxOAV4nSxuSZM/bvE92iI1RH26BqF",
+	"iUAqyB4SYMXMTnqdqlUI4xhFJiRa5YB4cXoGjj+87mQgwJD2FpisQDaDAxfyGaBrgbQMjVkaNw1jrGVz",
+	"eyMLNWSr3TJvS+v45SAFFJ5+bsS1qkYWABwIb2d4wnlnXhxmHv0GSEzrF29A5Riq1dN9oPFqpnUmTrx0",
+	"HYeTtZToher11PV6Y9s5KKVa5yZKytW3q1Tj+lA00NZtSr807Tu18UNDi2Vs8Ep+wdIbSXumGK8t01L7",
+	"HTsCrFqu0Zjy9ABNa/0IFXtTdM4X1iHMjLhapsevZ4wcPyxRaJGupuCcPZoMUR8Ow2AwGU+D4WR0EIyj",
+	"fi8YDKLuPuqH+7A3bbWrzqXM31p/NRChMIaaxNb4rt2KStbMvOFzfTbHcXSDN4vJHDEsviwly5OyIkby",
+	"M3FYHuZIAoEhldptwFwgjDkFcv6V3UIoF5gD5Cp50m8FkYBiATMdz0BRXs/URQeTUTgMpmgcBsNwNAwO",
+	"YRcFPdSPBtPhZBTuR7XoV63Ba7AXAipmyuyTK/8tefZrLkf60KAzBomOkeO3Zu3wj+4FKhWLRK9MFHAm",
+	"vGndLyYzE8jn9bV09nLmoI1tWVyDMXKyaO027cTWrvt1M+eSBtg7p+PcfGFnWf/GfI2L/s70LViUOWVS",
+	"3ewgvHyeKIajPd54d2u4izU4yGLyTZGR9AW+Zbtecdway56JCNPJmGyyDyfTi0IizYA+BxXbckRbszhX",
+	"iH1RiV5ycB2MmqntG7A7Fdp6yfTIT6f2U83j4fiLigxYG40AOcczggwkYe7Onkfizxo5geWp1O+fC1zS",
+	"q7NBT0aMtd4/P7tNg/z7pRYBinb5MuuImtk8an1AkuXSCweNwMD/9u2FeZ+/w+Jt8vbTp/ILgrGY31AJ",
+	"fh+23uulyh/3pbmpMjW7WdSRnVXbZn1bUC464B0F2mFZho0JGGvnAm3blTeeg/hxrZGqAkgcfcs0hsKv",
+	"OrKbyEVU59YOFnAlV7lKw6nX7MZOxCs2c7g/vIXteNQ/OuGASdSkfYx6I6CDkvld2mSrUhoqDbICav1U",
+	"9Jrcc80cSwUscKrKqtCq5USaWls74AVWMpaajTLj+Gry7tyCLbaxBONsf64wwcaa9jN6gcid+NbsKek7",
+	"dXnQExUon3YR5ihkyqVJt7gV4e44iqTZDl3pYfUdJlyBgfcc+HMT0tD4IJoxrvyEXnnsKmvvUqKvynW+",
+	"XshHeXv8nhnwLVwu0zilgnVdf5II6dnprzKdRrLQ7ohntTb2j/LI79J1y9ypa3e5RR2BXH7j5yRT4d3c",
+	"LGES29WqBU4Sow7QU0oiQiW2Mhn24lWmAtJRqVk6KoZCyqI0V0eS9xs1s5Vpv/UyrcrGG6HUEVUelhwX",
+	"/JaPB5NSjnzxmjtNW2OuW58Y8wfMPmmbis1zafupk9dkluHZXPlSaYueeya5bQ1rUrV4bl+6eOmNAJie",
+	"M00ExxGyjkCppvhru/VRrWAXSLYLJNsFkt0skMyHivXztoFEPgTsvL9bDPR6qJFd33HsVk2AlkkarHRL",
+	"kn9MfTXW3fitRmlVhl/toqu+bXTVHUVHrY+EqoO/bSKgvCkLM9MB/stJ2SSPXWQ5lGUuUfn0et3h4ehg",
+	"X+ddAo964O3Txx3wQac9s2yU7qJeLgS2ooHGGybtvnzP2tlZpSsxGfJU5mupXQALGMsBUZSOhhizmfAb",
+	"BnIVCLpp1wEf7RPnC+lFIJ96TGHRb+PNaVc8w08vJv2P+6+f/df89auT+H/++zV//erF7H8Wv4r/+9t1",
+	"bH7Dz/DTK3hGZ29Xw+t3z1/03jfkCm4x+kv98r2Gf3XM6ncxYHccA7YmuMvQvyak77Zio7LtLVY2GuoW",
+	"A5822NHDCXzaRTrtIp12kU53Fen0LcKN1oQObSpzPOTgoYcZLdQ0EugmN/EDhQPVUp9dmE+FYu0hBPHc",
+	"YgjORkE2mz6ebx1pkzb+p8TaKGeobXClvqnNL3gXKLMLlNkFyuwCZTYPlLk9JGQywp/czJ2Zme5urZhu",
+	"d33BAJ9iF4lcSTo5LHiUad7M7zwtJ6khT7uPdKo3+Y+L5jkrqBo8MQLNQ3pSjFKeQ33KhQ25cO7DPEvI",
+	"uf5LFrLAlxoLZctKG/qX8YUhyH1+Dr/Njdue49kfyYIH0p8CqfK62ec55oKy1ZrTeY6kyQmrCsYoan33",
+	"gU5eRHnsLFFiL8pmkOC/zIFwnaI4qxAsByksrxz/s4VuVc22MeK6i0iMomS5jGFofFlizIVVfMjmjYP5",
+	"v0XgRoN9FNzOf7BQj/p4jo3B7QHFQJRQDrXe+KqhroorGR7NzBtXbchQVhNe5eHWtdfBr/r7kxhx/gSI",
+	"OSTa8qhsjhMEGPpDeYgXog0rAjAqEMCmARmBgc38nK33f4H/i6RICZ4yHF6AEwqjNjiliZiDF0QwSEL0",
+	"E5AwipgqkdraJFDDBGkUJ332TfG/yDajScCr47MXg55hnC9nvfl9BHZoGlk4mP3uuDceDQ+C7nR4GAwP",
+	"x91g3J2EQW80OehN+73xtDfZIrajGr5Vw23h29Sh3QDEt4LwrxUuxMaDeHvEc9sRJbsQkn9SCMkuLuRO",
+	"40I2iAHZFAPc0FFfKVp5RaFx46avvfu4zEWAOVhCBtUFyAPCREIBFHgSI1NXUDf+AqPoXCFa+wNDC3qJ",
+	"zjUSTcmRP7GCWVW7nlpls3loQxRle9BRABxts5k7WrQ+ER+kyt+zpVvu92Es3qKMJq6/cvVNAVqr3lW5",
+	"bLXOpzB6BQW6gkUrh+Sc9pYxxOQnKcozjsTPiZgGh82DZ14wRpkvZOY1UQHRWeFudfbJkguG4MLk+e/I",
+	"Y3gKI6Mdu8flnTnqr4giHVwxpYrl5UsUpsYltcRnWnqqQMBmmNTadwVTeUv1fq6sPpv01nYi3fslZRMc",
+	"RYjc4+nIUu7WEVPQtI6scmcJ01N5TbQXmnbl0IPdJ4Dp2Q0oaZdMVcmIipeWxb1HaDJQg6L8VWq4Soi+",
+	"zHdU2BL5NXW6bPH9CUIELGwfGWMFyUxa/E9VxnCsuZJv8W7kTtMK9VlIkJPpXFJ8XbRU4SHre6t2ULUC",
+	"034v31it5IzSt5CsbHHV+9w1pWABySp9rUbNmb4Rp2Jqfs//HUiV/xu8wCJQ/63bd7mDWs9HAhMxpwz/",
+	"haJvgMXl5IiI1OGDoUj+E8a8k4V9bYLhNO2Sj+KrrRiXlYPzuKVkCNgtH9I7CLoHQb931js4GvSP+ocb",
+	"FYRtFwPNyt8Tzf+hnON9dXRPQbCrDisrfYkhF18YChG+RF/Ucm+21VpdQBa2JsomfnSJacK/bC2sOGFt",
+	"GwWj3b4nzEbLNvX5vtygMODW98Gz4J010pYSd3VLLRTn65Q2mugmcXRbRck1eDhWSVYaNo2Xa5BEq71R",
+	"vbWshGpaPFlPVlmKzVQ1tbgo22MG8C7K8D0k30Mvwl0GC5+/tjVCfDa3JLPkYuTO52wpnc/Uj8tFptlm",
+	"pdPOBw4W3NihmCOmtTIS4rPKuFI5yOeQoYK6CQsu4+mMgYsSJEUrHKmwFzWJViYKhrW5n2g9jtQ5mtHB",
+	"HxQTPaXtalkMHZ+no+Wm1DrUYKEYJ6hXoT5ofcgFWnnDdTK60oximA5fJqtavchH7letfNvAyu2q5NXE",
+	"VmadfW0rHrf/Cbtl7HLTZAfnPkHnPj7nAPjfyHNDqTqqFOGZK9XKlDdhGhkqm+hF5KrldMC5vox0RP3P",
+	"nJ0eXM0pN+kE1cg87xmaD13QvqGqFn+ouqs/0blSpZV+3jO/pKo601oDusUUfkQo4CwHTNkNmt+qsMMv",
+	"2qYvjUArv/vwrFm0pcFpX9umU4m5M2VxD86646NR76h70Lw2nBmx6SvtgLeYK58kiTBsusIFjJBES1nK",
+	"C3NBulh4CjCqwG6xqu8oOgz7g+ggGMCDw2DYG40DCIfdAA3QdBCNJ1M0GnmD8BitqOCsMdoETalBtOas",
+	"CzWbvJaDrcojC7puIdqNsWIdMLwg9CpG0aw+t0/aXW1dTWsWnEFG+rRPHdbOArivkEG7tYB/2NpV6v+v",
+	"ICPaDQcTDUTK1KWo/SSRv0uDofaiiVDqdVn01U3H97+O0you7lgTS+UDrgNTLRt3NccxkoQLc6C9chRV",
+	"TBk7TLhAMJKYQjOaprozIhEgNOeIL/Xvitop7wPldC7lRKgiWVNLioNvruaIqYQCKizKdJNLVD53K93M",
+	"H+ZaHSj+bWjqDYLTv6sY9AcWZ546rTXd2akwYYabixQb8REWQhsJBVYAMBy+OQQDVrk7zBKkr+dByvdY",
+	"Am2l3eEOJlAQroPCUtch4/N8hdBFBFfcUCDN054mJIIroPQAsnN3eNTtSrdpMwYiKsORIRlYWAYEqeAy",
+	"PTBB1wJEcKWRCiXI9lJELusjxRbNulzNqTIOG366KG8WzeAFsq7WqDgPIRCTx/D/PPq92/v8ezcYf/5/",
+	"+793g8Hnx0e/d4OR/ul/VQAdK1jOu/1bGFee+F/U6+d//O5YX4j8nhYsVufcBh/PnlWVuH2RyPPYOxU0",
+	"vJjT2Osjby+3PK3G5vKSs+BVd/jfW1zBQGNx4Dc9VW2ZG33C6gGUYPrU76d6DJYGdCw8WxW2GkzSLcoK",
+	"umIJ//oQXX9WM06rnXmpGh6rqQ+rq71wOAXDHoUx5dmgqfzdahf4loTIfxFXIG/CbB3nvM9gFGHNanxw",
+	"XoZ+K8UDVGmFjZVPhykIloQiYUjnvgc8kUEjXFm7YWxyJShDe0hVPjMoENeZ7vMZiNMk4gyliYqNxopr",
+	"87Sb0lhywfJq5NbaAE81XrjGXBQdxf5uTWNKmXIO1IuSAuS7oNcfDB0g03tSljQaoRMni1pRfEDhBU8W",
+	"hVClg3CCJlOEJmF3ND0IR0MYjgeD/XA4GU4mKDwc9Pr9A7g/7I1HPTicROgARdFov9vvTg9H467iBq+t",
+	"V8b+MOfVuD9MV+llYW5T3byO0VEWXikm5tDHdDo6hFHUC/pjGAXD0WAYTA6mh8F4eDCZhmg/gpOhX6m6",
+	"LlGd/WoAaIMcce2WTkBXySA00HPOSIMj2Kx+cLrdCrKcLtudv52Bm0RxTgqbaqAsnyWfw/5oH9hGBQtY",
+	"7jIPR4PpdDw42O/D7n4UTaYH/X44RBM0jqJofz86nO4PoggiOD6Yjvq9cIDCsN+N4EE4PpDA7AUpulgy",
+	"xNclJUwb2LVphz3XSGcRJKEEtdqt2V942Wq3/uKigHHVL+vfSymfhwYNTcBMuzZQNu4pJibO5+UzMBgM",
+	"xm3AkTIvg1Fnv9Nq3//jy9wx89NPB/uHg+FUxjCP94Nh2O0Fky4aBt1JJDHM/iTsj9bn1MlP+FLKfPqj",
+	"Ww46dZN64OXX3yVxrG80n35HkvcLtBSlNDzN/eeq3ZXT2vfgOZV+poSKJ2AOLxXjPEEgIfjPYhK2t2+k",
+	"7RzFYHU2u/zvg7/8bsp/VYXd5HJQ6dPAxDkilXeq08o7D+4PvSYO/eq+bDabpPWqX1unNrWvWf6w9Uq2",
+	"MOas8WTOPxvHi1lXLLmCui6L9MZWMB6oCj6CmqoInWaOy1EdfqFTJaMYbdQ3wTBmlfeLYSouRb0FoCwm",
+	"eIoRy082Qt3+OIymwXCKUDDsR/1g3BvvB3A6iaaTaDKODqfNCte0y7X7Lbk0LysP+XmK5ZJre8c5aCtQ",
+	"ceeEDRg7lLsZW/nwKPiO16zmNe8BM2/MShYB3AHBrBLNxmKf7goiGiaLAtiVC2hl7v8b1BZPu332yGWl",
+	"H1J3voJGQv4MFohzWND5F7+U4OQVogtkrEbFI3iFqDqFmWnTAS8pSwmJ8iaX6nHwgWIi0tRaNpC3DaiY",
+	"I3aFuam+wxDU1MdEC7scS1b6TeknkHJYb6LLckTs1tHfTvCdE78hl1eLONXXdm48CUOp3b0uP12j/O4Z",
+	"Zcg6HqCDw/4gDIPhcAqDYXcQBRKjBdEoRMND2O320XAjtP9ZeYFqw3x55dpBoI7N1K3sY7Zm/lyStGEj",
+	"tkapdW4gmW5st5j5HSVKDjswS+zbBgmJEU89fJus6wKt/GeYeVrIWZSnhWuf1j4ZkTVhmttwn6yjDv85",
+	"Cz36SVuAfz6c9sJuuI+C7nQfBcPJEAVjOJwE/WkPjaJ+OIYH3gwxd5zh28Kb45yERVznw3AEsg22gfO5",
+	"12+tZzgb8n6b2CZsekl5s3b5fu+d9NJ8fJIBePcRrnEUsg5i5WkKiYR0s9IO7RTrHQCg9d9s9Ca0004I",
+	"dQ0115Zc/1obeRoUziXvbPBNfQk6t2/9tybvSvP/bZr4q03hG9j3LbC5dv7C+/bFqaa+YJgD/Qg0aZf5",
+	"kpRJTZMUpRsIYwSZMT6oRqbDZGXzmeS8ZYxSP28IUF2aKf/fOLlFSvYRqstmSk71t1en4HBYsqvHUGCR",
+	"RHlsNhp3Bv3xwJsq1CaCGbtZYIKxL3OozBpVHrx32OnuH+6vHbx3mBu9d1gevnDp2VztbE/ydp3ctsXj",
+	"sXlaGcRc+R+kSMK629gkmOV4UJPH25rkFLM4kaFflIEJkkE9uXStChqy5J1ZhVLINchDku+QS/ppUyhY",
+	"905rwtJ2F+sVbNK9Rlpxk8WyYl5KI5ulnvX5WtwomW9TfKx0J3rt6vyjO+GY7tp7st7//kZpiHXnG7CY",
+	"1f7/U8xM7qSSz7kJqFWCrhYqwRzFkQ14VjeX5fpt+eoW5XMbr88+fBcZh2sTDt9rkuE7Siu8afrf7UKs",
+	"byGI2icajidDuD+JULAf9qJgOJiOAjiEo2AEu3AwHYR92POOlPrnVzIRVVC6RUqTbRIm1+dLrlGb5NWc",
+	"zqUZGHWhIPfS2i13EWbtDg5yXlaO5/c7KWXOzwZVZNyTDVvIENQa76QSkXDLGMk8J25isUuTtkwzA3KZ",
+	"Su0V0qToNayblsCjjEhz02FJdUWrXXJ70RvRpF9dqSToUYTSGnS5RBapl7+TdtBUXdOdicsb5FGp4g95",
+	"Kpfn+QzMwbnabMGnOl23xXBF65v5XHUe/zbILT1oyau02i3FqqhwkqLnqW1RGvEdulJoSWafqtATaAsY",
+	"QyJhJGWVCTjW3JUbrFxkPcp4Ao77veH4sBv0w8NxMOyjYQC7h1Fw0Ns/HMPp4f5k/6Bpyft2qyKF+K1n",
+	"Bq/Qsfd7QXc/6PbOelK1ftTt/s+tqNf9nMzNfcMfQH7zBpRku8Tf64NBNJ41gNAoEKQaHDzUQ4isPrvJ",
+	"Zd42uglK3JykEVq6zpNyXR1wLqsRnbfBOU+U9twk6xDoWv7JEHhF03xoPEuUmoaXuB7cbcARkkYAvqew",
+	"+5e8e/ci0kLGEnJ+RVmki12Y0GE1rfz3x5M3ei/nPIbhxbndk1oMQYojMsjgJ4kITNlIWxtTzNHCFNdx",
+	"vEW0stEnn8jtexBPmgHO+EpqGFK4egFxrGo4dfwuLcqe88Wf287Ehaf57dyxK4e0ehWXM4ynwZxyAXQQ",
+	"jVYZ/P9Ng05IF+p379t3wrv9Zp6/ayKNWy+uBYPgl7OzD+nl5bfSaRVNMu3WFcMCvSfxSvNHciGU+7Kr",
+	"vT37kObXyMX9LcSy4+zQt7cFEnPqMSirteqPhaW2wfmH96dnOkbpw8ezfCmMlvzmm8iCcPm0fDu1BX89",
+	"O5Wf2qA/cjxwO+ADZQIM90fy2XKAFzJGEgtw9uY0t7rR4YHX7qgfcj1Qa0g2zb2QJ7FA/TjhHAprOutU",
+	"x+I4fr90mQPWzSrQJcwjzBmsUXirxiZDVTW19Hdn1cZ5XqbcEhmPoXQrGvvkwcGm6dYpvp0d7KWq7jKN",
+	"4YhV5sppt6qomsNfZQRM3Vir3VJry7NYa6icO8VzFGPJbN5Q972NJQgKSUcK4RnVIvQNa3ncXN0T6aO6",
+	"icYEO9bFmmO1TdsK+nKEU0Ks/cybTKuitJE1gZc+E3QtvpjbaH4cS7iSpf02cw84ywCGMmePqVY0d3uS",
+	"mzdFOFBUoiGSyfLm4i+dZW5Q2WcbcG1mz/O9Lce2pzezZR2RzSKGUshnOm28la8dm7RdTnaZjkEtfZ4F",
+	"AMmBk8uzZu/jcwWSKccwZMERXC8pqzHgUPqKCF5foaAHVv/noeuU76Tm0D0nRfmxygP5hNBueAD7cNgP",
+	"xoewHwyjLgrGUS8K9uFgBLthfzqChxsKoQ5htNso6urc7CLFmMJcFOJG4utZhgI97v26Jp2QrFiK+Avv",
+	"8yfHlpZpe9q6eL9ZshkoAib0RyM1+2te+5W6FJSzqTSsbJTVXLrVUkqmzXdeSKkBZ1dRWSnVTe/972a6",
+	"mlGIRtEgjILpdDwOhoNhP4C9MQqm0aQ3GR12R72Dxs/EOR9nc+nht+3lOqv/rEFB1fNY4xRomqwxm+dd",
+	"80pxtgRBBpjV8fyeGqfbwNqmP6fGeRM+p5ODMJUiQetBVM1dTPVAOaHm999/V1b0tjLUf26rfx16/jX8",
+	"3M61/OxKbeU/GtiQsjL5/ariAHX/zh6FvYrPWzowOoW2dvWzdvWz7qd+1q6KVV0VKx/iHx5EEO6jSTCJ",
+	"emEwHEcoGB8c9oMeGg/7fdjv7k9HG/JHBinY9Agp4Laz5+88Hyd8wzzh52x1kniCNywqqChzghdIhUK5",
+	"SKKtfXTmkET2iswTs/LtFWUXiAGVcA3gTHGVi1vre4MSkN8x3hZGYgkBEY504HsShig/aOtEzrlAQLnL",
+	"H6lmEp4kR3gEEmJilny3SBMR0kWt74o5zpOEvDcddN9lUlFDW38DUukqELFeiumBzRlNZtpScR7T2TlI",
+	"AbKcrKk3zkxp4PW7l+9t7P8n4meBUoJRKLJmJi/FqGwHt3UxKiGNnEAVTVoYzJeo8IGChiL/mPpbNph7",
+	"qLkthaPJYdibDoOxlFKG8OAggN1wGPQn0Wjag8PpYdSvfYrZwE78illeBjkWeFN4aGevy3mJ/0Un3po6",
+	"qe5xnTu/YkLVZv+gExVioTIYy7eorfcQpOvKnppXfVlptC1AWmOjbQELDnq9RoEFeWWg/9lr/zl9Rmvf",
+	"v29dMYIcrc06qqaQrbRhz/Iu8oh1FgvezjFG2PjGQrxwMpOuOcZB9THW6vlkVHFT8LDtXFdmuQssNYsw",
+	"qn1yDxNlCCqvpBDbVl48S8gXdUuVuWX/TFCCovREkno02xj4LUGO1oCYnFfln08yb/WUe5y6KKxtKkKm",
+	"o8q+Wy/2llTJGf7aWIGcUU1XfZxFgazdV6fX3+AiGlAN+77Nndw+vTBCuodoZBpun14799RdgM4jMYf2",
+	"VKi+syiWDC7zJKis+taPQ09rcvlFOmGFQh05bjhtW9Y9pTf90HjN+iQJSBLRm4Fj7VO7IX/bgO72usNB",
+	"I8L7B52UF3Jm8JTBRywhKkVReuE15L3+kHd89jfks4f+1Blqc2sIl4TKK5UsI0s1Zmipm1+zDCQ3o6k3",
+	"IS7bCg9tk6xT78tuPt1cGudEaDqMDIW+hFjD/T3SkpQI5IgK03hf4zJHAlkrp0hU8DmHvd9nz3QNgVAo",
+	"inOjSzKFKk3a9rzJNG24hmI4phg7p6PnyBxIFpAksJCSx2lYmiEtnP1N6mF/C1/Wm9TgvlFx7drCuzdI",
+	"UFpOCKDrNe/Z+s2Nct2UlHVRfzQ4HA/HwbiLxsGw1z8IDvujXnCwP4RDeDDs74ebZmuxWjqryXfzWxiD",
+	"pQNNny2IvkS62KvHWOOk/LF2G9u6aK2ZOYkg1l1HmjCizEJseSbFldQCg89MYrfV1EyirWN2L7klFA/2",
+	"GY1jlNpA82ud6ibNjee5G2tg/imvoekO06Wl+zlB0rJ2iXy2oKWY6z9yib5SDUGMLlHMsyhLXX4jzV2H",
+	"ZD37Wt0RJnPEsPiylIZmb4TVB/PFWkVM7n4DxjGnqqbmyjLx4RzHOcZhCmOOfLF2wuLyRsB1iaGfBYDR",
+	"HzDMVkQJ0iej45dtFcba07kd5CEMkpWrbZsr9Bxyev2nTYw70gNY/yUXjS+bp3fN5sjyHfjqEHzDkgK3",
+	"WgygOc1iCJoypdnsz5HMhY1Vxi0UVXsXN52lABtuWL+ZPj2tVu7c8uBxxiDRJncPrtt447e0haol8vIa",
+	"Rf6jz2tTF4dTtNaGmOf8BuxG3VchaAb6X9tpi8J3+2C+Nk387D33xkbSszRnkvyurcjZ9lNMmQN6y/Ps",
+	"aR6oaVYk91jT27D+8pWF4e71WUY62deGlNjuweaZ3bAWT32R5s2XktU83wwUbOyNGix1KMtIto12yp32",
+	"jOFoj6/dj8dBaTJEfTgMg8FkPA2Gk9FBMI76vWAwiLr7qB/uw950Ozt1LpA3vdGSI17uvI4lnJ8groJX",
+	"S4yNGeOLz4N1TVrQC7xc+ksnWocl90j12WvFQFrWGOs8607F1tRNLkM2zcB9ozgSncFzHTNnNpHbgVJH",
+	"qHrlkcYZDU4pu60Nj9fp6Mvp1DApoNmodx3eOdplcMiuugRX1amnsyqfxbqcWMgM8KaBm0O4XGn91a98",
+	"8uqQv/4lugwX1xev//Pzz+6pm8KMtYKp7fD3naUnLuO/NOSzRrzfVFYv3G8+h2npfs5yeDZ/RfLJsS8T",
+	"W3A4XXow6m6V46B8Bopgy5EQU48IMStW1xwKx18SgguFMp7l+/VuxbUqWS69h9Db6hD8l2N3k90OQ17O",
+	"H8cRQyS3gXqKKMfybGxjUr+Gum8mGG5D29eQ8wqBrpIK2lPMTnu1RFl60/yZ83tJe2rrS9SF85h2jbKc",
+	"NmZ39RYN3eK3xOuac1NnvAbF3I5e16OCzWGuG+CqdZjnNhw3c9nHGyQLX95wXxuFjVVnqy4grvyJ59dZ",
+	"qXVNweIXBGMx9wDHXSb4uoWUV8r+zxFaY1O33IsktVwAm87mBvOlWYluhQC/IIjNVl5zZ7Noyrm6OxVH",
+	"aTnfZqVSvHXDUqOnmwAPEaEif+TvVrm/vasNak5qNFxmepi5N41Z6UiMVnNa3EiTFX6zxFlFLO4STytd",
+	"GsJq70DfofsMciBaijXNXpzv+Z944e0UCQ7mqmicUF5kLmBgmXJkiUKhBUOGdL4E6YsuPbhkNxUOgQXX",
+	"Lw8s4EqCkHH/ggukI2KYcQIrJ15kOFIun5SnRb0kNmvLf2SSqo6oMWWeLSH9Lmoy12YVNOf7pXmmvHyh",
+	"bJhPZ2VSpi0oz/FDY2/2snp/lmkMhT/6xq4qXU35fkvAULm8w/3hlgv02HWRQIwbYDW5vXojsMBEUcZb",
+	"TeEHZ/m5F0j4YWRrtNPY5LuRGstFPrqacxkOnctfl2KuPh7Vj+89ZuAC6uECxiitJWkLAup7NTmQTHK2",
+	"FEnZxRscZdYf2UEslrJj2bhVWxPAdkhDyDrgtfL9MdX8UucYhxqraBxsMiyYZZo0SXqh+fjXrC6gcvZQ",
+	"e3SPOp8o2JtQ5IxeIFKpzS6ZrbpBdxB0x9JsNTw8GnQ73cFow3ogXucJjZM5ChkSQKg1NVLL9g6G3WkP",
+	"DYOoH+4Hw/FwEIzHB/vBeDrtdRGcjLuT/qbh1Wbrn+3pyAx+p2plTWoiNN4MT4fMOuvfAtWn839lBcHh",
+	"X3D/1V/PITwbDqJl/Kd7zGnmom91VGYL6qS4VDhUWUHL4dQmarrdknRbpVnKQ2v6vcL++cVXbOi1rTLE",
+	"VQZulXJxSTERabYQ+bDsjI7vo6QnQO4T5UnJqHvYLDLDMfOuqQuVZTBvXBbq5jbhG9XYqc+8rDJw1sRa",
+	"uBeRGWQbBd2s35I9VO18AGMAEzGnrA3kuk1ggL15Vsocf1tnwJBOTVdbdTC7f2V7SIjxGt9OPlJYYl0t",
+	"NCvUyHY6CFRQsIAXlXn7h+EQjmVulEGIBsEwHE6DQziYBKOwj4aT3nQEB43kRcHXHYVZ1iOFJh87wHHL",
+	"j0LwL6bqc81KlEbCXUaKEiAHghs4kkG2MXLaNV/JZYNSCpCkiAk1g99BpzfcVIWdIU8nDYK0neiEZ/rE",
+	"7KsulZvyuFLkwNB5CpomvF5ItuUZjZOFj8lIf6+utajbtG02EINTlPOu8o8SoJe9rWenvxp2zGQzbOVD",
+	"4V2TxU25ao8sf9g77O33B2EA0eQwGEI0CA4hHAUH/W40HnYPe2N/GkeroSxVzkvNaUZCw8Q5kw74Vf+q",
+	"IsEpuURMZGmJE6d3tty8PS5Cs5f1VTP0JeXOx73ctDxX1d02vgBbsSvXBxNVJdpe/KdWv9ceffIGdzB6",
+	"5c+5YTvjFEraeQjKB8BsUapNJWqx63dP5y1cLr0e1fp8NjCp5N9SLuNGz2MuQiFewPgLR0vIYFrnwqa7",
+	"7pQzXavqY+C8o9Nnts87VXn0FligwnDt0nAvMYojkDZvZ/gznEMGQ4GYdwLzbN3Rq3LRmcQoStuUvn7J",
+	"mHLnkfCOtwyBgmUBF8svLg6y20m/ttprMFPa6uY4yo+L1Prcur12fWwaSrJYWt17ohZ3br7LTMAJwdfn",
+	"4JHNWWJ++bLg5+BRPpuJojUyQbAkjDFc0USkVd7P+12Vnjro9kFvdNQdHnVHJhG5d+l/UVJI5/7x7Flp",
+	"uUo38RfVi053zNP0v5IiTqclL4IXiXxIe6eChhdzGi8aYrA81qrynVHhHhVudBrget2uLVwvORLTob3Z",
+	"K9ZI02NhwoQjf4RR5sqSEgPdNKUDNBEcRyl8KnuOrbmf4m5FLYz/R8MqvZpxb7Aik/WqwZCMXq0dUDFZ",
+	"slHjMcsYmbfSpTvn2rZXrKFBwwH3O1FtgJxP6NW6fHD3x0345XW1G7NjelXe7XqGfauK6Vtzxsdk5SnR",
+	"qpnd9cyt4WPlPpV6vbRNlRmsOc3VdTAbuUduXQlzhMLJYTQJg/HkYBoMEZTxjZN+cBD2D/dROD6IDvc3",
+	"1MqYXcpj+A2hiwiuXMXLghL5S7slEsT1X1coIvZvMU+Y+XPKsP6DS5bN/Jmo3oWANTtiaZEchYnU9Crn",
+	"Cn0HTyHH4XGiLcjqpBVtlr9mQ8yFWOqcyphMqXVAgzp1nj791iss5skELHUSH5VJOk3qPFPfVDZnbvOr",
+	"Z3+V06D/61/gNxTLKL40nEH6T0jHNusQYmo4atz67v3zYyAzt8vhVMKlT+QTkUTi+MNryYxzzHUp0UMQ",
+	"QoFmVD7vI9koUKYfLv9Q8KX+sjEg8m/tqaj+ShGD/Jdx6NHtTaow+fexrjH36Ozp88dyghfKSCFT+QAD",
+	"IxysaGJq00sFCSICh1Brkz+Rf/3rX+A4+xVTovZCc03VCJJ0zKjyRqGAIJXeUde6B+dQBS3K2p/nSm5F",
+	"MJyD84guICbnqvcV5nPZUbdMDyxto6xxJsXduc20fQ6WkClBRnIDKskbW+l89SkgWcWdlttzK7HDWU3p",
+	"ebrjU5NWkkbydI/jWFNyO5bkQtQB8iUlpkSC4lSSVKqaUunlKk+DO2OZOx52u+ApjOxwHf1bD3wkWkOF",
+	"/0KR+XGoEpZPJbU2v4yBrBAR49D064/BGZWaG7JK16e+jLpd8JoIeVSxSbSvt6G2+dZtr+xnKppo6z31",
+	"u11wmtjbk//u2X+DANhcimkNF91k6GtinFPbrlaWyJVJ7/0oUY9wimOBVIZDOdDAHNNbGkn9buSOdgX5",
+	"njXCqBQ/C9NIc6gSMxOOXMzx4U0w6HQDSuJVCXXQJSJ6YJV0zvTme6aTU+a1lWKBwKIBqUxBTMedt7qd",
+	"nm4vh4RL3DpqDTrdTldFHIm5woZ7l32dbz4jTTPk9aIRCZMW9RhzkRYrBqaXmkIbmV5HSvoikUILr+x3",
+	"KQUqeyJX0R4+qpc12VNS2wf5bxX5UdNaM+mm+WelA1pSeWZyG/1ut+A/rCxdGtHs/WFihDK/veYZgSuo",
+	"89cScjcAKJsOu92qsdNV7z2F0Yl+NbpLr76L+6x1p0F9p5eUTXAUISWRDvvj+h5nlMpHbVandjRqsiOL",
+	"I3QVOiN8OORZwYRDmH9XCWyPXMD8LG9We8z9bosBf1bCga/yx3EkaQNBVy6clsD0OHKgtKVZGsTFU1PG",
+	"xb8n2wQjvmdLTqWgUAC93kag1xTiyhD2zJgMdxDWHMI0mWgAY1/bRSy597dkeL9qsFNmwrLwon4HcC38",
+	"6UbZ1T5dfUy12C4YDev3r4d66BDQZCfvqHipPEEfHsjoyz7yQEIVdlpPSx3YkKKssZGuI6VVENK9J0Tz",
+	"fZCy7xzMirSvDsg2463kWBmv5M3R9FGXQVuPvHQjL2huSEWLI3kIaYML/WgCznageYegqQWn5sDp0s5q",
+	"4eKNlCmUj5uSuTTY8U4J5FJcuLlEkZMR2rcsgFhJ8KVafuNuiFxu2uMSEbFhH+2asmEnLX1v2sm4Vb5B",
+	"W3Z8tW3HDbtJMN14JlXFZNNeOEYkRFGu2+e7pt4+wn0shdNUbuc7wVLjp8+qkPMCspVUyyDhoJ7GsmZT",
+	"7KWFtGMT3rC1rHmnYma+hnK1pNk2uTulps66emWxhDuBsgRb+txcnUSnVUcvG4uZPGXVJpBLbaa29MuL",
+	"6awTOn94efPhio95vimDk1RvwJcolHWr1gBLM/HSCJZruKl7Eiq9ZMmW43KRxw6cNqVkFcCkCFozSLoz",
+	"GZLkwXCdGHkrEmSV8FiAO7Wm5McUGbuj8oZ/dTyGrkO0tFmvHrB8WQXVqXaiAWD76OkeDGXUUowiEyly",
+	"E9D31x1HIg0/Trg1Hi+RfQvKCJ2uoYyZj7OPW7OLquNxaO95p0958PqUKnh3oCFFp82BfY65oDrD7Dpu",
+	"IQvIsPBqSi3bAFfAkErCqZwQ1zATv5j5fkiTr9ncC2Jy8f4Ylt8fRl1e9YKUhF4B4bfOD3lfIZ+j+PKO",
+	"qM2HRKT0hTKXskSWuSZgTuNI1kHUC7EfdGxvVjeJRABzNZKtlQSgkC7bAsfnqrjEDF8iUn7+p2rYHbH6",
+	"xxMrDQhb0KmEfBu2zPNcJjC8kDyafAdlUP/oLnQH8f94iM/Bw1aAf5fEwQPzBRpQB+18h9p3gL5yQKER",
+	"jLvpIhvZfG2HzidybP8hmRFIjG5QpUNRpTNtghMuKIMzFW+ks6PaUtlyWL6AcYwYkAekChnLMQM1g/Rw",
+	"52o4NoWh8vl+8oRQ8eTJ2jliyGbILIbb0LSf1PtJlrwNFjCcY1WcAGrDyIJGKOZtgBdwhngbXOII0SCM",
+	"8ZIDJMIOeKNGnOIYcfAkhOQJmOgZ5ePkOhsV1B7kHP+FQESR9jKWKpxIR9HDCadxIhBYwGu8SBa6pZKm",
+	"wCO8WFJTe/kD5WLG0Ol/3jyWm3nSe/X0SQf8Qq8kCyhrhYOIAhhJI6fm/Lhw6jrLqAIhT+0KruySVH5y",
+	"k01fH3nxrPTOpOP3HBq4iS4Rk0e+WMJQMaZLxFSkDpHzkshU/1omQjtPl6XM51luzgfkAlAyKd+LUFqZ",
+	"Y7Usir4xftvpw9yp2jcUMdOT82jZU+zloESnfb2bsjtAyUnZAfltzMYOlNyZ4TidY+ecfIu25CqQk3Dj",
+	"ZDj3QFyBDG9kSjadmhuTzeXvzMnfwpxcvOJag/J6wKkzKqfAsc6sXAMQ3ftAOxkXubMt34zgNbMu14HV",
+	"3XkpF0CywsRchsmtjMzVxHToDWFXK9sZmh+owFsD4mVT8zZUdw9yjhaT2KfiwfLM0jwsJj70mUaNwdvn",
+	"o5YbaK8z0WSYcQGv02D/fj72v++Jhjez/ZkgtsomW0Im3tl0B2vmwkSKl95keVVDJ8uYwuh1tHZgzzK3",
+	"0nsVuGZz5OYFfoBM8Kerf6NVkRoNN6RG+YQO3pRRL4jAYnVG6anUQdQmT8jSNn39WpFR55Fp8/inTwSA",
+	"ADzJT/HkCHxUR60SzRrFh5ibasnm5tKiSEadIvUEHfBChsmrEPdFIpNSI2nwiRHkAozA26cAE9WwbR5z",
+	"qhdRKetkv45Z0WudJ0se9JMjoNbNwIKyNB2MeUIoUt1kZHcSRyZmOo0+Lw71nkWIPTlSabdt5LHufmUC",
+	"rqWJiodI15ynsrlO0q1bqT52Z9kKMNFNJclQm9cJPTqfNKra6QxvD4Xah6iTwikotSDQAWdPn2+GSSM8",
+	"nVaqFF8ZXbdspAH1iqZ1oWwxbt4B74ms0YeuRfoxhEQCiDwYyHReojw6eYWEwSQndpzncim1rK2cZm8Z",
+	"Q0x+UpnHOBI/J2IaHOaxSlaCSiWY8OCMnW/BQ+GDNRDm4p1uh9Ftl4UuDWvguNX2EliGLr/AtdS1YkCD",
+	"70CWBfRR0HsMGFoyxOUS1UP65cXxc5VVTv6DoCvEs3fU0cmGNE8QVDAFFbM/XbOdyUPdzucqlGQLNtWh",
+	"pTSRDJBcRRvIYnBqNXQRpzIMR7FO/j5ZAagLQ6kcYoi1AV3qglLxKp9uExKqcifSqSk9obGJxXQQCJmf",
+	"1SI5HbihLQxyap+ePUN3z7NyVDcS4usRXDs3glzZzUaQx3tjJPv+3zv8ev/41cJcgZU4QVCqy7N3xAVL",
+	"QpEwlGpDW3eOk49zjxI8kvnn9sfd3mObuMsuT/5tdwIENe9O46irOY2dryq/HVosxSqfaXKPI8Ip43vd",
+	"PaeQhVeI08tp1QhWnpyS6k1k68svG5v1mlb52pq2foQARiD2LUz3zK3LJoIzdTJNgUxbGrO46iq0i1Ue",
+	"Szme37SigMXmO/UXB1X2RhhFhRo08l8LuFyi6BMxqTvl+Zy5hWkwyRLKKjuozmXdATr5mUwKi/knYvJ0",
+	"oki7zf6kj0ylxaJXYAqxNA9L7C2FF2mTXphyINoSij4RnSxSZT5LEbdKnGUcOob9vsq1dWIzrZ1r08G5",
+	"SXNmoVJuWW5OV5DR28tt6ROJ8QUCUJ2XNNxmzLqg4FxegeB6fec+kqHzihqq8ZoImssqeZP3V9M4YquT",
+	"hLhW180tZDYtqkerd3u66kLqVw+1eS5BJyGAmRbtWzXR1c//2oDfD0fz+vd4iK7qQ2WQlc8cRU7O3g54",
+	"Z568VGLYN9/5XswANiv/kcUHRTqtT8igktSVh+RxqI9SF3K8V2B+pTuo8SuKY4vJ8yqHEtqSzX06Qp+a",
+	"tkDaLlTDu1Ns+tSUOxb0m5i6FFDV6qwq7VRKNYtSTavDhUiHNMudeJwvVU8DnsYosA5Ad3aE23pu/Qdt",
+	"FZCYShfwMchtJ6Q+BFthw2e+udabwata7RKDV6mYM2V04Qo6WoizX1UxQobgQiVTJ9Enom0lqmBppiVS",
+	"x2qlDTnYiaobpXFMBzyjiyVDnGspKRtZ/j/k1os1rTpxHIZoKYIXJKTKUqOH0TYpLisOfiJS5olQmI4L",
+	"lFbrCnNUoaI6gVe36miyqYaJhgKJQJ/lt9Z2XX8LdVlbmzdCfrllzzsxjLz/d8vWcVFgYEBPgW+lR61p",
+	"v5dv/LWd0lALuXUDlNp/bbdeCFjbT7X52m69gVwENst0Xad846/t1q+Qreo6qTbq3Prd/d0D+ec9EMk+",
+	"yMoC9t5zr8XC74ktpNkE2HXjBwzpg4YsiDvDj8RQ9fbrO6hLfEfFKRSYT7Gq6fd9imvP6RVRzJhp50D6",
+	"rVsHahrj6TtK0FsowvkGfSwYnmISosb9VIHGDWY5cdpXqdlTt4m1DKiu9WFaZpUTU6U7dP3maj0rWvcY",
+	"OmMn3WVzeMgWwQwK78Dtohb09/62f37ZRhorGp2kuKOkdyh1pK4DwhoJJ4XTjd7GTRiwWr66Oa3XvObQ",
+	"X9XOHoAtSmi3sntl3+yV5QG+KXXNw/PdW+JdnUYKRDhX2HtojNKy3E3OzchurpmqsruBO1Al1qBxLEOF",
+	"bxTm/9DOoF1pfFf6n0o2QGuoHOQHNCYwWiNHnwSjSLsq6TDJtINHI3RiTthgzDNajTN3aQcevma1uXrU",
+	"dQ/0xzaeoAW91OCovHdUOUBooTDzHVoT3Ki77GqzPPDaLBWwUc02rmXjXGAp4DKXpVMBBlyJzas1Ak4V",
+	"CN16OKSZaCfCPCDmqhYQbz0+8lgIaYuCeSima/2P26oGIgxV2XCyAktJcGnCgV6+MSQljKmoCQ2yOm+c",
+	"dizTCL2gAWjnHoj6YobzUPFT33PZOueBfQk7DuC74gDqXksFI6DN4XwdJ/AMkhClqm9jQK/Ja6AtuxWO",
+	"Fxsx77fhRvR5x4J8VyxIqRKEFwLXuxV5hazXBEszjkz3BGsBOmtcAGpjOL8J9blFz5nU08cD+D6H1ewI",
+	"FkkssPK70GM4TrQbQ/wteIOsu5x6B5BGxXi5U41Xd/An4/hBa/A2Lr/7JndGuzQgm3KybnHUfPaPDOos",
+	"KKdtq5BWrg6Oqd2uOnkTX92kMO/d1+TdleO9/YxXfmDL8qSlsFKCuBzqbJDvKkrzXZk4HlOHtZj0CvBE",
+	"QgiKKpjEXeXe74c185Ss9GTKsljHg9TW5cbS8IPJlFaT4bvPiFWJlI71vr6PbFg/ggZoLbC9UiExUwrg",
+	"hMr8/euA7u4yZ62r7HsrRX139Xy/C/XLWlBNoaUSRH2kd29JYxwasa6hFCPdiGw3ADmnIYY5F3Q/vErs",
+	"+sF0e0lZxjTetQiiJt0Vgvl+sK4SBS2oqBRBd4R4zYvAJMSRbFdXfilt2PYUWwLHWQMwg2KOmE5oopOu",
+	"u9VsDBNyNcfhHPA5NDb4LLgdCw4u0IpXZdd+na74HvUFJbcGlbckPRP7/jE3Ue8VuQbSj804JbvVU93t",
+	"a+0ylFUDc/ekK1Ziv22ixb0D/GR3+ONgqAeIcLI3vrYEftrMEU3XZtp13nxVYlP3wd69ZJFB046+PQBw",
+	"8zrL3GYFs+JM37JuZvoUCqUzwWvBLRm8QGgpu2NmBlhbWDOF5l1Nmx9btmn4YCqBXjdoUD4zx43ZcVSq",
+	"IPlV5xsVc7QCV4gh7di4FqMf65l/yEKaO5lpR1PU8wpjyu+ImjyTQ+ffok6rJX82opB5s1pektISoQKo",
+	"NXls6GrAHd3Y0Y0mdGODussCL1CMdabvHLgWay/r8rVaK7CWdPyoRZgL+9vVYf4Hk5I/6KQRWybbWYfU",
+	"JaMzBhfgzwQlqG0S2QvEM02bbC7jTlQLk78EAkYTIR8o5iBKkKYjmIMwhniBIh1HIAdTvVQyzYRIGzIE",
+	"V5Rd6MTyXq21Xs9/ya18c32bOqi7UbVl+6xRtmWXhbm9rYolZF+/sZYt29xOz3aHmEW99xw2MRDgUGHZ",
+	"Zu/vP+jkSxMNm3rrPsxQ81afrl7fqaLNBagdLfu2EJdBUxXolfB2OUJT0ZRcUOag1/OGZerJ1vqDp5Hb",
+	"mIj9Yau9PqPeZ9/L0GJJ4pO7Htbiq0S7/yg6C0GEYKQWqCrWtjX1Msk/lXgnBFosPWk/T/QBOC9tJ449",
+	"bHGs2WP0Q3tCGnGKsp0p0EAnbUCJSnyuM/oZSPLxjGe6a9rTaBMUC4Xj2LKSWNcrEnPEEEiIwHHKHspv",
+	"c6jqThuOtZ5hPEnuKVGKmfIk2eVIebjUScP4902iFpRgQRmv59usv5DI0kmDtLfv2bzNPn5jIUurdPRq",
+	"UkEnnxbbJ+wIN5n+N5Z3zGHuhJ07fNwpNFeqT2rqiZsBdLyvyMFXKcrCXuh2cRYOONxZpEU6x/caa/Gd",
+	"ExwTnFEDlQU83rjmeB5CLeg6tn3JH8VoanNnYV4VsGvgZBeN8V1EYxQBpQrXrWcGPKCz1mGqBka694G0",
+	"dlzyfRPSuzNH1MVYeOCzIuKiDJlbxVxUE+SdSuNBqTSawaahqoQKWYBcwQzfk5ZgguINZCW3P0i7+xDk",
+	"O6fls6zhj2VJ9mxyJ9DcIR7Oge/WUo0PiNPiFlOsaug5NfdMqdcLTCL5BGwHj27tOPKB/ZYSUQVs3Zl0",
+	"5J1vF5V+i4JPE+hdi6c3kYV8QN4GMKZkpg0c0rLBkhhxoEumxFjl3orpzAfaelwPjOykpO9CSsoD1I1E",
+	"JR9krZWVNoCa7n2js50M9U1o97cTpHzQWyFJrYfbraSqZkR9J2E9KAlrA8j1km9DW+uj2HU6WIcSF0Uu",
+	"f2DvS10aNpvGFnpmGEXGSKzLUV9L+xkgqr6iHNt6NkiOl0kLNYqqbMYu5D7PNvTNTWHOrlWRam0g9z1x",
+	"n0Us+7pBxfPivLmI3oqJ7LcbTHM3TpWee13VuFd6to6zeAbfmpzP39js6NvuTmT/xiK7F2sq2WRLzZTu",
+	"W4fGTkyrH1cnJXe4g+7vSyGlowRmCoY7QIdvgoUs/SV/1QmyQ0oirFobx2PZWFF9bsKbpXRvZTMVfqB1",
+	"EFEbUJbWU8UMcDkgFiv5swmY1vFBDfRbCrpurtyyQHovmi092U6t9RDUWgpLb63Tct9JA3WVvPidruof",
+	"ratSSLKpomodvHTvFTXtVFT/ZBWVF72VlUkFcL2xcqqCKO80U9+5ZmqLfIppl3UZEzcWo4qqnc2krkKh",
+	"JUaT5SP+WDK+UxwLxAAl65KqfZEnldeRpLJVQS1REp4+73JC/hCiWwrW67I7Ohkdnfb1Ep25P48AlX7Z",
+	"RmzKwOLOhCU7xU5EukURqQrWPADjAbcC6m4sMUkkXgGIuoH+uJOKvgupqHj9+TAtFzmtF4n0pa+Vg9bD",
+	"RfcecM1O5rlvMlgPVncn8FQgKf29BIxbyTaVlPOfK9F0R+Ur+VW7PUrBE12HaGmTjD1M8acp7FoCamNZ",
+	"N5B9bJc1YdT3LfvUtJb7f6mEoPu0Opmz2Eksd4mqs1jsygjtGrkkS3dUFkzST1tJJtn9351oYufYySa3",
+	"KZvUQVUBe25isKkCNyN+6K87+eP7kD8K91+NhLy09TkSEMc8rVRUBRoOYb0HAaQao+wkkPsma/WAdXcS",
+	"SBU0GuGhBI/bySCVNHJnVnlYckVDiPRTxr2QRqhSxnhlqhMoP15bA/8RxzOCosfgEjHuuPXIkcpZCl4h",
+	"8YxG6CWjC5dp2+HIfwyO1CB2R4jSK0LoQt9KhpBzg0danmDoEkuAfaxTxBhY6ayRLyTknpheZVTqQKxA",
+	"12JvGUNMfpIuaYwj8XMipsFhHnTTLFETTKAy9JUqfpeEBTPJXcoquW3uEs48CAmn0eOpwOkRnk5rcbps",
+	"pOOFr6h+JvZ9cB8S97wI/lzOU4vN7+5t7FD6t0LpKahoWLsD5N4u6zv1lOC4wlmCocsvcG2SvooBdYiR",
+	"DqpXZQPBo6D3GDC0ZIjLJar38suL4+fKIVn+g6ArpEKb9BAdN+Ff4Mv4V72dp2u2M3mo2/lcgXkyFLIO",
+	"/ch0tWlLl3009SkrKXMFHrqXpKB5IrlLC/odIKc7YTrrIH/vb/vnl6aaxxz17axXQNYA/k4P+ZD1kJVQ",
+	"ch8E9MwiWTtzIfvt0Jv71lnmWmKU0ovuNuQifxx7UsNwowpZD2rzFfq8Uzwjxcdfevuy0a29/J1a7pup",
+	"5TZ++VUvJiF3UzruJHGr/hB61QY0ERxHaeE4Ce6RivM7c6qHZIWDyjWATNUglbuHABW2LxJG3PL2NBEh",
+	"XZgyQlE7y/YTUxNTYIpJvrH/niMYWfbWDDulcUyvUCT9xF69OAMVByePPlGnni1Mpb1Ps9ebFAMoApSE",
+	"qO2Ol8s93gG/yXVGbPWFJaSdK6eC9W6hUGOY+nvyhfMkFu4h6M1KAxNDIWWRjA8zOfl98YwnCbmB1dvp",
+	"/fXu9a/P2Uolz6/kis316TNRVQQitlI7l8vrd/u3vaKK5RyHIZL3nStp4Nxm2wCmZMo04Kkjs8BYfkZy",
+	"mBR003hXkvPeT4lO/oUPDyII99EkmES9MBiOIxSMDw77QQ+Nh/0+7Hf3pyMNxr3ucODTj+zw+s3wuuzb",
+	"b3R8r6BAV3BVQwoqVHprCxdU4/0NC3p8sBBcLN+xzoptSmx84zQpahNZBhFDIyr0JNnXTRHCe9Pz67pl",
+	"cAGZJAgSnzMAp0KVNMZcJbmtzmpSyJ+SKjclbxCYrs2SqnhWMUFTylDtMhCJNl/E512BlZ0mpRGmugsl",
+	"isuk1adTSUsQfaj3zzlJyD0VsVvP+eyg+IeBYq+SQYkkOdWC5Nb82oWE3E1ZoeIBXKHJnNKLuxEcjwlA",
+	"JFpSLF1DzEyPjUg1pewKsogbCUhdf43x/cU1CpNU2/mbWbn/1e4e2EOxUlsIK4QMnj193lrH5XIcIxJu",
+	"kjlLF0ex/Royt6d2mm/J2b4mYZxEKF27eSJzeInkA0JRFRd3vVS4IW/5m8IkFq2jKYw5SjHBhNIYQXJf",
+	"XJxK92TOdhdLcYcELX0m2+bsyr2aDnhrE3TpIVSGTnypan/jGKk3ZdoqpVgo8KVJ2KV/jQAmXCCoUszT",
+	"JSL+TJzHkX15W8ZpFAHszhyg8hPtIjZukVLUwG6BEGwQr0GKUC25LwPQWGSgKuFWw6hU52pvh2sB+BVC",
+	"yypbqwGFXbDHd2FkLQLPVtm3CuC0NuS8Bjy694aXdizvfZPgb5ZoqwCfFcEfZcjcKvijhvDuTM0PytTc",
+	"DDYNpRVosVQCU3OZ60zxillHH0pUbc6cJj9WnuLc9nayzh0i2gzMcmAs5AU0E3by4OqTSvK3uZ1sUoKI",
+	"OxNOCjPtpJNblE7qoK2INDeJJ8/DYcctea6rWEEBORLcZrsGU0YXSkCxfZTscoGWorrIVQ44dvLKdyGv",
+	"lKCpCtOtJ895+ForsDSCku79Yayd3HLv5LQO1u4uXL2IB19cY66SoasPuv4PocKk8ndrAKWwLbWQEhr9",
+	"ekY9UxWMbyUA1VH3nQT0oCSghlBeQc33JGyt7sg6KofOA7O0f8YxQIVnYLxspWCxhldYYM5lJ8Ur2Lcl",
+	"X1BaMkNV39DKTgIXRi86kfenhxJQT5bO71brliPpM1X+vGqs3Or1cKevQUKwNsgR70jawzb9Ytff1q8d",
+	"XSJm33vHHoCcJuGIAV0VC0Dj1CuoWZLqyy/wcinVuiRSAqoyRjgetl5DhLyEMtN/H9RPTX2iVrYjhA+K",
+	"ELZTGQAvELcF4sxvFl5rEIn+d2NFimlfyaHdt4F60+R89V2gEAxPEoE27TiZ0OvGjQmCzUdmMMIJb9x8",
+	"huhL5XSzlXpphuj/2QJbvERQJAw9o3GMQp3G8mv71vRWO33VXeIVixO2U1apppU6qpvopu5eJ7XTRd2u",
+	"LmodJOUozkZJ1TWLWKUdcLRIP7z26GEqg3I3up0mSKy94pS9uCfFz47P/fb06BtrezrrdDS3opvZ6WQe",
+	"vE6mDIiZY7ABlxRYGtG7PUhCxAVljSLhlpDJregAETVR25QRtV+kCoFTQEkHvIDh3FBKzK1eQccAQ50u",
+	"i88pU27GEeZCLqTjRbTHdokvKbMs3GaPbQGvn6OlmN+7efsExVB6eu5Csh44Pi+Uf0pfhQPq3jd1CwFb",
+	"+fcYznEcMUSaPEej5IswQ6GIV2CCYnpVTS/kW3pmhnee0u4p7J7CmqdgAfIuX0KlTC+EJCKpTl7KXmo9",
+	"ajGEijliZklAt1XNHPW5bg1nEBOj8ebgHJM5Ylh8sRVCzjufyCfy5Mk7KtCTJ0fgzGrNF4nEBTGnYII8",
+	"unP5BtUEHfAMszCJIQMRWiISIRJa1b+jdq9w6Fdv8ozeWDuhxtkxcd81E5dCvAZcFde3IUdnH+ze3+qv",
+	"L7V6jRO0oJcamlPolcREXCGUOUVIzo6SNHONnaUD3iGcvUPJ6ul5ohs/Kl/CFrVUBegy+3UFCdv55nw/",
+	"AP8c5QDetcHeNpnxxhZL9U6aLlMtwQJVllIGdtHBZBQOgykah8EwHA2DQ9hFQQ/1o8F0OBmF+1HLG46c",
+	"vcC1IcmlhBHeh50a0Bobx57bHj5W0H68X1YwMwzu+MCHygfu5U21BYbQwg2AXFctqKNSty4jSSBBJIJE",
+	"NNJaeDhYq7ZIP92F3uJ5tsyd5mL3TO9HXHPexr3rLuaYC8pWTd4kF5LX0+5KJX2iro3CUIiISUHgf2Cn",
+	"cpBf9KRbv7DvIkxG7fSZOq3dg/y+HmQVpN/PkzR6+W20iXAipcK12sQPevSdMnH3GJo9hrIB6X6eAU8m",
+	"giFULzllug6p5HCJqVI+ykHaQNAZUiqPNOVuJtaovimLTKcAXSK2MuMar1o5Sgccu70wB1RmJsQ6o00E",
+	"8DRz3l3AFZAHDLDQPsDQzlDVLE2R665E7mjNYz7VZ/RQWNV6d2CGdoEwD/e925pI5und+5N3XJA3cyZ2",
+	"Xe79jj94gU7V553qZPcKGlA9lzrct85EA09D9f9/nb5/B05VF1M2yASaSWBbFyy7WiLdbWOqIWz/SrIx",
+	"rPLE3Gnv78+Z0oDRNr6UBcDikllR8H8JY5y5LIEszmFNvG0KaPz+hI1s0p3X/53j0RpA81d9QaIIZWUg",
+	"kw0yEEtzsBhZRIcIVoXR2kFSTwIbTrtyo/t8BstTJMoYcvvcGDlIvGP22plrB+W3bg5dC+c5Ci5sZqEG",
+	"BNxVNgGWxIhvRMeVmvGMQcKxMBWRdtT8B6TmYm0yoDpibn028ppNCWcoM515QS2l4x5Au09NujvzjqTf",
+	"NUlfD21rKXo1qJWJ9wlaxjBUGrgVWMqCYDTR9lupa5Nt0uJQRuKXCjODK/UEU4ZQvFpHx72AuyU194Hi",
+	"3dL08ow7YL91yi4a5L5qqpvirnIq67ReK/WjRrx/3mnZfhzKkB1z/qW4vzcI9V6jqj2O8m9iKzydg4a7",
+	"C/12ptnFf99m/HcTMCsh5Qax4FEaC84xmcUol/FnArlyGQPCOpjyRKfaqhK+UjjdpRv8PsSoEqysw2I1",
+	"keYu5KyLN68Fku49IaSdben+yWQTOLvDCPR0osow9LTFjWPR19HcXSzTw5J4/PBZDkrPwc9GVFg5ozdK",
+	"DsyUJE+nKjNeGkmSzZyPR3pNQISnU8RUaQpBFbGWERqXMEZEyCLVbe2Tcz5D4FPS7Q7Cn8F1+leMzlUO",
+	"TRPaB3RCOuPlYxdzjgnHETq3ESZXmET0qjr1sHTXUYFN2wtz+YCXmsZqlacCMrFZlxek+RwzxYqx9+zF",
+	"n437xIhzp8PnG/NDu0d9A+ZGP8FS4Fbx2TluDrJDp7UZQ/SfRDrKuS/WDC3VfWpA+YD/9a9/gVcaogBl",
+	"8sHCWHm7vUGcZ7+EcxRecNnhbI44Mv8GSFdCTGsOIwBnM4ZmSrtIF8tEqBdpy9cvECQciLkqPI9ACAmY",
+	"Ko2EZe51HxTZsuuXME4QmCRCmQ1NI0yWieBgRjVyELR6YrXFFN8gEKMjkMM+708KKEhu/Ty2HX4Gs2KP",
+	"XGMm05aKeR3WoonwoC3jergOs8lzWCJZ3MyvRlV3nF3wS8okxvv+cRzHHwkW94kS6zssGQoxx5Q07pGC",
+	"ZOMe8l3/RQm6Vw0dP6FXOxe4By2meCmG9AjdilxUawFlR6Dq5HLjUZexe+DY+Zy5aquxBVwsi5mWncZT",
+	"iONcgd02SEisCMwlYlcM6/zpHAmVu1mjeY37DTr0jYqz7gKRduqjTePIdMaqbiRlUZaB2cRU2XFz7KxP",
+	"5Snx6Rk9c20Fd4hbN8R76em5+GILrSyXu6zSyK6HcEeZ6nVGeEf1lWHCEZOUPUqUdKDpvskJqFJ/Q7Yy",
+	"bMWPhmG64wLWFuha7C1jiMlP0mDKOBI/J2IaHDbXKFlc8rXtCcLPqcBQTFXqcipfJ2ThXJVRdQIzuIpy",
+	"CKRs9j1ontehxBSHCQpyb3YzEbku8FJHhrq4UrK0qkzyBCFiM3ZI9tnBUApnKrYwW5ov+CXDX/J6ruYy",
+	"x31kE3lIbN8xfLjB/Y+4gEzVLEckemyqUKgLv7K4VPcDV5CrsdqKnS5hb/lDtisfQrR6U714iTVMuOj3",
+	"z3I++LhVddy7mNXvmVtznqLDhzRl3OrQ1d7feuwv8reERPRG5UK82V3M4nHk5nMZdQ97FdlazHLWJmuZ",
+	"qlT2raMWJmJ/2Gq3FpjgRbJoHXVTKMdEoBliumq8n309QfIQUAGJ8SpcPFmpHDkpOs0Ume+ljtK6RinM",
+	"a/C2HFkeq5XNH/WHYE4TpsxMptz9Y6XUmCDA9HL8xYBIRPNY1DzrnXr+gb7zjZgQeb0pcOU5kWYPnM8R",
+	"jMW8kv1wfEd1yywuNl/eJg1TjSEXAEmmQkHhT5ozwEJ/YGhJFW+u+Q3NjmAlYHEBYyTfzjSGIsYVVfTz",
+	"bMEvevX36zWVPyH1hl0uWD1fvR+BbG6pP6X2LENX9tumJl29X+UL2PpatZYCV66DI3maHMu3Hlt3YU1e",
+	"q/v14DIXu/PjukNewr79evca03JPhSVsEJPrQKHBHnoE36PWN35ivv9giWAKcC13uYPte4BtA7G35Kfo",
+	"ArFPgedc7k19FvNwcg/ei+6EOz/G22TkmoKiF9luUmG5KaTqDtmF7xwXvw/bvhcwbsd50QGXtY6M9UDT",
+	"vXfctNM+fRuq+m3dGpsiO93BC7c3dHFcS6J32pSH5ey4IeSmtBgvlpQpOPTziR+XMYWSVXx2+qs0NGpD",
+	"CoyizK7Mrb1jAVWp3pDGyYJwq635RKwOBRMnJoFBwqEqftkBL5S+hdErqSbJEhqo9Jpav/KJQKJbKPu3",
+	"MrrYNLh44ahc5DqQPDedIYEpYoAipXf8RLiAIuFg2O+ndp9zuWpMZudgKS0/KgP8RL48IqTS57yI38+l",
+	"Y5LSv/JP5FzzQOcAKhRtXTWVzjTVI5ljcWo8+/Q+r9Uuzni0jdNRxFYnCam0XDvkaiFdquRO96SuOLAO",
+	"rBnFWjK5LmHi/+SN5xTLE0ygUqwU9CftljnHetuL3ulb0/zrV1eh/Xs6TFvP/fnr169FjfedxqKa5VXX",
+	"cH4uQTUhxq1OG+t79zj/awPuPxxe7d/jIb4nShO7oBJH0Cuu0AqKLPJRWOmdQTFXMMMxnYcsh+lFFlXo",
+	"+ihcDK78PM9y4YnriYRWplapxbRzLNUnyiXOg7E7/M5J9sdxklXWCUWjnq7+Y1TsBVJV0DO5WvrMdiMF",
+	"MEUuOZJuNBJEXDPo763e4Xi/OzwMg0kUjoPhIBwGcDrsBUM4Hu5PxnAw7KHWZ7+yXzI/fK2dNFVqeqjY",
+	"9RtEZvJl9bolBeYPE7Xwj3bRVU9ml6nygYne+hEXiJemLZaA6MApl5GuIV0JR2uqh3Y6/mzDH1WvH81G",
+	"I3e1s8ncIQhrYCsAcDlXimwG6OQPFIoc/Nru9RYb2dJnnvmof9/GMGOB484MMXqCasNLuxVjcqGm1Tp3",
+	"2eHpSilqj/4u7FUrjPVJTlbA1LByn+vfigloHbX+l91RZ0Kj1b+UCk5dpn3oT1fyv/55pphEN5tF68DW",
+	"7cWUlrvBLF93L3VjYcl5q8X355KOvQWqzYKkxIiEMUSEvsVHK5o8Lr3P3+YULnDrwWL6fzbalhddwNy/",
+	"zSmAC/C6VQMiGxgwP/oQdw7d7eyUD99Ombv2qshjc9Vl4l6TUc3SgUoL5TpA6d45ud4JRPeLlnyGHIdR",
+	"vDProxdT5ZiZGxkZK9jNrYyK+R280AV/z2eMJkt+Lp8SFhzFU0DTX7/AKFKKtz3nN6YyFZ8bq5FWHHXA",
+	"ewY4XSCgy3krw1LnYcfHjcpn8muq1AboOkT65wdrylyHXovw2YAw79lK6huks4RxDGw3ADmnIYbCGBGr",
+	"HoeqOWb6vKQslcXumtlTc652yqyHirsz+Lt1JO6DdgY1B3rrpOGZCcFwMh8bzA6Yyk+scrl7VROnSJiT",
+	"P4ECuY9jK+LhjLVzTHnojill4Cyg9LOnzxsickEvENkUjXMUMiSA7rsJLj9TPe4Tk6sZd4j8wSJyA3/F",
+	"MHnr+aM+3jqXXue8L6e1bkd8xQVaqLQfFu6vcBxLd6YZIhLAjZOU9Yrq+LTI0k1fjnpGb6BPTmH57jz7",
+	"5Qy/YTE/VTv9fp36f4xwzgYv5ZWBQQO60Hk4nY1IwN7f6v+/bBA5oJ6JZlEkVHeqkh3LdpU4f6eHe7B6",
+	"OC9kVOjmauCufctx/gqmrD4v9XBpTfYPonH3oBcM94fjYBihYQDhFAYTeBCNo8nBZBBNW95UANkW1/q4",
+	"lAJc1x6qPit1BXrXCYtbR62/l4wKGtL469He3t/6+9dWu3UJGYYTEyxp2+gHqGL3W0etuRDLVhElf7BN",
+	"2y1EkoU8d9NO/p8+fj1LfrBe/6DT7XQ7vaPD7nhUGlbDDvh48kbSgUzMKnsjfVQWGhiGNCHisU7Yo08Q",
+	"CJrCxhyB4w+vsyPXsFG+31dKd6R0Rm71TDmJhDCwZPQSRynMMTybi042rFY9ecb9kCofWNY5iRFXxH1V",
+	"mlCvwxk5FTrLYx/bgs8cQBDSOEbKC7sQz9oBv82hAFgAPqdJHAGGlgwpr+gILRGJOKAErGjSKQRZV0zp",
+	"xo87qemVVwcXDMGFO5CbZrmE1G3ZaHkItpSezldheBuG0WU2dBKKhCGufT3lE47RNRBzSPLbfUbJFM8S",
+	"TRKUn6TyRuQLGMeIZY6CctggnX9GaQTMo3bPPzKL9N0tozMGF7p/SCO5hNkCEZF6N0YAaS0m5NopXWWD",
+	"0ypItwN4tKBREqPHKj0BBEs9svZ3ZAnhygEdcAroVCACHpkGj+XGZA+pD9TIdwUEw7MZku8glHLToys0",
+	"mVN68dgFKrNyz6ZOBWVwhkBMQ3OAcooYMaGS3U0kpgGTJLxQshhYQDKTzSUaoQnXLQGhAk8NN+geph5H",
+	"Kjz+vwEAq41919EFAwA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
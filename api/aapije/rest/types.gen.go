@@ -4,6 +4,8 @@
 package rest
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -11,6 +13,30 @@ const (
 	BasicAuthScopes = "BasicAuth.Scopes"
 )
 
+// Defines values for AlertChange.
+const (
+	AlertChangeCreate AlertChange = "create"
+
+	AlertChangeDuplicate AlertChange = "duplicate"
+
+	AlertChangeSeverity AlertChange = "severity"
+
+	AlertChangeStatus AlertChange = "status"
+
+	AlertChangeValue AlertChange = "value"
+)
+
+// Defines values for AlertGroupKey.
+const (
+	AlertGroupKeyEnvironment AlertGroupKey = "environment"
+
+	AlertGroupKeyEvent AlertGroupKey = "event"
+
+	AlertGroupKeyParent AlertGroupKey = "parent"
+
+	AlertGroupKeyTag AlertGroupKey = "tag"
+)
+
 // Defines values for AlertSeverity.
 const (
 	AlertSeverityCritical AlertSeverity = "critical"
@@ -32,6 +58,15 @@ const (
 	AlertSeverityWarning AlertSeverity = "warning"
 )
 
+// Defines values for AlertSilenceState.
+const (
+	AlertSilenceStateActive AlertSilenceState = "active"
+
+	AlertSilenceStateExpired AlertSilenceState = "expired"
+
+	AlertSilenceStatePending AlertSilenceState = "pending"
+)
+
 // Defines values for AlertStatus.
 const (
 	AlertStatusAcknowledge AlertStatus = "acknowledge"
@@ -44,9 +79,20 @@ const (
 
 	AlertStatusShelve AlertStatus = "shelve"
 
+	AlertStatusSilence AlertStatus = "silence"
+
 	AlertStatusUnknown AlertStatus = "unknown"
 )
 
+// Defines values for DatasetCompression.
+const (
+	DatasetCompressionGzip DatasetCompression = "gzip"
+
+	DatasetCompressionNone DatasetCompression = "none"
+
+	DatasetCompressionZstd DatasetCompression = "zstd"
+)
+
 // Defines values for DatasetFormat.
 const (
 	DatasetFormatCsv DatasetFormat = "csv"
@@ -64,6 +110,82 @@ const (
 	DatasetFormatYaml DatasetFormat = "yaml"
 )
 
+// Defines values for IncidentChange.
+const (
+	IncidentChangeAlert IncidentChange = "alert"
+
+	IncidentChangeCreate IncidentChange = "create"
+
+	IncidentChangeSeverity IncidentChange = "severity"
+
+	IncidentChangeStatus IncidentChange = "status"
+)
+
+// Defines values for IncidentStatus.
+const (
+	IncidentStatusAcknowledge IncidentStatus = "acknowledge"
+
+	IncidentStatusClose IncidentStatus = "close"
+
+	IncidentStatusOpen IncidentStatus = "open"
+)
+
+// Defines values for MonitorAggregate.
+const (
+	MonitorAggregateAvg MonitorAggregate = "avg"
+
+	MonitorAggregateCount MonitorAggregate = "count"
+
+	MonitorAggregateMax MonitorAggregate = "max"
+
+	MonitorAggregateMin MonitorAggregate = "min"
+
+	MonitorAggregateSum MonitorAggregate = "sum"
+)
+
+// Defines values for MonitorEvaluation.
+const (
+	MonitorEvaluationIngest MonitorEvaluation = "ingest"
+
+	MonitorEvaluationSchedule MonitorEvaluation = "schedule"
+)
+
+// Defines values for MonitorKind.
+const (
+	MonitorKindAbove MonitorKind = "above"
+
+	MonitorKindBelow MonitorKind = "below"
+
+	MonitorKindRate MonitorKind = "rate"
+)
+
+// Defines values for NotificationChannelKind.
+const (
+	NotificationChannelKindEmail NotificationChannelKind = "email"
+
+	NotificationChannelKindSlack NotificationChannelKind = "slack"
+
+	NotificationChannelKindWebhook NotificationChannelKind = "webhook"
+)
+
+// Defines values for NotificationDeliveryStatus.
+const (
+	NotificationDeliveryStatusFailed NotificationDeliveryStatus = "failed"
+
+	NotificationDeliveryStatusPending NotificationDeliveryStatus = "pending"
+
+	NotificationDeliveryStatusSent NotificationDeliveryStatus = "sent"
+)
+
+// Defines values for NotificationTrigger.
+const (
+	NotificationTriggerCreate NotificationTrigger = "create"
+
+	NotificationTriggerSeverity NotificationTrigger = "severity"
+
+	NotificationTriggerStatus NotificationTrigger = "status"
+)
+
 // Defines values for PolicyAction.
 const (
 	PolicyActionCreate PolicyAction = "create"
@@ -82,6 +204,11 @@ const (
 	PolicyEffectDeny PolicyEffect = "deny"
 )
 
+// Defines values for PolygonType.
+const (
+	PolygonTypePolygon PolygonType = "Polygon"
+)
+
 // Defines values for ProgramLanguage.
 const (
 	ProgramLanguageTengo ProgramLanguage = "tengo"
@@ -105,6 +232,49 @@ const (
 	ProgramTypeWebhook ProgramType = "webhook"
 )
 
+// Defines values for ProgramJobStatus.
+const (
+	ProgramJobStatusDead ProgramJobStatus = "dead"
+
+	ProgramJobStatusDone ProgramJobStatus = "done"
+
+	ProgramJobStatusQueued ProgramJobStatus = "queued"
+
+	ProgramJobStatusRunning ProgramJobStatus = "running"
+)
+
+// Defines values for ProgramRunOutcome.
+const (
+	ProgramRunOutcomeFailure ProgramRunOutcome = "failure"
+
+	ProgramRunOutcomeQueued ProgramRunOutcome = "queued"
+
+	ProgramRunOutcomeRunning ProgramRunOutcome = "running"
+
+	ProgramRunOutcomeSuccess ProgramRunOutcome = "success"
+
+	ProgramRunOutcomeTimeout ProgramRunOutcome = "timeout"
+)
+
+// Defines values for ProgramRunTrigger.
+const (
+	ProgramRunTriggerManual ProgramRunTrigger = "manual"
+
+	ProgramRunTriggerSchedule ProgramRunTrigger = "schedule"
+
+	ProgramRunTriggerWebhook ProgramRunTrigger = "webhook"
+)
+
+// Defines values for ThingFeatureType.
+const (
+	ThingFeatureTypeFeature ThingFeatureType = "Feature"
+)
+
+// Defines values for ThingFeatureCollectionType.
+const (
+	ThingFeatureCollectionTypeFeatureCollection ThingFeatureCollectionType = "FeatureCollection"
+)
+
 // Defines values for ThingState.
 const (
 	ThingStateActive ThingState = "active"
@@ -116,6 +286,58 @@ const (
 	ThingStatePassive ThingState = "passive"
 )
 
+// Defines values for ThingTemplateDatasetFormat.
+const (
+	ThingTemplateDatasetFormatCsv ThingTemplateDatasetFormat = "csv"
+
+	ThingTemplateDatasetFormatIni ThingTemplateDatasetFormat = "ini"
+
+	ThingTemplateDatasetFormatJson ThingTemplateDatasetFormat = "json"
+
+	ThingTemplateDatasetFormatMisc ThingTemplateDatasetFormat = "misc"
+
+	ThingTemplateDatasetFormatToml ThingTemplateDatasetFormat = "toml"
+
+	ThingTemplateDatasetFormatXml ThingTemplateDatasetFormat = "xml"
+
+	ThingTemplateDatasetFormatYaml ThingTemplateDatasetFormat = "yaml"
+)
+
+// Defines values for TimeseriesHealthState.
+const (
+	TimeseriesHealthStateFlatline TimeseriesHealthState = "flatline"
+
+	TimeseriesHealthStateOk TimeseriesHealthState = "ok"
+
+	TimeseriesHealthStateStale TimeseriesHealthState = "stale"
+
+	TimeseriesHealthStateUnknown TimeseriesHealthState = "unknown"
+)
+
+// Defines values for TsDataChangeAction.
+const (
+	TsDataChangeActionDelete TsDataChangeAction = "delete"
+
+	TsDataChangeActionOverwrite TsDataChangeAction = "overwrite"
+)
+
+// Defines values for Weekday.
+const (
+	WeekdayFriday Weekday = "friday"
+
+	WeekdayMonday Weekday = "monday"
+
+	WeekdaySaturday Weekday = "saturday"
+
+	WeekdaySunday Weekday = "sunday"
+
+	WeekdayThursday Weekday = "thursday"
+
+	WeekdayTuesday Weekday = "tuesday"
+
+	WeekdayWednesday Weekday = "wednesday"
+)
+
 // Defines values for AggregateParam.
 const (
 	Avg AggregateParam = "avg"
@@ -129,6 +351,13 @@ const (
 	Sum AggregateParam = "sum"
 )
 
+// Defines values for GeoFormatParam.
+const (
+	Geojson GeoFormatParam = "geojson"
+
+	Json GeoFormatParam = "json"
+)
+
 // Defines values for PrecisionParam.
 const (
 	Century PrecisionParam = "century"
@@ -180,19 +409,103 @@ type Alert struct {
 	Resource         string        `json:"resource"`
 	Service          []string      `json:"service"`
 	Severity         AlertSeverity `json:"severity"`
-	Status           AlertStatus   `json:"status"`
-	Tags             []string      `json:"tags"`
-	Timeout          int32         `json:"timeout"`
-	Uuid             string        `json:"uuid"`
-	Value            string        `json:"value"`
+
+	// When a shelved alert is opened again
+	ShelvedUntil *time.Time `json:"shelved_until"`
+
+	// Reference to the silence of a silenced alert
+	Silence *string     `json:"silence"`
+	Status  AlertStatus `json:"status"`
+	Tags    []string    `json:"tags"`
+	Timeout int32       `json:"timeout"`
+	Uuid    string      `json:"uuid"`
+	Value   string      `json:"value"`
+}
+
+// AlertChange defines model for AlertChange.
+type AlertChange string
+
+// Gathers the open alerts which share the values of its keys into one incident. Groups are tried by name, and an alert joins the incident of the first group for which it has a value for every key.
+type AlertGroup struct {
+	Created time.Time `json:"created"`
+
+	// Reference to a User
+	CreatedBy string          `json:"created_by"`
+	Enabled   bool            `json:"enabled"`
+	Keys      []AlertGroupKey `json:"keys"`
+	Name      string          `json:"name"`
+	TagPrefix string          `json:"tag_prefix"`
+	Uuid      string          `json:"uuid"`
+}
+
+// `tag` is the first tag of the alert starting with the tag prefix of the group. `parent` is the parent of the thing whose UUID starts the resource of the alert, e.g. `<thing>` or `<thing>/<timeseries>`.
+type AlertGroupKey string
+
+// AlertHistoryEntry defines model for AlertHistoryEntry.
+type AlertHistoryEntry struct {
+	Change  AlertChange `json:"change"`
+	Changed time.Time   `json:"changed"`
+
+	// Reference to a User. Missing for changes made by the system, e.g. when the alert expired.
+	ChangedBy *string `json:"changed_by,omitempty"`
+
+	// The value before the change
+	From string `json:"from"`
+	Note string `json:"note"`
+
+	// The value after the change
+	To string `json:"to"`
 }
 
 // AlertSeverity defines model for AlertSeverity.
 type AlertSeverity string
 
+// Alerts matching a silence while it is active are silenced instead of opened, and send no notifications. Every list must contain a value of the alert, where an empty list matches any alert.
+type AlertSilence struct {
+	Comment string    `json:"comment"`
+	Created time.Time `json:"created"`
+
+	// Reference to a User
+	CreatedBy   string    `json:"created_by"`
+	Ends        time.Time `json:"ends"`
+	Environment []string  `json:"environment"`
+	Event       []string  `json:"event"`
+
+	// Limits a silence to a window of time on the weekdays, e.g. every Sunday 02:00 to 04:00. A window ending before it starts ends on the next day, and one ending when it starts lasts the whole day.
+	Recurrence *AlertSilenceRecurrence `json:"recurrence"`
+	Resource   []string                `json:"resource"`
+	Service    []string                `json:"service"`
+	Starts     time.Time               `json:"starts"`
+
+	// A pending silence has not started, or is outside of its window.
+	State AlertSilenceState `json:"state"`
+	Tags  []string          `json:"tags"`
+	Uuid  string            `json:"uuid"`
+}
+
+// Limits a silence to a window of time on the weekdays, e.g. every Sunday 02:00 to 04:00. A window ending before it starts ends on the next day, and one ending when it starts lasts the whole day.
+type AlertSilenceRecurrence struct {
+	End   string `json:"end"`
+	Start string `json:"start"`
+
+	// IANA time zone of the window, UTC by default
+	Timezone *string `json:"timezone,omitempty"`
+
+	// Every day when empty
+	Weekdays *[]Weekday `json:"weekdays,omitempty"`
+}
+
+// A pending silence has not started, or is outside of its window.
+type AlertSilenceState string
+
 // AlertStatus defines model for AlertStatus.
 type AlertStatus string
 
+// A JSON object with structured data such as serial numbers or coordinates. The attributes of a Thing are validated against the JSON Schema for its type, if one exists.
+type Attributes struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
 // CodeRevision defines model for CodeRevision.
 type CodeRevision struct {
 	Checksum string    `json:"checksum"`
@@ -214,6 +527,9 @@ type Dataset struct {
 	// The sha256 checksum of the content
 	Checksum string `json:"checksum"`
 
+	// The compression of the stored content.
+	Compression DatasetCompression `json:"compression"`
+
 	// Date-time when created, as defined by RFC 3339, section 5.6.
 	Created time.Time `json:"created"`
 
@@ -223,12 +539,18 @@ type Dataset struct {
 	// File format of the data set.
 	Format DatasetFormat `json:"format"`
 
+	// The number of content revisions to keep. Null when every revision is kept.
+	MaxRevisions *int `json:"max_revisions"`
+
 	// Name of the resource. Does *not* have to be unique.
 	Name string `json:"name"`
 
 	// The size of the content in number of bytes.
-	Size int64    `json:"size"`
-	Tags []string `json:"tags"`
+	Size int64 `json:"size"`
+
+	// The size of the content as stored, once compressed, in number of bytes.
+	StoredSize int64    `json:"stored_size"`
+	Tags       []string `json:"tags"`
 
 	// UUID reference to a Thing as a way to track data-sets to things.
 	ThingUuid *string `json:"thing_uuid"`
@@ -243,23 +565,267 @@ type Dataset struct {
 	Uuid string `json:"uuid"`
 }
 
+// The compression of the stored content.
+type DatasetCompression string
+
 // File format of the data set.
 type DatasetFormat string
 
+// DatasetRevision defines model for DatasetRevision.
+type DatasetRevision struct {
+	// The sha256 checksum of the content
+	Checksum string    `json:"checksum"`
+	Created  time.Time `json:"created"`
+
+	// User UUID
+	CreatedBy string `json:"created_by"`
+
+	// The revision number
+	Revision int `json:"revision"`
+
+	// The size of the content in number of bytes.
+	Size int64 `json:"size"`
+}
+
+// A JSON Schema document
+type DatasetSchema struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
 // Error message
 type Error string
 
+// A GeoJSON geometry. For a Thing this is a Point for the location, otherwise the area as a Polygon. Null when the Thing has neither.
+type Geometry struct {
+	Coordinates interface{} `json:"coordinates"`
+	Type        string      `json:"type"`
+}
+
 // Group defines model for Group.
 type Group struct {
 	Name string `json:"name"`
 	Uuid string `json:"uuid"`
 }
 
+// Incident defines model for Incident.
+type Incident struct {
+	// The number of alerts of the incident
+	Alerts  int64      `json:"alerts"`
+	Closed  *time.Time `json:"closed"`
+	Created time.Time  `json:"created"`
+
+	// Reference to the alert group, unless deleted
+	Group *string `json:"group"`
+
+	// The values of the keys of the group shared by the alerts
+	Key      string        `json:"key"`
+	Severity AlertSeverity `json:"severity"`
+
+	// An incident is closed when all its alerts have cleared, or when closed by a user.
+	Status  IncidentStatus `json:"status"`
+	Title   string         `json:"title"`
+	Updated time.Time      `json:"updated"`
+	Uuid    string         `json:"uuid"`
+}
+
+// IncidentChange defines model for IncidentChange.
+type IncidentChange string
+
+// IncidentHistoryEntry defines model for IncidentHistoryEntry.
+type IncidentHistoryEntry struct {
+	// Reference to the alert which caused the change
+	Alert   *string        `json:"alert"`
+	Change  IncidentChange `json:"change"`
+	Changed time.Time      `json:"changed"`
+
+	// Reference to a User. Missing for changes made by the system.
+	ChangedBy *string `json:"changed_by,omitempty"`
+
+	// The value before the change
+	From string `json:"from"`
+	Note string `json:"note"`
+
+	// The value after the change
+	To string `json:"to"`
+}
+
+// An incident is closed when all its alerts have cleared, or when closed by a user.
+type IncidentStatus string
+
+// A position in WGS 84
+type Location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// A monitor raises an alert when the aggregate of a time series over the window is above or below the threshold, or for `rate` changes faster than the threshold per second from the previous window. The alert is resolved once the value is back beyond the hysteresis.
+type Monitor struct {
+	Aggregate MonitorAggregate `json:"aggregate"`
+
+	// Reference to the last alert raised
+	Alert   *string   `json:"alert"`
+	Created time.Time `json:"created"`
+
+	// Reference to a User
+	CreatedBy   string `json:"created_by"`
+	Enabled     bool   `json:"enabled"`
+	Environment string `json:"environment"`
+
+	// Evaluate when data is added to the time series, or every interval. Without data in the window the condition clears, unless the aggregate is `count`.
+	Evaluate  MonitorEvaluation `json:"evaluate"`
+	Evaluated *time.Time        `json:"evaluated"`
+	Event     string            `json:"event"`
+
+	// Whether the condition held at the last evaluation
+	Firing     bool    `json:"firing"`
+	Hysteresis float64 `json:"hysteresis"`
+
+	// Seconds between evaluations on `schedule`
+	Interval  int32         `json:"interval"`
+	Kind      MonitorKind   `json:"kind"`
+	Name      string        `json:"name"`
+	Severity  AlertSeverity `json:"severity"`
+	Threshold float64       `json:"threshold"`
+
+	// Reference to a Time series
+	Timeseries string `json:"timeseries"`
+	Uuid       string `json:"uuid"`
+
+	// The value at the last evaluation
+	Value *float64 `json:"value"`
+
+	// Seconds of data to aggregate
+	Window int32 `json:"window"`
+}
+
+// MonitorAggregate defines model for MonitorAggregate.
+type MonitorAggregate string
+
+// Evaluate when data is added to the time series, or every interval. Without data in the window the condition clears, unless the aggregate is `count`.
+type MonitorEvaluation string
+
+// MonitorKind defines model for MonitorKind.
+type MonitorKind string
+
 // The model returned when an Alert was created.
 type NewAlertReply struct {
 	Uuid string `json:"uuid"`
 }
 
+// NotificationChannel defines model for NotificationChannel.
+type NotificationChannel struct {
+	// Settings of a channel, which ones are used depends on the kind. `body`, `subject` and `text` are Go templates executed with the notification, see docs/alert_notifications.md. The password, the headers and the URL of a `slack` channel are never returned; an update without them keeps the stored values.
+	Config  NotificationChannelConfig `json:"config"`
+	Created time.Time                 `json:"created"`
+
+	// Reference to a User
+	CreatedBy string                  `json:"created_by"`
+	Enabled   bool                    `json:"enabled"`
+	Kind      NotificationChannelKind `json:"kind"`
+	Name      string                  `json:"name"`
+	Uuid      string                  `json:"uuid"`
+}
+
+// Settings of a channel, which ones are used depends on the kind. `body`, `subject` and `text` are Go templates executed with the notification, see docs/alert_notifications.md. The password, the headers and the URL of a `slack` channel are never returned; an update without them keeps the stored values.
+type NotificationChannelConfig struct {
+	// Template of the webhook or email body.
+	Body *string `json:"body,omitempty"`
+
+	// Content type of the webhook body.
+	ContentType *string `json:"content_type,omitempty"`
+	From        *string `json:"from,omitempty"`
+
+	// Extra HTTP headers of the webhook.
+	Headers *NotificationChannelConfig_Headers `json:"headers,omitempty"`
+
+	// SMTP server.
+	Host *string `json:"host,omitempty"`
+
+	// HTTP method of the webhook, `POST` or `PUT`.
+	Method   *string `json:"method,omitempty"`
+	Password *string `json:"password,omitempty"`
+
+	// SMTP port, 25 by default. Port 465 uses implicit TLS.
+	Port *int `json:"port,omitempty"`
+
+	// Template of the email subject.
+	Subject *string `json:"subject,omitempty"`
+
+	// Template of the chat message.
+	Text *string   `json:"text,omitempty"`
+	To   *[]string `json:"to,omitempty"`
+
+	// URL of the webhook or the incoming webhook of the chat service. Not returned for `slack`.
+	Url      *string `json:"url,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// Extra HTTP headers of the webhook.
+type NotificationChannelConfig_Headers struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// NotificationChannelKind defines model for NotificationChannelKind.
+type NotificationChannelKind string
+
+// NotificationDelivery defines model for NotificationDelivery.
+type NotificationDelivery struct {
+	// Reference to the alert, unless deleted
+	Alert    *string `json:"alert"`
+	Attempts int32   `json:"attempts"`
+
+	// Reference to a notification channel
+	Channel   string     `json:"channel"`
+	Created   time.Time  `json:"created"`
+	Delivered *time.Time `json:"delivered"`
+
+	// Reference to the incident, for notifications of incidents
+	Incident    *string   `json:"incident"`
+	LastError   string    `json:"last_error"`
+	NextAttempt time.Time `json:"next_attempt"`
+
+	// The alert, or incident, when the notification was triggered
+	Payload NotificationDelivery_Payload `json:"payload"`
+
+	// Reference to the notification rule, unless deleted
+	Rule   *string                    `json:"rule"`
+	Status NotificationDeliveryStatus `json:"status"`
+
+	// The change that triggered a notification; an alert was created, its severity changed or its status changed.
+	Trigger NotificationTrigger `json:"trigger"`
+	Uuid    string              `json:"uuid"`
+}
+
+// The alert, or incident, when the notification was triggered
+type NotificationDelivery_Payload struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// NotificationDeliveryStatus defines model for NotificationDeliveryStatus.
+type NotificationDeliveryStatus string
+
+// NotificationRule defines model for NotificationRule.
+type NotificationRule struct {
+	// Reference to a notification channel
+	Channel string    `json:"channel"`
+	Created time.Time `json:"created"`
+
+	// Reference to a User
+	CreatedBy   string                `json:"created_by"`
+	Enabled     bool                  `json:"enabled"`
+	Environment []string              `json:"environment"`
+	Name        string                `json:"name"`
+	Resource    string                `json:"resource"`
+	Service     []string              `json:"service"`
+	Severity    []AlertSeverity       `json:"severity"`
+	Tags        []string              `json:"tags"`
+	Triggers    []NotificationTrigger `json:"triggers"`
+	Uuid        string                `json:"uuid"`
+}
+
+// The change that triggered a notification; an alert was created, its severity changed or its status changed.
+type NotificationTrigger string
+
 // Policy defines model for Policy.
 type Policy struct {
 	Action    PolicyAction `json:"action"`
@@ -276,6 +842,16 @@ type PolicyAction string
 // PolicyEffect defines model for Policy.Effect.
 type PolicyEffect string
 
+// A GeoJSON Polygon in WGS 84
+type Polygon struct {
+	// Linear rings of [longitude, latitude] positions. The first ring is the exterior ring.
+	Coordinates [][][]float64 `json:"coordinates"`
+	Type        PolygonType   `json:"type"`
+}
+
+// PolygonType defines model for Polygon.Type.
+type PolygonType string
+
 // Program defines model for Program.
 type Program struct {
 	// Ignored for Modules. An duration (in milliseconds) after which a Program (routine, webhook) shall terminate, to avoid long running programs.
@@ -302,32 +878,322 @@ type ProgramState string
 // Routines are executed at an interval. Webhooks are called using the REST API. Modules are used by Routines and Webhooks to extend their functionality.
 type ProgramType string
 
+// ProgramDryRun defines model for ProgramDryRun.
+type ProgramDryRun struct {
+	// The time, in milliseconds, from handing the program to the worker until it returned
+	Duration int `json:"duration"`
+
+	// Why the run did not succeed
+	Error   string            `json:"error"`
+	Outcome ProgramRunOutcome `json:"outcome"`
+
+	// The output written by the program through the `log` module
+	Output string `json:"output"`
+
+	// Program UUID
+	Program string `json:"program"`
+
+	// The code revision which ran
+	Revision int `json:"revision"`
+
+	// The worker which ran the program
+	Worker string `json:"worker"`
+}
+
+// ProgramJob defines model for ProgramJob.
+type ProgramJob struct {
+	// The number of times the job has been handed to a worker
+	Attempts int       `json:"attempts"`
+	Created  time.Time `json:"created"`
+	Id       int64     `json:"id"`
+
+	// Why the last attempt did not succeed
+	LastError string `json:"last_error"`
+
+	// When the lease of a running job expires, after which it is claimed again
+	LeaseUntil *time.Time `json:"lease_until"`
+
+	// The number of attempts before the job is dead
+	MaxAttempts int `json:"max_attempts"`
+
+	// Program UUID
+	Program string `json:"program"`
+
+	// The code revision to run
+	Revision int `json:"revision"`
+
+	// When a queued job is due
+	RunAfter time.Time `json:"run_after"`
+
+	// When the job was due by the schedule of the program, for a scheduled job
+	Scheduled *time.Time        `json:"scheduled"`
+	Status    ProgramJobStatus  `json:"status"`
+	Trigger   ProgramRunTrigger `json:"trigger"`
+	Updated   time.Time         `json:"updated"`
+
+	// The worker running the job
+	Worker string `json:"worker"`
+}
+
+// ProgramJobStatus defines model for ProgramJobStatus.
+type ProgramJobStatus string
+
+// ProgramRun defines model for ProgramRun.
+type ProgramRun struct {
+	// The time, in milliseconds, from handing the program to the worker until it returned
+	Duration *int       `json:"duration"`
+	Ended    *time.Time `json:"ended"`
+
+	// Why the run did not succeed
+	Error string `json:"error"`
+	Id    int64  `json:"id"`
+
+	// The job of the run, if queued
+	Job     *int64            `json:"job"`
+	Outcome ProgramRunOutcome `json:"outcome"`
+
+	// The output written by the program through the `log` module
+	Output string `json:"output"`
+
+	// Program UUID
+	Program string `json:"program"`
+
+	// The code revision which ran
+	Revision int `json:"revision"`
+
+	// When the run was started, or queued while it is queued
+	Started time.Time         `json:"started"`
+	Trigger ProgramRunTrigger `json:"trigger"`
+
+	// The worker which ran the program, empty while the run is queued or when no worker was available
+	Worker string `json:"worker"`
+}
+
+// ProgramRunOutcome defines model for ProgramRunOutcome.
+type ProgramRunOutcome string
+
+// ProgramRunTrigger defines model for ProgramRunTrigger.
+type ProgramRunTrigger string
+
 // Thing defines model for Thing.
 type Thing struct {
+	// A GeoJSON Polygon in WGS 84
+	Area *Polygon `json:"area,omitempty"`
+
+	// A JSON object with structured data such as serial numbers or coordinates. The attributes of a Thing are validated against the JSON Schema for its type, if one exists.
+	Attributes Attributes `json:"attributes"`
+
 	// Reference to a User
-	CreatedBy string     `json:"created_by"`
-	Name      string     `json:"name"`
-	State     ThingState `json:"state"`
-	Tags      []string   `json:"tags"`
-	Type      *string    `json:"type"`
-	Uuid      string     `json:"uuid"`
+	CreatedBy string `json:"created_by"`
+
+	// A position in WGS 84
+	Location *Location  `json:"location,omitempty"`
+	Name     string     `json:"name"`
+	State    ThingState `json:"state"`
+	Tags     []string   `json:"tags"`
+	Type     *string    `json:"type"`
+	Uuid     string     `json:"uuid"`
 }
 
-// ThingState defines model for Thing.State.
+// A Thing as a GeoJSON Feature
+type ThingFeature struct {
+	// A GeoJSON geometry. For a Thing this is a Point for the location, otherwise the area as a Polygon. Null when the Thing has neither.
+	Geometry   *Geometry        `json:"geometry"`
+	Id         string           `json:"id"`
+	Properties Thing            `json:"properties"`
+	Type       ThingFeatureType `json:"type"`
+}
+
+// ThingFeatureType defines model for ThingFeature.Type.
+type ThingFeatureType string
+
+// ThingFeatureCollection defines model for ThingFeatureCollection.
+type ThingFeatureCollection struct {
+	Features []ThingFeature             `json:"features"`
+	Type     ThingFeatureCollectionType `json:"type"`
+}
+
+// ThingFeatureCollectionType defines model for ThingFeatureCollection.Type.
+type ThingFeatureCollectionType string
+
+// ThingRelative defines model for ThingRelative.
+type ThingRelative struct {
+	// Number of levels from the origin of the search
+	Depth int `json:"depth"`
+
+	// Policies on the parent Thing also apply to the child
+	InheritPolicies bool  `json:"inherit_policies"`
+	Thing           Thing `json:"thing"`
+
+	// The adjacent Thing one level closer to the origin of the search
+	Via string `json:"via"`
+}
+
+// ThingState defines model for ThingState.
 type ThingState string
 
+// ThingStateChange defines model for ThingStateChange.
+type ThingStateChange struct {
+	Changed time.Time `json:"changed"`
+
+	// Reference to a User
+	ChangedBy string     `json:"changed_by"`
+	From      ThingState `json:"from"`
+	Reason    string     `json:"reason"`
+	To        ThingState `json:"to"`
+}
+
+// ThingStateTransition defines model for ThingStateTransition.
+type ThingStateTransition struct {
+	From ThingState `json:"from"`
+	To   ThingState `json:"to"`
+}
+
+// ThingStateTransitions defines model for ThingStateTransitions.
+type ThingStateTransitions struct {
+	// The allowed state changes
+	Transitions []ThingStateTransition `json:"transitions"`
+
+	// The Thing type the transitions apply to
+	Type string `json:"type"`
+}
+
+// ThingTemplate defines model for ThingTemplate.
+type ThingTemplate struct {
+	// Reference to a User
+	CreatedBy  string                    `json:"created_by"`
+	Datasets   []ThingTemplateDataset    `json:"datasets"`
+	Name       string                    `json:"name"`
+	Timeseries []ThingTemplateTimeseries `json:"timeseries"`
+
+	// The type of Things created from the template
+	Type string `json:"type"`
+	Uuid string `json:"uuid"`
+}
+
+// ThingTemplateApplyResult defines model for ThingTemplateApplyResult.
+type ThingTemplateApplyResult struct {
+	DatasetsCreated int64 `json:"datasets_created"`
+
+	// Things of the template type which the user is not allowed to update
+	Skipped []string `json:"skipped"`
+
+	// Number of Things the template was applied to
+	Things            int64 `json:"things"`
+	TimeseriesCreated int64 `json:"timeseries_created"`
+	TimeseriesUpdated int64 `json:"timeseries_updated"`
+}
+
+// ThingTemplateDataset defines model for ThingTemplateDataset.
+type ThingTemplateDataset struct {
+	// Initial content of the dataset.
+	Content *[]byte                    `json:"content"`
+	Format  ThingTemplateDatasetFormat `json:"format"`
+	Name    string                     `json:"name"`
+	Tags    *[]string                  `json:"tags,omitempty"`
+}
+
+// ThingTemplateDatasetFormat defines model for ThingTemplateDataset.Format.
+type ThingTemplateDatasetFormat string
+
+// ThingTemplateTimeseries defines model for ThingTemplateTimeseries.
+type ThingTemplateTimeseries struct {
+	LowerBound *float64  `json:"lower_bound"`
+	Name       string    `json:"name"`
+	SiUnit     string    `json:"si_unit"`
+	Tags       *[]string `json:"tags,omitempty"`
+	UpperBound *float64  `json:"upper_bound"`
+}
+
+// ThingTree defines model for ThingTree.
+type ThingTree struct {
+	Children   []ThingTree  `json:"children"`
+	Datasets   []Dataset    `json:"datasets"`
+	Thing      Thing        `json:"thing"`
+	Timeseries []Timeseries `json:"timeseries"`
+}
+
+// ThingTypeSchema defines model for ThingTypeSchema.
+type ThingTypeSchema struct {
+	// A JSON Schema document
+	Schema ThingTypeSchema_Schema `json:"schema"`
+
+	// The Thing type the schema applies to
+	Type string `json:"type"`
+}
+
+// A JSON Schema document
+type ThingTypeSchema_Schema struct {
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
 // Timeseries defines model for Timeseries.
 type Timeseries struct {
-	CreatedBy  string   `json:"created_by"`
-	LowerBound *float64 `json:"lower_bound"`
-	Name       string   `json:"name"`
-	SiUnit     string   `json:"si_unit"`
-	Tags       []string `json:"tags"`
-	ThingUuid  *string  `json:"thing_uuid"`
-	UpperBound *float64 `json:"upper_bound"`
-	Uuid       string   `json:"uuid"`
+	// A JSON object with structured data such as serial numbers or coordinates. The attributes of a Thing are validated against the JSON Schema for its type, if one exists.
+	Attributes Attributes `json:"attributes"`
+	CreatedBy  string     `json:"created_by"`
+	LowerBound *float64   `json:"lower_bound"`
+	Name       string     `json:"name"`
+	SiUnit     string     `json:"si_unit"`
+	Tags       []string   `json:"tags"`
+	ThingUuid  *string    `json:"thing_uuid"`
+	UpperBound *float64   `json:"upper_bound"`
+	Uuid       string     `json:"uuid"`
+}
+
+// TimeseriesHealth defines model for TimeseriesHealth.
+type TimeseriesHealth struct {
+	// Reference to the last alert raised
+	Alert     *string    `json:"alert"`
+	Evaluated *time.Time `json:"evaluated"`
+
+	// The time of the latest value
+	LastSeen  *time.Time `json:"last_seen"`
+	LastValue *float64   `json:"last_value"`
+	Name      string     `json:"name"`
+
+	// Reference to the health rule applied
+	Rule *string `json:"rule"`
+
+	// When the time series entered the state
+	Since *time.Time `json:"since"`
+
+	// A time series is stale when it has not reported within the expected interval, and flatlined when its value has not changed for the flatline duration. It is unknown when no rule applies to it, or it has never reported.
+	State TimeseriesHealthState `json:"state"`
+
+	// Reference to the Thing of the time series
+	Thing *string `json:"thing"`
+
+	// Reference to a Time series
+	Timeseries string `json:"timeseries"`
+}
+
+// Sets how often a time series is expected to report, and for how long its value may stay the same. The rule of a time series overrides those of its tags, of which the first by name applies.
+type TimeseriesHealthRule struct {
+	Created time.Time `json:"created"`
+
+	// Reference to a User
+	CreatedBy   string `json:"created_by"`
+	Enabled     bool   `json:"enabled"`
+	Environment string `json:"environment"`
+
+	// Seconds between the values of a time series, at the most
+	ExpectedInterval *int32 `json:"expected_interval"`
+
+	// Seconds the value of a time series may stay the same, at the most
+	Flatline *int32        `json:"flatline"`
+	Name     string        `json:"name"`
+	Severity AlertSeverity `json:"severity"`
+	Tag      string        `json:"tag"`
+
+	// Reference to a Time series
+	Timeseries *string `json:"timeseries"`
+	Uuid       string  `json:"uuid"`
 }
 
+// A time series is stale when it has not reported within the expected interval, and flatlined when its value has not changed for the flatline duration. It is unknown when no rule applies to it, or it has never reported.
+type TimeseriesHealthState string
+
 // Token defines model for Token.
 type Token struct {
 	Created time.Time `json:"created"`
@@ -342,6 +1208,103 @@ type TokenWithSecret struct {
 	Uuid   string `json:"uuid"`
 }
 
+// TsDataChange defines model for TsDataChange.
+type TsDataChange struct {
+	Action TsDataChangeAction `json:"action"`
+
+	// Identifies all data points deleted or overwritten by the same request
+	ChangeId int64 `json:"change_id"`
+
+	// Date-time of the change, as defined by RFC 3339, section 5.6.
+	Changed time.Time `json:"changed"`
+
+	// User UUID
+	ChangedBy *string `json:"changed_by"`
+
+	// The number of data points changed
+	Count int `json:"count"`
+
+	// User UUID of the original author, null for a deleted range
+	CreatedBy *string `json:"created_by"`
+
+	// Date-time when the change was undone
+	Restored *time.Time `json:"restored"`
+
+	// UUID of the token used to make the change
+	TokenUuid *string `json:"token_uuid"`
+
+	// Date-time of the (first) data point, as defined by RFC 3339, section 5.6.
+	Ts time.Time `json:"ts"`
+
+	// Date-time of the last data point, the same as ts for a single data point.
+	TsEnd time.Time `json:"ts_end"`
+
+	// The value before an overwrite, null for a deleted range
+	V *float32 `json:"v"`
+}
+
+// TsDataChangeAction defines model for TsDataChange.Action.
+type TsDataChangeAction string
+
+// TsImportColumn defines model for TsImportColumn.
+type TsImportColumn struct {
+	// Name of the column, or its number starting at 1 when the CSV has no header
+	Column string `json:"column"`
+
+	// Reference to a Timeseries
+	Timeseries string `json:"timeseries"`
+
+	// Unit of the values in the column. Values are converted to the unit of the Timeseries.
+	Unit *string `json:"unit,omitempty"`
+}
+
+// TsImportError defines model for TsImportError.
+type TsImportError struct {
+	Column  *string `json:"column,omitempty"`
+	Message string  `json:"message"`
+
+	// Line number in the CSV, starting at 1
+	Row int64 `json:"row"`
+}
+
+// TsImportMapping defines model for TsImportMapping.
+type TsImportMapping struct {
+	Columns []TsImportColumn `json:"columns"`
+
+	// Either `.` or `,`.
+	DecimalSeparator *string `json:"decimal_separator,omitempty"`
+
+	// Field delimiter, a single character.
+	Delimiter *string `json:"delimiter,omitempty"`
+
+	// The first row of the CSV names the columns.
+	Header *bool `json:"header,omitempty"`
+
+	// Name of the timestamp column, or its number starting at 1 when the CSV has no header
+	TimestampColumn *string `json:"timestamp_column,omitempty"`
+
+	// One of `rfc3339`, `unix` (seconds), `unix_ms` (milliseconds) or a Go time layout such as `2006-01-02 15:04:05`.
+	TimestampFormat *string `json:"timestamp_format,omitempty"`
+
+	// Time zone of timestamps without an offset.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// TsImportResult defines model for TsImportResult.
+type TsImportResult struct {
+	// The first 100 validation errors
+	Errors []TsImportError `json:"errors"`
+
+	// Number of values inserted. Values outside of the bounds of a Timeseries are skipped.
+	Inserted int64 `json:"inserted"`
+
+	// Number of values read
+	Points int64 `json:"points"`
+
+	// Number of data rows read
+	Rows int64 `json:"rows"`
+}
+
 // TsResults defines model for TsResults.
 type TsResults struct {
 	Data []TsRow `json:"data"`
@@ -366,39 +1329,75 @@ type User struct {
 	Uuid   string  `json:"uuid"`
 }
 
+// Weekday defines model for Weekday.
+type Weekday string
+
 // AggregateParam defines model for aggregateParam.
 type AggregateParam string
 
+// AttributesFilterParam defines model for attributesFilterParam.
+type AttributesFilterParam string
+
+// BboxParam defines model for bboxParam.
+type BboxParam []float64
+
+// DryRunParam defines model for dryRunParam.
+type DryRunParam bool
+
 // EnvFilterParam defines model for envFilterParam.
 type EnvFilterParam string
 
 // EventFilterParam defines model for eventFilterParam.
 type EventFilterParam string
 
+// GeoFormatParam defines model for geoFormatParam.
+type GeoFormatParam string
+
 // GreaterOrEqParam defines model for greaterOrEqParam.
 type GreaterOrEqParam float32
 
+// IfModifiedSinceParam defines model for ifModifiedSinceParam.
+type IfModifiedSinceParam string
+
 // IfNoneMatchParam defines model for ifNoneMatchParam.
 type IfNoneMatchParam string
 
+// IfRangeParam defines model for ifRangeParam.
+type IfRangeParam string
+
 // LessOrEqParam defines model for lessOrEqParam.
 type LessOrEqParam float32
 
 // LimitParam defines model for limitParam.
 type LimitParam int64
 
+// MaxDepthParam defines model for maxDepthParam.
+type MaxDepthParam int
+
+// NearParam defines model for nearParam.
+type NearParam []float64
+
 // OffsetParam defines model for offsetParam.
 type OffsetParam int64
 
 // OriginFilterParam defines model for originFilterParam.
 type OriginFilterParam string
 
+// OverwriteParam defines model for overwriteParam.
+type OverwriteParam bool
+
 // PrecisionParam defines model for precisionParam.
 type PrecisionParam string
 
+// RadiusParam defines model for radiusParam.
+type RadiusParam float64
+
 // RangeEndParam defines model for rangeEndParam.
 type RangeEndParam time.Time
 
+// RangeParam defines model for rangeParam.
+type RangeParam string
+
 // RangeStartParam defines model for rangeStartParam.
 type RangeStartParam time.Time
 
@@ -420,18 +1419,32 @@ type SeverityLeFilterParam AlertSeverity
 // SiUnitParam defines model for siUnitParam.
 type SiUnitParam string
 
+// SilencedFilterParam defines model for silencedFilterParam.
+type SilencedFilterParam bool
+
 // StatusFilterParam defines model for statusFilterParam.
 type StatusFilterParam AlertStatus
 
 // TagsFilterParam defines model for tagsFilterParam.
 type TagsFilterParam []string
 
+// ThingTypeParam defines model for thingTypeParam.
+type ThingTypeParam string
+
 // TimezoneParam defines model for timezoneParam.
 type TimezoneParam string
 
 // UuidParam defines model for uuidParam.
 type UuidParam string
 
+// AlertAction defines model for AlertAction.
+type AlertAction struct {
+	Note *string `json:"note,omitempty"`
+
+	// When a shelved alert is opened again. Only used when shelving; without it the alert stays shelved.
+	Until *time.Time `json:"until,omitempty"`
+}
+
 // NewAlert defines model for NewAlert.
 type NewAlert struct {
 	Description string `json:"description"`
@@ -450,12 +1463,42 @@ type NewAlert struct {
 	Value    string        `json:"value"`
 }
 
+// NewAlertGroup defines model for NewAlertGroup.
+type NewAlertGroup struct {
+	Enabled *bool           `json:"enabled,omitempty"`
+	Keys    []AlertGroupKey `json:"keys"`
+	Name    string          `json:"name"`
+
+	// Required with the `tag` key; the first tag of an alert with this prefix is its value.
+	TagPrefix *string `json:"tag_prefix,omitempty"`
+}
+
+// NewAlertSilence defines model for NewAlertSilence.
+type NewAlertSilence struct {
+	Comment     *string   `json:"comment,omitempty"`
+	Ends        time.Time `json:"ends"`
+	Environment *[]string `json:"environment,omitempty"`
+	Event       *[]string `json:"event,omitempty"`
+
+	// Limits a silence to a window of time on the weekdays, e.g. every Sunday 02:00 to 04:00. A window ending before it starts ends on the next day, and one ending when it starts lasts the whole day.
+	Recurrence *AlertSilenceRecurrence `json:"recurrence"`
+	Resource   *[]string               `json:"resource,omitempty"`
+	Service    *[]string               `json:"service,omitempty"`
+
+	// Now by default
+	Starts *time.Time `json:"starts,omitempty"`
+	Tags   *[]string  `json:"tags,omitempty"`
+}
+
 // NewDataset defines model for NewDataset.
 type NewDataset struct {
 	// Content of the resource.
 	Content *[]byte          `json:"content"`
 	Format  NewDatasetFormat `json:"format"`
-	Name    string           `json:"name"`
+
+	// The number of content revisions to keep. Zero keeps every revision.
+	MaxRevisions *int   `json:"max_revisions,omitempty"`
+	Name         string `json:"name"`
 
 	// An array of text labels (tags) for tracking and filtering purposes.
 	Tags *[]string `json:"tags,omitempty"`
@@ -464,12 +1507,73 @@ type NewDataset struct {
 	ThingUuid *string `json:"thing_uuid"`
 }
 
+// A JSON Schema document
+type NewDatasetSchema DatasetSchema
+
 // NewGroup defines model for NewGroup.
 type NewGroup struct {
 	// Name of the group
 	Name string `json:"name"`
 }
 
+// NewMonitor defines model for NewMonitor.
+type NewMonitor struct {
+	Aggregate   *MonitorAggregate `json:"aggregate,omitempty"`
+	Enabled     *bool             `json:"enabled,omitempty"`
+	Environment *string           `json:"environment,omitempty"`
+
+	// Evaluate when data is added to the time series, or every interval. Without data in the window the condition clears, unless the aggregate is `count`.
+	Evaluate *MonitorEvaluation `json:"evaluate,omitempty"`
+
+	// The event of the alert, the name of the monitor when empty.
+	Event *string `json:"event,omitempty"`
+
+	// How far back beyond the threshold the value must go for the alert to resolve.
+	Hysteresis *float64 `json:"hysteresis,omitempty"`
+
+	// Seconds between evaluations on `schedule`.
+	Interval *int32         `json:"interval,omitempty"`
+	Kind     MonitorKind    `json:"kind"`
+	Name     string         `json:"name"`
+	Severity *AlertSeverity `json:"severity,omitempty"`
+
+	// The limit of the value, or of its rate of change per second for `rate`.
+	Threshold float64 `json:"threshold"`
+
+	// Reference to a Time series
+	Timeseries string `json:"timeseries"`
+
+	// Seconds of data to aggregate.
+	Window *int32 `json:"window,omitempty"`
+}
+
+// NewNotificationChannel defines model for NewNotificationChannel.
+type NewNotificationChannel struct {
+	// Settings of a channel, which ones are used depends on the kind. `body`, `subject` and `text` are Go templates executed with the notification, see docs/alert_notifications.md. The password, the headers and the URL of a `slack` channel are never returned; an update without them keeps the stored values.
+	Config  NotificationChannelConfig `json:"config"`
+	Enabled *bool                     `json:"enabled,omitempty"`
+	Kind    NotificationChannelKind   `json:"kind"`
+	Name    string                    `json:"name"`
+}
+
+// NewNotificationRule defines model for NewNotificationRule.
+type NewNotificationRule struct {
+	// Reference to a notification channel
+	Channel     string    `json:"channel"`
+	Enabled     *bool     `json:"enabled,omitempty"`
+	Environment *[]string `json:"environment,omitempty"`
+	Name        string    `json:"name"`
+
+	// Shell pattern the resource must match, e.g. `meter-*`.
+	Resource *string          `json:"resource,omitempty"`
+	Service  *[]string        `json:"service,omitempty"`
+	Severity *[]AlertSeverity `json:"severity,omitempty"`
+	Tags     *[]string        `json:"tags,omitempty"`
+
+	// The changes to notify of. All of them by default.
+	Triggers *[]NotificationTrigger `json:"triggers,omitempty"`
+}
+
 // NewPolicy defines model for NewPolicy.
 type NewPolicy struct {
 	Action    NewPolicyAction `json:"action"`
@@ -499,19 +1603,58 @@ type NewProgram struct {
 
 // NewThing defines model for NewThing.
 type NewThing struct {
+	// A GeoJSON Polygon in WGS 84
+	Area *Polygon `json:"area,omitempty"`
+
+	// A JSON object with structured data such as serial numbers or coordinates. The attributes of a Thing are validated against the JSON Schema for its type, if one exists.
+	Attributes *Attributes `json:"attributes,omitempty"`
+
+	// A position in WGS 84
+	Location *Location `json:"location,omitempty"`
+
 	// Name of the thing
 	Name string `json:"name"`
 
 	// An array of text labels (tags) for tracking and filtering purposes.
 	Tags *[]string `json:"tags,omitempty"`
 
+	// UUID of a Thing template. The time series and datasets of the template are created together with the Thing. The type of the Thing defaults to the type of the template.
+	Template *string `json:"template,omitempty"`
+
 	// Thing type declaration
 	Type *string `json:"type,omitempty"`
 }
 
+// NewThingChild defines model for NewThingChild.
+type NewThingChild struct {
+	// Policies on the parent Thing also apply to the child.
+	InheritPolicies *bool `json:"inherit_policies,omitempty"`
+
+	// The UUID of the child Thing.
+	Uuid string `json:"uuid"`
+}
+
+// NewThingStateTransitions defines model for NewThingStateTransitions.
+type NewThingStateTransitions ThingStateTransitions
+
+// NewThingTemplate defines model for NewThingTemplate.
+type NewThingTemplate struct {
+	Datasets   *[]ThingTemplateDataset    `json:"datasets,omitempty"`
+	Name       string                     `json:"name"`
+	Timeseries *[]ThingTemplateTimeseries `json:"timeseries,omitempty"`
+
+	// The type of Things created from the template
+	Type string `json:"type"`
+}
+
+// NewThingTypeSchema defines model for NewThingTypeSchema.
+type NewThingTypeSchema ThingTypeSchema
+
 // NewTimeseries defines model for NewTimeseries.
 type NewTimeseries struct {
-	LowerBound *float64 `json:"lower_bound,omitempty"`
+	// A JSON object with structured data such as serial numbers or coordinates. The attributes of a Thing are validated against the JSON Schema for its type, if one exists.
+	Attributes *Attributes `json:"attributes,omitempty"`
+	LowerBound *float64    `json:"lower_bound,omitempty"`
 
 	// Name of the time series
 	Name string `json:"name"`
@@ -525,6 +1668,26 @@ type NewTimeseries struct {
 	UpperBound *float64 `json:"upper_bound,omitempty"`
 }
 
+// NewTimeseriesHealthRule defines model for NewTimeseriesHealthRule.
+type NewTimeseriesHealthRule struct {
+	Enabled     *bool   `json:"enabled,omitempty"`
+	Environment *string `json:"environment,omitempty"`
+
+	// Seconds between the values of a time series, at the most. No check of stale data when null.
+	ExpectedInterval *int32 `json:"expected_interval"`
+
+	// Seconds the value of a time series may stay the same, at the most. No check of flatlines when null.
+	Flatline *int32         `json:"flatline"`
+	Name     string         `json:"name"`
+	Severity *AlertSeverity `json:"severity,omitempty"`
+
+	// The rule applies to every time series with this tag.
+	Tag *string `json:"tag,omitempty"`
+
+	// Reference to a Time series. Either this or `tag` is set.
+	Timeseries *string `json:"timeseries,omitempty"`
+}
+
 // NewToken defines model for NewToken.
 type NewToken struct {
 	// Name/label for the Token
@@ -534,12 +1697,24 @@ type NewToken struct {
 // NewTsData defines model for NewTsData.
 type NewTsData []TsRow
 
+// NewTsImport defines model for NewTsImport.
+type NewTsImport TsImportMapping
+
 // NewUser defines model for NewUser.
 type NewUser struct {
 	// Name of the user
 	Name string `json:"name"`
 }
 
+// RunProgram defines model for RunProgram.
+type RunProgram struct {
+	// Run the program at once and reply with the result, without recording the run.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// The code revision to run. Without it the latest signed revision is run. Running a revision which is not signed requires the right to update the program.
+	Revision *int `json:"revision,omitempty"`
+}
+
 // UpdateAlert defines model for UpdateAlert.
 type UpdateAlert struct {
 	Description *string `json:"description,omitempty"`
@@ -558,12 +1733,40 @@ type UpdateAlert struct {
 	Value    *string        `json:"value,omitempty"`
 }
 
+// UpdateAlertGroup defines model for UpdateAlertGroup.
+type UpdateAlertGroup struct {
+	Enabled *bool            `json:"enabled,omitempty"`
+	Keys    *[]AlertGroupKey `json:"keys,omitempty"`
+	Name    *string          `json:"name,omitempty"`
+
+	// Required with the `tag` key; the first tag of an alert with this prefix is its value.
+	TagPrefix *string `json:"tag_prefix,omitempty"`
+}
+
+// UpdateAlertSilence defines model for UpdateAlertSilence.
+type UpdateAlertSilence struct {
+	Comment     *string    `json:"comment,omitempty"`
+	Ends        *time.Time `json:"ends,omitempty"`
+	Environment *[]string  `json:"environment,omitempty"`
+	Event       *[]string  `json:"event,omitempty"`
+
+	// Limits a silence to a window of time on the weekdays, e.g. every Sunday 02:00 to 04:00. A window ending before it starts ends on the next day, and one ending when it starts lasts the whole day.
+	Recurrence *AlertSilenceRecurrence `json:"recurrence"`
+	Resource   *[]string               `json:"resource,omitempty"`
+	Service    *[]string               `json:"service,omitempty"`
+	Starts     *time.Time              `json:"starts,omitempty"`
+	Tags       *[]string               `json:"tags,omitempty"`
+}
+
 // The max allowed size of the complete request body is 1048576 bytes (1 MB). Performing a request with a Content-Length over this limit will result in a 400, malformed request error.
 type UpdateDataset struct {
 	// Base64 encoded content. Used for smaller uploads.
 	Content *[]byte              `json:"content,omitempty"`
 	Format  *UpdateDatasetFormat `json:"format,omitempty"`
-	Name    *string              `json:"name,omitempty"`
+
+	// The number of content revisions to keep. Zero keeps every revision.
+	MaxRevisions *int    `json:"max_revisions,omitempty"`
+	Name         *string `json:"name,omitempty"`
 
 	// An array of text labels (tags) for tracking and filtering purposes.
 	Tags *[]string `json:"tags,omitempty"`
@@ -577,6 +1780,59 @@ type UpdateGroup struct {
 	Name string `json:"name"`
 }
 
+// UpdateMonitor defines model for UpdateMonitor.
+type UpdateMonitor struct {
+	Aggregate   *MonitorAggregate `json:"aggregate,omitempty"`
+	Enabled     *bool             `json:"enabled,omitempty"`
+	Environment *string           `json:"environment,omitempty"`
+
+	// Evaluate when data is added to the time series, or every interval. Without data in the window the condition clears, unless the aggregate is `count`.
+	Evaluate *MonitorEvaluation `json:"evaluate,omitempty"`
+
+	// The event of the alert, the name of the monitor when empty.
+	Event *string `json:"event,omitempty"`
+
+	// How far back beyond the threshold the value must go for the alert to resolve.
+	Hysteresis *float64 `json:"hysteresis,omitempty"`
+
+	// Seconds between evaluations on `schedule`.
+	Interval *int32         `json:"interval,omitempty"`
+	Kind     *MonitorKind   `json:"kind,omitempty"`
+	Name     *string        `json:"name,omitempty"`
+	Severity *AlertSeverity `json:"severity,omitempty"`
+
+	// The limit of the value, or of its rate of change per second for `rate`.
+	Threshold *float64 `json:"threshold,omitempty"`
+
+	// Seconds of data to aggregate.
+	Window *int32 `json:"window,omitempty"`
+}
+
+// UpdateNotificationChannel defines model for UpdateNotificationChannel.
+type UpdateNotificationChannel struct {
+	// Settings of a channel, which ones are used depends on the kind. `body`, `subject` and `text` are Go templates executed with the notification, see docs/alert_notifications.md. The password, the headers and the URL of a `slack` channel are never returned; an update without them keeps the stored values.
+	Config  *NotificationChannelConfig `json:"config,omitempty"`
+	Enabled *bool                      `json:"enabled,omitempty"`
+	Kind    *NotificationChannelKind   `json:"kind,omitempty"`
+	Name    *string                    `json:"name,omitempty"`
+}
+
+// UpdateNotificationRule defines model for UpdateNotificationRule.
+type UpdateNotificationRule struct {
+	// Reference to a notification channel
+	Channel     *string   `json:"channel,omitempty"`
+	Enabled     *bool     `json:"enabled,omitempty"`
+	Environment *[]string `json:"environment,omitempty"`
+	Name        *string   `json:"name,omitempty"`
+
+	// Shell pattern the resource must match, e.g. `meter-*`.
+	Resource *string                `json:"resource,omitempty"`
+	Service  *[]string              `json:"service,omitempty"`
+	Severity *[]AlertSeverity       `json:"severity,omitempty"`
+	Tags     *[]string              `json:"tags,omitempty"`
+	Triggers *[]NotificationTrigger `json:"triggers,omitempty"`
+}
+
 // UpdatePolicy defines model for UpdatePolicy.
 type UpdatePolicy struct {
 	Action    *UpdatePolicyAction `json:"action,omitempty"`
@@ -611,12 +1867,24 @@ type UpdateRequestRate struct {
 
 // UpdateThing defines model for UpdateThing.
 type UpdateThing struct {
+	// A GeoJSON Polygon in WGS 84
+	Area *Polygon `json:"area,omitempty"`
+
+	// A JSON object with structured data such as serial numbers or coordinates. The attributes of a Thing are validated against the JSON Schema for its type, if one exists.
+	Attributes *Attributes `json:"attributes,omitempty"`
+
+	// A position in WGS 84
+	Location *Location `json:"location,omitempty"`
+
 	// The name of the Thing.
 	Name *string `json:"name,omitempty"`
 
 	// The state of the thing.
 	State *UpdateThingState `json:"state,omitempty"`
 
+	// Why the state changed. Recorded in the state history of the Thing.
+	StateReason *string `json:"state_reason,omitempty"`
+
 	// An array of text labels (tags) for tracking and filtering purposes.
 	Tags *[]string `json:"tags,omitempty"`
 
@@ -624,8 +1892,24 @@ type UpdateThing struct {
 	Type *string `json:"type"`
 }
 
+// UpdateThingTemplate defines model for UpdateThingTemplate.
+type UpdateThingTemplate struct {
+	// Replaces the list of datasets.
+	Datasets *[]ThingTemplateDataset `json:"datasets,omitempty"`
+	Name     *string                 `json:"name,omitempty"`
+
+	// Replaces the list of time series.
+	Timeseries *[]ThingTemplateTimeseries `json:"timeseries,omitempty"`
+
+	// The type of Things created from the template
+	Type *string `json:"type,omitempty"`
+}
+
 // UpdateTimeseries defines model for UpdateTimeseries.
 type UpdateTimeseries struct {
+	// A JSON object with structured data such as serial numbers or coordinates. The attributes of a Thing are validated against the JSON Schema for its type, if one exists.
+	Attributes *Attributes `json:"attributes,omitempty"`
+
 	// An optional lower bound at which values are accepted and stored. Values *less* than this will be rejected.
 	LowerBound *float64 `json:"lower_bound"`
 
@@ -645,6 +1929,26 @@ type UpdateTimeseries struct {
 	UpperBound *float64 `json:"upper_bound"`
 }
 
+// UpdateTimeseriesHealthRule defines model for UpdateTimeseriesHealthRule.
+type UpdateTimeseriesHealthRule struct {
+	Enabled     *bool   `json:"enabled,omitempty"`
+	Environment *string `json:"environment,omitempty"`
+
+	// Seconds between the values of a time series, at the most. No check of stale data when null.
+	ExpectedInterval *int32 `json:"expected_interval"`
+
+	// Seconds the value of a time series may stay the same, at the most. No check of flatlines when null.
+	Flatline *int32         `json:"flatline"`
+	Name     *string        `json:"name,omitempty"`
+	Severity *AlertSeverity `json:"severity,omitempty"`
+
+	// The rule applies to every time series with this tag.
+	Tag *string `json:"tag,omitempty"`
+
+	// Reference to a Time series. Either this or `tag` is set.
+	Timeseries *string `json:"timeseries,omitempty"`
+}
+
 // UpdateUser defines model for UpdateUser.
 type UpdateUser struct {
 	// Set the user groups. This parameter is incompatible with `groups_add` and `groups_remove`.
@@ -658,6 +1962,15 @@ type UpdateUser struct {
 	Name         *string   `json:"name,omitempty"`
 }
 
+// FindAlertGroupsParams defines parameters for FindAlertGroups.
+type FindAlertGroupsParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+}
+
 // FindAlertsParams defines parameters for FindAlerts.
 type FindAlertsParams struct {
 	// The number of items to skip before starting to collect the result set.
@@ -695,6 +2008,18 @@ type FindAlertsParams struct {
 
 	// Array of services to match on
 	Service *ServiceFilterParam `json:"service,omitempty"`
+
+	// Only silenced alerts when true, or no silenced alerts when false
+	Silenced *SilencedFilterParam `json:"silenced,omitempty"`
+}
+
+// FindAlertHistoryParams defines parameters for FindAlertHistory.
+type FindAlertHistoryParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
 }
 
 // FindDatasetsParams defines parameters for FindDatasets.
@@ -722,6 +2047,30 @@ type AssembleDatasetPartsByKeyParams struct {
 	ContentMD5 string `json:"Content-MD5"`
 }
 
+// GetDatasetRevisionsDiffParams defines parameters for GetDatasetRevisionsDiff.
+type GetDatasetRevisionsDiffParams struct {
+	// Revision A
+	RevA int `json:"rev_a"`
+
+	// Revision B
+	RevB int `json:"rev_b"`
+}
+
+// GetDatasetDocumentParams defines parameters for GetDatasetDocument.
+type GetDatasetDocumentParams struct {
+	// A JSON pointer (RFC 6901) to the part of the document to return. The whole document when empty.
+	Pointer *string `json:"pointer,omitempty"`
+
+	// The format to return the document in. The format of the dataset when not set.
+	Format *string `json:"format,omitempty"`
+}
+
+// ImportDatasetIntoTimeseriesParams defines parameters for ImportDatasetIntoTimeseries.
+type ImportDatasetIntoTimeseriesParams struct {
+	// Validate the request and report the result without making any changes.
+	DryRun *DryRunParam `json:"dry_run,omitempty"`
+}
+
 // ListDatasetPartsByKeyParams defines parameters for ListDatasetPartsByKey.
 type ListDatasetPartsByKeyParams struct {
 	Key string `json:"key"`
@@ -742,6 +2091,19 @@ type GetRawDatasetByUuidParams struct {
 	// a 304 Not Modified status, without a body, which tells the client
 	// that the cached version of the response is still good to use (fresh).
 	IfNoneMatch *IfNoneMatchParam `json:"If-None-Match,omitempty"`
+
+	// An HTTP-date. The server sends back a 304 Not Modified status when the
+	// resource has not been modified since. Ignored when If-None-Match is set.
+	IfModifiedSince *IfModifiedSinceParam `json:"If-Modified-Since,omitempty"`
+
+	// A single byte range of the content to return, as in "bytes=0-1023",
+	// "bytes=1024-" or "bytes=-512" (the last 512 bytes). Requests with
+	// several ranges get the full content.
+	Range *RangeParam `json:"Range,omitempty"`
+
+	// An ETag or an HTTP-date. The Range is only used when the content still
+	// matches, otherwise the full content is sent.
+	IfRange *IfRangeParam `json:"If-Range,omitempty"`
 }
 
 // DeleteDatasetUploadByKeyParams defines parameters for DeleteDatasetUploadByKey.
@@ -758,6 +2120,105 @@ type FindGroupsParams struct {
 	Offset *OffsetParam `json:"offset,omitempty"`
 }
 
+// FindIncidentsParams defines parameters for FindIncidents.
+type FindIncidentsParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+
+	// Only incidents with this status
+	Status *IncidentStatus `json:"status,omitempty"`
+
+	// Only incidents of this alert group
+	Group *string `json:"group,omitempty"`
+}
+
+// FindIncidentAlertsParams defines parameters for FindIncidentAlerts.
+type FindIncidentAlertsParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+}
+
+// FindIncidentHistoryParams defines parameters for FindIncidentHistory.
+type FindIncidentHistoryParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+}
+
+// FindProgramJobsParams defines parameters for FindProgramJobs.
+type FindProgramJobsParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+
+	// Only jobs with this status
+	Status *ProgramJobStatus `json:"status,omitempty"`
+
+	// Only jobs of this program
+	Program *string `json:"program,omitempty"`
+}
+
+// FindMonitorsParams defines parameters for FindMonitors.
+type FindMonitorsParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+
+	// Only the monitors of this Time series
+	Timeseries *string `json:"timeseries,omitempty"`
+}
+
+// FindNotificationChannelsParams defines parameters for FindNotificationChannels.
+type FindNotificationChannelsParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+}
+
+// FindNotificationDeliveriesParams defines parameters for FindNotificationDeliveries.
+type FindNotificationDeliveriesParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+
+	// Only deliveries to this notification channel
+	Channel *string `json:"channel,omitempty"`
+
+	// Only deliveries of this alert
+	Alert *string `json:"alert,omitempty"`
+
+	// Only deliveries with this status
+	Status *NotificationDeliveryStatus `json:"status,omitempty"`
+
+	// Only deliveries of this incident
+	Incident *string `json:"incident,omitempty"`
+}
+
+// FindNotificationRulesParams defines parameters for FindNotificationRules.
+type FindNotificationRulesParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+}
+
 // FindPoliciesParams defines parameters for FindPolicies.
 type FindPoliciesParams struct {
 	// The number of items to skip before starting to collect the result set.
@@ -821,6 +2282,45 @@ type GetProgramCodeRevisionsDiffParams struct {
 	RevB int `json:"rev_b"`
 }
 
+// FindProgramRunsParams defines parameters for FindProgramRuns.
+type FindProgramRunsParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+
+	// Only runs with this outcome
+	Outcome *ProgramRunOutcome `json:"outcome,omitempty"`
+
+	// Only runs started at or after this time
+	Start *time.Time `json:"start,omitempty"`
+
+	// Only runs started at or before this time
+	End *time.Time `json:"end,omitempty"`
+}
+
+// FindSilencesParams defines parameters for FindSilences.
+type FindSilencesParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+
+	// Include silences which have ended
+	Expired *bool `json:"expired,omitempty"`
+}
+
+// FindThingTemplatesParams defines parameters for FindThingTemplates.
+type FindThingTemplatesParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+}
+
 // FindThingsParams defines parameters for FindThings.
 type FindThingsParams struct {
 	// The numbers of items to return.
@@ -831,11 +2331,68 @@ type FindThingsParams struct {
 
 	// Array of tags to match on
 	Tags *TagsFilterParam `json:"tags,omitempty"`
+
+	// A JSON object the attributes must contain to match
+	Attributes *AttributesFilterParam `json:"attributes,omitempty"`
+
+	// Only include items located within a bounding box given as min longitude, min latitude, max longitude, max latitude (WGS 84).
+	Bbox *BboxParam `json:"bbox,omitempty"`
+
+	// Only include items located within `radius` meters of a position given as longitude, latitude (WGS 84).
+	Near *NearParam `json:"near,omitempty"`
+
+	// Search radius in meters, used together with `near`.
+	Radius *RadiusParam `json:"radius,omitempty"`
+
+	// Set to `geojson` to return a GeoJSON FeatureCollection.
+	Format *FindThingsParamsFormat `json:"format,omitempty"`
 }
 
+// FindThingsParamsFormat defines parameters for FindThings.
+type FindThingsParamsFormat string
+
 // UpdateThingByUuidJSONBodyState defines parameters for UpdateThingByUuid.
 type UpdateThingByUuidJSONBodyState string
 
+// FindAncestorsForThingParams defines parameters for FindAncestorsForThing.
+type FindAncestorsForThingParams struct {
+	// The maximum number of levels to traverse. Defaults to no limit.
+	MaxDepth *MaxDepthParam `json:"max_depth,omitempty"`
+}
+
+// FindDescendantsForThingParams defines parameters for FindDescendantsForThing.
+type FindDescendantsForThingParams struct {
+	// The maximum number of levels to traverse. Defaults to no limit.
+	MaxDepth *MaxDepthParam `json:"max_depth,omitempty"`
+}
+
+// FindStateHistoryForThingParams defines parameters for FindStateHistoryForThing.
+type FindStateHistoryForThingParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+}
+
+// FindSubtreeForThingParams defines parameters for FindSubtreeForThing.
+type FindSubtreeForThingParams struct {
+	// The maximum number of levels to traverse. Defaults to no limit.
+	MaxDepth *MaxDepthParam `json:"max_depth,omitempty"`
+}
+
+// DeleteThingTypeSchemaParams defines parameters for DeleteThingTypeSchema.
+type DeleteThingTypeSchemaParams struct {
+	// The Thing type
+	Type ThingTypeParam `json:"type"`
+}
+
+// DeleteThingStateTransitionsParams defines parameters for DeleteThingStateTransitions.
+type DeleteThingStateTransitionsParams struct {
+	// The Thing type
+	Type ThingTypeParam `json:"type"`
+}
+
 // FindTimeSeriesParams defines parameters for FindTimeSeries.
 type FindTimeSeriesParams struct {
 	// The numbers of items to return.
@@ -846,6 +2403,9 @@ type FindTimeSeriesParams struct {
 
 	// Array of tags to match on
 	Tags *TagsFilterParam `json:"tags,omitempty"`
+
+	// A JSON object the attributes must contain to match
+	Attributes *AttributesFilterParam `json:"attributes,omitempty"`
 }
 
 // DeleteDataFromTimeSeriesParams defines parameters for DeleteDataFromTimeSeries.
@@ -900,6 +2460,54 @@ type QueryTimeseriesForDataParamsAggregate string
 type AddDataToTimeseriesParams struct {
 	// The SI unit of the result. A cast will occur if the base unit differes.
 	Unit *SiUnitParam `json:"unit,omitempty"`
+
+	// Overwrite the value of existing data points with the same timestamp, instead of failing the request.
+	Overwrite *OverwriteParam `json:"overwrite,omitempty"`
+}
+
+// FindTimeseriesDataHistoryParams defines parameters for FindTimeseriesDataHistory.
+type FindTimeseriesDataHistoryParams struct {
+	// Start (>=) of time period. The period (start to end) can **not** exceed 1 year. Defaults to `now`.
+	Start RangeStartParam `json:"start"`
+
+	// End (<=) of time period. The period (start to end) can **not** exceed 1 year. Defaults to `now`.
+	End RangeEndParam `json:"end"`
+
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+}
+
+// FindTimeseriesHealthParams defines parameters for FindTimeseriesHealth.
+type FindTimeseriesHealthParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+
+	// Only time series in this state
+	State *TimeseriesHealthState `json:"state,omitempty"`
+
+	// Only the time series of this Thing
+	Thing *string `json:"thing,omitempty"`
+}
+
+// FindHealthRulesParams defines parameters for FindHealthRules.
+type FindHealthRulesParams struct {
+	// The numbers of items to return.
+	Limit *LimitParam `json:"limit,omitempty"`
+
+	// The number of items to skip before starting to collect the result set.
+	Offset *OffsetParam `json:"offset,omitempty"`
+}
+
+// ImportTsdataParams defines parameters for ImportTsdata.
+type ImportTsdataParams struct {
+	// Validate the request and report the result without making any changes.
+	DryRun *DryRunParam `json:"dry_run,omitempty"`
 }
 
 // FindTsdataByQueryParams defines parameters for FindTsdataByQuery.
@@ -944,24 +2552,72 @@ type FindUsersParams struct {
 	Offset *OffsetParam `json:"offset,omitempty"`
 }
 
+// AddAlertGroupJSONRequestBody defines body for AddAlertGroup for application/json ContentType.
+type AddAlertGroupJSONRequestBody NewAlertGroup
+
+// UpdateAlertGroupByUuidJSONRequestBody defines body for UpdateAlertGroupByUuid for application/json ContentType.
+type UpdateAlertGroupByUuidJSONRequestBody UpdateAlertGroup
+
 // CreateAlertJSONRequestBody defines body for CreateAlert for application/json ContentType.
 type CreateAlertJSONRequestBody NewAlert
 
 // UpdateAlertByUuidJSONRequestBody defines body for UpdateAlertByUuid for application/json ContentType.
 type UpdateAlertByUuidJSONRequestBody UpdateAlert
 
+// AcknowledgeAlertJSONRequestBody defines body for AcknowledgeAlert for application/json ContentType.
+type AcknowledgeAlertJSONRequestBody AlertAction
+
+// ShelveAlertJSONRequestBody defines body for ShelveAlert for application/json ContentType.
+type ShelveAlertJSONRequestBody AlertAction
+
+// UnacknowledgeAlertJSONRequestBody defines body for UnacknowledgeAlert for application/json ContentType.
+type UnacknowledgeAlertJSONRequestBody AlertAction
+
+// UnshelveAlertJSONRequestBody defines body for UnshelveAlert for application/json ContentType.
+type UnshelveAlertJSONRequestBody AlertAction
+
 // AddDatasetsJSONRequestBody defines body for AddDatasets for application/json ContentType.
 type AddDatasetsJSONRequestBody NewDataset
 
 // UpdateDatasetByUuidJSONRequestBody defines body for UpdateDatasetByUuid for application/json ContentType.
 type UpdateDatasetByUuidJSONRequestBody UpdateDataset
 
+// ImportDatasetIntoTimeseriesJSONRequestBody defines body for ImportDatasetIntoTimeseries for application/json ContentType.
+type ImportDatasetIntoTimeseriesJSONRequestBody NewTsImport
+
+// SetDatasetSchemaJSONRequestBody defines body for SetDatasetSchema for application/json ContentType.
+type SetDatasetSchemaJSONRequestBody NewDatasetSchema
+
 // AddGroupJSONRequestBody defines body for AddGroup for application/json ContentType.
 type AddGroupJSONRequestBody NewGroup
 
 // UpdateGroupByUuidJSONRequestBody defines body for UpdateGroupByUuid for application/json ContentType.
 type UpdateGroupByUuidJSONRequestBody UpdateGroup
 
+// AcknowledgeIncidentJSONRequestBody defines body for AcknowledgeIncident for application/json ContentType.
+type AcknowledgeIncidentJSONRequestBody AlertAction
+
+// CloseIncidentJSONRequestBody defines body for CloseIncident for application/json ContentType.
+type CloseIncidentJSONRequestBody AlertAction
+
+// AddMonitorJSONRequestBody defines body for AddMonitor for application/json ContentType.
+type AddMonitorJSONRequestBody NewMonitor
+
+// UpdateMonitorByUuidJSONRequestBody defines body for UpdateMonitorByUuid for application/json ContentType.
+type UpdateMonitorByUuidJSONRequestBody UpdateMonitor
+
+// AddNotificationChannelJSONRequestBody defines body for AddNotificationChannel for application/json ContentType.
+type AddNotificationChannelJSONRequestBody NewNotificationChannel
+
+// UpdateNotificationChannelByUuidJSONRequestBody defines body for UpdateNotificationChannelByUuid for application/json ContentType.
+type UpdateNotificationChannelByUuidJSONRequestBody UpdateNotificationChannel
+
+// AddNotificationRuleJSONRequestBody defines body for AddNotificationRule for application/json ContentType.
+type AddNotificationRuleJSONRequestBody NewNotificationRule
+
+// UpdateNotificationRuleByUuidJSONRequestBody defines body for UpdateNotificationRuleByUuid for application/json ContentType.
+type UpdateNotificationRuleByUuidJSONRequestBody UpdateNotificationRule
+
 // AddPolicyJSONRequestBody defines body for AddPolicy for application/json ContentType.
 type AddPolicyJSONRequestBody NewPolicy
 
@@ -974,12 +2630,36 @@ type AddProgramJSONRequestBody NewProgram
 // UpdateProgramByUuidJSONRequestBody defines body for UpdateProgramByUuid for application/json ContentType.
 type UpdateProgramByUuidJSONRequestBody UpdateProgram
 
+// RunProgramJSONRequestBody defines body for RunProgram for application/json ContentType.
+type RunProgramJSONRequestBody RunProgram
+
+// AddSilenceJSONRequestBody defines body for AddSilence for application/json ContentType.
+type AddSilenceJSONRequestBody NewAlertSilence
+
+// UpdateSilenceByUuidJSONRequestBody defines body for UpdateSilenceByUuid for application/json ContentType.
+type UpdateSilenceByUuidJSONRequestBody UpdateAlertSilence
+
+// AddThingTemplateJSONRequestBody defines body for AddThingTemplate for application/json ContentType.
+type AddThingTemplateJSONRequestBody NewThingTemplate
+
+// UpdateThingTemplateByUuidJSONRequestBody defines body for UpdateThingTemplateByUuid for application/json ContentType.
+type UpdateThingTemplateByUuidJSONRequestBody UpdateThingTemplate
+
 // AddThingJSONRequestBody defines body for AddThing for application/json ContentType.
 type AddThingJSONRequestBody NewThing
 
 // UpdateThingByUuidJSONRequestBody defines body for UpdateThingByUuid for application/json ContentType.
 type UpdateThingByUuidJSONRequestBody UpdateThing
 
+// AddChildToThingJSONRequestBody defines body for AddChildToThing for application/json ContentType.
+type AddChildToThingJSONRequestBody NewThingChild
+
+// SetThingTypeSchemaJSONRequestBody defines body for SetThingTypeSchema for application/json ContentType.
+type SetThingTypeSchemaJSONRequestBody NewThingTypeSchema
+
+// SetThingStateTransitionsJSONRequestBody defines body for SetThingStateTransitions for application/json ContentType.
+type SetThingStateTransitionsJSONRequestBody NewThingStateTransitions
+
 // AddTimeSeriesJSONRequestBody defines body for AddTimeSeries for application/json ContentType.
 type AddTimeSeriesJSONRequestBody NewTimeseries
 
@@ -989,6 +2669,12 @@ type UpdateTimeseriesByUuidJSONRequestBody UpdateTimeseries
 // AddDataToTimeseriesJSONRequestBody defines body for AddDataToTimeseries for application/json ContentType.
 type AddDataToTimeseriesJSONRequestBody NewTsData
 
+// AddHealthRuleJSONRequestBody defines body for AddHealthRule for application/json ContentType.
+type AddHealthRuleJSONRequestBody NewTimeseriesHealthRule
+
+// UpdateHealthRuleByUuidJSONRequestBody defines body for UpdateHealthRuleByUuid for application/json ContentType.
+type UpdateHealthRuleByUuidJSONRequestBody UpdateTimeseriesHealthRule
+
 // AddUserJSONRequestBody defines body for AddUser for application/json ContentType.
 type AddUserJSONRequestBody NewUser
 
@@ -1000,3 +2686,268 @@ type SetRequestRateForUserJSONRequestBody UpdateRequestRate
 
 // AddNewTokenToUserJSONRequestBody defines body for AddNewTokenToUser for application/json ContentType.
 type AddNewTokenToUserJSONRequestBody NewToken
+
+// Getter for additional properties for Attributes. Returns the specified
+// element and whether it was found
+func (a Attributes) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Attributes
+func (a *Attributes) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Attributes to handle AdditionalProperties
+func (a *Attributes) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Attributes to handle AdditionalProperties
+func (a Attributes) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for DatasetSchema. Returns the specified
+// element and whether it was found
+func (a DatasetSchema) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for DatasetSchema
+func (a *DatasetSchema) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for DatasetSchema to handle AdditionalProperties
+func (a *DatasetSchema) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for DatasetSchema to handle AdditionalProperties
+func (a DatasetSchema) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for NotificationChannelConfig_Headers. Returns the specified
+// element and whether it was found
+func (a NotificationChannelConfig_Headers) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for NotificationChannelConfig_Headers
+func (a *NotificationChannelConfig_Headers) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for NotificationChannelConfig_Headers to handle AdditionalProperties
+func (a *NotificationChannelConfig_Headers) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for NotificationChannelConfig_Headers to handle AdditionalProperties
+func (a NotificationChannelConfig_Headers) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for NotificationDelivery_Payload. Returns the specified
+// element and whether it was found
+func (a NotificationDelivery_Payload) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for NotificationDelivery_Payload
+func (a *NotificationDelivery_Payload) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for NotificationDelivery_Payload to handle AdditionalProperties
+func (a *NotificationDelivery_Payload) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for NotificationDelivery_Payload to handle AdditionalProperties
+func (a NotificationDelivery_Payload) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for ThingTypeSchema_Schema. Returns the specified
+// element and whether it was found
+func (a ThingTypeSchema_Schema) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ThingTypeSchema_Schema
+func (a *ThingTypeSchema_Schema) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ThingTypeSchema_Schema to handle AdditionalProperties
+func (a *ThingTypeSchema_Schema) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ThingTypeSchema_Schema to handle AdditionalProperties
+func (a ThingTypeSchema_Schema) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
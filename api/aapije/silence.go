@@ -0,0 +1,230 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package aapije
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/internal/services"
+)
+
+// AddSilence adds a new alert silence
+func (ra *RestApi) AddSilence(w http.ResponseWriter, r *http.Request) {
+	// We expect a NewAlertSilence object in the request body.
+	var n rest.NewAlertSilence
+	if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+
+	author, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidAPIKey)
+		return
+	}
+
+	params := &services.AddAlertSilenceParams{
+		Starts:     n.Starts,
+		Ends:       n.Ends,
+		Recurrence: n.Recurrence,
+		CreatedBy:  author,
+	}
+	if n.Comment != nil {
+		params.Comment = *n.Comment
+	}
+	if n.Resource != nil {
+		params.Resource = *n.Resource
+	}
+	if n.Environment != nil {
+		params.Environment = *n.Environment
+	}
+	if n.Event != nil {
+		params.Event = *n.Event
+	}
+	if n.Service != nil {
+		params.Service = *n.Service
+	}
+	if n.Tags != nil {
+		params.Tags = *n.Tags
+	}
+
+	svc := services.NewSilenceService(db)
+
+	silence, err := svc.AddSilence(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(silence)
+}
+
+// FindSilences lists all alert silences
+func (ra *RestApi) FindSilences(w http.ResponseWriter, r *http.Request, p rest.FindSilencesParams) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.FindAlertSilencesParams{}
+	params.Limit.Scan((*int64)(p.Limit))
+	params.Offset.Scan((*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+	if p.Expired != nil {
+		params.IncludeExpired = *p.Expired
+	}
+
+	svc := services.NewSilenceService(db)
+
+	silences, err := svc.FindSilences(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(silences)
+}
+
+// FindSilenceByUuid returns a specific alert silence by its UUID
+func (ra *RestApi) FindSilenceByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	silenceUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewSilenceService(db)
+
+	silence, err := svc.FindSilenceByUuid(r.Context(), silenceUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(silence)
+}
+
+// UpdateSilenceByUuid updates a specific alert silence by its UUID
+func (ra *RestApi) UpdateSilenceByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	silenceUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	// We expect a UpdateAlertSilence object in the request body.
+	var upd rest.UpdateAlertSilence
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&upd); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	// A null recurrence removes it, while leaving it out keeps it as is
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+	_, setRecurrence := fields["recurrence"]
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewSilenceService(db)
+
+	count, err := svc.UpdateSilenceByUuid(r.Context(), services.UpdateAlertSilenceParams{
+		Uuid:          silenceUUID,
+		Comment:       upd.Comment,
+		Resource:      upd.Resource,
+		Environment:   upd.Environment,
+		Event:         upd.Event,
+		Service:       upd.Service,
+		Tags:          upd.Tags,
+		Starts:        upd.Starts,
+		Ends:          upd.Ends,
+		SetRecurrence: setRecurrence,
+		Recurrence:    upd.Recurrence,
+	})
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteSilenceByUuid deletes a specific alert silence by its UUID
+func (ra *RestApi) DeleteSilenceByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	silenceUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewSilenceService(db)
+
+	count, err := svc.DeleteSilence(r.Context(), silenceUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
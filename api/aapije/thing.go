@@ -6,6 +6,7 @@ package aapije
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -44,16 +45,68 @@ func (ra *RestApi) AddThing(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s := services.NewThingService(db)
+	store, err := ra.GetStore(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	compression, err := ra.GetCompression(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	datasets := services.NewDatasetService(db).WithStore(store).WithCompression(compression)
+	s := services.NewThingService(db).WithDatasets(datasets)
 
 	params := &services.AddThingParams{
 		Name:      n.Name,
 		Type:      n.Type,
 		CreatedBy: &author,
+		Location:  n.Location,
+		Area:      n.Area,
 	}
 	if n.Tags != nil {
 		params.Tags = *n.Tags
 	}
+	if n.Attributes != nil {
+		params.Attributes, err = json.Marshal(n.Attributes)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+			return
+		}
+	}
+	if n.Template != nil {
+		templateUUID, err := uuid.Parse(*n.Template)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+			return
+		}
+		params.Template = &templateUUID
+
+		check := services.NewPolicyCheckService(db)
+		access, err := check.UserHasAccessViaToken(r.Context(), []byte(domaintoken.Token), "read", "templates/"+templateUUID.String())
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		} else if access == false {
+			ie.SendHTTPError(w, ie.ErrorForbidden)
+			return
+		}
+
+		// The template creates time series and datasets on behalf of the user
+		for _, resource := range []string{"timeseries", "datasets"} {
+			access, err := check.UserHasAccessViaToken(r.Context(), []byte(domaintoken.Token), "create", resource)
+			if err != nil {
+				ie.SendHTTPError(w, ie.ParseDBError(err))
+				return
+			} else if access == false {
+				ie.SendHTTPError(w, ie.ErrorForbidden)
+				return
+			}
+		}
+	}
 
 	// Add the thing
 	thing, err := s.AddThing(r.Context(), params)
@@ -83,15 +136,76 @@ func (ra *RestApi) FindThings(w http.ResponseWriter, r *http.Request, p rest.Fin
 		return
 	}
 
+	attributes, err := parseAttributesFilter(p.Attributes)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	var geo *services.GeoFilter
+	if p.Bbox != nil && p.Near != nil {
+		ie.SendHTTPError(w, ie.NewBadRequestError(errors.New("bbox and near can not be combined")))
+		return
+	} else if p.Bbox != nil {
+		b := *p.Bbox
+		if len(b) != 4 {
+			ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+			return
+		}
+		geo = &services.GeoFilter{
+			MinLon: b[0],
+			MinLat: b[1],
+			MaxLon: b[2],
+			MaxLat: b[3],
+		}
+	} else if p.Near != nil {
+		n := *p.Near
+		if len(n) != 2 {
+			ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+			return
+		}
+		radius := 1000.0
+		if p.Radius != nil {
+			radius = float64(*p.Radius)
+		}
+		f := services.NewGeoRadiusFilter(n[0], n[1], radius)
+		geo = &f
+	}
+
 	svc := services.NewThingService(db)
 
-	if p.Tags != nil {
+	if geo != nil {
+		var tags []string
+		if p.Tags != nil {
+			tags = *p.Tags
+		}
+
+		params := services.NewFindByTagsParams(
+			[]byte(domaintoken.Token),
+			tags,
+			(*int64)(p.Limit),
+			(*int64)(p.Offset))
+
+		params.Attributes = attributes
+
+		if params.Limit.Value == 0 {
+			params.Limit.Value = 20
+		}
+
+		things, err = svc.FindWithin(r.Context(), params, *geo)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		}
+	} else if p.Tags != nil {
 		params := services.NewFindByTagsParams(
 			[]byte(domaintoken.Token),
 			*p.Tags,
 			(*int64)(p.Limit),
 			(*int64)(p.Offset))
 
+		params.Attributes = attributes
+
 		if params.Limit.Value == 0 {
 			params.Limit.Value = 20
 		}
@@ -107,6 +221,8 @@ func (ra *RestApi) FindThings(w http.ResponseWriter, r *http.Request, p rest.Fin
 			(*int64)(p.Limit),
 			(*int64)(p.Offset))
 
+		params.Attributes = attributes
+
 		if params.Limit.Value == 0 {
 			params.Limit.Value = 20
 		}
@@ -118,10 +234,49 @@ func (ra *RestApi) FindThings(w http.ResponseWriter, r *http.Request, p rest.Fin
 		}
 	}
 
+	if p.Format != nil && string(*p.Format) == string(rest.Geojson) {
+		w.Header().Set("Content-Type", "application/geo+json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(newThingFeatureCollection(things))
+		return
+	}
+
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(things)
 }
 
+// newThingFeatureCollection returns things as a GeoJSON FeatureCollection
+func newThingFeatureCollection(things []*rest.Thing) rest.ThingFeatureCollection {
+	fc := rest.ThingFeatureCollection{
+		Type:     rest.ThingFeatureCollectionTypeFeatureCollection,
+		Features: make([]rest.ThingFeature, 0, len(things)),
+	}
+
+	for _, t := range things {
+		f := rest.ThingFeature{
+			Type:       rest.ThingFeatureTypeFeature,
+			Id:         t.Uuid,
+			Properties: *t,
+		}
+
+		if t.Location != nil {
+			f.Geometry = &rest.Geometry{
+				Type:        "Point",
+				Coordinates: []float64{t.Location.Longitude, t.Location.Latitude},
+			}
+		} else if t.Area != nil {
+			f.Geometry = &rest.Geometry{
+				Type:        string(t.Area.Type),
+				Coordinates: t.Area.Coordinates,
+			}
+		}
+
+		fc.Features = append(fc.Features, f)
+	}
+
+	return fc
+}
+
 // FindThingByUuid returns a specific thing by its UUID
 func (ra *RestApi) FindThingByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
 	thingUUID, err := uuid.Parse(string(id))
@@ -221,6 +376,19 @@ func (ra *RestApi) UpdateThingByUuid(w http.ResponseWriter, r *http.Request, id
 		return
 	}
 
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+	changedBy, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
 	svc := services.NewThingService(db)
 
 	// We expect a UpdateThing object in the request body.
@@ -231,11 +399,22 @@ func (ra *RestApi) UpdateThingByUuid(w http.ResponseWriter, r *http.Request, id
 	}
 
 	params := services.UpdateThingParams{
-		Uuid:  thingUUID,
-		Name:  obj.Name,
-		Type:  obj.Type,
-		State: (*string)(obj.State),
-		Tags:  obj.Tags,
+		Uuid:        thingUUID,
+		Name:        obj.Name,
+		Type:        obj.Type,
+		State:       (*string)(obj.State),
+		StateReason: obj.StateReason,
+		Tags:        obj.Tags,
+		Location:    obj.Location,
+		Area:        obj.Area,
+		ChangedBy:   changedBy,
+	}
+	if obj.Attributes != nil {
+		params.Attributes, err = json.Marshal(obj.Attributes)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+			return
+		}
 	}
 
 	count, err := svc.UpdateByUuid(r.Context(), params)
@@ -277,3 +456,510 @@ func (ra *RestApi) DeleteThingByUuid(w http.ResponseWriter, r *http.Request, id
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// FindParentsForThing lists the things directly above a thing
+func (ra *RestApi) FindParentsForThing(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	thingUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.FindThingRelativesParams{
+		Uuid:     thingUUID,
+		MaxDepth: 1,
+		Token:    []byte(domaintoken.Token),
+	}
+
+	svc := services.NewThingService(db)
+	things, err := svc.FindAncestors(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(things)
+}
+
+// FindChildrenForThing lists the things directly below a thing
+func (ra *RestApi) FindChildrenForThing(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	thingUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.FindThingRelativesParams{
+		Uuid:     thingUUID,
+		MaxDepth: 1,
+		Token:    []byte(domaintoken.Token),
+	}
+
+	svc := services.NewThingService(db)
+	things, err := svc.FindDescendants(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(things)
+}
+
+// FindAncestorsForThing lists the parents of a thing, their parents and so on
+func (ra *RestApi) FindAncestorsForThing(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.FindAncestorsForThingParams) {
+	thingUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.FindThingRelativesParams{
+		Uuid:  thingUUID,
+		Token: []byte(domaintoken.Token),
+	}
+
+	if p.MaxDepth != nil {
+		params.MaxDepth = int32(*p.MaxDepth)
+	}
+
+	svc := services.NewThingService(db)
+	things, err := svc.FindAncestors(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(things)
+}
+
+// FindStateHistoryForThing lists the state changes of a thing, the most recent first
+func (ra *RestApi) FindStateHistoryForThing(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.FindStateHistoryForThingParams) {
+	thingUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.NewFindByUuidParams(
+		[]byte(domaintoken.Token),
+		thingUUID,
+		(*int64)(p.Limit),
+		(*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	svc := services.NewThingService(db)
+	changes, err := svc.FindStateHistory(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(changes)
+}
+
+// FindDescendantsForThing lists the children of a thing, their children and so on
+func (ra *RestApi) FindDescendantsForThing(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.FindDescendantsForThingParams) {
+	thingUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.FindThingRelativesParams{
+		Uuid:  thingUUID,
+		Token: []byte(domaintoken.Token),
+	}
+
+	if p.MaxDepth != nil {
+		params.MaxDepth = int32(*p.MaxDepth)
+	}
+
+	svc := services.NewThingService(db)
+	things, err := svc.FindDescendants(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(things)
+}
+
+// FindSubtreeForThing returns a thing and its descendants, including time series and datasets
+func (ra *RestApi) FindSubtreeForThing(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.FindSubtreeForThingParams) {
+	thingUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.FindThingRelativesParams{
+		Uuid:  thingUUID,
+		Token: []byte(domaintoken.Token),
+	}
+
+	if p.MaxDepth != nil {
+		params.MaxDepth = int32(*p.MaxDepth)
+	}
+
+	svc := services.NewThingService(db)
+	tree, err := svc.FindSubtree(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(tree)
+}
+
+// AddChildToThing attaches a thing as a child of another thing
+func (ra *RestApi) AddChildToThing(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	thingUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	// We expect a NewThingChild object in the request body.
+	var obj rest.NewThingChild
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	childUUID, err := uuid.Parse(obj.Uuid)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	} else if childUUID == thingUUID {
+		ie.SendHTTPError(w, ie.ErrorCircularDependency)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewThingService(db)
+
+	// Ensure both things exists
+	for _, t := range []uuid.UUID{thingUUID, childUUID} {
+		if ok, err := svc.Exists(r.Context(), t); err != nil {
+			ie.SendHTTPError(w, ie.ParseDBError(err))
+			return
+		} else if ok == false {
+			ie.SendHTTPError(w, ie.ErrorNotFound)
+			return
+		}
+	}
+
+	// The policy validator only covers the parent, the child has to be checked here
+	check := services.NewPolicyCheckService(db)
+	access, err := check.UserHasAccessViaToken(r.Context(), []byte(domaintoken.Token), "update", "things/"+childUUID.String())
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if access == false {
+		ie.SendHTTPError(w, ie.ErrorForbidden)
+		return
+	}
+
+	params := services.AddThingChildParams{
+		Parent: thingUUID,
+		Child:  childUUID,
+	}
+	if obj.InheritPolicies != nil {
+		params.InheritPolicies = *obj.InheritPolicies
+	}
+
+	_, err = svc.AddChild(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemoveChildFromThing detaches a child from a thing
+func (ra *RestApi) RemoveChildFromThing(w http.ResponseWriter, r *http.Request, id rest.UuidParam, child string) {
+	thingUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	childUUID, err := uuid.Parse(child)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	// The policy validator only covers the parent, the child has to be checked here
+	check := services.NewPolicyCheckService(db)
+	access, err := check.UserHasAccessViaToken(r.Context(), []byte(domaintoken.Token), "update", "things/"+childUUID.String())
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if access == false {
+		ie.SendHTTPError(w, ie.ErrorForbidden)
+		return
+	}
+
+	svc := services.NewThingService(db)
+
+	count, err := svc.RemoveChild(r.Context(), thingUUID, childUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// FindThingTypeSchemas lists the JSON Schemas used to validate thing attributes
+func (ra *RestApi) FindThingTypeSchemas(w http.ResponseWriter, r *http.Request) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewThingService(db)
+
+	schemas, err := svc.FindTypeSchemas(r.Context())
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(schemas)
+}
+
+// SetThingTypeSchema sets the JSON Schema used to validate the attributes of a thing type
+func (ra *RestApi) SetThingTypeSchema(w http.ResponseWriter, r *http.Request) {
+	// We expect a NewThingTypeSchema object in the request body.
+	var obj rest.NewThingTypeSchema
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	schema, err := json.Marshal(obj.Schema)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewThingService(db)
+
+	s, err := svc.SetTypeSchema(r.Context(), obj.Type, schema)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(s)
+}
+
+// DeleteThingTypeSchema removes the JSON Schema for a thing type
+func (ra *RestApi) DeleteThingTypeSchema(w http.ResponseWriter, r *http.Request, p rest.DeleteThingTypeSchemaParams) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewThingService(db)
+
+	count, err := svc.DeleteTypeSchema(r.Context(), string(p.Type))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// FindThingStateTransitions lists the allowed state changes for each thing type
+func (ra *RestApi) FindThingStateTransitions(w http.ResponseWriter, r *http.Request) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewThingService(db)
+
+	transitions, err := svc.FindStateTransitions(r.Context())
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(transitions)
+}
+
+// SetThingStateTransitions sets the allowed state changes for a thing type
+func (ra *RestApi) SetThingStateTransitions(w http.ResponseWriter, r *http.Request) {
+	// We expect a NewThingStateTransitions object in the request body.
+	var obj rest.NewThingStateTransitions
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewThingService(db)
+
+	transitions, err := svc.SetStateTransitions(r.Context(), obj.Type, obj.Transitions)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(transitions)
+}
+
+// DeleteThingStateTransitions removes the state change rules for a thing type
+func (ra *RestApi) DeleteThingStateTransitions(w http.ResponseWriter, r *http.Request, p rest.DeleteThingStateTransitionsParams) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewThingService(db)
+
+	count, err := svc.DeleteStateTransitions(r.Context(), string(p.Type))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,258 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package aapije
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/internal/services"
+)
+
+// AddThingTemplate adds a new thing template
+func (ra *RestApi) AddThingTemplate(w http.ResponseWriter, r *http.Request) {
+	// We expect a NewThingTemplate object in the request body.
+	var n rest.NewThingTemplate
+	if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+
+	author, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidAPIKey)
+		return
+	}
+
+	params := &services.AddThingTemplateParams{
+		Name:      n.Name,
+		Type:      n.Type,
+		CreatedBy: author,
+	}
+	if n.Timeseries != nil {
+		params.Timeseries = *n.Timeseries
+	}
+	if n.Datasets != nil {
+		params.Datasets = *n.Datasets
+	}
+
+	svc := services.NewThingTemplateService(db)
+
+	template, err := svc.AddTemplate(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(template)
+}
+
+// FindThingTemplates lists all thing templates
+func (ra *RestApi) FindThingTemplates(w http.ResponseWriter, r *http.Request, p rest.FindThingTemplatesParams) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.NewFindAllParams(
+		[]byte(domaintoken.Token),
+		(*int64)(p.Limit),
+		(*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	svc := services.NewThingTemplateService(db)
+
+	templates, err := svc.FindAll(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(templates)
+}
+
+// FindThingTemplateByUuid returns a specific thing template by its UUID
+func (ra *RestApi) FindThingTemplateByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	templateUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewThingTemplateService(db)
+
+	template, err := svc.FindByUuid(r.Context(), templateUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(template)
+}
+
+// UpdateThingTemplateByUuid updates a specific thing template by its UUID
+func (ra *RestApi) UpdateThingTemplateByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	templateUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	// We expect a UpdateThingTemplate object in the request body.
+	var obj rest.UpdateThingTemplate
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewThingTemplateService(db)
+
+	params := services.UpdateThingTemplateParams{
+		Uuid:       templateUUID,
+		Name:       obj.Name,
+		Type:       obj.Type,
+		Timeseries: obj.Timeseries,
+		Datasets:   obj.Datasets,
+	}
+
+	count, err := svc.UpdateByUuid(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteThingTemplateByUuid deletes a specific thing template by its UUID
+func (ra *RestApi) DeleteThingTemplateByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	templateUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewThingTemplateService(db)
+
+	count, err := svc.DeleteTemplate(r.Context(), templateUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ApplyThingTemplate applies a thing template to all existing things of its type
+func (ra *RestApi) ApplyThingTemplate(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	templateUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+
+	author, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidAPIKey)
+		return
+	}
+
+	store, err := ra.GetStore(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	compression, err := ra.GetCompression(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	datasets := services.NewDatasetService(db).WithStore(store).WithCompression(compression)
+	svc := services.NewThingTemplateService(db).WithDatasets(datasets)
+
+	result, err := svc.Apply(r.Context(), services.ApplyThingTemplateParams{
+		Uuid:      templateUUID,
+		AppliedBy: author,
+		Token:     []byte(domaintoken.Token),
+	})
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/spf13/viper"
 
 	"github.com/self-host/self-host/api/aapije/rest"
 	ie "github.com/self-host/self-host/internal/errors"
@@ -54,6 +55,13 @@ func (ra *RestApi) AddTimeSeries(w http.ResponseWriter, r *http.Request) {
 	if n.Tags != nil {
 		params.Tags = *n.Tags
 	}
+	if n.Attributes != nil {
+		params.Attributes, err = json.Marshal(n.Attributes)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+			return
+		}
+	}
 
 	if n.ThingUuid != nil {
 		thingUUID, err := uuid.Parse(*n.ThingUuid)
@@ -143,12 +151,14 @@ func (ra *RestApi) AddDataToTimeseries(w http.ResponseWriter, r *http.Request, i
 		Points:    points,
 		CreatedBy: createdBy,
 		Unit:      (*string)(p.Unit),
+		Token:     []byte(domaintoken.Token),
+		Overwrite: p.Overwrite != nil && bool(*p.Overwrite),
 	})
 	if err != nil {
 		ie.SendHTTPError(w, ie.ParseDBError(err))
 		return
 	} else if count == 0 {
-		// No rows where inserted due to boundary checks
+		// No rows where inserted or changed, due to boundary checks or unchanged values
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
@@ -241,6 +251,12 @@ func (ra *RestApi) FindTimeSeries(w http.ResponseWriter, r *http.Request, p rest
 		return
 	}
 
+	attributes, err := parseAttributesFilter(p.Attributes)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
 	srv := services.NewTimeseriesService(db)
 
 	if p.Tags != nil {
@@ -250,6 +266,8 @@ func (ra *RestApi) FindTimeSeries(w http.ResponseWriter, r *http.Request, p rest
 			(*int64)(p.Limit),
 			(*int64)(p.Offset))
 
+		params.Attributes = attributes
+
 		if params.Limit.Value == 0 {
 			params.Limit.Value = 20
 		}
@@ -265,6 +283,8 @@ func (ra *RestApi) FindTimeSeries(w http.ResponseWriter, r *http.Request, p rest
 			(*int64)(p.Limit),
 			(*int64)(p.Offset))
 
+		params.Attributes = attributes
+
 		if params.Limit.Value == 0 {
 			params.Limit.Value = 20
 		}
@@ -334,6 +354,13 @@ func (ra *RestApi) UpdateTimeseriesByUuid(w http.ResponseWriter, r *http.Request
 		SiUnit: obj.SiUnit,
 		Tags:   obj.Tags,
 	}
+	if obj.Attributes != nil {
+		params.Attributes, err = json.Marshal(obj.Attributes)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+			return
+		}
+	}
 
 	if obj.ThingUuid != nil {
 		thingUUID, err := uuid.Parse(*obj.ThingUuid)
@@ -435,12 +462,19 @@ func (ra *RestApi) DeleteDataFromTimeSeries(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
 	params := services.DeleteTsDataParams{
 		Uuid:        tsUUID,
 		Start:       time.Time(p.Start),
 		End:         time.Time(p.End),
 		GreaterOrEq: (*float32)(p.Ge),
 		LessOrEq:    (*float32)(p.Le),
+		Token:       []byte(domaintoken.Token),
 	}
 
 	_, err = svc.DeleteTsData(r.Context(), params)
@@ -451,3 +485,87 @@ func (ra *RestApi) DeleteDataFromTimeSeries(w http.ResponseWriter, r *http.Reque
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// FindTimeseriesDataHistory lists the deleted ranges and overwritten data points of a time series
+func (ra *RestApi) FindTimeseriesDataHistory(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.FindTimeseriesDataHistoryParams) {
+	tsUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	if time.Time(p.End).Sub(time.Time(p.Start)) > 31622401*time.Second {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	params := services.FindTsDataHistoryParams{
+		Uuid:  tsUUID,
+		Start: time.Time(p.Start),
+		End:   time.Time(p.End),
+		Limit: 20,
+	}
+
+	if p.Limit != nil {
+		params.Limit = int64(*p.Limit)
+	}
+	if p.Offset != nil {
+		params.Offset = int64(*p.Offset)
+	}
+
+	svc := services.NewTimeseriesService(db)
+	history, err := svc.FindTsDataHistory(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(history)
+}
+
+// UndoTimeseriesDataChange restores data points deleted or overwritten by a change
+func (ra *RestApi) UndoTimeseriesDataChange(w http.ResponseWriter, r *http.Request, id rest.UuidParam, changeId int64) {
+	tsUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewTimeseriesService(db)
+
+	count, err := svc.UndoTsDataChange(r.Context(), services.UndoTsDataChangeParams{
+		Uuid:     tsUUID,
+		ChangeId: changeId,
+		Window:   viper.GetDuration("tsdata.undo_window"),
+		Token:    []byte(domaintoken.Token),
+	})
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		// Nothing to restore, or the change is outside of the undo window
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,290 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package aapije
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/internal/services"
+)
+
+// FindTimeseriesHealth lists the health of all time series
+func (ra *RestApi) FindTimeseriesHealth(w http.ResponseWriter, r *http.Request, p rest.FindTimeseriesHealthParams) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.FindHealthParams{
+		Token: []byte(domaintoken.Token),
+		State: p.State,
+	}
+	params.Limit.Scan((*int64)(p.Limit))
+	params.Offset.Scan((*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	if p.Thing != nil {
+		thingUUID, err := uuid.Parse(*p.Thing)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+			return
+		}
+		params.Thing = &thingUUID
+	}
+
+	svc := services.NewHealthService(db)
+
+	health, err := svc.FindHealth(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(health)
+}
+
+// AddHealthRule adds a new time series health rule
+func (ra *RestApi) AddHealthRule(w http.ResponseWriter, r *http.Request) {
+	// We expect a NewTimeseriesHealthRule object in the request body.
+	var n rest.NewTimeseriesHealthRule
+	if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+
+	author, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidAPIKey)
+		return
+	}
+
+	params := &services.AddHealthRuleParams{
+		Name:             n.Name,
+		ExpectedInterval: n.ExpectedInterval,
+		Flatline:         n.Flatline,
+		Severity:         rest.AlertSeverityMajor,
+		Enabled:          true,
+		CreatedBy:        author,
+	}
+	if n.Timeseries != nil {
+		tsUUID, err := uuid.Parse(*n.Timeseries)
+		if err != nil {
+			ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+			return
+		}
+		params.Timeseries = tsUUID
+	}
+	if n.Tag != nil {
+		params.Tag = *n.Tag
+	}
+	if n.Severity != nil {
+		params.Severity = *n.Severity
+	}
+	if n.Environment != nil {
+		params.Environment = *n.Environment
+	}
+	if n.Enabled != nil {
+		params.Enabled = *n.Enabled
+	}
+
+	svc := services.NewHealthService(db)
+
+	rule, err := svc.AddHealthRule(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(rule)
+}
+
+// FindHealthRules lists all time series health rules
+func (ra *RestApi) FindHealthRules(w http.ResponseWriter, r *http.Request, p rest.FindHealthRulesParams) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	params := services.PaginationParams{}
+	params.Limit.Scan((*int64)(p.Limit))
+	params.Offset.Scan((*int64)(p.Offset))
+
+	if params.Limit.Value == 0 {
+		params.Limit.Value = 20
+	}
+
+	svc := services.NewHealthService(db)
+
+	rules, err := svc.FindHealthRules(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(rules)
+}
+
+// FindHealthRuleByUuid returns a specific time series health rule by its UUID
+func (ra *RestApi) FindHealthRuleByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	ruleUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewHealthService(db)
+
+	rule, err := svc.FindHealthRuleByUuid(r.Context(), ruleUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(rule)
+}
+
+// UpdateHealthRuleByUuid updates a specific time series health rule by its UUID
+func (ra *RestApi) UpdateHealthRuleByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	ruleUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	// We expect a UpdateTimeseriesHealthRule object in the request body.
+	var upd rest.UpdateTimeseriesHealthRule
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&upd); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	// A null duration removes that check, while leaving it out keeps it as is
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+	_, setInterval := fields["expected_interval"]
+	_, setFlatline := fields["flatline"]
+
+	params := services.UpdateHealthRuleParams{
+		Uuid:             ruleUUID,
+		Name:             upd.Name,
+		Tag:              upd.Tag,
+		SetInterval:      setInterval,
+		ExpectedInterval: upd.ExpectedInterval,
+		SetFlatline:      setFlatline,
+		Flatline:         upd.Flatline,
+		Severity:         upd.Severity,
+		Environment:      upd.Environment,
+		Enabled:          upd.Enabled,
+	}
+	if upd.Timeseries != nil {
+		tsUUID := uuid.Nil
+		if *upd.Timeseries != "" {
+			tsUUID, err = uuid.Parse(*upd.Timeseries)
+			if err != nil {
+				ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+				return
+			}
+		}
+		params.Timeseries = &tsUUID
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewHealthService(db)
+
+	count, err := svc.UpdateHealthRuleByUuid(r.Context(), params)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteHealthRuleByUuid deletes a specific time series health rule by its UUID
+func (ra *RestApi) DeleteHealthRuleByUuid(w http.ResponseWriter, r *http.Request, id rest.UuidParam) {
+	ruleUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	svc := services.NewHealthService(db)
+
+	count, err := svc.DeleteHealthRule(r.Context(), ruleUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if count == 0 {
+		ie.SendHTTPError(w, ie.ErrorNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,147 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package aapije
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/internal/services"
+)
+
+// ImportTsdata imports an uploaded CSV file into time series
+func (ra *RestApi) ImportTsdata(w http.ResponseWriter, r *http.Request, p rest.ImportTsdataParams) {
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	// Allow max of 64 MB read from body
+	r.Body = http.MaxBytesReader(w, r.Body, 67108864)
+
+	if err := r.ParseMultipartForm(10485760); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	var mapping rest.TsImportMapping
+	if err := json.Unmarshal([]byte(r.FormValue("mapping")), &mapping); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+	defer file.Close()
+
+	importTsdata(w, r, db, mapping, file, p.DryRun)
+}
+
+// ImportDatasetIntoTimeseries imports the CSV content of a dataset into time series
+func (ra *RestApi) ImportDatasetIntoTimeseries(w http.ResponseWriter, r *http.Request, id rest.UuidParam, p rest.ImportDatasetIntoTimeseriesParams) {
+	datasetUUID, err := uuid.Parse(string(id))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	db, err := ra.GetDB(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	store, err := ra.GetStore(r)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	// We expect a NewTsImport object in the request body.
+	var mapping rest.TsImportMapping
+	if err := json.NewDecoder(r.Body).Decode(&mapping); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	svc := services.NewDatasetService(db).WithStore(store)
+	stream, err := svc.OpenDatasetContentByUuid(r.Context(), datasetUUID)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+	defer stream.Close()
+
+	if stream.Format != "csv" {
+		ie.SendHTTPError(w, ie.NewBadRequestError(fmt.Errorf("the dataset format is %s, not csv", stream.Format)))
+		return
+	}
+
+	importTsdata(w, r, db, mapping, stream, p.DryRun)
+}
+
+func importTsdata(w http.ResponseWriter, r *http.Request, db *sql.DB, mapping rest.TsImportMapping, content io.Reader, dryRun *rest.DryRunParam) {
+	domaintoken, ok := r.Context().Value("domaintoken").(*services.DomainToken)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	u := services.NewUserService(db)
+	createdBy, err := u.GetUserUuidFromToken(r.Context(), []byte(domaintoken.Token))
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	// Generate check rules for access control
+	resources := make([]string, 0)
+	for _, c := range mapping.Columns {
+		resources = append(resources, fmt.Sprintf("timeseries/%v/data", c.Timeseries))
+	}
+
+	// Ensure that the User may add data to all mapped time series
+	policySvc := services.NewPolicyCheckService(db)
+	ok, err = policySvc.UserHasManyAccessViaToken(r.Context(), []byte(domaintoken.Token), "create", resources)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	} else if ok == false {
+		ie.SendHTTPError(w, ie.ErrorForbidden)
+		return
+	}
+
+	svc := services.NewTimeseriesService(db)
+	result, err := svc.ImportData(r.Context(), services.ImportTsDataParams{
+		Mapping:   mapping,
+		Content:   content,
+		CreatedBy: createdBy,
+		DryRun:    dryRun != nil && bool(*dryRun),
+	})
+	if err != nil {
+		ie.SendHTTPError(w, ie.ParseDBError(err))
+		return
+	}
+
+	if len(result.Errors) > 0 {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	} else if dryRun != nil && bool(*dryRun) {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusCreated)
+	}
+	json.NewEncoder(w).Encode(result)
+}
@@ -21,6 +21,9 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Run a program once
+	// (POST /v1/dryruns)
+	DryRunProgram(w http.ResponseWriter, r *http.Request)
 	// Get module
 	// (GET /v1/library)
 	GetModuleAtRevision(w http.ResponseWriter, r *http.Request, params GetModuleAtRevisionParams)
@@ -50,6 +53,23 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
 
+// DryRunProgram operation middleware
+func (siw *ServerInterfaceWrapper) DryRunProgram(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DryRunProgram(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
 // GetModuleAtRevision operation middleware
 func (siw *ServerInterfaceWrapper) GetModuleAtRevision(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -379,6 +399,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/dryruns", wrapper.DryRunProgram)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/library", wrapper.GetModuleAtRevision)
 	})
@@ -404,35 +427,44 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9RYX1PjuhX/Kqq2M/fF2AnkspBOZ5YtvTvM5bL0UoYHmgfFPo5VbMmrP2TTjL9750i2",
-	"42AHArvdtk8Q6ejod875nT/ymsayKKUAYTSdrmnJFCvAgHK/ElkwLq6Zya5x3S2BjhUvDZeCTukZ8SI0",
-	"oBx/l8xkNKCCFUCntN1T8MVyBQmdGmUhoDrOoGCoDr6yosxROJWSBtSsSvyhjeJiQasqqJX8zYJa7Qni",
-	"C8p+ZxQ5EwvLFrADwt8zII0IkSkxGZBCJjaHHaAa4T1hGRCLYWD+lmdgeQHi7h3G0gLdB0mxyvl8EImC",
-	"R665FC9jaSRDgosPsFpKlZCfcmZAm5/Ikuc5UWCsEs6Rfn1zaocZzf6ehhzSgBZcXIJYmIxOx0MmWcuT",
-	"Z8xRoKVVMZDb24vz4RRADXsCGp+cHo8mJ/HBPIlPDyZH8eSApZPxwYSdTo7np+xoMoYBx1deO2jzUSYc",
-	"XN5ewfLGzhHuHBQuxFIYEAb/ZWWZ85ihGdE/Ndqy7sAplSxBmVoPsyaTiptV3/7PPjapVAUz5N5qUFyk",
-	"8sMsk9rcT0upzAwjtbEPRaYl0/rDeBSOwvHh+3B8Oj0Z9W3apJseyvaca4NJpm2J10DSpp523DBQ6L3S",
-	"p11gSrEV/naOAHdY2IJO72lmTEkD90fTWdeeeqen04X8e0S2S5v7hkk1wqATm667Zo4Q2x6rqeB+EgwS",
-	"hqxOpG1iVgG9LRNm4FKy5Bt4k9fHWxeMD48mPx8H1N9Np5QLczzZ2MyFgQWontFO0ZBNd1I9gCK4TxQg",
-	"DdBnPXucOl1KoT2wjyz5xAws2epV1v1RQUqn9F20aZaR39XRX5WSig5AvBCPLOcIzwMgqZIFsaU2ClhB",
-	"NKhHUCEy5hxyMJDsKjIuuTfFZsk0SfwJd/pCGFCC5TdOoYfzA4zzl9ZmEPCCAb2S5hdpRfKfh4DO0SXE",
-	"POWQbLtHSENSROEc9PnXvmdvbByD1m7/VtS59C9Ifhwt8E4QptZOYgUJ/mR5Dcrl4Ws4Yf2J0CVRDQOP",
-	"t4zYztFYJtDX7oSJ2+snZ0AL0Bqnlh3nmu2XalmtvxGfOcQQWyxnN67A1dmqeXxmsT2v29JM57i6ucKV",
-	"YednLG19ZO/ekTvIY1kAMdL1LJmmPOYsJ4mMbQHC+AjUY9vV5/MzcgN5io2MXCu5UKwgvzHBFqDI2fVF",
-	"+A8cMXIeg9DOE3Wr/3R9eXAUjg6kyLEmW5XX6PQ0imQJwscqlGoR1ad1VB9yvYgb1y1eBEAD+ghKe/NG",
-	"4dgfxxtYyemUHoWjELsqTiLOj9HjOMr5XDHlyt4CTN9Nn8A482tCYYSwuRPWDG0fSGfAQiI5r10k/uxv",
-	"TujM/N4dwjqF93A0epJaBr6aqMwZF38iccaUBvNna9KDk+0cazvGnAvmxr2BAejJaPIr+mMymuzK0RZZ",
-	"1FYrx0BbFM5FzhntVGzYQrteVHtwVgVbz6T74Us2IlHvCVMFL57pzvV7iG+/T/Y4sD2tV2gU0kS3Y6N/",
-	"Dko9wJVra0gBhAsSSxZnf+gRwnfndgSl3RF1tTsqnSk22h5hqx6dxi8Ht+HBHqJbLaAK6M+ers8fGmq8",
-	"2zxqLSBME0aWzi1hh1Otu30NfBKBaI1DX+UDgA2/H4pboYfu2BGRjnQ/QfdIl2ZQeatbX5mT3y0Ou700",
-	"FIhguEL+JYP4gWzCE5JbDQmZr1p9hBnCcMQ2oB5Zjv0GhLYKiMmYL69FXcYfhFxqwubSGsJNSC5SN7Sg",
-	"SK3LvYLb28JeRB2cu8aO18fyG7LjvxVGHwGedoJAMqbJHEAQliSQ7Izo6wr25t0/VBjrtIyaV87bdQe0",
-	"tANMu5KGp6stvnim4EpslQJh3NNnR5rj683Pj2+pvJ0XYPUWYjWT6/9dkXC4CRpOzplhz5fpJcwzKR90",
-	"tPbdveoU67dMB5uPrHs07yccGm7SSn5d+VqUstgPcw3okPwi1ZKpRHtKsdwVq00dE+5VnXPQZMlN5qQa",
-	"X/ZLUa3szmt/Wo3+10mAZw9fPtv5dOAmzpTZfMDtHyFmVmPF55pw7DWlC0SdY8GWK0nMBMGeJFYm42IR",
-	"PqHkczHs0LNeqj+UNA8px73OE+p+hmTx73VPTP9EWZdKGhnLvJpG0drvV/jGYIqzee6D2Mh4g2vbm09g",
-	"PeJ50WDHR7SqQbGtbHz4PnQfBqcno9P3PbU+ZuT290uc+Wet9evnvnaBSErJhdGbL7KbXK5m1b8DAAD/",
-	"/6N9I57/GAAA",
+	"H4sIAAAAAAAC/9RZa3PbuNX+K3ix78x+oSRSlh1bnc5sUjepJ9nYddaTmbqe3SPyUERDAlxcrKge/fcO",
+	"AF4lylacNNP9JJEADp5zv/CBxqIoBUeuFZ0/0BIkFKhRuqdEFMD4Fejsyr53r1DFkpWaCU7n9CXxW2hA",
+	"mX0uQWc0oBwKpHParEn83TCJCZ1raTCgKs6wAEsOP0NR5nZzKgQNqF6X9kFpyfiSbjZBReTvBuX6QBC/",
+	"273fGEUOfGlgiXsg/JIhqbcQkRKdISlEYnLcA6refCAsjXw5DMzf8ggsv4G4e4exNEAPQVKsc7YYRCLx",
+	"nikm+NNY6p1jYl9+wvVKyIT8mINGpX8kK5bnRKI2kjtB+vftqT1s1OsHMjKlAS0Yf4d8qTM6j4ZYMoYl",
+	"j7AjUQkjYyQ3Nxfnwy5gKRwIKDo9Owlnp/FokcRno9lRPBtBOotGMzibnSzO4GgW4YDgN546Kv1KJAyd",
+	"377H1blcXxtuH2LBNXJt/0JZ5iwGy8LkX0q45RZKKUWJUlc0EoQkZxx7II/DMAxoAZ9ZYQo6Pwn9M+P+",
+	"OWzgMa5xiZI2DrxtRY1XbvHTutpBHhBY1EsJxa9O0r0jcDxFiE6TERzB6WgWLqIRLPB0dDSdnp4gnqQn",
+	"i2iIolfqr7FIHIZUyAI0ndPFWuMey6+1e9uKLWgDT8fZe2j7V905XW6HtuoA0YJIw4ngMQYEFGEqIIIT",
+	"ICshP6Ec7xjZJrBm8MEsLL0Fyq8wBTA6E5Lp9a4bXHoX9TIit0ahZDwVP91lQunbeSmkvrPYWq3YLfMS",
+	"lPopCsfhOJq+GEdn89NwSBMxlBAP3mvdr16tA66XBJGYg2b3aEVmXwudoawWVUCMwoQs1v4EsmWmMamP",
+	"Ki1B43I9JueYgsm1skSiHgNR0FoE4/pkRjsOEA05QK1+NZS6cqa0ZUCZ0goLkyaPKBfoNBbqQE+oXoCU",
+	"sLbPTp3ejbgFd0szrUsauB9F77paqVZ2aO561TPDVN9LavP3CIOOhXXFNeQRlUG7R8K4V0WVFXYc4KZM",
+	"QOM7AclXWH8uoC+CaHo0Oz4ZMINt1W8x7QgN8fTRW59dJxKtGViZ7fDjyKlScOWBvYLkDWhcwfqLuPt/",
+	"iSmd0x8mbeU38atq8lcphaQDEC/4PeQsITUAkkpREFMqLREKolDe2yC0CSyqa5+P/vuofBJ2l5FEoCJc",
+	"aJKKPBcrokqMWVpd6JCdY44ak3253JNpcvoKFEn8CXf6gmuUHPIPjlUP6TuI3V9aCZig3xjQ90K/FoYn",
+	"30fGlSwx6YvHS9twL6DLt7uS/WDiGJVy6ze88vJ/Y/L9DNbeiVxX1EksMbGPkFegXIT4Epsw/sTYuXcF",
+	"wx73Bdc1KpPrgWrKSPCUhy7SrMCAME4KludMYSx4ogLvYxnwhPGly1edYqCT8AzXLCdMVzWzxdbNV9PB",
+	"qgxrA95F45ZsToLmwlXG4owkLHE6V1arW9fQoewhjI5FPwUpbxE0oCmw3EiXK1iBwuh+Rmo3DpEtjR4G",
+	"79fISjKtkdeJvhFcJoVZZu7db7lY/la1JH1WpuE0GkXhKDr7JZrOw3Aehv8gF+9fXxKlwebofw4Wrl4d",
+	"w7AqVXk5SuBdVP3L4+PFaRyls9FZEuJoBi9ejCCMZ6PpIjlOI5ilp8n0yfxaC77WcyOzoLXEBvDdJqBN",
+	"POubbV0D9xlym4lbGzKtApWC5d5z9fJTPFT06+13dl1hbGyZ8MEVDlUWVCx+aXTWBA1Lc2Hftle48sZF",
+	"CVsy7CL74QfyEXMrsqZqTFMWM8hJImJTINc+flSl5vvL85fkA+apLXPJVWVdPwOHJUry8upi7EwkZzFy",
+	"5SRR9YNvrt6NjsbhSPDc1jpG5hU6NZ9MRIncR5qxkMtJdVpNqkOuxmPaWcmTAGhA71Eqz144jvxxewOU",
+	"jM7p0Tgc25rbtqtOjpP7aJLItTTcPZZCDXjY34AnajsUwZZ1G66qvt0XcgEB7gqbnKEiK6a9/0kXKl1H",
+	"041mGShLgjDtxwPuv881EmMhEx94rJU6lVwkdF4F30oMtNsRr/dlkF7TPGk75u0qaxqG3yxb9XLEQNK6",
+	"fGuvn4XhPkINskmnzNoE9PiQI0MVjDs7Pei6utZ0fmiKAuSazum14Z08YZVJA6phqawLV6+Vd15rYTlb",
+	"SHfwgS5xwMDeoHbGUCVcGwNsc0mgnh39RDpznr4RvEH9s9v0Ul+3e55QpsbPelLmwPifSJyBVKj/bHQ6",
+	"Ou1rtR0CMA5y3UaW7hxmWJmzp6XbVHN92VphNMO5Wqa1BG3U7k5rb4cvabdMdiapm+DJM93x4gHb+2PS",
+	"Aw70h4abu8pMVDO2eCQYXRlNCrRlUywgzv5vxyB8X9WMQJ4ZF5rzciA2RE8rt7aDA7b2SuSvcuueHTUc",
+	"2MFRd2JU21Qj7tZROxqYPNh2feMVYBuiXVXccDV0xx6NdHbvOugB7lI3cs8V6xf65DfTw34pDSkiGI6Q",
+	"f8kw/kRa9YzJTTXRavIwaAKcMIvnHnKbopErI22aBR9ei6pQ+MTFShFYCKNdwr1IXaLtpGM3jG9u2828",
+	"Ds7Hmo8v1+Xl2z+cGr0GWNpRgqtaFoicQJJgslejXxaw288Pm7u9bjmp51PPpx3QwXbqvdAsXffsxVuK",
+	"fRMbKZFrN7Ta4+Z27ub76+dE3s7sbvMcw6o7+z9ckHC4iWWcnIOGx8P0CheZEJ/U5MFn900nWD+nOmi/",
+	"9R6QvLdsaDhJS/F57WNRCrEv5mrQY/JayBXIqqWIIc97/cS+tsHJcjcUVcQ+eurb0eh/3QieUYjbitN9",
+	"ptgV+yuMwSgb8Zmy7ZMr0T+v65FW0BMliYETm5P4WmeML8dbJvmYDjvmWb2qRtx1q+5sr9Ok395ZY/Hz",
+	"TG+Yvgl+KKXQIhb5Zj6ZPPj1je1iQTJY5F6J9R7PcMV7/fFix/D81mDP549NjaJPzH6Qch+m5qfh2Ysd",
+	"sl5n5Ob6na357xruHx77ToE8KQXjWrUfhltf3txt/jMAoISX84YhAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
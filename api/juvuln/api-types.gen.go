@@ -7,6 +7,34 @@ const (
 	BasicAuthScopes = "BasicAuth.Scopes"
 )
 
+// Defines values for DryRunResultOutcome.
+const (
+	DryRunResultOutcomeFailure DryRunResultOutcome = "failure"
+
+	DryRunResultOutcomeSuccess DryRunResultOutcome = "success"
+
+	DryRunResultOutcomeTimeout DryRunResultOutcome = "timeout"
+)
+
+// DryRunResult defines model for DryRunResult.
+type DryRunResult struct {
+	// The time, in milliseconds, from handing the program to the worker until it returned.
+	Duration int `json:"duration"`
+
+	// The error of a program which did not succeed.
+	Error   string              `json:"error"`
+	Outcome DryRunResultOutcome `json:"outcome"`
+
+	// The output written by the program through the `log` module.
+	Output string `json:"output"`
+
+	// The worker which ran the program.
+	Worker string `json:"worker"`
+}
+
+// DryRunResultOutcome defines model for DryRunResult.Outcome.
+type DryRunResultOutcome string
+
 // Error defines model for Error.
 type Error struct {
 	// Error code
@@ -37,6 +65,9 @@ type UuidParam string
 // BadGateway defines model for BadGateway.
 type BadGateway Error
 
+// BadRequest defines model for BadRequest.
+type BadRequest Error
+
 // InternalServerError defines model for InternalServerError.
 type InternalServerError Error
 
@@ -46,11 +77,23 @@ type NotFound Error
 // Unauthorized defines model for Unauthorized.
 type Unauthorized Error
 
+// NewDryRun defines model for NewDryRun.
+type NewDryRun struct {
+	Deadline    int    `json:"deadline"`
+	Domain      string `json:"domain"`
+	Language    string `json:"language"`
+	ProgramUuid string `json:"program_uuid"`
+	SourceCode  []byte `json:"source_code"`
+}
+
 // NewSubscriber defines model for NewSubscriber.
 type NewSubscriber struct {
 	// On the format [userinfo@]host[:port].
 	Authority string `json:"authority"`
 
+	// The capacity of the worker relative to the other workers, used by the weighted worker strategy. Defaults to 1.
+	Capacity *int64 `json:"capacity,omitempty"`
+
 	// A list of supported languages.
 	Languages []string            `json:"languages"`
 	Scheme    NewSubscriberScheme `json:"scheme"`
@@ -80,6 +123,9 @@ type GetModuleAtRevisionParams struct {
 // WorkerSubscribeJSONBodyScheme defines parameters for WorkerSubscribe.
 type WorkerSubscribeJSONBodyScheme string
 
+// DryRunProgramJSONRequestBody defines body for DryRunProgram for application/json ContentType.
+type DryRunProgramJSONRequestBody NewDryRun
+
 // WorkerSubscribeJSONRequestBody defines body for WorkerSubscribe for application/json ContentType.
 type WorkerSubscribeJSONRequestBody NewSubscriber
 
@@ -10,11 +10,15 @@ package juvuln
 import (
 	"context"
 	"crypto/md5"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 
@@ -40,8 +44,7 @@ func init() {
 	var err error
 
 	pcache = &ProgramCache{
-		m:       make(map[string]*ProgramRevision),
-		deletes: make(map[string]struct{}),
+		m: make(map[string]*ProgramRevision),
 	}
 
 	logger, err = zap.NewProduction()
@@ -61,10 +64,16 @@ func (ra *RestApi) WorkerSubscribe(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var capacity uint64
+	if sub.Capacity != nil && *sub.Capacity > 0 {
+		capacity = uint64(*sub.Capacity)
+	}
+
 	workforce.Add(sub.Uuid, NewWorker(
 		sub.Uuid,
 		fmt.Sprintf("%v://%v", sub.Scheme, sub.Authority),
 		sub.Languages,
+		capacity,
 		viper.GetDuration("worker.timeout")))
 
 	w.WriteHeader(http.StatusCreated)
@@ -83,6 +92,71 @@ func (ra *RestApi) ForwardWebhook(w http.ResponseWriter, r *http.Request, dom Do
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Run a program once on a worker, without recording the run
+func (ra *RestApi) DryRunProgram(w http.ResponseWriter, r *http.Request) {
+	// We expect a NewDryRun object in the request body.
+	var obj NewDryRun
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	programUUID, err := uuid.Parse(obj.ProgramUuid)
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorInvalidUUID)
+		return
+	}
+
+	requestBody, err := json.Marshal(WorkerTask{
+		ProgramUuid: programUUID,
+		Domain:      obj.Domain,
+		Language:    obj.Language,
+		Deadline:    obj.Deadline,
+		SourceCode:  base64.StdEncoding.EncodeToString(obj.SourceCode),
+	})
+	if err != nil {
+		ie.SendHTTPError(w, ie.ErrorMalformedRequest)
+		return
+	}
+
+	id, wrk, err := workforce.Select(workforce.Task{
+		Program:  fmt.Sprintf("%v/%v", obj.Domain, programUUID),
+		Language: obj.Language,
+	})
+	if err != nil {
+		ie.SendHTTPError(w, &ie.HTTPError{
+			Code:    http.StatusBadGateway,
+			Message: err.Error(),
+		})
+		return
+	}
+	worker, ok := wrk.(*Worker)
+	if ok == false {
+		ie.SendHTTPError(w, ie.ErrorUndefined)
+		return
+	}
+
+	started := time.Now()
+	result, err := worker.Execute(requestBody, time.Duration(obj.Deadline)*time.Millisecond)
+	if err != nil {
+		ie.SendHTTPError(w, &ie.HTTPError{
+			Code:    http.StatusBadGateway,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(DryRunResult{
+		Outcome:  DryRunResultOutcome(result.Outcome),
+		Error:    result.Error,
+		Output:   result.Output,
+		Duration: int(time.Since(started) / time.Millisecond),
+		Worker:   id,
+	})
+}
+
 // Check registration
 func (ra *RestApi) CheckWorker(w http.ResponseWriter, r *http.Request, id UuidParam) {
 	if workforce.Exists(string(id)) == false {
@@ -161,45 +235,151 @@ func GetOpenAPIFile() ([]byte, error) {
 	return decodeSpec()
 }
 
-// Update the program listing by queries each DB for content
+// The updates of the program cache of each domain are made one at a time, from
+// reading the DB to applying what was read, so that an update which read the
+// DB before a program changed never undoes the update made for the change.
+var (
+	domainUpdates    = make(map[string]*sync.Mutex)
+	domainUpdatesMux sync.Mutex
+)
+
+func lockDomainUpdates(domain string) *sync.Mutex {
+	domainUpdatesMux.Lock()
+	defer domainUpdatesMux.Unlock()
+
+	m, ok := domainUpdates[domain]
+	if ok == false {
+		m = &sync.Mutex{}
+		domainUpdates[domain] = m
+	}
+	m.Lock()
+
+	return m
+}
+
+// domainCacheRead is called once the programs of a domain were read, and
+// before they are applied to the cache. Tests change programs meanwhile.
+var domainCacheRead = func(domain string) {}
+
+// Update the program listing of the domain by querying its DB
+func UpdateDomainCache(ctx context.Context, domain string) error {
+	defer lockDomainUpdates(domain).Unlock()
+
+	db, err := postgres.GetDB(domain)
+	if err != nil {
+		return err
+	}
+
+	routines, err := postgres.New(db).FindAllRoutineRevisions(ctx)
+	if err != nil {
+		return err
+	}
+
+	items := make([]*ProgramRevision, 0)
+	for _, p := range routines {
+		items = append(items, NewProgramRevision(
+			domain,
+			p.Name,
+			p.ProgramUuid,
+			p.Type,
+			p.Schedule,
+			p.Deadline,
+			p.Language,
+			p.Revision,
+			p.Code,
+			p.Checksum,
+		))
+	}
+
+	domainCacheRead(domain)
+
+	pcache.Sync(func(obj *ProgramRevision) bool {
+		return obj.Domain == domain
+	}, items)
+
+	return nil
+}
+
+// Update the program listing for a single program of the domain
+func UpdateProgram(ctx context.Context, domain string, programUUID uuid.UUID) error {
+	defer lockDomainUpdates(domain).Unlock()
+
+	db, err := postgres.GetDB(domain)
+	if err != nil {
+		return err
+	}
+
+	routines, err := postgres.New(db).FindRoutineRevisionsByProgram(ctx, programUUID)
+	if err != nil {
+		return err
+	}
+
+	items := make([]*ProgramRevision, 0)
+	for _, p := range routines {
+		items = append(items, NewProgramRevision(
+			domain,
+			p.Name,
+			p.ProgramUuid,
+			p.Type,
+			p.Schedule,
+			p.Deadline,
+			p.Language,
+			p.Revision,
+			p.Code,
+			p.Checksum,
+		))
+	}
+
+	pcache.Sync(func(obj *ProgramRevision) bool {
+		return obj.Domain == domain && obj.ProgramUuid == programUUID
+	}, items)
+
+	return nil
+}
+
+// Update the program listing by querying each DB for content, and forget the
+// programs of the domains which are gone
 func UpdateProgramCache() error {
-	dbs := postgres.GetAllDB()
+	domains := make(map[string]struct{})
 
-	pcache.Begin()
+	for _, item := range postgres.GetAllDB() {
+		domains[item.Domain] = struct{}{}
 
-	for _, item := range dbs {
 		if item.DB == nil {
 			continue
 		}
 
-		q := postgres.New(item.DB)
-		if q == nil {
-			continue
+		ctx := context.Background() // FIXME: timeout
+		if err := UpdateDomainCache(ctx, item.Domain); err != nil {
+			logger.Error("unable to update programs", zap.String("domain", item.Domain), zap.Error(err))
 		}
+	}
 
-		ctx := context.Background() // FIXME: timeout
-		routines, err := q.FindAllRoutineRevisions(ctx)
-		if err != nil {
-			return nil
+	pcache.Sync(func(obj *ProgramRevision) bool {
+		_, ok := domains[obj.Domain]
+		return ok == false
+	}, nil)
+
+	return nil
+}
+
+// Remove the program runs started before the time from each DB
+func PurgeProgramRuns(before time.Time) (int64, error) {
+	var total int64
+
+	for _, item := range postgres.GetAllDB() {
+		if item.DB == nil {
+			continue
 		}
 
-		for _, p := range routines {
-			pcache.Add(NewProgramRevision(
-				item.Domain,
-				p.Name,
-				p.ProgramUuid,
-				p.Type,
-				p.Schedule,
-				p.Deadline,
-				p.Language,
-				p.Revision,
-				p.Code,
-				p.Checksum,
-			))
+		q := postgres.New(item.DB)
+
+		count, err := q.DeleteProgramRunsBefore(context.Background(), before)
+		if err != nil {
+			return total, err
 		}
+		total += count
 	}
 
-	pcache.Commit()
-
-	return nil
+	return total, nil
 }
@@ -0,0 +1,349 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package juvuln
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/self-host/self-host/pkg/workforce"
+	"github.com/self-host/self-host/postgres"
+)
+
+// The number of jobs currently dispatched to a worker.
+var inflight int64
+
+// errClaimLost is returned for a job whose lease expired and which was claimed
+// again, by this or another Program Manager.
+var errClaimLost = errors.New("the job was claimed again")
+
+// EnqueueProgramJob adds a job to run the revision of the program to the queue
+// in the database of the domain. A job for a scheduled time, unless zero, is
+// only queued once, and zero is returned for a job already queued.
+func EnqueueProgramJob(ctx context.Context, domain string, programUUID uuid.UUID, revision int32, trigger string, scheduled time.Time) (int64, error) {
+	db, err := postgres.GetDB(domain)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := postgres.New(db).CreateProgramJob(ctx, postgres.CreateProgramJobParams{
+		ProgramUuid: programUUID,
+		Revision:    revision,
+		Trigger:     trigger,
+		MaxAttempts: int32(viper.GetInt("queue.max_attempts")),
+		Scheduled:   sql.NullTime{Time: scheduled, Valid: scheduled.IsZero() == false},
+	})
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+
+	return id, err
+}
+
+// DispatchJobs claims the jobs which are due from the queue of each DB, and
+// hands each one of them to a worker. No more than queue.batch jobs are in
+// flight at the same time.
+func DispatchJobs(ctx context.Context) error {
+	for _, item := range postgres.GetAllDB() {
+		if item.DB == nil {
+			continue
+		}
+
+		limit := viper.GetInt64("queue.batch") - atomic.LoadInt64(&inflight)
+		if limit <= 0 {
+			return nil
+		}
+
+		// Leave the jobs in the queue while there is no one to run them
+		if _, err := workforce.GetAvailable(); err != nil {
+			return nil
+		}
+
+		q := postgres.New(item.DB)
+
+		jobs, err := q.ClaimProgramJobs(ctx, postgres.ClaimProgramJobsParams{
+			ArgLimit: limit,
+			Lease:    int32(viper.GetDuration("queue.lease") / time.Second),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, job := range jobs {
+			atomic.AddInt64(&inflight, 1)
+			go func(domain string, job postgres.ProgramJob) {
+				defer atomic.AddInt64(&inflight, -1)
+
+				err := runJob(context.Background(), domain, q, job)
+				if err != nil {
+					logger.Error("unable to run job",
+						zap.String("domain", domain),
+						zap.Int64("job", job.ID),
+						zap.Error(err))
+				}
+			}(item.Domain, job)
+		}
+	}
+
+	return nil
+}
+
+// runJob runs a claimed job on a worker, and records the run. A job which
+// fails is retried with a growing delay until it has no attempts left, when it
+// is dead-lettered. The attempts of the job, as claimed, are the claim: once
+// the job is claimed again the claim no longer changes it.
+func runJob(ctx context.Context, domain string, q *postgres.Queries, job postgres.ProgramJob) error {
+	// A run left behind by an attempt whose lease expired will never complete
+	_, err := q.FailStaleProgramRuns(ctx, postgres.FailStaleProgramRunsParams{
+		Error:   "the lease of the job expired",
+		JobID:   job.ID,
+		Attempt: job.Attempts,
+	})
+	if err != nil {
+		return err
+	}
+
+	if job.Attempts > job.MaxAttempts {
+		return killJob(ctx, q, job, "the job has no attempts left")
+	}
+
+	program, err := q.FindProgramByUUID(ctx, job.ProgramUuid)
+	if err == sql.ErrNoRows {
+		return killJob(ctx, q, job, "the program does not exist")
+	} else if err != nil {
+		return retryJob(ctx, q, job, err.Error())
+	}
+
+	if program.State != "active" {
+		return killJob(ctx, q, job, "the program is not active")
+	}
+
+	code, err := q.GetProgramCodeAtRevision(ctx, postgres.GetProgramCodeAtRevisionParams{
+		ProgramUuid: job.ProgramUuid,
+		Revision:    job.Revision,
+	})
+	if err == sql.ErrNoRows {
+		return killJob(ctx, q, job, "the revision does not exist")
+	} else if err != nil {
+		return retryJob(ctx, q, job, err.Error())
+	}
+
+	requestBody, err := json.Marshal(WorkerTask{
+		ProgramUuid: job.ProgramUuid,
+		Domain:      domain,
+		Language:    program.Language,
+		Deadline:    int(program.Deadline),
+		SourceCode:  base64.StdEncoding.EncodeToString(code),
+	})
+	if err != nil {
+		return killJob(ctx, q, job, err.Error())
+	}
+
+	task := workforce.Task{
+		Program:  fmt.Sprintf("%v/%v@%v", domain, job.ProgramUuid, job.Revision),
+		Language: program.Language,
+	}
+
+	_, w, err := workforce.Select(task)
+	if err != nil {
+		return retryJob(ctx, q, job, err.Error())
+	}
+	worker, ok := w.(*Worker)
+	if ok == false {
+		return retryJob(ctx, q, job, "no available worker")
+	}
+
+	lease := viper.GetDuration("queue.lease")
+	if err := heartbeatJob(ctx, q, job, worker, lease); err != nil {
+		return err
+	}
+
+	runID, err := startRun(ctx, q, job, worker)
+	if err != nil {
+		return retryJob(ctx, q, job, err.Error())
+	}
+
+	// Keep the lease of the job for as long as the worker is running it
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(lease / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := heartbeatJob(ctx, q, job, worker, lease); err != nil {
+					logger.Error("unable to extend the lease of job", zap.Int64("job", job.ID), zap.Error(err))
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	started := time.Now()
+	result, err := worker.Execute(requestBody, time.Duration(program.Deadline)*time.Millisecond)
+	close(done)
+	if err != nil {
+		result = WorkerTaskResult{
+			Outcome: "failure",
+			Error:   err.Error(),
+		}
+	} else {
+		workforce.Ran(task.Program, worker.Id, result.Expires)
+	}
+
+	_, err = q.CompleteProgramRun(ctx, postgres.CompleteProgramRunParams{
+		ID:       runID,
+		Duration: sql.NullInt32{Int32: int32(time.Since(started) / time.Millisecond), Valid: true},
+		Outcome:  result.Outcome,
+		Error:    result.Error,
+		Output:   result.Output,
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.Outcome == "success" {
+		_, err := q.CompleteProgramJob(ctx, postgres.CompleteProgramJobParams{
+			ID:       job.ID,
+			Attempts: job.Attempts,
+		})
+		return err
+	}
+
+	return retryJob(ctx, q, job, result.Error)
+}
+
+// startRun starts the run queued with the job for the attempt, or records a
+// new run when none was queued.
+func startRun(ctx context.Context, q *postgres.Queries, job postgres.ProgramJob, worker *Worker) (int64, error) {
+	runID, err := q.StartProgramRun(ctx, postgres.StartProgramRunParams{
+		Worker:  worker.Id,
+		JobID:   job.ID,
+		Attempt: job.Attempts,
+	})
+	if err != sql.ErrNoRows {
+		return runID, err
+	}
+
+	return q.CreateProgramRun(ctx, postgres.CreateProgramRunParams{
+		ProgramUuid: job.ProgramUuid,
+		Revision:    job.Revision,
+		Worker:      worker.Id,
+		Trigger:     job.Trigger,
+		JobID:       job.ID,
+		Attempt:     job.Attempts,
+	})
+}
+
+// heartbeatJob extends the lease of the job, as long as it was not claimed
+// again.
+func heartbeatJob(ctx context.Context, q *postgres.Queries, job postgres.ProgramJob, worker *Worker, lease time.Duration) error {
+	count, err := q.HeartbeatProgramJob(ctx, postgres.HeartbeatProgramJobParams{
+		Lease:    int32(lease / time.Second),
+		Worker:   worker.Id,
+		ID:       job.ID,
+		Attempts: job.Attempts,
+	})
+	if err != nil {
+		return err
+	} else if count == 0 {
+		return errClaimLost
+	}
+
+	return nil
+}
+
+// retryJob queues the job again after a delay which doubles with each
+// attempt, or kills it when it has no attempts left.
+func retryJob(ctx context.Context, q *postgres.Queries, job postgres.ProgramJob, reason string) error {
+	if job.Attempts >= job.MaxAttempts {
+		return killJob(ctx, q, job, reason)
+	}
+
+	_, err := q.RetryProgramJob(ctx, postgres.RetryProgramJobParams{
+		RetryIn:   int32(retryDelay(job.Attempts) / time.Second),
+		LastError: reason,
+		ID:        job.ID,
+		Attempts:  job.Attempts,
+	})
+	return err
+}
+
+// killJob marks the job dead, along with the run queued with it if it was
+// never started.
+func killJob(ctx context.Context, q *postgres.Queries, job postgres.ProgramJob, reason string) error {
+	count, err := q.KillProgramJob(ctx, postgres.KillProgramJobParams{
+		LastError: reason,
+		ID:        job.ID,
+		Attempts:  job.Attempts,
+	})
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		_, err := q.FailQueuedProgramRuns(ctx, postgres.FailQueuedProgramRunsParams{
+			Error: reason,
+			JobID: job.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return fmt.Errorf("job is dead: %v", reason)
+}
+
+// retryDelay returns the delay before the next attempt, after a number of
+// attempts.
+func retryDelay(attempts int32) time.Duration {
+	base := viper.GetDuration("queue.retry_base")
+	max := viper.GetDuration("queue.retry_max")
+
+	delay := base
+	for i := int32(1); i < attempts && delay < max; i++ {
+		delay *= 2
+	}
+
+	if delay > max {
+		delay = max
+	}
+
+	return delay
+}
+
+// Remove the jobs which are done or dead, and were last updated before the
+// time, from each DB
+func PurgeProgramJobs(before time.Time) (int64, error) {
+	var total int64
+
+	for _, item := range postgres.GetAllDB() {
+		if item.DB == nil {
+			continue
+		}
+
+		q := postgres.New(item.DB)
+
+		count, err := q.DeleteProgramJobsBefore(context.Background(), before)
+		if err != nil {
+			return total, err
+		}
+		total += count
+	}
+
+	return total, nil
+}
@@ -0,0 +1,254 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package juvuln
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/pkg/workforce"
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestRunJobAfterClaimLost(t *testing.T) {
+	ctx := context.Background()
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+	svc := services.NewProgramService(db)
+
+	program, err := svc.AddProgram(ctx, services.AddProgramParams{
+		Name:      "test/claims",
+		Type:      "routine",
+		State:     "active",
+		Schedule:  "@hourly",
+		Deadline:  500,
+		Language:  "tengo",
+		CreatedBy: rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	programUUID := uuid.MustParse(program.Uuid)
+
+	_, err = svc.AddCodeRevision(ctx, services.AddCodeRevisionParams{
+		ProgramUuid: programUUID,
+		CreatedBy:   rootUUID,
+		Code:        []byte(`x := 1`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A worker which holds on to each run until told to return
+	var requests int32
+	received := make(chan struct{})
+	release := []chan struct{}{make(chan struct{}), make(chan struct{})}
+	outcome := []string{"failure", "success"}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := atomic.AddInt32(&requests, 1) - 1
+		received <- struct{}{}
+		<-release[i]
+		json.NewEncoder(w).Encode(WorkerTaskResult{Outcome: outcome[i]})
+	}))
+	defer ts.Close()
+
+	workforce.Add("test/worker", NewWorker("test/worker", ts.URL, []string{"tengo"}, 2, time.Minute))
+	defer workforce.Delete("test/worker")
+
+	jobID, err := EnqueueProgramJob(ctx, testDomain, programUUID, 0, "manual", time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := postgres.New(db)
+	claim := func() postgres.ProgramJob {
+		jobs, err := q.ClaimProgramJobs(ctx, postgres.ClaimProgramJobsParams{
+			ArgLimit: 10,
+			Lease:    30,
+		})
+		if err != nil {
+			t.Fatal(err)
+		} else if len(jobs) != 1 || jobs[0].ID != jobID {
+			t.Fatalf("expected the job to be claimed, got %v", jobs)
+		}
+		return jobs[0]
+	}
+
+	// The first claim is running on the worker when its lease expires
+	stale := claim()
+	staleDone := make(chan error)
+	go func() { staleDone <- runJob(ctx, testDomain, q, stale) }()
+	<-received
+
+	if _, err := db.Exec("UPDATE program_jobs SET lease_until = NOW() - INTERVAL '1 second' WHERE id = $1", jobID); err != nil {
+		t.Fatal(err)
+	}
+
+	current := claim()
+	if current.Attempts != 2 {
+		t.Fatalf("expected the second attempt, got %v", current.Attempts)
+	}
+	currentDone := make(chan error)
+	go func() { currentDone <- runJob(ctx, testDomain, q, current) }()
+	<-received
+
+	// The first claim fails, and may no longer queue the job again
+	close(release[0])
+	if err := <-staleDone; err != nil {
+		t.Fatal(err)
+	}
+
+	job, err := svc.FindProgramJobById(ctx, jobID)
+	if err != nil {
+		t.Fatal(err)
+	} else if job.Status != "running" || job.Attempts != 2 {
+		t.Errorf("expected the second attempt to keep running, got %v after %v attempts", job.Status, job.Attempts)
+	}
+
+	// The first claim may no longer extend the lease or kill the job either
+	if err := heartbeatJob(ctx, q, stale, NewWorker("test/stale", ts.URL, nil, 1, time.Minute), time.Minute); err != errClaimLost {
+		t.Errorf("expected the claim to be lost, got %v", err)
+	}
+	killJob(ctx, q, stale, "stale")
+
+	close(release[1])
+	if err := <-currentDone; err != nil {
+		t.Fatal(err)
+	}
+
+	job, err = svc.FindProgramJobById(ctx, jobID)
+	if err != nil {
+		t.Fatal(err)
+	} else if job.Status != "done" {
+		t.Errorf("expected the job to be done, got %v", job.Status)
+	}
+
+	// The run of the first attempt was failed by the second attempt
+	runs, err := svc.FindProgramJobRuns(ctx, jobID)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(runs) != 2 {
+		t.Fatalf("expected a run for each attempt, got %v", len(runs))
+	} else if runs[0].Outcome != "success" {
+		t.Errorf("expected the second attempt to succeed, got %v", runs[0].Outcome)
+	} else if runs[1].Outcome != "failure" || runs[1].Error != "the lease of the job expired" {
+		t.Errorf("expected the first attempt to be failed, got %v %q", runs[1].Outcome, runs[1].Error)
+	}
+
+	if _, err := svc.DeleteProgram(ctx, programUUID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunJobStartsQueuedRun(t *testing.T) {
+	ctx := context.Background()
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+	svc := services.NewProgramService(db)
+
+	program, err := svc.AddProgram(ctx, services.AddProgramParams{
+		Name:      "test/queued",
+		Type:      "routine",
+		State:     "active",
+		Schedule:  "@hourly",
+		Deadline:  500,
+		Language:  "tengo",
+		CreatedBy: rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	programUUID := uuid.MustParse(program.Uuid)
+
+	_, err = svc.AddCodeRevision(ctx, services.AddCodeRevisionParams{
+		ProgramUuid: programUUID,
+		CreatedBy:   rootUUID,
+		Code:        []byte(`x := 1`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(WorkerTaskResult{Outcome: "success"})
+	}))
+	defer ts.Close()
+
+	workforce.Add("test/worker", NewWorker("test/worker", ts.URL, []string{"tengo"}, 1, time.Minute))
+	defer workforce.Delete("test/worker")
+
+	q := postgres.New(db)
+	claim := func(jobID int64) postgres.ProgramJob {
+		jobs, err := q.ClaimProgramJobs(ctx, postgres.ClaimProgramJobsParams{
+			ArgLimit: 10,
+			Lease:    30,
+		})
+		if err != nil {
+			t.Fatal(err)
+		} else if len(jobs) != 1 || jobs[0].ID != jobID {
+			t.Fatalf("expected the job to be claimed, got %v", jobs)
+		}
+		return jobs[0]
+	}
+
+	revision := 0
+	task, err := svc.FindProgramRunTask(ctx, programUUID, &revision)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The run returned when the routine is queued is the one which runs
+	run, err := svc.QueueProgramRun(ctx, task)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runJob(ctx, testDomain, q, claim(*run.Job)); err != nil {
+		t.Fatal(err)
+	}
+
+	runs, err := svc.FindProgramJobRuns(ctx, *run.Job)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(runs) != 1 || runs[0].Id != run.Id {
+		t.Fatalf("expected the queued run to be started, got %v runs", len(runs))
+	} else if runs[0].Outcome != "success" || runs[0].Worker != "test/worker" || runs[0].Ended == nil {
+		t.Errorf("unexpected run %+v", runs[0])
+	}
+
+	// A job killed before its run started fails the run
+	run, err = svc.QueueProgramRun(ctx, task)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := "inactive"
+	if _, err := svc.UpdateProgramByUuid(ctx, programUUID, services.UpdateProgramByUuidParams{State: &state}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runJob(ctx, testDomain, q, claim(*run.Job)); err == nil {
+		t.Error("expected the job to be killed")
+	}
+
+	found, err := svc.FindProgramRunById(ctx, programUUID, run.Id)
+	if err != nil {
+		t.Fatal(err)
+	} else if found.Outcome != "failure" || found.Error != "the program is not active" || found.Ended == nil {
+		t.Errorf("expected the queued run to fail, got %v %q", found.Outcome, found.Error)
+	}
+
+	if _, err := svc.DeleteProgram(ctx, programUUID); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package juvuln
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/self-host/self-host/postgres"
+)
+
+// The name of the lease held by the Program Manager which schedules the
+// routines of a domain.
+const schedulerLease = "scheduler"
+
+// Leadership tracks the domains for which this Program Manager holds the
+// scheduler lease. Every Program Manager keeps its program cache and accepts
+// workers, but only the leader of a domain queues its routines.
+type Leadership struct {
+	sync.RWMutex
+
+	holder string
+	until  map[string]time.Time
+}
+
+var leadership *Leadership
+
+func init() {
+	host, _ := os.Hostname()
+	leadership = &Leadership{
+		holder: fmt.Sprintf("%v/%v", host, uuid.New().String()),
+		until:  make(map[string]time.Time),
+	}
+}
+
+// IsLeader reports whether this Program Manager leads the domain.
+func IsLeader(domain string) bool {
+	leadership.RLock()
+	defer leadership.RUnlock()
+
+	return time.Now().Before(leadership.until[domain])
+}
+
+// ElectLeaders acquires, or extends, the scheduler lease of each DB. A lease
+// is regarded as lost a while before it expires in the DB, so that two
+// Program Managers never both believe they lead.
+func ElectLeaders(ctx context.Context) error {
+	ttl := viper.GetDuration("leader.ttl")
+	seen := make(map[string]struct{})
+
+	for _, item := range postgres.GetAllDB() {
+		if item.DB == nil {
+			continue
+		}
+		seen[item.Domain] = struct{}{}
+
+		// The lease can be relied on for less than its time to live, as
+		// counted from before it was asked for
+		until := time.Now().Add(ttl - viper.GetDuration("leader.interval"))
+
+		q := postgres.New(item.DB)
+		count, err := q.AcquireProgramManagerLease(ctx, postgres.AcquireProgramManagerLeaseParams{
+			Name:   schedulerLease,
+			Holder: leadership.holder,
+			Ttl:    int32(ttl / time.Second),
+		})
+		if err != nil {
+			logger.Error("unable to acquire lease", zap.String("domain", item.Domain), zap.Error(err))
+			count = 0
+		}
+
+		wasLeader := IsLeader(item.Domain)
+
+		leadership.Lock()
+		if count > 0 {
+			leadership.until[item.Domain] = until
+		} else {
+			delete(leadership.until, item.Domain)
+		}
+		leadership.Unlock()
+
+		if count > 0 && wasLeader == false {
+			logger.Info("leading domain", zap.String("domain", item.Domain), zap.String("holder", leadership.holder))
+			catchUp(ctx, item.Domain)
+		} else if count == 0 && wasLeader {
+			logger.Info("no longer leading domain", zap.String("domain", item.Domain))
+		}
+	}
+
+	// Forget the domains which are gone
+	leadership.Lock()
+	for domain := range leadership.until {
+		if _, ok := seen[domain]; ok == false {
+			delete(leadership.until, domain)
+		}
+	}
+	leadership.Unlock()
+
+	return nil
+}
+
+// ResignLeaders releases the leases held, so that another Program Manager
+// takes over without waiting for them to expire.
+func ResignLeaders(ctx context.Context) {
+	leadership.Lock()
+	defer leadership.Unlock()
+
+	for _, item := range postgres.GetAllDB() {
+		if _, ok := leadership.until[item.Domain]; ok == false || item.DB == nil {
+			continue
+		}
+
+		q := postgres.New(item.DB)
+		_, err := q.ReleaseProgramManagerLease(ctx, postgres.ReleaseProgramManagerLeaseParams{
+			Name:   schedulerLease,
+			Holder: leadership.holder,
+		})
+		if err != nil {
+			logger.Error("unable to release lease", zap.String("domain", item.Domain), zap.Error(err))
+		}
+	}
+
+	leadership.until = make(map[string]time.Time)
+}
+
+// catchUp queues the last tick of each routine of the domain, which was due
+// while no one led the domain. Ticks already queued by the former leader are
+// left out by the queue.
+func catchUp(ctx context.Context, domain string) {
+	window := viper.GetDuration("leader.ttl") + viper.GetDuration("leader.interval")
+
+	for _, p := range pcache.Routines(domain) {
+		scheduled, ok := lastTick(p.schedule, time.Now(), window)
+		if ok == false {
+			continue
+		}
+
+		p.enqueue(ctx, scheduled)
+	}
+}
+
+// lastTick returns the last time the schedule was due, no earlier than the
+// window before now.
+func lastTick(s cron.Schedule, now time.Time, window time.Duration) (time.Time, bool) {
+	var last time.Time
+
+	if s == nil {
+		return last, false
+	}
+
+	for t := s.Next(now.Add(-window)); t.IsZero() == false && t.After(now) == false; t = s.Next(t) {
+		last = t
+	}
+
+	return last, last.IsZero() == false
+}
@@ -0,0 +1,207 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package juvuln
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestLastTick(t *testing.T) {
+	base := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		schedule string
+		now      time.Time
+		window   time.Duration
+		expected time.Time
+		ok       bool
+	}{
+		// Run by the cron entry, shortly after the tick
+		{"@every 5m", base.Add(5*time.Minute + 20*time.Millisecond), time.Minute, base.Add(5 * time.Minute), true},
+		{"@every 5m", base.Add(7 * time.Minute), time.Minute, time.Time{}, false},
+		{"@every 10s", base.Add(30*time.Second + 400*time.Millisecond), time.Minute, base.Add(30 * time.Second), true},
+		{"0 */5 * * * *", base.Add(5*time.Minute + 20*time.Millisecond), time.Minute, base.Add(5 * time.Minute), true},
+		// Caught up by a new leader
+		{"@every 5m", base.Add(7 * time.Minute), 6 * time.Minute, base.Add(5 * time.Minute), true},
+		{"@hourly", base.Add(30 * time.Minute), time.Hour, base, true},
+	}
+
+	for _, c := range cases {
+		s, err := parseSchedule(c.schedule)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		scheduled, ok := lastTick(s, c.now, c.window)
+		if ok != c.ok || scheduled.Equal(c.expected) == false {
+			t.Errorf("%v at %v: expected %v %v, got %v %v", c.schedule, c.now, c.expected, c.ok, scheduled, ok)
+		}
+	}
+}
+
+func TestEveryScheduleIsSharedByProgramManagers(t *testing.T) {
+	s, err := parseSchedule("@every 5m")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Program Managers started at different times agree on the next tick
+	a := s.Next(time.Date(2021, 6, 1, 12, 1, 13, 0, time.UTC))
+	b := s.Next(time.Date(2021, 6, 1, 12, 3, 49, 0, time.UTC))
+	if a.Equal(b) == false {
+		t.Errorf("expected the same tick, got %v and %v", a, b)
+	}
+
+	// The entry is due when the grid says so
+	if next := s.Next(a); next.Sub(a) != 5*time.Minute {
+		t.Errorf("expected the tick after %v to be 5m later, got %v", a, next)
+	}
+}
+
+func TestLeaderHandOver(t *testing.T) {
+	ctx := context.Background()
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+	svc := services.NewProgramService(db)
+
+	// A new leader catches up on the tick of the last five minutes
+	ttl := viper.GetDuration("leader.ttl")
+	viper.Set("leader.ttl", 5*time.Minute)
+	defer viper.Set("leader.ttl", ttl)
+
+	program, err := svc.AddProgram(ctx, services.AddProgramParams{
+		Name:      "test/leader",
+		Type:      "routine",
+		State:     "active",
+		Schedule:  "@every 5m",
+		Deadline:  500,
+		Language:  "tengo",
+		CreatedBy: rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	programUUID := uuid.MustParse(program.Uuid)
+
+	rev, err := svc.AddCodeRevision(ctx, services.AddCodeRevisionParams{
+		ProgramUuid: programUUID,
+		CreatedBy:   rootUUID,
+		Code:        []byte(`x := 1`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = svc.SignCodeRevision(ctx, services.SignCodeRevisionParams{
+		ProgramUuid: programUUID,
+		Revision:    rev.Revision,
+		SignedBy:    rootUUID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := UpdateProgram(ctx, testDomain, programUUID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Another Program Manager leads the domain
+	_, err = db.Exec("INSERT INTO program_manager_leases(name, holder, expires) VALUES ($1, 'other', NOW() + INTERVAL '1 minute')", schedulerLease)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ElectLeaders(ctx); err != nil {
+		t.Fatal(err)
+	} else if IsLeader(testDomain) {
+		t.Fatal("expected the lease held by another to be kept")
+	}
+
+	jobs := func() []*rest.ProgramJob {
+		list, err := svc.FindProgramJobs(ctx, services.FindProgramJobsParams{
+			PaginationParams: services.PaginationParams{Limit: services.PaginationLimit{Value: 10}},
+			Program:          &programUUID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return list
+	}
+	if list := jobs(); len(list) != 0 {
+		t.Fatalf("expected no job to be queued by a follower, got %v", len(list))
+	}
+
+	// The lease of the other expires, and is taken over
+	_, err = db.Exec("UPDATE program_manager_leases SET expires = NOW() - INTERVAL '1 second' WHERE name = $1", schedulerLease)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ElectLeaders(ctx); err != nil {
+		t.Fatal(err)
+	} else if IsLeader(testDomain) == false {
+		t.Fatal("expected the expired lease to be taken over")
+	}
+
+	list := jobs()
+	if len(list) != 1 || list[0].Scheduled == nil {
+		t.Fatalf("expected the last tick to be queued, got %v", list)
+	}
+	tick := *list[0].Scheduled
+	if tick.Equal(tick.Truncate(5*time.Minute)) == false {
+		t.Errorf("expected the tick to be on the five minute grid, got %v", tick)
+	}
+
+	// Resigning hands the lease over at once
+	ResignLeaders(ctx)
+	if IsLeader(testDomain) {
+		t.Error("expected to no longer lead the domain")
+	}
+
+	q := postgres.New(db)
+	count, err := q.AcquireProgramManagerLease(ctx, postgres.AcquireProgramManagerLeaseParams{
+		Name:   schedulerLease,
+		Holder: "other",
+		Ttl:    60,
+	})
+	if err != nil {
+		t.Fatal(err)
+	} else if count != 1 {
+		t.Fatal("expected the released lease to be acquired by another")
+	}
+
+	// Catching up again leaves out the tick already queued
+	_, err = db.Exec("UPDATE program_manager_leases SET expires = NOW() - INTERVAL '1 second' WHERE name = $1", schedulerLease)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ElectLeaders(ctx); err != nil {
+		t.Fatal(err)
+	} else if IsLeader(testDomain) == false {
+		t.Fatal("expected the expired lease to be taken over")
+	}
+
+	if list := jobs(); len(list) != 1 {
+		t.Errorf("expected the tick to be queued once, got %v jobs", len(list))
+	}
+
+	ResignLeaders(ctx)
+
+	if _, err := svc.DeleteProgram(ctx, programUUID); err != nil {
+		t.Fatal(err)
+	}
+	if err := UpdateProgram(ctx, testDomain, programUUID); err != nil {
+		t.Fatal(err)
+	}
+}
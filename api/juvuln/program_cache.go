@@ -12,18 +12,17 @@ import (
 type ProgramCache struct {
 	sync.RWMutex
 	m map[string]*ProgramRevision
-
-	deletes map[string]struct{}
 }
 
 func (p *ProgramCache) Add(obj *ProgramRevision) {
 	p.Lock()
 	defer p.Unlock()
 
-	id := obj.GetId()
+	p.add(obj)
+}
 
-	// Remove id from deletion list
-	delete(p.deletes, id)
+func (p *ProgramCache) add(obj *ProgramRevision) {
+	id := obj.GetId()
 
 	if a, ok := p.m[id]; ok {
 		if a.Equals(obj) {
@@ -43,29 +42,44 @@ func (p *ProgramCache) Add(obj *ProgramRevision) {
 	p.m[id] = obj
 }
 
-func (p *ProgramCache) Begin() {
-	// Mark all items for deletion
+// Sync replaces the items which match with the items given, so that an item
+// which did not change keeps running. The items are replaced at once, so that
+// no one sees the cache in between.
+func (p *ProgramCache) Sync(match func(*ProgramRevision) bool, items []*ProgramRevision) {
+	keep := make(map[string]struct{})
+	for _, obj := range items {
+		keep[obj.GetId()] = struct{}{}
+	}
+
 	p.Lock()
 	defer p.Unlock()
 
-	p.deletes = make(map[string]struct{})
-	for k := range p.m {
-		p.deletes[k] = struct{}{}
+	for id, obj := range p.m {
+		if _, ok := keep[id]; ok == false && match(obj) {
+			obj.Stop()
+			delete(p.m, id)
+		}
+	}
+
+	for _, obj := range items {
+		p.add(obj)
 	}
 }
 
-func (p *ProgramCache) Commit() {
-	// Remove all items still marked for deletion
-	p.Lock()
-	defer p.Unlock()
+// Routines returns the routines of the domain.
+func (p *ProgramCache) Routines(domain string) []*ProgramRevision {
+	p.RLock()
+	defer p.RUnlock()
 
-	for k := range p.deletes {
-		if obj, ok := p.m[k]; ok {
-			obj.Stop()
+	routines := make([]*ProgramRevision, 0)
+	for _, item := range p.m {
+		if item.Domain == domain && item.Type == "routine" {
+			v := item
+			routines = append(routines, v)
 		}
-		delete(p.m, k)
 	}
-	p.deletes = make(map[string]struct{})
+
+	return routines
 }
 
 func (p *ProgramCache) GetModule(domain, name string, revision int32) (*ProgramRevision, error) {
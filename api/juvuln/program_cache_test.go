@@ -0,0 +1,100 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package juvuln
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/internal/services"
+)
+
+func TestProgramChangeDuringResync(t *testing.T) {
+	ctx := context.Background()
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+	svc := services.NewProgramService(db)
+
+	l := newProgramListener(testDomain, pgUrl)
+	defer l.stop()
+
+	cached := func(id uuid.UUID) bool {
+		for _, p := range pcache.Routines(testDomain) {
+			if p.ProgramUuid == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	// waitFor waits for the listener to apply a change to the cache
+	waitFor := func(what string, id uuid.UUID, expected bool) {
+		for i := 0; cached(id) != expected; i++ {
+			if i == 500 {
+				t.Fatalf("expected the cache to be updated when %v", what)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	program, err := svc.AddProgram(ctx, services.AddProgramParams{
+		Name:      "test/resync",
+		Type:      "routine",
+		State:     "active",
+		Schedule:  "@hourly",
+		Deadline:  500,
+		Language:  "tengo",
+		CreatedBy: rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	programUUID := uuid.MustParse(program.Uuid)
+
+	rev, err := svc.AddCodeRevision(ctx, services.AddCodeRevisionParams{
+		ProgramUuid: programUUID,
+		CreatedBy:   rootUUID,
+		Code:        []byte(`x := 1`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = svc.SignCodeRevision(ctx, services.SignCodeRevisionParams{
+		ProgramUuid: programUUID,
+		Revision:    rev.Revision,
+		SignedBy:    rootUUID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitFor("signed", programUUID, true)
+
+	// The program is deactivated after a resync has read it, but before the
+	// resync applies what it read
+	domainCacheRead = func(domain string) {
+		domainCacheRead = func(string) {}
+
+		state := "inactive"
+		_, err := svc.UpdateProgramByUuid(ctx, programUUID, services.UpdateProgramByUuidParams{State: &state})
+		if err != nil {
+			t.Error(err)
+		}
+
+		// Let the notification reach the listener
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if err := UpdateProgramCache(); err != nil {
+		t.Fatal(err)
+	}
+	waitFor("deactivated during a resync", programUUID, false)
+
+	if _, err := svc.DeleteProgram(ctx, programUUID); err != nil {
+		t.Fatal(err)
+	}
+}
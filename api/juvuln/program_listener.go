@@ -0,0 +1,155 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package juvuln
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+
+	"github.com/self-host/self-host/postgres"
+)
+
+// The channel on which the DB notifies of changed programs.
+const programChangesChannel = "program_changes"
+
+type programListener struct {
+	domain           string
+	connectionString string
+	listener         *pq.Listener
+	quit             chan struct{}
+}
+
+var (
+	listeners    = make(map[string]*programListener)
+	listenersMux sync.Mutex
+)
+
+// ListenForProgramChanges listens for changed programs on the DB of each
+// domain, and applies them to the program cache as they happen. Listening is
+// stopped for the domains which are gone, and started again for a domain
+// whose DB changed.
+func ListenForProgramChanges() {
+	listenersMux.Lock()
+	defer listenersMux.Unlock()
+
+	domains := make(map[string]struct{})
+
+	for _, domain := range postgres.GetDomains() {
+		domains[domain] = struct{}{}
+
+		connStr, err := postgres.GetConnectionString(domain)
+		if err != nil {
+			continue
+		}
+
+		if l, ok := listeners[domain]; ok {
+			if l.connectionString == connStr {
+				continue
+			}
+			l.stop()
+		}
+
+		listeners[domain] = newProgramListener(domain, connStr)
+	}
+
+	for domain, l := range listeners {
+		if _, ok := domains[domain]; ok == false {
+			l.stop()
+			delete(listeners, domain)
+		}
+	}
+}
+
+// StopListening stops listening for changed programs.
+func StopListening() {
+	listenersMux.Lock()
+	defer listenersMux.Unlock()
+
+	for domain, l := range listeners {
+		l.stop()
+		delete(listeners, domain)
+	}
+}
+
+func newProgramListener(domain, connStr string) *programListener {
+	l := &programListener{
+		domain:           domain,
+		connectionString: connStr,
+		quit:             make(chan struct{}),
+	}
+
+	l.listener = pq.NewListener(connStr, time.Second, 30*time.Second, func(ev pq.ListenerEventType, err error) {
+		switch ev {
+		case pq.ListenerEventDisconnected:
+			logger.Error("lost connection to DB for program changes", zap.String("domain", domain), zap.Error(err))
+		case pq.ListenerEventConnectionAttemptFailed:
+			logger.Debug("unable to connect to DB for program changes", zap.String("domain", domain), zap.Error(err))
+		case pq.ListenerEventReconnected:
+			logger.Info("reconnected to DB for program changes", zap.String("domain", domain))
+		}
+	})
+
+	go l.run()
+
+	return l
+}
+
+func (l *programListener) stop() {
+	close(l.quit)
+	l.listener.Close()
+}
+
+func (l *programListener) run() {
+	// Blocks until connected, or until closed
+	if err := l.listener.Listen(programChangesChannel); err != nil {
+		select {
+		case <-l.quit:
+		default:
+			logger.Error("unable to listen for program changes", zap.String("domain", l.domain), zap.Error(err))
+		}
+		return
+	}
+
+	// Changes made before listening started are not notified
+	l.resync()
+
+	for {
+		select {
+		case n := <-l.listener.Notify:
+			if n == nil {
+				// Notifications may have been lost while reconnecting
+				l.resync()
+				continue
+			}
+
+			programUUID, err := uuid.Parse(n.Extra)
+			if err != nil {
+				continue
+			}
+
+			err = UpdateProgram(context.Background(), l.domain, programUUID)
+			if err != nil {
+				logger.Error("unable to update program", zap.String("domain", l.domain), zap.Error(err))
+			}
+		case <-time.After(90 * time.Second):
+			// Detect a connection which has gone away silently
+			go l.listener.Ping()
+		case <-l.quit:
+			return
+		}
+	}
+}
+
+func (l *programListener) resync() {
+	err := UpdateDomainCache(context.Background(), l.domain)
+	if err != nil {
+		logger.Error("unable to update programs", zap.String("domain", l.domain), zap.Error(err))
+	}
+}
@@ -6,24 +6,51 @@ package juvuln
 
 import (
 	"bytes"
-	"encoding/base64"
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/robfig/cron/v3"
-	"github.com/self-host/self-host/pkg/workforce"
 	"go.uber.org/zap"
 )
 
-var watch *cron.Cron
+var (
+	watch  *cron.Cron
+	parser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+)
 
 func init() {
-	watch = cron.New(cron.WithSeconds())
+	watch = cron.New(cron.WithParser(parser))
 	watch.Start()
 }
 
+// gridSchedule is due every interval, on a grid counted from the zero time
+// rather than from the time asked about. Every Program Manager then agrees on
+// the time of each tick, which is what the queue tells ticks apart by.
+type gridSchedule struct {
+	interval time.Duration
+}
+
+func (s gridSchedule) Next(t time.Time) time.Time {
+	return t.Truncate(s.interval).Add(s.interval)
+}
+
+// parseSchedule parses the schedule of a routine. An @every schedule is kept
+// on a grid, as cron counts it from when it is asked about.
+func parseSchedule(spec string) (cron.Schedule, error) {
+	schedule, err := parser.Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if s, ok := schedule.(cron.ConstantDelaySchedule); ok {
+		return gridSchedule{interval: s.Delay}, nil
+	}
+
+	return schedule, nil
+}
+
 type ProgramRevision struct {
 	Domain      string
 	Name        string
@@ -36,7 +63,18 @@ type ProgramRevision struct {
 	Code        []byte
 	Checksum    string
 
-	eid cron.EntryID
+	eid      cron.EntryID
+	schedule cron.Schedule
+}
+
+// WorkerTaskResult is the result of a run, as returned by the worker.
+type WorkerTaskResult struct {
+	Outcome string `json:"outcome"`
+	Error   string `json:"error"`
+	Output  string `json:"output"`
+
+	// Until when the worker keeps the program cached
+	Expires time.Time `json:"-"`
 }
 
 type WorkerTask struct {
@@ -80,50 +118,36 @@ func (p *ProgramRevision) Start() {
 		return
 	}
 
-	eid, err := watch.AddFunc(p.Schedule, p.Run)
+	schedule, err := parseSchedule(p.Schedule)
 	if err != nil {
 		logger.Error("unable to schedule task", zap.Error(err))
+		return
 	}
-	p.eid = eid
+	p.schedule = schedule
+	p.eid = watch.Schedule(schedule, p)
 }
 
+// Run queues the routine when due by its schedule, as long as this Program
+// Manager leads the domain. Every Program Manager keeps the schedule, so that
+// another one can take over at once.
 func (p *ProgramRevision) Run() {
-	err := p.Execute()
-	if err != nil {
-		logger.Error("unable to execute task", zap.Error(err))
-	}
-}
-
-func (p *ProgramRevision) Execute() error {
-	code := base64.StdEncoding.EncodeToString([]byte(p.Code))
-	requestBody, err := json.Marshal(WorkerTask{
-		ProgramUuid: p.ProgramUuid,
-		Domain:      p.Domain,
-		Language:    p.Language,
-		Deadline:    int(p.Deadline),
-		SourceCode:  code,
-	})
-	if err != nil {
-		return err
-	}
-
-	w, err := workforce.GetAvailable()
-	if err != nil {
-		return nil
+	if IsLeader(p.Domain) == false {
+		return
 	}
 
-	worker, ok := w.(*Worker)
+	scheduled, ok := lastTick(p.schedule, time.Now(), time.Minute)
 	if ok == false {
-		return fmt.Errorf("incorrect format for worker")
+		return
 	}
 
-	resp, err := http.Post(worker.URI+"/v1/tasks", "application/json", bytes.NewBuffer(requestBody))
+	p.enqueue(context.Background(), scheduled)
+}
+
+func (p *ProgramRevision) enqueue(ctx context.Context, scheduled time.Time) {
+	_, err := EnqueueProgramJob(ctx, p.Domain, p.ProgramUuid, p.Revision, "schedule", scheduled)
 	if err != nil {
-		return err
+		logger.Error("unable to queue task", zap.Error(err))
 	}
-	defer resp.Body.Close()
-
-	return nil
 }
 
 func (p *ProgramRevision) Stop() {
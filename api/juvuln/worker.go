@@ -5,6 +5,10 @@
 package juvuln
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"sync"
 	"time"
 )
@@ -16,6 +20,7 @@ type Worker struct {
 	URI       string
 	Languages []string
 
+	capacity uint64
 	load     uint64
 	timeout  time.Duration
 	lastSeen time.Time
@@ -34,18 +39,75 @@ func (w *Worker) GetLoad() uint64 {
 	return w.load
 }
 
+// Speaks reports whether the worker runs programs in the language.
+func (w *Worker) Speaks(language string) bool {
+	for _, l := range w.Languages {
+		if l == language {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *Worker) Capacity() uint64 {
+	return w.capacity
+}
+
 func (w *Worker) Alive() bool {
 	w.Lock()
 	defer w.Unlock()
 	return time.Now().Before(w.lastSeen.Add(w.timeout))
 }
 
-func NewWorker(id, uri string, langs []string, timeout time.Duration) *Worker {
+func NewWorker(id, uri string, langs []string, capacity uint64, timeout time.Duration) *Worker {
+	if capacity == 0 {
+		capacity = 1
+	}
+
 	return &Worker{
 		Id:        id,
 		URI:       uri,
 		Languages: langs,
+		capacity:  capacity,
 		timeout:   timeout,
 		lastSeen:  time.Now(),
 	}
 }
+
+// Execute posts a task to the worker and returns the result of the run, with
+// how long the worker keeps the program cached. The request is given up on
+// when the worker has not returned a while after the deadline of the task.
+func (w *Worker) Execute(task []byte, deadline time.Duration) (WorkerTaskResult, error) {
+	var result WorkerTaskResult
+
+	client := &http.Client{
+		Timeout: deadline + 30*time.Second,
+	}
+
+	resp, err := client.Post(w.URI+"/v1/tasks", "application/json", bytes.NewBuffer(task))
+	if err != nil {
+		return result, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			Message string `json:"message"`
+		}
+		json.NewDecoder(resp.Body).Decode(&e)
+		if e.Message == "" {
+			e.Message = resp.Status
+		}
+		return result, fmt.Errorf("worker error: %v", e.Message)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return result, err
+	}
+
+	if expires, err := http.ParseTime(resp.Header.Get("X-Expires")); err == nil {
+		result.Expires = expires
+	}
+
+	return result, nil
+}
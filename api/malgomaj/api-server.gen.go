@@ -197,27 +197,31 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xW3W/bNhD/Vw7sHlpAlmTno5ne0q3rinVNsKTIgMwozuRZYiORGknF8QL/7wNJyY4/",
-	"0q5vezIt3h3vfve7j0fGddNqRcpZVjyyilCQCcc/R28fWmko/BFkuZGtk1qxgp0r+PX6+nIk0BE42ZB1",
-	"2LQpSxg9YNPWxIqNegE3JBKYjOGCO5jk4xPIXxeTsyLP4d3v1yxhllfUoH/GLVuva52RqmSr1Sphhv7u",
-	"yLo3WsjoykdaXKO980eulSPl/BHbtpYcvYPZF+u9fHxitzW6JePkEAyKWiry57XDJ3meJ6zBB9l0DStO",
-	"8/hfqvg/TwbnpHJUkmGrhAndoFRbZliz7L8mu9EkrHKu3UfzIhywTuGmIgVSQWcJXEXg0N6BtEAPxDtH",
-	"AtACBuihNZqT6Ax51LfDm2mx9L9zbRp0rGCzpaOD7mySjULI6MXllq09nf6Dnn0h7tiQIGlIsOJ2bTGJ",
-	"Tkz35BNWoyo7LCP4ykN7yxypUnvpDYzx0wGnW6NLg83nrpNiG3k8mRCOz8QIj/BsdJzPxiOc0dnoaDI5",
-	"OyU6nZ/Oxiyk+AOp0lWsODoNGX76d+89qzvD6TPXgv4DqDt4rJm25soTAHZi2X5qGsi/TRRPe3Aa0FpZ",
-	"Kn/yJFloc0fG02DzsjMdRV9sq5WNufxFm5kUgtQ+BT9qB1jXekHCm23J+DiDeeReJvVQvFeOjML6isw9",
-	"mbfGaLNvahACG6SAgtgqYZ8Udq7SRv5D4pDaPdZSgJch5fpSBm5I+L9Y2zSgG2s6xHPl0HV2v7xrjQce",
-	"uK4IeGcMKQdeAl5iWRoq0dfVkCf76mkPO0426ZbKnR6zrzeEneQHP6bBaeKdkW555Z2PTr5BK/l552nX",
-	"Nypvaua/blgV+kUgglRzvR/TixdwQzXXDQ1k0PO55BJrEJp3DSkXYdTzcPvx4udzuKJ6Xmnr4DKyD24C",
-	"geD88n36VyCo5KRsYLvC4Na7yw+jozQfaVUvWcI6U/fO2SLLdEsqMjfVpsx6bZv1SqFpSBcq9Fvvs4Td",
-	"k7ExuDwdR23/ALaSFewozdPc1w26KqCY3Y8zu6ZBSW4fo3fkoBcJpkxA5L2IV1fDzValTPL8u8bLD4bm",
-	"Ph3ZZphmPVGz/oUD5Xzxm4/uOB8/Z2LtU7ZVO0Hp6NtKm3pfJewkhvR1jUMF/pS/rLjdYu4tY9PVNGG2",
-	"axo0yx7t2JA2oDssrZftP4SK8Inz0y2Wr7YHEncuBCAoWkAY97vJ+8kQOuqvNkvC8vkon+wR2bBErA5n",
-	"ftuTq45zsjaFc3jpLcqaxCvom7efzxx5RQI65WQdCs0vRiO/GIFticu5JAGzZbha70UQh6W32usPFhey",
-	"rmFGYKizJGAhXaU7B7G3SFWCoejGVnHzivid7Rpo0PGKrJ8Iz61zhwDqZbONYODs/56fvqzm2NUHKBT2",
-	"qbg8edhwjXDYoxY0q7S+g54ZSUBxeAw4Kp8DVEtXSVWm31kIe+wdqiCyPo73Z81FYyFKGy5jy31sjXaa",
-	"63pVZNljvF/5polG4qymYRgGmQhHj0wcJbsd6HIQTdarWC8XmjubrgYvto2NJ699J07HxVn+4+me2Zge",
-	"+PTHB0+h6Tr23fSkqedoP2MiLqvp6t8AAAD//0CJJOGTDAAA",
+	"H4sIAAAAAAAC/9RXS3PbvhH/KjtID8kMRVHyIw5vTpuknqa2J3bGnbqaBAJWJGISYPGwrHr43TsASD2Z",
+	"uDn08D+JJBb7+O3ub1fPhKm6URKlNSR/JiVSjjo8/mP04akRGsMLR8O0aKxQkuTkXMJfb2+vR5xaBCtq",
+	"NJbWTUoSgk+0biok+eZ6DnfIE5hO4IpZmGaTE8je5tOzPMvg099vSUIMK7Gm3oxdNf6usVrIgrRtmxCN",
+	"/3Zo7HvFRXTlEpe31Dz4R6akRWn9I22aSjDqHRz/MN7L5y29jVYNaiv6YCivhET/vHb4JMuyhNT0SdSu",
+	"JvlpFt+FjO9Z0jsnpMUCNWkTwlVNhdxRQ+pV9zXZjyYhpbXNIZpX4YFWKdyVKEFIcAbBlgiWmgcQBvAJ",
+	"mbPIgRqgAXpotGLInUaP+m54c8VX/nehdE0tycl8ZXHQnU2yKecienG9o+vgTvdBzX8gs6RPkNDISX6/",
+	"1phEJ2YH8gmpqCwcLSL40kN7TyzKQnnpDYzx04DTjVaFpvU35wTfRZ6eTJFOzviIHtGz0XE2n4zoHM9G",
+	"R9Pp2Sni6eJ0PiEhxZ9RFrYk+dFpyPD264E9o5xm+I0pjv8DqHt4rCttXStbAOzFsmtqFop/t1B82YNV",
+	"QI0RhfRPvkiWSj+gTsm2ZasdRl9Mo6SJufyo9FxwjvKwBC+VBVpVaoncq21Q+ziDesq8TOqhuJAWtaTV",
+	"DepH1B+0VvpQVS8EJkgBBrE2IV8ldbZUWvwH+dC1R1oJDl4Gpe1aGZhG7l9pZdKAbuzpEM+NpdaZw/au",
+	"FB0wcFsiMKc1SgteAl7TotBYUN9XfZ7Mm20OO0426RbSnh6TXxPCXvKDH7M2CXn7gsZV9tBZHEbRexuO",
+	"QC2AQlcosCwFK4ELDlJZMI4x9NxKK6MGBRWaIOlJXlS4y9BfnPTcDSGReZSjVUXnFebgJMeFkMiHelA5",
+	"y1SNA4MBFlRUyNd+aLROS89cMsaTeNcqPHDVe8JBOQuaSmiosSCsWScmeN6xRQjbGJIQb8zp0IaiRuXs",
+	"LodsBIciaJwdxj2ewVILa1HCfBX6oHfXllq5ogzfvleq+A614m4f22k2nYwm2Wjy7nYyzbMsz7J/wsXl",
+	"xyswlmqL/F/yRfLoUV57O/MSBpnTwq5ufCPEGnpPjWDnzpbroee1zv3XjZEwewKpCLlQh5G/egV3WHmD",
+	"PbGoxUIwQSvgirkapY0tqRbh9PLqL+dwg9WiVMbCdYfOXSAjOL++SEOElWAoTagUSYNbn64/j47SbKRk",
+	"tSIJcbrqnDP5eKwalJEFU6WLcXfbjLtLYQAJGxB+yT5JyCNqE4PL0km87Q3QRpCcHKVZmpGENNSWAcXx",
+	"42Rs1pRS4EB1fEILnUhQpQMiFzwe3fQnO6w7zbLfWlX+pHHh0zHeLGbjeGrGnYWB0XD1Nx/dcTb5mYq1",
+	"T+MdHg6Xjl6+tJkdbUJOsuzlG0PDYrt+SX6/U7n3hMzaWUKMq2uqVx3acbhtQLe0MF62+xA6wifOb0px",
+	"FCgzkLhzzoGCxCWE1XE/eX/WSC12R5uFc/XzKLd20nG/kLb/x8xvjZCB7N9EokvhdouoNJUJLIWNVKXD",
+	"5b55tZMpnMPrbi7wN+tbwgCjrEQOfjpUQdqv+aMwKkyDTCwE8p4V11s+xNXPa+3ur/ldVBXMvQfOIA8e",
+	"BZoPTCdkARqjGzv0wkpkD8bVUFPLSjSeXn/252QIuE52vBFs2z9Ch/jULmi3KuxmOfw7iH8FPGybCRr+",
+	"FSxxXir1AF1tJn3WgzFgVPocULmypZBF+puteNA/fR/Gvpu1v1QXlYUoTTiMpP/caGUVU1Wbj8fP8bz1",
+	"tE218EtIv9oFmQhHh0wcZvtdcN2LblaFTs7/mLCKRSu7yibTt34WpJP8LHt3eqA2pge+fvnsS2i2jn0/",
+	"PWnqa7SbchGXdtb+dwBar4TQYQ8AAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -12,12 +12,36 @@ const (
 	BasicAuthScopes = "BasicAuth.Scopes"
 )
 
+// Defines values for TaskResultOutcome.
+const (
+	TaskResultOutcomeFailure TaskResultOutcome = "failure"
+
+	TaskResultOutcomeSuccess TaskResultOutcome = "success"
+
+	TaskResultOutcomeTimeout TaskResultOutcome = "timeout"
+)
+
 // Status defines model for Status.
 type Status struct {
 	// The current load (aggregated deadlines)
 	Load int64 `json:"load"`
 }
 
+// TaskResult defines model for TaskResult.
+type TaskResult struct {
+	// The error of a program which did not succeed, also of a program which does not compile.
+	Error *string `json:"error,omitempty"`
+
+	// A failed program returned an error, while a program which timed out ran past its deadline.
+	Outcome TaskResultOutcome `json:"outcome"`
+
+	// The output written by the program through the `log` module.
+	Output string `json:"output"`
+}
+
+// A failed program returned an error, while a program which timed out ran past its deadline.
+type TaskResultOutcome string
+
 // NewTask defines model for NewTask.
 type NewTask struct {
 	Deadline int    `json:"deadline"`
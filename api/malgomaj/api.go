@@ -10,6 +10,7 @@ package malgomaj
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -57,16 +58,25 @@ func (ra *RestApi) CreateTask(w http.ResponseWriter, r *http.Request) {
 		// Compile
 		err = prog.Compile(ctx)
 		if err != nil {
-			e, ok := err.(*library.LibraryError)
-			if ok {
-				ie.SendHTTPError(w, &ie.HTTPError{
-					Code:    500,
-					Message: fmt.Sprintf("library error (%d) %v", e.Code, e.Message),
-				})
+			// A program which does not compile is a failed run, and the error
+			// is returned as such to the caller.
+			var msg string
+			var cerr *CompileError
+			if e, ok := err.(*library.LibraryError); ok {
+				msg = fmt.Sprintf("library error (%d) %v", e.Code, e.Message)
+			} else if errors.As(err, &cerr) {
+				msg = cerr.Error()
+			} else {
+				ie.SendHTTPError(w, ie.NewInternalServerError(err))
 				return
 			}
 
-			ie.SendHTTPError(w, ie.NewInternalServerError(err))
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(TaskResult{
+				Outcome: TaskResultOutcomeFailure,
+				Error:   &msg,
+			})
 			return
 		}
 
@@ -95,16 +105,29 @@ func (ra *RestApi) CreateTask(w http.ResponseWriter, r *http.Request) {
 			ie.SendHTTPError(w, ie.NewInternalServerError(err))
 			return
 		}
-	} else {
-		// Run program
-		err := cacheItem.program.Run(ctx)
-		if err != nil {
-			ie.SendHTTPError(w, ie.NewInternalServerError(err))
-			return
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// Run program
+	output, err := cacheItem.program.Run(ctx)
+
+	result := TaskResult{
+		Outcome: TaskResultOutcomeSuccess,
+		Output:  output,
+	}
+	if err != nil {
+		result.Outcome = TaskResultOutcomeFailure
+		if errors.Is(err, context.DeadlineExceeded) {
+			result.Outcome = TaskResultOutcomeTimeout
 		}
+		msg := err.Error()
+		result.Error = &msg
 	}
 
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
 }
 
 func (ra *RestApi) GetStatus(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,113 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package malgomaj
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func createTask(t *testing.T, ra *RestApi, task NewTask) TaskResult {
+	t.Helper()
+
+	body, err := json.Marshal(task)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	ra.CreateTask(w, httptest.NewRequest(http.MethodPost, "/v1/tasks", bytes.NewBuffer(body)))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %v", w.Code, w.Body.String())
+	}
+
+	var result TaskResult
+	if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+		t.Fatal(err)
+	}
+
+	return result
+}
+
+func TestDryRunDoesNotReplaceCachedProgram(t *testing.T) {
+	SetCacheTimeout(60)
+	defer SetCacheTimeout(0)
+
+	ra := New()
+	task := func(code string) NewTask {
+		return NewTask{
+			Deadline:    1000,
+			Domain:      "test",
+			Language:    NewTaskLanguageTengo,
+			ProgramUuid: "a52ea18d-a3a8-40b1-abe8-32286ee6f6b1",
+			SourceCode:  []byte(code),
+		}
+	}
+	signed := task("log := import(\"log\")\nlog.info(\"signed\")")
+	draft := task("log := import(\"log\")\nlog.info(\"draft\")")
+
+	// The signed revision is scheduled, and cached by the worker
+	if r := createTask(t, ra, signed); strings.Contains(r.Output, "signed") == false {
+		t.Fatalf("expected the signed revision to run, got %q", r.Output)
+	}
+
+	// A dry run of a draft of the same program runs the draft
+	if r := createTask(t, ra, draft); strings.Contains(r.Output, "draft") == false {
+		t.Fatalf("expected the draft to run, got %q", r.Output)
+	}
+
+	// The next scheduled run still runs the signed revision
+	if r := createTask(t, ra, signed); strings.Contains(r.Output, "signed") == false {
+		t.Errorf("expected the signed revision to run after a dry run, got %q", r.Output)
+	}
+}
+
+func TestDryRunWithoutCachedProgram(t *testing.T) {
+	SetCacheTimeout(60)
+	defer SetCacheTimeout(0)
+
+	ra := New()
+	task := func(code string) NewTask {
+		return NewTask{
+			Deadline:    1000,
+			Domain:      "test",
+			Language:    NewTaskLanguageTengo,
+			ProgramUuid: "0b4bd1c6-7e59-4f33-9a42-3c9ef7d1a6c0",
+			SourceCode:  []byte(code),
+		}
+	}
+
+	// A dry run on a worker which has not cached the program
+	if r := createTask(t, ra, task("log := import(\"log\")\nlog.info(\"draft\")")); strings.Contains(r.Output, "draft") == false {
+		t.Fatalf("expected the draft to run, got %q", r.Output)
+	}
+
+	// A scheduled run is not handed the draft
+	if r := createTask(t, ra, task("log := import(\"log\")\nlog.info(\"signed\")")); strings.Contains(r.Output, "signed") == false {
+		t.Errorf("expected the signed revision to run after a dry run, got %q", r.Output)
+	}
+}
+
+func TestCompileErrorIsFailure(t *testing.T) {
+	r := createTask(t, New(), NewTask{
+		Deadline:    1000,
+		Domain:      "test",
+		Language:    NewTaskLanguageTengo,
+		ProgramUuid: "5f3c2a8e-2d7b-4c4e-8a1f-6b9d0e7c3a21",
+		SourceCode:  []byte(`x := `),
+	})
+
+	if r.Outcome != TaskResultOutcomeFailure {
+		t.Errorf("expected outcome failure, got %v", r.Outcome)
+	}
+	if r.Error == nil || *r.Error == "" {
+		t.Errorf("expected the compile error")
+	}
+}
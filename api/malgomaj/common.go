@@ -5,6 +5,7 @@
 package malgomaj
 
 import (
+	"crypto/md5"
 	"fmt"
 )
 
@@ -25,7 +26,14 @@ type NewTaskHttp struct {
 
 // Return the Id used by the Cache
 func (t *NewTask) GetId() string {
-	return fmt.Sprintf("%v/%v", t.Domain, t.ProgramUuid)
+	return ProgramId(t.Domain, t.ProgramUuid, t.SourceCode)
+}
+
+// ProgramId identifies a program by its source code, as well as by its UUID,
+// so that another revision of the program, such as that of a dry run, is
+// cached apart from it.
+func ProgramId(domain string, programUUID string, sourceCode []byte) string {
+	return fmt.Sprintf("%v/%v@%x", domain, programUUID, md5.Sum(sourceCode))
 }
 
 func GetOpenAPIFile() ([]byte, error) {
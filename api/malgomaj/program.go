@@ -16,7 +16,7 @@ import (
 type Program interface {
 	Equals(b Program) bool
 	Compile(context.Context) error
-	Run(context.Context) error
+	Run(context.Context) (string, error) // Returns the output of the run
 	RunWithHTTP(context.Context, http.ResponseWriter, *http.Request) error
 	Modules() []string
 	Language() string
@@ -8,7 +8,6 @@ import (
 	"context"
 	"crypto/md5"
 	"errors"
-	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
@@ -37,7 +36,7 @@ var AllowedBaseModules = []string{
 var ExtraModules = map[string]map[string]tengo.Object{
 	"fmt":  fmtModule, // Our own fmt module without Print* functions
 	"http": httpModule,
-	"log":  logModule,
+	"log":  nil, // Initialize on every call
 	"cgi":  nil, // Initialize on every call
 }
 
@@ -55,16 +54,39 @@ func moduleMapKeys(m map[string]map[string]tengo.Object) []string {
 	return keys
 }
 
+// CompileError is the error of a program which does not compile.
+type CompileError struct {
+	Err error
+}
+
+func (e *CompileError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CompileError) Unwrap() error {
+	return e.Err
+}
+
+// tengoInstance is a compiled copy of a program, with modules of its own. An
+// instance is used by one run at a time, so that the output and the CGI
+// request of concurrent runs are kept apart.
+type tengoInstance struct {
+	byteCode *tengo.Compiled
+
+	cgi *cgiModule
+	log *logModule
+}
+
 type TengoProgram struct {
-	sync.RWMutex
+	sync.Mutex
 
 	domain     string
 	id         string
 	deadline   time.Duration
 	sourceCode []byte
-	byteCode   *tengo.Compiled // Compiled code
+	libraries  map[string][]byte // Source code of the modules, by import name
 
-	cgi *cgiModule
+	idle []*tengoInstance // Compiled instances not in use by a run
 }
 
 func NewTengoProgram(domain string, id string, deadline time.Duration, sourceCode []byte) *TengoProgram {
@@ -81,7 +103,7 @@ func (p *TengoProgram) Language() string {
 }
 
 func (p *TengoProgram) Id() string {
-	return fmt.Sprintf("%v/%v", p.domain, p.id)
+	return ProgramId(p.domain, p.id, p.sourceCode)
 }
 
 func (p *TengoProgram) Deadline() time.Duration {
@@ -99,10 +121,6 @@ func (p *TengoProgram) Equals(b Program) bool {
 		p.Checksum() == b.Checksum()
 }
 
-func (p *TengoProgram) IsCGI() bool {
-	return p.cgi != nil
-}
-
 func (p *TengoProgram) AllImports() []string {
 	// Use a map to avoid duplicates
 	m := make(map[string]struct{})
@@ -136,19 +154,11 @@ func (p *TengoProgram) Modules() []string {
 	return libs
 }
 
-func (p *TengoProgram) Compile(ctx context.Context) (err error) {
-	modules := stdlib.GetModuleMap(AllowedBaseModules...)
-
-	for name, mod := range ExtraModules {
-		if name == "cgi" {
-			p.cgi = &cgiModule{
-				respHeaders: make(map[string]string),
-			}
-			modules.Add(name, p.cgi)
-		} else {
-			modules.AddBuiltinModule(name, mod)
-		}
-	}
+// Compile gets the modules imported by the program from the module library,
+// and compiles the program. A program which does not compile returns a
+// CompileError.
+func (p *TengoProgram) Compile(ctx context.Context) error {
+	libraries := make(map[string][]byte)
 
 	// All external modules declared in program source code
 	for _, modname := range p.Modules() {
@@ -175,63 +185,143 @@ func (p *TengoProgram) Compile(ctx context.Context) (err error) {
 		}
 
 		// The module must maintain the name from the source code
-		modules.AddSourceModule(modname, mod.Code)
+		libraries[modname] = mod.Code
 	}
 
-	script := tengo.NewScript(p.sourceCode)
-	script.SetImports(modules)
+	p.Lock()
+	p.libraries = libraries
+	p.idle = nil
+	p.Unlock()
+
+	inst, err := p.newInstance()
+	if err != nil {
+		return err
+	}
+	p.release(inst)
 
-	p.byteCode, err = script.Compile()
-	return err
+	return nil
 }
 
-func (p *TengoProgram) Run(ctx context.Context) error {
-	if p.byteCode == nil {
-		if err := p.Compile(ctx); err != nil {
-			return err
+// newInstance compiles the program with modules of its own.
+func (p *TengoProgram) newInstance() (*tengoInstance, error) {
+	inst := &tengoInstance{
+		cgi: &cgiModule{
+			respHeaders: make(map[string]string),
+		},
+		log: &logModule{},
+	}
+
+	modules := stdlib.GetModuleMap(AllowedBaseModules...)
+
+	for name, mod := range ExtraModules {
+		if name == "cgi" {
+			modules.Add(name, inst.cgi)
+		} else if name == "log" {
+			modules.Add(name, inst.log)
+		} else {
+			modules.AddBuiltinModule(name, mod)
 		}
 	}
 
-	lctx, cancel := context.WithTimeout(ctx, p.deadline)
-	defer cancel() // Release context if execution finishes before deadline
+	p.Lock()
+	for name, code := range p.libraries {
+		modules.AddSourceModule(name, code)
+	}
+	p.Unlock()
+
+	script := tengo.NewScript(p.sourceCode)
+	script.SetImports(modules)
 
-	return p.byteCode.RunContext(lctx)
+	byteCode, err := script.Compile()
+	if err != nil {
+		return nil, &CompileError{Err: err}
+	}
+	inst.byteCode = byteCode
+
+	return inst, nil
 }
 
-func (p *TengoProgram) RunWithHTTP(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
-	if p.byteCode == nil {
+// acquire returns an instance for a run, compiling another one when all are
+// in use.
+func (p *TengoProgram) acquire(ctx context.Context) (*tengoInstance, error) {
+	p.Lock()
+	compiled := p.libraries != nil
+	var inst *tengoInstance
+	if n := len(p.idle); n > 0 {
+		inst = p.idle[n-1]
+		p.idle = p.idle[:n-1]
+	}
+	p.Unlock()
+
+	if inst != nil {
+		return inst, nil
+	}
+
+	if compiled == false {
 		if err := p.Compile(ctx); err != nil {
-			return err
+			return nil, err
 		}
+		return p.acquire(ctx)
 	}
 
-	if p.IsCGI() == false {
-		return errors.New("program can not handle a CGI request")
+	return p.newInstance()
+}
+
+// release returns the instance once the run is over, cleared for the next.
+func (p *TengoProgram) release(inst *tengoInstance) {
+	inst.log.Reset()
+	inst.cgi.Reset()
+
+	p.Lock()
+	defer p.Unlock()
+	p.idle = append(p.idle, inst)
+}
+
+// Run runs the program, and returns what it wrote to the log.
+func (p *TengoProgram) Run(ctx context.Context) (string, error) {
+	inst, err := p.acquire(ctx)
+	if err != nil {
+		return "", err
 	}
+	defer p.release(inst)
 
+	lctx, cancel := context.WithTimeout(ctx, p.deadline)
+	defer cancel() // Release context if execution finishes before deadline
+
+	err = inst.byteCode.RunContext(lctx)
+	return inst.log.Output(), err
+}
+
+func (p *TengoProgram) RunWithHTTP(ctx context.Context, w http.ResponseWriter, r *http.Request) error {
 	req, ok := ctx.Value("http").(*NewTaskHttp)
 	if ok == false {
 		return errors.New("http object was not provided to cgi program")
 	}
 
-	p.cgi.reqHeaders = req.Headers
-	p.cgi.reqBody = req.Body
+	inst, err := p.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer p.release(inst)
+
+	inst.cgi.reqHeaders = req.Headers
+	inst.cgi.reqBody = req.Body
 
 	lctx, cancel := context.WithTimeout(ctx, p.deadline)
 	defer cancel() // Release context if execution finishes before deadline
 
-	err := p.byteCode.RunContext(lctx)
+	err = inst.byteCode.RunContext(lctx)
 	if err != nil {
 		return err
 	}
 
-	w.WriteHeader(p.cgi.respStatus)
+	w.WriteHeader(inst.cgi.respStatus)
 
-	for k, v := range p.cgi.respHeaders {
+	for k, v := range inst.cgi.respHeaders {
 		w.Header().Set(k, v)
 	}
 
-	w.Write(p.cgi.respBody.Bytes())
+	w.Write(inst.cgi.respBody.Bytes())
 
 	return nil
 }
@@ -0,0 +1,66 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package malgomaj
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTengoProgramConcurrentRuns(t *testing.T) {
+	source := []byte(`
+log := import("log")
+times := import("times")
+for i := 0; i < 5; i++ {
+	log.info("line %d", i)
+	times.sleep(times.millisecond)
+}
+`)
+
+	p := NewTengoProgram("test", "1", 5*time.Second, source)
+	if err := p.Compile(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			output, err := p.Run(context.Background())
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			// Each run keeps its own output, whole and unmixed
+			lines := strings.Split(strings.TrimSpace(output), "\n")
+			if len(lines) != 5 {
+				errs <- fmt.Errorf("expected 5 lines, got %d: %q", len(lines), output)
+				return
+			}
+			for n, line := range lines {
+				if strings.HasSuffix(line, fmt.Sprintf("line %d", n)) == false {
+					errs <- fmt.Errorf("unexpected line %d: %q", n, line)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
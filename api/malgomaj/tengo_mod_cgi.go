@@ -55,6 +55,15 @@ func (cgi *cgiModule) Import(moduleName string) (interface{}, error) {
 	}, nil
 }
 
+// Reset clears the request and the response, before a new run.
+func (cgi *cgiModule) Reset() {
+	cgi.reqHeaders = nil
+	cgi.reqBody = nil
+	cgi.respBody.Reset()
+	cgi.respHeaders = make(map[string]string)
+	cgi.respStatus = 0
+}
+
 func (cgi *cgiModule) GetHeaders(args ...tengo.Object) (ret tengo.Object, err error) {
 	numArgs := len(args)
 	if numArgs != 0 {
@@ -5,10 +5,17 @@
 package malgomaj
 
 import (
+	"bytes"
+	"sync"
+	"time"
+
 	"github.com/d5/tengo/v2"
 	"go.uber.org/zap"
 )
 
+// The most output kept from a run; what is written after is left out.
+const maxLogOutput = 64 * 1024
+
 var logModuleLogger *zap.Logger
 
 func init() {
@@ -19,50 +26,91 @@ func init() {
 	}
 }
 
-var logModule = map[string]tengo.Object{
-	"info":  &tengo.UserFunction{Name: "info", Value: logInfo},
-	"error": &tengo.UserFunction{Name: "error", Value: logInfo},
+// logModule writes to the log of the worker, and keeps what is written as the
+// output of the run.
+type logModule struct {
+	tengo.ObjectImpl
+
+	mux       sync.Mutex
+	output    bytes.Buffer
+	truncated bool
 }
 
-func logInfo(args ...tengo.Object) (ret tengo.Object, err error) {
-	numArgs := len(args)
-	if numArgs == 0 {
-		return nil, tengo.ErrWrongNumArguments
-	}
+func (l *logModule) Import(moduleName string) (interface{}, error) {
+	return &tengo.ImmutableMap{
+		Value: map[string]tengo.Object{
+			"info":  &tengo.UserFunction{Name: "info", Value: l.Info},
+			"error": &tengo.UserFunction{Name: "error", Value: l.Error},
+		},
+	}, nil
+}
 
-	format, ok := args[0].(*tengo.String)
-	if !ok {
-		return nil, tengo.ErrInvalidArgumentType{
-			Name:     "format",
-			Expected: "string",
-			Found:    args[0].TypeName(),
-		}
+// Reset clears the output, before a new run.
+func (l *logModule) Reset() {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.output.Reset()
+	l.truncated = false
+}
+
+// Output returns what was written during the run.
+func (l *logModule) Output() string {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	return l.output.String()
+}
+
+func (l *logModule) write(level string, s string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	if l.truncated {
+		return
 	}
 
-	if numArgs == 1 {
-		logModuleLogger.Info(format.Value)
-		return nil, nil
+	line := time.Now().UTC().Format(time.RFC3339) + " " + level + " " + s + "\n"
+	if l.output.Len()+len(line) > maxLogOutput {
+		l.output.WriteString("...\n")
+		l.truncated = true
+		return
 	}
 
-	s, err := tengo.Format(format.Value, args[1:]...)
+	l.output.WriteString(line)
+}
+
+func (l *logModule) Info(args ...tengo.Object) (ret tengo.Object, err error) {
+	s, err := logFormat(args...)
 	if err != nil {
 		return nil, err
 	}
 
 	logModuleLogger.Info(s)
+	l.write("INFO", s)
+
+	return nil, nil
+}
+
+func (l *logModule) Error(args ...tengo.Object) (ret tengo.Object, err error) {
+	s, err := logFormat(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	logModuleLogger.Error(s)
+	l.write("ERROR", s)
 
 	return nil, nil
 }
 
-func logError(args ...tengo.Object) (ret tengo.Object, err error) {
+func logFormat(args ...tengo.Object) (string, error) {
 	numArgs := len(args)
 	if numArgs == 0 {
-		return nil, tengo.ErrWrongNumArguments
+		return "", tengo.ErrWrongNumArguments
 	}
 
 	format, ok := args[0].(*tengo.String)
 	if !ok {
-		return nil, tengo.ErrInvalidArgumentType{
+		return "", tengo.ErrInvalidArgumentType{
 			Name:     "format",
 			Expected: "string",
 			Found:    args[0].TypeName(),
@@ -70,16 +118,8 @@ func logError(args ...tengo.Object) (ret tengo.Object, err error) {
 	}
 
 	if numArgs == 1 {
-		logModuleLogger.Error(format.Value)
-		return nil, nil
+		return format.Value, nil
 	}
 
-	s, err := tengo.Format(format.Value, args[1:]...)
-	if err != nil {
-		return nil, err
-	}
-
-	logModuleLogger.Error(s)
-
-	return nil, nil
+	return tengo.Format(format.Value, args[1:]...)
 }
@@ -0,0 +1,54 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/self-host/self-host/api/aapije"
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/pkg/util"
+	"github.com/self-host/self-host/postgres"
+)
+
+// Collector removes the dataset content of all domains which is no longer
+// referenced from their storage backends, until quit is closed.
+func Collector(quit <-chan struct{}) {
+	for {
+		select {
+		case <-util.AtInterval(viper.GetDuration("storage_gc.interval")):
+			collectGarbage()
+		case <-quit:
+			return
+		}
+	}
+}
+
+func collectGarbage() {
+	limit := viper.GetInt("storage_gc.batch")
+
+	for _, d := range postgres.GetAllDB() {
+		if d.DB == nil {
+			continue
+		}
+
+		store, err := aapije.DomainStore(d.Domain)
+		if err != nil {
+			logger.Error("Error while getting the storage backend", zap.String("domain", d.Domain), zap.Error(err))
+			continue
+		}
+
+		svc := services.NewDatasetService(d.DB).WithStore(store)
+		removed, err := svc.CollectGarbage(context.Background(), limit)
+		if err != nil {
+			logger.Error("Error while removing unreferenced dataset content", zap.String("domain", d.Domain), zap.Error(err))
+		} else if removed > 0 {
+			logger.Debug("Removed unreferenced dataset content", zap.String("domain", d.Domain), zap.Int("count", removed))
+		}
+	}
+}
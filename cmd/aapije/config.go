@@ -39,6 +39,36 @@ func initConfig() {
 	viper.SetDefault("cors.allow_credentials", true)
 	viper.SetDefault("cors.max_age", 300) // Maximum value not ignored by any of major browsers
 
+	// Time series data default settings
+	viper.SetDefault("tsdata.undo_window", 24*time.Hour)
+
+	// Alert default settings
+	viper.SetDefault("alerts.sweep_interval", 30*time.Second)
+	viper.SetDefault("alerts.sweep_batch", 100)
+
+	// Alert notification default settings
+	viper.SetDefault("notifications.interval", 5*time.Second)
+	viper.SetDefault("notifications.batch", 20)
+	viper.SetDefault("notifications.max_attempts", 8)
+	viper.SetDefault("notifications.timeout", 10*time.Second)
+
+	// Time series monitor default settings
+	viper.SetDefault("monitors.interval", 5*time.Second)
+	viper.SetDefault("monitors.batch", 100)
+
+	// Time series health default settings
+	viper.SetDefault("health.interval", 60*time.Second)
+	viper.SetDefault("health.batch", 500)
+
+	// Dataset content garbage collection default settings
+	viper.SetDefault("storage_gc.interval", 10*time.Minute)
+	viper.SetDefault("storage_gc.batch", 1000)
+
+	// Program Manager default settings, used for dry runs
+	viper.SetDefault("program_manager.scheme", "http")
+	viper.SetDefault("program_manager.authority", "127.0.0.1:8097")
+	viper.SetDefault("program_manager.timeout", 60*time.Second)
+
 	err = viper.ReadInConfig()
 	if err != nil {
 		logger.Fatal("Fatal error unable to load config file", zap.Error(err))
@@ -0,0 +1,46 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/pkg/util"
+	"github.com/self-host/self-host/postgres"
+)
+
+// Health evaluates the health of the time series of all domains until quit is closed.
+func Health(quit <-chan struct{}) {
+	for {
+		select {
+		case <-util.AtInterval(viper.GetDuration("health.interval")):
+			evaluateHealth()
+		case <-quit:
+			return
+		}
+	}
+}
+
+func evaluateHealth() {
+	limit := viper.GetInt64("health.batch")
+
+	for _, d := range postgres.GetAllDB() {
+		if d.DB == nil {
+			continue
+		}
+
+		svc := services.NewHealthService(d.DB)
+		evaluated, err := svc.EvaluateHealth(context.Background(), viper.GetDuration("health.interval"), limit)
+		if err != nil {
+			logger.Error("Error while evaluating time series health", zap.String("domain", d.Domain), zap.Error(err))
+		} else if evaluated > 0 {
+			logger.Debug("Evaluated time series health", zap.String("domain", d.Domain), zap.Int("count", evaluated))
+		}
+	}
+}
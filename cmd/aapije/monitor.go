@@ -0,0 +1,46 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/pkg/util"
+	"github.com/self-host/self-host/postgres"
+)
+
+// Monitor evaluates the due time series monitors of all domains until quit is closed.
+func Monitor(quit <-chan struct{}) {
+	for {
+		select {
+		case <-util.AtInterval(viper.GetDuration("monitors.interval")):
+			evaluateMonitors()
+		case <-quit:
+			return
+		}
+	}
+}
+
+func evaluateMonitors() {
+	limit := viper.GetInt64("monitors.batch")
+
+	for _, d := range postgres.GetAllDB() {
+		if d.DB == nil {
+			continue
+		}
+
+		svc := services.NewMonitorService(d.DB)
+		evaluated, err := svc.EvaluateMonitors(context.Background(), limit)
+		if err != nil {
+			logger.Error("Error while evaluating monitors", zap.String("domain", d.Domain), zap.Error(err))
+		} else if evaluated > 0 {
+			logger.Debug("Evaluated monitors", zap.String("domain", d.Domain), zap.Int("count", evaluated))
+		}
+	}
+}
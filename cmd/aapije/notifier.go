@@ -0,0 +1,50 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/pkg/util"
+	"github.com/self-host/self-host/postgres"
+)
+
+// Notifier delivers the pending alert notifications of all domains until quit is closed.
+func Notifier(quit <-chan struct{}) {
+	for {
+		select {
+		case <-util.AtInterval(viper.GetDuration("notifications.interval")):
+			deliverNotifications()
+		case <-quit:
+			return
+		}
+	}
+}
+
+func deliverNotifications() {
+	params := services.DeliverNotificationsParams{
+		Limit:       viper.GetInt64("notifications.batch"),
+		MaxAttempts: viper.GetInt32("notifications.max_attempts"),
+		Timeout:     viper.GetDuration("notifications.timeout"),
+	}
+
+	for _, d := range postgres.GetAllDB() {
+		if d.DB == nil {
+			continue
+		}
+
+		svc := services.NewNotificationService(d.DB)
+		sent, err := svc.DeliverNotifications(context.Background(), params)
+		if err != nil {
+			logger.Error("Error while delivering notifications", zap.String("domain", d.Domain), zap.Error(err))
+		} else if sent > 0 {
+			logger.Debug("Delivered notifications", zap.String("domain", d.Domain), zap.Int("count", sent))
+		}
+	}
+}
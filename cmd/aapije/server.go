@@ -182,6 +182,12 @@ func Server(address string) (<-chan error, error) {
 		syscall.SIGTERM,
 		syscall.SIGQUIT)
 
+	go Notifier(ctx.Done())
+	go Sweeper(ctx.Done())
+	go Monitor(ctx.Done())
+	go Health(ctx.Done())
+	go Collector(ctx.Done())
+
 	go func() {
 		<-ctx.Done()
 
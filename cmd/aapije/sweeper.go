@@ -0,0 +1,61 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/pkg/util"
+	"github.com/self-host/self-host/postgres"
+)
+
+// Sweeper expires the alerts of all domains whose timeout has passed, and
+// opens those whose shelve or silence has ended, until quit is closed.
+func Sweeper(quit <-chan struct{}) {
+	for {
+		select {
+		case <-util.AtInterval(viper.GetDuration("alerts.sweep_interval")):
+			sweepAlerts()
+		case <-quit:
+			return
+		}
+	}
+}
+
+func sweepAlerts() {
+	limit := viper.GetInt64("alerts.sweep_batch")
+
+	for _, d := range postgres.GetAllDB() {
+		if d.DB == nil {
+			continue
+		}
+
+		svc := services.NewAlertService(d.DB)
+		expired, err := svc.ExpireAlerts(context.Background(), limit)
+		if err != nil {
+			logger.Error("Error while expiring alerts", zap.String("domain", d.Domain), zap.Error(err))
+		} else if expired > 0 {
+			logger.Debug("Expired alerts", zap.String("domain", d.Domain), zap.Int("count", expired))
+		}
+
+		unshelved, err := svc.UnshelveAlerts(context.Background(), limit)
+		if err != nil {
+			logger.Error("Error while unshelving alerts", zap.String("domain", d.Domain), zap.Error(err))
+		} else if unshelved > 0 {
+			logger.Debug("Unshelved alerts", zap.String("domain", d.Domain), zap.Int("count", unshelved))
+		}
+
+		released, err := services.NewSilenceService(d.DB).ReleaseSilencedAlerts(context.Background(), limit)
+		if err != nil {
+			logger.Error("Error while releasing silenced alerts", zap.String("domain", d.Domain), zap.Error(err))
+		} else if released > 0 {
+			logger.Debug("Released silenced alerts", zap.String("domain", d.Domain), zap.Int("count", released))
+		}
+	}
+}
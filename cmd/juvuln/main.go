@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/self-host/self-host/pkg/configdir"
+	"github.com/self-host/self-host/pkg/workforce"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 )
@@ -39,12 +40,35 @@ func main() {
 	viper.AddConfigPath(".")
 
 	viper.SetDefault("worker.timeout", 30*time.Second)
+	viper.SetDefault("worker.strategy", "least-load")
+	viper.SetDefault("worker.affinity", true)
+	viper.SetDefault("runs.retention", 30*24*time.Hour)
+	viper.SetDefault("runs.purge_interval", time.Hour)
+	viper.SetDefault("programs.resync_interval", 10*time.Minute)
+	viper.SetDefault("leader.ttl", 10*time.Second)
+	viper.SetDefault("leader.interval", 2*time.Second)
+	viper.SetDefault("queue.interval", time.Second)
+	viper.SetDefault("queue.batch", 10)
+	viper.SetDefault("queue.lease", 30*time.Second)
+	viper.SetDefault("queue.max_attempts", 5)
+	viper.SetDefault("queue.retry_base", 10*time.Second)
+	viper.SetDefault("queue.retry_max", 10*time.Minute)
 
 	err := viper.ReadInConfig()
 	if err != nil {
 		logger.Fatal("Fatal error config file", zap.Error(err))
 	}
 
+	strategy, err := workforce.NewStrategy(viper.GetString("worker.strategy"), viper.GetBool("worker.affinity"))
+	if err != nil {
+		logger.Fatal("Fatal error config file", zap.Error(err))
+	}
+	workforce.SetStrategy(strategy)
+
+	if viper.GetDuration("leader.interval") >= viper.GetDuration("leader.ttl") {
+		logger.Fatal("Fatal error config file", zap.Error(fmt.Errorf("leader.interval must be shorter than leader.ttl")))
+	}
+
 	quit := make(chan struct{})
 	ctx, stop := signal.NotifyContext(context.Background(),
 		os.Interrupt,
@@ -5,6 +5,7 @@
 package main
 
 import (
+	"context"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
@@ -86,15 +87,19 @@ func ProgramManager(quit <-chan struct{}) (<-chan error, error) {
 
 	go func() {
 		juvuln.UpdateProgramCache()
+		juvuln.ListenForProgramChanges()
 
-		for {
-			every5s := util.AtInterval(5 * time.Second)
-			every1m := util.AtInterval(1 * time.Minute)
+		// Lead once the routines are known, so that a new leader can queue
+		// what was missed while no one led
+		go LeaderElection(quit)
+		go ProgramCacheResync(quit)
 
+		for {
 			select {
-			case <-every1m:
-				juvuln.UpdateProgramCache()
-			case <-every5s:
+			case <-util.AtInterval(5 * time.Second):
+				// Listen on the DBs of new domains
+				juvuln.ListenForProgramChanges()
+
 				rejected := workforce.ClearInactive()
 				for _, obj := range rejected {
 					w, ok := obj.(*juvuln.Worker)
@@ -108,5 +113,83 @@ func ProgramManager(quit <-chan struct{}) (<-chan error, error) {
 		}
 	}()
 
+	go ProgramRunRetention(quit)
+	go Dispatcher(quit)
+
 	return errC, nil
 }
+
+// ProgramRunRetention removes the program runs, and the jobs which are done or
+// dead, older than the retention until quit is closed.
+func ProgramRunRetention(quit <-chan struct{}) {
+	for {
+		select {
+		case <-util.AtInterval(viper.GetDuration("runs.purge_interval")):
+			before := time.Now().Add(-viper.GetDuration("runs.retention"))
+			count, err := juvuln.PurgeProgramRuns(before)
+			if err != nil {
+				logger.Error("Error while removing program runs", zap.Error(err))
+			} else if count > 0 {
+				logger.Debug("Removed program runs", zap.Int64("count", count))
+			}
+
+			count, err = juvuln.PurgeProgramJobs(before)
+			if err != nil {
+				logger.Error("Error while removing program jobs", zap.Error(err))
+			} else if count > 0 {
+				logger.Debug("Removed program jobs", zap.Int64("count", count))
+			}
+		case <-quit:
+			return
+		}
+	}
+}
+
+// ProgramCacheResync reads all programs of every domain again until quit is
+// closed. Changes are applied as they happen through the notifications of
+// each DB, this catches anything missed.
+func ProgramCacheResync(quit <-chan struct{}) {
+	for {
+		select {
+		case <-util.AtInterval(viper.GetDuration("programs.resync_interval")):
+			juvuln.UpdateProgramCache()
+		case <-quit:
+			juvuln.StopListening()
+			return
+		}
+	}
+}
+
+// LeaderElection acquires and extends the leadership of the domains until
+// quit is closed, when it is released.
+func LeaderElection(quit <-chan struct{}) {
+	for {
+		select {
+		case <-util.AtInterval(viper.GetDuration("leader.interval")):
+			err := juvuln.ElectLeaders(context.Background())
+			if err != nil {
+				logger.Error("Error while electing leaders", zap.Error(err))
+			}
+		case <-quit:
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			juvuln.ResignLeaders(ctx)
+			cancel()
+			return
+		}
+	}
+}
+
+// Dispatcher hands the queued jobs to the workers until quit is closed.
+func Dispatcher(quit <-chan struct{}) {
+	for {
+		select {
+		case <-util.AtInterval(viper.GetDuration("queue.interval")):
+			err := juvuln.DispatchJobs(context.Background())
+			if err != nil {
+				logger.Error("Error while dispatching jobs", zap.Error(err))
+			}
+		case <-quit:
+			return
+		}
+	}
+}
@@ -52,6 +52,8 @@ func init() {
 	viper.SetDefault("cache.library_timeout", 0) // No cache
 	viper.SetDefault("cache.program_timeout", 0) // No cache
 
+	viper.SetDefault("capacity", 1)
+
 	err = viper.ReadInConfig()
 	if err != nil {
 		logger.Fatal("Fatal error config file", zap.Error(err))
@@ -68,6 +70,7 @@ type SubscribeRequest struct {
 	Scheme    string    `json:"scheme"`
 	Authority string    `json:"authority"`
 	Languages []string  `json:"languages"`
+	Capacity  int64     `json:"capacity"`
 }
 
 type UpdateLoadRequest struct {
@@ -118,6 +121,7 @@ func Subscribe(ip string) error {
 			viper.GetInt("listen.port"),
 		),
 		Languages: []string{"tengo"},
+		Capacity:  viper.GetInt64("capacity"),
 	})
 	if err != nil {
 		return err
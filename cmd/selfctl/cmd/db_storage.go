@@ -0,0 +1,44 @@
+/*
+Copyright © 2021 Self-host Authors
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"github.com/self-host/self-host/pkg/util/templates"
+	"github.com/spf13/cobra"
+)
+
+var (
+	storageCmdLong = templates.LongDesc(`
+		Manage where the content of datasets is stored
+	`)
+
+	storageCmdExample = templates.Examples(`
+		# Move dataset content from the database to the backend in s3.yaml
+		selfctl db storage migrate --database URI --from database --to s3.yaml
+	`)
+)
+
+var storageCmd = &cobra.Command{
+	Use:     "storage",
+	Short:   "Manage where the content of datasets is stored",
+	Long:    storageCmdLong,
+	Example: storageCmdExample,
+}
+
+func init() {
+	dbCmd.AddCommand(storageCmd)
+}
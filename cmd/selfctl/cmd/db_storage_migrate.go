@@ -0,0 +1,236 @@
+/*
+Copyright © 2021 Self-host Authors
+
+This program is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+This program is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with this program. If not, see <http://www.gnu.org/licenses/>.
+*/
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/self-host/self-host/internal/services"
+	"github.com/self-host/self-host/pkg/blobstore"
+	"github.com/self-host/self-host/pkg/util/templates"
+	"github.com/self-host/self-host/postgres"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	storageMigrateCmdLong = templates.LongDesc(`
+		Move the content of all datasets, and their revisions, from one
+		storage backend to another.
+
+		A backend is either "database" or a YAML file with the same keys as
+		the "storage.<domain>" section of the aapije config. Content is
+		verified against its checksum before it is moved, and keeps its
+		compression. Content in a filesystem or S3 source is left in
+		place, remove it once the migration is done.
+	`)
+
+	storageMigrateCmdExample = templates.Examples(`
+		# Move dataset content from the database to the backend in s3.yaml
+		selfctl db storage migrate --database URI --from database --to s3.yaml
+
+		# Move dataset content back into the database
+		selfctl db storage migrate --database URI --from s3.yaml --to database
+	`)
+)
+
+var (
+	storageMigrateCmd = &cobra.Command{
+		Use:     "migrate",
+		Short:   "Move dataset content between storage backends",
+		Long:    storageMigrateCmdLong,
+		Example: storageMigrateCmdExample,
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			from, err := loadStorage(storageMigrateFrom)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+
+			to, err := loadStorage(storageMigrateTo)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+
+			if from.Name() == to.Name() {
+				fmt.Fprintln(os.Stderr, "Error: both sides use the", from.Name(), "backend")
+				os.Exit(1)
+			}
+
+			db, err := sql.Open("pgx", storageMigrateDbUri)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			datasets, revisions, err := migrateStorage(context.Background(), postgres.New(db), from, to)
+			fmt.Printf("Moved %v datasets and %v revisions from %v to %v\n", datasets, revisions, from.Name(), to.Name())
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+
+			// The content moved is no longer referenced in the former backend
+			removed, err := services.NewDatasetService(db).WithStore(from).CollectGarbage(context.Background(), math.MaxInt32)
+			if removed > 0 {
+				fmt.Printf("Removed %v files from %v\n", removed, from.Name())
+			}
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err.Error())
+				os.Exit(1)
+			}
+		},
+	}
+	storageMigrateDbUri string
+	storageMigrateFrom  string
+	storageMigrateTo    string
+)
+
+// loadStorage returns the database store or the store described in a YAML file
+func loadStorage(name string) (blobstore.Store, error) {
+	if name == blobstore.BackendDatabase {
+		return blobstore.Database{}, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(name)
+	v.SetConfigType("yaml")
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	var c blobstore.Config
+	if err := v.Unmarshal(&c); err != nil {
+		return nil, err
+	}
+
+	return blobstore.New(c)
+}
+
+// moveContent reads the content from one store, verifies it and writes it to
+// the other, compressed as it was. It returns the bytes to keep in the
+// database row.
+func moveContent(ctx context.Context, from, to blobstore.Store, checksum string, compression string, inline []byte) ([]byte, error) {
+	key := blobstore.Key(checksum, compression)
+
+	stored, err := from.Get(ctx, key, inline)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := blobstore.Decompress(compression, stored)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(content)
+	if hex.EncodeToString(sum[:]) != checksum {
+		return nil, errors.New("content does not match the checksum")
+	}
+
+	return to.Put(ctx, key, stored)
+}
+
+func migrateStorage(ctx context.Context, q *postgres.Queries, from, to blobstore.Store) (int, int, error) {
+	var datasets, revisions int
+
+	dsList, err := q.FindDatasetsByStorage(ctx, from.Name())
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, ds := range dsList {
+		row, err := q.GetDatasetContentByUUID(ctx, ds.Uuid)
+		if err != nil {
+			return datasets, revisions, err
+		}
+
+		inline, err := moveContent(ctx, from, to, row.Checksum, row.Compression, row.Content)
+		if err != nil {
+			return datasets, revisions, fmt.Errorf("dataset %v: %w", ds.Uuid, err)
+		}
+
+		// A dataset updated in the meantime keeps its new content as is
+		count, err := q.SetDatasetStorageByUUID(ctx, postgres.SetDatasetStorageByUUIDParams{
+			Content:  inline,
+			Storage:  to.Name(),
+			Uuid:     ds.Uuid,
+			Checksum: row.Checksum,
+		})
+		if err != nil {
+			return datasets, revisions, err
+		}
+		datasets += int(count)
+	}
+
+	revList, err := q.FindDatasetRevisionsByStorage(ctx, from.Name())
+	if err != nil {
+		return datasets, revisions, err
+	}
+
+	for _, rev := range revList {
+		row, err := q.GetDatasetContentAtRevision(ctx, postgres.GetDatasetContentAtRevisionParams{
+			DatasetUuid: rev.DatasetUuid,
+			Revision:    rev.Revision,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			// Pruned in the meantime
+			continue
+		} else if err != nil {
+			return datasets, revisions, err
+		}
+
+		inline, err := moveContent(ctx, from, to, row.Checksum, row.Compression, row.Content)
+		if err != nil {
+			return datasets, revisions, fmt.Errorf("dataset %v revision %v: %w", rev.DatasetUuid, rev.Revision, err)
+		}
+
+		count, err := q.SetDatasetRevisionStorage(ctx, postgres.SetDatasetRevisionStorageParams{
+			Content:     inline,
+			Storage:     to.Name(),
+			DatasetUuid: rev.DatasetUuid,
+			Revision:    rev.Revision,
+			Checksum:    row.Checksum,
+		})
+		if err != nil {
+			return datasets, revisions, err
+		}
+		revisions += int(count)
+	}
+
+	return datasets, revisions, nil
+}
+
+func init() {
+	storageCmd.AddCommand(storageMigrateCmd)
+	storageMigrateCmd.Flags().StringVarP(&storageMigrateDbUri, "database", "d", "", "Database URI")
+	storageMigrateCmd.Flags().StringVar(&storageMigrateFrom, "from", "", "Source backend, \"database\" or a YAML file")
+	storageMigrateCmd.Flags().StringVar(&storageMigrateTo, "to", "", "Target backend, \"database\" or a YAML file")
+	storageMigrateCmd.MarkFlagRequired("database")
+	storageMigrateCmd.MarkFlagRequired("from")
+	storageMigrateCmd.MarkFlagRequired("to")
+}
@@ -64,7 +64,7 @@ var runCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		err = program.Run(context.Background())
+		_, err = program.Run(context.Background())
 		if err != nil {
 			fmt.Fprintln(os.Stderr, err.Error())
 			os.Exit(1)
@@ -36,6 +36,11 @@ var (
 		Cause:   nil,
 		Message: "Request caused an error due to duplicate key violation",
 	}
+	ErrorCircularDependency = &HTTPError{
+		Code:    400,
+		Cause:   nil,
+		Message: "Request caused an error due to a circular dependency",
+	}
 	ErrorMalformedRequest = &HTTPError{
 		Code:    400,
 		Cause:   nil,
@@ -96,6 +101,11 @@ var (
 		Cause:   nil,
 		Message: "Undefined error",
 	}
+	ErrorThingArchived = &HTTPError{
+		Code:    http.StatusConflict,
+		Cause:   nil,
+		Message: "The thing is archived",
+	}
 	ErrorLengthRequired = &HTTPError{
 		Code:    http.StatusLengthRequired,
 		Cause:   nil,
@@ -106,6 +116,11 @@ var (
 		Cause:   nil,
 		Message: http.StatusText(http.StatusRequestEntityTooLarge),
 	}
+	ErrorRangeNotSatisfiable = &HTTPError{
+		Code:    http.StatusRequestedRangeNotSatisfiable,
+		Cause:   nil,
+		Message: http.StatusText(http.StatusRequestedRangeNotSatisfiable),
+	}
 	ErrorDBNoRows = &HTTPError{
 		Code:    404,
 		Cause:   nil,
@@ -153,6 +168,8 @@ func ParseDBError(e error) ClientError {
 		return ErrorDuplicateKey
 	} else if strings.Contains(serr, "SQLSTATE 23503") {
 		return ErrorMalformedRequest
+	} else if strings.Contains(serr, "circular dependencies are not allowed") {
+		return ErrorCircularDependency
 	} else if strings.Contains(serr, "no rows") {
 		// Expected one row, but got no rows.
 		return ErrorDBNoRows
@@ -7,9 +7,13 @@ package services
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
 	"github.com/self-host/self-host/postgres"
 )
 
@@ -53,6 +57,7 @@ type CreateAlertParams struct {
 	Tags        []string
 	Timeout     int32
 	Rawdata     []byte
+	CreatedBy   uuid.UUID
 }
 
 func (p *CreateAlertParams) ToPgParams() postgres.CreateAlertParams {
@@ -69,24 +74,78 @@ func (p *CreateAlertParams) ToPgParams() postgres.CreateAlertParams {
 		Tags:        p.Tags,
 		Timeout:     p.Timeout,
 		Rawdata:     p.Rawdata,
+		CreatedBy:   p.CreatedBy,
 	}
 }
 
+// CreateAlert adds an alert, or merges it with a matching open alert, and
+// queues the notifications of the new alert or its change of severity.
 func (svc *AlertService) CreateAlert(ctx context.Context, p *CreateAlertParams) (*rest.NewAlertReply, error) {
-	tags := make([]string, 0)
-	if p.Tags != nil {
-		for _, tag := range p.Tags {
-			tags = append(tags, tag)
-		}
+	params := p.ToPgParams()
+	if params.Tags == nil {
+		params.Tags = make([]string, 0)
 	}
 
-	params := p.ToPgParams()
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	alert_uuid, err := q.CreateAlert(ctx, params)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	alert, err := q.FindAlertByUUID(ctx, alert_uuid)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// An open alert matched by an active silence is silenced
+	silenced, err := silenceAlert(ctx, q, alert)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if silenced {
+		alert, err = q.FindAlertByUUID(ctx, alert_uuid)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
 
-	alert_uuid, err := svc.q.CreateAlert(ctx, params)
+	// A duplicate of an expired alert re-opens it
+	transitions, err := q.FindCurrentAlertStatusChanges(ctx, alert_uuid)
 	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	triggers := make([]rest.NotificationTrigger, 0)
+	if alert.Duplicate == 0 {
+		triggers = append(triggers, rest.NotificationTriggerCreate)
+	} else {
+		if alert.Severity != alert.PreviousSeverity {
+			triggers = append(triggers, rest.NotificationTriggerSeverity)
+		}
+		if len(transitions) > 0 {
+			triggers = append(triggers, rest.NotificationTriggerStatus)
+		}
+	}
+
+	if err := notifyAlert(ctx, q, alert, triggers); err != nil {
+		tx.Rollback()
 		return nil, err
 	}
 
+	tx.Commit()
+
 	v := &rest.NewAlertReply{
 		Uuid: alert_uuid.String(),
 	}
@@ -105,6 +164,7 @@ type FindAllAlertParams struct {
 	SeverityEq  *rest.AlertSeverity
 	Service     []string
 	Tags        []string
+	Silenced    *bool
 	ArgOffset   int64
 	ArgLimit    int64
 }
@@ -135,6 +195,9 @@ func (svc *AlertService) FindAll(ctx context.Context, p FindAllAlertParams) ([]*
 	if p.Status != nil {
 		params.Status = string(*p.Status)
 	}
+	if p.Silenced != nil {
+		params.Silenced = sql.NullBool{Bool: *p.Silenced, Valid: true}
+	}
 
 	alertList, err := svc.q.FindAlerts(ctx, params)
 	if err != nil {
@@ -164,6 +227,13 @@ func (svc *AlertService) FindAll(ctx context.Context, p FindAllAlertParams) ([]*
 		if t.LastReceiveTime.Valid == true {
 			alert.LastReceiveTime = &t.LastReceiveTime.Time
 		}
+		if t.ShelvedUntil.Valid == true {
+			alert.ShelvedUntil = &t.ShelvedUntil.Time
+		}
+		if t.SilenceUuid != uuid.Nil {
+			silence := t.SilenceUuid.String()
+			alert.Silence = &silence
+		}
 
 		alerts = append(alerts, alert)
 	}
@@ -171,12 +241,7 @@ func (svc *AlertService) FindAll(ctx context.Context, p FindAllAlertParams) ([]*
 	return alerts, nil
 }
 
-func (svc *AlertService) FindAlertByUuid(ctx context.Context, id uuid.UUID) (*rest.Alert, error) {
-	alert, err := svc.q.FindAlertByUUID(ctx, id)
-	if err != nil {
-		return nil, err
-	}
-
+func newRestAlert(alert postgres.VAlert) *rest.Alert {
 	v := &rest.Alert{
 		Uuid:             alert.Uuid.String(),
 		Resource:         alert.Resource,
@@ -199,8 +264,24 @@ func (svc *AlertService) FindAlertByUuid(ctx context.Context, id uuid.UUID) (*re
 	if alert.LastReceiveTime.Valid == true {
 		v.LastReceiveTime = &alert.LastReceiveTime.Time
 	}
+	if alert.ShelvedUntil.Valid == true {
+		v.ShelvedUntil = &alert.ShelvedUntil.Time
+	}
+	if alert.SilenceUuid != uuid.Nil {
+		silence := alert.SilenceUuid.String()
+		v.Silence = &silence
+	}
 
-	return v, nil
+	return v
+}
+
+func (svc *AlertService) FindAlertByUuid(ctx context.Context, id uuid.UUID) (*rest.Alert, error) {
+	alert, err := svc.q.FindAlertByUUID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestAlert(alert), nil
 }
 
 type UpdateAlertByUuidParams struct {
@@ -216,6 +297,7 @@ type UpdateAlertByUuidParams struct {
 	Tags        *[]string
 	Timeout     *int32
 	Rawdata     *[]byte
+	UpdatedBy   uuid.UUID
 }
 
 func (svc *AlertService) UpdateAlertByUuid(ctx context.Context, id uuid.UUID, p UpdateAlertByUuidParams) (int64, error) {
@@ -229,6 +311,15 @@ func (svc *AlertService) UpdateAlertByUuid(ctx context.Context, id uuid.UUID, p
 
 	q := svc.q.WithTx(tx)
 
+	before, err := q.FindAlertByUUID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		tx.Rollback()
+		return 0, nil
+	} else if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
 	if p.Resource != nil {
 		c, err := q.UpdateAlertSetResource(ctx, postgres.UpdateAlertSetResourceParams{
 			Uuid:     id,
@@ -385,6 +476,30 @@ func (svc *AlertService) UpdateAlertByUuid(ctx context.Context, id uuid.UUID, p
 		}
 	}
 
+	after, err := q.FindAlertByUUID(ctx, id)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	if err := recordAlertChanges(ctx, q, before, after, p.UpdatedBy); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	triggers := make([]rest.NotificationTrigger, 0)
+	if after.Severity != before.Severity {
+		triggers = append(triggers, rest.NotificationTriggerSeverity)
+	}
+	if after.Status != before.Status {
+		triggers = append(triggers, rest.NotificationTriggerStatus)
+	}
+
+	if err := notifyAlert(ctx, q, after, triggers); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
 	tx.Commit()
 
 	return count, nil
@@ -398,3 +513,253 @@ func (svc *AlertService) DeleteAlert(ctx context.Context, id uuid.UUID) (int64,
 
 	return count, nil
 }
+
+// recordAlertChanges adds the changes of status, severity and value to the
+// history of the alert.
+func recordAlertChanges(ctx context.Context, q *postgres.Queries, before, after postgres.VAlert, changedBy uuid.UUID) error {
+	changes := []struct {
+		change   string
+		from, to string
+	}{
+		{"status", string(before.Status), string(after.Status)},
+		{"severity", string(before.Severity), string(after.Severity)},
+		{"value", before.Value, after.Value},
+	}
+
+	for _, c := range changes {
+		if c.from == c.to {
+			continue
+		}
+
+		err := q.CreateAlertHistory(ctx, postgres.CreateAlertHistoryParams{
+			AlertUuid: after.Uuid,
+			Change:    c.change,
+			FromValue: c.from,
+			ToValue:   c.to,
+			ChangedBy: changedBy,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type ChangeAlertStatusParams struct {
+	Uuid      uuid.UUID
+	Note      string
+	Until     *time.Time // Of a shelve
+	ChangedBy uuid.UUID
+}
+
+// changeAlertStatus changes the status of an alert, when allowed from its
+// current status, and records the change with the note in the history.
+func (svc *AlertService) changeAlertStatus(ctx context.Context, from []rest.AlertStatus, to rest.AlertStatus, p ChangeAlertStatusParams) (int64, error) {
+	until := sql.NullTime{}
+	if to == rest.AlertStatusShelve && p.Until != nil {
+		if p.Until.Before(time.Now()) {
+			return 0, ie.NewBadRequestError(fmt.Errorf("shelve until %s has already passed", p.Until.Format(time.RFC3339)))
+		}
+		until = sql.NullTime{Time: *p.Until, Valid: true}
+	}
+
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	alert, err := q.FindAlertByUUID(ctx, p.Uuid)
+	if errors.Is(err, sql.ErrNoRows) {
+		tx.Rollback()
+		return 0, nil
+	} else if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	allowed := false
+	for _, s := range from {
+		if rest.AlertStatus(alert.Status) == s {
+			allowed = true
+			break
+		}
+	}
+	if allowed == false {
+		tx.Rollback()
+		return 0, ie.NewBadRequestError(fmt.Errorf("an alert can not change status from %s to %s", alert.Status, to))
+	}
+
+	count, err := q.UpdateAlertSetStatus(ctx, postgres.UpdateAlertSetStatusParams{
+		Uuid:   p.Uuid,
+		Status: postgres.AlertStatus(to),
+	})
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	_, err = q.UpdateAlertSetShelvedUntil(ctx, postgres.UpdateAlertSetShelvedUntilParams{
+		Uuid:         p.Uuid,
+		ShelvedUntil: until,
+	})
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	err = q.CreateAlertHistory(ctx, postgres.CreateAlertHistoryParams{
+		AlertUuid: p.Uuid,
+		Change:    "status",
+		FromValue: string(alert.Status),
+		ToValue:   string(to),
+		Note:      p.Note,
+		ChangedBy: p.ChangedBy,
+	})
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	after, err := q.FindAlertByUUID(ctx, p.Uuid)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	triggers := []rest.NotificationTrigger{rest.NotificationTriggerStatus}
+	if err := notifyAlert(ctx, q, after, triggers); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	tx.Commit()
+
+	return count, nil
+}
+
+// AcknowledgeAlert sets the status of an open alert to acknowledge.
+func (svc *AlertService) AcknowledgeAlert(ctx context.Context, p ChangeAlertStatusParams) (int64, error) {
+	return svc.changeAlertStatus(ctx, []rest.AlertStatus{rest.AlertStatusOpen}, rest.AlertStatusAcknowledge, p)
+}
+
+// UnacknowledgeAlert sets the status of an acknowledged alert back to open.
+func (svc *AlertService) UnacknowledgeAlert(ctx context.Context, p ChangeAlertStatusParams) (int64, error) {
+	return svc.changeAlertStatus(ctx, []rest.AlertStatus{rest.AlertStatusAcknowledge}, rest.AlertStatusOpen, p)
+}
+
+// ShelveAlert puts an open or acknowledged alert on hold, until p.Until if set.
+func (svc *AlertService) ShelveAlert(ctx context.Context, p ChangeAlertStatusParams) (int64, error) {
+	return svc.changeAlertStatus(ctx, []rest.AlertStatus{rest.AlertStatusOpen, rest.AlertStatusAcknowledge}, rest.AlertStatusShelve, p)
+}
+
+// UnshelveAlert sets the status of a shelved alert back to open.
+func (svc *AlertService) UnshelveAlert(ctx context.Context, p ChangeAlertStatusParams) (int64, error) {
+	return svc.changeAlertStatus(ctx, []rest.AlertStatus{rest.AlertStatusShelve}, rest.AlertStatusOpen, p)
+}
+
+// ResolveAlert closes an alert whatever its status, unless already closed.
+func (svc *AlertService) ResolveAlert(ctx context.Context, p ChangeAlertStatusParams) (int64, error) {
+	from := []rest.AlertStatus{
+		rest.AlertStatusOpen,
+		rest.AlertStatusAcknowledge,
+		rest.AlertStatusShelve,
+		rest.AlertStatusExpire,
+		rest.AlertStatusSilence,
+		rest.AlertStatusUnknown,
+	}
+	return svc.changeAlertStatus(ctx, from, rest.AlertStatusClose, p)
+}
+
+func (svc *AlertService) FindHistory(ctx context.Context, p FindByUuidParams) ([]*rest.AlertHistoryEntry, error) {
+	entries := make([]*rest.AlertHistoryEntry, 0)
+
+	count, err := svc.q.ExistsAlert(ctx, p.Uuid)
+	if err != nil {
+		return nil, err
+	} else if count == 0 {
+		return nil, ie.ErrorNotFound
+	}
+
+	list, err := svc.q.FindAlertHistory(ctx, postgres.FindAlertHistoryParams{
+		AlertUuid: p.Uuid,
+		ArgLimit:  p.Limit.Value,
+		ArgOffset: p.Offset.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, h := range list {
+		e := &rest.AlertHistoryEntry{
+			Change:  rest.AlertChange(h.Change),
+			From:    h.FromValue,
+			To:      h.ToValue,
+			Note:    h.Note,
+			Changed: h.Changed,
+		}
+		if h.ChangedBy != uuid.Nil {
+			changedBy := h.ChangedBy.String()
+			e.ChangedBy = &changedBy
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+// ExpireAlerts sets the status of open and acknowledged alerts whose timeout
+// has passed to expire, and returns the number expired. Alerts are locked
+// while expired, so several instances can sweep the same domain.
+func (svc *AlertService) ExpireAlerts(ctx context.Context, limit int64) (int, error) {
+	return svc.sweepAlerts(ctx, func(q *postgres.Queries) ([]uuid.UUID, error) {
+		return q.ExpireAlerts(ctx, limit)
+	})
+}
+
+// UnshelveAlerts opens the shelved alerts whose shelve has expired, and
+// returns the number opened.
+func (svc *AlertService) UnshelveAlerts(ctx context.Context, limit int64) (int, error) {
+	return svc.sweepAlerts(ctx, func(q *postgres.Queries) ([]uuid.UUID, error) {
+		return q.UnshelveAlerts(ctx, limit)
+	})
+}
+
+// sweepAlerts changes the status of alerts with sweep and queues the
+// notifications of the changes.
+func (svc *AlertService) sweepAlerts(ctx context.Context, sweep func(q *postgres.Queries) ([]uuid.UUID, error)) (int, error) {
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	changed, err := sweep(q)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	for _, alert_uuid := range changed {
+		alert, err := q.FindAlertByUUID(ctx, alert_uuid)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+
+		triggers := []rest.NotificationTrigger{rest.NotificationTriggerStatus}
+		if err := notifyAlert(ctx, q, alert, triggers); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
+	tx.Commit()
+
+	return len(changed), nil
+}
@@ -0,0 +1,512 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+// SilenceService represents the repository used for interacting with alert
+// silences.
+type SilenceService struct {
+	q  *postgres.Queries
+	db *sql.DB
+}
+
+// NewSilenceService instantiates the SilenceService repository.
+func NewSilenceService(db *sql.DB) *SilenceService {
+	if db == nil {
+		return nil
+	}
+
+	return &SilenceService{
+		q:  postgres.New(db),
+		db: db,
+	}
+}
+
+// windowMinutes parses the "HH:MM" of a silence window into minutes since midnight.
+func windowMinutes(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// silenceActive reports whether the silence is in effect at the time.
+func silenceActive(s postgres.AlertSilence, at time.Time) bool {
+	if at.Before(s.Starts) || at.Before(s.Ends) == false {
+		return false
+	}
+	if s.WindowStart == "" {
+		return true
+	}
+
+	start, err := windowMinutes(s.WindowStart)
+	if err != nil {
+		return false
+	}
+	end, err := windowMinutes(s.WindowEnd)
+	if err != nil {
+		return false
+	}
+
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	local := at.In(loc)
+	now := local.Hour()*60 + local.Minute()
+
+	onDay := func(t time.Time) bool {
+		if len(s.Weekdays) == 0 {
+			return true
+		}
+		day := strings.ToLower(t.Weekday().String())
+		for _, d := range s.Weekdays {
+			if d == day {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch {
+	case start == end:
+		return onDay(local)
+	case start < end:
+		return now >= start && now < end && onDay(local)
+	default:
+		// The window ends on the day after the weekday it starts
+		return (now >= start && onDay(local)) || (now < end && onDay(local.AddDate(0, 0, -1)))
+	}
+}
+
+// silenceMatches reports whether the silence applies to the alert. Every list
+// must contain the value of the alert, or for service and tags one of them.
+// An empty list matches any alert.
+func silenceMatches(s postgres.AlertSilence, a postgres.VAlert) bool {
+	contains := func(list []string, v string) bool {
+		if len(list) == 0 {
+			return true
+		}
+		for _, e := range list {
+			if e == v {
+				return true
+			}
+		}
+		return false
+	}
+	overlaps := func(list []string, other []string) bool {
+		if len(list) == 0 {
+			return true
+		}
+		for _, v := range other {
+			for _, e := range list {
+				if e == v {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	return contains(s.Resource, a.Resource) &&
+		contains(s.Environment, a.Environment) &&
+		contains(s.Event, a.Event) &&
+		overlaps(s.Service, a.Service) &&
+		overlaps(s.Tags, a.Tags)
+}
+
+func silenceState(s postgres.AlertSilence, at time.Time) rest.AlertSilenceState {
+	if at.Before(s.Ends) == false {
+		return rest.AlertSilenceStateExpired
+	} else if silenceActive(s, at) {
+		return rest.AlertSilenceStateActive
+	}
+	return rest.AlertSilenceStatePending
+}
+
+func newRestAlertSilence(s postgres.AlertSilence) *rest.AlertSilence {
+	v := &rest.AlertSilence{
+		Uuid:        s.Uuid.String(),
+		Comment:     s.Comment,
+		Resource:    stringsOrEmpty(s.Resource),
+		Environment: stringsOrEmpty(s.Environment),
+		Event:       stringsOrEmpty(s.Event),
+		Service:     stringsOrEmpty(s.Service),
+		Tags:        stringsOrEmpty(s.Tags),
+		Starts:      s.Starts,
+		Ends:        s.Ends,
+		State:       silenceState(s, time.Now()),
+		Created:     s.Created,
+		CreatedBy:   s.CreatedBy.String(),
+	}
+
+	if s.WindowStart != "" {
+		weekdays := make([]rest.Weekday, 0, len(s.Weekdays))
+		for _, d := range s.Weekdays {
+			weekdays = append(weekdays, rest.Weekday(d))
+		}
+		timezone := s.Timezone
+		v.Recurrence = &rest.AlertSilenceRecurrence{
+			Weekdays: &weekdays,
+			Start:    s.WindowStart,
+			End:      s.WindowEnd,
+			Timezone: &timezone,
+		}
+	}
+
+	return v
+}
+
+// silenceParams holds the values of a silence shared by adding and updating.
+type silenceParams struct {
+	Starts      time.Time
+	Ends        time.Time
+	Weekdays    []string
+	WindowStart string
+	WindowEnd   string
+	Timezone    string
+}
+
+// setRecurrence validates the recurrence and sets the window of the silence,
+// or clears it when there is none.
+func (p *silenceParams) setRecurrence(r *rest.AlertSilenceRecurrence) error {
+	p.Weekdays = make([]string, 0)
+	p.WindowStart = ""
+	p.WindowEnd = ""
+	p.Timezone = "UTC"
+
+	if r == nil {
+		return nil
+	}
+
+	if _, err := windowMinutes(r.Start); err != nil {
+		return ie.NewBadRequestError(err)
+	}
+	if _, err := windowMinutes(r.End); err != nil {
+		return ie.NewBadRequestError(err)
+	}
+	p.WindowStart = r.Start
+	p.WindowEnd = r.End
+
+	if r.Weekdays != nil {
+		for _, d := range *r.Weekdays {
+			switch d {
+			case rest.WeekdayMonday, rest.WeekdayTuesday, rest.WeekdayWednesday, rest.WeekdayThursday,
+				rest.WeekdayFriday, rest.WeekdaySaturday, rest.WeekdaySunday:
+				p.Weekdays = append(p.Weekdays, string(d))
+			default:
+				return ie.NewBadRequestError(fmt.Errorf("invalid weekday %s", d))
+			}
+		}
+	}
+
+	if r.Timezone != nil && *r.Timezone != "" {
+		if _, err := time.LoadLocation(*r.Timezone); err != nil {
+			return ie.NewBadRequestError(fmt.Errorf("invalid timezone %s", *r.Timezone))
+		}
+		p.Timezone = *r.Timezone
+	}
+
+	return nil
+}
+
+func (p *silenceParams) validate() error {
+	if p.Ends.After(p.Starts) == false {
+		return ie.NewBadRequestError(fmt.Errorf("a silence must end after it starts"))
+	}
+	return nil
+}
+
+type AddAlertSilenceParams struct {
+	Comment     string
+	Resource    []string
+	Environment []string
+	Event       []string
+	Service     []string
+	Tags        []string
+	Starts      *time.Time
+	Ends        time.Time
+	Recurrence  *rest.AlertSilenceRecurrence
+	CreatedBy   uuid.UUID
+}
+
+func (svc *SilenceService) AddSilence(ctx context.Context, p *AddAlertSilenceParams) (*rest.AlertSilence, error) {
+	sp := silenceParams{
+		Starts: time.Now(),
+		Ends:   p.Ends,
+	}
+	if p.Starts != nil {
+		sp.Starts = *p.Starts
+	}
+	if err := sp.setRecurrence(p.Recurrence); err != nil {
+		return nil, err
+	}
+	if err := sp.validate(); err != nil {
+		return nil, err
+	}
+
+	s, err := svc.q.CreateAlertSilence(ctx, postgres.CreateAlertSilenceParams{
+		Comment:     p.Comment,
+		Resource:    stringsOrEmpty(p.Resource),
+		Environment: stringsOrEmpty(p.Environment),
+		Event:       stringsOrEmpty(p.Event),
+		Service:     stringsOrEmpty(p.Service),
+		Tags:        stringsOrEmpty(p.Tags),
+		Starts:      sp.Starts,
+		Ends:        sp.Ends,
+		Weekdays:    sp.Weekdays,
+		WindowStart: sp.WindowStart,
+		WindowEnd:   sp.WindowEnd,
+		Timezone:    sp.Timezone,
+		CreatedBy:   p.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestAlertSilence(s), nil
+}
+
+type FindAlertSilencesParams struct {
+	PaginationParams
+	IncludeExpired bool
+}
+
+func (svc *SilenceService) FindSilences(ctx context.Context, p FindAlertSilencesParams) ([]*rest.AlertSilence, error) {
+	silences := make([]*rest.AlertSilence, 0)
+
+	list, err := svc.q.FindAlertSilences(ctx, postgres.FindAlertSilencesParams{
+		IncludeExpired: p.IncludeExpired,
+		ArgLimit:       p.Limit.Value,
+		ArgOffset:      p.Offset.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range list {
+		silences = append(silences, newRestAlertSilence(s))
+	}
+
+	return silences, nil
+}
+
+func (svc *SilenceService) FindSilenceByUuid(ctx context.Context, id uuid.UUID) (*rest.AlertSilence, error) {
+	s, err := svc.q.FindAlertSilenceByUUID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestAlertSilence(s), nil
+}
+
+type UpdateAlertSilenceParams struct {
+	Uuid        uuid.UUID
+	Comment     *string
+	Resource    *[]string
+	Environment *[]string
+	Event       *[]string
+	Service     *[]string
+	Tags        *[]string
+	Starts      *time.Time
+	Ends        *time.Time
+
+	// The recurrence is replaced, or removed when nil, only when set
+	SetRecurrence bool
+	Recurrence    *rest.AlertSilenceRecurrence
+}
+
+func (svc *SilenceService) UpdateSilenceByUuid(ctx context.Context, p UpdateAlertSilenceParams) (int64, error) {
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	s, err := q.FindAlertSilenceByUUID(ctx, p.Uuid)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	params := postgres.UpdateAlertSilenceParams{
+		Uuid:        s.Uuid,
+		Comment:     s.Comment,
+		Resource:    s.Resource,
+		Environment: s.Environment,
+		Event:       s.Event,
+		Service:     s.Service,
+		Tags:        s.Tags,
+		Starts:      s.Starts,
+		Ends:        s.Ends,
+		Weekdays:    s.Weekdays,
+		WindowStart: s.WindowStart,
+		WindowEnd:   s.WindowEnd,
+		Timezone:    s.Timezone,
+	}
+
+	if p.Comment != nil {
+		params.Comment = *p.Comment
+	}
+	if p.Resource != nil {
+		params.Resource = stringsOrEmpty(*p.Resource)
+	}
+	if p.Environment != nil {
+		params.Environment = stringsOrEmpty(*p.Environment)
+	}
+	if p.Event != nil {
+		params.Event = stringsOrEmpty(*p.Event)
+	}
+	if p.Service != nil {
+		params.Service = stringsOrEmpty(*p.Service)
+	}
+	if p.Tags != nil {
+		params.Tags = stringsOrEmpty(*p.Tags)
+	}
+
+	sp := silenceParams{
+		Starts:      params.Starts,
+		Ends:        params.Ends,
+		Weekdays:    params.Weekdays,
+		WindowStart: params.WindowStart,
+		WindowEnd:   params.WindowEnd,
+		Timezone:    params.Timezone,
+	}
+	if p.Starts != nil {
+		sp.Starts = *p.Starts
+	}
+	if p.Ends != nil {
+		sp.Ends = *p.Ends
+	}
+	if p.SetRecurrence {
+		if err := sp.setRecurrence(p.Recurrence); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+	if err := sp.validate(); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	params.Starts = sp.Starts
+	params.Ends = sp.Ends
+	params.Weekdays = sp.Weekdays
+	params.WindowStart = sp.WindowStart
+	params.WindowEnd = sp.WindowEnd
+	params.Timezone = sp.Timezone
+
+	count, err := q.UpdateAlertSilence(ctx, params)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	tx.Commit()
+
+	return count, nil
+}
+
+// DeleteSilence deletes a silence. The alerts it silenced are released by
+// the next sweep.
+func (svc *SilenceService) DeleteSilence(ctx context.Context, id uuid.UUID) (int64, error) {
+	count, err := svc.q.DeleteAlertSilence(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// silenceAlert silences an open alert when an active silence matches it, and
+// reports whether it did.
+func silenceAlert(ctx context.Context, q *postgres.Queries, a postgres.VAlert) (bool, error) {
+	if rest.AlertStatus(a.Status) != rest.AlertStatusOpen {
+		return false, nil
+	}
+
+	silences, err := q.FindCurrentAlertSilences(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	for _, s := range silences {
+		if silenceActive(s, now) == false || silenceMatches(s, a) == false {
+			continue
+		}
+
+		_, err := q.SilenceAlert(ctx, postgres.SilenceAlertParams{
+			Uuid:        a.Uuid,
+			SilenceUuid: s.Uuid,
+		})
+		if err != nil {
+			return false, err
+		}
+
+		note := "Silenced"
+		if s.Comment != "" {
+			note += ": " + s.Comment
+		}
+
+		err = q.CreateAlertHistory(ctx, postgres.CreateAlertHistoryParams{
+			AlertUuid: a.Uuid,
+			Change:    "status",
+			FromValue: string(a.Status),
+			ToValue:   string(rest.AlertStatusSilence),
+			Note:      note,
+		})
+		if err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// ReleaseSilencedAlerts opens the silenced alerts whose silence is no longer
+// active, and returns the number opened. Alerts are locked while released,
+// so several instances can sweep the same domain.
+func (svc *SilenceService) ReleaseSilencedAlerts(ctx context.Context, limit int64) (int, error) {
+	silences, err := svc.q.FindCurrentAlertSilences(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	active := make([]uuid.UUID, 0)
+	now := time.Now()
+	for _, s := range silences {
+		if silenceActive(s, now) {
+			active = append(active, s.Uuid)
+		}
+	}
+
+	alerts := NewAlertService(svc.db)
+
+	return alerts.sweepAlerts(ctx, func(q *postgres.Queries) ([]uuid.UUID, error) {
+		return q.ReleaseSilencedAlerts(ctx, postgres.ReleaseSilencedAlertsParams{
+			Active:   active,
+			ArgLimit: limit,
+		})
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestSilenceMatches(t *testing.T) {
+	alert := postgres.VAlert{
+		Resource:    "web01",
+		Environment: "Production",
+		Event:       "Crashed",
+		Service:     []string{"web", "api"},
+		Tags:        []string{"eu"},
+	}
+
+	cases := []struct {
+		silence postgres.AlertSilence
+		matches bool
+	}{
+		{postgres.AlertSilence{}, true},
+		{postgres.AlertSilence{Environment: []string{"Production"}, Resource: []string{"web01", "web02"}}, true},
+		{postgres.AlertSilence{Environment: []string{"Staging"}}, false},
+		{postgres.AlertSilence{Event: []string{"Down"}}, false},
+		{postgres.AlertSilence{Service: []string{"db", "api"}}, true},
+		{postgres.AlertSilence{Tags: []string{"us"}}, false},
+	}
+
+	for i, c := range cases {
+		if silenceMatches(c.silence, alert) != c.matches {
+			t.Errorf("case %d: expected %v", i, c.matches)
+		}
+	}
+}
+
+func TestSilenceActive(t *testing.T) {
+	at := func(s string) time.Time {
+		v, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+
+	base := postgres.AlertSilence{
+		Starts:   at("2021-10-01T00:00:00Z"),
+		Ends:     at("2021-11-01T00:00:00Z"),
+		Timezone: "UTC",
+	}
+
+	// Every Sunday 02:00 to 04:00
+	sunday := base
+	sunday.Weekdays = []string{"sunday"}
+	sunday.WindowStart = "02:00"
+	sunday.WindowEnd = "04:00"
+
+	// Every night 22:00 to 06:00 starting on Fridays
+	nightly := base
+	nightly.Weekdays = []string{"friday"}
+	nightly.WindowStart = "22:00"
+	nightly.WindowEnd = "06:00"
+
+	cases := []struct {
+		silence postgres.AlertSilence
+		at      string
+		active  bool
+	}{
+		{base, "2021-09-30T12:00:00Z", false},
+		{base, "2021-10-15T12:00:00Z", true},
+		{base, "2021-11-01T00:00:00Z", false},
+		{sunday, "2021-10-10T03:00:00Z", true},
+		{sunday, "2021-10-10T04:00:00Z", false},
+		{sunday, "2021-10-11T03:00:00Z", false},
+		{nightly, "2021-10-08T23:00:00Z", true},
+		{nightly, "2021-10-09T05:00:00Z", true},
+		{nightly, "2021-10-09T23:00:00Z", false},
+		{nightly, "2021-10-08T05:00:00Z", false},
+	}
+
+	for i, c := range cases {
+		if silenceActive(c.silence, at(c.at)) != c.active {
+			t.Errorf("case %d: expected %v", i, c.active)
+		}
+	}
+}
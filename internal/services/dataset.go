@@ -5,12 +5,26 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
 	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/pkg/blobstore"
 	"github.com/self-host/self-host/postgres"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 type DatasetFile struct {
@@ -19,31 +33,152 @@ type DatasetFile struct {
 	Checksum string
 }
 
+// DatasetStream is the content of a dataset, read on demand. Stored reads the
+// content as stored, which is compressed unless the compression is none.
+type DatasetStream struct {
+	io.ReadSeekCloser
+	Stored      io.ReadSeeker
+	Format      string
+	Checksum    string
+	Size        int64
+	Compression string
+	StoredSize  int64
+	Updated     time.Time
+}
+
 // DatasetService represents the repository used for interacting with Dataset records.
 type DatasetService struct {
-	q  *postgres.Queries
-	db *sql.DB
+	q           *postgres.Queries
+	db          *sql.DB
+	store       blobstore.Store
+	compression string
 }
 
-// NewDatasetService instantiates the DatasetService repository.
+// NewDatasetService instantiates the DatasetService repository. The content
+// is kept uncompressed in the database unless another store is set.
 func NewDatasetService(db *sql.DB) *DatasetService {
 	if db == nil {
 		return nil
 	}
 
 	return &DatasetService{
-		q:  postgres.New(db),
-		db: db,
+		q:           postgres.New(db),
+		db:          db,
+		store:       blobstore.Database{},
+		compression: blobstore.CompressionNone,
+	}
+}
+
+// WithStore sets the store used for new content.
+func (svc *DatasetService) WithStore(store blobstore.Store) *DatasetService {
+	if svc != nil && store != nil {
+		svc.store = store
 	}
+	return svc
+}
+
+// WithCompression sets the compression of new content.
+func (svc *DatasetService) WithCompression(compression string) *DatasetService {
+	if svc != nil && compression != "" {
+		svc.compression = compression
+	}
+	return svc
+}
+
+func contentChecksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// storedContent describes content once stored. The checksum is that of the
+// uncompressed content, inline the bytes to keep in the database row.
+type storedContent struct {
+	checksum    string
+	inline      []byte
+	compression string
+	storedSize  int
+}
+
+// putContent compresses and stores the content. Content that does not get
+// smaller is stored uncompressed. The content is stored within the
+// transaction of q, holding a lock on its key, so that it is not collected
+// as garbage before the transaction refers to it.
+func (svc *DatasetService) putContent(ctx context.Context, q *postgres.Queries, content []byte) (*storedContent, error) {
+	c := &storedContent{
+		checksum:    contentChecksum(content),
+		compression: blobstore.CompressionNone,
+	}
+
+	stored := content
+	if svc.compression != blobstore.CompressionNone {
+		compressed, err := blobstore.Compress(svc.compression, content)
+		if err != nil {
+			return nil, err
+		}
+		if len(compressed) < len(content) {
+			stored = compressed
+			c.compression = svc.compression
+		}
+	}
+	c.storedSize = len(stored)
+
+	key := blobstore.Key(c.checksum, c.compression)
+
+	if svc.store.Name() != blobstore.BackendDatabase {
+		err := q.LockDatasetBlob(ctx, postgres.LockDatasetBlobParams{
+			Storage: svc.store.Name(),
+			Key:     key,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	inline, err := svc.store.Put(ctx, key, stored)
+	if err != nil {
+		return nil, err
+	}
+	c.inline = inline
+
+	return c, nil
+}
+
+// getContent reads the content from the store it was written to, and
+// decompresses it. Content in the database can always be read.
+func (svc *DatasetService) getContent(ctx context.Context, storage string, checksum string, compression string, inline []byte) ([]byte, error) {
+	stored := inline
+	if storage != blobstore.BackendDatabase {
+		if storage != svc.store.Name() {
+			return nil, fmt.Errorf("the content is kept in the %s storage backend, which is not configured", storage)
+		}
+
+		var err error
+		stored, err = svc.store.Get(ctx, blobstore.Key(checksum, compression), inline)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return blobstore.Decompress(compression, stored)
 }
 
 type AddDatasetParams struct {
-	Name      string
-	Format    string
-	Content   []byte
-	CreatedBy uuid.UUID
-	ThingUuid uuid.UUID
-	Tags      []string
+	Name         string
+	Format       string
+	Content      []byte
+	CreatedBy    uuid.UUID
+	ThingUuid    uuid.UUID
+	Tags         []string
+	MaxRevisions int
+}
+
+// restMaxRevisions returns nil when every revision is kept.
+func restMaxRevisions(n sql.NullInt32) *int {
+	if n.Valid == false {
+		return nil
+	}
+	v := int(n.Int32)
+	return &v
 }
 
 func (svc *DatasetService) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
@@ -56,38 +191,36 @@ func (svc *DatasetService) Exists(ctx context.Context, id uuid.UUID) (bool, erro
 }
 
 func (svc *DatasetService) AddDataset(ctx context.Context, p *AddDatasetParams) (*rest.Dataset, error) {
-	tags := make([]string, 0)
-	if p.Tags != nil {
-		for _, tag := range p.Tags {
-			tags = append(tags, tag)
-		}
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
 	}
 
-	params := postgres.CreateDatasetParams{
-		Name:      p.Name,
-		Content:   p.Content,
-		Format:    p.Format,
-		CreatedBy: p.CreatedBy,
-		BelongsTo: p.ThingUuid,
-		Tags:      tags,
+	dataset, err := svc.addDataset(ctx, svc.q.WithTx(tx), p)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
 	}
 
-	dataset, err := svc.q.CreateDataset(ctx, params)
-	if err != nil {
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
 	v := &rest.Dataset{
-		Uuid:      dataset.Uuid.String(),
-		Name:      dataset.Name,
-		Format:    rest.DatasetFormat(dataset.Format),
-		Checksum:  dataset.Checksum,
-		Size:      int64(dataset.Size),
-		Created:   dataset.Created,
-		Updated:   dataset.Updated,
-		CreatedBy: dataset.CreatedBy.String(),
-		UpdatedBy: dataset.UpdatedBy.String(),
-		Tags:      dataset.Tags,
+		Uuid:         dataset.Uuid.String(),
+		Name:         dataset.Name,
+		Format:       rest.DatasetFormat(dataset.Format),
+		Checksum:     dataset.Checksum,
+		Size:         int64(dataset.Size),
+		StoredSize:   int64(dataset.StoredSize),
+		Compression:  rest.DatasetCompression(dataset.Compression),
+		Created:      dataset.Created,
+		Updated:      dataset.Updated,
+		CreatedBy:    dataset.CreatedBy.String(),
+		UpdatedBy:    dataset.UpdatedBy.String(),
+		Tags:         dataset.Tags,
+		MaxRevisions: restMaxRevisions(dataset.MaxRevisions),
 	}
 
 	if dataset.BelongsTo != NilUUID {
@@ -98,6 +231,47 @@ func (svc *DatasetService) AddDataset(ctx context.Context, p *AddDatasetParams)
 	return v, nil
 }
 
+// addDataset validates and stores the content, and creates the dataset within
+// the transaction of q.
+func (svc *DatasetService) addDataset(ctx context.Context, q *postgres.Queries, p *AddDatasetParams) (postgres.CreateDatasetRow, error) {
+	tags := make([]string, 0)
+	if p.Tags != nil {
+		for _, tag := range p.Tags {
+			tags = append(tags, tag)
+		}
+	}
+
+	if p.Content == nil {
+		p.Content = make([]byte, 0)
+	}
+
+	if err := validateDatasetFormat(p.Format, p.Content); err != nil {
+		return postgres.CreateDatasetRow{}, err
+	}
+
+	stored, err := svc.putContent(ctx, q, p.Content)
+	if err != nil {
+		return postgres.CreateDatasetRow{}, err
+	}
+
+	params := postgres.CreateDatasetParams{
+		Name:         p.Name,
+		Content:      stored.inline,
+		Checksum:     stored.checksum,
+		Size:         int64(len(p.Content)),
+		Storage:      svc.store.Name(),
+		Compression:  stored.compression,
+		StoredSize:   int64(stored.storedSize),
+		Format:       p.Format,
+		CreatedBy:    p.CreatedBy,
+		BelongsTo:    p.ThingUuid,
+		Tags:         tags,
+		MaxRevisions: int32(p.MaxRevisions),
+	}
+
+	return q.CreateDataset(ctx, params)
+}
+
 func (svc *DatasetService) FindDatasetByUuid(ctx context.Context, id uuid.UUID) (*rest.Dataset, error) {
 	dataset, err := svc.q.FindDatasetByUUID(ctx, id)
 	if err != nil {
@@ -105,16 +279,19 @@ func (svc *DatasetService) FindDatasetByUuid(ctx context.Context, id uuid.UUID)
 	}
 
 	v := &rest.Dataset{
-		Uuid:      dataset.Uuid.String(),
-		Name:      dataset.Name,
-		Format:    rest.DatasetFormat(dataset.Format),
-		Checksum:  dataset.Checksum,
-		Size:      int64(dataset.Size),
-		Created:   dataset.Created,
-		Updated:   dataset.Updated,
-		CreatedBy: dataset.CreatedBy.String(),
-		UpdatedBy: dataset.UpdatedBy.String(),
-		Tags:      dataset.Tags,
+		Uuid:         dataset.Uuid.String(),
+		Name:         dataset.Name,
+		Format:       rest.DatasetFormat(dataset.Format),
+		Checksum:     dataset.Checksum,
+		Size:         int64(dataset.Size),
+		StoredSize:   int64(dataset.StoredSize),
+		Compression:  rest.DatasetCompression(dataset.Compression),
+		Created:      dataset.Created,
+		Updated:      dataset.Updated,
+		CreatedBy:    dataset.CreatedBy.String(),
+		UpdatedBy:    dataset.UpdatedBy.String(),
+		Tags:         dataset.Tags,
+		MaxRevisions: restMaxRevisions(dataset.MaxRevisions),
 	}
 
 	if dataset.BelongsTo != NilUUID {
@@ -135,16 +312,19 @@ func (svc *DatasetService) FindByThing(ctx context.Context, id uuid.UUID) ([]*re
 
 	for _, t := range datasetsList {
 		dataset := &rest.Dataset{
-			Uuid:      t.Uuid.String(),
-			Name:      t.Name,
-			Format:    rest.DatasetFormat(t.Format),
-			Checksum:  t.Checksum,
-			Size:      int64(t.Size),
-			Created:   t.Created,
-			Updated:   t.Updated,
-			CreatedBy: t.CreatedBy.String(),
-			UpdatedBy: t.UpdatedBy.String(),
-			Tags:      t.Tags,
+			Uuid:         t.Uuid.String(),
+			Name:         t.Name,
+			Format:       rest.DatasetFormat(t.Format),
+			Checksum:     t.Checksum,
+			Size:         int64(t.Size),
+			StoredSize:   int64(t.StoredSize),
+			Compression:  rest.DatasetCompression(t.Compression),
+			Created:      t.Created,
+			Updated:      t.Updated,
+			CreatedBy:    t.CreatedBy.String(),
+			UpdatedBy:    t.UpdatedBy.String(),
+			Tags:         t.Tags,
+			MaxRevisions: restMaxRevisions(t.MaxRevisions),
 		}
 
 		if t.BelongsTo != NilUUID {
@@ -158,6 +338,44 @@ func (svc *DatasetService) FindByThing(ctx context.Context, id uuid.UUID) ([]*re
 	return datasets, nil
 }
 
+// FindByThings returns the datasets of each of the things, which the user of
+// the token may list as those of the thing and read.
+func (svc *DatasetService) FindByThings(ctx context.Context, token []byte, things []uuid.UUID) (map[uuid.UUID][]*rest.Dataset, error) {
+	datasets := make(map[uuid.UUID][]*rest.Dataset)
+
+	datasetsList, err := svc.q.FindDatasetsByThings(ctx, postgres.FindDatasetsByThingsParams{
+		Token:      token,
+		ThingUuids: things,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range datasetsList {
+		thingUUID := t.BelongsTo.String()
+		dataset := &rest.Dataset{
+			Uuid:         t.Uuid.String(),
+			Name:         t.Name,
+			Format:       rest.DatasetFormat(t.Format),
+			Checksum:     t.Checksum,
+			Size:         int64(t.Size),
+			StoredSize:   int64(t.StoredSize),
+			Compression:  rest.DatasetCompression(t.Compression),
+			Created:      t.Created,
+			Updated:      t.Updated,
+			CreatedBy:    t.CreatedBy.String(),
+			UpdatedBy:    t.UpdatedBy.String(),
+			Tags:         t.Tags,
+			MaxRevisions: restMaxRevisions(t.MaxRevisions),
+			ThingUuid:    &thingUUID,
+		}
+
+		datasets[t.BelongsTo] = append(datasets[t.BelongsTo], dataset)
+	}
+
+	return datasets, nil
+}
+
 func (svc *DatasetService) FindAll(ctx context.Context, p FindAllParams) ([]*rest.Dataset, error) {
 	datasets := make([]*rest.Dataset, 0)
 
@@ -179,16 +397,19 @@ func (svc *DatasetService) FindAll(ctx context.Context, p FindAllParams) ([]*res
 
 	for _, t := range datasetsList {
 		dataset := &rest.Dataset{
-			Uuid:      t.Uuid.String(),
-			Name:      t.Name,
-			Format:    rest.DatasetFormat(t.Format),
-			Checksum:  t.Checksum,
-			Size:      int64(t.Size),
-			Created:   t.Created,
-			Updated:   t.Updated,
-			CreatedBy: t.CreatedBy.String(),
-			UpdatedBy: t.UpdatedBy.String(),
-			Tags:      t.Tags,
+			Uuid:         t.Uuid.String(),
+			Name:         t.Name,
+			Format:       rest.DatasetFormat(t.Format),
+			Checksum:     t.Checksum,
+			Size:         int64(t.Size),
+			StoredSize:   int64(t.StoredSize),
+			Compression:  rest.DatasetCompression(t.Compression),
+			Created:      t.Created,
+			Updated:      t.Updated,
+			CreatedBy:    t.CreatedBy.String(),
+			UpdatedBy:    t.UpdatedBy.String(),
+			Tags:         t.Tags,
+			MaxRevisions: restMaxRevisions(t.MaxRevisions),
 		}
 
 		if t.BelongsTo != NilUUID {
@@ -223,16 +444,19 @@ func (svc *DatasetService) FindByTags(ctx context.Context, p FindByTagsParams) (
 
 	for _, t := range dsList {
 		dataset := &rest.Dataset{
-			Uuid:      t.Uuid.String(),
-			Name:      t.Name,
-			Format:    rest.DatasetFormat(t.Format),
-			Checksum:  t.Checksum,
-			Size:      int64(t.Size),
-			Created:   t.Created,
-			Updated:   t.Updated,
-			CreatedBy: t.CreatedBy.String(),
-			UpdatedBy: t.UpdatedBy.String(),
-			Tags:      t.Tags,
+			Uuid:         t.Uuid.String(),
+			Name:         t.Name,
+			Format:       rest.DatasetFormat(t.Format),
+			Checksum:     t.Checksum,
+			Size:         int64(t.Size),
+			StoredSize:   int64(t.StoredSize),
+			Compression:  rest.DatasetCompression(t.Compression),
+			Created:      t.Created,
+			Updated:      t.Updated,
+			CreatedBy:    t.CreatedBy.String(),
+			UpdatedBy:    t.UpdatedBy.String(),
+			Tags:         t.Tags,
+			MaxRevisions: restMaxRevisions(t.MaxRevisions),
 		}
 
 		if t.BelongsTo != NilUUID {
@@ -247,27 +471,113 @@ func (svc *DatasetService) FindByTags(ctx context.Context, p FindByTagsParams) (
 }
 
 func (svc *DatasetService) GetDatasetContentByUuid(ctx context.Context, id uuid.UUID) (*DatasetFile, error) {
-	content, err := svc.q.GetDatasetContentByUUID(ctx, id)
+	row, err := svc.q.GetDatasetContentByUUID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := svc.getContent(ctx, row.Storage, row.Checksum, row.Compression, row.Content)
 	if err != nil {
 		return nil, err
 	}
 
 	return &DatasetFile{
-		Format:   content.Format,
-		Content:  content.Content,
-		Checksum: content.Checksum,
+		Format:   row.Format,
+		Content:  content,
+		Checksum: row.Checksum,
 	}, nil
 }
 
+// OpenDatasetContentByUuid opens the content of a dataset for reading, without
+// loading all of it into memory.
+func (svc *DatasetService) OpenDatasetContentByUuid(ctx context.Context, id uuid.UUID) (*DatasetStream, error) {
+	info, err := svc.q.GetDatasetContentInfoByUUID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := &DatasetStream{
+		Format:      info.Format,
+		Checksum:    info.Checksum,
+		Size:        int64(info.Size),
+		Compression: info.Compression,
+		StoredSize:  int64(info.StoredSize),
+		Updated:     info.Updated,
+	}
+
+	stored, err := svc.openStored(ctx, id, info)
+	if err != nil {
+		return nil, err
+	}
+	stream.Stored = stored
+
+	if info.Compression == blobstore.CompressionNone {
+		stream.ReadSeekCloser = stored
+	} else {
+		stream.ReadSeekCloser = blobstore.NewDecompressReader(info.Compression, stored, stream.Size)
+	}
+
+	return stream, nil
+}
+
+// openStored opens the content of a dataset as stored.
+func (svc *DatasetService) openStored(ctx context.Context, id uuid.UUID, info postgres.GetDatasetContentInfoByUUIDRow) (io.ReadSeekCloser, error) {
+	size := int64(info.StoredSize)
+
+	if info.Storage == blobstore.BackendDatabase {
+		// The content is read in parts of the same version, a new version
+		// while reading is an error rather than a mix of both.
+		return blobstore.NewChunkReader(size, func(offset int64, length int64) ([]byte, error) {
+			return svc.q.GetDatasetContentPart(ctx, postgres.GetDatasetContentPartParams{
+				ArgOffset: int32(offset),
+				ArgLength: int32(length),
+				Uuid:      id,
+				Checksum:  info.Checksum,
+			})
+		}), nil
+	} else if info.Storage != svc.store.Name() {
+		return nil, fmt.Errorf("the content is kept in the %s storage backend, which is not configured", info.Storage)
+	}
+
+	key := blobstore.Key(info.Checksum, info.Compression)
+
+	if o, ok := svc.store.(blobstore.Opener); ok {
+		return o.Open(ctx, key, size)
+	}
+
+	content, err := svc.store.Get(ctx, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return nopReadSeekCloser{bytes.NewReader(content)}, nil
+}
+
+type nopReadSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopReadSeekCloser) Close() error {
+	return nil
+}
+
 type UpdateDatasetByUuidParams struct {
-	Content   *[]byte
-	Format    *string
-	Name      *string
-	Tags      *[]string
-	ThingUuid *uuid.UUID
+	Content      *[]byte
+	Format       *string
+	Name         *string
+	Tags         *[]string
+	ThingUuid    *uuid.UUID
+	MaxRevisions *int
+	UpdatedBy    uuid.UUID
 }
 
 func (svc *DatasetService) UpdateDatasetByUuid(ctx context.Context, id uuid.UUID, p UpdateDatasetByUuidParams) (int64, error) {
+	if p.Content != nil || p.Format != nil {
+		if err := svc.validateUpdate(ctx, id, p.Format, p.Content); err != nil {
+			return 0, err
+		}
+	}
+
 	// Use a transaction for this action
 	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
@@ -278,6 +588,15 @@ func (svc *DatasetService) UpdateDatasetByUuid(ctx context.Context, id uuid.UUID
 
 	q := svc.q.WithTx(tx)
 
+	var stored *storedContent
+	if p.Content != nil {
+		stored, err = svc.putContent(ctx, q, *p.Content)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
 	if p.Name != nil {
 		c, err := q.SetDatasetNameByUUID(ctx, postgres.SetDatasetNameByUUIDParams{
 			Uuid: id,
@@ -306,8 +625,14 @@ func (svc *DatasetService) UpdateDatasetByUuid(ctx context.Context, id uuid.UUID
 
 	if p.Content != nil {
 		c, err := q.SetDatasetContentByUUID(ctx, postgres.SetDatasetContentByUUIDParams{
-			Uuid:    id,
-			Content: *p.Content,
+			Uuid:        id,
+			Content:     stored.inline,
+			Checksum:    stored.checksum,
+			Size:        int64(len(*p.Content)),
+			Storage:     svc.store.Name(),
+			Compression: stored.compression,
+			StoredSize:  int64(stored.storedSize),
+			UpdatedBy:   p.UpdatedBy,
 		})
 		if err != nil {
 			tx.Rollback()
@@ -330,6 +655,19 @@ func (svc *DatasetService) UpdateDatasetByUuid(ctx context.Context, id uuid.UUID
 		count += c
 	}
 
+	if p.MaxRevisions != nil {
+		params := postgres.SetDatasetMaxRevisionsByUUIDParams{
+			Uuid:         id,
+			MaxRevisions: int32(*p.MaxRevisions),
+		}
+		c, err := q.SetDatasetMaxRevisionsByUUID(ctx, params)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		count += c
+	}
+
 	if p.ThingUuid != nil {
 		params := postgres.SetDatasetThingByUUIDParams{
 			Uuid:      id,
@@ -343,11 +681,58 @@ func (svc *DatasetService) UpdateDatasetByUuid(ctx context.Context, id uuid.UUID
 		count += c
 	}
 
-	tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
 
 	return count, nil
 }
 
+// validateUpdate checks the content of a dataset against its format and
+// schema, using the new format and content where given.
+func (svc *DatasetService) validateUpdate(ctx context.Context, id uuid.UUID, format *string, content *[]byte) error {
+	var f string
+	var c []byte
+
+	if content != nil {
+		info, err := svc.q.GetDatasetContentInfoByUUID(ctx, id)
+		if err != nil {
+			return err
+		}
+		f = info.Format
+		c = *content
+	} else {
+		current, err := svc.GetDatasetContentByUuid(ctx, id)
+		if err != nil {
+			return err
+		}
+		f = current.Format
+		c = current.Content
+	}
+
+	if format != nil {
+		f = *format
+	}
+
+	return svc.validateContent(ctx, id, f, c)
+}
+
+// validateContent checks content against the format, and the schema of the dataset if any.
+func (svc *DatasetService) validateContent(ctx context.Context, id uuid.UUID, format string, content []byte) error {
+	if err := validateDatasetFormat(format, content); err != nil {
+		return err
+	}
+
+	schema, err := svc.q.GetDatasetSchemaByUUID(ctx, id)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return validateDatasetSchema(format, content, schema)
+}
+
 func (svc *DatasetService) DeleteDataset(ctx context.Context, id uuid.UUID) (int64, error) {
 	count, err := svc.q.DeleteDataset(ctx, id)
 	if err != nil {
@@ -356,3 +741,298 @@ func (svc *DatasetService) DeleteDataset(ctx context.Context, id uuid.UUID) (int
 
 	return count, nil
 }
+
+// CollectGarbage removes up to limit items of content from the store, which
+// no dataset nor revision refers to any longer, and returns how many were
+// removed. Content of other storage backends is left for a service which has
+// that store.
+func (svc *DatasetService) CollectGarbage(ctx context.Context, limit int) (int, error) {
+	var removed int
+
+	for i := 0; i < limit; i++ {
+		ok, gone, err := svc.collectBlob(ctx)
+		if err != nil {
+			return removed, err
+		} else if ok == false {
+			break
+		}
+
+		if gone {
+			removed++
+		}
+	}
+
+	return removed, nil
+}
+
+// collectBlob claims a piece of content which may no longer be referenced,
+// and removes it from the store if so. It returns false when there is none.
+func (svc *DatasetService) collectBlob(ctx context.Context) (bool, bool, error) {
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return false, false, err
+	}
+	defer tx.Rollback()
+
+	q := svc.q.WithTx(tx)
+
+	d, err := q.ClaimDatasetBlobDeletion(ctx, svc.store.Name())
+	if err == sql.ErrNoRows {
+		return false, false, nil
+	} else if err != nil {
+		return false, false, err
+	}
+
+	key := blobstore.Key(d.Checksum, d.Compression)
+
+	// Content being stored by another transaction waits for this one
+	err = q.LockDatasetBlob(ctx, postgres.LockDatasetBlobParams{
+		Storage: d.Storage,
+		Key:     key,
+	})
+	if err != nil {
+		return false, false, err
+	}
+
+	refs, err := q.CountDatasetBlobReferences(ctx, postgres.CountDatasetBlobReferencesParams{
+		Storage:     d.Storage,
+		Checksum:    d.Checksum,
+		Compression: d.Compression,
+	})
+	if err != nil {
+		return false, false, err
+	}
+
+	if refs == 0 {
+		if err := svc.store.Delete(ctx, key); err != nil {
+			return false, false, err
+		}
+	}
+
+	_, err = q.DeleteDatasetBlobDeletion(ctx, postgres.DeleteDatasetBlobDeletionParams{
+		Storage:     d.Storage,
+		Checksum:    d.Checksum,
+		Compression: d.Compression,
+	})
+	if err != nil {
+		return false, false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, false, err
+	}
+
+	return true, refs == 0, nil
+}
+
+func (svc *DatasetService) FindAllRevisions(ctx context.Context, id uuid.UUID) ([]*rest.DatasetRevision, error) {
+	revisions := make([]*rest.DatasetRevision, 0)
+
+	found, err := svc.q.ExistsDataset(ctx, id)
+	if err != nil {
+		return nil, err
+	} else if found == 0 {
+		return nil, ie.ErrorNotFound
+	}
+
+	revList, err := svc.q.FindDatasetRevisions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range revList {
+		revisions = append(revisions, &rest.DatasetRevision{
+			Revision:  int(t.Revision),
+			Created:   t.Created,
+			CreatedBy: t.CreatedBy.String(),
+			Checksum:  t.Checksum,
+			Size:      int64(t.Size),
+		})
+	}
+
+	return revisions, nil
+}
+
+// GetDatasetContentAtRevision returns the content of a dataset at a revision.
+// The revision (-1) is the newest revision.
+func (svc *DatasetService) GetDatasetContentAtRevision(ctx context.Context, id uuid.UUID, revision int) (*DatasetFile, int, error) {
+	if revision == -1 {
+		row, err := svc.q.GetDatasetContentAtHead(ctx, id)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		content, err := svc.getContent(ctx, row.Storage, row.Checksum, row.Compression, row.Content)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return &DatasetFile{
+			Format:   row.Format,
+			Content:  content,
+			Checksum: row.Checksum,
+		}, int(row.Revision), nil
+	}
+
+	row, err := svc.q.GetDatasetContentAtRevision(ctx, postgres.GetDatasetContentAtRevisionParams{
+		DatasetUuid: id,
+		Revision:    int32(revision),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	content, err := svc.getContent(ctx, row.Storage, row.Checksum, row.Compression, row.Content)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return &DatasetFile{
+		Format:   row.Format,
+		Content:  content,
+		Checksum: row.Checksum,
+	}, revision, nil
+}
+
+func (svc *DatasetService) DiffDatasetAtRevisions(ctx context.Context, id uuid.UUID, revA int, revB int) (string, error) {
+	fA, revA, err := svc.GetDatasetContentAtRevision(ctx, id, revA)
+	if err != nil {
+		return "", err
+	}
+
+	fB, revB, err := svc.GetDatasetContentAtRevision(ctx, id, revB)
+	if err != nil {
+		return "", err
+	}
+
+	if utf8.Valid(fA.Content) == false || utf8.Valid(fB.Content) == false {
+		return "", ie.NewBadRequestError(fmt.Errorf("only text content can be compared"))
+	}
+
+	contentA := string(fA.Content)
+	contentB := string(fB.Content)
+
+	aName := fmt.Sprintf("%v@%v", id.String(), revA)
+	bName := fmt.Sprintf("%v@%v", id.String(), revB)
+	edits := myers.ComputeEdits(span.URIFromPath(id.String()), contentA, contentB)
+	return fmt.Sprint(gotextdiff.ToUnified(aName, bName, contentA, edits)), nil
+}
+
+// RollbackToRevision restores the content of a dataset from a revision. The
+// restored content becomes a new revision.
+func (svc *DatasetService) RollbackToRevision(ctx context.Context, id uuid.UUID, revision int, updatedBy uuid.UUID) (int64, error) {
+	// The format or schema may have changed since the revision
+	f, _, err := svc.GetDatasetContentAtRevision(ctx, id, revision)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := svc.validateContent(ctx, id, f.Format, f.Content); err != nil {
+		return 0, err
+	}
+
+	count, err := svc.q.RollbackDatasetToRevision(ctx, postgres.RollbackDatasetToRevisionParams{
+		Uuid:      id,
+		Revision:  int32(revision),
+		UpdatedBy: updatedBy,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetDocument returns the part of a json, yaml or toml dataset selected by a
+// JSON pointer, in the format asked for. An empty format keeps the format of
+// the dataset.
+func (svc *DatasetService) GetDocument(ctx context.Context, id uuid.UUID, pointer string, format string) (*DatasetFile, error) {
+	f, err := svc.GetDatasetContentByUuid(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if structuredFormat(f.Format) == false {
+		return nil, ie.NewBadRequestError(fmt.Errorf("documents can not be read from the %s format", f.Format))
+	}
+
+	if format == "" {
+		format = f.Format
+	} else if structuredFormat(format) == false {
+		return nil, ie.NewBadRequestError(fmt.Errorf("documents can not be written as %s", format))
+	}
+
+	var doc interface{}
+	if len(f.Content) > 0 {
+		doc, err = decodeDataset(f.Format, f.Content)
+		if err != nil {
+			return nil, ie.NewBadRequestError(err)
+		}
+	}
+
+	doc, err = lookupJSONPointer(doc, pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := encodeDataset(format, doc)
+	if err != nil {
+		return nil, ie.NewBadRequestError(err)
+	}
+
+	return &DatasetFile{
+		Format:   format,
+		Content:  content,
+		Checksum: f.Checksum,
+	}, nil
+}
+
+func (svc *DatasetService) GetSchema(ctx context.Context, id uuid.UUID) (map[string]interface{}, error) {
+	schema, err := svc.q.GetDatasetSchemaByUUID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var v map[string]interface{}
+	if err := json.Unmarshal(schema, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// SetSchema attaches a JSON Schema to a dataset, replacing any previous
+// schema. The current content must satisfy the schema.
+func (svc *DatasetService) SetSchema(ctx context.Context, id uuid.UUID, schema []byte) (int64, error) {
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schema)); err != nil {
+		return 0, ie.NewBadRequestError(err)
+	}
+
+	f, err := svc.GetDatasetContentByUuid(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := validateDatasetSchema(f.Format, f.Content, schema); err != nil {
+		return 0, err
+	}
+
+	count, err := svc.q.SetDatasetSchemaByUUID(ctx, postgres.SetDatasetSchemaByUUIDParams{
+		Uuid:   id,
+		Schema: schema,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (svc *DatasetService) DeleteSchema(ctx context.Context, id uuid.UUID) (int64, error) {
+	count, err := svc.q.DeleteDatasetSchemaByUUID(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v2"
+)
+
+// structuredFormat reports if the format is a document that can be selected
+// from, converted and validated with a JSON Schema.
+func structuredFormat(format string) bool {
+	switch format {
+	case "json", "yaml", "toml":
+		return true
+	}
+	return false
+}
+
+// validateDatasetFormat checks that the content parses as the format. Empty
+// content and formats without a parser are always valid.
+func validateDatasetFormat(format string, content []byte) error {
+	if len(content) == 0 {
+		return nil
+	}
+
+	var err error
+	switch format {
+	case "json", "yaml", "toml":
+		_, err = decodeDataset(format, content)
+	case "csv":
+		_, err = csv.NewReader(bytes.NewReader(content)).ReadAll()
+	case "xml":
+		d := xml.NewDecoder(bytes.NewReader(content))
+		for err == nil {
+			_, err = d.Token()
+		}
+		if err == io.EOF {
+			err = nil
+		}
+	}
+
+	if err != nil {
+		return ie.NewBadRequestError(fmt.Errorf("the content is not valid %s: %w", format, err))
+	}
+
+	return nil
+}
+
+// validateDatasetSchema checks the content against a JSON Schema.
+func validateDatasetSchema(format string, content []byte, schema []byte) error {
+	if structuredFormat(format) == false {
+		return ie.NewBadRequestError(fmt.Errorf("a schema can not be used with the %s format", format))
+	}
+
+	var doc interface{}
+	if len(content) > 0 {
+		var err error
+		doc, err = decodeDataset(format, content)
+		if err != nil {
+			return ie.NewBadRequestError(err)
+		}
+	}
+
+	result, err := gojsonschema.Validate(
+		gojsonschema.NewBytesLoader(schema),
+		gojsonschema.NewGoLoader(doc))
+	if err != nil {
+		return ie.NewBadRequestError(err)
+	}
+
+	if result.Valid() == false {
+		msgs := make([]string, 0)
+		for _, e := range result.Errors() {
+			msgs = append(msgs, e.String())
+		}
+		return ie.NewBadRequestError(fmt.Errorf("the content does not match the schema: %s", strings.Join(msgs, "; ")))
+	}
+
+	return nil
+}
+
+// decodeDataset parses a json, yaml or toml document into maps, slices and
+// scalars, the same types for every format.
+func decodeDataset(format string, content []byte) (interface{}, error) {
+	var doc interface{}
+
+	switch format {
+	case "json":
+		d := json.NewDecoder(bytes.NewReader(content))
+		d.UseNumber()
+		if err := d.Decode(&doc); err != nil {
+			return nil, err
+		} else if d.More() {
+			return nil, fmt.Errorf("unexpected data after the document")
+		}
+	case "yaml":
+		if err := yaml.Unmarshal(content, &doc); err != nil {
+			return nil, err
+		}
+	case "toml":
+		tree, err := toml.LoadBytes(content)
+		if err != nil {
+			return nil, err
+		}
+		doc = tree.ToMap()
+	default:
+		return nil, fmt.Errorf("the %s format is not a structured document", format)
+	}
+
+	return normalizeDocument(doc), nil
+}
+
+// encodeDataset writes a document decoded by decodeDataset as json, yaml or toml.
+func encodeDataset(format string, doc interface{}) ([]byte, error) {
+	switch format {
+	case "json":
+		return json.Marshal(doc)
+	case "yaml":
+		return yaml.Marshal(doc)
+	case "toml":
+		m, ok := doc.(map[string]interface{})
+		if ok == false {
+			return nil, fmt.Errorf("only a table can be written as toml")
+		}
+		tree, err := toml.TreeFromMap(m)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(tree.String()), nil
+	}
+
+	return nil, fmt.Errorf("the %s format is not a structured document", format)
+}
+
+func normalizeDocument(doc interface{}) interface{} {
+	switch v := doc.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = normalizeDocument(value)
+		}
+		return m
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = normalizeDocument(value)
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = normalizeDocument(value)
+		}
+		return v
+	case []map[string]interface{}:
+		// Arrays of tables in toml
+		l := make([]interface{}, len(v))
+		for i, value := range v {
+			l[i] = normalizeDocument(value)
+		}
+		return l
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case int:
+		return int64(v)
+	}
+
+	return doc
+}
+
+// lookupJSONPointer returns the part of the document selected by a RFC 6901
+// JSON pointer. The empty pointer selects the whole document.
+func lookupJSONPointer(doc interface{}, pointer string) (interface{}, error) {
+	if pointer == "" {
+		return doc, nil
+	} else if strings.HasPrefix(pointer, "/") == false {
+		return nil, ie.NewBadRequestError(fmt.Errorf("a JSON pointer must start with /"))
+	}
+
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		switch v := doc.(type) {
+		case map[string]interface{}:
+			value, ok := v[token]
+			if ok == false {
+				return nil, ie.ErrorNotFound
+			}
+			doc = value
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, ie.ErrorNotFound
+			}
+			doc = v[i]
+		default:
+			return nil, ie.ErrorNotFound
+		}
+	}
+
+	return doc, nil
+}
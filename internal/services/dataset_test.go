@@ -0,0 +1,259 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/pkg/blobstore"
+)
+
+func TestValidateDatasetFormat(t *testing.T) {
+	cases := []struct {
+		format  string
+		content string
+		valid   bool
+	}{
+		{"json", `{"a": [1, 2]}`, true},
+		{"json", `{"a": }`, false},
+		{"json", `{} {}`, false},
+		{"yaml", "a:\n  - 1\n  - 2\n", true},
+		{"yaml", "a: [1, 2\n", false},
+		{"toml", "[a]\nb = 1\n", true},
+		{"toml", "[a\nb = 1\n", false},
+		{"csv", "a,b\n1,2\n", true},
+		{"csv", "a,b\n1,2,3\n", false},
+		{"xml", "<a><b>1</b></a>", true},
+		{"xml", "<a><b>1</a>", false},
+		{"misc", "anything", true},
+		{"json", "", true},
+	}
+
+	for _, c := range cases {
+		err := validateDatasetFormat(c.format, []byte(c.content))
+		if c.valid && err != nil {
+			t.Errorf("%s %q: unexpected error %v", c.format, c.content, err)
+		} else if c.valid == false && err == nil {
+			t.Errorf("%s %q: expected an error", c.format, c.content)
+		}
+	}
+}
+
+func TestDatasetDocument(t *testing.T) {
+	doc, err := decodeDataset("yaml", []byte("sensors:\n  - name: a/b\n    interval: 10\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := lookupJSONPointer(doc, "/sensors/0/interval")
+	if err != nil {
+		t.Fatal(err)
+	} else if v != int64(10) {
+		t.Errorf("expected 10, got %#v", v)
+	}
+
+	if _, err := lookupJSONPointer(doc, "/sensors/1"); err == nil {
+		t.Error("expected an error for a missing element")
+	}
+
+	v, err = lookupJSONPointer(doc, "/sensors/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := encodeDataset("json", v)
+	if err != nil {
+		t.Fatal(err)
+	} else if string(b) != `{"interval":10,"name":"a/b"}` {
+		t.Errorf("unexpected json %s", b)
+	}
+
+	b, err = encodeDataset("toml", v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err = decodeDataset("toml", b)
+	if err != nil {
+		t.Fatal(err)
+	} else if doc.(map[string]interface{})["interval"] != int64(10) {
+		t.Errorf("unexpected toml %s", b)
+	}
+
+	if _, err := encodeDataset("toml", int64(10)); err == nil {
+		t.Error("expected an error for a scalar as toml")
+	}
+
+	schema := []byte(`{"type": "object", "required": ["interval"]}`)
+	if err := validateDatasetSchema("toml", b, schema); err != nil {
+		t.Error(err)
+	}
+	if err := validateDatasetSchema("json", []byte(`{"name": "x"}`), schema); err == nil {
+		t.Error("expected the schema to fail")
+	}
+}
+
+func TestDatasetContentCollection(t *testing.T) {
+	ctx := context.Background()
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+
+	store, err := blobstore.NewFilesystem(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := NewDatasetService(db).WithStore(store)
+
+	exists := func(content string) bool {
+		_, err := store.Get(ctx, contentChecksum([]byte(content)), nil)
+		return err == nil
+	}
+
+	add := func(name string, content string) uuid.UUID {
+		ds, err := svc.AddDataset(ctx, &AddDatasetParams{
+			Name:      name,
+			Format:    "misc",
+			Content:   []byte(content),
+			CreatedBy: rootUUID,
+			Tags:      []string{},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return uuid.MustParse(ds.Uuid)
+	}
+
+	first := add("first", "shared")
+	second := add("second", "shared")
+
+	// The old content is kept as a revision
+	content := []byte("changed")
+	if _, err := svc.UpdateDatasetByUuid(ctx, first, UpdateDatasetByUuidParams{Content: &content, UpdatedBy: rootUUID}); err != nil {
+		t.Fatal(err)
+	}
+	if exists("shared") == false || exists("changed") == false {
+		t.Fatal("expected both revisions to be kept")
+	}
+
+	// Dropping the old revision leaves the content to the other dataset
+	maxRevisions := 1
+	if _, err := svc.UpdateDatasetByUuid(ctx, first, UpdateDatasetByUuidParams{MaxRevisions: &maxRevisions, UpdatedBy: rootUUID}); err != nil {
+		t.Fatal(err)
+	}
+	if exists("shared") == false {
+		t.Error("expected content still referenced to be kept")
+	}
+
+	// Once no one refers to the content it is removed by the collection
+	if _, err := svc.DeleteDataset(ctx, second); err != nil {
+		t.Fatal(err)
+	}
+	if exists("shared") == false {
+		t.Error("expected the content to be left to the collection")
+	}
+	if removed, err := svc.CollectGarbage(ctx, 1000); err != nil {
+		t.Fatal(err)
+	} else if removed == 0 || exists("shared") {
+		t.Errorf("expected unreferenced content to be removed, got %v", removed)
+	}
+
+	if _, err := svc.DeleteDataset(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+	if removed, err := svc.CollectGarbage(ctx, 1000); err != nil {
+		t.Fatal(err)
+	} else if removed == 0 || exists("changed") {
+		t.Errorf("expected the content of a deleted dataset to be removed, got %v", removed)
+	}
+
+	if removed, err := svc.CollectGarbage(ctx, 10); err != nil {
+		t.Fatal(err)
+	} else if removed != 0 {
+		t.Errorf("expected nothing left to remove, got %v", removed)
+	}
+}
+
+func TestDatasetRevisions(t *testing.T) {
+	ctx := context.Background()
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+	svc := NewDatasetService(db)
+
+	ds, err := svc.AddDataset(ctx, &AddDatasetParams{
+		Name:      "revisions",
+		Format:    "misc",
+		Content:   []byte("v0\n"),
+		CreatedBy: rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := uuid.MustParse(ds.Uuid)
+
+	update := func(p UpdateDatasetByUuidParams) {
+		p.UpdatedBy = rootUUID
+		if _, err := svc.UpdateDatasetByUuid(ctx, id, p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	revisions := func() []int {
+		list, err := svc.FindAllRevisions(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		numbers := make([]int, 0, len(list))
+		for _, r := range list {
+			numbers = append(numbers, r.Revision)
+		}
+		return numbers
+	}
+
+	for _, v := range []string{"v1\n", "v2\n", "v3\n"} {
+		content := []byte(v)
+		update(UpdateDatasetByUuidParams{Content: &content})
+	}
+	if r := revisions(); len(r) != 4 || r[0] != 0 || r[3] != 3 {
+		t.Fatalf("expected revisions 0 to 3, got %v", r)
+	}
+
+	// Capping drops the oldest revisions
+	maxRevisions := 2
+	update(UpdateDatasetByUuidParams{MaxRevisions: &maxRevisions})
+	if r := revisions(); len(r) != 2 || r[0] != 2 || r[1] != 3 {
+		t.Fatalf("expected revisions 2 and 3, got %v", r)
+	}
+	if _, _, err := svc.GetDatasetContentAtRevision(ctx, id, 0); err == nil {
+		t.Error("expected a dropped revision to be gone")
+	}
+
+	// Restoring adds the content as a new revision, within the cap
+	if _, err := svc.RollbackToRevision(ctx, id, 2, rootUUID); err != nil {
+		t.Fatal(err)
+	}
+	if r := revisions(); len(r) != 2 || r[0] != 3 || r[1] != 4 {
+		t.Fatalf("expected revisions 3 and 4, got %v", r)
+	}
+
+	f, err := svc.GetDatasetContentByUuid(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	} else if string(f.Content) != "v2\n" {
+		t.Errorf("expected the restored content, got %q", f.Content)
+	}
+
+	diff, err := svc.DiffDatasetAtRevisions(ctx, id, 3, -1)
+	if err != nil {
+		t.Fatal(err)
+	} else if strings.Contains(diff, "-v3") == false || strings.Contains(diff, "+v2") == false {
+		t.Errorf("unexpected diff %q", diff)
+	}
+
+	if _, err := svc.DeleteDataset(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,767 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+// The keys of an alert group, in the order they make up the key of an
+// incident.
+var alertGroupKeys = []rest.AlertGroupKey{
+	rest.AlertGroupKeyEnvironment,
+	rest.AlertGroupKeyEvent,
+	rest.AlertGroupKeyTag,
+	rest.AlertGroupKeyParent,
+}
+
+// IncidentService represents the repository used for interacting with alert
+// groups and the incidents they gather alerts into.
+type IncidentService struct {
+	q  *postgres.Queries
+	db *sql.DB
+}
+
+// NewIncidentService instantiates the IncidentService repository.
+func NewIncidentService(db *sql.DB) *IncidentService {
+	if db == nil {
+		return nil
+	}
+
+	return &IncidentService{
+		q:  postgres.New(db),
+		db: db,
+	}
+}
+
+func newRestAlertGroup(g postgres.AlertGroup) *rest.AlertGroup {
+	v := &rest.AlertGroup{
+		Uuid:      g.Uuid.String(),
+		Name:      g.Name,
+		Keys:      make([]rest.AlertGroupKey, 0, len(g.Keys)),
+		TagPrefix: g.TagPrefix,
+		Enabled:   g.Enabled,
+		Created:   g.Created,
+		CreatedBy: g.CreatedBy.String(),
+	}
+
+	for _, k := range g.Keys {
+		v.Keys = append(v.Keys, rest.AlertGroupKey(k))
+	}
+
+	return v
+}
+
+func newRestIncident(i postgres.Incident, alerts int64) *rest.Incident {
+	v := &rest.Incident{
+		Uuid:     i.Uuid.String(),
+		Key:      i.Key,
+		Title:    i.Title,
+		Status:   rest.IncidentStatus(i.Status),
+		Severity: rest.AlertSeverity(i.Severity),
+		Alerts:   alerts,
+		Created:  i.Created,
+		Updated:  i.Updated,
+	}
+
+	if i.GroupUuid != uuid.Nil {
+		group := i.GroupUuid.String()
+		v.Group = &group
+	}
+	if i.Closed.Valid {
+		v.Closed = &i.Closed.Time
+	}
+
+	return v
+}
+
+// alertGroupKeyStrings validates the keys of an alert group and returns them
+// in order, without duplicates.
+func alertGroupKeyStrings(keys []rest.AlertGroupKey, tagPrefix string) ([]string, error) {
+	set := make(map[rest.AlertGroupKey]bool)
+	for _, k := range keys {
+		set[k] = true
+	}
+
+	list := make([]string, 0, len(set))
+	for _, k := range alertGroupKeys {
+		if set[k] {
+			list = append(list, string(k))
+			delete(set, k)
+		}
+	}
+
+	if len(set) > 0 {
+		return nil, ie.NewBadRequestError(fmt.Errorf("unknown alert group key"))
+	} else if len(list) == 0 {
+		return nil, ie.NewBadRequestError(fmt.Errorf("an alert group needs at least one key"))
+	}
+
+	tagged := false
+	for _, k := range list {
+		if k == string(rest.AlertGroupKeyTag) {
+			tagged = true
+		}
+	}
+	if tagged && tagPrefix == "" {
+		return nil, ie.NewBadRequestError(fmt.Errorf("the tag key requires a tag prefix"))
+	} else if tagged == false && tagPrefix != "" {
+		return nil, ie.NewBadRequestError(fmt.Errorf("a tag prefix is only used with the tag key"))
+	}
+
+	return list, nil
+}
+
+type AddAlertGroupParams struct {
+	Name      string
+	Keys      []rest.AlertGroupKey
+	TagPrefix string
+	Enabled   bool
+	CreatedBy uuid.UUID
+}
+
+func (svc *IncidentService) AddAlertGroup(ctx context.Context, p *AddAlertGroupParams) (*rest.AlertGroup, error) {
+	keys, err := alertGroupKeyStrings(p.Keys, p.TagPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := svc.q.CreateAlertGroup(ctx, postgres.CreateAlertGroupParams{
+		Name:      p.Name,
+		Keys:      keys,
+		TagPrefix: p.TagPrefix,
+		Enabled:   p.Enabled,
+		CreatedBy: p.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestAlertGroup(g), nil
+}
+
+func (svc *IncidentService) FindAlertGroups(ctx context.Context, p PaginationParams) ([]*rest.AlertGroup, error) {
+	groups := make([]*rest.AlertGroup, 0)
+
+	list, err := svc.q.FindAlertGroups(ctx, postgres.FindAlertGroupsParams{
+		ArgLimit:  p.Limit.Value,
+		ArgOffset: p.Offset.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, g := range list {
+		groups = append(groups, newRestAlertGroup(g))
+	}
+
+	return groups, nil
+}
+
+func (svc *IncidentService) FindAlertGroupByUuid(ctx context.Context, id uuid.UUID) (*rest.AlertGroup, error) {
+	g, err := svc.q.FindAlertGroupByUUID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestAlertGroup(g), nil
+}
+
+type UpdateAlertGroupParams struct {
+	Uuid      uuid.UUID
+	Name      *string
+	Keys      *[]rest.AlertGroupKey
+	TagPrefix *string
+	Enabled   *bool
+}
+
+func (svc *IncidentService) UpdateAlertGroupByUuid(ctx context.Context, p UpdateAlertGroupParams) (int64, error) {
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	g, err := q.FindAlertGroupByUUID(ctx, p.Uuid)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	params := postgres.UpdateAlertGroupParams{
+		Uuid:      g.Uuid,
+		Name:      g.Name,
+		TagPrefix: g.TagPrefix,
+		Enabled:   g.Enabled,
+	}
+
+	keys := make([]rest.AlertGroupKey, 0, len(g.Keys))
+	for _, k := range g.Keys {
+		keys = append(keys, rest.AlertGroupKey(k))
+	}
+
+	if p.Name != nil {
+		params.Name = *p.Name
+	}
+	if p.Keys != nil {
+		keys = *p.Keys
+	}
+	if p.TagPrefix != nil {
+		params.TagPrefix = *p.TagPrefix
+	}
+	if p.Enabled != nil {
+		params.Enabled = *p.Enabled
+	}
+
+	params.Keys, err = alertGroupKeyStrings(keys, params.TagPrefix)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	count, err := q.UpdateAlertGroup(ctx, params)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	tx.Commit()
+
+	return count, nil
+}
+
+func (svc *IncidentService) DeleteAlertGroup(ctx context.Context, id uuid.UUID) (int64, error) {
+	count, err := svc.q.DeleteAlertGroup(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+type FindIncidentsParams struct {
+	PaginationParams
+	Status *rest.IncidentStatus
+	Group  *uuid.UUID
+}
+
+func (svc *IncidentService) FindIncidents(ctx context.Context, p FindIncidentsParams) ([]*rest.Incident, error) {
+	incidents := make([]*rest.Incident, 0)
+
+	params := postgres.FindIncidentsParams{
+		ArgLimit:  p.Limit.Value,
+		ArgOffset: p.Offset.Value,
+	}
+	if p.Status != nil {
+		params.Status = string(*p.Status)
+	}
+	if p.Group != nil {
+		params.GroupUuid = p.Group.String()
+	}
+
+	list, err := svc.q.FindIncidents(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(list))
+	for _, i := range list {
+		ids = append(ids, i.Uuid)
+	}
+
+	counts, err := svc.q.CountIncidentAlerts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make(map[uuid.UUID]int64)
+	for _, c := range counts {
+		alerts[c.IncidentUuid] = c.Alerts
+	}
+
+	for _, i := range list {
+		incidents = append(incidents, newRestIncident(i, alerts[i.Uuid]))
+	}
+
+	return incidents, nil
+}
+
+func (svc *IncidentService) FindIncidentByUuid(ctx context.Context, id uuid.UUID) (*rest.Incident, error) {
+	return findRestIncident(ctx, svc.q, id)
+}
+
+func findRestIncident(ctx context.Context, q *postgres.Queries, id uuid.UUID) (*rest.Incident, error) {
+	i, err := q.FindIncidentByUUID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := q.CountIncidentAlerts(ctx, []uuid.UUID{i.Uuid})
+	if err != nil {
+		return nil, err
+	}
+
+	var alerts int64
+	if len(counts) > 0 {
+		alerts = counts[0].Alerts
+	}
+
+	return newRestIncident(i, alerts), nil
+}
+
+func (svc *IncidentService) FindIncidentAlerts(ctx context.Context, p FindByUuidParams) ([]*rest.Alert, error) {
+	alerts := make([]*rest.Alert, 0)
+
+	if _, err := svc.q.FindIncidentByUUID(ctx, p.Uuid); err != nil {
+		return nil, err
+	}
+
+	list, err := svc.q.FindIncidentAlerts(ctx, postgres.FindIncidentAlertsParams{
+		IncidentUuid: p.Uuid,
+		ArgLimit:     p.Limit.Value,
+		ArgOffset:    p.Offset.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, a := range list {
+		alerts = append(alerts, newRestAlert(a))
+	}
+
+	return alerts, nil
+}
+
+func (svc *IncidentService) FindIncidentHistory(ctx context.Context, p FindByUuidParams) ([]*rest.IncidentHistoryEntry, error) {
+	entries := make([]*rest.IncidentHistoryEntry, 0)
+
+	if _, err := svc.q.FindIncidentByUUID(ctx, p.Uuid); err != nil {
+		return nil, err
+	}
+
+	list, err := svc.q.FindIncidentHistory(ctx, postgres.FindIncidentHistoryParams{
+		IncidentUuid: p.Uuid,
+		ArgLimit:     p.Limit.Value,
+		ArgOffset:    p.Offset.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, h := range list {
+		e := &rest.IncidentHistoryEntry{
+			Change:  rest.IncidentChange(h.Change),
+			From:    h.FromValue,
+			To:      h.ToValue,
+			Note:    h.Note,
+			Changed: h.Changed,
+		}
+		if h.AlertUuid != uuid.Nil {
+			alert := h.AlertUuid.String()
+			e.Alert = &alert
+		}
+		if h.ChangedBy != uuid.Nil {
+			changedBy := h.ChangedBy.String()
+			e.ChangedBy = &changedBy
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+// changeIncidentStatus changes the status of an incident, when allowed from
+// its current status, and records the change with the note in the timeline.
+// When closed, the alerts of the incident are closed with it.
+func (svc *IncidentService) changeIncidentStatus(ctx context.Context, from []rest.IncidentStatus, to rest.IncidentStatus, p ChangeAlertStatusParams) (int64, error) {
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	incident, err := q.FindIncidentByUUID(ctx, p.Uuid)
+	if errors.Is(err, sql.ErrNoRows) {
+		tx.Rollback()
+		return 0, nil
+	} else if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	allowed := false
+	for _, s := range from {
+		if rest.IncidentStatus(incident.Status) == s {
+			allowed = true
+			break
+		}
+	}
+	if allowed == false {
+		tx.Rollback()
+		return 0, ie.NewBadRequestError(fmt.Errorf("an incident can not change status from %s to %s", incident.Status, to))
+	}
+
+	if to == rest.IncidentStatusClose {
+		closed, err := q.CloseIncidentAlerts(ctx, incident.Uuid)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+
+		for _, a := range closed {
+			err := q.CreateAlertHistory(ctx, postgres.CreateAlertHistoryParams{
+				AlertUuid: a.Uuid,
+				Change:    "status",
+				FromValue: string(a.Status),
+				ToValue:   string(rest.AlertStatusClose),
+				Note:      "Closed with incident " + incident.Title,
+				ChangedBy: p.ChangedBy,
+			})
+			if err != nil {
+				tx.Rollback()
+				return 0, err
+			}
+		}
+	}
+
+	count, err := q.UpdateIncidentState(ctx, postgres.UpdateIncidentStateParams{
+		Uuid:     incident.Uuid,
+		Status:   string(to),
+		Severity: incident.Severity,
+	})
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	err = q.CreateIncidentHistory(ctx, postgres.CreateIncidentHistoryParams{
+		IncidentUuid: incident.Uuid,
+		Change:       "status",
+		FromValue:    incident.Status,
+		ToValue:      string(to),
+		Note:         p.Note,
+		ChangedBy:    p.ChangedBy,
+	})
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	// Incidents are routed as their first alert
+	first, err := q.FindIncidentAlerts(ctx, postgres.FindIncidentAlertsParams{
+		IncidentUuid: incident.Uuid,
+		ArgLimit:     1,
+	})
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if len(first) > 0 {
+		triggers := []rest.NotificationTrigger{rest.NotificationTriggerStatus}
+		if err := enqueueIncidentNotifications(ctx, q, incident.Uuid, first[0], triggers); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
+	tx.Commit()
+
+	return count, nil
+}
+
+// AcknowledgeIncident sets the status of an open incident to acknowledge.
+func (svc *IncidentService) AcknowledgeIncident(ctx context.Context, p ChangeAlertStatusParams) (int64, error) {
+	return svc.changeIncidentStatus(ctx, []rest.IncidentStatus{rest.IncidentStatusOpen}, rest.IncidentStatusAcknowledge, p)
+}
+
+// CloseIncident closes an incident, and its alerts.
+func (svc *IncidentService) CloseIncident(ctx context.Context, p ChangeAlertStatusParams) (int64, error) {
+	from := []rest.IncidentStatus{rest.IncidentStatusOpen, rest.IncidentStatusAcknowledge}
+	return svc.changeIncidentStatus(ctx, from, rest.IncidentStatusClose, p)
+}
+
+// alertGroupKey returns the key and title of the incident of the group for
+// the alert, with parent the parent thing of the resource of the alert, if
+// any. It is not ok when the alert has no value for one of the keys.
+func alertGroupKey(g postgres.AlertGroup, a postgres.VAlert, parent *postgres.FindThingParentRow) (string, string, bool) {
+	keys := make([]string, 0, len(g.Keys))
+	values := make([]string, 0, len(g.Keys))
+
+	for _, k := range alertGroupKeys {
+		used := false
+		for _, gk := range g.Keys {
+			if gk == string(k) {
+				used = true
+			}
+		}
+		if used == false {
+			continue
+		}
+
+		var key, value string
+		switch k {
+		case rest.AlertGroupKeyEnvironment:
+			key, value = a.Environment, a.Environment
+		case rest.AlertGroupKeyEvent:
+			key, value = a.Event, a.Event
+		case rest.AlertGroupKeyTag:
+			for _, tag := range a.Tags {
+				if strings.HasPrefix(tag, g.TagPrefix) {
+					key, value = tag, tag
+					break
+				}
+			}
+		case rest.AlertGroupKeyParent:
+			if parent != nil {
+				key, value = parent.Uuid.String(), parent.Name
+			}
+		}
+		if key == "" {
+			return "", "", false
+		}
+
+		keys = append(keys, string(k)+"="+key)
+		values = append(values, value)
+	}
+
+	if len(keys) == 0 {
+		return "", "", false
+	}
+
+	return strings.Join(keys, ";"), g.Name + ": " + strings.Join(values, ", "), true
+}
+
+// alertParent returns the parent thing of the thing whose UUID starts the
+// resource of the alert, or nil.
+func alertParent(ctx context.Context, q *postgres.Queries, a postgres.VAlert) (*postgres.FindThingParentRow, error) {
+	thingUUID, err := uuid.Parse(strings.SplitN(a.Resource, "/", 2)[0])
+	if err != nil {
+		return nil, nil
+	}
+
+	parent, err := q.FindThingParent(ctx, thingUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &parent, nil
+}
+
+// joinIncident adds an alert to the incident of the first alert group with a
+// value for each of its keys, creating the incident when the group has none
+// open for the key. The incident is nil when no group applies.
+func joinIncident(ctx context.Context, q *postgres.Queries, a postgres.VAlert) (*postgres.Incident, bool, error) {
+	groups, err := q.FindEnabledAlertGroups(ctx)
+	if err != nil || len(groups) == 0 {
+		return nil, false, err
+	}
+
+	var parent *postgres.FindThingParentRow
+	for _, g := range groups {
+		for _, k := range g.Keys {
+			if k == string(rest.AlertGroupKeyParent) && parent == nil {
+				if parent, err = alertParent(ctx, q, a); err != nil {
+					return nil, false, err
+				}
+			}
+		}
+	}
+
+	for _, g := range groups {
+		key, title, ok := alertGroupKey(g, a, parent)
+		if ok == false {
+			continue
+		}
+
+		incident, err := q.CreateIncident(ctx, postgres.CreateIncidentParams{
+			GroupUuid: g.Uuid,
+			Key:       key,
+			Title:     title,
+			Severity:  a.Severity,
+		})
+		created := err == nil
+		if errors.Is(err, sql.ErrNoRows) {
+			incident, err = q.FindOpenIncidentByKey(ctx, postgres.FindOpenIncidentByKeyParams{
+				GroupUuid: g.Uuid,
+				Key:       key,
+			})
+		}
+		if err != nil {
+			return nil, false, err
+		}
+
+		if created {
+			err := q.CreateIncidentHistory(ctx, postgres.CreateIncidentHistoryParams{
+				IncidentUuid: incident.Uuid,
+				Change:       "create",
+				ToValue:      incident.Status,
+				Note:         "Created by alert group " + g.Name,
+				AlertUuid:    a.Uuid,
+			})
+			if err != nil {
+				return nil, false, err
+			}
+		}
+
+		if _, err := q.AddIncidentAlert(ctx, postgres.AddIncidentAlertParams{
+			IncidentUuid: incident.Uuid,
+			AlertUuid:    a.Uuid,
+		}); err != nil {
+			return nil, false, err
+		}
+
+		err = q.CreateIncidentHistory(ctx, postgres.CreateIncidentHistoryParams{
+			IncidentUuid: incident.Uuid,
+			Change:       "alert",
+			ToValue:      a.Resource,
+			Note:         a.Event,
+			AlertUuid:    a.Uuid,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+
+		return &incident, created, nil
+	}
+
+	return nil, false, nil
+}
+
+// updateIncident sets the severity of an incident to that of the most severe
+// of its alerts which have not cleared, or closes it when all have cleared,
+// and queues the notifications of the changes.
+func updateIncident(ctx context.Context, q *postgres.Queries, incident postgres.Incident, a postgres.VAlert, created bool) error {
+	active, err := q.GetIncidentActiveAlerts(ctx, incident.Uuid)
+	if err != nil {
+		return err
+	}
+
+	status, severity := incident.Status, incident.Severity
+	if active.Active > 0 {
+		severity = active.Severity
+	} else {
+		status = string(rest.IncidentStatusClose)
+	}
+
+	triggers := make([]rest.NotificationTrigger, 0)
+	if created {
+		triggers = append(triggers, rest.NotificationTriggerCreate)
+	}
+
+	if severity != incident.Severity {
+		err := q.CreateIncidentHistory(ctx, postgres.CreateIncidentHistoryParams{
+			IncidentUuid: incident.Uuid,
+			Change:       "severity",
+			FromValue:    string(incident.Severity),
+			ToValue:      string(severity),
+			AlertUuid:    a.Uuid,
+		})
+		if err != nil {
+			return err
+		}
+		triggers = append(triggers, rest.NotificationTriggerSeverity)
+	}
+
+	if status != incident.Status {
+		err := q.CreateIncidentHistory(ctx, postgres.CreateIncidentHistoryParams{
+			IncidentUuid: incident.Uuid,
+			Change:       "status",
+			FromValue:    incident.Status,
+			ToValue:      status,
+			Note:         "All alerts have cleared",
+			AlertUuid:    a.Uuid,
+		})
+		if err != nil {
+			return err
+		}
+		triggers = append(triggers, rest.NotificationTriggerStatus)
+	}
+
+	if severity != incident.Severity || status != incident.Status {
+		_, err := q.UpdateIncidentState(ctx, postgres.UpdateIncidentStateParams{
+			Uuid:     incident.Uuid,
+			Status:   status,
+			Severity: severity,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return enqueueIncidentNotifications(ctx, q, incident.Uuid, a, triggers)
+}
+
+// notifyAlert queues the notifications of a change of an alert. The changes
+// of an alert which is part of an incident, or joins one, are notified as
+// changes of the incident instead.
+func notifyAlert(ctx context.Context, q *postgres.Queries, a postgres.VAlert, triggers []rest.NotificationTrigger) error {
+	incident, err := q.FindLatestIncidentByAlert(ctx, a.Uuid)
+	if err != nil && errors.Is(err, sql.ErrNoRows) == false {
+		return err
+	}
+	grouped := err == nil
+
+	if grouped && rest.IncidentStatus(incident.Status) != rest.IncidentStatusClose {
+		return updateIncident(ctx, q, incident, a, false)
+	}
+
+	// An open alert joins a new incident, even when its last incident closed
+	if rest.AlertStatus(a.Status) == rest.AlertStatusOpen {
+		joined, created, err := joinIncident(ctx, q, a)
+		if err != nil {
+			return err
+		} else if joined != nil {
+			return updateIncident(ctx, q, *joined, a, created)
+		}
+	}
+
+	// The alerts of a closed incident are no longer notified
+	if grouped {
+		return nil
+	}
+
+	return enqueueAlertNotifications(ctx, q, newRestAlert(a), triggers)
+}
+
+// enqueueIncidentNotifications queues a notification of the incident to the
+// channel of every active rule routing it on one of the triggers. An incident
+// is routed as the alert, with the status and severity of the incident.
+func enqueueIncidentNotifications(ctx context.Context, q *postgres.Queries, id uuid.UUID, a postgres.VAlert, triggers []rest.NotificationTrigger) error {
+	if len(triggers) == 0 {
+		return nil
+	}
+
+	incident, err := findRestIncident(ctx, q, id)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(incident)
+	if err != nil {
+		return err
+	}
+
+	route := newRestAlert(a)
+	route.Status = rest.AlertStatus(incident.Status)
+	route.Severity = incident.Severity
+
+	return enqueueNotifications(ctx, q, route, triggers, payload, id)
+}
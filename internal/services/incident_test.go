@@ -0,0 +1,71 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestAlertGroupKey(t *testing.T) {
+	parent := &postgres.FindThingParentRow{
+		Uuid: uuid.MustParse("a8f6c6ad-1e2d-4a0d-8a4b-3f0b6c9e2d11"),
+		Name: "Substation 4",
+	}
+
+	alert := postgres.VAlert{
+		Resource:    "meter-17",
+		Environment: "Production",
+		Event:       "PowerLoss",
+		Tags:        []string{"area:north", "site:harbour"},
+	}
+
+	cases := []struct {
+		group  postgres.AlertGroup
+		parent *postgres.FindThingParentRow
+		key    string
+		title  string
+		ok     bool
+	}{
+		{
+			postgres.AlertGroup{Name: "Power", Keys: []string{"parent", "environment"}},
+			parent,
+			"environment=Production;parent=a8f6c6ad-1e2d-4a0d-8a4b-3f0b6c9e2d11",
+			"Power: Production, Substation 4",
+			true,
+		},
+		{
+			postgres.AlertGroup{Name: "Sites", Keys: []string{"tag", "event"}, TagPrefix: "site:"},
+			nil,
+			"event=PowerLoss;tag=site:harbour",
+			"Sites: PowerLoss, site:harbour",
+			true,
+		},
+		{
+			postgres.AlertGroup{Name: "Power", Keys: []string{"parent"}},
+			nil,
+			"",
+			"",
+			false,
+		},
+		{
+			postgres.AlertGroup{Name: "Zones", Keys: []string{"tag"}, TagPrefix: "zone:"},
+			parent,
+			"",
+			"",
+			false,
+		},
+	}
+
+	for i, c := range cases {
+		key, title, ok := alertGroupKey(c.group, alert, c.parent)
+		if ok != c.ok || key != c.key || title != c.title {
+			t.Errorf("case %d: expected %q %q %v, got %q %q %v", i, c.key, c.title, c.ok, key, title, ok)
+		}
+	}
+}
@@ -0,0 +1,471 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+// Alerts raised by monitors expire when not raised again within an hour, as
+// when no more data is added to a time series evaluated on ingest.
+const monitorAlertTimeout = 3600
+
+// MonitorService represents the repository used for interacting with time
+// series monitors.
+type MonitorService struct {
+	q  *postgres.Queries
+	db *sql.DB
+}
+
+// NewMonitorService instantiates the MonitorService repository.
+func NewMonitorService(db *sql.DB) *MonitorService {
+	if db == nil {
+		return nil
+	}
+
+	return &MonitorService{
+		q:  postgres.New(db),
+		db: db,
+	}
+}
+
+func newRestMonitor(m postgres.TimeseriesMonitor) *rest.Monitor {
+	v := &rest.Monitor{
+		Uuid:        m.Uuid.String(),
+		Name:        m.Name,
+		Timeseries:  m.TsUuid.String(),
+		Kind:        rest.MonitorKind(m.Kind),
+		Threshold:   m.Threshold,
+		Hysteresis:  m.Hysteresis,
+		Aggregate:   rest.MonitorAggregate(m.Aggregate),
+		Window:      m.WindowSeconds,
+		Evaluate:    rest.MonitorEvaluation(m.Evaluate),
+		Interval:    m.IntervalSeconds,
+		Severity:    rest.AlertSeverity(m.Severity),
+		Environment: m.Environment,
+		Event:       m.Event,
+		Enabled:     m.Enabled,
+		Firing:      m.Firing,
+		Created:     m.Created,
+		CreatedBy:   m.CreatedBy.String(),
+	}
+
+	if m.AlertUuid != uuid.Nil {
+		alert := m.AlertUuid.String()
+		v.Alert = &alert
+	}
+	if m.Value.Valid {
+		v.Value = &m.Value.Float64
+	}
+	if m.Evaluated.Valid {
+		v.Evaluated = &m.Evaluated.Time
+	}
+
+	return v
+}
+
+func validMonitor(hysteresis float64, window, interval int32) error {
+	if hysteresis < 0 {
+		return ie.NewBadRequestError(fmt.Errorf("the hysteresis can not be negative"))
+	} else if window <= 0 {
+		return ie.NewBadRequestError(fmt.Errorf("the window must be at least one second"))
+	} else if interval <= 0 {
+		return ie.NewBadRequestError(fmt.Errorf("the interval must be at least one second"))
+	}
+	return nil
+}
+
+type AddMonitorParams struct {
+	Name        string
+	Timeseries  uuid.UUID
+	Kind        rest.MonitorKind
+	Threshold   float64
+	Hysteresis  float64
+	Aggregate   rest.MonitorAggregate
+	Window      int32
+	Evaluate    rest.MonitorEvaluation
+	Interval    int32
+	Severity    rest.AlertSeverity
+	Environment string
+	Event       string
+	Enabled     bool
+	CreatedBy   uuid.UUID
+}
+
+func (svc *MonitorService) AddMonitor(ctx context.Context, p *AddMonitorParams) (*rest.Monitor, error) {
+	if err := validMonitor(p.Hysteresis, p.Window, p.Interval); err != nil {
+		return nil, err
+	}
+
+	m, err := svc.q.CreateTimeseriesMonitor(ctx, postgres.CreateTimeseriesMonitorParams{
+		TsUuid:          p.Timeseries,
+		Name:            p.Name,
+		Kind:            string(p.Kind),
+		Threshold:       p.Threshold,
+		Hysteresis:      p.Hysteresis,
+		Aggregate:       string(p.Aggregate),
+		WindowSeconds:   p.Window,
+		Evaluate:        string(p.Evaluate),
+		IntervalSeconds: p.Interval,
+		Severity:        postgres.AlertSeverity(p.Severity),
+		Environment:     p.Environment,
+		Event:           p.Event,
+		Enabled:         p.Enabled,
+		CreatedBy:       p.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestMonitor(m), nil
+}
+
+type FindMonitorsParams struct {
+	PaginationParams
+	Timeseries *uuid.UUID
+}
+
+func (svc *MonitorService) FindMonitors(ctx context.Context, p FindMonitorsParams) ([]*rest.Monitor, error) {
+	monitors := make([]*rest.Monitor, 0)
+
+	params := postgres.FindTimeseriesMonitorsParams{
+		ArgLimit:  p.Limit.Value,
+		ArgOffset: p.Offset.Value,
+	}
+	if p.Timeseries != nil {
+		params.TsUuid = p.Timeseries.String()
+	}
+
+	list, err := svc.q.FindTimeseriesMonitors(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range list {
+		monitors = append(monitors, newRestMonitor(m))
+	}
+
+	return monitors, nil
+}
+
+func (svc *MonitorService) FindMonitorByUuid(ctx context.Context, id uuid.UUID) (*rest.Monitor, error) {
+	m, err := svc.q.FindTimeseriesMonitorByUUID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestMonitor(m), nil
+}
+
+type UpdateMonitorParams struct {
+	Uuid        uuid.UUID
+	Name        *string
+	Kind        *rest.MonitorKind
+	Threshold   *float64
+	Hysteresis  *float64
+	Aggregate   *rest.MonitorAggregate
+	Window      *int32
+	Evaluate    *rest.MonitorEvaluation
+	Interval    *int32
+	Severity    *rest.AlertSeverity
+	Environment *string
+	Event       *string
+	Enabled     *bool
+}
+
+func (svc *MonitorService) UpdateMonitorByUuid(ctx context.Context, p UpdateMonitorParams) (int64, error) {
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	m, err := q.FindTimeseriesMonitorByUUID(ctx, p.Uuid)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	params := postgres.UpdateTimeseriesMonitorParams{
+		Uuid:            m.Uuid,
+		Name:            m.Name,
+		Kind:            m.Kind,
+		Threshold:       m.Threshold,
+		Hysteresis:      m.Hysteresis,
+		Aggregate:       m.Aggregate,
+		WindowSeconds:   m.WindowSeconds,
+		Evaluate:        m.Evaluate,
+		IntervalSeconds: m.IntervalSeconds,
+		Severity:        m.Severity,
+		Environment:     m.Environment,
+		Event:           m.Event,
+		Enabled:         m.Enabled,
+	}
+
+	if p.Name != nil {
+		params.Name = *p.Name
+	}
+	if p.Kind != nil {
+		params.Kind = string(*p.Kind)
+	}
+	if p.Threshold != nil {
+		params.Threshold = *p.Threshold
+	}
+	if p.Hysteresis != nil {
+		params.Hysteresis = *p.Hysteresis
+	}
+	if p.Aggregate != nil {
+		params.Aggregate = string(*p.Aggregate)
+	}
+	if p.Window != nil {
+		params.WindowSeconds = *p.Window
+	}
+	if p.Evaluate != nil {
+		params.Evaluate = string(*p.Evaluate)
+	}
+	if p.Interval != nil {
+		params.IntervalSeconds = *p.Interval
+	}
+	if p.Severity != nil {
+		params.Severity = postgres.AlertSeverity(*p.Severity)
+	}
+	if p.Environment != nil {
+		params.Environment = *p.Environment
+	}
+	if p.Event != nil {
+		params.Event = *p.Event
+	}
+	if p.Enabled != nil {
+		params.Enabled = *p.Enabled
+	}
+
+	if err := validMonitor(params.Hysteresis, params.WindowSeconds, params.IntervalSeconds); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	count, err := q.UpdateTimeseriesMonitor(ctx, params)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	tx.Commit()
+
+	return count, nil
+}
+
+func (svc *MonitorService) DeleteMonitor(ctx context.Context, id uuid.UUID) (int64, error) {
+	count, err := svc.q.DeleteTimeseriesMonitor(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// monitorFiring reports whether the condition of the monitor holds for the
+// value, where firing is whether it held at the last evaluation. Once firing,
+// the value must go back beyond the hysteresis for the condition to clear.
+func monitorFiring(m postgres.TimeseriesMonitor, firing bool, value float64) bool {
+	threshold := m.Threshold
+
+	switch rest.MonitorKind(m.Kind) {
+	case rest.MonitorKindAbove:
+		if firing {
+			threshold -= m.Hysteresis
+		}
+		return value > threshold
+	case rest.MonitorKindBelow:
+		if firing {
+			threshold += m.Hysteresis
+		}
+		return value < threshold
+	case rest.MonitorKindRate:
+		if firing {
+			threshold -= m.Hysteresis
+		}
+		return math.Abs(value) > threshold
+	}
+
+	return false
+}
+
+// monitorValue returns the aggregate of the time series over the window
+// ending at the time, or for a rate monitor the change per second from the
+// previous window. It is not valid without data, except when counting.
+func (svc *MonitorService) monitorValue(ctx context.Context, m postgres.TimeseriesMonitor, at time.Time) (sql.NullFloat64, error) {
+	window := time.Duration(m.WindowSeconds) * time.Second
+
+	aggregate := func(stop time.Time) (sql.NullFloat64, error) {
+		row, err := svc.q.GetTsDataWindowAgg(ctx, postgres.GetTsDataWindowAggParams{
+			Aggregate: m.Aggregate,
+			TsUuid:    m.TsUuid,
+			Start:     stop.Add(-window),
+			Stop:      stop,
+		})
+		if err != nil {
+			return sql.NullFloat64{}, err
+		}
+		return sql.NullFloat64{
+			Float64: row.Value,
+			Valid:   row.Points > 0 || rest.MonitorAggregate(m.Aggregate) == rest.MonitorAggregateCount,
+		}, nil
+	}
+
+	current, err := aggregate(at)
+	if err != nil || current.Valid == false || rest.MonitorKind(m.Kind) != rest.MonitorKindRate {
+		return current, err
+	}
+
+	previous, err := aggregate(at.Add(-window))
+	if err != nil || previous.Valid == false {
+		return previous, err
+	}
+
+	return sql.NullFloat64{
+		Float64: (current.Float64 - previous.Float64) / window.Seconds(),
+		Valid:   true,
+	}, nil
+}
+
+// monitorAlertParams returns the alert raised by a monitor for the value.
+func monitorAlertParams(m postgres.TimeseriesMonitor, ts postgres.Timeseries, value float64) *CreateAlertParams {
+	unit := ts.SiUnit
+	if rest.MonitorKind(m.Kind) == rest.MonitorKindRate {
+		unit += "/s"
+	}
+	format := func(f float64) string {
+		return strings.TrimSpace(strconv.FormatFloat(f, 'g', 6, 64) + " " + unit)
+	}
+
+	p := &CreateAlertParams{
+		Resource:    ts.Uuid.String(),
+		Environment: m.Environment,
+		Event:       m.Event,
+		Severity:    rest.AlertSeverity(m.Severity),
+		Status:      rest.AlertStatusOpen,
+		Value:       format(value),
+		Origin:      "monitor/" + m.Uuid.String(),
+		Service:     make([]string, 0),
+		Tags:        stringsOrEmpty(ts.Tags),
+		Timeout:     monitorAlertTimeout,
+	}
+	if p.Event == "" {
+		p.Event = m.Name
+	}
+
+	window := time.Duration(m.WindowSeconds) * time.Second
+
+	switch rest.MonitorKind(m.Kind) {
+	case rest.MonitorKindAbove:
+		p.Description = fmt.Sprintf("The %s of %s over %s is %s, above %s",
+			m.Aggregate, ts.Name, window, format(value), format(m.Threshold))
+	case rest.MonitorKindBelow:
+		p.Description = fmt.Sprintf("The %s of %s over %s is %s, below %s",
+			m.Aggregate, ts.Name, window, format(value), format(m.Threshold))
+	case rest.MonitorKindRate:
+		p.Description = fmt.Sprintf("The %s of %s over %s changes by %s, faster than %s",
+			m.Aggregate, ts.Name, window, format(value), format(m.Threshold))
+	}
+
+	return p
+}
+
+// evaluateMonitor raises the alert of the monitor while its condition holds,
+// and resolves it when the condition clears.
+func (svc *MonitorService) evaluateMonitor(ctx context.Context, m postgres.TimeseriesMonitor) error {
+	value, err := svc.monitorValue(ctx, m, time.Now())
+	if err != nil {
+		return err
+	}
+
+	firing := value.Valid && monitorFiring(m, m.Firing, value.Float64)
+	alertUUID := m.AlertUuid
+	alerts := NewAlertService(svc.db)
+
+	if firing {
+		ts, err := svc.q.GetTimeseriesByUUID(ctx, m.TsUuid)
+		if err != nil {
+			return err
+		}
+
+		reply, err := alerts.CreateAlert(ctx, monitorAlertParams(m, ts, value.Float64))
+		if err != nil {
+			return err
+		}
+
+		alertUUID, err = uuid.Parse(reply.Uuid)
+		if err != nil {
+			return err
+		}
+	} else if m.Firing && alertUUID != uuid.Nil {
+		alert, err := svc.q.FindAlertByUUID(ctx, alertUUID)
+		if err != nil && errors.Is(err, sql.ErrNoRows) == false {
+			return err
+		} else if err == nil && rest.AlertStatus(alert.Status) != rest.AlertStatusClose {
+			_, err := alerts.ResolveAlert(ctx, ChangeAlertStatusParams{
+				Uuid: alertUUID,
+				Note: "Resolved by monitor " + m.Name,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = svc.q.UpdateTimeseriesMonitorState(ctx, postgres.UpdateTimeseriesMonitorStateParams{
+		Uuid:      m.Uuid,
+		Firing:    firing,
+		AlertUuid: alertUUID,
+		Value:     value,
+	})
+
+	return err
+}
+
+// EvaluateMonitors evaluates the monitors which are due; those of time series
+// with data added since their last evaluation, and those on schedule whose
+// interval has passed. Monitors are claimed before being evaluated, so
+// several instances can evaluate the same domain.
+func (svc *MonitorService) EvaluateMonitors(ctx context.Context, limit int64) (int, error) {
+	list, err := svc.q.ClaimDueTimeseriesMonitors(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		evaluated int
+		errs      []error
+	)
+
+	for _, m := range list {
+		if err := svc.evaluateMonitor(ctx, m); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		evaluated++
+	}
+
+	if len(errs) > 0 {
+		return evaluated, errs[0]
+	}
+
+	return evaluated, nil
+}
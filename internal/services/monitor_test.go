@@ -0,0 +1,62 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestMonitorFiring(t *testing.T) {
+	above := postgres.TimeseriesMonitor{Kind: "above", Threshold: 30, Hysteresis: 2}
+	below := postgres.TimeseriesMonitor{Kind: "below", Threshold: 10, Hysteresis: 1}
+	rate := postgres.TimeseriesMonitor{Kind: "rate", Threshold: 0.5}
+
+	cases := []struct {
+		monitor postgres.TimeseriesMonitor
+		firing  bool
+		value   float64
+		expect  bool
+	}{
+		{above, false, 31, true},
+		{above, false, 29, false},
+		{above, true, 29, true},
+		{above, true, 28, false},
+		{below, false, 9, true},
+		{below, true, 10.5, true},
+		{below, true, 11, false},
+		{rate, false, -0.6, true},
+		{rate, false, 0.4, false},
+	}
+
+	for i, c := range cases {
+		if monitorFiring(c.monitor, c.firing, c.value) != c.expect {
+			t.Errorf("case %d: expected %v", i, c.expect)
+		}
+	}
+}
+
+func TestMonitorAlertParams(t *testing.T) {
+	m := postgres.TimeseriesMonitor{
+		Name:          "Server room too hot",
+		Kind:          "above",
+		Threshold:     30,
+		Aggregate:     "avg",
+		WindowSeconds: 300,
+	}
+	ts := postgres.Timeseries{Name: "Temperature", SiUnit: "°C"}
+
+	p := monitorAlertParams(m, ts, 31.25)
+	if p.Event != m.Name {
+		t.Errorf("expected the event %q, got %q", m.Name, p.Event)
+	}
+	if p.Value != "31.25 °C" {
+		t.Errorf("unexpected value %q", p.Value)
+	}
+	if p.Description != "The avg of Temperature over 5m0s is 31.25 °C, above 30 °C" {
+		t.Errorf("unexpected description %q", p.Description)
+	}
+}
@@ -0,0 +1,820 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/pkg/notify"
+	"github.com/self-host/self-host/postgres"
+)
+
+// NotificationService represents the repository used for interacting with
+// notification channels, routing rules and deliveries.
+type NotificationService struct {
+	q  *postgres.Queries
+	db *sql.DB
+}
+
+// NewNotificationService instantiates the NotificationService repository.
+func NewNotificationService(db *sql.DB) *NotificationService {
+	if db == nil {
+		return nil
+	}
+
+	return &NotificationService{
+		q:  postgres.New(db),
+		db: db,
+	}
+}
+
+func newRestNotificationChannel(c postgres.NotificationChannel) (*rest.NotificationChannel, error) {
+	v := &rest.NotificationChannel{
+		Uuid:      c.Uuid.String(),
+		Name:      c.Name,
+		Kind:      rest.NotificationChannelKind(c.Kind),
+		Enabled:   c.Enabled,
+		Created:   c.Created,
+		CreatedBy: c.CreatedBy.String(),
+	}
+
+	if err := json.Unmarshal(c.Config, &v.Config); err != nil {
+		return nil, err
+	}
+
+	redactChannelConfig(v.Kind, &v.Config)
+
+	return v, nil
+}
+
+// redactChannelConfig removes the secrets of a channel config. The password,
+// the webhook headers (which usually carry a token) and the URL of a chat
+// service (which is the secret itself) are kept, but never returned.
+func redactChannelConfig(kind rest.NotificationChannelKind, config *rest.NotificationChannelConfig) {
+	config.Password = nil
+	config.Headers = nil
+	if kind == rest.NotificationChannelKindSlack {
+		config.Url = nil
+	}
+}
+
+// keepChannelSecrets copies the secrets of the current config into a new
+// config which does not set them, as they are never returned.
+func keepChannelSecrets(current rest.NotificationChannelConfig, config *rest.NotificationChannelConfig) {
+	if config.Password == nil {
+		config.Password = current.Password
+	}
+	if config.Headers == nil {
+		config.Headers = current.Headers
+	}
+	if config.Url == nil {
+		config.Url = current.Url
+	}
+}
+
+// channelConfig validates the config for the kind of channel and returns it as
+// stored in the database.
+func channelConfig(kind rest.NotificationChannelKind, config rest.NotificationChannelConfig) (json.RawMessage, error) {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var c notify.Config
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, err
+	}
+
+	if _, err := notify.New(string(kind), c); err != nil {
+		return nil, ie.NewBadRequestError(err)
+	}
+
+	return b, nil
+}
+
+type AddNotificationChannelParams struct {
+	Name      string
+	Kind      rest.NotificationChannelKind
+	Config    rest.NotificationChannelConfig
+	Enabled   bool
+	CreatedBy uuid.UUID
+}
+
+func (svc *NotificationService) AddChannel(ctx context.Context, p *AddNotificationChannelParams) (*rest.NotificationChannel, error) {
+	config, err := channelConfig(p.Kind, p.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := svc.q.CreateNotificationChannel(ctx, postgres.CreateNotificationChannelParams{
+		Name:      p.Name,
+		Kind:      string(p.Kind),
+		Config:    config,
+		Enabled:   p.Enabled,
+		CreatedBy: p.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestNotificationChannel(c)
+}
+
+func (svc *NotificationService) FindChannels(ctx context.Context, p FindAllParams) ([]*rest.NotificationChannel, error) {
+	channels := make([]*rest.NotificationChannel, 0)
+
+	list, err := svc.q.FindNotificationChannels(ctx, postgres.FindNotificationChannelsParams{
+		ArgLimit:  p.Limit.Value,
+		ArgOffset: p.Offset.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range list {
+		v, err := newRestNotificationChannel(c)
+		if err != nil {
+			return nil, err
+		}
+
+		channels = append(channels, v)
+	}
+
+	return channels, nil
+}
+
+func (svc *NotificationService) FindChannelByUuid(ctx context.Context, id uuid.UUID) (*rest.NotificationChannel, error) {
+	c, err := svc.q.FindNotificationChannelByUUID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestNotificationChannel(c)
+}
+
+type UpdateNotificationChannelParams struct {
+	Uuid    uuid.UUID
+	Name    *string
+	Kind    *rest.NotificationChannelKind
+	Config  *rest.NotificationChannelConfig
+	Enabled *bool
+}
+
+// UpdateChannelByUuid updates a channel. A new config without a password,
+// headers or URL keeps the current ones.
+func (svc *NotificationService) UpdateChannelByUuid(ctx context.Context, p UpdateNotificationChannelParams) (int64, error) {
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	c, err := q.FindNotificationChannelByUUID(ctx, p.Uuid)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	var config rest.NotificationChannelConfig
+	if err := json.Unmarshal(c.Config, &config); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	params := postgres.UpdateNotificationChannelParams{
+		Uuid:    c.Uuid,
+		Name:    c.Name,
+		Kind:    c.Kind,
+		Enabled: c.Enabled,
+	}
+
+	if p.Name != nil {
+		params.Name = *p.Name
+	}
+	if p.Kind != nil {
+		params.Kind = string(*p.Kind)
+	}
+	if p.Config != nil {
+		current := config
+		config = *p.Config
+		keepChannelSecrets(current, &config)
+	}
+	if p.Enabled != nil {
+		params.Enabled = *p.Enabled
+	}
+
+	params.Config, err = channelConfig(rest.NotificationChannelKind(params.Kind), config)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	count, err := q.UpdateNotificationChannel(ctx, params)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	tx.Commit()
+
+	return count, nil
+}
+
+// DeleteChannel deletes a channel along with its rules and deliveries.
+func (svc *NotificationService) DeleteChannel(ctx context.Context, id uuid.UUID) (int64, error) {
+	count, err := svc.q.DeleteNotificationChannel(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func newRestNotificationRule(r postgres.NotificationRule) *rest.NotificationRule {
+	v := &rest.NotificationRule{
+		Uuid:        r.Uuid.String(),
+		Name:        r.Name,
+		Channel:     r.ChannelUuid.String(),
+		Triggers:    make([]rest.NotificationTrigger, 0),
+		Environment: r.Environment,
+		Severity:    make([]rest.AlertSeverity, 0),
+		Service:     r.Service,
+		Tags:        r.Tags,
+		Resource:    r.Resource,
+		Enabled:     r.Enabled,
+		Created:     r.Created,
+		CreatedBy:   r.CreatedBy.String(),
+	}
+
+	for _, t := range r.Triggers {
+		v.Triggers = append(v.Triggers, rest.NotificationTrigger(t))
+	}
+	for _, s := range r.Severity {
+		v.Severity = append(v.Severity, rest.AlertSeverity(s))
+	}
+
+	return v
+}
+
+func validResourcePattern(pattern string) error {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return ie.NewBadRequestError(fmt.Errorf("invalid resource pattern %q", pattern))
+	}
+	return nil
+}
+
+func triggerStrings(triggers []rest.NotificationTrigger) []string {
+	s := make([]string, 0, len(triggers))
+	for _, t := range triggers {
+		s = append(s, string(t))
+	}
+	return s
+}
+
+func severityStrings(severity []rest.AlertSeverity) []string {
+	s := make([]string, 0, len(severity))
+	for _, v := range severity {
+		s = append(s, string(v))
+	}
+	return s
+}
+
+type AddNotificationRuleParams struct {
+	Name        string
+	Channel     uuid.UUID
+	Triggers    []rest.NotificationTrigger
+	Environment []string
+	Severity    []rest.AlertSeverity
+	Service     []string
+	Tags        []string
+	Resource    string
+	Enabled     bool
+	CreatedBy   uuid.UUID
+}
+
+func (svc *NotificationService) AddRule(ctx context.Context, p *AddNotificationRuleParams) (*rest.NotificationRule, error) {
+	if err := validResourcePattern(p.Resource); err != nil {
+		return nil, err
+	}
+
+	r, err := svc.q.CreateNotificationRule(ctx, postgres.CreateNotificationRuleParams{
+		Name:        p.Name,
+		ChannelUuid: p.Channel,
+		Triggers:    triggerStrings(p.Triggers),
+		Environment: stringsOrEmpty(p.Environment),
+		Severity:    severityStrings(p.Severity),
+		Service:     stringsOrEmpty(p.Service),
+		Tags:        stringsOrEmpty(p.Tags),
+		Resource:    p.Resource,
+		Enabled:     p.Enabled,
+		CreatedBy:   p.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestNotificationRule(r), nil
+}
+
+func (svc *NotificationService) FindRules(ctx context.Context, p FindAllParams) ([]*rest.NotificationRule, error) {
+	rules := make([]*rest.NotificationRule, 0)
+
+	list, err := svc.q.FindNotificationRules(ctx, postgres.FindNotificationRulesParams{
+		ArgLimit:  p.Limit.Value,
+		ArgOffset: p.Offset.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range list {
+		rules = append(rules, newRestNotificationRule(r))
+	}
+
+	return rules, nil
+}
+
+func (svc *NotificationService) FindRuleByUuid(ctx context.Context, id uuid.UUID) (*rest.NotificationRule, error) {
+	r, err := svc.q.FindNotificationRuleByUUID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestNotificationRule(r), nil
+}
+
+type UpdateNotificationRuleParams struct {
+	Uuid        uuid.UUID
+	Name        *string
+	Channel     *uuid.UUID
+	Triggers    *[]rest.NotificationTrigger
+	Environment *[]string
+	Severity    *[]rest.AlertSeverity
+	Service     *[]string
+	Tags        *[]string
+	Resource    *string
+	Enabled     *bool
+}
+
+func (svc *NotificationService) UpdateRuleByUuid(ctx context.Context, p UpdateNotificationRuleParams) (int64, error) {
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	r, err := q.FindNotificationRuleByUUID(ctx, p.Uuid)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	params := postgres.UpdateNotificationRuleParams{
+		Uuid:        r.Uuid,
+		Name:        r.Name,
+		ChannelUuid: r.ChannelUuid,
+		Triggers:    r.Triggers,
+		Environment: r.Environment,
+		Severity:    r.Severity,
+		Service:     r.Service,
+		Tags:        r.Tags,
+		Resource:    r.Resource,
+		Enabled:     r.Enabled,
+	}
+
+	if p.Name != nil {
+		params.Name = *p.Name
+	}
+	if p.Channel != nil {
+		params.ChannelUuid = *p.Channel
+	}
+	if p.Triggers != nil {
+		params.Triggers = triggerStrings(*p.Triggers)
+	}
+	if p.Environment != nil {
+		params.Environment = stringsOrEmpty(*p.Environment)
+	}
+	if p.Severity != nil {
+		params.Severity = severityStrings(*p.Severity)
+	}
+	if p.Service != nil {
+		params.Service = stringsOrEmpty(*p.Service)
+	}
+	if p.Tags != nil {
+		params.Tags = stringsOrEmpty(*p.Tags)
+	}
+	if p.Resource != nil {
+		if err := validResourcePattern(*p.Resource); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		params.Resource = *p.Resource
+	}
+	if p.Enabled != nil {
+		params.Enabled = *p.Enabled
+	}
+
+	count, err := q.UpdateNotificationRule(ctx, params)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	tx.Commit()
+
+	return count, nil
+}
+
+func (svc *NotificationService) DeleteRule(ctx context.Context, id uuid.UUID) (int64, error) {
+	count, err := svc.q.DeleteNotificationRule(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+type FindNotificationDeliveriesParams struct {
+	PaginationParams
+	Channel  *uuid.UUID
+	Alert    *uuid.UUID
+	Incident *uuid.UUID
+	Status   *rest.NotificationDeliveryStatus
+}
+
+func (svc *NotificationService) FindDeliveries(ctx context.Context, p FindNotificationDeliveriesParams) ([]*rest.NotificationDelivery, error) {
+	deliveries := make([]*rest.NotificationDelivery, 0)
+
+	params := postgres.FindNotificationDeliveriesParams{
+		ArgLimit:  p.Limit.Value,
+		ArgOffset: p.Offset.Value,
+	}
+	if p.Channel != nil {
+		params.ChannelUuid = p.Channel.String()
+	}
+	if p.Alert != nil {
+		params.AlertUuid = p.Alert.String()
+	}
+	if p.Incident != nil {
+		params.IncidentUuid = p.Incident.String()
+	}
+	if p.Status != nil {
+		params.Status = string(*p.Status)
+	}
+
+	list, err := svc.q.FindNotificationDeliveries(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range list {
+		v := &rest.NotificationDelivery{
+			Uuid:        d.Uuid.String(),
+			Channel:     d.ChannelUuid.String(),
+			Trigger:     rest.NotificationTrigger(d.Trigger),
+			Status:      rest.NotificationDeliveryStatus(d.Status),
+			Attempts:    d.Attempts,
+			NextAttempt: d.NextAttempt,
+			LastError:   d.LastError,
+			Created:     d.Created,
+		}
+
+		if err := json.Unmarshal(d.Payload, &v.Payload); err != nil {
+			return nil, err
+		}
+		if d.RuleUuid != uuid.Nil {
+			s := d.RuleUuid.String()
+			v.Rule = &s
+		}
+		if d.AlertUuid != uuid.Nil {
+			s := d.AlertUuid.String()
+			v.Alert = &s
+		}
+		if d.IncidentUuid != uuid.Nil {
+			s := d.IncidentUuid.String()
+			v.Incident = &s
+		}
+		if d.Delivered.Valid {
+			v.Delivered = &d.Delivered.Time
+		}
+
+		deliveries = append(deliveries, v)
+	}
+
+	return deliveries, nil
+}
+
+// ruleMatches reports whether the rule routes the alert on the trigger. Empty
+// lists match anything, as does an empty resource pattern.
+func ruleMatches(r postgres.NotificationRule, a *rest.Alert, trigger rest.NotificationTrigger) bool {
+	contains := func(list []string, s string) bool {
+		if len(list) == 0 {
+			return true
+		}
+		for _, v := range list {
+			if v == s {
+				return true
+			}
+		}
+		return false
+	}
+	overlaps := func(list []string, other []string) bool {
+		if len(list) == 0 {
+			return true
+		}
+		for _, s := range other {
+			for _, v := range list {
+				if v == s {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	if contains(r.Triggers, string(trigger)) == false ||
+		contains(r.Environment, a.Environment) == false ||
+		contains(r.Severity, string(a.Severity)) == false ||
+		overlaps(r.Service, a.Service) == false ||
+		overlaps(r.Tags, a.Tags) == false {
+		return false
+	}
+
+	if r.Resource != "" {
+		if ok, _ := path.Match(r.Resource, a.Resource); ok == false {
+			return false
+		}
+	}
+
+	return true
+}
+
+// enqueueAlertNotifications queues a notification of the alert to the channel
+// of every active rule routing it on one of the triggers.
+func enqueueAlertNotifications(ctx context.Context, q *postgres.Queries, a *rest.Alert, triggers []rest.NotificationTrigger) error {
+	payload, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	return enqueueNotifications(ctx, q, a, triggers, payload, uuid.Nil)
+}
+
+// enqueueNotifications queues the payload to the channel of every active rule
+// routing the alert on one of the triggers, as a notification of the incident
+// when one is given. A channel is only notified once, however many of its
+// rules match.
+func enqueueNotifications(ctx context.Context, q *postgres.Queries, a *rest.Alert, triggers []rest.NotificationTrigger, payload []byte, incidentUUID uuid.UUID) error {
+	// Silenced alerts are not notified until their silence ends
+	if len(triggers) == 0 || a.Status == rest.AlertStatusSilence {
+		return nil
+	}
+
+	rules, err := q.FindActiveNotificationRules(ctx)
+	if err != nil || len(rules) == 0 {
+		return err
+	}
+
+	alertUUID := uuid.Nil
+	if incidentUUID == uuid.Nil {
+		alertUUID, err = uuid.Parse(a.Uuid)
+		if err != nil {
+			return err
+		}
+	}
+
+	queued := make(map[uuid.UUID]bool)
+	for _, r := range rules {
+		if queued[r.ChannelUuid] {
+			continue
+		}
+
+		for _, t := range triggers {
+			if ruleMatches(r, a, t) == false {
+				continue
+			}
+
+			err := q.CreateNotificationDelivery(ctx, postgres.CreateNotificationDeliveryParams{
+				ChannelUuid:  r.ChannelUuid,
+				RuleUuid:     r.Uuid,
+				AlertUuid:    alertUUID,
+				IncidentUuid: incidentUUID,
+				Trigger:      string(t),
+				Payload:      payload,
+			})
+			if err != nil {
+				return err
+			}
+
+			queued[r.ChannelUuid] = true
+			break
+		}
+	}
+
+	return nil
+}
+
+// payloadField formats a field of the payload of a delivery.
+func payloadField(payload map[string]interface{}, name string) string {
+	switch v := payload[name].(type) {
+	case string:
+		return v
+	case []interface{}:
+		s := make([]string, 0, len(v))
+		for _, e := range v {
+			s = append(s, fmt.Sprint(e))
+		}
+		return strings.Join(s, ", ")
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// alertMessage describes an alert, from the payload of a delivery.
+func alertMessage(trigger string, payload map[string]interface{}) notify.Message {
+	field := func(name string) string {
+		return payloadField(payload, name)
+	}
+
+	subject := fmt.Sprintf("[%s] %s on %s", field("severity"), field("event"), field("resource"))
+	if env := field("environment"); env != "" {
+		subject += " (" + env + ")"
+	}
+	switch rest.NotificationTrigger(trigger) {
+	case rest.NotificationTriggerSeverity:
+		subject += ", severity was " + field("previous_severity")
+	case rest.NotificationTriggerStatus:
+		subject += ", status is " + field("status")
+	}
+
+	var text strings.Builder
+	for _, name := range []string{"resource", "environment", "event", "severity", "status", "value", "description", "service", "tags", "origin", "uuid"} {
+		if v := field(name); v != "" {
+			fmt.Fprintf(&text, "%s: %s\n", strings.ToUpper(name[:1])+name[1:], v)
+		}
+	}
+
+	return notify.Message{
+		Trigger: trigger,
+		Subject: subject,
+		Text:    text.String(),
+		Payload: payload,
+	}
+}
+
+// incidentMessage describes an incident, from the payload of a delivery.
+func incidentMessage(trigger string, payload map[string]interface{}) notify.Message {
+	field := func(name string) string {
+		return payloadField(payload, name)
+	}
+
+	subject := fmt.Sprintf("[%s] Incident: %s", field("severity"), field("title"))
+	if rest.NotificationTrigger(trigger) == rest.NotificationTriggerStatus {
+		subject += ", status is " + field("status")
+	}
+
+	var text strings.Builder
+	for _, name := range []string{"title", "status", "severity", "alerts", "key", "uuid"} {
+		if v := field(name); v != "" {
+			fmt.Fprintf(&text, "%s: %s\n", strings.ToUpper(name[:1])+name[1:], v)
+		}
+	}
+
+	return notify.Message{
+		Trigger: trigger,
+		Subject: subject,
+		Text:    text.String(),
+		Payload: payload,
+	}
+}
+
+type DeliverNotificationsParams struct {
+	Limit       int64
+	MaxAttempts int32
+	Timeout     time.Duration // Of each attempt
+}
+
+// notificationRetryDelay is the delay, in seconds, before the next attempt of
+// a failed delivery; 30 seconds doubling with every attempt, up to an hour.
+func notificationRetryDelay(attempts int32) int32 {
+	delay := int32(30)
+	for i := int32(1); i < attempts && delay < 3600; i++ {
+		delay *= 2
+	}
+	if delay > 3600 {
+		delay = 3600
+	}
+	return delay
+}
+
+// DeliverNotifications sends the pending notifications that are due and
+// returns the number sent. Deliveries are claimed for the duration of an
+// attempt, so several instances can deliver from the same domain. Failed
+// deliveries are retried until MaxAttempts.
+func (svc *NotificationService) DeliverNotifications(ctx context.Context, p DeliverNotificationsParams) (int, error) {
+	list, err := svc.q.ClaimNotificationDeliveries(ctx, postgres.ClaimNotificationDeliveriesParams{
+		Lease:    int32(p.Timeout.Seconds()) + 60,
+		ArgLimit: p.Limit,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		sent int
+		errs []error
+	)
+
+	for _, d := range list {
+		wg.Add(1)
+		go func(d postgres.ClaimNotificationDeliveriesRow) {
+			defer wg.Done()
+
+			var err error
+			if serr := deliverNotification(ctx, d, p.Timeout); serr == nil {
+				_, err = svc.q.SetNotificationDeliverySent(ctx, d.Uuid)
+				if err == nil {
+					mu.Lock()
+					sent++
+					mu.Unlock()
+				}
+			} else if d.Enabled == false || d.Attempts >= p.MaxAttempts {
+				_, err = svc.q.SetNotificationDeliveryFailed(ctx, postgres.SetNotificationDeliveryFailedParams{
+					Uuid:      d.Uuid,
+					LastError: serr.Error(),
+				})
+			} else {
+				_, err = svc.q.SetNotificationDeliveryRetry(ctx, postgres.SetNotificationDeliveryRetryParams{
+					Uuid:      d.Uuid,
+					LastError: serr.Error(),
+					RetryIn:   notificationRetryDelay(d.Attempts),
+				})
+			}
+
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(d)
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return sent, errs[0]
+	}
+
+	return sent, nil
+}
+
+func deliverNotification(ctx context.Context, d postgres.ClaimNotificationDeliveriesRow, timeout time.Duration) error {
+	if d.Enabled == false {
+		return errors.New("the channel is disabled")
+	}
+
+	var c notify.Config
+	if err := json.Unmarshal(d.Config, &c); err != nil {
+		return err
+	}
+
+	sender, err := notify.New(d.Kind, c)
+	if err != nil {
+		return err
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(d.Payload, &payload); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if d.IncidentUuid != uuid.Nil {
+		return sender.Send(ctx, incidentMessage(d.Trigger, payload))
+	}
+
+	return sender.Send(ctx, alertMessage(d.Trigger, payload))
+}
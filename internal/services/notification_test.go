@@ -0,0 +1,111 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"testing"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestNotificationRuleMatches(t *testing.T) {
+	alert := &rest.Alert{
+		Resource:    "web01",
+		Environment: "Production",
+		Event:       "Crashed",
+		Severity:    rest.AlertSeverity("critical"),
+		Service:     []string{"web", "api"},
+		Tags:        []string{"eu"},
+	}
+
+	cases := []struct {
+		rule    postgres.NotificationRule
+		matches bool
+	}{
+		{postgres.NotificationRule{}, true},
+		{postgres.NotificationRule{Triggers: []string{"status"}}, false},
+		{postgres.NotificationRule{Environment: []string{"Production"}, Severity: []string{"major", "critical"}}, true},
+		{postgres.NotificationRule{Environment: []string{"Staging"}}, false},
+		{postgres.NotificationRule{Service: []string{"db", "api"}}, true},
+		{postgres.NotificationRule{Tags: []string{"us"}}, false},
+		{postgres.NotificationRule{Resource: "web*"}, true},
+		{postgres.NotificationRule{Resource: "db*"}, false},
+	}
+
+	for i, c := range cases {
+		if ruleMatches(c.rule, alert, rest.NotificationTriggerCreate) != c.matches {
+			t.Errorf("case %d: expected %v", i, c.matches)
+		}
+	}
+}
+
+func TestNotificationMessage(t *testing.T) {
+	m := alertMessage("severity", map[string]interface{}{
+		"resource":          "web01",
+		"environment":       "Production",
+		"event":             "Crashed",
+		"severity":          "critical",
+		"previous_severity": "minor",
+		"service":           []interface{}{"web", "api"},
+	})
+
+	if m.Subject != "[critical] Crashed on web01 (Production), severity was minor" {
+		t.Errorf("unexpected subject %q", m.Subject)
+	}
+	if m.Text != "Resource: web01\nEnvironment: Production\nEvent: Crashed\nSeverity: critical\nService: web, api\n" {
+		t.Errorf("unexpected text %q", m.Text)
+	}
+}
+
+func TestNotificationRetryDelay(t *testing.T) {
+	for attempts, delay := range map[int32]int32{1: 30, 2: 60, 4: 240, 8: 3600, 100: 3600} {
+		if d := notificationRetryDelay(attempts); d != delay {
+			t.Errorf("attempt %d: expected %d, got %d", attempts, delay, d)
+		}
+	}
+}
+
+func TestNotificationChannelSecrets(t *testing.T) {
+	c, err := newRestNotificationChannel(postgres.NotificationChannel{
+		Kind:   "slack",
+		Config: []byte(`{"url":"https://hooks.example.com/T000/B000/XXXX","text":"{{.Subject}}"}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Config.Url != nil || c.Config.Text == nil {
+		t.Errorf("expected only the URL of a slack channel to be redacted")
+	}
+
+	c, err = newRestNotificationChannel(postgres.NotificationChannel{
+		Kind:   "webhook",
+		Config: []byte(`{"url":"https://hooks.example.com/alerts","headers":{"Authorization":"Bearer secret"}}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Config.Headers != nil || c.Config.Url == nil {
+		t.Errorf("expected only the headers of a webhook channel to be redacted")
+	}
+
+	password := "secret"
+	current := rest.NotificationChannelConfig{
+		Password: &password,
+		Headers: &rest.NotificationChannelConfig_Headers{
+			AdditionalProperties: map[string]string{"Authorization": "Bearer secret"},
+		},
+	}
+	config := rest.NotificationChannelConfig{
+		Headers: &rest.NotificationChannelConfig_Headers{},
+	}
+	keepChannelSecrets(current, &config)
+	if config.Password == nil || *config.Password != password {
+		t.Errorf("expected the password to be kept")
+	}
+	if len(config.Headers.AdditionalProperties) != 0 {
+		t.Errorf("expected the headers to be replaced")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hexops/gotextdiff"
@@ -15,6 +16,7 @@ import (
 	"github.com/hexops/gotextdiff/span"
 
 	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
 	"github.com/self-host/self-host/postgres"
 )
 
@@ -456,3 +458,276 @@ func (s *ProgramService) DeleteProgramCodeRevision(ctx context.Context, id uuid.
 
 	return count, nil
 }
+
+func newRestProgramRun(r postgres.ProgramRun) *rest.ProgramRun {
+	v := &rest.ProgramRun{
+		Id:       r.ID,
+		Program:  r.ProgramUuid.String(),
+		Revision: int(r.Revision),
+		Worker:   r.Worker,
+		Trigger:  rest.ProgramRunTrigger(r.Trigger),
+		Started:  r.Started,
+		Outcome:  rest.ProgramRunOutcome(r.Outcome),
+		Error:    r.Error,
+		Output:   r.Output,
+	}
+
+	if r.Ended.Valid {
+		v.Ended = &r.Ended.Time
+	}
+	if r.Duration.Valid {
+		duration := int(r.Duration.Int32)
+		v.Duration = &duration
+	}
+	if r.JobID.Valid {
+		v.Job = &r.JobID.Int64
+	}
+
+	return v
+}
+
+type FindProgramRunsParams struct {
+	PaginationParams
+	Uuid    uuid.UUID
+	Outcome *rest.ProgramRunOutcome
+	Start   *time.Time
+	End     *time.Time
+}
+
+func (s *ProgramService) FindProgramRuns(ctx context.Context, p FindProgramRunsParams) ([]*rest.ProgramRun, error) {
+	runs := make([]*rest.ProgramRun, 0)
+
+	count, err := s.q.ExistsProgram(ctx, p.Uuid)
+	if err != nil {
+		return nil, err
+	} else if count == 0 {
+		return nil, ie.ErrorNotFound
+	}
+
+	params := postgres.FindProgramRunsParams{
+		ProgramUuid: p.Uuid,
+		ArgLimit:    p.Limit.Value,
+		ArgOffset:   p.Offset.Value,
+	}
+	if p.Outcome != nil {
+		params.Outcome = string(*p.Outcome)
+	}
+	if p.Start != nil {
+		params.StartTime = sql.NullTime{Time: *p.Start, Valid: true}
+	}
+	if p.End != nil {
+		params.EndTime = sql.NullTime{Time: *p.End, Valid: true}
+	}
+
+	list, err := s.q.FindProgramRuns(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range list {
+		runs = append(runs, newRestProgramRun(r))
+	}
+
+	return runs, nil
+}
+
+func (s *ProgramService) FindProgramRunById(ctx context.Context, id uuid.UUID, runID int64) (*rest.ProgramRun, error) {
+	r, err := s.q.FindProgramRunByID(ctx, postgres.FindProgramRunByIDParams{
+		ProgramUuid: id,
+		ID:          runID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestProgramRun(r), nil
+}
+
+func newRestProgramJob(j postgres.ProgramJob) *rest.ProgramJob {
+	v := &rest.ProgramJob{
+		Id:          j.ID,
+		Program:     j.ProgramUuid.String(),
+		Revision:    int(j.Revision),
+		Trigger:     rest.ProgramRunTrigger(j.Trigger),
+		Status:      rest.ProgramJobStatus(j.Status),
+		Attempts:    int(j.Attempts),
+		MaxAttempts: int(j.MaxAttempts),
+		RunAfter:    j.RunAfter,
+		Worker:      j.Worker,
+		LastError:   j.LastError,
+		Created:     j.Created,
+		Updated:     j.Updated,
+	}
+
+	if j.LeaseUntil.Valid {
+		v.LeaseUntil = &j.LeaseUntil.Time
+	}
+	if j.Scheduled.Valid {
+		v.Scheduled = &j.Scheduled.Time
+	}
+
+	return v
+}
+
+type FindProgramJobsParams struct {
+	PaginationParams
+	Status  *rest.ProgramJobStatus
+	Program *uuid.UUID
+}
+
+func (s *ProgramService) FindProgramJobs(ctx context.Context, p FindProgramJobsParams) ([]*rest.ProgramJob, error) {
+	jobs := make([]*rest.ProgramJob, 0)
+
+	params := postgres.FindProgramJobsParams{
+		ArgLimit:  p.Limit.Value,
+		ArgOffset: p.Offset.Value,
+	}
+	if p.Status != nil {
+		params.Status = string(*p.Status)
+	}
+	if p.Program != nil {
+		params.ProgramUuid = p.Program.String()
+	}
+
+	list, err := s.q.FindProgramJobs(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, j := range list {
+		jobs = append(jobs, newRestProgramJob(j))
+	}
+
+	return jobs, nil
+}
+
+func (s *ProgramService) FindProgramJobById(ctx context.Context, id int64) (*rest.ProgramJob, error) {
+	j, err := s.q.FindProgramJobByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestProgramJob(j), nil
+}
+
+// FindProgramJobRuns returns the runs of a job, one for each attempt.
+func (s *ProgramService) FindProgramJobRuns(ctx context.Context, id int64) ([]*rest.ProgramRun, error) {
+	runs := make([]*rest.ProgramRun, 0)
+
+	if _, err := s.q.FindProgramJobByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	list, err := s.q.FindProgramRunsByJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range list {
+		runs = append(runs, newRestProgramRun(r))
+	}
+
+	return runs, nil
+}
+
+// RequeueProgramJob queues a dead job again, with all of its attempts.
+func (s *ProgramService) RequeueProgramJob(ctx context.Context, id int64) (int64, error) {
+	return s.q.RequeueProgramJob(ctx, id)
+}
+
+// ProgramRunTask is a revision of a routine, ready to run.
+type ProgramRunTask struct {
+	Program  postgres.Program
+	Revision int32
+	Code     []byte
+	Signed   bool
+}
+
+// FindProgramRunTask returns the revision of a routine to run, or the latest
+// signed revision when none is given.
+func (s *ProgramService) FindProgramRunTask(ctx context.Context, id uuid.UUID, revision *int) (*ProgramRunTask, error) {
+	program, err := s.q.FindProgramByUUID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if program.Type != "routine" {
+		return nil, ie.NewBadRequestError(fmt.Errorf("only a routine can be run"))
+	}
+
+	t := &ProgramRunTask{
+		Program: program,
+	}
+
+	if revision == nil {
+		r, err := s.q.GetSignedProgramCodeAtHead(ctx, id)
+		if err == sql.ErrNoRows {
+			return nil, ie.NewBadRequestError(fmt.Errorf("the program has no signed revision"))
+		} else if err != nil {
+			return nil, err
+		}
+
+		t.Revision = r.Revision
+		t.Code = r.Code
+		t.Signed = true
+	} else {
+		r, err := s.q.GetProgramCodeRevision(ctx, postgres.GetProgramCodeRevisionParams{
+			ProgramUuid: id,
+			Revision:    int32(*revision),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		t.Revision = int32(*revision)
+		t.Code = r.Code
+		t.Signed = r.Signed
+	}
+
+	return t, nil
+}
+
+// QueueProgramRun queues a job to run the revision of the routine once, on
+// the next worker available, and returns the run of the job, which is
+// started when a worker is handed the job.
+func (s *ProgramService) QueueProgramRun(ctx context.Context, t *ProgramRunTask) (*rest.ProgramRun, error) {
+	if t.Program.State != "active" {
+		return nil, ie.NewBadRequestError(fmt.Errorf("the program is not active"))
+	}
+
+	// Use a transaction for this action
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	q := s.q.WithTx(tx)
+
+	jobID, err := q.CreateProgramJob(ctx, postgres.CreateProgramJobParams{
+		ProgramUuid: t.Program.Uuid,
+		Revision:    t.Revision,
+		Trigger:     "manual",
+		MaxAttempts: 1,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	runID, err := q.QueueProgramRun(ctx, postgres.QueueProgramRunParams{
+		ProgramUuid: t.Program.Uuid,
+		Revision:    t.Revision,
+		Trigger:     "manual",
+		JobID:       jobID,
+	})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.FindProgramRunById(ctx, t.Program.Uuid, runID)
+}
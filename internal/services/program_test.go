@@ -0,0 +1,152 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// addTestRoutine adds an active routine with a signed revision of its code.
+func addTestRoutine(t *testing.T, name string) uuid.UUID {
+	ctx := context.Background()
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+	svc := NewProgramService(db)
+
+	p, err := svc.AddProgram(ctx, AddProgramParams{
+		Name:      name,
+		Type:      "routine",
+		State:     "active",
+		Schedule:  "1h",
+		Deadline:  500,
+		Language:  "tengo",
+		CreatedBy: rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := uuid.MustParse(p.Uuid)
+
+	rev, err := svc.AddCodeRevision(ctx, AddCodeRevisionParams{
+		ProgramUuid: id,
+		CreatedBy:   rootUUID,
+		Code:        []byte(`fmt := import("fmt"); fmt.println("` + name + `")`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = svc.SignCodeRevision(ctx, SignCodeRevisionParams{
+		ProgramUuid: id,
+		Revision:    rev.Revision,
+		SignedBy:    rootUUID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return id
+}
+
+func TestProgramRunNow(t *testing.T) {
+	ctx := context.Background()
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+	id := addTestRoutine(t, "test/run")
+	svc := NewProgramService(db)
+
+	// A draft is run by its revision, and is reported as not signed
+	_, err := svc.AddCodeRevision(ctx, AddCodeRevisionParams{
+		ProgramUuid: id,
+		CreatedBy:   rootUUID,
+		Code:        []byte(`x := 2`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	draft := 1
+	task, err := svc.FindProgramRunTask(ctx, id, &draft)
+	if err != nil {
+		t.Fatal(err)
+	} else if task.Revision != 1 || task.Signed || string(task.Code) != `x := 2` {
+		t.Errorf("expected the draft revision, got %v %v %q", task.Revision, task.Signed, task.Code)
+	}
+
+	// Without a revision the latest signed revision is run
+	task, err = svc.FindProgramRunTask(ctx, id, nil)
+	if err != nil {
+		t.Fatal(err)
+	} else if task.Revision != 0 || task.Signed == false {
+		t.Errorf("expected the signed revision, got %v %v", task.Revision, task.Signed)
+	}
+
+	// A dry run leaves the queue alone
+	jobs, err := svc.FindProgramJobs(ctx, FindProgramJobsParams{
+		PaginationParams: PaginationParams{Limit: PaginationLimit{Value: 10}},
+		Program:          &id,
+	})
+	if err != nil {
+		t.Fatal(err)
+	} else if len(jobs) != 0 {
+		t.Fatalf("expected no job, got %v", len(jobs))
+	}
+
+	// Running now queues a single attempt, and its run
+	run, err := svc.QueueProgramRun(ctx, task)
+	if err != nil {
+		t.Fatal(err)
+	} else if run.Program != id.String() || run.Revision != 0 || run.Trigger != "manual" || run.Outcome != "queued" || run.Ended != nil || run.Job == nil {
+		t.Fatalf("unexpected run %+v", run)
+	}
+
+	job, err := svc.FindProgramJobById(ctx, *run.Job)
+	if err != nil {
+		t.Fatal(err)
+	} else if job.Status != "queued" || job.MaxAttempts != 1 {
+		t.Errorf("unexpected job %+v", job)
+	}
+
+	runs, err := svc.FindProgramJobRuns(ctx, job.Id)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(runs) != 1 || runs[0].Id != run.Id {
+		t.Errorf("expected the queued run of the job, got %v", len(runs))
+	}
+
+	found, err := svc.FindProgramRunById(ctx, id, run.Id)
+	if err != nil {
+		t.Fatal(err)
+	} else if found.Outcome != "queued" {
+		t.Errorf("expected the run to be queued, got %v", found.Outcome)
+	}
+
+	// Only an active routine is run
+	state := "inactive"
+	if _, err := svc.UpdateProgramByUuid(ctx, id, UpdateProgramByUuidParams{State: &state}); err != nil {
+		t.Fatal(err)
+	}
+	task, err = svc.FindProgramRunTask(ctx, id, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.QueueProgramRun(ctx, task); err == nil {
+		t.Error("expected an inactive routine to be refused")
+	}
+
+	programType := "module"
+	if _, err := svc.UpdateProgramByUuid(ctx, id, UpdateProgramByUuidParams{Type: &programType}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.FindProgramRunTask(ctx, id, nil); err == nil {
+		t.Error("expected a module to be refused")
+	}
+
+	if _, err := svc.DeleteProgram(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -5,12 +5,14 @@
 package services
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"os"
 	"testing"
 
+	"github.com/google/uuid"
 	_ "github.com/jackc/pgx/v4/stdlib"
 	"github.com/ory/dockertest/v3"
 
@@ -86,3 +88,42 @@ func TestMain(m *testing.M) {
 
 	os.Exit(code)
 }
+
+// addTestUser adds a user with a token, and returns the UUID of the user, the
+// UUID of the own group of the user and the secret of the token.
+func addTestUser(t *testing.T, name string) (uuid.UUID, uuid.UUID, []byte) {
+	u := NewUserService(db)
+
+	user, err := u.AddUser(context.Background(), name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userUUID := uuid.MustParse(user.Uuid)
+
+	var groupUUID uuid.UUID
+	for _, g := range user.Groups {
+		if g.Name == name {
+			groupUUID = uuid.MustParse(g.Uuid)
+		}
+	}
+
+	token, err := u.AddTokenToUser(context.Background(), userUUID, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return userUUID, groupUUID, []byte(token.Secret)
+}
+
+// addTestPolicy adds a policy to a group.
+func addTestPolicy(t *testing.T, group uuid.UUID, effect string, action string, resource string) {
+	_, err := NewPolicyService(db).Add(context.Background(), NewPolicyParams{
+		GroupUuid: group,
+		Effect:    effect,
+		Action:    action,
+		Resource:  resource,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
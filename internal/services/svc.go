@@ -6,7 +6,9 @@ package services
 
 import (
 	"database/sql"
+	"encoding/json"
 	"github.com/google/uuid"
+	"github.com/self-host/self-host/api/aapije/rest"
 	"math/rand"
 	"time"
 )
@@ -68,8 +70,9 @@ type PaginationParams struct {
 
 type FindByTagsParams struct {
 	PaginationParams
-	Token []byte
-	Tags  []string
+	Token      []byte
+	Tags       []string
+	Attributes []byte // JSON object the attributes must contain, if supported
 }
 
 type FindByUuidParams struct {
@@ -80,7 +83,8 @@ type FindByUuidParams struct {
 
 type FindAllParams struct {
 	PaginationParams
-	Token []byte
+	Token      []byte
+	Attributes []byte // JSON object the attributes must contain, if supported
 }
 
 func NewFindByTagsParams(token []byte, tags []string, limit *int64, offset *int64) FindByTagsParams {
@@ -130,6 +134,32 @@ func NewFindAllParams(token []byte, limit *int64, offset *int64) FindAllParams {
 	return p
 }
 
+// attributesOrEmpty returns the attributes, or an empty JSON object if none were given.
+func attributesOrEmpty(attributes []byte) json.RawMessage {
+	if len(attributes) == 0 {
+		return json.RawMessage("{}")
+	}
+	return attributes
+}
+
+// stringsOrEmpty returns the list, or an empty list instead of nil, as the DB
+// columns are NOT NULL.
+func stringsOrEmpty(list []string) []string {
+	if list == nil {
+		return make([]string, 0)
+	}
+	return list
+}
+
+// restAttributes converts attributes as stored in the DB to the REST type.
+func restAttributes(attributes json.RawMessage) rest.Attributes {
+	var a rest.Attributes
+	if len(attributes) > 0 {
+		json.Unmarshal(attributes, &a)
+	}
+	return a
+}
+
 func RandomString(strlen int) string {
 	const chars = "abcdefghijklmnopqrstuvwxyz0123456789"
 	result := make([]byte, strlen)
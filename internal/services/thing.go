@@ -7,16 +7,24 @@ package services
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
 	"github.com/self-host/self-host/postgres"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 // ThingService represents the repository used for interacting with Thing records.
 type ThingService struct {
-	q  *postgres.Queries
-	db *sql.DB
+	q        *postgres.Queries
+	db       *sql.DB
+	datasets *DatasetService
 }
 
 // NewThingService instantiates the ThingService repository.
@@ -26,11 +34,21 @@ func NewThingService(db *sql.DB) *ThingService {
 	}
 
 	return &ThingService{
-		q:  postgres.New(db),
-		db: db,
+		q:        postgres.New(db),
+		db:       db,
+		datasets: NewDatasetService(db),
 	}
 }
 
+// WithDatasets sets the service used to create the datasets of a template
+// applied to a new thing.
+func (svc *ThingService) WithDatasets(datasets *DatasetService) *ThingService {
+	if svc != nil && datasets != nil {
+		svc.datasets = datasets
+	}
+	return svc
+}
+
 func (svc *ThingService) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
 	found, err := svc.q.ExistsThing(ctx, id)
 	if err != nil {
@@ -41,10 +59,14 @@ func (svc *ThingService) Exists(ctx context.Context, id uuid.UUID) (bool, error)
 }
 
 type AddThingParams struct {
-	Name      string
-	Type      *string
-	CreatedBy *uuid.UUID
-	Tags      []string
+	Name       string
+	Type       *string
+	CreatedBy  *uuid.UUID
+	Tags       []string
+	Attributes []byte
+	Template   *uuid.UUID
+	Location   *rest.Location
+	Area       *rest.Polygon
 }
 
 func (svc *ThingService) AddThing(ctx context.Context, p *AddThingParams) (*rest.Thing, error) {
@@ -63,8 +85,9 @@ func (svc *ThingService) AddThing(ctx context.Context, p *AddThingParams) (*rest
 	}
 
 	params := postgres.CreateThingParams{
-		Name: p.Name,
-		Tags: tags,
+		Name:       p.Name,
+		Tags:       tags,
+		Attributes: attributesOrEmpty(p.Attributes),
 	}
 
 	if p.Type != nil {
@@ -75,22 +98,69 @@ func (svc *ThingService) AddThing(ctx context.Context, p *AddThingParams) (*rest
 		params.CreatedBy = *p.CreatedBy
 	}
 
+	if p.Location != nil {
+		params.Longitude.Scan(p.Location.Longitude)
+		params.Latitude.Scan(p.Location.Latitude)
+	}
+
+	if p.Area != nil {
+		params.Area, err = marshalArea(p.Area)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
 	q := svc.q.WithTx(tx)
 
+	var template *postgres.ThingTemplate
+	if p.Template != nil {
+		t, err := q.FindThingTemplateByUUID(ctx, *p.Template)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		if params.Type.Valid == false {
+			params.Type.Scan(t.ThingType)
+		} else if params.Type.String != t.ThingType {
+			tx.Rollback()
+			return nil, ie.NewBadRequestError(fmt.Errorf("thing type %s does not match the template type %s",
+				params.Type.String, t.ThingType))
+		}
+
+		template = &t
+	}
+
+	if err := validateAttributes(ctx, q, params.Type, params.Attributes); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
 	thing, err := q.CreateThing(ctx, params)
 	if err != nil {
 		tx.Rollback()
 		return nil, err
 	}
 
+	if template != nil {
+		if _, err := applyThingTemplate(ctx, q, svc.datasets, *template, thing.Uuid, params.CreatedBy); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
 	tx.Commit()
 
 	v := &rest.Thing{
-		Uuid:      thing.Uuid.String(),
-		Name:      thing.Name,
-		CreatedBy: thing.CreatedBy.String(),
-		State:     rest.ThingState(thing.State),
-		Tags:      thing.Tags,
+		Uuid:       thing.Uuid.String(),
+		Name:       thing.Name,
+		CreatedBy:  thing.CreatedBy.String(),
+		State:      rest.ThingState(thing.State),
+		Tags:       thing.Tags,
+		Attributes: restAttributes(thing.Attributes),
+		Location:   restLocation(thing.Longitude, thing.Latitude),
+		Area:       restArea(thing.Area),
 	}
 
 	if thing.Type.Valid {
@@ -107,11 +177,14 @@ func (svc *ThingService) FindThingByUuid(ctx context.Context, thingUUID uuid.UUI
 	}
 
 	thing := &rest.Thing{
-		Uuid:      t.Uuid.String(),
-		Name:      t.Name,
-		State:     rest.ThingState(t.State),
-		CreatedBy: t.CreatedBy.String(),
-		Tags:      t.Tags,
+		Uuid:       t.Uuid.String(),
+		Name:       t.Name,
+		State:      rest.ThingState(t.State),
+		CreatedBy:  t.CreatedBy.String(),
+		Tags:       t.Tags,
+		Attributes: restAttributes(t.Attributes),
+		Location:   restLocation(t.Longitude, t.Latitude),
+		Area:       restArea(t.Area),
 	}
 
 	if t.Type.Valid {
@@ -125,7 +198,8 @@ func (svc *ThingService) FindAll(ctx context.Context, p FindAllParams) ([]*rest.
 	things := make([]*rest.Thing, 0)
 
 	params := postgres.FindThingsParams{
-		Token: p.Token,
+		Token:      p.Token,
+		Attributes: attributesOrEmpty(p.Attributes),
 	}
 
 	if p.Limit.Value != 0 {
@@ -142,11 +216,14 @@ func (svc *ThingService) FindAll(ctx context.Context, p FindAllParams) ([]*rest.
 
 	for _, t := range thingList {
 		thing := &rest.Thing{
-			Uuid:      t.Uuid.String(),
-			Name:      t.Name,
-			State:     rest.ThingState(t.State),
-			CreatedBy: t.CreatedBy.String(),
-			Tags:      t.Tags,
+			Uuid:       t.Uuid.String(),
+			Name:       t.Name,
+			State:      rest.ThingState(t.State),
+			CreatedBy:  t.CreatedBy.String(),
+			Tags:       t.Tags,
+			Attributes: restAttributes(t.Attributes),
+			Location:   restLocation(t.Longitude, t.Latitude),
+			Area:       restArea(t.Area),
 		}
 		if t.Type.Valid {
 			thing.Type = &t.Type.String
@@ -162,8 +239,9 @@ func (svc *ThingService) FindByTags(ctx context.Context, p FindByTagsParams) ([]
 	things := make([]*rest.Thing, 0)
 
 	params := postgres.FindThingsByTagsParams{
-		Tags:  p.Tags,
-		Token: p.Token,
+		Tags:       p.Tags,
+		Token:      p.Token,
+		Attributes: attributesOrEmpty(p.Attributes),
 	}
 	if p.Limit.Value != 0 {
 		params.ArgLimit = p.Limit.Value
@@ -179,11 +257,114 @@ func (svc *ThingService) FindByTags(ctx context.Context, p FindByTagsParams) ([]
 
 	for _, t := range thingList {
 		thing := &rest.Thing{
-			Uuid:      t.Uuid.String(),
-			Name:      t.Name,
-			State:     rest.ThingState(t.State),
-			CreatedBy: t.CreatedBy.String(),
-			Tags:      t.Tags,
+			Uuid:       t.Uuid.String(),
+			Name:       t.Name,
+			State:      rest.ThingState(t.State),
+			CreatedBy:  t.CreatedBy.String(),
+			Tags:       t.Tags,
+			Attributes: restAttributes(t.Attributes),
+			Location:   restLocation(t.Longitude, t.Latitude),
+			Area:       restArea(t.Area),
+		}
+		if t.Type.Valid {
+			thing.Type = &t.Type.String
+		}
+
+		things = append(things, thing)
+	}
+
+	return things, nil
+}
+
+// GeoFilter limits a search to things located within a bounding box and,
+// when Radius is set, within Radius meters of Lon, Lat.
+type GeoFilter struct {
+	MinLon float64
+	MinLat float64
+	MaxLon float64
+	MaxLat float64
+	Lon    float64
+	Lat    float64
+	Radius float64
+}
+
+// NewGeoRadiusFilter returns a filter for things within radius meters of a
+// position. The bounding box around the circle lets the search use the index.
+// http://janmatuschek.de/LatitudeLongitudeBoundingCoordinates
+func NewGeoRadiusFilter(lon, lat, radius float64) GeoFilter {
+	const earthRadius = 6371008.8
+
+	f := GeoFilter{
+		MinLon: -180,
+		MaxLon: 180,
+		Lon:    lon,
+		Lat:    lat,
+		Radius: radius,
+	}
+
+	r := radius / earthRadius
+	latR := lat * math.Pi / 180
+	minLat := latR - r
+	maxLat := latR + r
+
+	if minLat > -math.Pi/2 && maxLat < math.Pi/2 {
+		dLon := math.Asin(math.Sin(r)/math.Cos(latR)) * 180 / math.Pi
+		if lon-dLon >= -180 && lon+dLon <= 180 {
+			f.MinLon = lon - dLon
+			f.MaxLon = lon + dLon
+		}
+	}
+
+	f.MinLat = math.Max(minLat*180/math.Pi, -90)
+	f.MaxLat = math.Min(maxLat*180/math.Pi, 90)
+
+	return f
+}
+
+// FindWithin finds things located within the area of the filter. Tags and
+// attributes in p narrow the search further when set.
+func (svc *ThingService) FindWithin(ctx context.Context, p FindByTagsParams, geo GeoFilter) ([]*rest.Thing, error) {
+	things := make([]*rest.Thing, 0)
+
+	tags := make([]string, 0)
+	if p.Tags != nil {
+		tags = p.Tags
+	}
+
+	params := postgres.FindThingsWithinParams{
+		Token:      p.Token,
+		MinLon:     geo.MinLon,
+		MinLat:     geo.MinLat,
+		MaxLon:     geo.MaxLon,
+		MaxLat:     geo.MaxLat,
+		Radius:     geo.Radius,
+		Lon:        geo.Lon,
+		Lat:        geo.Lat,
+		Tags:       tags,
+		Attributes: attributesOrEmpty(p.Attributes),
+	}
+	if p.Limit.Value != 0 {
+		params.ArgLimit = p.Limit.Value
+	}
+	if p.Offset.Value != 0 {
+		params.ArgOffset = p.Offset.Value
+	}
+
+	thingList, err := svc.q.FindThingsWithin(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range thingList {
+		thing := &rest.Thing{
+			Uuid:       t.Uuid.String(),
+			Name:       t.Name,
+			State:      rest.ThingState(t.State),
+			CreatedBy:  t.CreatedBy.String(),
+			Tags:       t.Tags,
+			Attributes: restAttributes(t.Attributes),
+			Location:   restLocation(t.Longitude, t.Latitude),
+			Area:       restArea(t.Area),
 		}
 		if t.Type.Valid {
 			thing.Type = &t.Type.String
@@ -195,12 +376,69 @@ func (svc *ThingService) FindByTags(ctx context.Context, p FindByTagsParams) ([]
 	return things, nil
 }
 
+func restLocation(lon, lat sql.NullFloat64) *rest.Location {
+	if lon.Valid == false || lat.Valid == false {
+		return nil
+	}
+	return &rest.Location{
+		Longitude: lon.Float64,
+		Latitude:  lat.Float64,
+	}
+}
+
+func restArea(area json.RawMessage) *rest.Polygon {
+	if len(area) == 0 {
+		return nil
+	}
+
+	var p rest.Polygon
+	if err := json.Unmarshal(area, &p); err != nil {
+		return nil
+	}
+	return &p
+}
+
+// marshalArea checks that the polygon is a valid GeoJSON Polygon in WGS 84
+// and returns it as JSON.
+func marshalArea(p *rest.Polygon) (json.RawMessage, error) {
+	if p.Type != rest.PolygonTypePolygon {
+		return nil, ie.NewBadRequestError(errors.New("area must be a GeoJSON Polygon"))
+	}
+	if len(p.Coordinates) == 0 {
+		return nil, ie.NewBadRequestError(errors.New("area must have an exterior ring"))
+	}
+
+	for _, ring := range p.Coordinates {
+		if len(ring) < 4 {
+			return nil, ie.NewBadRequestError(errors.New("a linear ring must have at least four positions"))
+		}
+
+		for _, pos := range ring {
+			if len(pos) < 2 || pos[0] < -180 || pos[0] > 180 || pos[1] < -90 || pos[1] > 90 {
+				return nil, ie.NewBadRequestError(errors.New("positions must be longitude, latitude in WGS 84"))
+			}
+		}
+
+		first, last := ring[0], ring[len(ring)-1]
+		if first[0] != last[0] || first[1] != last[1] {
+			return nil, ie.NewBadRequestError(errors.New("a linear ring must be closed"))
+		}
+	}
+
+	return json.Marshal(p)
+}
+
 type UpdateThingParams struct {
-	Uuid  uuid.UUID
-	Name  *string
-	Type  *string
-	State *string
-	Tags  *[]string
+	Uuid        uuid.UUID
+	Name        *string
+	Type        *string
+	State       *string
+	StateReason *string
+	Tags        *[]string
+	Attributes  []byte
+	Location    *rest.Location
+	Area        *rest.Polygon
+	ChangedBy   uuid.UUID
 }
 
 func (svc *ThingService) UpdateByUuid(ctx context.Context, p UpdateThingParams) (int64, error) {
@@ -243,12 +481,14 @@ func (svc *ThingService) UpdateByUuid(ctx context.Context, p UpdateThingParams)
 		count += c
 	}
 
+	// After the type, as the allowed transitions depend on it
 	if p.State != nil {
-		params := postgres.SetThingStateByUUIDParams{
-			Uuid:  p.Uuid,
-			State: postgres.ThingState(*p.State),
+		reason := ""
+		if p.StateReason != nil {
+			reason = *p.StateReason
 		}
-		c, err := q.SetThingStateByUUID(ctx, params)
+
+		c, err := changeThingState(ctx, q, p.Uuid, postgres.ThingState(*p.State), reason, p.ChangedBy)
 		if err != nil {
 			tx.Rollback()
 			return 0, err
@@ -269,6 +509,68 @@ func (svc *ThingService) UpdateByUuid(ctx context.Context, p UpdateThingParams)
 		count += c
 	}
 
+	if p.Attributes != nil {
+		params := postgres.SetThingAttributesByUUIDParams{
+			Uuid:       p.Uuid,
+			Attributes: p.Attributes,
+		}
+		c, err := q.SetThingAttributesByUUID(ctx, params)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		count += c
+	}
+
+	if p.Location != nil {
+		params := postgres.SetThingLocationByUUIDParams{
+			Uuid: p.Uuid,
+		}
+		params.Longitude.Scan(p.Location.Longitude)
+		params.Latitude.Scan(p.Location.Latitude)
+
+		c, err := q.SetThingLocationByUUID(ctx, params)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		count += c
+	}
+
+	if p.Area != nil {
+		area, err := marshalArea(p.Area)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+
+		params := postgres.SetThingAreaByUUIDParams{
+			Uuid: p.Uuid,
+			Area: area,
+		}
+		c, err := q.SetThingAreaByUUID(ctx, params)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		count += c
+	}
+
+	// A new type may come with a different schema, so check the result of
+	// the update as a whole.
+	if count > 0 && (p.Type != nil || p.Attributes != nil) {
+		t, err := q.FindThingByUUID(ctx, p.Uuid)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+
+		if err := validateAttributes(ctx, q, t.Type, t.Attributes); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
 	tx.Commit()
 
 	return count, nil
@@ -282,3 +584,304 @@ func (svc *ThingService) DeleteThing(ctx context.Context, thingUUID uuid.UUID) (
 
 	return count, nil
 }
+
+// validateAttributes checks attributes against the JSON Schema registered for
+// the thing type. Any attributes are accepted when there is no schema.
+func validateAttributes(ctx context.Context, q *postgres.Queries, thingType sql.NullString, attributes json.RawMessage) error {
+	if thingType.Valid == false {
+		return nil
+	}
+
+	s, err := q.GetThingTypeSchema(ctx, thingType.String)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	result, err := gojsonschema.Validate(
+		gojsonschema.NewBytesLoader(s.Schema),
+		gojsonschema.NewBytesLoader(attributes))
+	if err != nil {
+		return ie.NewBadRequestError(err)
+	}
+
+	if result.Valid() == false {
+		msgs := make([]string, 0)
+		for _, e := range result.Errors() {
+			msgs = append(msgs, e.String())
+		}
+		return ie.NewBadRequestError(fmt.Errorf("attributes do not match the schema for type %s: %s",
+			thingType.String, strings.Join(msgs, "; ")))
+	}
+
+	return nil
+}
+
+func (svc *ThingService) FindTypeSchemas(ctx context.Context) ([]*rest.ThingTypeSchema, error) {
+	schemas := make([]*rest.ThingTypeSchema, 0)
+
+	list, err := svc.q.FindThingTypeSchemas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range list {
+		v := &rest.ThingTypeSchema{
+			Type: s.Type,
+		}
+		if err := json.Unmarshal(s.Schema, &v.Schema); err != nil {
+			return nil, err
+		}
+
+		schemas = append(schemas, v)
+	}
+
+	return schemas, nil
+}
+
+// SetTypeSchema registers the JSON Schema for a thing type, replacing any
+// previous schema. Things already of the type are not validated again.
+func (svc *ThingService) SetTypeSchema(ctx context.Context, thingType string, schema []byte) (*rest.ThingTypeSchema, error) {
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schema)); err != nil {
+		return nil, ie.NewBadRequestError(err)
+	}
+
+	s, err := svc.q.SetThingTypeSchema(ctx, postgres.SetThingTypeSchemaParams{
+		Type:   thingType,
+		Schema: schema,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	v := &rest.ThingTypeSchema{
+		Type: s.Type,
+	}
+	if err := json.Unmarshal(s.Schema, &v.Schema); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func (svc *ThingService) DeleteTypeSchema(ctx context.Context, thingType string) (int64, error) {
+	count, err := svc.q.DeleteThingTypeSchema(ctx, thingType)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+type AddThingChildParams struct {
+	Parent          uuid.UUID
+	Child           uuid.UUID
+	InheritPolicies bool
+}
+
+// AddChild attaches a thing as a child of another thing. Attaching an
+// existing child again updates InheritPolicies.
+func (svc *ThingService) AddChild(ctx context.Context, p AddThingChildParams) (int64, error) {
+	count, err := svc.q.AddThingDep(ctx, postgres.AddThingDepParams{
+		Parent:          p.Parent,
+		Child:           p.Child,
+		InheritPolicies: p.InheritPolicies,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (svc *ThingService) RemoveChild(ctx context.Context, parent uuid.UUID, child uuid.UUID) (int64, error) {
+	count, err := svc.q.RemoveThingDep(ctx, postgres.RemoveThingDepParams{
+		Parent: parent,
+		Child:  child,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+type FindThingRelativesParams struct {
+	Uuid uuid.UUID
+	// MaxDepth of zero means no limit
+	MaxDepth int32
+	Token    []byte
+}
+
+func (svc *ThingService) FindAncestors(ctx context.Context, p FindThingRelativesParams) ([]*rest.ThingRelative, error) {
+	relatives := make([]*rest.ThingRelative, 0)
+
+	count, err := svc.q.ExistsThing(ctx, p.Uuid)
+	if err != nil {
+		return nil, err
+	} else if count == 0 {
+		return nil, ie.ErrorNotFound
+	}
+
+	params := postgres.FindThingAncestorsParams{
+		Token:    p.Token,
+		Uuid:     p.Uuid,
+		MaxDepth: p.MaxDepth,
+	}
+
+	thingList, err := svc.q.FindThingAncestors(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range thingList {
+		relative := &rest.ThingRelative{
+			Thing: rest.Thing{
+				Uuid:       t.Uuid.String(),
+				Name:       t.Name,
+				State:      rest.ThingState(t.State),
+				CreatedBy:  t.CreatedBy.String(),
+				Tags:       t.Tags,
+				Attributes: restAttributes(t.Attributes),
+				Location:   restLocation(t.Longitude, t.Latitude),
+				Area:       restArea(t.Area),
+			},
+			Via:             t.Via.String(),
+			Depth:           int(t.Depth),
+			InheritPolicies: t.InheritPolicies,
+		}
+		if t.Type.Valid {
+			relative.Thing.Type = &t.Type.String
+		}
+
+		relatives = append(relatives, relative)
+	}
+
+	return relatives, nil
+}
+
+func (svc *ThingService) FindDescendants(ctx context.Context, p FindThingRelativesParams) ([]*rest.ThingRelative, error) {
+	relatives := make([]*rest.ThingRelative, 0)
+
+	count, err := svc.q.ExistsThing(ctx, p.Uuid)
+	if err != nil {
+		return nil, err
+	} else if count == 0 {
+		return nil, ie.ErrorNotFound
+	}
+
+	params := postgres.FindThingDescendantsParams{
+		Token:    p.Token,
+		Uuid:     p.Uuid,
+		MaxDepth: p.MaxDepth,
+	}
+
+	thingList, err := svc.q.FindThingDescendants(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range thingList {
+		relative := &rest.ThingRelative{
+			Thing: rest.Thing{
+				Uuid:       t.Uuid.String(),
+				Name:       t.Name,
+				State:      rest.ThingState(t.State),
+				CreatedBy:  t.CreatedBy.String(),
+				Tags:       t.Tags,
+				Attributes: restAttributes(t.Attributes),
+				Location:   restLocation(t.Longitude, t.Latitude),
+				Area:       restArea(t.Area),
+			},
+			Via:             t.Via.String(),
+			Depth:           int(t.Depth),
+			InheritPolicies: t.InheritPolicies,
+		}
+		if t.Type.Valid {
+			relative.Thing.Type = &t.Type.String
+		}
+
+		relatives = append(relatives, relative)
+	}
+
+	return relatives, nil
+}
+
+// FindSubtree returns a thing and its descendants as a tree, including the
+// time series and datasets of each thing in the tree which the user of the
+// token may read.
+func (svc *ThingService) FindSubtree(ctx context.Context, p FindThingRelativesParams) (*rest.ThingTree, error) {
+	root, err := svc.FindThingByUuid(ctx, p.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	descendants, err := svc.FindDescendants(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	things := []*rest.Thing{root}
+	children := make(map[string][]string)
+	for _, d := range descendants {
+		thing := d.Thing
+		things = append(things, &thing)
+		children[d.Via] = append(children[d.Via], thing.Uuid)
+	}
+
+	thingUUIDs := make([]uuid.UUID, 0, len(things))
+	for _, thing := range things {
+		thingUUID, err := uuid.Parse(thing.Uuid)
+		if err != nil {
+			return nil, err
+		}
+		thingUUIDs = append(thingUUIDs, thingUUID)
+	}
+
+	// Only what the caller could fetch on its own is part of the tree
+	timeseries, err := NewTimeseriesService(svc.db).FindByThings(ctx, p.Token, thingUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	datasets, err := NewDatasetService(svc.db).FindByThings(ctx, p.Token, thingUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[string]*rest.ThingTree)
+	for i, thing := range things {
+		node := &rest.ThingTree{
+			Thing:      *thing,
+			Timeseries: make([]rest.Timeseries, 0),
+			Datasets:   make([]rest.Dataset, 0),
+			Children:   make([]rest.ThingTree, 0),
+		}
+
+		for _, t := range timeseries[thingUUIDs[i]] {
+			node.Timeseries = append(node.Timeseries, *t)
+		}
+
+		for _, d := range datasets[thingUUIDs[i]] {
+			node.Datasets = append(node.Datasets, *d)
+		}
+
+		nodes[thing.Uuid] = node
+	}
+
+	// Children are stored by value, so the tree is assembled from the leaves up.
+	// Things whose parent was filtered out by access control are left out.
+	var assemble func(id string) rest.ThingTree
+	assemble = func(id string) rest.ThingTree {
+		node := nodes[id]
+		for _, child := range children[id] {
+			node.Children = append(node.Children, assemble(child))
+		}
+		return *node
+	}
+
+	tree := assemble(root.Uuid)
+
+	return &tree, nil
+}
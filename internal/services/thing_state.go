@@ -0,0 +1,216 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+func validThingState(s rest.ThingState) bool {
+	switch s {
+	case rest.ThingStateActive, rest.ThingStateInactive, rest.ThingStatePassive, rest.ThingStateArchived:
+		return true
+	}
+	return false
+}
+
+// changeThingState moves the thing to a new state and records the change in
+// the state history. When the thing type has a list of allowed transitions,
+// only those are accepted. Setting the current state again is not a change.
+func changeThingState(ctx context.Context, q *postgres.Queries, thingUUID uuid.UUID, to postgres.ThingState, reason string, changedBy uuid.UUID) (int64, error) {
+	if validThingState(rest.ThingState(to)) == false {
+		return 0, ie.NewBadRequestError(fmt.Errorf("invalid state %s", to))
+	}
+
+	t, err := q.FindThingByUUID(ctx, thingUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	if t.State == to {
+		return 1, nil
+	}
+
+	if t.Type.Valid {
+		transitions, err := q.FindThingStateTransitions(ctx, t.Type.String)
+		if err != nil {
+			return 0, err
+		}
+
+		allowed := len(transitions) == 0
+		for _, tr := range transitions {
+			if tr.FromState == t.State && tr.ToState == to {
+				allowed = true
+				break
+			}
+		}
+
+		if allowed == false {
+			return 0, ie.NewBadRequestError(fmt.Errorf("a thing of type %s can not change state from %s to %s", t.Type.String, t.State, to))
+		}
+	}
+
+	count, err := q.SetThingStateByUUID(ctx, postgres.SetThingStateByUUIDParams{
+		Uuid:  thingUUID,
+		State: to,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = q.CreateThingStateChange(ctx, postgres.CreateThingStateChangeParams{
+		ThingUuid: thingUUID,
+		FromState: t.State,
+		ToState:   to,
+		Reason:    reason,
+		ChangedBy: changedBy,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (svc *ThingService) FindStateHistory(ctx context.Context, p FindByUuidParams) ([]*rest.ThingStateChange, error) {
+	changes := make([]*rest.ThingStateChange, 0)
+
+	count, err := svc.q.ExistsThing(ctx, p.Uuid)
+	if err != nil {
+		return nil, err
+	} else if count == 0 {
+		return nil, ie.ErrorNotFound
+	}
+
+	list, err := svc.q.FindThingStateHistory(ctx, postgres.FindThingStateHistoryParams{
+		ThingUuid: p.Uuid,
+		ArgLimit:  p.Limit.Value,
+		ArgOffset: p.Offset.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range list {
+		changes = append(changes, &rest.ThingStateChange{
+			From:      rest.ThingState(c.FromState),
+			To:        rest.ThingState(c.ToState),
+			Reason:    c.Reason,
+			Changed:   c.Changed,
+			ChangedBy: c.ChangedBy.String(),
+		})
+	}
+
+	return changes, nil
+}
+
+func (svc *ThingService) FindStateTransitions(ctx context.Context) ([]*rest.ThingStateTransitions, error) {
+	result := make([]*rest.ThingStateTransitions, 0)
+
+	list, err := svc.q.FindAllThingStateTransitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var current *rest.ThingStateTransitions
+	for _, tr := range list {
+		if current == nil || current.Type != tr.ThingType {
+			current = &rest.ThingStateTransitions{
+				Type:        tr.ThingType,
+				Transitions: make([]rest.ThingStateTransition, 0),
+			}
+			result = append(result, current)
+		}
+
+		current.Transitions = append(current.Transitions, rest.ThingStateTransition{
+			From: rest.ThingState(tr.FromState),
+			To:   rest.ThingState(tr.ToState),
+		})
+	}
+
+	return result, nil
+}
+
+// SetStateTransitions replaces the allowed state transitions for a thing type.
+func (svc *ThingService) SetStateTransitions(ctx context.Context, thingType string, transitions []rest.ThingStateTransition) (*rest.ThingStateTransitions, error) {
+	if thingType == "" {
+		return nil, ie.NewBadRequestError(fmt.Errorf("a type is required"))
+	}
+
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	_, err = q.DeleteThingStateTransitions(ctx, thingType)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	seen := make(map[rest.ThingStateTransition]bool)
+	for _, tr := range transitions {
+		if validThingState(tr.From) == false || validThingState(tr.To) == false {
+			tx.Rollback()
+			return nil, ie.NewBadRequestError(fmt.Errorf("invalid transition from %s to %s", tr.From, tr.To))
+		} else if tr.From == tr.To {
+			tx.Rollback()
+			return nil, ie.NewBadRequestError(fmt.Errorf("a transition must change the state, got %s to %s", tr.From, tr.To))
+		} else if seen[tr] {
+			continue
+		}
+		seen[tr] = true
+
+		err := q.CreateThingStateTransition(ctx, postgres.CreateThingStateTransitionParams{
+			ThingType: thingType,
+			FromState: postgres.ThingState(tr.From),
+			ToState:   postgres.ThingState(tr.To),
+		})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	list, err := q.FindThingStateTransitions(ctx, thingType)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	tx.Commit()
+
+	result := &rest.ThingStateTransitions{
+		Type:        thingType,
+		Transitions: make([]rest.ThingStateTransition, 0),
+	}
+	for _, tr := range list {
+		result.Transitions = append(result.Transitions, rest.ThingStateTransition{
+			From: rest.ThingState(tr.FromState),
+			To:   rest.ThingState(tr.ToState),
+		})
+	}
+
+	return result, nil
+}
+
+func (svc *ThingService) DeleteStateTransitions(ctx context.Context, thingType string) (int64, error) {
+	count, err := svc.q.DeleteThingStateTransitions(ctx, thingType)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
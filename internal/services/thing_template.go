@@ -0,0 +1,439 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/self-host/self-host/api/aapije/rest"
+	"github.com/self-host/self-host/postgres"
+)
+
+// ThingTemplateService represents the repository used for interacting with Thing template records.
+type ThingTemplateService struct {
+	q        *postgres.Queries
+	db       *sql.DB
+	datasets *DatasetService
+}
+
+// NewThingTemplateService instantiates the ThingTemplateService repository.
+func NewThingTemplateService(db *sql.DB) *ThingTemplateService {
+	if db == nil {
+		return nil
+	}
+
+	return &ThingTemplateService{
+		q:        postgres.New(db),
+		db:       db,
+		datasets: NewDatasetService(db),
+	}
+}
+
+// WithDatasets sets the service used to create the datasets of the template,
+// which decides where their content is stored.
+func (svc *ThingTemplateService) WithDatasets(datasets *DatasetService) *ThingTemplateService {
+	if svc != nil && datasets != nil {
+		svc.datasets = datasets
+	}
+	return svc
+}
+
+func newRestThingTemplate(t postgres.ThingTemplate) (*rest.ThingTemplate, error) {
+	v := &rest.ThingTemplate{
+		Uuid:       t.Uuid.String(),
+		Name:       t.Name,
+		Type:       t.ThingType,
+		CreatedBy:  t.CreatedBy.String(),
+		Timeseries: make([]rest.ThingTemplateTimeseries, 0),
+		Datasets:   make([]rest.ThingTemplateDataset, 0),
+	}
+
+	if err := json.Unmarshal(t.Timeseries, &v.Timeseries); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(t.Datasets, &v.Datasets); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// validateTemplateDatasets ensures the content of the datasets of a template
+// can be used to create datasets when the template is applied.
+func validateTemplateDatasets(datasets []rest.ThingTemplateDataset) error {
+	for _, td := range datasets {
+		if td.Content == nil {
+			continue
+		}
+		if err := validateDatasetFormat(string(td.Format), *td.Content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type AddThingTemplateParams struct {
+	Name       string
+	Type       string
+	Timeseries []rest.ThingTemplateTimeseries
+	Datasets   []rest.ThingTemplateDataset
+	CreatedBy  uuid.UUID
+}
+
+func (svc *ThingTemplateService) AddTemplate(ctx context.Context, p *AddThingTemplateParams) (*rest.ThingTemplate, error) {
+	if p.Timeseries == nil {
+		p.Timeseries = make([]rest.ThingTemplateTimeseries, 0)
+	}
+	if p.Datasets == nil {
+		p.Datasets = make([]rest.ThingTemplateDataset, 0)
+	}
+	if err := validateTemplateDatasets(p.Datasets); err != nil {
+		return nil, err
+	}
+
+	timeseries, err := json.Marshal(p.Timeseries)
+	if err != nil {
+		return nil, err
+	}
+	datasets, err := json.Marshal(p.Datasets)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := svc.q.CreateThingTemplate(ctx, postgres.CreateThingTemplateParams{
+		Name:       p.Name,
+		ThingType:  p.Type,
+		Timeseries: timeseries,
+		Datasets:   datasets,
+		CreatedBy:  p.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestThingTemplate(postgres.ThingTemplate(t))
+}
+
+func (svc *ThingTemplateService) FindAll(ctx context.Context, p FindAllParams) ([]*rest.ThingTemplate, error) {
+	templates := make([]*rest.ThingTemplate, 0)
+
+	params := postgres.FindThingTemplatesParams{}
+	if p.Limit.Value != 0 {
+		params.ArgLimit = p.Limit.Value
+	}
+	if p.Offset.Value != 0 {
+		params.ArgOffset = p.Offset.Value
+	}
+
+	list, err := svc.q.FindThingTemplates(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range list {
+		v, err := newRestThingTemplate(t)
+		if err != nil {
+			return nil, err
+		}
+
+		templates = append(templates, v)
+	}
+
+	return templates, nil
+}
+
+func (svc *ThingTemplateService) FindByUuid(ctx context.Context, id uuid.UUID) (*rest.ThingTemplate, error) {
+	t, err := svc.q.FindThingTemplateByUUID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestThingTemplate(t)
+}
+
+type UpdateThingTemplateParams struct {
+	Uuid       uuid.UUID
+	Name       *string
+	Type       *string
+	Timeseries *[]rest.ThingTemplateTimeseries
+	Datasets   *[]rest.ThingTemplateDataset
+}
+
+func (svc *ThingTemplateService) UpdateByUuid(ctx context.Context, p UpdateThingTemplateParams) (int64, error) {
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	t, err := q.FindThingTemplateByUUID(ctx, p.Uuid)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	params := postgres.UpdateThingTemplateParams{
+		Uuid:       t.Uuid,
+		Name:       t.Name,
+		ThingType:  t.ThingType,
+		Timeseries: t.Timeseries,
+		Datasets:   t.Datasets,
+	}
+
+	if p.Name != nil {
+		params.Name = *p.Name
+	}
+	if p.Type != nil {
+		params.ThingType = *p.Type
+	}
+	if p.Timeseries != nil {
+		params.Timeseries, err = json.Marshal(p.Timeseries)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+	if p.Datasets != nil {
+		if err := validateTemplateDatasets(*p.Datasets); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		params.Datasets, err = json.Marshal(p.Datasets)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
+	count, err := q.UpdateThingTemplate(ctx, params)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	tx.Commit()
+
+	return count, nil
+}
+
+func (svc *ThingTemplateService) DeleteTemplate(ctx context.Context, id uuid.UUID) (int64, error) {
+	count, err := svc.q.DeleteThingTemplate(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+type ApplyThingTemplateParams struct {
+	Uuid      uuid.UUID
+	AppliedBy uuid.UUID
+	Token     []byte
+}
+
+// Apply applies the template to every thing of the template type, in a
+// single transaction. Things the token is not allowed to update are skipped.
+// Created time series and datasets are owned by AppliedBy.
+func (svc *ThingTemplateService) Apply(ctx context.Context, p ApplyThingTemplateParams) (*rest.ThingTemplateApplyResult, error) {
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	t, err := q.FindThingTemplateByUUID(ctx, p.Uuid)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	things, err := q.FindThingsByType(ctx, sql.NullString{String: t.ThingType, Valid: true})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	result := &rest.ThingTemplateApplyResult{
+		Skipped: make([]string, 0),
+	}
+	for _, thing := range things {
+		access, err := q.CheckUserTokenHasAccess(ctx, postgres.CheckUserTokenHasAccessParams{
+			Action:   postgres.PolicyAction("update"),
+			Resource: "things/" + thing.Uuid.String(),
+			Token:    p.Token,
+		})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		} else if access == false {
+			result.Skipped = append(result.Skipped, thing.Uuid.String())
+			continue
+		}
+
+		r, err := applyThingTemplate(ctx, q, svc.datasets, t, thing.Uuid, p.AppliedBy)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		result.Things++
+		result.TimeseriesCreated += r.TimeseriesCreated
+		result.TimeseriesUpdated += r.TimeseriesUpdated
+		result.DatasetsCreated += r.DatasetsCreated
+	}
+
+	tx.Commit()
+
+	return result, nil
+}
+
+// applyThingTemplate creates the time series and datasets of the template
+// missing from the thing, matched by name. Existing time series get the
+// bounds and tags of the template. Units and dataset content are left as is.
+// Datasets are created by the dataset service, within the transaction of q.
+func applyThingTemplate(ctx context.Context, q *postgres.Queries, datasets *DatasetService, t postgres.ThingTemplate, thing uuid.UUID, createdBy uuid.UUID) (*rest.ThingTemplateApplyResult, error) {
+	var timeseries []rest.ThingTemplateTimeseries
+	if err := json.Unmarshal(t.Timeseries, &timeseries); err != nil {
+		return nil, err
+	}
+
+	var templateDatasets []rest.ThingTemplateDataset
+	if err := json.Unmarshal(t.Datasets, &templateDatasets); err != nil {
+		return nil, err
+	}
+
+	result := &rest.ThingTemplateApplyResult{}
+
+	tsList, err := q.FindTimeseriesByThing(ctx, thing)
+	if err != nil {
+		return nil, err
+	}
+
+	existingTs := make(map[string]postgres.Timeseries)
+	for _, ts := range tsList {
+		existingTs[ts.Name] = ts
+	}
+
+	for _, tt := range timeseries {
+		var lb, ub sql.NullFloat64
+		if tt.LowerBound != nil {
+			lb.Scan(*tt.LowerBound)
+		}
+		if tt.UpperBound != nil {
+			ub.Scan(*tt.UpperBound)
+		}
+
+		tags := make([]string, 0)
+		if tt.Tags != nil {
+			tags = append(tags, *tt.Tags...)
+		}
+
+		ts, ok := existingTs[tt.Name]
+		if ok == false {
+			_, err := q.CreateTimeseries(ctx, postgres.CreateTimeseriesParams{
+				ThingUuid:  thing,
+				Name:       tt.Name,
+				SiUnit:     tt.SiUnit,
+				LowerBound: lb,
+				UpperBound: ub,
+				CreatedBy:  createdBy,
+				Tags:       tags,
+				Attributes: attributesOrEmpty(nil),
+			})
+			if err != nil {
+				return nil, err
+			}
+			result.TimeseriesCreated++
+			continue
+		}
+
+		updated := false
+		if ts.LowerBound != lb {
+			if _, err := q.SetTimeseriesLowerBound(ctx, postgres.SetTimeseriesLowerBoundParams{
+				Uuid:       ts.Uuid,
+				LowerBound: lb,
+			}); err != nil {
+				return nil, err
+			}
+			updated = true
+		}
+		if ts.UpperBound != ub {
+			if _, err := q.SetTimeseriesUpperBound(ctx, postgres.SetTimeseriesUpperBoundParams{
+				Uuid:       ts.Uuid,
+				UpperBound: ub,
+			}); err != nil {
+				return nil, err
+			}
+			updated = true
+		}
+		if equalTags(ts.Tags, tags) == false {
+			if _, err := q.SetTimeseriesTags(ctx, postgres.SetTimeseriesTagsParams{
+				Uuid: ts.Uuid,
+				Tags: tags,
+			}); err != nil {
+				return nil, err
+			}
+			updated = true
+		}
+		if updated {
+			result.TimeseriesUpdated++
+		}
+	}
+
+	dsList, err := q.FindDatasetByThing(ctx, thing)
+	if err != nil {
+		return nil, err
+	}
+
+	existingDs := make(map[string]bool)
+	for _, ds := range dsList {
+		existingDs[ds.Name] = true
+	}
+
+	for _, td := range templateDatasets {
+		if existingDs[td.Name] {
+			continue
+		}
+
+		params := &AddDatasetParams{
+			Name:      td.Name,
+			Format:    string(td.Format),
+			CreatedBy: createdBy,
+			ThingUuid: thing,
+		}
+		if td.Content != nil {
+			params.Content = *td.Content
+		}
+		if td.Tags != nil {
+			params.Tags = *td.Tags
+		}
+
+		if _, err := datasets.addDataset(ctx, q, params); err != nil {
+			return nil, err
+		}
+		result.DatasetsCreated++
+	}
+
+	return result, nil
+}
+
+func equalTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,103 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	"github.com/self-host/self-host/pkg/blobstore"
+)
+
+func TestThingTemplateApply(t *testing.T) {
+	ctx := context.Background()
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+
+	thingType := "test/template"
+	thing, err := NewThingService(db).AddThing(ctx, &AddThingParams{
+		Name:      "Template target",
+		Type:      &thingType,
+		CreatedBy: &rootUUID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content := []byte(`{"interval": 60}`)
+	templates := NewThingTemplateService(db)
+
+	invalid := []byte(`{"interval":`)
+	_, err = templates.AddTemplate(ctx, &AddThingTemplateParams{
+		Name:      "Invalid",
+		Type:      thingType,
+		Datasets:  []rest.ThingTemplateDataset{{Name: "config", Format: "json", Content: &invalid}},
+		CreatedBy: rootUUID,
+	})
+	if err == nil {
+		t.Error("expected invalid dataset content to be refused")
+	}
+
+	template, err := templates.AddTemplate(ctx, &AddThingTemplateParams{
+		Name:      "Template test",
+		Type:      thingType,
+		Datasets:  []rest.ThingTemplateDataset{{Name: "config", Format: "json", Content: &content}},
+		CreatedBy: rootUUID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A user without access to the thing is skipped
+	dave, _, token := addTestUser(t, "dave")
+
+	result, err := templates.Apply(ctx, ApplyThingTemplateParams{
+		Uuid:      uuid.MustParse(template.Uuid),
+		AppliedBy: dave,
+		Token:     token,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Things != 0 || len(result.Skipped) != 1 || result.Skipped[0] != thing.Uuid {
+		t.Fatalf("expected the thing to be skipped, got %+v", result)
+	}
+
+	// Datasets are created through the dataset service of the template service
+	store, err := blobstore.NewFilesystem(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	datasets := NewDatasetService(db).WithStore(store).WithCompression(blobstore.CompressionGzip)
+
+	result, err = templates.WithDatasets(datasets).Apply(ctx, ApplyThingTemplateParams{
+		Uuid:      uuid.MustParse(template.Uuid),
+		AppliedBy: rootUUID,
+		Token:     []byte(rootToken),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Things != 1 || result.DatasetsCreated != 1 || len(result.Skipped) != 0 {
+		t.Fatalf("expected the template to be applied, got %+v", result)
+	}
+
+	list, err := datasets.FindByThing(ctx, uuid.MustParse(thing.Uuid))
+	if err != nil {
+		t.Fatal(err)
+	} else if len(list) != 1 {
+		t.Fatalf("expected one dataset, got %v", len(list))
+	}
+
+	f, err := datasets.GetDatasetContentByUuid(ctx, uuid.MustParse(list[0].Uuid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(f.Content) != string(content) {
+		t.Errorf("unexpected content %q", f.Content)
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+)
+
+func TestNewGeoRadiusFilter(t *testing.T) {
+	// Stockholm, 1 km. One degree of latitude is roughly 111 km.
+	f := NewGeoRadiusFilter(18.0686, 59.3293, 1000)
+	if math.Abs((f.MaxLat-f.MinLat)/2-0.009) > 0.0005 {
+		t.Errorf("unexpected latitude span %v - %v", f.MinLat, f.MaxLat)
+	}
+	// Meridians converge, so the longitude span is wider than the latitude span
+	if f.MaxLon-f.MinLon <= f.MaxLat-f.MinLat {
+		t.Errorf("unexpected longitude span %v - %v", f.MinLon, f.MaxLon)
+	}
+	if f.MinLon > 18.0686 || f.MaxLon < 18.0686 || f.Radius != 1000 {
+		t.Error("the position must be within the bounding box")
+	}
+
+	// Close to a pole every longitude is within the radius
+	f = NewGeoRadiusFilter(18, 89.999, 1000)
+	if f.MinLon != -180 || f.MaxLon != 180 || f.MaxLat != 90 {
+		t.Errorf("expected the full longitude range close to the pole, got %+v", f)
+	}
+
+	// Crossing the anti-meridian
+	f = NewGeoRadiusFilter(179.999, 0, 1000)
+	if f.MinLon != -180 || f.MaxLon != 180 {
+		t.Errorf("expected the full longitude range when crossing the anti-meridian, got %+v", f)
+	}
+}
+
+func TestThingPolicyInheritance(t *testing.T) {
+	ctx := context.Background()
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+	svc := NewThingService(db)
+
+	add := func(name string) uuid.UUID {
+		thing, err := svc.AddThing(ctx, &AddThingParams{
+			Name:      name,
+			CreatedBy: &rootUUID,
+			Tags:      []string{"inheritance"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return uuid.MustParse(thing.Uuid)
+	}
+
+	site := add("Site")
+	building := add("Building")
+	meter := add("Meter")
+
+	_, group, token := addTestUser(t, "erin")
+	addTestPolicy(t, group, "allow", "read", "things/"+site.String())
+
+	attach := func(parent, child uuid.UUID, inherit bool) {
+		_, err := svc.AddChild(ctx, AddThingChildParams{Parent: parent, Child: child, InheritPolicies: inherit})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	limit := int64(100)
+	visible := func() int {
+		all, err := svc.FindAll(ctx, NewFindAllParams(token, &limit, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tagged, err := svc.FindByTags(ctx, NewFindByTagsParams(token, []string{"inheritance"}, &limit, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(all) != len(tagged) {
+			t.Fatalf("listing found %v things, search by tags %v", len(all), len(tagged))
+		}
+		return len(all)
+	}
+
+	hasAccess := func(id uuid.UUID) bool {
+		access, err := NewPolicyCheckService(db).UserHasAccessViaToken(ctx, token, "read", "things/"+id.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return access
+	}
+
+	// Without inheritance only the site is accessible
+	attach(site, building, false)
+	attach(building, meter, true)
+	if hasAccess(building) || hasAccess(meter) {
+		t.Error("expected no access to children which do not inherit")
+	}
+	if n := visible(); n != 1 {
+		t.Errorf("expected one visible thing, got %v", n)
+	}
+
+	// Inheritance goes through every inheriting ancestor
+	attach(site, building, true)
+	if hasAccess(building) == false || hasAccess(meter) == false {
+		t.Error("expected access to inheriting descendants")
+	}
+	if n := visible(); n != 3 {
+		t.Errorf("expected three visible things, got %v", n)
+	}
+
+	// A deny on the parent also applies to its inheriting children
+	addTestPolicy(t, group, "deny", "read", "things/"+building.String())
+	if hasAccess(site) == false || hasAccess(building) || hasAccess(meter) {
+		t.Error("expected the deny to apply to the building and the meter")
+	}
+	if n := visible(); n != 1 {
+		t.Errorf("expected one visible thing, got %v", n)
+	}
+}
+
+func TestThingSubtreeAccess(t *testing.T) {
+	ctx := context.Background()
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+	svc := NewThingService(db)
+
+	add := func(name string) uuid.UUID {
+		thing, err := svc.AddThing(ctx, &AddThingParams{
+			Name:      name,
+			CreatedBy: &rootUUID,
+			Tags:      []string{},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return uuid.MustParse(thing.Uuid)
+	}
+
+	site := add("Subtree Site")
+	meter := add("Subtree Meter")
+	_, err := svc.AddChild(ctx, AddThingChildParams{Parent: site, Child: meter, InheritPolicies: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ts, err := NewTimeseriesService(db).AddTimeseries(ctx, &NewTimeseriesParams{
+		Name:      "Subtree Power",
+		CreatedBy: rootUUID,
+		ThingUuid: meter,
+		Tags:      []string{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ds, err := NewDatasetService(db).AddDataset(ctx, &AddDatasetParams{
+		Name:      "Subtree Manual",
+		Format:    "misc",
+		Content:   []byte("manual"),
+		CreatedBy: rootUUID,
+		ThingUuid: meter,
+		Tags:      []string{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, group, token := addTestUser(t, "frank")
+	addTestPolicy(t, group, "allow", "read", "things/"+site.String())
+
+	subtree := func() (int, int) {
+		tree, err := svc.FindSubtree(ctx, FindThingRelativesParams{Uuid: site, Token: token})
+		if err != nil {
+			t.Fatal(err)
+		} else if len(tree.Children) != 1 {
+			t.Fatalf("expected the meter in the tree, got %v children", len(tree.Children))
+		}
+		return len(tree.Children[0].Timeseries), len(tree.Children[0].Datasets)
+	}
+
+	// Reading the things is not reading what belongs to them
+	if nTs, nDs := subtree(); nTs != 0 || nDs != 0 {
+		t.Errorf("expected no time series or datasets, got %v and %v", nTs, nDs)
+	}
+
+	addTestPolicy(t, group, "allow", "read", "timeseries/"+ts.Uuid)
+	addTestPolicy(t, group, "allow", "read", "datasets/"+ds.Uuid)
+	if nTs, nDs := subtree(); nTs != 1 || nDs != 0 {
+		t.Errorf("expected the time series only, got %v and %v", nTs, nDs)
+	}
+
+	// The datasets of a thing are listed by those who may list them
+	addTestPolicy(t, group, "allow", "read", "things/"+site.String()+"/datasets")
+	if nTs, nDs := subtree(); nTs != 1 || nDs != 1 {
+		t.Errorf("expected the time series and the dataset, got %v and %v", nTs, nDs)
+	}
+}
+
+func TestThingStateLifecycle(t *testing.T) {
+	ctx := context.Background()
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+	svc := NewThingService(db)
+
+	thingType := "test/lifecycle"
+	_, err := svc.SetStateTransitions(ctx, thingType, []rest.ThingStateTransition{
+		{From: rest.ThingStateInactive, To: rest.ThingStateActive},
+		{From: rest.ThingStateActive, To: rest.ThingStateArchived},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	thing, err := svc.AddThing(ctx, &AddThingParams{
+		Name:      "Meter",
+		Type:      &thingType,
+		CreatedBy: &rootUUID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	thingUUID := uuid.MustParse(thing.Uuid)
+
+	tsSvc := NewTimeseriesService(db)
+	timeseries, err := tsSvc.AddTimeseries(ctx, &NewTimeseriesParams{
+		Name:      "Energy",
+		ThingUuid: thingUUID,
+		CreatedBy: rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	setState := func(state rest.ThingState, reason string) error {
+		s := string(state)
+		_, err := svc.UpdateByUuid(ctx, UpdateThingParams{
+			Uuid:        thingUUID,
+			State:       &s,
+			StateReason: &reason,
+			ChangedBy:   rootUUID,
+		})
+		return err
+	}
+
+	ingest := func() error {
+		_, err := tsSvc.AddDataToTimeseries(ctx, AddDataToTimeseriesParams{
+			Uuid:      uuid.MustParse(timeseries.Uuid),
+			Points:    []DataPoint{{Value: 1, Timestamp: time.Now().Truncate(time.Second)}},
+			CreatedBy: rootUUID,
+			Token:     []byte(rootToken),
+		})
+		return err
+	}
+
+	if err := setState(rest.ThingStateArchived, "skipped"); err == nil {
+		t.Error("expected a transition which is not allowed to be refused")
+	}
+	if err := setState(rest.ThingStateActive, "commissioned"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ingest(); err != nil {
+		t.Fatal(err)
+	}
+	if err := setState(rest.ThingStateArchived, "decommissioned"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ingest(); errors.Is(err, ie.ErrorThingArchived) == false {
+		t.Errorf("expected the time series of an archived thing to be read-only, got %v", err)
+	}
+
+	limit := int64(10)
+	history, err := svc.FindStateHistory(ctx, NewFindByUuidParams([]byte(rootToken), thingUUID, &limit, nil))
+	if err != nil {
+		t.Fatal(err)
+	} else if len(history) != 2 {
+		t.Fatalf("expected two state changes, got %v", len(history))
+	}
+
+	if history[0].From != rest.ThingStateActive || history[0].To != rest.ThingStateArchived || history[0].Reason != "decommissioned" {
+		t.Errorf("unexpected latest change %+v", history[0])
+	}
+	if history[1].From != rest.ThingStateInactive || history[1].To != rest.ThingStateActive || history[1].ChangedBy != rootUUID.String() {
+		t.Errorf("unexpected first change %+v", history[1])
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -26,6 +27,20 @@ FROM
 json_to_recordset($3::json) AS x("v" double precision, "ts" timestamptz);
 `
 
+// overwriteDataInTimeseries overwrites the value of existing data points,
+// which the audit trigger records in the history. Points whose value does not
+// change are left alone and not counted.
+const overwriteDataInTimeseries = `
+INSERT INTO tsdata(ts_uuid, value, ts, created_by)
+SELECT $1::uuid, x.v, x.ts, $2::uuid
+FROM
+json_to_recordset($3::json) AS x("v" double precision, "ts" timestamptz)
+ON CONFLICT (ts_uuid, ts) DO UPDATE
+SET value = EXCLUDED.value,
+	created_by = EXCLUDED.created_by
+WHERE tsdata.value IS DISTINCT FROM EXCLUDED.value;
+`
+
 // NewTimeseries defines model for NewTimeseries.
 type NewTimeseriesParams struct {
 	CreatedBy  uuid.UUID
@@ -35,6 +50,7 @@ type NewTimeseriesParams struct {
 	Tags       []string
 	LowerBound sql.NullFloat64
 	UpperBound sql.NullFloat64
+	Attributes []byte
 }
 
 func inValidRange(v float32, leLimit, geLimit *float32) bool {
@@ -121,6 +137,7 @@ func (svc *TimeseriesService) AddTimeseries(ctx context.Context, opt *NewTimeser
 		LowerBound: opt.LowerBound,
 		UpperBound: opt.UpperBound,
 		Tags:       tags,
+		Attributes: attributesOrEmpty(opt.Attributes),
 	}
 
 	timeseries, err := q.CreateTimeseries(ctx, params)
@@ -150,6 +167,7 @@ func (svc *TimeseriesService) AddTimeseries(ctx context.Context, opt *NewTimeser
 		LowerBound: lb,
 		UpperBound: ub,
 		Tags:       timeseries.Tags,
+		Attributes: restAttributes(timeseries.Attributes),
 	}
 
 	if timeseries.ThingUuid != NilUUID {
@@ -165,53 +183,118 @@ type AddDataToTimeseriesParams struct {
 	Points    []DataPoint
 	CreatedBy uuid.UUID
 	Unit      *string
+	Token     []byte
+	Overwrite bool
 }
 
 func (svc *TimeseriesService) AddDataToTimeseries(ctx context.Context, p AddDataToTimeseriesParams) (int64, error) {
-	series, err := svc.q.GetTimeseriesByUUID(ctx, p.Uuid)
+	// Use a transaction for this action, the audit context is local to it
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
 		return 0, err
 	}
 
-	filteredPoints := make([]*DataPoint, 0)
+	q := svc.q.WithTx(tx)
 
-	var fromUnit units.Unit
-	var toUnit units.Unit
+	err = q.SetAuditContext(ctx, p.Token)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
 
-	if p.Unit != nil {
-		tsUnit, err := svc.q.GetUnitFromTimeseries(ctx, p.Uuid)
-		if err != nil {
-			return 0, err
-		}
+	count, err := addDataToTimeseries(ctx, q, tx, p)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
 
-		if tsUnit == *p.Unit {
-			p.Unit = nil
-		} else {
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
 
-			fromUnit, err = units.Find(*p.Unit)
-			if err != nil {
-				return 0, ie.ErrorInvalidUnit
-			}
+	return count, nil
+}
 
-			toUnit, err = units.Find(tsUnit)
-			if err != nil {
-				// This should never error out, as there should be no incompatible units in the DB
-				return 0, ie.ErrorInvalidUnit
-			}
-		}
+// unitConversion converts values from a unit to the unit of a time series.
+type unitConversion struct {
+	from units.Unit
+	to   units.Unit
+}
+
+func (c *unitConversion) convert(value float64) (float64, error) {
+	if c == nil {
+		return value, nil
+	}
+
+	v := units.NewValue(value, c.from)
+	conv, err := v.Convert(c.to)
+	if err != nil {
+		return 0, ie.ErrorInvalidUnitConversion
+	}
+
+	return float64(conv.Float()), nil
+}
+
+// timeseriesForWrite returns the time series to add data to together with
+// the conversion from unit, which is nil when no conversion is needed.
+func timeseriesForWrite(ctx context.Context, q *postgres.Queries, id uuid.UUID, unit *string) (postgres.Timeseries, *unitConversion, error) {
+	series, err := q.GetTimeseriesByUUID(ctx, id)
+	if err != nil {
+		return series, nil, err
+	}
+
+	// Time series of an archived thing are read-only
+	state, err := q.GetThingStateByTimeseries(ctx, id)
+	if err != nil && errors.Is(err, sql.ErrNoRows) == false {
+		return series, nil, err
+	} else if err == nil && state == postgres.ThingStateArchived {
+		return series, nil, ie.ErrorThingArchived
 	}
 
+	if unit == nil || *unit == series.SiUnit {
+		return series, nil, nil
+	}
+
+	fromUnit, err := units.Find(*unit)
+	if err != nil {
+		return series, nil, ie.ErrorInvalidUnit
+	}
+
+	toUnit, err := units.Find(series.SiUnit)
+	if err != nil {
+		// This should never error out, as there should be no incompatible units in the DB
+		return series, nil, ie.ErrorInvalidUnit
+	}
+
+	conv := &unitConversion{from: fromUnit, to: toUnit}
+	if _, err := conv.convert(0); err != nil {
+		return series, nil, err
+	}
+
+	return series, conv, nil
+}
+
+// addDataToTimeseries converts the points to the unit of the time series and
+// inserts those within its bounds. A point with the timestamp of an existing
+// point is a conflict, unless overwrite is set; then the last of several
+// points with the same timestamp wins. The db is the transaction of q, which
+// has the audit context set when overwriting.
+func addDataToTimeseries(ctx context.Context, q *postgres.Queries, db postgres.DBTX, p AddDataToTimeseriesParams) (int64, error) {
+	series, conv, err := timeseriesForWrite(ctx, q, p.Uuid, p.Unit)
+	if err != nil {
+		return 0, err
+	}
+
+	filteredPoints := make([]*DataPoint, 0)
+	seen := make(map[int64]int)
+
 	for _, item := range p.Points {
 		// Do not use a pointer to the item variable as this is a known gotcha.
 		pItem := item
 
-		if p.Unit != nil {
-			v := units.NewValue(pItem.Value, fromUnit)
-			conv, err := v.Convert(toUnit)
-			if err != nil {
-				return 0, ie.ErrorInvalidUnitConversion
-			}
-			pItem.Value = float64(conv.Float())
+		pItem.Value, err = conv.convert(pItem.Value)
+		if err != nil {
+			return 0, err
 		}
 
 		if series.LowerBound.Valid {
@@ -227,6 +310,15 @@ func (svc *TimeseriesService) AddDataToTimeseries(ctx context.Context, p AddData
 			}
 		}
 
+		// A point can only be overwritten once per statement
+		if p.Overwrite {
+			if i, ok := seen[pItem.Timestamp.UnixNano()]; ok {
+				filteredPoints[i] = &pItem
+				continue
+			}
+			seen[pItem.Timestamp.UnixNano()] = len(filteredPoints)
+		}
+
 		filteredPoints = append(filteredPoints, &pItem)
 	}
 
@@ -235,7 +327,12 @@ func (svc *TimeseriesService) AddDataToTimeseries(ctx context.Context, p AddData
 		return 0, err
 	}
 
-	result, err := svc.db.ExecContext(ctx, insertDataToTimeseries, p.Uuid, p.CreatedBy, data)
+	query := insertDataToTimeseries
+	if p.Overwrite {
+		query = overwriteDataInTimeseries
+	}
+
+	result, err := db.ExecContext(ctx, query, p.Uuid, p.CreatedBy, data)
 	if err != nil {
 		return 0, err
 	}
@@ -245,6 +342,13 @@ func (svc *TimeseriesService) AddDataToTimeseries(ctx context.Context, p AddData
 		return 0, err
 	}
 
+	// Monitors evaluated on ingest are evaluated by the next monitor run
+	if count > 0 {
+		if _, err := q.MarkTimeseriesMonitorsDue(ctx, p.Uuid); err != nil {
+			return 0, err
+		}
+	}
+
 	return count, nil
 }
 
@@ -252,8 +356,9 @@ func (svc *TimeseriesService) FindByTags(ctx context.Context, p FindByTagsParams
 	timeseries := make([]*rest.Timeseries, 0)
 
 	params := postgres.FindTimeseriesByTagsParams{
-		Tags:  p.Tags,
-		Token: p.Token,
+		Tags:       p.Tags,
+		Token:      p.Token,
+		Attributes: attributesOrEmpty(p.Attributes),
 	}
 	if p.Limit.Value != 0 {
 		params.ArgLimit = p.Limit.Value
@@ -284,6 +389,7 @@ func (svc *TimeseriesService) FindByTags(ctx context.Context, p FindByTagsParams
 			Name:       item.Name,
 			SiUnit:     item.SiUnit,
 			Tags:       item.Tags,
+			Attributes: restAttributes(item.Attributes),
 			UpperBound: uBound,
 			Uuid:       item.Uuid.String(),
 		}
@@ -331,6 +437,7 @@ func (svc *TimeseriesService) FindByThing(ctx context.Context, thing uuid.UUID)
 			Name:       item.Name,
 			SiUnit:     item.SiUnit,
 			Tags:       item.Tags,
+			Attributes: restAttributes(item.Attributes),
 			UpperBound: uBound,
 			Uuid:       item.Uuid.String(),
 		}
@@ -346,6 +453,49 @@ func (svc *TimeseriesService) FindByThing(ctx context.Context, thing uuid.UUID)
 	return timeseries, nil
 }
 
+// FindByThings returns the time series of each of the things, which the user
+// of the token may read.
+func (svc *TimeseriesService) FindByThings(ctx context.Context, token []byte, things []uuid.UUID) (map[uuid.UUID][]*rest.Timeseries, error) {
+	timeseries := make(map[uuid.UUID][]*rest.Timeseries)
+
+	tsList, err := svc.q.FindTimeseriesByThings(ctx, postgres.FindTimeseriesByThingsParams{
+		Token:      token,
+		ThingUuids: things,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range tsList {
+		var lBound *float64
+		var uBound *float64
+
+		if item.LowerBound.Valid {
+			lBound = &item.LowerBound.Float64
+		}
+		if item.UpperBound.Valid {
+			uBound = &item.UpperBound.Float64
+		}
+
+		thingUUID := item.ThingUuid.String()
+		t := &rest.Timeseries{
+			CreatedBy:  item.CreatedBy.String(),
+			LowerBound: lBound,
+			Name:       item.Name,
+			SiUnit:     item.SiUnit,
+			Tags:       item.Tags,
+			Attributes: restAttributes(item.Attributes),
+			UpperBound: uBound,
+			Uuid:       item.Uuid.String(),
+			ThingUuid:  &thingUUID,
+		}
+
+		timeseries[item.ThingUuid] = append(timeseries[item.ThingUuid], t)
+	}
+
+	return timeseries, nil
+}
+
 func (svc *TimeseriesService) FindByUuid(ctx context.Context, id uuid.UUID) (*rest.Timeseries, error) {
 	t, err := svc.q.FindTimeseriesByUUID(ctx, id)
 	if err != nil {
@@ -367,6 +517,7 @@ func (svc *TimeseriesService) FindByUuid(ctx context.Context, id uuid.UUID) (*re
 		Name:       t.Name,
 		SiUnit:     t.SiUnit,
 		Tags:       t.Tags,
+		Attributes: restAttributes(t.Attributes),
 		LowerBound: lBound,
 		UpperBound: uBound,
 		CreatedBy:  t.CreatedBy.String(),
@@ -384,7 +535,8 @@ func (svc *TimeseriesService) FindAll(ctx context.Context, p FindAllParams) ([]*
 	timeseries := make([]*rest.Timeseries, 0)
 
 	params := postgres.FindTimeseriesParams{
-		Token: p.Token,
+		Token:      p.Token,
+		Attributes: attributesOrEmpty(p.Attributes),
 	}
 	if p.Limit.Value != 0 {
 		params.ArgLimit = p.Limit.Value
@@ -416,6 +568,7 @@ func (svc *TimeseriesService) FindAll(ctx context.Context, p FindAllParams) ([]*
 			UpperBound: uBound,
 			LowerBound: lBound,
 			Tags:       item.Tags,
+			Attributes: restAttributes(item.Attributes),
 			CreatedBy:  item.CreatedBy.String(),
 		}
 
@@ -587,6 +740,7 @@ type UpdateTimeseriesParams struct {
 	Name       *string
 	SiUnit     *string
 	Tags       *[]string
+	Attributes []byte
 }
 
 func (svc *TimeseriesService) UpdateTimeseries(ctx context.Context, p UpdateTimeseriesParams) (int64, error) {
@@ -680,6 +834,19 @@ func (svc *TimeseriesService) UpdateTimeseries(ctx context.Context, p UpdateTime
 		count += c
 	}
 
+	if p.Attributes != nil {
+		params := postgres.SetTimeseriesAttributesParams{
+			Uuid:       p.Uuid,
+			Attributes: p.Attributes,
+		}
+		c, err := q.SetTimeseriesAttributes(ctx, params)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		count += c
+	}
+
 	tx.Commit()
 
 	return count, nil
@@ -700,6 +867,7 @@ type DeleteTsDataParams struct {
 	End         time.Time
 	GreaterOrEq *float32
 	LessOrEq    *float32
+	Token       []byte
 }
 
 func (svc *TimeseriesService) DeleteTsData(ctx context.Context, p DeleteTsDataParams) (int64, error) {
@@ -723,10 +891,134 @@ func (svc *TimeseriesService) DeleteTsData(ctx context.Context, p DeleteTsDataPa
 		params.Le = float64(*p.LessOrEq)
 	}
 
-	count, err := svc.q.DeleteTsDataRange(ctx, params)
+	// Use a transaction for this action, the audit context is local to it
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	err = q.SetAuditContext(ctx, p.Token)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	count, err := q.DeleteTsDataRange(ctx, params)
 	if err != nil {
+		tx.Rollback()
 		return 0, err
 	}
 
+	tx.Commit()
+
+	return count, nil
+}
+
+type FindTsDataHistoryParams struct {
+	Uuid   uuid.UUID
+	Start  time.Time
+	End    time.Time
+	Limit  int64
+	Offset int64
+}
+
+func (svc *TimeseriesService) FindTsDataHistory(ctx context.Context, p FindTsDataHistoryParams) ([]*rest.TsDataChange, error) {
+	changes := make([]*rest.TsDataChange, 0)
+
+	count, err := svc.q.ExistsTimeseries(ctx, p.Uuid)
+	if err != nil {
+		return nil, err
+	} else if count == 0 {
+		return nil, ie.ErrorNotFound
+	}
+
+	params := postgres.FindTsDataHistoryParams{
+		TsUuid:    p.Uuid,
+		Start:     p.Start,
+		Stop:      p.End,
+		ArgLimit:  p.Limit,
+		ArgOffset: p.Offset,
+	}
+
+	history, err := svc.q.FindTsDataHistory(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range history {
+		c := &rest.TsDataChange{
+			ChangeId: item.ChangeID,
+			Action:   rest.TsDataChangeAction(item.Action),
+			Ts:       item.Ts,
+			TsEnd:    item.TsEnd,
+			Count:    int(item.Count),
+			Changed:  item.Changed,
+		}
+
+		if item.Value.Valid {
+			v := float32(item.Value.Float64)
+			c.V = &v
+		}
+
+		if item.CreatedBy != NilUUID {
+			v := item.CreatedBy.String()
+			c.CreatedBy = &v
+		}
+		if item.ChangedBy != NilUUID {
+			v := item.ChangedBy.String()
+			c.ChangedBy = &v
+		}
+		if item.TokenUuid != NilUUID {
+			v := item.TokenUuid.String()
+			c.TokenUuid = &v
+		}
+		if item.Restored.Valid {
+			c.Restored = &item.Restored.Time
+		}
+
+		changes = append(changes, c)
+	}
+
+	return changes, nil
+}
+
+type UndoTsDataChangeParams struct {
+	Uuid     uuid.UUID
+	ChangeId int64
+	Window   time.Duration
+	Token    []byte
+}
+
+// UndoTsDataChange restores the data points deleted or overwritten by a
+// change, as long as the change was made within the undo window.
+func (svc *TimeseriesService) UndoTsDataChange(ctx context.Context, p UndoTsDataChangeParams) (int64, error) {
+	// Use a transaction for this action, the audit context is local to it
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	err = q.SetAuditContext(ctx, p.Token)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	count, err := q.RestoreTsDataChange(ctx, postgres.RestoreTsDataChangeParams{
+		TsUuid:        p.Uuid,
+		ChangeID:      p.ChangeId,
+		WindowSeconds: int64(p.Window / time.Second),
+	})
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	tx.Commit()
+
 	return count, nil
 }
@@ -0,0 +1,519 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+	"github.com/self-host/self-host/postgres"
+)
+
+// Alerts raised for unhealthy time series expire when not raised again
+// within an hour, as when the evaluation stops.
+const healthAlertTimeout = 3600
+
+// HealthService represents the repository used for interacting with the
+// health of time series; whether they report as often as expected.
+type HealthService struct {
+	q  *postgres.Queries
+	db *sql.DB
+}
+
+// NewHealthService instantiates the HealthService repository.
+func NewHealthService(db *sql.DB) *HealthService {
+	if db == nil {
+		return nil
+	}
+
+	return &HealthService{
+		q:  postgres.New(db),
+		db: db,
+	}
+}
+
+func newRestHealthRule(r postgres.TimeseriesHealthRule) *rest.TimeseriesHealthRule {
+	v := &rest.TimeseriesHealthRule{
+		Uuid:        r.Uuid.String(),
+		Name:        r.Name,
+		Tag:         r.Tag,
+		Severity:    rest.AlertSeverity(r.Severity),
+		Environment: r.Environment,
+		Enabled:     r.Enabled,
+		Created:     r.Created,
+		CreatedBy:   r.CreatedBy.String(),
+	}
+
+	if r.TsUuid != uuid.Nil {
+		ts := r.TsUuid.String()
+		v.Timeseries = &ts
+	}
+	if r.ExpectedInterval.Valid {
+		v.ExpectedInterval = &r.ExpectedInterval.Int32
+	}
+	if r.Flatline.Valid {
+		v.Flatline = &r.Flatline.Int32
+	}
+
+	return v
+}
+
+func newRestHealth(h postgres.FindTimeseriesHealthRow) *rest.TimeseriesHealth {
+	v := &rest.TimeseriesHealth{
+		Timeseries: h.Uuid.String(),
+		Name:       h.Name,
+		State:      rest.TimeseriesHealthState(h.State),
+	}
+
+	if h.ThingUuid != uuid.Nil {
+		thing := h.ThingUuid.String()
+		v.Thing = &thing
+	}
+	if h.Since.Valid {
+		v.Since = &h.Since.Time
+	}
+	if h.LastSeen.Valid {
+		v.LastSeen = &h.LastSeen.Time
+	}
+	if h.LastValue.Valid {
+		v.LastValue = &h.LastValue.Float64
+	}
+	if h.RuleUuid != uuid.Nil {
+		rule := h.RuleUuid.String()
+		v.Rule = &rule
+	}
+	if h.AlertUuid != uuid.Nil {
+		alert := h.AlertUuid.String()
+		v.Alert = &alert
+	}
+	if h.Evaluated.Valid {
+		v.Evaluated = &h.Evaluated.Time
+	}
+
+	return v
+}
+
+func validHealthRule(tsUUID uuid.UUID, tag string, interval, flatline sql.NullInt32) error {
+	if (tsUUID == uuid.Nil) == (tag == "") {
+		return ie.NewBadRequestError(fmt.Errorf("either a time series or a tag must be set"))
+	} else if interval.Valid == false && flatline.Valid == false {
+		return ie.NewBadRequestError(fmt.Errorf("either an expected interval or a flatline must be set"))
+	} else if interval.Valid && interval.Int32 <= 0 {
+		return ie.NewBadRequestError(fmt.Errorf("the expected interval must be at least one second"))
+	} else if flatline.Valid && flatline.Int32 <= 0 {
+		return ie.NewBadRequestError(fmt.Errorf("the flatline must be at least one second"))
+	}
+	return nil
+}
+
+func nullInt32(v *int32) sql.NullInt32 {
+	if v == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: *v, Valid: true}
+}
+
+type AddHealthRuleParams struct {
+	Name             string
+	Timeseries       uuid.UUID
+	Tag              string
+	ExpectedInterval *int32
+	Flatline         *int32
+	Severity         rest.AlertSeverity
+	Environment      string
+	Enabled          bool
+	CreatedBy        uuid.UUID
+}
+
+func (svc *HealthService) AddHealthRule(ctx context.Context, p *AddHealthRuleParams) (*rest.TimeseriesHealthRule, error) {
+	params := postgres.CreateTimeseriesHealthRuleParams{
+		Name:             p.Name,
+		TsUuid:           p.Timeseries,
+		Tag:              p.Tag,
+		ExpectedInterval: nullInt32(p.ExpectedInterval),
+		Flatline:         nullInt32(p.Flatline),
+		Severity:         postgres.AlertSeverity(p.Severity),
+		Environment:      p.Environment,
+		Enabled:          p.Enabled,
+		CreatedBy:        p.CreatedBy,
+	}
+
+	if err := validHealthRule(params.TsUuid, params.Tag, params.ExpectedInterval, params.Flatline); err != nil {
+		return nil, err
+	}
+
+	r, err := svc.q.CreateTimeseriesHealthRule(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestHealthRule(r), nil
+}
+
+func (svc *HealthService) FindHealthRules(ctx context.Context, p PaginationParams) ([]*rest.TimeseriesHealthRule, error) {
+	rules := make([]*rest.TimeseriesHealthRule, 0)
+
+	list, err := svc.q.FindTimeseriesHealthRules(ctx, postgres.FindTimeseriesHealthRulesParams{
+		ArgLimit:  p.Limit.Value,
+		ArgOffset: p.Offset.Value,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range list {
+		rules = append(rules, newRestHealthRule(r))
+	}
+
+	return rules, nil
+}
+
+func (svc *HealthService) FindHealthRuleByUuid(ctx context.Context, id uuid.UUID) (*rest.TimeseriesHealthRule, error) {
+	r, err := svc.q.FindTimeseriesHealthRuleByUUID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRestHealthRule(r), nil
+}
+
+type UpdateHealthRuleParams struct {
+	Uuid             uuid.UUID
+	Name             *string
+	Timeseries       *uuid.UUID
+	Tag              *string
+	SetInterval      bool // Whether to set the ExpectedInterval, even to null
+	ExpectedInterval *int32
+	SetFlatline      bool // Whether to set the Flatline, even to null
+	Flatline         *int32
+	Severity         *rest.AlertSeverity
+	Environment      *string
+	Enabled          *bool
+}
+
+func (svc *HealthService) UpdateHealthRuleByUuid(ctx context.Context, p UpdateHealthRuleParams) (int64, error) {
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	r, err := q.FindTimeseriesHealthRuleByUUID(ctx, p.Uuid)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	params := postgres.UpdateTimeseriesHealthRuleParams{
+		Uuid:             r.Uuid,
+		Name:             r.Name,
+		TsUuid:           r.TsUuid,
+		Tag:              r.Tag,
+		ExpectedInterval: r.ExpectedInterval,
+		Flatline:         r.Flatline,
+		Severity:         r.Severity,
+		Environment:      r.Environment,
+		Enabled:          r.Enabled,
+	}
+
+	if p.Name != nil {
+		params.Name = *p.Name
+	}
+	if p.Timeseries != nil {
+		params.TsUuid = *p.Timeseries
+	}
+	if p.Tag != nil {
+		params.Tag = *p.Tag
+	}
+	if p.SetInterval {
+		params.ExpectedInterval = nullInt32(p.ExpectedInterval)
+	}
+	if p.SetFlatline {
+		params.Flatline = nullInt32(p.Flatline)
+	}
+	if p.Severity != nil {
+		params.Severity = postgres.AlertSeverity(*p.Severity)
+	}
+	if p.Environment != nil {
+		params.Environment = *p.Environment
+	}
+	if p.Enabled != nil {
+		params.Enabled = *p.Enabled
+	}
+
+	if err := validHealthRule(params.TsUuid, params.Tag, params.ExpectedInterval, params.Flatline); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	count, err := q.UpdateTimeseriesHealthRule(ctx, params)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	tx.Commit()
+
+	return count, nil
+}
+
+func (svc *HealthService) DeleteHealthRule(ctx context.Context, id uuid.UUID) (int64, error) {
+	count, err := svc.q.DeleteTimeseriesHealthRule(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+type FindHealthParams struct {
+	PaginationParams
+	Token []byte
+	State *rest.TimeseriesHealthState
+	Thing *uuid.UUID
+}
+
+// FindHealth returns the health of every time series the token may read, as
+// of its last evaluation.
+func (svc *HealthService) FindHealth(ctx context.Context, p FindHealthParams) ([]*rest.TimeseriesHealth, error) {
+	health := make([]*rest.TimeseriesHealth, 0)
+
+	params := postgres.FindTimeseriesHealthParams{
+		Token:     p.Token,
+		ArgLimit:  p.Limit.Value,
+		ArgOffset: p.Offset.Value,
+	}
+	if p.State != nil {
+		params.State = string(*p.State)
+	}
+	if p.Thing != nil {
+		params.ThingUuid = p.Thing.String()
+	}
+
+	list, err := svc.q.FindTimeseriesHealth(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, h := range list {
+		health = append(health, newRestHealth(h))
+	}
+
+	return health, nil
+}
+
+// effectiveHealthRule returns the rule applied to a time series; its own
+// rule, or else the first by name of the rules of its tags.
+func effectiveHealthRule(rules []postgres.TimeseriesHealthRule, tsUUID uuid.UUID, tags []string) *postgres.TimeseriesHealthRule {
+	var tagged *postgres.TimeseriesHealthRule
+
+	for i := range rules {
+		r := &rules[i]
+		if r.TsUuid != uuid.Nil {
+			if r.TsUuid == tsUUID {
+				return r
+			}
+			continue
+		}
+		if tagged != nil {
+			continue
+		}
+		for _, tag := range tags {
+			if tag == r.Tag {
+				tagged = r
+				break
+			}
+		}
+	}
+
+	return tagged
+}
+
+// healthState returns the state of a time series under the rule. It is stale
+// when its latest value is older than the expected interval, and flatlined
+// when its value has not changed since the start of the flatline window.
+func healthState(r *postgres.TimeseriesHealthRule, stats postgres.GetTsDataHealthRow, now time.Time) rest.TimeseriesHealthState {
+	if r == nil || stats.LastSeen.Valid == false {
+		return rest.TimeseriesHealthStateUnknown
+	}
+
+	if r.ExpectedInterval.Valid {
+		interval := time.Duration(r.ExpectedInterval.Int32) * time.Second
+		if now.Sub(stats.LastSeen.Time) > interval {
+			return rest.TimeseriesHealthStateStale
+		}
+	}
+
+	// The value before the window is needed to tell a flatline from a time
+	// series younger than the window.
+	if r.Flatline.Valid && stats.PreviousValue.Valid {
+		previous := stats.PreviousValue.Float64
+		if stats.WindowMin.Valid == false ||
+			(stats.WindowMin.Float64 == previous && stats.WindowMax.Float64 == previous) {
+			return rest.TimeseriesHealthStateFlatline
+		}
+	}
+
+	return rest.TimeseriesHealthStateOk
+}
+
+// healthAlertParams returns the alert raised for an unhealthy time series.
+func healthAlertParams(r *postgres.TimeseriesHealthRule, ts postgres.ClaimTimeseriesHealthRow, state rest.TimeseriesHealthState, stats postgres.GetTsDataHealthRow) *CreateAlertParams {
+	resource := ts.TsUuid.String()
+	if ts.ThingUuid != uuid.Nil {
+		resource = ts.ThingUuid.String() + "/" + resource
+	}
+
+	p := &CreateAlertParams{
+		Resource:    resource,
+		Environment: r.Environment,
+		Severity:    rest.AlertSeverity(r.Severity),
+		Status:      rest.AlertStatusOpen,
+		Value:       strings.TrimSpace(strconv.FormatFloat(stats.LastValue.Float64, 'g', 6, 64) + " " + ts.SiUnit),
+		Origin:      "health/" + r.Uuid.String(),
+		Service:     make([]string, 0),
+		Tags:        stringsOrEmpty(ts.Tags),
+		Timeout:     healthAlertTimeout,
+	}
+
+	switch state {
+	case rest.TimeseriesHealthStateStale:
+		p.Event = "Stale data"
+		p.Description = fmt.Sprintf("%s has not reported since %s, expected every %s",
+			ts.Name, stats.LastSeen.Time.UTC().Format(time.RFC3339),
+			time.Duration(r.ExpectedInterval.Int32)*time.Second)
+	case rest.TimeseriesHealthStateFlatline:
+		p.Event = "Flatline"
+		p.Description = fmt.Sprintf("%s has not changed from %s in %s",
+			ts.Name, p.Value, time.Duration(r.Flatline.Int32)*time.Second)
+	}
+
+	return p
+}
+
+// evaluateHealth updates the health state of a time series, raising an alert
+// while it is unhealthy and resolving it once healthy again.
+func (svc *HealthService) evaluateHealth(ctx context.Context, rules []postgres.TimeseriesHealthRule, ts postgres.ClaimTimeseriesHealthRow, now time.Time) error {
+	r := effectiveHealthRule(rules, ts.TsUuid, ts.Tags)
+
+	params := postgres.GetTsDataHealthParams{
+		TsUuid: ts.TsUuid,
+		Since:  now,
+	}
+	if r != nil && r.Flatline.Valid {
+		params.Since = now.Add(-time.Duration(r.Flatline.Int32) * time.Second)
+	}
+
+	stats, err := svc.q.GetTsDataHealth(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	state := healthState(r, stats, now)
+	alertUUID := ts.AlertUuid
+	alerts := NewAlertService(svc.db)
+
+	// The alert of the previous state no longer holds
+	if alertUUID != uuid.Nil && rest.TimeseriesHealthState(ts.State) != state {
+		alert, err := svc.q.FindAlertByUUID(ctx, alertUUID)
+		if err != nil && errors.Is(err, sql.ErrNoRows) == false {
+			return err
+		} else if err == nil && rest.AlertStatus(alert.Status) != rest.AlertStatusClose {
+			_, err := alerts.ResolveAlert(ctx, ChangeAlertStatusParams{
+				Uuid: alertUUID,
+				Note: "Resolved as the time series is " + string(state),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		alertUUID = uuid.Nil
+	}
+
+	if state == rest.TimeseriesHealthStateStale || state == rest.TimeseriesHealthStateFlatline {
+		reply, err := alerts.CreateAlert(ctx, healthAlertParams(r, ts, state, stats))
+		if err != nil {
+			return err
+		}
+
+		alertUUID, err = uuid.Parse(reply.Uuid)
+		if err != nil {
+			return err
+		}
+	}
+
+	since := ts.Since
+	if rest.TimeseriesHealthState(ts.State) != state {
+		since = now
+	}
+
+	update := postgres.UpdateTimeseriesHealthParams{
+		TsUuid:    ts.TsUuid,
+		State:     string(state),
+		Since:     since,
+		LastSeen:  stats.LastSeen,
+		LastValue: stats.LastValue,
+		AlertUuid: alertUUID,
+	}
+	if r != nil {
+		update.RuleUuid = r.Uuid
+	}
+
+	_, err = svc.q.UpdateTimeseriesHealth(ctx, update)
+
+	return err
+}
+
+// EvaluateHealth evaluates the health of the time series not evaluated within
+// the interval. Time series are claimed before being evaluated, so several
+// instances can evaluate the same domain.
+func (svc *HealthService) EvaluateHealth(ctx context.Context, interval time.Duration, limit int64) (int, error) {
+	if _, err := svc.q.InitTimeseriesHealth(ctx); err != nil {
+		return 0, err
+	}
+
+	rules, err := svc.q.FindEnabledTimeseriesHealthRules(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	list, err := svc.q.ClaimTimeseriesHealth(ctx, postgres.ClaimTimeseriesHealthParams{
+		Interval: int32(interval.Seconds()),
+		ArgLimit: limit,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		evaluated int
+		errs      []error
+		now       = time.Now()
+	)
+
+	for _, ts := range list {
+		if err := svc.evaluateHealth(ctx, rules, ts, now); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		evaluated++
+	}
+
+	if len(errs) > 0 {
+		return evaluated, errs[0]
+	}
+
+	return evaluated, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+	"github.com/self-host/self-host/postgres"
+)
+
+func TestEffectiveHealthRule(t *testing.T) {
+	tsUUID := uuid.New()
+
+	rules := []postgres.TimeseriesHealthRule{
+		{Name: "a", Tag: "meter"},
+		{Name: "b", Tag: "power"},
+		{Name: "c", TsUuid: uuid.New()},
+		{Name: "d", TsUuid: tsUUID},
+	}
+
+	cases := []struct {
+		ts   uuid.UUID
+		tags []string
+		name string
+	}{
+		{tsUUID, nil, "d"},
+		{tsUUID, []string{"meter"}, "d"},
+		{uuid.New(), []string{"power", "meter"}, "a"},
+		{uuid.New(), []string{"power"}, "b"},
+		{uuid.New(), []string{"other"}, ""},
+	}
+
+	for i, c := range cases {
+		r := effectiveHealthRule(rules, c.ts, c.tags)
+		if c.name == "" && r != nil {
+			t.Errorf("case %d: expected no rule, got %s", i, r.Name)
+		} else if c.name != "" && (r == nil || r.Name != c.name) {
+			t.Errorf("case %d: expected rule %s", i, c.name)
+		}
+	}
+}
+
+func TestHealthState(t *testing.T) {
+	now := time.Date(2021, 10, 19, 12, 0, 0, 0, time.UTC)
+	seen := func(ago time.Duration) sql.NullTime {
+		return sql.NullTime{Time: now.Add(-ago), Valid: true}
+	}
+	value := func(v float64) sql.NullFloat64 {
+		return sql.NullFloat64{Float64: v, Valid: true}
+	}
+
+	rule := &postgres.TimeseriesHealthRule{
+		ExpectedInterval: sql.NullInt32{Int32: 900, Valid: true},
+		Flatline:         sql.NullInt32{Int32: 3600, Valid: true},
+	}
+
+	cases := []struct {
+		rule  *postgres.TimeseriesHealthRule
+		stats postgres.GetTsDataHealthRow
+		state rest.TimeseriesHealthState
+	}{
+		{nil, postgres.GetTsDataHealthRow{LastSeen: seen(time.Minute)}, rest.TimeseriesHealthStateUnknown},
+		{rule, postgres.GetTsDataHealthRow{}, rest.TimeseriesHealthStateUnknown},
+		{rule, postgres.GetTsDataHealthRow{LastSeen: seen(time.Hour)}, rest.TimeseriesHealthStateStale},
+		{rule, postgres.GetTsDataHealthRow{LastSeen: seen(time.Minute), PreviousValue: value(1), WindowMin: value(1), WindowMax: value(1)}, rest.TimeseriesHealthStateFlatline},
+		{rule, postgres.GetTsDataHealthRow{LastSeen: seen(time.Minute), PreviousValue: value(1), WindowMin: value(1), WindowMax: value(2)}, rest.TimeseriesHealthStateOk},
+		{rule, postgres.GetTsDataHealthRow{LastSeen: seen(time.Minute), WindowMin: value(1), WindowMax: value(1)}, rest.TimeseriesHealthStateOk},
+	}
+
+	for i, c := range cases {
+		if s := healthState(c.rule, c.stats, now); s != c.state {
+			t.Errorf("case %d: expected %s, got %s", i, c.state, s)
+		}
+	}
+}
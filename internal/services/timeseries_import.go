@@ -0,0 +1,366 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/self-host/self-host/api/aapije/rest"
+	ie "github.com/self-host/self-host/internal/errors"
+)
+
+// maxImportErrors limits the number of errors reported by an import.
+const maxImportErrors = 100
+
+type tsImportColumn struct {
+	name  string
+	index int
+	uuid  uuid.UUID
+	unit  *string
+}
+
+// tsImport reads time series data from CSV content as described by a mapping.
+type tsImport struct {
+	header       bool
+	delimiter    rune
+	decimalComma bool
+	location     *time.Location
+	format       string
+	timestamp    tsImportColumn
+	columns      []tsImportColumn
+}
+
+func newTsImport(m rest.TsImportMapping) (*tsImport, error) {
+	imp := &tsImport{
+		header:    true,
+		delimiter: ',',
+		location:  time.UTC,
+		format:    "rfc3339",
+		timestamp: tsImportColumn{name: "timestamp"},
+	}
+
+	if m.Header != nil {
+		imp.header = *m.Header
+	}
+	if m.Delimiter != nil {
+		r, size := utf8.DecodeRuneInString(*m.Delimiter)
+		if size == 0 || size != len(*m.Delimiter) || r == '"' || r == '\r' || r == '\n' || r == utf8.RuneError {
+			return nil, ie.NewBadRequestError(fmt.Errorf("invalid delimiter %q", *m.Delimiter))
+		}
+		imp.delimiter = r
+	}
+	if m.DecimalSeparator != nil {
+		switch *m.DecimalSeparator {
+		case ".":
+		case ",":
+			imp.decimalComma = true
+		default:
+			return nil, ie.NewBadRequestError(fmt.Errorf("invalid decimal separator %q", *m.DecimalSeparator))
+		}
+	}
+	if m.Timezone != nil {
+		loc, err := time.LoadLocation(*m.Timezone)
+		if err != nil {
+			return nil, ie.NewBadRequestError(fmt.Errorf("invalid timezone %q", *m.Timezone))
+		}
+		imp.location = loc
+	}
+	if m.TimestampFormat != nil && *m.TimestampFormat != "" {
+		imp.format = *m.TimestampFormat
+	}
+	if m.TimestampColumn != nil {
+		imp.timestamp.name = *m.TimestampColumn
+	}
+
+	if len(m.Columns) == 0 {
+		return nil, ie.NewBadRequestError(fmt.Errorf("no columns to import"))
+	}
+
+	for _, c := range m.Columns {
+		if c.Column == "" {
+			return nil, ie.NewBadRequestError(fmt.Errorf("a column name is required"))
+		} else if c.Column == imp.timestamp.name {
+			return nil, ie.NewBadRequestError(fmt.Errorf("column %s is the timestamp column", c.Column))
+		}
+
+		id, err := uuid.Parse(c.Timeseries)
+		if err != nil {
+			return nil, ie.NewBadRequestError(fmt.Errorf("column %s: invalid time series uuid %q", c.Column, c.Timeseries))
+		}
+
+		imp.columns = append(imp.columns, tsImportColumn{
+			name: c.Column,
+			uuid: id,
+			unit: c.Unit,
+		})
+	}
+
+	if imp.header == false {
+		if err := imp.resolve(nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return imp, nil
+}
+
+// resolve sets the index of every mapped column, using the header or, without
+// one, the column numbers starting at 1.
+func (imp *tsImport) resolve(header []string) error {
+	names := make(map[string]int)
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		if _, ok := names[strings.TrimSpace(name)]; ok == false {
+			names[strings.TrimSpace(name)] = i
+		}
+	}
+
+	index := func(c *tsImportColumn) error {
+		if imp.header {
+			i, ok := names[c.name]
+			if ok == false {
+				return ie.NewBadRequestError(fmt.Errorf("column %s not found in header", c.name))
+			}
+			c.index = i
+		} else {
+			i, err := strconv.Atoi(c.name)
+			if err != nil || i < 1 {
+				return ie.NewBadRequestError(fmt.Errorf("column %s must be a column number when there is no header", c.name))
+			}
+			c.index = i - 1
+		}
+		return nil
+	}
+
+	if err := index(&imp.timestamp); err != nil {
+		return err
+	}
+	for i := range imp.columns {
+		if err := index(&imp.columns[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (imp *tsImport) parseTime(s string) (time.Time, error) {
+	switch imp.format {
+	case "rfc3339":
+		return time.Parse(time.RFC3339, s)
+	case "unix":
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return time.Time{}, fmt.Errorf("invalid unix time %q", s)
+		}
+		sec := math.Floor(f)
+		return time.Unix(int64(sec), int64((f-sec)*1e9)).UTC(), nil
+	case "unix_ms":
+		ms, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid unix time %q", s)
+		}
+		return time.UnixMilli(ms).UTC(), nil
+	}
+
+	return time.ParseInLocation(imp.format, s, imp.location)
+}
+
+func (imp *tsImport) parseValue(s string) (float64, error) {
+	if imp.decimalComma {
+		if strings.Contains(s, ".") {
+			return 0, fmt.Errorf("invalid number %q", s)
+		}
+		s = strings.Replace(s, ",", ".", 1)
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+
+	return v, nil
+}
+
+// parse reads every row of the CSV content and returns the points of each
+// mapped column. Rows that fail validation are reported in the result.
+func (imp *tsImport) parse(r io.Reader) (*rest.TsImportResult, [][]DataPoint, error) {
+	result := &rest.TsImportResult{
+		Errors: make([]rest.TsImportError, 0),
+	}
+	points := make([][]DataPoint, len(imp.columns))
+
+	addError := func(row int, column string, err error) {
+		if len(result.Errors) >= maxImportErrors {
+			return
+		}
+
+		e := rest.TsImportError{
+			Row:     int64(row),
+			Message: err.Error(),
+		}
+		if column != "" {
+			e.Column = &column
+		}
+		result.Errors = append(result.Errors, e)
+	}
+
+	reader := csv.NewReader(r)
+	reader.Comma = imp.delimiter
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	first := true
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			var perr *csv.ParseError
+			if errors.As(err, &perr) {
+				if first && imp.header {
+					return nil, nil, ie.NewBadRequestError(fmt.Errorf("invalid header: %v", perr.Err))
+				}
+				addError(perr.StartLine, "", perr.Err)
+				first = false
+				continue
+			}
+			return nil, nil, err
+		}
+
+		if first && imp.header {
+			first = false
+			if err := imp.resolve(record); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+		first = false
+
+		row, _ := reader.FieldPos(0)
+		result.Rows++
+
+		if imp.timestamp.index >= len(record) {
+			addError(row, imp.timestamp.name, fmt.Errorf("missing column"))
+			continue
+		}
+
+		ts, err := imp.parseTime(strings.TrimSpace(record[imp.timestamp.index]))
+		if err != nil {
+			addError(row, imp.timestamp.name, err)
+			continue
+		}
+
+		for i, c := range imp.columns {
+			if c.index >= len(record) {
+				addError(row, c.name, fmt.Errorf("missing column"))
+				continue
+			}
+
+			s := strings.TrimSpace(record[c.index])
+			if s == "" {
+				// No value for this time series on this row
+				continue
+			}
+
+			v, err := imp.parseValue(s)
+			if err != nil {
+				addError(row, c.name, err)
+				continue
+			}
+
+			result.Points++
+			points[i] = append(points[i], DataPoint{
+				Value:     v,
+				Timestamp: ts,
+			})
+		}
+	}
+
+	if imp.header && first {
+		return nil, nil, ie.NewBadRequestError(fmt.Errorf("the content has no header"))
+	}
+
+	return result, points, nil
+}
+
+type ImportTsDataParams struct {
+	Mapping   rest.TsImportMapping
+	Content   io.Reader
+	CreatedBy uuid.UUID
+	DryRun    bool
+}
+
+// ImportData adds the values of CSV content to time series, as mapped by
+// column, in a single transaction. When any row fails validation nothing is
+// imported and the errors are returned in the result. A dry run rolls back
+// the transaction.
+func (svc *TimeseriesService) ImportData(ctx context.Context, p ImportTsDataParams) (*rest.TsImportResult, error) {
+	imp, err := newTsImport(p.Mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check every time series and unit before reading any data
+	for _, c := range imp.columns {
+		_, _, err := timeseriesForWrite(ctx, svc.q, c.uuid, c.unit)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ie.NewBadRequestError(fmt.Errorf("column %s: time series %s not found", c.name, c.uuid))
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	result, points, err := imp.parse(p.Content)
+	if err != nil {
+		return nil, err
+	} else if len(result.Errors) > 0 {
+		return result, nil
+	}
+
+	// Use a transaction for this action
+	tx, err := svc.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	q := svc.q.WithTx(tx)
+
+	for i, c := range imp.columns {
+		count, err := addDataToTimeseries(ctx, q, tx, AddDataToTimeseriesParams{
+			Uuid:      c.uuid,
+			Points:    points[i],
+			CreatedBy: p.CreatedBy,
+			Unit:      c.unit,
+		})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		result.Inserted += count
+	}
+
+	if p.DryRun {
+		tx.Rollback()
+	} else {
+		tx.Commit()
+	}
+
+	return result, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package services
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/self-host/self-host/api/aapije/rest"
+)
+
+func TestTsImportParse(t *testing.T) {
+	header := false
+	format := "2006-01-02 15:04"
+	timezone := "Europe/Stockholm"
+	delimiter := ";"
+	decimal := ","
+
+	imp, err := newTsImport(rest.TsImportMapping{
+		Header:           &header,
+		TimestampColumn:  func(s string) *string { return &s }("1"),
+		TimestampFormat:  &format,
+		Timezone:         &timezone,
+		Delimiter:        &delimiter,
+		DecimalSeparator: &decimal,
+		Columns: []rest.TsImportColumn{
+			{Column: "2", Timeseries: "8181623c-aeb8-4ae3-8aa5-720d9408193e"},
+			{Column: "3", Timeseries: "5ecb8dbc-9b7f-4eae-97b2-7c286ec97d86"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content := "2021-06-01 12:00;21,5;3\n" +
+		"2021-06-01 12:15;;4\n" +
+		"2021-06-01 12:30;1.5;5\n" +
+		"yesterday;1;2\n"
+
+	result, points, err := imp.parse(strings.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Rows != 4 || result.Points != 4 {
+		t.Errorf("expected 4 rows and 4 points, got %d and %d", result.Rows, result.Points)
+	}
+	if len(result.Errors) != 2 || result.Errors[0].Row != 3 || *result.Errors[0].Column != "2" || result.Errors[1].Row != 4 {
+		t.Errorf("unexpected errors %+v", result.Errors)
+	}
+
+	if len(points[0]) != 1 || points[0][0].Value != 21.5 {
+		t.Errorf("unexpected points %v", points[0])
+	} else if ts := points[0][0].Timestamp; ts.Equal(time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)) == false {
+		t.Errorf("unexpected timestamp %v", ts)
+	}
+	if len(points[1]) != 3 {
+		t.Errorf("unexpected points %v", points[1])
+	}
+}
+
+func TestTsImportHeader(t *testing.T) {
+	format := "unix_ms"
+	mapping := rest.TsImportMapping{
+		TimestampFormat: &format,
+		Columns: []rest.TsImportColumn{
+			{Column: "temperature", Timeseries: "8181623c-aeb8-4ae3-8aa5-720d9408193e"},
+		},
+	}
+
+	imp, err := newTsImport(mapping)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, points, err := imp.parse(strings.NewReader("\ufefftimestamp,temperature\n1622548800000,21.5\n"))
+	if err != nil {
+		t.Fatal(err)
+	} else if len(result.Errors) != 0 || len(points[0]) != 1 {
+		t.Fatalf("unexpected result %+v", result)
+	} else if points[0][0].Timestamp.Equal(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)) == false {
+		t.Errorf("unexpected timestamp %v", points[0][0].Timestamp)
+	}
+
+	imp, err = newTsImport(mapping)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := imp.parse(strings.NewReader("timestamp,humidity\n1622548800000,40\n")); err == nil {
+		t.Errorf("expected an error for a missing column")
+	}
+}
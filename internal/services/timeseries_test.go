@@ -8,6 +8,7 @@ import (
 	"context"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -49,6 +50,243 @@ func TestTimeseriesAll(t *testing.T) {
 	}
 }
 
+func TestTimeseriesDataHistory(t *testing.T) {
+	svc := NewTimeseriesService(db)
+	ctx := context.Background()
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+
+	timeseries, err := svc.AddTimeseries(ctx, &NewTimeseriesParams{
+		Name:      "MyHistory",
+		CreatedBy: rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tsUUID, err := uuid.Parse(timeseries.Uuid)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	start := time.Now().Add(-1 * time.Hour).Truncate(time.Second)
+	end := start.Add(10 * time.Minute)
+
+	_, err = svc.AddDataToTimeseries(ctx, AddDataToTimeseriesParams{
+		Uuid: tsUUID,
+		Points: []DataPoint{
+			{Value: 1, Timestamp: start},
+			{Value: 2, Timestamp: start.Add(5 * time.Minute)},
+			{Value: 3, Timestamp: end},
+		},
+		CreatedBy: rootUUID,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	count, err := svc.DeleteTsData(ctx, DeleteTsDataParams{
+		Uuid:  tsUUID,
+		Start: start,
+		End:   end,
+		Token: []byte("root"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	} else if count != 3 {
+		log.Fatal("Expected three data points to be deleted")
+	}
+
+	history, err := svc.FindTsDataHistory(ctx, FindTsDataHistoryParams{
+		Uuid:  tsUUID,
+		Start: start,
+		End:   time.Now().Add(time.Minute),
+		Limit: 20,
+	})
+	if err != nil {
+		log.Fatal(err)
+	} else if len(history) != 1 {
+		log.Fatalf("Expected the delete as one change in the history, got %v", len(history))
+	}
+
+	item := history[0]
+	if item.Action != "delete" {
+		log.Fatal("Action does not match expected")
+	}
+	if item.Count != 3 || item.Ts.Equal(start) == false || item.TsEnd.Equal(end) == false || item.V != nil {
+		log.Fatalf("Expected the deleted range, got %v points from %v to %v", item.Count, item.Ts, item.TsEnd)
+	}
+	if item.ChangedBy == nil || *item.ChangedBy != rootUUID.String() {
+		log.Fatal("Changed by does not match expected")
+	}
+	if item.TokenUuid == nil {
+		log.Fatal("Token is missing from the history")
+	}
+
+	// Outside of the undo window
+	count, err = svc.UndoTsDataChange(ctx, UndoTsDataChangeParams{
+		Uuid:     tsUUID,
+		ChangeId: history[0].ChangeId,
+		Window:   0,
+		Token:    []byte("root"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	} else if count != 0 {
+		log.Fatal("Change outside of the undo window was restored")
+	}
+
+	count, err = svc.UndoTsDataChange(ctx, UndoTsDataChangeParams{
+		Uuid:     tsUUID,
+		ChangeId: history[0].ChangeId,
+		Window:   time.Hour,
+		Token:    []byte("root"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	} else if count != 3 {
+		log.Fatal("Expected three data points to be restored")
+	}
+
+	data, err := svc.QuerySingleSourceData(ctx, QuerySingleSourceDataParams{
+		Uuid:      tsUUID,
+		Start:     start,
+		End:       end,
+		Aggregate: "avg",
+		Precision: "microseconds",
+		Timezone:  "UTC",
+	})
+	if err != nil {
+		log.Fatal(err)
+	} else if len(data) != 3 || data[1].V != 2 || data[1].Ts.Equal(start.Add(5*time.Minute)) == false {
+		log.Fatal("Restored data points do not match expected")
+	}
+
+	// A change can only be restored once
+	count, err = svc.UndoTsDataChange(ctx, UndoTsDataChangeParams{
+		Uuid:     tsUUID,
+		ChangeId: history[0].ChangeId,
+		Window:   time.Hour,
+		Token:    []byte("root"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	} else if count != 0 {
+		log.Fatal("Change was restored twice")
+	}
+
+	_, err = svc.DeleteTsData(ctx, DeleteTsDataParams{
+		Uuid:  tsUUID,
+		Start: start,
+		End:   end,
+		Token: []byte("root"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = svc.DeleteTimeseries(ctx, tsUUID)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func TestTimeseriesDataOverwrite(t *testing.T) {
+	svc := NewTimeseriesService(db)
+	ctx := context.Background()
+	rootUUID := uuid.MustParse("00000000-0000-1000-8000-000000000000") // UUID for Root user
+
+	timeseries, err := svc.AddTimeseries(ctx, &NewTimeseriesParams{
+		Name:      "MyOverwrite",
+		CreatedBy: rootUUID,
+		Tags:      []string{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tsUUID, err := uuid.Parse(timeseries.Uuid)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	start := time.Now().Add(-1 * time.Hour).Truncate(time.Second)
+
+	add := func(points ...DataPoint) int64 {
+		count, err := svc.AddDataToTimeseries(ctx, AddDataToTimeseriesParams{
+			Uuid:      tsUUID,
+			Points:    points,
+			CreatedBy: rootUUID,
+			Token:     []byte(rootToken),
+			Overwrite: true,
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+		return count
+	}
+
+	if count := add(DataPoint{Value: 1, Timestamp: start}, DataPoint{Value: 2, Timestamp: start.Add(time.Minute)}); count != 2 {
+		log.Fatalf("Expected two data points to be added, got %v", count)
+	}
+
+	// Without overwrite an existing data point is a conflict, and nothing is added
+	_, err = svc.AddDataToTimeseries(ctx, AddDataToTimeseriesParams{
+		Uuid:      tsUUID,
+		Points:    []DataPoint{{Value: 5, Timestamp: start}, {Value: 6, Timestamp: start.Add(2 * time.Minute)}},
+		CreatedBy: rootUUID,
+		Token:     []byte(rootToken),
+	})
+	if err == nil {
+		log.Fatal("Expected adding an existing data point to fail")
+	}
+
+	// The last point with the same timestamp wins, unchanged points are left alone
+	count := add(
+		DataPoint{Value: 10, Timestamp: start},
+		DataPoint{Value: 11, Timestamp: start},
+		DataPoint{Value: 2, Timestamp: start.Add(time.Minute)},
+	)
+	if count != 1 {
+		log.Fatalf("Expected one data point to be overwritten, got %v", count)
+	}
+
+	history, err := svc.FindTsDataHistory(ctx, FindTsDataHistoryParams{
+		Uuid:  tsUUID,
+		Start: start,
+		End:   time.Now().Add(time.Minute),
+		Limit: 20,
+	})
+	if err != nil {
+		log.Fatal(err)
+	} else if len(history) != 1 {
+		log.Fatalf("Expected one change in the history, got %v", len(history))
+	}
+
+	item := history[0]
+	if item.Action != "overwrite" || item.V == nil || *item.V != 1 || item.Ts.Equal(start) == false || item.Count != 1 {
+		log.Fatal("Overwrite does not match expected")
+	}
+	if item.ChangedBy == nil || *item.ChangedBy != rootUUID.String() || item.TokenUuid == nil {
+		log.Fatal("Audit context is missing from the history")
+	}
+
+	_, err = svc.DeleteTsData(ctx, DeleteTsDataParams{
+		Uuid:  tsUUID,
+		Start: start,
+		End:   start.Add(time.Minute),
+		Token: []byte(rootToken),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, err = svc.DeleteTimeseries(ctx, tsUUID)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
 type RangeRowT struct {
 	V   float32
 	Le  *float32
@@ -15,6 +15,11 @@ import (
 	ie "github.com/self-host/self-host/internal/errors"
 )
 
+func init() {
+	// CSV files are uploaded as is, e.g. for a time series import
+	openapi3filter.RegisterBodyDecoder("text/csv", openapi3filter.FileBodyDecoder)
+}
+
 // Validate a request against the OpenAPI specification
 func OapiRequestValidator(swagger *openapi3.T) func(http.HandlerFunc) http.HandlerFunc {
 	return OapiRequestValidatorWithOptions(swagger, nil)
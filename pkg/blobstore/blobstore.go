@@ -0,0 +1,102 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// Package blobstore stores dataset content addressed by its sha256 checksum.
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+const (
+	BackendDatabase   = "database"
+	BackendFilesystem = "filesystem"
+	BackendS3         = "s3"
+)
+
+var (
+	ErrNotFound        = errors.New("blob not found")
+	ErrInvalidChecksum = errors.New("invalid checksum")
+)
+
+var checksumRegex = regexp.MustCompile(`^[0-9a-f]{64}(\.gz|\.zst)?$`)
+
+// Store is where the content of datasets is kept.
+//
+// Content is addressed by the hex encoded sha256 checksum, with a suffix when
+// compressed (see Key). Put returns the bytes to keep in the database row,
+// which is the content itself for the database backend and nothing for the
+// others. Get is given those bytes back. Delete removes content which no
+// longer is referenced, and content already gone is no error.
+type Store interface {
+	Name() string
+	Put(ctx context.Context, checksum string, content []byte) ([]byte, error)
+	Get(ctx context.Context, checksum string, inline []byte) ([]byte, error)
+	Delete(ctx context.Context, checksum string) error
+}
+
+// Config selects and configures a backend.
+type Config struct {
+	Backend string `mapstructure:"backend"`
+
+	// Compression of new content, for every backend
+	Compression string `mapstructure:"compression"`
+
+	// Filesystem
+	Path string `mapstructure:"path"`
+
+	// S3
+	Endpoint  string `mapstructure:"endpoint"`
+	Region    string `mapstructure:"region"`
+	Bucket    string `mapstructure:"bucket"`
+	AccessKey string `mapstructure:"access_key"`
+	SecretKey string `mapstructure:"secret_key"`
+}
+
+// New returns the store described by the config. An empty backend is the database.
+func New(c Config) (Store, error) {
+	if err := ValidCompression(c.Compression); err != nil {
+		return nil, err
+	}
+
+	switch c.Backend {
+	case "", BackendDatabase:
+		return Database{}, nil
+	case BackendFilesystem:
+		return NewFilesystem(c.Path)
+	case BackendS3:
+		return NewS3(c.Endpoint, c.Region, c.Bucket, c.AccessKey, c.SecretKey)
+	}
+
+	return nil, fmt.Errorf("unknown storage backend %s", c.Backend)
+}
+
+func validChecksum(checksum string) error {
+	if checksumRegex.MatchString(checksum) == false {
+		return ErrInvalidChecksum
+	}
+	return nil
+}
+
+// Database keeps the content in the database row, as datasets always did.
+type Database struct{}
+
+func (Database) Name() string {
+	return BackendDatabase
+}
+
+func (Database) Put(ctx context.Context, checksum string, content []byte) ([]byte, error) {
+	return content, nil
+}
+
+func (Database) Get(ctx context.Context, checksum string, inline []byte) ([]byte, error) {
+	return inline, nil
+}
+
+func (Database) Delete(ctx context.Context, checksum string) error {
+	return nil
+}
@@ -0,0 +1,194 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package blobstore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// The compressions double as HTTP content-codings.
+const (
+	CompressionNone = "none"
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+)
+
+// ValidCompression checks the name of a compression. An empty name is none.
+func ValidCompression(compression string) error {
+	switch compression {
+	case "", CompressionNone, CompressionGzip, CompressionZstd:
+		return nil
+	}
+	return fmt.Errorf("unknown compression %s", compression)
+}
+
+// Key returns the key of content with the checksum, once compressed. The same
+// content compressed in different ways is kept under different keys.
+func Key(checksum string, compression string) string {
+	switch compression {
+	case CompressionGzip:
+		return checksum + ".gz"
+	case CompressionZstd:
+		return checksum + ".zst"
+	}
+	return checksum
+}
+
+// Compress compresses the content.
+func Compress(compression string, content []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	switch compression {
+	case "", CompressionNone:
+		return content, nil
+	case CompressionGzip:
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(content); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	case CompressionZstd:
+		w, err := zstd.NewWriter(&buf)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(content); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ValidCompression(compression)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Decompress returns the content of compressed bytes.
+func Decompress(compression string, content []byte) ([]byte, error) {
+	if compression == "" || compression == CompressionNone {
+		return content, nil
+	}
+
+	r, err := NewDecompressor(compression, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
+
+// NewDecompressor returns a reader of the content of the compressed source.
+func NewDecompressor(compression string, src io.Reader) (io.ReadCloser, error) {
+	switch compression {
+	case "", CompressionNone:
+		return io.NopCloser(src), nil
+	case CompressionGzip:
+		return gzip.NewReader(src)
+	case CompressionZstd:
+		d, err := zstd.NewReader(src, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	}
+
+	return nil, ValidCompression(compression)
+}
+
+// DecompressReader reads the content of a compressed source of a known size.
+// Compressed content can not be read from an offset, seeking forward skips
+// content and seeking backward reads the source again from the start.
+type DecompressReader struct {
+	compression string
+	src         io.ReadSeekCloser
+	size        int64
+	offset      int64
+	r           io.ReadCloser
+	roffset     int64
+}
+
+func NewDecompressReader(compression string, src io.ReadSeekCloser, size int64) *DecompressReader {
+	return &DecompressReader{
+		compression: compression,
+		src:         src,
+		size:        size,
+	}
+}
+
+func (r *DecompressReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+
+	if r.r == nil || r.offset < r.roffset {
+		if r.r != nil {
+			r.r.Close()
+			r.r = nil
+		}
+
+		if _, err := r.src.Seek(0, io.SeekStart); err != nil {
+			return 0, err
+		}
+
+		d, err := NewDecompressor(r.compression, r.src)
+		if err != nil {
+			return 0, err
+		}
+		r.r = d
+		r.roffset = 0
+	}
+
+	if r.offset > r.roffset {
+		n, err := io.CopyN(io.Discard, r.r, r.offset-r.roffset)
+		r.roffset += n
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.r.Read(p)
+	r.offset += int64(n)
+	r.roffset += int64(n)
+
+	return n, err
+}
+
+func (r *DecompressReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	r.offset = offset
+
+	return offset, nil
+}
+
+func (r *DecompressReader) Close() error {
+	if r.r != nil {
+		r.r.Close()
+		r.r = nil
+	}
+	return r.src.Close()
+}
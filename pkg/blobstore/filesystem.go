@@ -0,0 +1,123 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Filesystem keeps content as files in a local directory.
+type Filesystem struct {
+	root string
+}
+
+func NewFilesystem(root string) (*Filesystem, error) {
+	if root == "" {
+		return nil, fmt.Errorf("the filesystem storage backend requires a path")
+	}
+
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &Filesystem{root: root}, nil
+}
+
+func (s *Filesystem) Name() string {
+	return BackendFilesystem
+}
+
+// path spreads the files over sub-directories named after the first two bytes of the checksum
+func (s *Filesystem) path(checksum string) string {
+	return filepath.Join(s.root, checksum[0:2], checksum[2:4], checksum)
+}
+
+func (s *Filesystem) Put(ctx context.Context, checksum string, content []byte) ([]byte, error) {
+	if err := validChecksum(checksum); err != nil {
+		return nil, err
+	}
+
+	p := s.path(checksum)
+	if _, err := os.Stat(p); err == nil {
+		// Same checksum, same content
+		return nil, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0o750); err != nil {
+		return nil, err
+	}
+
+	// Write to a temporary file first so that a partial file is never visible
+	f, err := os.CreateTemp(filepath.Dir(p), checksum+".*")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return nil, err
+	}
+
+	if err := os.Rename(f.Name(), p); err != nil {
+		os.Remove(f.Name())
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (s *Filesystem) Get(ctx context.Context, checksum string, inline []byte) ([]byte, error) {
+	if err := validChecksum(checksum); err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(s.path(checksum))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+func (s *Filesystem) Delete(ctx context.Context, checksum string) error {
+	if err := validChecksum(checksum); err != nil {
+		return err
+	}
+
+	err := os.Remove(s.path(checksum))
+	if err != nil && errors.Is(err, fs.ErrNotExist) == false {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Filesystem) Open(ctx context.Context, checksum string, size int64) (io.ReadSeekCloser, error) {
+	if err := validChecksum(checksum); err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(s.path(checksum))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ChunkSize is the size of the parts fetched by a ChunkReader.
+const ChunkSize = 1 << 20
+
+// Opener is implemented by the stores able to read content in parts,
+// without loading all of it into memory.
+type Opener interface {
+	Open(ctx context.Context, checksum string, size int64) (io.ReadSeekCloser, error)
+}
+
+// FetchFunc returns length bytes of the content, starting at offset.
+type FetchFunc func(offset int64, length int64) ([]byte, error)
+
+// ChunkReader reads content of a known size in chunks, fetched on demand.
+type ChunkReader struct {
+	size   int64
+	offset int64
+	buf    []byte
+	fetch  FetchFunc
+}
+
+func NewChunkReader(size int64, fetch FetchFunc) *ChunkReader {
+	return &ChunkReader{
+		size:  size,
+		fetch: fetch,
+	}
+}
+
+func (r *ChunkReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+
+	if len(r.buf) == 0 {
+		length := r.size - r.offset
+		if length > ChunkSize {
+			length = ChunkSize
+		}
+
+		b, err := r.fetch(r.offset, length)
+		if err != nil {
+			return 0, err
+		} else if len(b) == 0 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		r.buf = b
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	r.offset += int64(n)
+
+	return n, nil
+}
+
+func (r *ChunkReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+
+	if offset != r.offset {
+		r.offset = offset
+		r.buf = nil
+	}
+
+	return offset, nil
+}
+
+func (r *ChunkReader) Close() error {
+	r.buf = nil
+	return nil
+}
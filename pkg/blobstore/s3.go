@@ -0,0 +1,231 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// S3 keeps content as objects in a bucket of an S3 compatible object store.
+// Objects are addressed path-style, as supported by both AWS and MinIO.
+type S3 struct {
+	endpoint  *url.URL
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewS3(endpoint, region, bucket, accessKey, secretKey string) (*S3, error) {
+	if endpoint == "" || bucket == "" {
+		return nil, fmt.Errorf("the s3 storage backend requires an endpoint and a bucket")
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid s3 endpoint %s", endpoint)
+	}
+
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	return &S3{
+		endpoint:  u,
+		region:    region,
+		bucket:    bucket,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		client:    &http.Client{Timeout: 60 * time.Second},
+		now:       time.Now,
+	}, nil
+}
+
+func (s *S3) Name() string {
+	return BackendS3
+}
+
+func (s *S3) Put(ctx context.Context, checksum string, content []byte) ([]byte, error) {
+	if err := validChecksum(checksum); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(ctx, http.MethodPut, checksum, content, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, s3Error(resp)
+	}
+
+	return nil, nil
+}
+
+func (s *S3) Get(ctx context.Context, checksum string, inline []byte) ([]byte, error) {
+	if err := validChecksum(checksum); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(ctx, http.MethodGet, checksum, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, s3Error(resp)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+func (s *S3) Delete(ctx context.Context, checksum string) error {
+	if err := validChecksum(checksum); err != nil {
+		return err
+	}
+
+	resp, err := s.do(ctx, http.MethodDelete, checksum, nil, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	}
+
+	return s3Error(resp)
+}
+
+// Open reads the object with ranged requests, one chunk at a time.
+func (s *S3) Open(ctx context.Context, checksum string, size int64) (io.ReadSeekCloser, error) {
+	if err := validChecksum(checksum); err != nil {
+		return nil, err
+	}
+
+	return NewChunkReader(size, func(offset int64, length int64) ([]byte, error) {
+		rng := fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)
+		resp, err := s.do(ctx, http.MethodGet, checksum, nil, rng)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		switch resp.StatusCode {
+		case http.StatusPartialContent:
+			return io.ReadAll(io.LimitReader(resp.Body, length))
+		case http.StatusOK:
+			// The range was ignored
+			if _, err := io.CopyN(io.Discard, resp.Body, offset); err != nil {
+				return nil, err
+			}
+			return io.ReadAll(io.LimitReader(resp.Body, length))
+		case http.StatusNotFound:
+			return nil, ErrNotFound
+		}
+
+		return nil, s3Error(resp)
+	}), nil
+}
+
+// do sends a signed request for the object. The range header is optional.
+func (s *S3) do(ctx context.Context, method string, key string, body []byte, rng string) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.bucket + "/" + key
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if rng != "" {
+		req.Header.Set("Range", rng)
+	}
+
+	s.sign(req, body)
+
+	return s.client.Do(req)
+}
+
+// sign adds an AWS Signature Version 4 to the request.
+// https://docs.aws.amazon.com/general/latest/gr/sigv4_signing.html
+func (s *S3) sign(req *http.Request, body []byte) {
+	t := s.now().UTC()
+	amzDate := t.Format("20060102T150405Z")
+	date := t.Format("20060102")
+
+	payloadHash := sha256Hex(body)
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKey, scope, signedHeaders, signature))
+}
+
+func sha256Hex(b []byte) string {
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func s3Error(resp *http.Response) error {
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("s3: %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+}
@@ -0,0 +1,184 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Email sends messages as plain text email over SMTP. STARTTLS is used when
+// the server supports it, port 465 is implicit TLS.
+type Email struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     *mail.Address
+	to       []*mail.Address
+	subject  *template.Template
+	body     *template.Template
+
+	now func() time.Time
+}
+
+func NewEmail(host string, port int, username, password, from string, to []string, subject, body string) (*Email, error) {
+	if host == "" || from == "" || len(to) == 0 {
+		return nil, fmt.Errorf("the email channel requires a host, a from address and a to address")
+	}
+
+	if port == 0 {
+		port = 25
+	} else if port < 0 || port > 65535 {
+		return nil, fmt.Errorf("invalid port %d", port)
+	}
+
+	e := &Email{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		now:      time.Now,
+	}
+
+	var err error
+	if e.from, err = mail.ParseAddress(from); err != nil {
+		return nil, fmt.Errorf("invalid from address %q", from)
+	}
+	for _, s := range to {
+		a, err := mail.ParseAddress(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to address %q", s)
+		}
+		e.to = append(e.to, a)
+	}
+
+	if e.subject, err = parseTemplate("subject", subject); err != nil {
+		return nil, err
+	}
+	if e.body, err = parseTemplate("body", body); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+func (e *Email) Send(ctx context.Context, m Message) error {
+	subject, err := execute(e.subject, m, m.Subject)
+	if err != nil {
+		return err
+	}
+	body, err := execute(e.body, m, m.Text)
+	if err != nil {
+		return err
+	}
+
+	msg, err := e.message(strings.TrimSpace(subject), body)
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(e.host, strconv.Itoa(e.port))
+	dialer := &net.Dialer{Timeout: 30 * time.Second}
+
+	var conn net.Conn
+	if e.port == 465 {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: e.host}}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+
+	// The SMTP client does not take a context, so a deadline bounds the whole exchange
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	} else {
+		conn.SetDeadline(time.Now().Add(60 * time.Second))
+	}
+
+	c, err := smtp.NewClient(conn, e.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if e.port != 465 {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(&tls.Config{ServerName: e.host}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if e.username != "" {
+		if err := c.Auth(smtp.PlainAuth("", e.username, e.password, e.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(e.from.Address); err != nil {
+		return err
+	}
+	for _, a := range e.to {
+		if err := c.Rcpt(a.Address); err != nil {
+			return err
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+// message formats the email with the headers and a quoted-printable body.
+func (e *Email) message(subject, body string) ([]byte, error) {
+	to := make([]string, 0, len(e.to))
+	for _, a := range e.to {
+		to = append(to, a.String())
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", e.from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", e.now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	buf.WriteString("\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+	if _, err := w.Write([]byte(body)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// Package notify sends notifications to webhooks, email and chat services.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"text/template"
+)
+
+const (
+	KindWebhook = "webhook"
+	KindEmail   = "email"
+	KindSlack   = "slack"
+)
+
+// Message is a notification to send. The templates of a channel are
+// executed with the message as data, e.g. {{.Subject}} or {{.Payload.resource}}.
+type Message struct {
+	Trigger string                 `json:"trigger"`
+	Subject string                 `json:"subject"`
+	Text    string                 `json:"text"`
+	Payload map[string]interface{} `json:"payload"`
+}
+
+// Sender sends messages to a channel.
+type Sender interface {
+	Send(ctx context.Context, m Message) error
+}
+
+// Config configures a channel. Which settings are used depends on the kind.
+type Config struct {
+	// Webhook and Slack
+	URL string `json:"url,omitempty"`
+
+	// Webhook
+	Method      string            `json:"method,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	ContentType string            `json:"content_type,omitempty"`
+
+	// Webhook and Email, a template
+	Body string `json:"body,omitempty"`
+
+	// Email
+	Host     string   `json:"host,omitempty"`
+	Port     int      `json:"port,omitempty"`
+	Username string   `json:"username,omitempty"`
+	Password string   `json:"password,omitempty"`
+	From     string   `json:"from,omitempty"`
+	To       []string `json:"to,omitempty"`
+	Subject  string   `json:"subject,omitempty"` // A template
+
+	// Slack, a template
+	Text string `json:"text,omitempty"`
+}
+
+// New returns the sender of a channel of the kind.
+func New(kind string, c Config) (Sender, error) {
+	switch kind {
+	case KindWebhook:
+		return NewWebhook(c.URL, c.Method, c.Headers, c.ContentType, c.Body)
+	case KindEmail:
+		return NewEmail(c.Host, c.Port, c.Username, c.Password, c.From, c.To, c.Subject, c.Body)
+	case KindSlack:
+		return NewSlack(c.URL, c.Text)
+	}
+
+	return nil, fmt.Errorf("unknown notification channel kind %s", kind)
+}
+
+var funcs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// parseTemplate parses a template, or returns nil when there is none.
+func parseTemplate(name, text string) (*template.Template, error) {
+	if text == "" {
+		return nil, nil
+	}
+
+	t, err := template.New(name).Funcs(funcs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s template: %w", name, err)
+	}
+
+	return t, nil
+}
+
+// execute executes the template with the message, or returns the fallback
+// when there is no template.
+func execute(t *template.Template, m Message, fallback string) (string, error) {
+	if t == nil {
+		return fallback, nil
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, m); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var message = Message{
+	Trigger: "create",
+	Subject: "[critical] Crashed on web01",
+	Text:    "The web server crashed.",
+	Payload: map[string]interface{}{
+		"resource": "web01",
+		"severity": "critical",
+	},
+}
+
+type request struct {
+	header http.Header
+	body   string
+}
+
+func recorder(t *testing.T, status int) (*httptest.Server, <-chan request) {
+	c := make(chan request, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		c <- request{r.Header, string(b)}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, c
+}
+
+func TestWebhook(t *testing.T) {
+	srv, c := recorder(t, http.StatusNoContent)
+
+	s, err := New(KindWebhook, Config{
+		URL:     srv.URL,
+		Headers: map[string]string{"X-Token": "secret"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Send(context.Background(), message); err != nil {
+		t.Fatal(err)
+	}
+
+	r := <-c
+	var m Message
+	if err := json.Unmarshal([]byte(r.body), &m); err != nil {
+		t.Fatal(err)
+	} else if m.Subject != message.Subject || m.Payload["resource"] != "web01" {
+		t.Errorf("unexpected body %s", r.body)
+	}
+	if r.header.Get("X-Token") != "secret" || r.header.Get("Content-Type") != "application/json" {
+		t.Errorf("unexpected headers %v", r.header)
+	}
+
+	s, err = New(KindWebhook, Config{
+		URL:  srv.URL,
+		Body: `{"resource": {{json .Payload.resource}}, "trigger": "{{.Trigger}}"}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Send(context.Background(), message); err != nil {
+		t.Fatal(err)
+	}
+	if r := <-c; r.body != `{"resource": "web01", "trigger": "create"}` {
+		t.Errorf("unexpected body %s", r.body)
+	}
+
+	if _, err := New(KindWebhook, Config{URL: "ftp://example.com"}); err == nil {
+		t.Error("expected an error for an invalid url")
+	}
+	if _, err := New(KindWebhook, Config{URL: srv.URL, Body: "{{.Subject"}); err == nil {
+		t.Error("expected an error for an invalid template")
+	}
+}
+
+func TestWebhookError(t *testing.T) {
+	srv, c := recorder(t, http.StatusBadGateway)
+
+	s, err := New(KindWebhook, Config{URL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Send(context.Background(), message); err == nil || strings.Contains(err.Error(), "502") == false {
+		t.Errorf("expected a 502 error, got %v", err)
+	}
+	<-c
+}
+
+func TestSlack(t *testing.T) {
+	srv, c := recorder(t, http.StatusOK)
+
+	s, err := New(KindSlack, Config{URL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Send(context.Background(), message); err != nil {
+		t.Fatal(err)
+	}
+
+	var body map[string]string
+	if err := json.Unmarshal([]byte((<-c).body), &body); err != nil {
+		t.Fatal(err)
+	} else if body["text"] != "*[critical] Crashed on web01*\nThe web server crashed." {
+		t.Errorf("unexpected text %q", body["text"])
+	}
+}
+
+// smtpServer accepts a single mail and returns the commands and the data.
+func smtpServer(t *testing.T) (string, int, <-chan []string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	c := make(chan []string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		lines := make([]string, 0)
+		r := bufio.NewReader(conn)
+		reply := func(s string) { conn.Write([]byte(s + "\r\n")) }
+
+		reply("220 localhost ESMTP")
+		data := false
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				break
+			}
+			line = strings.TrimRight(line, "\r\n")
+			lines = append(lines, line)
+
+			if data {
+				if line == "." {
+					data = false
+					reply("250 OK")
+				}
+				continue
+			}
+
+			switch cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); cmd {
+			case "EHLO", "HELO":
+				reply("250 localhost")
+			case "DATA":
+				data = true
+				reply("354 Go ahead")
+			case "QUIT":
+				reply("221 Bye")
+				c <- lines
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+		c <- lines
+	}()
+
+	host, port, _ := net.SplitHostPort(l.Addr().String())
+	p, _ := strconv.Atoi(port)
+
+	return host, p, c
+}
+
+func TestEmail(t *testing.T) {
+	host, port, c := smtpServer(t)
+
+	s, err := New(KindEmail, Config{
+		Host:    host,
+		Port:    port,
+		From:    "Self-host <alerts@example.com>",
+		To:      []string{"ops@example.com", "oncall@example.com"},
+		Subject: "Alert: {{.Payload.resource}}",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Send(context.Background(), message); err != nil {
+		t.Fatal(err)
+	}
+
+	session := strings.Join(<-c, "\n")
+	for _, expected := range []string{
+		"MAIL FROM:<alerts@example.com>",
+		"RCPT TO:<ops@example.com>",
+		"RCPT TO:<oncall@example.com>",
+		"Subject: Alert: web01",
+		"The web server crashed.",
+	} {
+		if strings.Contains(session, expected) == false {
+			t.Errorf("expected %q in the session\n%s", expected, session)
+		}
+	}
+
+	if _, err := New(KindEmail, Config{Host: host, From: "alerts@example.com"}); err == nil {
+		t.Error("expected an error without a to address")
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"text/template"
+	"time"
+)
+
+// Slack sends messages to an incoming webhook as {"text": "..."}, which is
+// understood by Slack, Microsoft Teams and Mattermost among others.
+type Slack struct {
+	url  string
+	text *template.Template
+
+	client *http.Client
+}
+
+func NewSlack(endpoint, text string) (*Slack, error) {
+	if err := validURL(endpoint); err != nil {
+		return nil, err
+	}
+
+	t, err := parseTemplate("text", text)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Slack{
+		url:    endpoint,
+		text:   t,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+func (s *Slack) Send(ctx context.Context, m Message) error {
+	text, err := execute(s.text, m, "*"+m.Subject+"*\n"+m.Text)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+
+	return post(ctx, s.client, http.MethodPost, s.url, header, body)
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Webhook sends messages as HTTP requests. Without a body template the
+// message is sent as JSON.
+type Webhook struct {
+	url         string
+	method      string
+	headers     map[string]string
+	contentType string
+	body        *template.Template
+
+	client *http.Client
+}
+
+func NewWebhook(endpoint, method string, headers map[string]string, contentType, body string) (*Webhook, error) {
+	if err := validURL(endpoint); err != nil {
+		return nil, err
+	}
+
+	if method == "" {
+		method = http.MethodPost
+	}
+	method = strings.ToUpper(method)
+	if method != http.MethodPost && method != http.MethodPut {
+		return nil, fmt.Errorf("invalid webhook method %s", method)
+	}
+
+	t, err := parseTemplate("body", body)
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType == "" {
+		if t == nil {
+			contentType = "application/json"
+		} else {
+			contentType = "text/plain; charset=utf-8"
+		}
+	}
+
+	return &Webhook{
+		url:         endpoint,
+		method:      method,
+		headers:     headers,
+		contentType: contentType,
+		body:        t,
+		client:      &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+func (w *Webhook) Send(ctx context.Context, m Message) error {
+	var body []byte
+	if w.body == nil {
+		b, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+		body = b
+	} else {
+		s, err := execute(w.body, m, "")
+		if err != nil {
+			return err
+		}
+		body = []byte(s)
+	}
+
+	header := http.Header{}
+	for k, v := range w.headers {
+		header.Set(k, v)
+	}
+	header.Set("Content-Type", w.contentType)
+
+	return post(ctx, w.client, w.method, w.url, header, body)
+}
+
+func validURL(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid url %q", endpoint)
+	}
+	return nil
+}
+
+// post sends the request and fails unless the response is a 2xx.
+func post(ctx context.Context, client *http.Client, method, endpoint string, header http.Header, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header = header
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected response %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	// Drain the body to reuse the connection
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 65536))
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package workforce
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// A Polyglot is a worker which runs programs in some languages only. A worker
+// which is not a Polyglot is assumed to run programs in any language.
+type Polyglot interface {
+	Speaks(language string) bool
+}
+
+// A Weighted worker has a capacity relative to the other workers. A worker
+// which is not Weighted has a capacity of one.
+type Weighted interface {
+	Capacity() uint64
+}
+
+// Task is what a worker is selected to run.
+type Task struct {
+	Program  string // Identifies the program, for cache affinity
+	Language string
+}
+
+// Candidate is a worker which may be selected to run a task.
+type Candidate struct {
+	Id     string
+	Worker Worker
+}
+
+// A Strategy selects the worker to run a task among the candidates. The
+// candidates are never empty, are all alive and run the language of the task,
+// and are sorted by id.
+type Strategy interface {
+	Select(t Task, candidates []Candidate) Candidate
+}
+
+// An Affine strategy remembers which worker ran a program.
+type Affine interface {
+	Remember(program string, id string, expires time.Time)
+}
+
+// NewStrategy returns the strategy by name, one of least-load, round-robin
+// or weighted. With affinity, a program is sent to the worker which last ran
+// it for as long as the worker has it cached.
+func NewStrategy(name string, affinity bool) (Strategy, error) {
+	var s Strategy
+
+	switch name {
+	case "least-load":
+		s = &LeastLoad{}
+	case "round-robin":
+		s = &RoundRobin{}
+	case "weighted":
+		s = &WeightedLoad{}
+	default:
+		return nil, fmt.Errorf("unknown worker strategy: %v", name)
+	}
+
+	if affinity {
+		s = NewAffinity(s)
+	}
+
+	return s, nil
+}
+
+// LeastLoad selects the worker with the least load.
+type LeastLoad struct{}
+
+func (s *LeastLoad) Select(t Task, candidates []Candidate) Candidate {
+	selected := candidates[0]
+	for _, c := range candidates[1:] {
+		if c.Worker.GetLoad() < selected.Worker.GetLoad() {
+			selected = c
+		}
+	}
+	return selected
+}
+
+// RoundRobin selects each worker in turn.
+type RoundRobin struct {
+	sync.Mutex
+	next uint64
+}
+
+func (s *RoundRobin) Select(t Task, candidates []Candidate) Candidate {
+	s.Lock()
+	defer s.Unlock()
+
+	selected := candidates[s.next%uint64(len(candidates))]
+	s.next++
+	return selected
+}
+
+// WeightedLoad selects the worker with the least load relative to its
+// capacity.
+type WeightedLoad struct{}
+
+func (s *WeightedLoad) Select(t Task, candidates []Candidate) Candidate {
+	selected := candidates[0]
+	for _, c := range candidates[1:] {
+		// a/b < c/d as a*d < c*b, to stay clear of fractions
+		if c.Worker.GetLoad()*capacity(selected.Worker) < selected.Worker.GetLoad()*capacity(c.Worker) {
+			selected = c
+		}
+	}
+	return selected
+}
+
+func capacity(w Worker) uint64 {
+	if weighted, ok := w.(Weighted); ok && weighted.Capacity() > 0 {
+		return weighted.Capacity()
+	}
+	return 1
+}
+
+type affinity struct {
+	id      string
+	expires time.Time
+}
+
+// Affinity selects the worker which last ran the program while it has the
+// program cached, so it is not compiled again. Otherwise it leaves the
+// selection to the next strategy.
+type Affinity struct {
+	sync.Mutex
+	next Strategy
+	last map[string]affinity
+}
+
+func NewAffinity(next Strategy) *Affinity {
+	return &Affinity{
+		next: next,
+		last: make(map[string]affinity),
+	}
+}
+
+func (s *Affinity) Select(t Task, candidates []Candidate) Candidate {
+	s.Lock()
+	a, ok := s.last[t.Program]
+	s.Unlock()
+
+	if ok && time.Now().Before(a.expires) {
+		for _, c := range candidates {
+			if c.Id == a.id {
+				return c
+			}
+		}
+	}
+
+	return s.next.Select(t, candidates)
+}
+
+func (s *Affinity) Remember(program string, id string, expires time.Time) {
+	s.Lock()
+	defer s.Unlock()
+
+	// Forget what has expired, as to not grow without bounds
+	now := time.Now()
+	for k, v := range s.last {
+		if now.After(v.expires) {
+			delete(s.last, k)
+		}
+	}
+
+	if now.Before(expires) {
+		s.last[program] = affinity{id: id, expires: expires}
+	} else {
+		delete(s.last, program)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 The Self-host Authors. All rights reserved.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package workforce
+
+import (
+	"testing"
+	"time"
+)
+
+type worker struct {
+	load      uint64
+	capacity  uint64
+	languages []string
+}
+
+func (w *worker) Alive() bool      { return true }
+func (w *worker) SetLoad(l uint64) { w.load = l }
+func (w *worker) GetLoad() uint64  { return w.load }
+func (w *worker) Capacity() uint64 { return w.capacity }
+func (w *worker) Speaks(l string) bool {
+	for _, lang := range w.languages {
+		if lang == l {
+			return true
+		}
+	}
+	return false
+}
+
+func newTestWorkforce(s Strategy) *Workforce {
+	c := NewWorkforce()
+	c.SetStrategy(s)
+	c.Add("a", &worker{load: 400, capacity: 4, languages: []string{"tengo"}})
+	c.Add("b", &worker{load: 200, capacity: 1, languages: []string{"tengo"}})
+	c.Add("c", &worker{load: 0, capacity: 1, languages: []string{"lua"}})
+	return c
+}
+
+func TestStrategies(t *testing.T) {
+	tengo := Task{Program: "test/1", Language: "tengo"}
+
+	cases := []struct {
+		name     string
+		strategy Strategy
+		task     Task
+		ids      []string
+	}{
+		{"least-load", &LeastLoad{}, tengo, []string{"b", "b"}},
+		{"least-load any language", &LeastLoad{}, Task{Program: "test/1"}, []string{"c"}},
+		{"round-robin", &RoundRobin{}, tengo, []string{"a", "b", "a"}},
+		{"weighted", &WeightedLoad{}, tengo, []string{"a", "a"}},
+		{"lua", &RoundRobin{}, Task{Program: "test/2", Language: "lua"}, []string{"c", "c"}},
+	}
+
+	for _, c := range cases {
+		wf := newTestWorkforce(c.strategy)
+		for i, expected := range c.ids {
+			id, _, err := wf.Select(c.task)
+			if err != nil {
+				t.Fatalf("%v: %v", c.name, err)
+			}
+			if id != expected {
+				t.Errorf("%v: selection %d, expected %v, got %v", c.name, i, expected, id)
+			}
+		}
+	}
+
+	wf := newTestWorkforce(&LeastLoad{})
+	if _, _, err := wf.Select(Task{Program: "test/3", Language: "python"}); err == nil {
+		t.Errorf("expected no available worker for python")
+	}
+}
+
+func TestAffinity(t *testing.T) {
+	wf := newTestWorkforce(NewAffinity(&LeastLoad{}))
+	task := Task{Program: "test/1", Language: "tengo"}
+
+	if id, _, _ := wf.Select(task); id != "b" {
+		t.Fatalf("expected b, got %v", id)
+	}
+
+	// The program is cached on a, which is kept while the cache is valid
+	wf.Ran(task.Program, "a", time.Now().Add(time.Minute))
+	if id, _, _ := wf.Select(task); id != "a" {
+		t.Errorf("expected a while cached, got %v", id)
+	}
+
+	// Other programs are not affected
+	if id, _, _ := wf.Select(Task{Program: "test/2", Language: "tengo"}); id != "b" {
+		t.Errorf("expected b for another program, got %v", id)
+	}
+
+	// Once expired, the next strategy selects
+	wf.Ran(task.Program, "a", time.Now().Add(-time.Second))
+	if id, _, _ := wf.Select(task); id != "b" {
+		t.Errorf("expected b once expired, got %v", id)
+	}
+
+	// A worker gone is not selected, even if it has the program cached
+	wf.Ran(task.Program, "a", time.Now().Add(time.Minute))
+	wf.Delete("a")
+	if id, _, _ := wf.Select(task); id != "b" {
+		t.Errorf("expected b once a is gone, got %v", id)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 )
 
 type Worker interface {
@@ -30,7 +31,8 @@ func (l ByLoad) Swap(i, j int) {
 
 type Workforce struct {
 	sync.RWMutex
-	workers map[string]Worker
+	workers  map[string]Worker
+	strategy Strategy
 }
 
 var (
@@ -43,7 +45,8 @@ func init() {
 
 func NewWorkforce() *Workforce {
 	return &Workforce{
-		workers: make(map[string]Worker),
+		workers:  make(map[string]Worker),
+		strategy: &LeastLoad{},
 	}
 }
 
@@ -96,6 +99,54 @@ func (c *Workforce) GetAvailable() (Worker, error) {
 	return nil, fmt.Errorf("no available worker")
 }
 
+func (c *Workforce) SetStrategy(s Strategy) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.strategy = s
+}
+
+// Select returns the id of the worker selected by the strategy to run the
+// task, among the workers which are alive and run the language of the task.
+func (c *Workforce) Select(t Task) (string, Worker, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	candidates := make([]Candidate, 0)
+
+	for id, worker := range c.workers {
+		if worker.Alive() == false {
+			continue
+		}
+		if p, ok := worker.(Polyglot); ok && t.Language != "" && p.Speaks(t.Language) == false {
+			continue
+		}
+		candidates = append(candidates, Candidate{Id: id, Worker: worker})
+	}
+
+	if len(candidates) == 0 {
+		return "", nil, fmt.Errorf("no available worker for language: %v", t.Language)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Id < candidates[j].Id
+	})
+
+	selected := c.strategy.Select(t, candidates)
+	return selected.Id, selected.Worker, nil
+}
+
+// Ran tells the strategy that the worker ran the program, which it has
+// cached until it expires.
+func (c *Workforce) Ran(program string, id string, expires time.Time) {
+	c.RLock()
+	defer c.RUnlock()
+
+	if a, ok := c.strategy.(Affine); ok {
+		a.Remember(program, id, expires)
+	}
+}
+
 func (c *Workforce) SetLoad(id string, l uint64) {
 	c.Lock()
 	defer c.Unlock()
@@ -146,6 +197,18 @@ func Exists(id string) bool {
 	return wforce.Exists(id)
 }
 
+func SetStrategy(s Strategy) {
+	wforce.SetStrategy(s)
+}
+
+func Select(t Task) (string, Worker, error) {
+	return wforce.Select(t)
+}
+
+func Ran(program string, id string, expires time.Time) {
+	wforce.Ran(program, id, expires)
+}
+
 func SetLoad(id string, l uint64) {
 	wforce.SetLoad(id, l)
 }
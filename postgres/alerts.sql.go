@@ -25,7 +25,8 @@ SELECT  alert_merge(
         $9::text,
 	$10::text[],
 	$11::integer,
-	$12::bytea
+	$12::bytea,
+	NULLIF($13::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
 )::UUID AS uuid LIMIT 1
 `
 
@@ -42,6 +43,7 @@ type CreateAlertParams struct {
 	Tags        []string
 	Timeout     int32
 	Rawdata     []byte
+	CreatedBy   uuid.UUID
 }
 
 func (q *Queries) CreateAlert(ctx context.Context, arg CreateAlertParams) (uuid.UUID, error) {
@@ -58,12 +60,46 @@ func (q *Queries) CreateAlert(ctx context.Context, arg CreateAlertParams) (uuid.
 		pq.Array(arg.Tags),
 		arg.Timeout,
 		arg.Rawdata,
+		arg.CreatedBy,
 	)
 	var uuid uuid.UUID
 	err := row.Scan(&uuid)
 	return uuid, err
 }
 
+const createAlertHistory = `-- name: CreateAlertHistory :exec
+INSERT INTO alert_history (alert_uuid, change, from_value, to_value, note, changed_by)
+VALUES (
+	$1,
+	$2,
+	$3,
+	$4,
+	$5,
+	NULLIF($6::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
+)
+`
+
+type CreateAlertHistoryParams struct {
+	AlertUuid uuid.UUID
+	Change    string
+	FromValue string
+	ToValue   string
+	Note      string
+	ChangedBy uuid.UUID
+}
+
+func (q *Queries) CreateAlertHistory(ctx context.Context, arg CreateAlertHistoryParams) error {
+	_, err := q.exec(ctx, q.createAlertHistoryStmt, createAlertHistory,
+		arg.AlertUuid,
+		arg.Change,
+		arg.FromValue,
+		arg.ToValue,
+		arg.Note,
+		arg.ChangedBy,
+	)
+	return err
+}
+
 const deleteAlert = `-- name: DeleteAlert :execrows
 DELETE FROM alerts
 WHERE alerts.uuid = $1
@@ -90,8 +126,53 @@ func (q *Queries) ExistsAlert(ctx context.Context, uuid uuid.UUID) (int64, error
 	return count, err
 }
 
+const expireAlerts = `-- name: ExpireAlerts :many
+WITH expired AS (
+	SELECT uuid, status
+	FROM alerts
+	WHERE status IN ('open'::alert_status, 'acknowledge'::alert_status)
+	AND COALESCE(last_receive_time, created) + make_interval(secs => timeout) < NOW()
+	ORDER BY COALESCE(last_receive_time, created) + make_interval(secs => timeout)
+	LIMIT $1::BIGINT
+	FOR UPDATE SKIP LOCKED
+), updated AS (
+	UPDATE alerts
+	SET status = 'expire'::alert_status
+	FROM expired
+	WHERE alerts.uuid = expired.uuid
+	RETURNING alerts.uuid, expired.status
+)
+INSERT INTO alert_history (alert_uuid, change, from_value, to_value, note)
+SELECT updated.uuid, 'status', updated.status::TEXT, 'expire', 'Expired by timeout'
+FROM updated
+RETURNING alert_uuid
+`
+
+func (q *Queries) ExpireAlerts(ctx context.Context, argLimit int64) ([]uuid.UUID, error) {
+	rows, err := q.query(ctx, q.expireAlertsStmt, expireAlerts, argLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var alert_uuid uuid.UUID
+		if err := rows.Scan(&alert_uuid); err != nil {
+			return nil, err
+		}
+		items = append(items, alert_uuid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findAlertByUUID = `-- name: FindAlertByUUID :one
-SELECT uuid, resource, environment, event, severity, previous_severity, status, description, value, origin, created, last_receive_time, timeout, duplicate, service, tags, rawdata
+SELECT uuid, resource, environment, event, severity, previous_severity, status, description, value, origin, created, last_receive_time, timeout, duplicate, service, tags, rawdata, shelved_until, silence_uuid
 FROM v_alerts
 WHERE uuid = $1
 `
@@ -117,10 +198,59 @@ func (q *Queries) FindAlertByUUID(ctx context.Context, uuid uuid.UUID) (VAlert,
 		pq.Array(&i.Service),
 		pq.Array(&i.Tags),
 		&i.Rawdata,
+		&i.ShelvedUntil,
+		&i.SilenceUuid,
 	)
 	return i, err
 }
 
+const findAlertHistory = `-- name: FindAlertHistory :many
+SELECT id, alert_uuid, change, from_value, to_value, note, changed, changed_by
+FROM alert_history
+WHERE alert_history.alert_uuid = $1
+ORDER BY changed DESC, id DESC
+LIMIT $2::BIGINT
+OFFSET $3::BIGINT
+`
+
+type FindAlertHistoryParams struct {
+	AlertUuid uuid.UUID
+	ArgLimit  int64
+	ArgOffset int64
+}
+
+func (q *Queries) FindAlertHistory(ctx context.Context, arg FindAlertHistoryParams) ([]AlertHistory, error) {
+	rows, err := q.query(ctx, q.findAlertHistoryStmt, findAlertHistory, arg.AlertUuid, arg.ArgLimit, arg.ArgOffset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AlertHistory{}
+	for rows.Next() {
+		var i AlertHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.AlertUuid,
+			&i.Change,
+			&i.FromValue,
+			&i.ToValue,
+			&i.Note,
+			&i.Changed,
+			&i.ChangedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findAlerts = `-- name: FindAlerts :many
 WITH severity_levels AS (
 	SELECT name::alert_severity, level::int FROM (
@@ -153,7 +283,9 @@ SELECT
 	v_alerts.tags,
 	v_alerts.timeout,
 	v_alerts.uuid,
-	v_alerts.value
+	v_alerts.value,
+	v_alerts.shelved_until,
+	v_alerts.silence_uuid
 FROM v_alerts, severity_levels
 WHERE v_alerts.severity = severity_levels.name
 AND (
@@ -218,9 +350,14 @@ AND (
 	OR
 	$10::TEXT[] && v_alerts.tags
 )
+AND (
+	$11::BOOLEAN IS NULL
+	OR
+	(v_alerts.status = 'silence'::alert_status) = $11::BOOLEAN
+)
 ORDER BY resource, environment, event
-LIMIT $12::BIGINT
-OFFSET $11::BIGINT
+LIMIT $13::BIGINT
+OFFSET $12::BIGINT
 `
 
 type FindAlertsParams struct {
@@ -234,6 +371,7 @@ type FindAlertsParams struct {
 	SeverityEq  string
 	Service     []string
 	Tags        []string
+	Silenced    sql.NullBool
 	ArgOffset   int64
 	ArgLimit    int64
 }
@@ -256,6 +394,8 @@ type FindAlertsRow struct {
 	Timeout          int32
 	Uuid             uuid.UUID
 	Value            string
+	ShelvedUntil     sql.NullTime
+	SilenceUuid      uuid.UUID
 }
 
 func (q *Queries) FindAlerts(ctx context.Context, arg FindAlertsParams) ([]FindAlertsRow, error) {
@@ -270,6 +410,7 @@ func (q *Queries) FindAlerts(ctx context.Context, arg FindAlertsParams) ([]FindA
 		arg.SeverityEq,
 		pq.Array(arg.Service),
 		pq.Array(arg.Tags),
+		arg.Silenced,
 		arg.ArgOffset,
 		arg.ArgLimit,
 	)
@@ -298,6 +439,8 @@ func (q *Queries) FindAlerts(ctx context.Context, arg FindAlertsParams) ([]FindA
 			&i.Timeout,
 			&i.Uuid,
 			&i.Value,
+			&i.ShelvedUntil,
+			&i.SilenceUuid,
 		); err != nil {
 			return nil, err
 		}
@@ -312,6 +455,93 @@ func (q *Queries) FindAlerts(ctx context.Context, arg FindAlertsParams) ([]FindA
 	return items, nil
 }
 
+const findCurrentAlertStatusChanges = `-- name: FindCurrentAlertStatusChanges :many
+SELECT id, alert_uuid, change, from_value, to_value, note, changed, changed_by
+FROM alert_history
+WHERE alert_uuid = $1
+AND change = 'status'
+AND changed = NOW()
+ORDER BY id
+`
+
+// The status changes made by the current transaction
+func (q *Queries) FindCurrentAlertStatusChanges(ctx context.Context, alertUuid uuid.UUID) ([]AlertHistory, error) {
+	rows, err := q.query(ctx, q.findCurrentAlertStatusChangesStmt, findCurrentAlertStatusChanges, alertUuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AlertHistory{}
+	for rows.Next() {
+		var i AlertHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.AlertUuid,
+			&i.Change,
+			&i.FromValue,
+			&i.ToValue,
+			&i.Note,
+			&i.Changed,
+			&i.ChangedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unshelveAlerts = `-- name: UnshelveAlerts :many
+WITH unshelved AS (
+	SELECT uuid
+	FROM alerts
+	WHERE status = 'shelve'::alert_status
+	AND shelved_until < NOW()
+	ORDER BY shelved_until
+	LIMIT $1::BIGINT
+	FOR UPDATE SKIP LOCKED
+), updated AS (
+	UPDATE alerts
+	SET status = 'open'::alert_status, shelved_until = NULL
+	FROM unshelved
+	WHERE alerts.uuid = unshelved.uuid
+	RETURNING alerts.uuid
+)
+INSERT INTO alert_history (alert_uuid, change, from_value, to_value, note)
+SELECT updated.uuid, 'status', 'shelve', 'open', 'Shelve expired'
+FROM updated
+RETURNING alert_uuid
+`
+
+func (q *Queries) UnshelveAlerts(ctx context.Context, argLimit int64) ([]uuid.UUID, error) {
+	rows, err := q.query(ctx, q.unshelveAlertsStmt, unshelveAlerts, argLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var alert_uuid uuid.UUID
+		if err := rows.Scan(&alert_uuid); err != nil {
+			return nil, err
+		}
+		items = append(items, alert_uuid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateAlertIncDuplicate = `-- name: UpdateAlertIncDuplicate :execrows
 UPDATE alerts
 SET duplicate = duplicate + 1
@@ -496,6 +726,25 @@ func (q *Queries) UpdateAlertSetSeverity(ctx context.Context, arg UpdateAlertSet
 	return result.RowsAffected()
 }
 
+const updateAlertSetShelvedUntil = `-- name: UpdateAlertSetShelvedUntil :execrows
+UPDATE alerts
+SET shelved_until = $1
+WHERE uuid = $2
+`
+
+type UpdateAlertSetShelvedUntilParams struct {
+	ShelvedUntil sql.NullTime
+	Uuid         uuid.UUID
+}
+
+func (q *Queries) UpdateAlertSetShelvedUntil(ctx context.Context, arg UpdateAlertSetShelvedUntilParams) (int64, error) {
+	result, err := q.exec(ctx, q.updateAlertSetShelvedUntilStmt, updateAlertSetShelvedUntil, arg.ShelvedUntil, arg.Uuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateAlertSetStatus = `-- name: UpdateAlertSetStatus :execrows
 UPDATE alerts
 SET status = $1
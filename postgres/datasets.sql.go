@@ -5,6 +5,8 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,17 +15,21 @@ import (
 
 const createDataset = `-- name: CreateDataset :one
 WITH ds AS (
-	INSERT INTO datasets (name, format, content, checksum, size, belongs_to, created_by, updated_by, tags)
+	INSERT INTO datasets (name, format, content, checksum, size, storage, compression, stored_size, belongs_to, created_by, updated_by, tags, max_revisions)
 	VALUES(
 		$1::text,
 		$2::text,
 		$3::bytea,
-		sha256($3::bytea),
-		length($3)::integer,
-		NULLIF($4::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
-		$5::uuid,
-		$5::uuid,
-		$6
+		decode($4::text, 'hex'),
+		$5::bigint,
+		$6::text,
+		$7::text,
+		$8::bigint,
+		NULLIF($9::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
+		$10::uuid,
+		$10::uuid,
+		$11,
+		NULLIF($12::integer, 0)
 	)
 	RETURNING
 		uuid,
@@ -36,7 +42,10 @@ WITH ds AS (
 		updated,
 		created_by,
 		updated_by,
-		tags
+		tags,
+		max_revisions,
+		stored_size,
+		compression
 ), grp AS (
 	SELECT groups.uuid
 	FROM groups, user_groups
@@ -60,31 +69,40 @@ WITH ds AS (
 		(SELECT uuid FROM grp), 0, 'allow', 'delete','datasets/'||(SELECT uuid FROM ds)||'/%'
 	)
 )
-SELECT uuid, name, format, checksum, size, belongs_to, created, updated, created_by, updated_by, tags
+SELECT uuid, name, format, checksum, size, belongs_to, created, updated, created_by, updated_by, tags, max_revisions, stored_size, compression
 FROM ds LIMIT 1
 `
 
 type CreateDatasetParams struct {
-	Name      string
-	Format    string
-	Content   []byte
-	BelongsTo uuid.UUID
-	CreatedBy uuid.UUID
-	Tags      []string
+	Name         string
+	Format       string
+	Content      []byte
+	Checksum     string
+	Size         int64
+	Storage      string
+	Compression  string
+	StoredSize   int64
+	BelongsTo    uuid.UUID
+	CreatedBy    uuid.UUID
+	Tags         []string
+	MaxRevisions int32
 }
 
 type CreateDatasetRow struct {
-	Uuid      uuid.UUID
-	Name      string
-	Format    string
-	Checksum  string
-	Size      int32
-	BelongsTo uuid.UUID
-	Created   time.Time
-	Updated   time.Time
-	CreatedBy uuid.UUID
-	UpdatedBy uuid.UUID
-	Tags      []string
+	Uuid         uuid.UUID
+	Name         string
+	Format       string
+	Checksum     string
+	Size         int64
+	BelongsTo    uuid.UUID
+	Created      time.Time
+	Updated      time.Time
+	CreatedBy    uuid.UUID
+	UpdatedBy    uuid.UUID
+	Tags         []string
+	MaxRevisions sql.NullInt32
+	StoredSize   int64
+	Compression  string
 }
 
 func (q *Queries) CreateDataset(ctx context.Context, arg CreateDatasetParams) (CreateDatasetRow, error) {
@@ -92,9 +110,15 @@ func (q *Queries) CreateDataset(ctx context.Context, arg CreateDatasetParams) (C
 		arg.Name,
 		arg.Format,
 		arg.Content,
+		arg.Checksum,
+		arg.Size,
+		arg.Storage,
+		arg.Compression,
+		arg.StoredSize,
 		arg.BelongsTo,
 		arg.CreatedBy,
 		pq.Array(arg.Tags),
+		arg.MaxRevisions,
 	)
 	var i CreateDatasetRow
 	err := row.Scan(
@@ -109,6 +133,9 @@ func (q *Queries) CreateDataset(ctx context.Context, arg CreateDatasetParams) (C
 		&i.CreatedBy,
 		&i.UpdatedBy,
 		pq.Array(&i.Tags),
+		&i.MaxRevisions,
+		&i.StoredSize,
+		&i.Compression,
 	)
 	return i, err
 }
@@ -126,6 +153,21 @@ func (q *Queries) DeleteDataset(ctx context.Context, uuid uuid.UUID) (int64, err
 	return result.RowsAffected()
 }
 
+const deleteDatasetSchemaByUUID = `-- name: DeleteDatasetSchemaByUUID :execrows
+UPDATE datasets
+SET schema = NULL
+WHERE datasets.uuid = $1
+AND datasets.schema IS NOT NULL
+`
+
+func (q *Queries) DeleteDatasetSchemaByUUID(ctx context.Context, uuid uuid.UUID) (int64, error) {
+	result, err := q.exec(ctx, q.deleteDatasetSchemaByUUIDStmt, deleteDatasetSchemaByUUID, uuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const existsDataset = `-- name: ExistsDataset :one
 SELECT COUNT(*) AS count
 FROM datasets
@@ -151,24 +193,30 @@ SELECT
 	updated,
 	created_by,
 	updated_by,
-	tags
+	tags,
+	max_revisions,
+	stored_size,
+	compression
 FROM datasets
 WHERE datasets.belongs_to = $1
 ORDER BY name
 `
 
 type FindDatasetByThingRow struct {
-	Uuid      uuid.UUID
-	Name      string
-	Format    string
-	Checksum  string
-	Size      int32
-	BelongsTo uuid.UUID
-	Created   time.Time
-	Updated   time.Time
-	CreatedBy uuid.UUID
-	UpdatedBy uuid.UUID
-	Tags      []string
+	Uuid         uuid.UUID
+	Name         string
+	Format       string
+	Checksum     string
+	Size         int64
+	BelongsTo    uuid.UUID
+	Created      time.Time
+	Updated      time.Time
+	CreatedBy    uuid.UUID
+	UpdatedBy    uuid.UUID
+	Tags         []string
+	MaxRevisions sql.NullInt32
+	StoredSize   int64
+	Compression  string
 }
 
 func (q *Queries) FindDatasetByThing(ctx context.Context, thingUuid uuid.UUID) ([]FindDatasetByThingRow, error) {
@@ -192,6 +240,9 @@ func (q *Queries) FindDatasetByThing(ctx context.Context, thingUuid uuid.UUID) (
 			&i.CreatedBy,
 			&i.UpdatedBy,
 			pq.Array(&i.Tags),
+			&i.MaxRevisions,
+			&i.StoredSize,
+			&i.Compression,
 		); err != nil {
 			return nil, err
 		}
@@ -218,24 +269,30 @@ SELECT
 	updated,
 	created_by,
 	updated_by,
-	tags
+	tags,
+	max_revisions,
+	stored_size,
+	compression
 FROM datasets
 WHERE datasets.uuid = $1
 LIMIT 1
 `
 
 type FindDatasetByUUIDRow struct {
-	Uuid      uuid.UUID
-	Name      string
-	Format    string
-	Checksum  string
-	Size      int32
-	BelongsTo uuid.UUID
-	Created   time.Time
-	Updated   time.Time
-	CreatedBy uuid.UUID
-	UpdatedBy uuid.UUID
-	Tags      []string
+	Uuid         uuid.UUID
+	Name         string
+	Format       string
+	Checksum     string
+	Size         int64
+	BelongsTo    uuid.UUID
+	Created      time.Time
+	Updated      time.Time
+	CreatedBy    uuid.UUID
+	UpdatedBy    uuid.UUID
+	Tags         []string
+	MaxRevisions sql.NullInt32
+	StoredSize   int64
+	Compression  string
 }
 
 func (q *Queries) FindDatasetByUUID(ctx context.Context, uuid uuid.UUID) (FindDatasetByUUIDRow, error) {
@@ -253,10 +310,96 @@ func (q *Queries) FindDatasetByUUID(ctx context.Context, uuid uuid.UUID) (FindDa
 		&i.CreatedBy,
 		&i.UpdatedBy,
 		pq.Array(&i.Tags),
+		&i.MaxRevisions,
+		&i.StoredSize,
+		&i.Compression,
 	)
 	return i, err
 }
 
+const findDatasetRevisions = `-- name: FindDatasetRevisions :many
+SELECT
+	revision, created, created_by, encode(checksum, 'hex') AS checksum, size
+FROM
+	dataset_revisions
+WHERE
+	dataset_uuid = $1
+ORDER BY revision ASC
+`
+
+type FindDatasetRevisionsRow struct {
+	Revision  int32
+	Created   time.Time
+	CreatedBy uuid.UUID
+	Checksum  string
+	Size      int64
+}
+
+func (q *Queries) FindDatasetRevisions(ctx context.Context, datasetUuid uuid.UUID) ([]FindDatasetRevisionsRow, error) {
+	rows, err := q.query(ctx, q.findDatasetRevisionsStmt, findDatasetRevisions, datasetUuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindDatasetRevisionsRow{}
+	for rows.Next() {
+		var i FindDatasetRevisionsRow
+		if err := rows.Scan(
+			&i.Revision,
+			&i.Created,
+			&i.CreatedBy,
+			&i.Checksum,
+			&i.Size,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findDatasetRevisionsByStorage = `-- name: FindDatasetRevisionsByStorage :many
+SELECT dataset_uuid, revision, encode(checksum, 'hex') AS checksum
+FROM dataset_revisions
+WHERE dataset_revisions.storage = $1
+ORDER BY dataset_uuid, revision
+`
+
+type FindDatasetRevisionsByStorageRow struct {
+	DatasetUuid uuid.UUID
+	Revision    int32
+	Checksum    string
+}
+
+func (q *Queries) FindDatasetRevisionsByStorage(ctx context.Context, storage string) ([]FindDatasetRevisionsByStorageRow, error) {
+	rows, err := q.query(ctx, q.findDatasetRevisionsByStorageStmt, findDatasetRevisionsByStorage, storage)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindDatasetRevisionsByStorageRow
+	for rows.Next() {
+		var i FindDatasetRevisionsByStorageRow
+		if err := rows.Scan(&i.DatasetUuid, &i.Revision, &i.Checksum); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findDatasets = `-- name: FindDatasets :many
 WITH usr AS (
 	SELECT users.uuid
@@ -282,7 +425,10 @@ SELECT
 	updated,
 	created_by,
 	updated_by,
-	tags
+	tags,
+	max_revisions,
+	stored_size,
+	compression
 FROM datasets
 WHERE 'datasets/'||datasets.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'allow')
@@ -299,7 +445,10 @@ SELECT
 	updated,
 	created_by,
 	updated_by,
-	tags
+	tags,
+	max_revisions,
+	stored_size,
+	compression
 FROM datasets
 WHERE 'datasets/'||datasets.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'deny')
@@ -316,17 +465,20 @@ type FindDatasetsParams struct {
 }
 
 type FindDatasetsRow struct {
-	Uuid      uuid.UUID
-	Name      string
-	Format    string
-	Checksum  string
-	Size      int32
-	BelongsTo uuid.UUID
-	Created   time.Time
-	Updated   time.Time
-	CreatedBy uuid.UUID
-	UpdatedBy uuid.UUID
-	Tags      []string
+	Uuid         uuid.UUID
+	Name         string
+	Format       string
+	Checksum     string
+	Size         int64
+	BelongsTo    uuid.UUID
+	Created      time.Time
+	Updated      time.Time
+	CreatedBy    uuid.UUID
+	UpdatedBy    uuid.UUID
+	Tags         []string
+	MaxRevisions sql.NullInt32
+	StoredSize   int64
+	Compression  string
 }
 
 func (q *Queries) FindDatasets(ctx context.Context, arg FindDatasetsParams) ([]FindDatasetsRow, error) {
@@ -350,6 +502,9 @@ func (q *Queries) FindDatasets(ctx context.Context, arg FindDatasetsParams) ([]F
 			&i.CreatedBy,
 			&i.UpdatedBy,
 			pq.Array(&i.Tags),
+			&i.MaxRevisions,
+			&i.StoredSize,
+			&i.Compression,
 		); err != nil {
 			return nil, err
 		}
@@ -364,6 +519,41 @@ func (q *Queries) FindDatasets(ctx context.Context, arg FindDatasetsParams) ([]F
 	return items, nil
 }
 
+const findDatasetsByStorage = `-- name: FindDatasetsByStorage :many
+SELECT uuid, encode(checksum, 'hex') AS checksum
+FROM datasets
+WHERE datasets.storage = $1
+ORDER BY uuid
+`
+
+type FindDatasetsByStorageRow struct {
+	Uuid     uuid.UUID
+	Checksum string
+}
+
+func (q *Queries) FindDatasetsByStorage(ctx context.Context, storage string) ([]FindDatasetsByStorageRow, error) {
+	rows, err := q.query(ctx, q.findDatasetsByStorageStmt, findDatasetsByStorage, storage)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindDatasetsByStorageRow
+	for rows.Next() {
+		var i FindDatasetsByStorageRow
+		if err := rows.Scan(&i.Uuid, &i.Checksum); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findDatasetsByTags = `-- name: FindDatasetsByTags :many
 WITH usr AS (
 	SELECT users.uuid
@@ -389,7 +579,10 @@ SELECT
 	updated,
 	created_by,
 	updated_by,
-	tags
+	tags,
+	max_revisions,
+	stored_size,
+	compression
 FROM datasets
 WHERE 'datasets/'||datasets.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'allow')
@@ -407,7 +600,10 @@ SELECT
 	updated,
 	created_by,
 	updated_by,
-	tags
+	tags,
+	max_revisions,
+	stored_size,
+	compression
 FROM datasets
 WHERE 'datasets/'||datasets.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'deny')
@@ -426,17 +622,20 @@ type FindDatasetsByTagsParams struct {
 }
 
 type FindDatasetsByTagsRow struct {
-	Uuid      uuid.UUID
-	Name      string
-	Format    string
-	Checksum  string
-	Size      int32
-	BelongsTo uuid.UUID
-	Created   time.Time
-	Updated   time.Time
-	CreatedBy uuid.UUID
-	UpdatedBy uuid.UUID
-	Tags      []string
+	Uuid         uuid.UUID
+	Name         string
+	Format       string
+	Checksum     string
+	Size         int64
+	BelongsTo    uuid.UUID
+	Created      time.Time
+	Updated      time.Time
+	CreatedBy    uuid.UUID
+	UpdatedBy    uuid.UUID
+	Tags         []string
+	MaxRevisions sql.NullInt32
+	StoredSize   int64
+	Compression  string
 }
 
 func (q *Queries) FindDatasetsByTags(ctx context.Context, arg FindDatasetsByTagsParams) ([]FindDatasetsByTagsRow, error) {
@@ -465,6 +664,101 @@ func (q *Queries) FindDatasetsByTags(ctx context.Context, arg FindDatasetsByTags
 			&i.CreatedBy,
 			&i.UpdatedBy,
 			pq.Array(&i.Tags),
+			&i.MaxRevisions,
+			&i.StoredSize,
+			&i.Compression,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findDatasetsByThings = `-- name: FindDatasetsByThings :many
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256($1)
+	LIMIT 1
+)
+SELECT
+	uuid,
+	name,
+	format,
+	encode(checksum, 'hex') AS checksum,
+	size,
+	belongs_to,
+	created,
+	updated,
+	created_by,
+	updated_by,
+	tags,
+	max_revisions,
+	stored_size,
+	compression
+FROM datasets
+WHERE datasets.belongs_to = ANY($2::UUID[])
+AND user_has_access((SELECT uuid FROM usr), 'read', 'things/'||datasets.belongs_to||'/datasets')
+AND user_has_access((SELECT uuid FROM usr), 'read', 'datasets/'||datasets.uuid)
+ORDER BY name
+`
+
+type FindDatasetsByThingsParams struct {
+	Token      []byte
+	ThingUuids []uuid.UUID
+}
+
+type FindDatasetsByThingsRow struct {
+	Uuid         uuid.UUID
+	Name         string
+	Format       string
+	Checksum     string
+	Size         int64
+	BelongsTo    uuid.UUID
+	Created      time.Time
+	Updated      time.Time
+	CreatedBy    uuid.UUID
+	UpdatedBy    uuid.UUID
+	Tags         []string
+	MaxRevisions sql.NullInt32
+	StoredSize   int64
+	Compression  string
+}
+
+// The datasets of the things, which the user of the token may list as those
+// of the thing and read.
+func (q *Queries) FindDatasetsByThings(ctx context.Context, arg FindDatasetsByThingsParams) ([]FindDatasetsByThingsRow, error) {
+	rows, err := q.query(ctx, q.findDatasetsByThingsStmt, findDatasetsByThings, arg.Token, pq.Array(arg.ThingUuids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindDatasetsByThingsRow{}
+	for rows.Next() {
+		var i FindDatasetsByThingsRow
+		if err := rows.Scan(
+			&i.Uuid,
+			&i.Name,
+			&i.Format,
+			&i.Checksum,
+			&i.Size,
+			&i.BelongsTo,
+			&i.Created,
+			&i.Updated,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			pq.Array(&i.Tags),
+			&i.MaxRevisions,
+			&i.StoredSize,
+			&i.Compression,
 		); err != nil {
 			return nil, err
 		}
@@ -479,40 +773,240 @@ func (q *Queries) FindDatasetsByTags(ctx context.Context, arg FindDatasetsByTags
 	return items, nil
 }
 
+const getDatasetContentAtHead = `-- name: GetDatasetContentAtHead :one
+SELECT datasets.format, dataset_revisions.content, encode(dataset_revisions.checksum, 'hex') AS checksum, dataset_revisions.storage, dataset_revisions.compression, dataset_revisions.revision
+FROM dataset_revisions, datasets
+WHERE dataset_revisions.dataset_uuid = datasets.uuid
+AND dataset_revisions.dataset_uuid = $1
+ORDER BY dataset_revisions.revision DESC
+LIMIT 1
+`
+
+type GetDatasetContentAtHeadRow struct {
+	Format      string
+	Content     []byte
+	Checksum    string
+	Storage     string
+	Compression string
+	Revision    int32
+}
+
+func (q *Queries) GetDatasetContentAtHead(ctx context.Context, datasetUuid uuid.UUID) (GetDatasetContentAtHeadRow, error) {
+	row := q.queryRow(ctx, q.getDatasetContentAtHeadStmt, getDatasetContentAtHead, datasetUuid)
+	var i GetDatasetContentAtHeadRow
+	err := row.Scan(
+		&i.Format,
+		&i.Content,
+		&i.Checksum,
+		&i.Storage,
+		&i.Compression,
+		&i.Revision,
+	)
+	return i, err
+}
+
+const getDatasetContentAtRevision = `-- name: GetDatasetContentAtRevision :one
+SELECT datasets.format, dataset_revisions.content, encode(dataset_revisions.checksum, 'hex') AS checksum, dataset_revisions.storage, dataset_revisions.compression
+FROM dataset_revisions, datasets
+WHERE dataset_revisions.dataset_uuid = datasets.uuid
+AND dataset_revisions.dataset_uuid = $1
+AND dataset_revisions.revision = $2
+LIMIT 1
+`
+
+type GetDatasetContentAtRevisionParams struct {
+	DatasetUuid uuid.UUID
+	Revision    int32
+}
+
+type GetDatasetContentAtRevisionRow struct {
+	Format      string
+	Content     []byte
+	Checksum    string
+	Storage     string
+	Compression string
+}
+
+func (q *Queries) GetDatasetContentAtRevision(ctx context.Context, arg GetDatasetContentAtRevisionParams) (GetDatasetContentAtRevisionRow, error) {
+	row := q.queryRow(ctx, q.getDatasetContentAtRevisionStmt, getDatasetContentAtRevision, arg.DatasetUuid, arg.Revision)
+	var i GetDatasetContentAtRevisionRow
+	err := row.Scan(
+		&i.Format,
+		&i.Content,
+		&i.Checksum,
+		&i.Storage,
+		&i.Compression,
+	)
+	return i, err
+}
+
 const getDatasetContentByUUID = `-- name: GetDatasetContentByUUID :one
-SELECT format, content, encode(checksum, 'hex') AS checksum
+SELECT format, content, encode(checksum, 'hex') AS checksum, storage, compression
 FROM datasets
 WHERE datasets.uuid = $1
 LIMIT 1
 `
 
 type GetDatasetContentByUUIDRow struct {
-	Format   string
-	Content  []byte
-	Checksum string
+	Format      string
+	Content     []byte
+	Checksum    string
+	Storage     string
+	Compression string
 }
 
 func (q *Queries) GetDatasetContentByUUID(ctx context.Context, uuid uuid.UUID) (GetDatasetContentByUUIDRow, error) {
 	row := q.queryRow(ctx, q.getDatasetContentByUUIDStmt, getDatasetContentByUUID, uuid)
 	var i GetDatasetContentByUUIDRow
-	err := row.Scan(&i.Format, &i.Content, &i.Checksum)
+	err := row.Scan(
+		&i.Format,
+		&i.Content,
+		&i.Checksum,
+		&i.Storage,
+		&i.Compression,
+	)
+	return i, err
+}
+
+const getDatasetContentInfoByUUID = `-- name: GetDatasetContentInfoByUUID :one
+SELECT format, encode(checksum, 'hex') AS checksum, size, storage, compression, stored_size, updated
+FROM datasets
+WHERE datasets.uuid = $1
+LIMIT 1
+`
+
+type GetDatasetContentInfoByUUIDRow struct {
+	Format      string
+	Checksum    string
+	Size        int64
+	Storage     string
+	Compression string
+	StoredSize  int64
+	Updated     time.Time
+}
+
+func (q *Queries) GetDatasetContentInfoByUUID(ctx context.Context, uuid uuid.UUID) (GetDatasetContentInfoByUUIDRow, error) {
+	row := q.queryRow(ctx, q.getDatasetContentInfoByUUIDStmt, getDatasetContentInfoByUUID, uuid)
+	var i GetDatasetContentInfoByUUIDRow
+	err := row.Scan(
+		&i.Format,
+		&i.Checksum,
+		&i.Size,
+		&i.Storage,
+		&i.Compression,
+		&i.StoredSize,
+		&i.Updated,
+	)
 	return i, err
 }
 
+const getDatasetContentPart = `-- name: GetDatasetContentPart :one
+SELECT substring(content FROM $1::integer + 1 FOR $2::integer)::bytea AS content
+FROM datasets
+WHERE datasets.uuid = $3
+AND datasets.checksum = decode($4::text, 'hex')
+LIMIT 1
+`
+
+type GetDatasetContentPartParams struct {
+	ArgOffset int32
+	ArgLength int32
+	Uuid      uuid.UUID
+	Checksum  string
+}
+
+func (q *Queries) GetDatasetContentPart(ctx context.Context, arg GetDatasetContentPartParams) ([]byte, error) {
+	row := q.queryRow(ctx, q.getDatasetContentPartStmt, getDatasetContentPart,
+		arg.ArgOffset,
+		arg.ArgLength,
+		arg.Uuid,
+		arg.Checksum,
+	)
+	var content []byte
+	err := row.Scan(&content)
+	return content, err
+}
+
+const getDatasetSchemaByUUID = `-- name: GetDatasetSchemaByUUID :one
+SELECT schema
+FROM datasets
+WHERE datasets.uuid = $1
+AND datasets.schema IS NOT NULL
+LIMIT 1
+`
+
+func (q *Queries) GetDatasetSchemaByUUID(ctx context.Context, uuid uuid.UUID) (json.RawMessage, error) {
+	row := q.queryRow(ctx, q.getDatasetSchemaByUUIDStmt, getDatasetSchemaByUUID, uuid)
+	var schema json.RawMessage
+	err := row.Scan(&schema)
+	return schema, err
+}
+
+const rollbackDatasetToRevision = `-- name: RollbackDatasetToRevision :execrows
+UPDATE datasets
+SET content = dataset_revisions.content,
+    checksum = dataset_revisions.checksum,
+    size = dataset_revisions.size,
+    storage = dataset_revisions.storage,
+    compression = dataset_revisions.compression,
+    stored_size = dataset_revisions.stored_size,
+    updated = NOW(),
+    updated_by = $1
+FROM dataset_revisions
+WHERE datasets.uuid = $2
+AND dataset_revisions.dataset_uuid = datasets.uuid
+AND dataset_revisions.revision = $3
+`
+
+type RollbackDatasetToRevisionParams struct {
+	UpdatedBy uuid.UUID
+	Uuid      uuid.UUID
+	Revision  int32
+}
+
+func (q *Queries) RollbackDatasetToRevision(ctx context.Context, arg RollbackDatasetToRevisionParams) (int64, error) {
+	result, err := q.exec(ctx, q.rollbackDatasetToRevisionStmt, rollbackDatasetToRevision, arg.UpdatedBy, arg.Uuid, arg.Revision)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setDatasetContentByUUID = `-- name: SetDatasetContentByUUID :execrows
 UPDATE datasets
 SET content = $1::bytea,
-    checksum = sha256($1::bytea)
-WHERE datasets.uuid = $2
+    checksum = decode($2::text, 'hex'),
+    size = $3::bigint,
+    storage = $4::text,
+    compression = $5::text,
+    stored_size = $6::bigint,
+    updated = NOW(),
+    updated_by = $7
+WHERE datasets.uuid = $8
 `
 
 type SetDatasetContentByUUIDParams struct {
-	Content []byte
-	Uuid    uuid.UUID
+	Content     []byte
+	Checksum    string
+	Size        int64
+	Storage     string
+	Compression string
+	StoredSize  int64
+	UpdatedBy   uuid.UUID
+	Uuid        uuid.UUID
 }
 
 func (q *Queries) SetDatasetContentByUUID(ctx context.Context, arg SetDatasetContentByUUIDParams) (int64, error) {
-	result, err := q.exec(ctx, q.setDatasetContentByUUIDStmt, setDatasetContentByUUID, arg.Content, arg.Uuid)
+	result, err := q.exec(ctx, q.setDatasetContentByUUIDStmt, setDatasetContentByUUID,
+		arg.Content,
+		arg.Checksum,
+		arg.Size,
+		arg.Storage,
+		arg.Compression,
+		arg.StoredSize,
+		arg.UpdatedBy,
+		arg.Uuid,
+	)
 	if err != nil {
 		return 0, err
 	}
@@ -538,6 +1032,25 @@ func (q *Queries) SetDatasetFormatByUUID(ctx context.Context, arg SetDatasetForm
 	return result.RowsAffected()
 }
 
+const setDatasetMaxRevisionsByUUID = `-- name: SetDatasetMaxRevisionsByUUID :execrows
+UPDATE datasets
+SET max_revisions = NULLIF($1::integer, 0)
+WHERE datasets.uuid = $2
+`
+
+type SetDatasetMaxRevisionsByUUIDParams struct {
+	MaxRevisions int32
+	Uuid         uuid.UUID
+}
+
+func (q *Queries) SetDatasetMaxRevisionsByUUID(ctx context.Context, arg SetDatasetMaxRevisionsByUUIDParams) (int64, error) {
+	result, err := q.exec(ctx, q.setDatasetMaxRevisionsByUUIDStmt, setDatasetMaxRevisionsByUUID, arg.MaxRevisions, arg.Uuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setDatasetNameByUUID = `-- name: SetDatasetNameByUUID :execrows
 UPDATE datasets
 SET name = $1
@@ -557,6 +1070,84 @@ func (q *Queries) SetDatasetNameByUUID(ctx context.Context, arg SetDatasetNameBy
 	return result.RowsAffected()
 }
 
+const setDatasetRevisionStorage = `-- name: SetDatasetRevisionStorage :execrows
+UPDATE dataset_revisions
+SET content = $1::bytea,
+    storage = $2::text
+WHERE dataset_revisions.dataset_uuid = $3
+AND dataset_revisions.revision = $4
+AND dataset_revisions.checksum = decode($5::text, 'hex')
+`
+
+type SetDatasetRevisionStorageParams struct {
+	Content     []byte
+	Storage     string
+	DatasetUuid uuid.UUID
+	Revision    int32
+	Checksum    string
+}
+
+func (q *Queries) SetDatasetRevisionStorage(ctx context.Context, arg SetDatasetRevisionStorageParams) (int64, error) {
+	result, err := q.exec(ctx, q.setDatasetRevisionStorageStmt, setDatasetRevisionStorage,
+		arg.Content,
+		arg.Storage,
+		arg.DatasetUuid,
+		arg.Revision,
+		arg.Checksum,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setDatasetSchemaByUUID = `-- name: SetDatasetSchemaByUUID :execrows
+UPDATE datasets
+SET schema = $1::jsonb
+WHERE datasets.uuid = $2
+`
+
+type SetDatasetSchemaByUUIDParams struct {
+	Schema json.RawMessage
+	Uuid   uuid.UUID
+}
+
+func (q *Queries) SetDatasetSchemaByUUID(ctx context.Context, arg SetDatasetSchemaByUUIDParams) (int64, error) {
+	result, err := q.exec(ctx, q.setDatasetSchemaByUUIDStmt, setDatasetSchemaByUUID, arg.Schema, arg.Uuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setDatasetStorageByUUID = `-- name: SetDatasetStorageByUUID :execrows
+UPDATE datasets
+SET content = $1::bytea,
+    storage = $2::text
+WHERE datasets.uuid = $3
+AND datasets.checksum = decode($4::text, 'hex')
+`
+
+type SetDatasetStorageByUUIDParams struct {
+	Content  []byte
+	Storage  string
+	Uuid     uuid.UUID
+	Checksum string
+}
+
+func (q *Queries) SetDatasetStorageByUUID(ctx context.Context, arg SetDatasetStorageByUUIDParams) (int64, error) {
+	result, err := q.exec(ctx, q.setDatasetStorageByUUIDStmt, setDatasetStorageByUUID,
+		arg.Content,
+		arg.Storage,
+		arg.Uuid,
+		arg.Checksum,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setDatasetTags = `-- name: SetDatasetTags :execrows
 UPDATE datasets
 SET tags = $1
@@ -22,6 +22,15 @@ func New(db DBTX) *Queries {
 func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	q := Queries{db: db}
 	var err error
+	if q.acquireProgramManagerLeaseStmt, err = db.PrepareContext(ctx, acquireProgramManagerLease); err != nil {
+		return nil, fmt.Errorf("error preparing query AcquireProgramManagerLease: %w", err)
+	}
+	if q.addIncidentAlertStmt, err = db.PrepareContext(ctx, addIncidentAlert); err != nil {
+		return nil, fmt.Errorf("error preparing query AddIncidentAlert: %w", err)
+	}
+	if q.addThingDepStmt, err = db.PrepareContext(ctx, addThingDep); err != nil {
+		return nil, fmt.Errorf("error preparing query AddThingDep: %w", err)
+	}
 	if q.addTokenToUserStmt, err = db.PrepareContext(ctx, addTokenToUser); err != nil {
 		return nil, fmt.Errorf("error preparing query AddTokenToUser: %w", err)
 	}
@@ -34,9 +43,48 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.checkUserTokenHasAccessManyStmt, err = db.PrepareContext(ctx, checkUserTokenHasAccessMany); err != nil {
 		return nil, fmt.Errorf("error preparing query CheckUserTokenHasAccessMany: %w", err)
 	}
+	if q.claimDatasetBlobDeletionStmt, err = db.PrepareContext(ctx, claimDatasetBlobDeletion); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimDatasetBlobDeletion: %w", err)
+	}
+	if q.claimDueTimeseriesMonitorsStmt, err = db.PrepareContext(ctx, claimDueTimeseriesMonitors); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimDueTimeseriesMonitors: %w", err)
+	}
+	if q.claimNotificationDeliveriesStmt, err = db.PrepareContext(ctx, claimNotificationDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimNotificationDeliveries: %w", err)
+	}
+	if q.claimProgramJobsStmt, err = db.PrepareContext(ctx, claimProgramJobs); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimProgramJobs: %w", err)
+	}
+	if q.claimTimeseriesHealthStmt, err = db.PrepareContext(ctx, claimTimeseriesHealth); err != nil {
+		return nil, fmt.Errorf("error preparing query ClaimTimeseriesHealth: %w", err)
+	}
+	if q.closeIncidentAlertsStmt, err = db.PrepareContext(ctx, closeIncidentAlerts); err != nil {
+		return nil, fmt.Errorf("error preparing query CloseIncidentAlerts: %w", err)
+	}
+	if q.completeProgramJobStmt, err = db.PrepareContext(ctx, completeProgramJob); err != nil {
+		return nil, fmt.Errorf("error preparing query CompleteProgramJob: %w", err)
+	}
+	if q.completeProgramRunStmt, err = db.PrepareContext(ctx, completeProgramRun); err != nil {
+		return nil, fmt.Errorf("error preparing query CompleteProgramRun: %w", err)
+	}
+	if q.countDatasetBlobReferencesStmt, err = db.PrepareContext(ctx, countDatasetBlobReferences); err != nil {
+		return nil, fmt.Errorf("error preparing query CountDatasetBlobReferences: %w", err)
+	}
+	if q.countIncidentAlertsStmt, err = db.PrepareContext(ctx, countIncidentAlerts); err != nil {
+		return nil, fmt.Errorf("error preparing query CountIncidentAlerts: %w", err)
+	}
 	if q.createAlertStmt, err = db.PrepareContext(ctx, createAlert); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateAlert: %w", err)
 	}
+	if q.createAlertGroupStmt, err = db.PrepareContext(ctx, createAlertGroup); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateAlertGroup: %w", err)
+	}
+	if q.createAlertHistoryStmt, err = db.PrepareContext(ctx, createAlertHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateAlertHistory: %w", err)
+	}
+	if q.createAlertSilenceStmt, err = db.PrepareContext(ctx, createAlertSilence); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateAlertSilence: %w", err)
+	}
 	if q.createCodeRevisionStmt, err = db.PrepareContext(ctx, createCodeRevision); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateCodeRevision: %w", err)
 	}
@@ -46,18 +94,54 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.createGroupStmt, err = db.PrepareContext(ctx, createGroup); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateGroup: %w", err)
 	}
+	if q.createIncidentStmt, err = db.PrepareContext(ctx, createIncident); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateIncident: %w", err)
+	}
+	if q.createIncidentHistoryStmt, err = db.PrepareContext(ctx, createIncidentHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateIncidentHistory: %w", err)
+	}
+	if q.createNotificationChannelStmt, err = db.PrepareContext(ctx, createNotificationChannel); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateNotificationChannel: %w", err)
+	}
+	if q.createNotificationDeliveryStmt, err = db.PrepareContext(ctx, createNotificationDelivery); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateNotificationDelivery: %w", err)
+	}
+	if q.createNotificationRuleStmt, err = db.PrepareContext(ctx, createNotificationRule); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateNotificationRule: %w", err)
+	}
 	if q.createPolicyStmt, err = db.PrepareContext(ctx, createPolicy); err != nil {
 		return nil, fmt.Errorf("error preparing query CreatePolicy: %w", err)
 	}
 	if q.createProgramStmt, err = db.PrepareContext(ctx, createProgram); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateProgram: %w", err)
 	}
+	if q.createProgramJobStmt, err = db.PrepareContext(ctx, createProgramJob); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateProgramJob: %w", err)
+	}
+	if q.createProgramRunStmt, err = db.PrepareContext(ctx, createProgramRun); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateProgramRun: %w", err)
+	}
 	if q.createThingStmt, err = db.PrepareContext(ctx, createThing); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateThing: %w", err)
 	}
+	if q.createThingStateChangeStmt, err = db.PrepareContext(ctx, createThingStateChange); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateThingStateChange: %w", err)
+	}
+	if q.createThingStateTransitionStmt, err = db.PrepareContext(ctx, createThingStateTransition); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateThingStateTransition: %w", err)
+	}
+	if q.createThingTemplateStmt, err = db.PrepareContext(ctx, createThingTemplate); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateThingTemplate: %w", err)
+	}
 	if q.createTimeseriesStmt, err = db.PrepareContext(ctx, createTimeseries); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateTimeseries: %w", err)
 	}
+	if q.createTimeseriesHealthRuleStmt, err = db.PrepareContext(ctx, createTimeseriesHealthRule); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateTimeseriesHealthRule: %w", err)
+	}
+	if q.createTimeseriesMonitorStmt, err = db.PrepareContext(ctx, createTimeseriesMonitor); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateTimeseriesMonitor: %w", err)
+	}
 	if q.createTsDataStmt, err = db.PrepareContext(ctx, createTsData); err != nil {
 		return nil, fmt.Errorf("error preparing query CreateTsData: %w", err)
 	}
@@ -70,15 +154,33 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteAlertStmt, err = db.PrepareContext(ctx, deleteAlert); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteAlert: %w", err)
 	}
+	if q.deleteAlertGroupStmt, err = db.PrepareContext(ctx, deleteAlertGroup); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAlertGroup: %w", err)
+	}
+	if q.deleteAlertSilenceStmt, err = db.PrepareContext(ctx, deleteAlertSilence); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteAlertSilence: %w", err)
+	}
 	if q.deleteAllTsDataStmt, err = db.PrepareContext(ctx, deleteAllTsData); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteAllTsData: %w", err)
 	}
 	if q.deleteDatasetStmt, err = db.PrepareContext(ctx, deleteDataset); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteDataset: %w", err)
 	}
+	if q.deleteDatasetBlobDeletionStmt, err = db.PrepareContext(ctx, deleteDatasetBlobDeletion); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteDatasetBlobDeletion: %w", err)
+	}
+	if q.deleteDatasetSchemaByUUIDStmt, err = db.PrepareContext(ctx, deleteDatasetSchemaByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteDatasetSchemaByUUID: %w", err)
+	}
 	if q.deleteGroupStmt, err = db.PrepareContext(ctx, deleteGroup); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteGroup: %w", err)
 	}
+	if q.deleteNotificationChannelStmt, err = db.PrepareContext(ctx, deleteNotificationChannel); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteNotificationChannel: %w", err)
+	}
+	if q.deleteNotificationRuleStmt, err = db.PrepareContext(ctx, deleteNotificationRule); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteNotificationRule: %w", err)
+	}
 	if q.deletePolicyByUUIDStmt, err = db.PrepareContext(ctx, deletePolicyByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query DeletePolicyByUUID: %w", err)
 	}
@@ -88,12 +190,33 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.deleteProgramCodeRevisionStmt, err = db.PrepareContext(ctx, deleteProgramCodeRevision); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteProgramCodeRevision: %w", err)
 	}
+	if q.deleteProgramJobsBeforeStmt, err = db.PrepareContext(ctx, deleteProgramJobsBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteProgramJobsBefore: %w", err)
+	}
+	if q.deleteProgramRunsBeforeStmt, err = db.PrepareContext(ctx, deleteProgramRunsBefore); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteProgramRunsBefore: %w", err)
+	}
 	if q.deleteThingStmt, err = db.PrepareContext(ctx, deleteThing); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteThing: %w", err)
 	}
+	if q.deleteThingStateTransitionsStmt, err = db.PrepareContext(ctx, deleteThingStateTransitions); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteThingStateTransitions: %w", err)
+	}
+	if q.deleteThingTemplateStmt, err = db.PrepareContext(ctx, deleteThingTemplate); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteThingTemplate: %w", err)
+	}
+	if q.deleteThingTypeSchemaStmt, err = db.PrepareContext(ctx, deleteThingTypeSchema); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteThingTypeSchema: %w", err)
+	}
 	if q.deleteTimeseriesStmt, err = db.PrepareContext(ctx, deleteTimeseries); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteTimeseries: %w", err)
 	}
+	if q.deleteTimeseriesHealthRuleStmt, err = db.PrepareContext(ctx, deleteTimeseriesHealthRule); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTimeseriesHealthRule: %w", err)
+	}
+	if q.deleteTimeseriesMonitorStmt, err = db.PrepareContext(ctx, deleteTimeseriesMonitor); err != nil {
+		return nil, fmt.Errorf("error preparing query DeleteTimeseriesMonitor: %w", err)
+	}
 	if q.deleteTokenFromUserStmt, err = db.PrepareContext(ctx, deleteTokenFromUser); err != nil {
 		return nil, fmt.Errorf("error preparing query DeleteTokenFromUser: %w", err)
 	}
@@ -127,9 +250,36 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.existsUserStmt, err = db.PrepareContext(ctx, existsUser); err != nil {
 		return nil, fmt.Errorf("error preparing query ExistsUser: %w", err)
 	}
+	if q.expireAlertsStmt, err = db.PrepareContext(ctx, expireAlerts); err != nil {
+		return nil, fmt.Errorf("error preparing query ExpireAlerts: %w", err)
+	}
+	if q.failQueuedProgramRunsStmt, err = db.PrepareContext(ctx, failQueuedProgramRuns); err != nil {
+		return nil, fmt.Errorf("error preparing query FailQueuedProgramRuns: %w", err)
+	}
+	if q.failStaleProgramRunsStmt, err = db.PrepareContext(ctx, failStaleProgramRuns); err != nil {
+		return nil, fmt.Errorf("error preparing query FailStaleProgramRuns: %w", err)
+	}
+	if q.findActiveNotificationRulesStmt, err = db.PrepareContext(ctx, findActiveNotificationRules); err != nil {
+		return nil, fmt.Errorf("error preparing query FindActiveNotificationRules: %w", err)
+	}
 	if q.findAlertByUUIDStmt, err = db.PrepareContext(ctx, findAlertByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query FindAlertByUUID: %w", err)
 	}
+	if q.findAlertGroupByUUIDStmt, err = db.PrepareContext(ctx, findAlertGroupByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query FindAlertGroupByUUID: %w", err)
+	}
+	if q.findAlertGroupsStmt, err = db.PrepareContext(ctx, findAlertGroups); err != nil {
+		return nil, fmt.Errorf("error preparing query FindAlertGroups: %w", err)
+	}
+	if q.findAlertHistoryStmt, err = db.PrepareContext(ctx, findAlertHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query FindAlertHistory: %w", err)
+	}
+	if q.findAlertSilenceByUUIDStmt, err = db.PrepareContext(ctx, findAlertSilenceByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query FindAlertSilenceByUUID: %w", err)
+	}
+	if q.findAlertSilencesStmt, err = db.PrepareContext(ctx, findAlertSilences); err != nil {
+		return nil, fmt.Errorf("error preparing query FindAlertSilences: %w", err)
+	}
 	if q.findAlertsStmt, err = db.PrepareContext(ctx, findAlerts); err != nil {
 		return nil, fmt.Errorf("error preparing query FindAlerts: %w", err)
 	}
@@ -139,18 +289,45 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.findAllRoutineRevisionsStmt, err = db.PrepareContext(ctx, findAllRoutineRevisions); err != nil {
 		return nil, fmt.Errorf("error preparing query FindAllRoutineRevisions: %w", err)
 	}
+	if q.findAllThingStateTransitionsStmt, err = db.PrepareContext(ctx, findAllThingStateTransitions); err != nil {
+		return nil, fmt.Errorf("error preparing query FindAllThingStateTransitions: %w", err)
+	}
+	if q.findCurrentAlertSilencesStmt, err = db.PrepareContext(ctx, findCurrentAlertSilences); err != nil {
+		return nil, fmt.Errorf("error preparing query FindCurrentAlertSilences: %w", err)
+	}
+	if q.findCurrentAlertStatusChangesStmt, err = db.PrepareContext(ctx, findCurrentAlertStatusChanges); err != nil {
+		return nil, fmt.Errorf("error preparing query FindCurrentAlertStatusChanges: %w", err)
+	}
 	if q.findDatasetByThingStmt, err = db.PrepareContext(ctx, findDatasetByThing); err != nil {
 		return nil, fmt.Errorf("error preparing query FindDatasetByThing: %w", err)
 	}
 	if q.findDatasetByUUIDStmt, err = db.PrepareContext(ctx, findDatasetByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query FindDatasetByUUID: %w", err)
 	}
+	if q.findDatasetRevisionsStmt, err = db.PrepareContext(ctx, findDatasetRevisions); err != nil {
+		return nil, fmt.Errorf("error preparing query FindDatasetRevisions: %w", err)
+	}
+	if q.findDatasetRevisionsByStorageStmt, err = db.PrepareContext(ctx, findDatasetRevisionsByStorage); err != nil {
+		return nil, fmt.Errorf("error preparing query FindDatasetRevisionsByStorage: %w", err)
+	}
 	if q.findDatasetsStmt, err = db.PrepareContext(ctx, findDatasets); err != nil {
 		return nil, fmt.Errorf("error preparing query FindDatasets: %w", err)
 	}
+	if q.findDatasetsByStorageStmt, err = db.PrepareContext(ctx, findDatasetsByStorage); err != nil {
+		return nil, fmt.Errorf("error preparing query FindDatasetsByStorage: %w", err)
+	}
 	if q.findDatasetsByTagsStmt, err = db.PrepareContext(ctx, findDatasetsByTags); err != nil {
 		return nil, fmt.Errorf("error preparing query FindDatasetsByTags: %w", err)
 	}
+	if q.findDatasetsByThingsStmt, err = db.PrepareContext(ctx, findDatasetsByThings); err != nil {
+		return nil, fmt.Errorf("error preparing query FindDatasetsByThings: %w", err)
+	}
+	if q.findEnabledAlertGroupsStmt, err = db.PrepareContext(ctx, findEnabledAlertGroups); err != nil {
+		return nil, fmt.Errorf("error preparing query FindEnabledAlertGroups: %w", err)
+	}
+	if q.findEnabledTimeseriesHealthRulesStmt, err = db.PrepareContext(ctx, findEnabledTimeseriesHealthRules); err != nil {
+		return nil, fmt.Errorf("error preparing query FindEnabledTimeseriesHealthRules: %w", err)
+	}
 	if q.findGroupByUuidStmt, err = db.PrepareContext(ctx, findGroupByUuid); err != nil {
 		return nil, fmt.Errorf("error preparing query FindGroupByUuid: %w", err)
 	}
@@ -160,6 +337,39 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.findGroupsByUserStmt, err = db.PrepareContext(ctx, findGroupsByUser); err != nil {
 		return nil, fmt.Errorf("error preparing query FindGroupsByUser: %w", err)
 	}
+	if q.findIncidentAlertsStmt, err = db.PrepareContext(ctx, findIncidentAlerts); err != nil {
+		return nil, fmt.Errorf("error preparing query FindIncidentAlerts: %w", err)
+	}
+	if q.findIncidentByUUIDStmt, err = db.PrepareContext(ctx, findIncidentByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query FindIncidentByUUID: %w", err)
+	}
+	if q.findIncidentHistoryStmt, err = db.PrepareContext(ctx, findIncidentHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query FindIncidentHistory: %w", err)
+	}
+	if q.findIncidentsStmt, err = db.PrepareContext(ctx, findIncidents); err != nil {
+		return nil, fmt.Errorf("error preparing query FindIncidents: %w", err)
+	}
+	if q.findLatestIncidentByAlertStmt, err = db.PrepareContext(ctx, findLatestIncidentByAlert); err != nil {
+		return nil, fmt.Errorf("error preparing query FindLatestIncidentByAlert: %w", err)
+	}
+	if q.findNotificationChannelByUUIDStmt, err = db.PrepareContext(ctx, findNotificationChannelByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query FindNotificationChannelByUUID: %w", err)
+	}
+	if q.findNotificationChannelsStmt, err = db.PrepareContext(ctx, findNotificationChannels); err != nil {
+		return nil, fmt.Errorf("error preparing query FindNotificationChannels: %w", err)
+	}
+	if q.findNotificationDeliveriesStmt, err = db.PrepareContext(ctx, findNotificationDeliveries); err != nil {
+		return nil, fmt.Errorf("error preparing query FindNotificationDeliveries: %w", err)
+	}
+	if q.findNotificationRuleByUUIDStmt, err = db.PrepareContext(ctx, findNotificationRuleByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query FindNotificationRuleByUUID: %w", err)
+	}
+	if q.findNotificationRulesStmt, err = db.PrepareContext(ctx, findNotificationRules); err != nil {
+		return nil, fmt.Errorf("error preparing query FindNotificationRules: %w", err)
+	}
+	if q.findOpenIncidentByKeyStmt, err = db.PrepareContext(ctx, findOpenIncidentByKey); err != nil {
+		return nil, fmt.Errorf("error preparing query FindOpenIncidentByKey: %w", err)
+	}
 	if q.findPoliciesStmt, err = db.PrepareContext(ctx, findPolicies); err != nil {
 		return nil, fmt.Errorf("error preparing query FindPolicies: %w", err)
 	}
@@ -178,21 +388,69 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.findProgramCodeRevisionsStmt, err = db.PrepareContext(ctx, findProgramCodeRevisions); err != nil {
 		return nil, fmt.Errorf("error preparing query FindProgramCodeRevisions: %w", err)
 	}
+	if q.findProgramJobByIDStmt, err = db.PrepareContext(ctx, findProgramJobByID); err != nil {
+		return nil, fmt.Errorf("error preparing query FindProgramJobByID: %w", err)
+	}
+	if q.findProgramJobsStmt, err = db.PrepareContext(ctx, findProgramJobs); err != nil {
+		return nil, fmt.Errorf("error preparing query FindProgramJobs: %w", err)
+	}
+	if q.findProgramRunByIDStmt, err = db.PrepareContext(ctx, findProgramRunByID); err != nil {
+		return nil, fmt.Errorf("error preparing query FindProgramRunByID: %w", err)
+	}
+	if q.findProgramRunsStmt, err = db.PrepareContext(ctx, findProgramRuns); err != nil {
+		return nil, fmt.Errorf("error preparing query FindProgramRuns: %w", err)
+	}
+	if q.findProgramRunsByJobStmt, err = db.PrepareContext(ctx, findProgramRunsByJob); err != nil {
+		return nil, fmt.Errorf("error preparing query FindProgramRunsByJob: %w", err)
+	}
 	if q.findProgramsStmt, err = db.PrepareContext(ctx, findPrograms); err != nil {
 		return nil, fmt.Errorf("error preparing query FindPrograms: %w", err)
 	}
 	if q.findProgramsByTagsStmt, err = db.PrepareContext(ctx, findProgramsByTags); err != nil {
 		return nil, fmt.Errorf("error preparing query FindProgramsByTags: %w", err)
 	}
+	if q.findRoutineRevisionsByProgramStmt, err = db.PrepareContext(ctx, findRoutineRevisionsByProgram); err != nil {
+		return nil, fmt.Errorf("error preparing query FindRoutineRevisionsByProgram: %w", err)
+	}
+	if q.findThingAncestorsStmt, err = db.PrepareContext(ctx, findThingAncestors); err != nil {
+		return nil, fmt.Errorf("error preparing query FindThingAncestors: %w", err)
+	}
 	if q.findThingByUUIDStmt, err = db.PrepareContext(ctx, findThingByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query FindThingByUUID: %w", err)
 	}
+	if q.findThingDescendantsStmt, err = db.PrepareContext(ctx, findThingDescendants); err != nil {
+		return nil, fmt.Errorf("error preparing query FindThingDescendants: %w", err)
+	}
+	if q.findThingParentStmt, err = db.PrepareContext(ctx, findThingParent); err != nil {
+		return nil, fmt.Errorf("error preparing query FindThingParent: %w", err)
+	}
+	if q.findThingStateHistoryStmt, err = db.PrepareContext(ctx, findThingStateHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query FindThingStateHistory: %w", err)
+	}
+	if q.findThingStateTransitionsStmt, err = db.PrepareContext(ctx, findThingStateTransitions); err != nil {
+		return nil, fmt.Errorf("error preparing query FindThingStateTransitions: %w", err)
+	}
+	if q.findThingTemplateByUUIDStmt, err = db.PrepareContext(ctx, findThingTemplateByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query FindThingTemplateByUUID: %w", err)
+	}
+	if q.findThingTemplatesStmt, err = db.PrepareContext(ctx, findThingTemplates); err != nil {
+		return nil, fmt.Errorf("error preparing query FindThingTemplates: %w", err)
+	}
+	if q.findThingTypeSchemasStmt, err = db.PrepareContext(ctx, findThingTypeSchemas); err != nil {
+		return nil, fmt.Errorf("error preparing query FindThingTypeSchemas: %w", err)
+	}
 	if q.findThingsStmt, err = db.PrepareContext(ctx, findThings); err != nil {
 		return nil, fmt.Errorf("error preparing query FindThings: %w", err)
 	}
 	if q.findThingsByTagsStmt, err = db.PrepareContext(ctx, findThingsByTags); err != nil {
 		return nil, fmt.Errorf("error preparing query FindThingsByTags: %w", err)
 	}
+	if q.findThingsByTypeStmt, err = db.PrepareContext(ctx, findThingsByType); err != nil {
+		return nil, fmt.Errorf("error preparing query FindThingsByType: %w", err)
+	}
+	if q.findThingsWithinStmt, err = db.PrepareContext(ctx, findThingsWithin); err != nil {
+		return nil, fmt.Errorf("error preparing query FindThingsWithin: %w", err)
+	}
 	if q.findTimeseriesStmt, err = db.PrepareContext(ctx, findTimeseries); err != nil {
 		return nil, fmt.Errorf("error preparing query FindTimeseries: %w", err)
 	}
@@ -202,21 +460,60 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.findTimeseriesByThingStmt, err = db.PrepareContext(ctx, findTimeseriesByThing); err != nil {
 		return nil, fmt.Errorf("error preparing query FindTimeseriesByThing: %w", err)
 	}
+	if q.findTimeseriesByThingsStmt, err = db.PrepareContext(ctx, findTimeseriesByThings); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTimeseriesByThings: %w", err)
+	}
 	if q.findTimeseriesByUUIDStmt, err = db.PrepareContext(ctx, findTimeseriesByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query FindTimeseriesByUUID: %w", err)
 	}
+	if q.findTimeseriesHealthStmt, err = db.PrepareContext(ctx, findTimeseriesHealth); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTimeseriesHealth: %w", err)
+	}
+	if q.findTimeseriesHealthRuleByUUIDStmt, err = db.PrepareContext(ctx, findTimeseriesHealthRuleByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTimeseriesHealthRuleByUUID: %w", err)
+	}
+	if q.findTimeseriesHealthRulesStmt, err = db.PrepareContext(ctx, findTimeseriesHealthRules); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTimeseriesHealthRules: %w", err)
+	}
+	if q.findTimeseriesMonitorByUUIDStmt, err = db.PrepareContext(ctx, findTimeseriesMonitorByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTimeseriesMonitorByUUID: %w", err)
+	}
+	if q.findTimeseriesMonitorsStmt, err = db.PrepareContext(ctx, findTimeseriesMonitors); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTimeseriesMonitors: %w", err)
+	}
 	if q.findTokensByUserStmt, err = db.PrepareContext(ctx, findTokensByUser); err != nil {
 		return nil, fmt.Errorf("error preparing query FindTokensByUser: %w", err)
 	}
+	if q.findTsDataHistoryStmt, err = db.PrepareContext(ctx, findTsDataHistory); err != nil {
+		return nil, fmt.Errorf("error preparing query FindTsDataHistory: %w", err)
+	}
 	if q.findUserByUUIDStmt, err = db.PrepareContext(ctx, findUserByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query FindUserByUUID: %w", err)
 	}
 	if q.findUsersStmt, err = db.PrepareContext(ctx, findUsers); err != nil {
 		return nil, fmt.Errorf("error preparing query FindUsers: %w", err)
 	}
+	if q.getDatasetContentAtHeadStmt, err = db.PrepareContext(ctx, getDatasetContentAtHead); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDatasetContentAtHead: %w", err)
+	}
+	if q.getDatasetContentAtRevisionStmt, err = db.PrepareContext(ctx, getDatasetContentAtRevision); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDatasetContentAtRevision: %w", err)
+	}
 	if q.getDatasetContentByUUIDStmt, err = db.PrepareContext(ctx, getDatasetContentByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetDatasetContentByUUID: %w", err)
 	}
+	if q.getDatasetContentInfoByUUIDStmt, err = db.PrepareContext(ctx, getDatasetContentInfoByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDatasetContentInfoByUUID: %w", err)
+	}
+	if q.getDatasetContentPartStmt, err = db.PrepareContext(ctx, getDatasetContentPart); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDatasetContentPart: %w", err)
+	}
+	if q.getDatasetSchemaByUUIDStmt, err = db.PrepareContext(ctx, getDatasetSchemaByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query GetDatasetSchemaByUUID: %w", err)
+	}
+	if q.getIncidentActiveAlertsStmt, err = db.PrepareContext(ctx, getIncidentActiveAlerts); err != nil {
+		return nil, fmt.Errorf("error preparing query GetIncidentActiveAlerts: %w", err)
+	}
 	if q.getNamedModuleCodeAtHeadStmt, err = db.PrepareContext(ctx, getNamedModuleCodeAtHead); err != nil {
 		return nil, fmt.Errorf("error preparing query GetNamedModuleCodeAtHead: %w", err)
 	}
@@ -229,39 +526,108 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getProgramCodeAtRevisionStmt, err = db.PrepareContext(ctx, getProgramCodeAtRevision); err != nil {
 		return nil, fmt.Errorf("error preparing query GetProgramCodeAtRevision: %w", err)
 	}
+	if q.getProgramCodeRevisionStmt, err = db.PrepareContext(ctx, getProgramCodeRevision); err != nil {
+		return nil, fmt.Errorf("error preparing query GetProgramCodeRevision: %w", err)
+	}
 	if q.getSignedProgramCodeAtHeadStmt, err = db.PrepareContext(ctx, getSignedProgramCodeAtHead); err != nil {
 		return nil, fmt.Errorf("error preparing query GetSignedProgramCodeAtHead: %w", err)
 	}
+	if q.getThingStateByTimeseriesStmt, err = db.PrepareContext(ctx, getThingStateByTimeseries); err != nil {
+		return nil, fmt.Errorf("error preparing query GetThingStateByTimeseries: %w", err)
+	}
+	if q.getThingTypeSchemaStmt, err = db.PrepareContext(ctx, getThingTypeSchema); err != nil {
+		return nil, fmt.Errorf("error preparing query GetThingTypeSchema: %w", err)
+	}
 	if q.getTimeseriesByUUIDStmt, err = db.PrepareContext(ctx, getTimeseriesByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTimeseriesByUUID: %w", err)
 	}
+	if q.getTsDataHealthStmt, err = db.PrepareContext(ctx, getTsDataHealth); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTsDataHealth: %w", err)
+	}
 	if q.getTsDataRangeStmt, err = db.PrepareContext(ctx, getTsDataRange); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTsDataRange: %w", err)
 	}
 	if q.getTsDataRangeAggStmt, err = db.PrepareContext(ctx, getTsDataRangeAgg); err != nil {
 		return nil, fmt.Errorf("error preparing query GetTsDataRangeAgg: %w", err)
 	}
+	if q.getTsDataWindowAggStmt, err = db.PrepareContext(ctx, getTsDataWindowAgg); err != nil {
+		return nil, fmt.Errorf("error preparing query GetTsDataWindowAgg: %w", err)
+	}
 	if q.getUnitFromTimeseriesStmt, err = db.PrepareContext(ctx, getUnitFromTimeseries); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUnitFromTimeseries: %w", err)
 	}
 	if q.getUserUuidFromTokenStmt, err = db.PrepareContext(ctx, getUserUuidFromToken); err != nil {
 		return nil, fmt.Errorf("error preparing query GetUserUuidFromToken: %w", err)
 	}
+	if q.heartbeatProgramJobStmt, err = db.PrepareContext(ctx, heartbeatProgramJob); err != nil {
+		return nil, fmt.Errorf("error preparing query HeartbeatProgramJob: %w", err)
+	}
+	if q.initTimeseriesHealthStmt, err = db.PrepareContext(ctx, initTimeseriesHealth); err != nil {
+		return nil, fmt.Errorf("error preparing query InitTimeseriesHealth: %w", err)
+	}
+	if q.killProgramJobStmt, err = db.PrepareContext(ctx, killProgramJob); err != nil {
+		return nil, fmt.Errorf("error preparing query KillProgramJob: %w", err)
+	}
+	if q.lockDatasetBlobStmt, err = db.PrepareContext(ctx, lockDatasetBlob); err != nil {
+		return nil, fmt.Errorf("error preparing query LockDatasetBlob: %w", err)
+	}
+	if q.markTimeseriesMonitorsDueStmt, err = db.PrepareContext(ctx, markTimeseriesMonitorsDue); err != nil {
+		return nil, fmt.Errorf("error preparing query MarkTimeseriesMonitorsDue: %w", err)
+	}
+	if q.queueProgramRunStmt, err = db.PrepareContext(ctx, queueProgramRun); err != nil {
+		return nil, fmt.Errorf("error preparing query QueueProgramRun: %w", err)
+	}
+	if q.releaseProgramManagerLeaseStmt, err = db.PrepareContext(ctx, releaseProgramManagerLease); err != nil {
+		return nil, fmt.Errorf("error preparing query ReleaseProgramManagerLease: %w", err)
+	}
+	if q.releaseSilencedAlertsStmt, err = db.PrepareContext(ctx, releaseSilencedAlerts); err != nil {
+		return nil, fmt.Errorf("error preparing query ReleaseSilencedAlerts: %w", err)
+	}
+	if q.removeThingDepStmt, err = db.PrepareContext(ctx, removeThingDep); err != nil {
+		return nil, fmt.Errorf("error preparing query RemoveThingDep: %w", err)
+	}
 	if q.removeUserFromAllGroupsStmt, err = db.PrepareContext(ctx, removeUserFromAllGroups); err != nil {
 		return nil, fmt.Errorf("error preparing query RemoveUserFromAllGroups: %w", err)
 	}
 	if q.removeUserFromGroupsStmt, err = db.PrepareContext(ctx, removeUserFromGroups); err != nil {
 		return nil, fmt.Errorf("error preparing query RemoveUserFromGroups: %w", err)
 	}
+	if q.requeueProgramJobStmt, err = db.PrepareContext(ctx, requeueProgramJob); err != nil {
+		return nil, fmt.Errorf("error preparing query RequeueProgramJob: %w", err)
+	}
+	if q.restoreTsDataChangeStmt, err = db.PrepareContext(ctx, restoreTsDataChange); err != nil {
+		return nil, fmt.Errorf("error preparing query RestoreTsDataChange: %w", err)
+	}
+	if q.retryProgramJobStmt, err = db.PrepareContext(ctx, retryProgramJob); err != nil {
+		return nil, fmt.Errorf("error preparing query RetryProgramJob: %w", err)
+	}
+	if q.rollbackDatasetToRevisionStmt, err = db.PrepareContext(ctx, rollbackDatasetToRevision); err != nil {
+		return nil, fmt.Errorf("error preparing query RollbackDatasetToRevision: %w", err)
+	}
+	if q.setAuditContextStmt, err = db.PrepareContext(ctx, setAuditContext); err != nil {
+		return nil, fmt.Errorf("error preparing query SetAuditContext: %w", err)
+	}
 	if q.setDatasetContentByUUIDStmt, err = db.PrepareContext(ctx, setDatasetContentByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query SetDatasetContentByUUID: %w", err)
 	}
 	if q.setDatasetFormatByUUIDStmt, err = db.PrepareContext(ctx, setDatasetFormatByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query SetDatasetFormatByUUID: %w", err)
 	}
+	if q.setDatasetMaxRevisionsByUUIDStmt, err = db.PrepareContext(ctx, setDatasetMaxRevisionsByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query SetDatasetMaxRevisionsByUUID: %w", err)
+	}
 	if q.setDatasetNameByUUIDStmt, err = db.PrepareContext(ctx, setDatasetNameByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query SetDatasetNameByUUID: %w", err)
 	}
+	if q.setDatasetRevisionStorageStmt, err = db.PrepareContext(ctx, setDatasetRevisionStorage); err != nil {
+		return nil, fmt.Errorf("error preparing query SetDatasetRevisionStorage: %w", err)
+	}
+	if q.setDatasetSchemaByUUIDStmt, err = db.PrepareContext(ctx, setDatasetSchemaByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query SetDatasetSchemaByUUID: %w", err)
+	}
+	if q.setDatasetStorageByUUIDStmt, err = db.PrepareContext(ctx, setDatasetStorageByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query SetDatasetStorageByUUID: %w", err)
+	}
 	if q.setDatasetTagsStmt, err = db.PrepareContext(ctx, setDatasetTags); err != nil {
 		return nil, fmt.Errorf("error preparing query SetDatasetTags: %w", err)
 	}
@@ -271,6 +637,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.setGroupNameByUUIDStmt, err = db.PrepareContext(ctx, setGroupNameByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query SetGroupNameByUUID: %w", err)
 	}
+	if q.setNotificationDeliveryFailedStmt, err = db.PrepareContext(ctx, setNotificationDeliveryFailed); err != nil {
+		return nil, fmt.Errorf("error preparing query SetNotificationDeliveryFailed: %w", err)
+	}
+	if q.setNotificationDeliveryRetryStmt, err = db.PrepareContext(ctx, setNotificationDeliveryRetry); err != nil {
+		return nil, fmt.Errorf("error preparing query SetNotificationDeliveryRetry: %w", err)
+	}
+	if q.setNotificationDeliverySentStmt, err = db.PrepareContext(ctx, setNotificationDeliverySent); err != nil {
+		return nil, fmt.Errorf("error preparing query SetNotificationDeliverySent: %w", err)
+	}
 	if q.setPolicyActionStmt, err = db.PrepareContext(ctx, setPolicyAction); err != nil {
 		return nil, fmt.Errorf("error preparing query SetPolicyAction: %w", err)
 	}
@@ -307,6 +682,15 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.setProgramTypeByUUIDStmt, err = db.PrepareContext(ctx, setProgramTypeByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query SetProgramTypeByUUID: %w", err)
 	}
+	if q.setThingAreaByUUIDStmt, err = db.PrepareContext(ctx, setThingAreaByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query SetThingAreaByUUID: %w", err)
+	}
+	if q.setThingAttributesByUUIDStmt, err = db.PrepareContext(ctx, setThingAttributesByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query SetThingAttributesByUUID: %w", err)
+	}
+	if q.setThingLocationByUUIDStmt, err = db.PrepareContext(ctx, setThingLocationByUUID); err != nil {
+		return nil, fmt.Errorf("error preparing query SetThingLocationByUUID: %w", err)
+	}
 	if q.setThingNameByUUIDStmt, err = db.PrepareContext(ctx, setThingNameByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query SetThingNameByUUID: %w", err)
 	}
@@ -319,6 +703,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.setThingTypeByUUIDStmt, err = db.PrepareContext(ctx, setThingTypeByUUID); err != nil {
 		return nil, fmt.Errorf("error preparing query SetThingTypeByUUID: %w", err)
 	}
+	if q.setThingTypeSchemaStmt, err = db.PrepareContext(ctx, setThingTypeSchema); err != nil {
+		return nil, fmt.Errorf("error preparing query SetThingTypeSchema: %w", err)
+	}
+	if q.setTimeseriesAttributesStmt, err = db.PrepareContext(ctx, setTimeseriesAttributes); err != nil {
+		return nil, fmt.Errorf("error preparing query SetTimeseriesAttributes: %w", err)
+	}
 	if q.setTimeseriesLowerBoundStmt, err = db.PrepareContext(ctx, setTimeseriesLowerBound); err != nil {
 		return nil, fmt.Errorf("error preparing query SetTimeseriesLowerBound: %w", err)
 	}
@@ -343,6 +733,18 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.signProgramCodeRevisionStmt, err = db.PrepareContext(ctx, signProgramCodeRevision); err != nil {
 		return nil, fmt.Errorf("error preparing query SignProgramCodeRevision: %w", err)
 	}
+	if q.silenceAlertStmt, err = db.PrepareContext(ctx, silenceAlert); err != nil {
+		return nil, fmt.Errorf("error preparing query SilenceAlert: %w", err)
+	}
+	if q.startProgramRunStmt, err = db.PrepareContext(ctx, startProgramRun); err != nil {
+		return nil, fmt.Errorf("error preparing query StartProgramRun: %w", err)
+	}
+	if q.unshelveAlertsStmt, err = db.PrepareContext(ctx, unshelveAlerts); err != nil {
+		return nil, fmt.Errorf("error preparing query UnshelveAlerts: %w", err)
+	}
+	if q.updateAlertGroupStmt, err = db.PrepareContext(ctx, updateAlertGroup); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateAlertGroup: %w", err)
+	}
 	if q.updateAlertIncDuplicateStmt, err = db.PrepareContext(ctx, updateAlertIncDuplicate); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateAlertIncDuplicate: %w", err)
 	}
@@ -373,6 +775,9 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateAlertSetSeverityStmt, err = db.PrepareContext(ctx, updateAlertSetSeverity); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateAlertSetSeverity: %w", err)
 	}
+	if q.updateAlertSetShelvedUntilStmt, err = db.PrepareContext(ctx, updateAlertSetShelvedUntil); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateAlertSetShelvedUntil: %w", err)
+	}
 	if q.updateAlertSetStatusStmt, err = db.PrepareContext(ctx, updateAlertSetStatus); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateAlertSetStatus: %w", err)
 	}
@@ -385,11 +790,53 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.updateAlertSetValueStmt, err = db.PrepareContext(ctx, updateAlertSetValue); err != nil {
 		return nil, fmt.Errorf("error preparing query UpdateAlertSetValue: %w", err)
 	}
+	if q.updateAlertSilenceStmt, err = db.PrepareContext(ctx, updateAlertSilence); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateAlertSilence: %w", err)
+	}
+	if q.updateIncidentStateStmt, err = db.PrepareContext(ctx, updateIncidentState); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateIncidentState: %w", err)
+	}
+	if q.updateNotificationChannelStmt, err = db.PrepareContext(ctx, updateNotificationChannel); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateNotificationChannel: %w", err)
+	}
+	if q.updateNotificationRuleStmt, err = db.PrepareContext(ctx, updateNotificationRule); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateNotificationRule: %w", err)
+	}
+	if q.updateThingTemplateStmt, err = db.PrepareContext(ctx, updateThingTemplate); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateThingTemplate: %w", err)
+	}
+	if q.updateTimeseriesHealthStmt, err = db.PrepareContext(ctx, updateTimeseriesHealth); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateTimeseriesHealth: %w", err)
+	}
+	if q.updateTimeseriesHealthRuleStmt, err = db.PrepareContext(ctx, updateTimeseriesHealthRule); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateTimeseriesHealthRule: %w", err)
+	}
+	if q.updateTimeseriesMonitorStmt, err = db.PrepareContext(ctx, updateTimeseriesMonitor); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateTimeseriesMonitor: %w", err)
+	}
+	if q.updateTimeseriesMonitorStateStmt, err = db.PrepareContext(ctx, updateTimeseriesMonitorState); err != nil {
+		return nil, fmt.Errorf("error preparing query UpdateTimeseriesMonitorState: %w", err)
+	}
 	return &q, nil
 }
 
 func (q *Queries) Close() error {
 	var err error
+	if q.acquireProgramManagerLeaseStmt != nil {
+		if cerr := q.acquireProgramManagerLeaseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing acquireProgramManagerLeaseStmt: %w", cerr)
+		}
+	}
+	if q.addIncidentAlertStmt != nil {
+		if cerr := q.addIncidentAlertStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addIncidentAlertStmt: %w", cerr)
+		}
+	}
+	if q.addThingDepStmt != nil {
+		if cerr := q.addThingDepStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addThingDepStmt: %w", cerr)
+		}
+	}
 	if q.addTokenToUserStmt != nil {
 		if cerr := q.addTokenToUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing addTokenToUserStmt: %w", cerr)
@@ -410,11 +857,76 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing checkUserTokenHasAccessManyStmt: %w", cerr)
 		}
 	}
+	if q.claimDatasetBlobDeletionStmt != nil {
+		if cerr := q.claimDatasetBlobDeletionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimDatasetBlobDeletionStmt: %w", cerr)
+		}
+	}
+	if q.claimDueTimeseriesMonitorsStmt != nil {
+		if cerr := q.claimDueTimeseriesMonitorsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimDueTimeseriesMonitorsStmt: %w", cerr)
+		}
+	}
+	if q.claimNotificationDeliveriesStmt != nil {
+		if cerr := q.claimNotificationDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimNotificationDeliveriesStmt: %w", cerr)
+		}
+	}
+	if q.claimProgramJobsStmt != nil {
+		if cerr := q.claimProgramJobsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimProgramJobsStmt: %w", cerr)
+		}
+	}
+	if q.claimTimeseriesHealthStmt != nil {
+		if cerr := q.claimTimeseriesHealthStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing claimTimeseriesHealthStmt: %w", cerr)
+		}
+	}
+	if q.closeIncidentAlertsStmt != nil {
+		if cerr := q.closeIncidentAlertsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing closeIncidentAlertsStmt: %w", cerr)
+		}
+	}
+	if q.completeProgramJobStmt != nil {
+		if cerr := q.completeProgramJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing completeProgramJobStmt: %w", cerr)
+		}
+	}
+	if q.completeProgramRunStmt != nil {
+		if cerr := q.completeProgramRunStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing completeProgramRunStmt: %w", cerr)
+		}
+	}
+	if q.countDatasetBlobReferencesStmt != nil {
+		if cerr := q.countDatasetBlobReferencesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countDatasetBlobReferencesStmt: %w", cerr)
+		}
+	}
+	if q.countIncidentAlertsStmt != nil {
+		if cerr := q.countIncidentAlertsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing countIncidentAlertsStmt: %w", cerr)
+		}
+	}
 	if q.createAlertStmt != nil {
 		if cerr := q.createAlertStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createAlertStmt: %w", cerr)
 		}
 	}
+	if q.createAlertGroupStmt != nil {
+		if cerr := q.createAlertGroupStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createAlertGroupStmt: %w", cerr)
+		}
+	}
+	if q.createAlertHistoryStmt != nil {
+		if cerr := q.createAlertHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createAlertHistoryStmt: %w", cerr)
+		}
+	}
+	if q.createAlertSilenceStmt != nil {
+		if cerr := q.createAlertSilenceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createAlertSilenceStmt: %w", cerr)
+		}
+	}
 	if q.createCodeRevisionStmt != nil {
 		if cerr := q.createCodeRevisionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createCodeRevisionStmt: %w", cerr)
@@ -430,6 +942,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createGroupStmt: %w", cerr)
 		}
 	}
+	if q.createIncidentStmt != nil {
+		if cerr := q.createIncidentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createIncidentStmt: %w", cerr)
+		}
+	}
+	if q.createIncidentHistoryStmt != nil {
+		if cerr := q.createIncidentHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createIncidentHistoryStmt: %w", cerr)
+		}
+	}
+	if q.createNotificationChannelStmt != nil {
+		if cerr := q.createNotificationChannelStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createNotificationChannelStmt: %w", cerr)
+		}
+	}
+	if q.createNotificationDeliveryStmt != nil {
+		if cerr := q.createNotificationDeliveryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createNotificationDeliveryStmt: %w", cerr)
+		}
+	}
+	if q.createNotificationRuleStmt != nil {
+		if cerr := q.createNotificationRuleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createNotificationRuleStmt: %w", cerr)
+		}
+	}
 	if q.createPolicyStmt != nil {
 		if cerr := q.createPolicyStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createPolicyStmt: %w", cerr)
@@ -440,16 +977,51 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing createProgramStmt: %w", cerr)
 		}
 	}
+	if q.createProgramJobStmt != nil {
+		if cerr := q.createProgramJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProgramJobStmt: %w", cerr)
+		}
+	}
+	if q.createProgramRunStmt != nil {
+		if cerr := q.createProgramRunStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createProgramRunStmt: %w", cerr)
+		}
+	}
 	if q.createThingStmt != nil {
 		if cerr := q.createThingStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createThingStmt: %w", cerr)
 		}
 	}
+	if q.createThingStateChangeStmt != nil {
+		if cerr := q.createThingStateChangeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createThingStateChangeStmt: %w", cerr)
+		}
+	}
+	if q.createThingStateTransitionStmt != nil {
+		if cerr := q.createThingStateTransitionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createThingStateTransitionStmt: %w", cerr)
+		}
+	}
+	if q.createThingTemplateStmt != nil {
+		if cerr := q.createThingTemplateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createThingTemplateStmt: %w", cerr)
+		}
+	}
 	if q.createTimeseriesStmt != nil {
 		if cerr := q.createTimeseriesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createTimeseriesStmt: %w", cerr)
 		}
 	}
+	if q.createTimeseriesHealthRuleStmt != nil {
+		if cerr := q.createTimeseriesHealthRuleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createTimeseriesHealthRuleStmt: %w", cerr)
+		}
+	}
+	if q.createTimeseriesMonitorStmt != nil {
+		if cerr := q.createTimeseriesMonitorStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createTimeseriesMonitorStmt: %w", cerr)
+		}
+	}
 	if q.createTsDataStmt != nil {
 		if cerr := q.createTsDataStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing createTsDataStmt: %w", cerr)
@@ -470,6 +1042,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteAlertStmt: %w", cerr)
 		}
 	}
+	if q.deleteAlertGroupStmt != nil {
+		if cerr := q.deleteAlertGroupStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAlertGroupStmt: %w", cerr)
+		}
+	}
+	if q.deleteAlertSilenceStmt != nil {
+		if cerr := q.deleteAlertSilenceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteAlertSilenceStmt: %w", cerr)
+		}
+	}
 	if q.deleteAllTsDataStmt != nil {
 		if cerr := q.deleteAllTsDataStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteAllTsDataStmt: %w", cerr)
@@ -480,11 +1062,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteDatasetStmt: %w", cerr)
 		}
 	}
+	if q.deleteDatasetBlobDeletionStmt != nil {
+		if cerr := q.deleteDatasetBlobDeletionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteDatasetBlobDeletionStmt: %w", cerr)
+		}
+	}
+	if q.deleteDatasetSchemaByUUIDStmt != nil {
+		if cerr := q.deleteDatasetSchemaByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteDatasetSchemaByUUIDStmt: %w", cerr)
+		}
+	}
 	if q.deleteGroupStmt != nil {
 		if cerr := q.deleteGroupStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteGroupStmt: %w", cerr)
 		}
 	}
+	if q.deleteNotificationChannelStmt != nil {
+		if cerr := q.deleteNotificationChannelStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteNotificationChannelStmt: %w", cerr)
+		}
+	}
+	if q.deleteNotificationRuleStmt != nil {
+		if cerr := q.deleteNotificationRuleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteNotificationRuleStmt: %w", cerr)
+		}
+	}
 	if q.deletePolicyByUUIDStmt != nil {
 		if cerr := q.deletePolicyByUUIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deletePolicyByUUIDStmt: %w", cerr)
@@ -500,16 +1102,51 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing deleteProgramCodeRevisionStmt: %w", cerr)
 		}
 	}
+	if q.deleteProgramJobsBeforeStmt != nil {
+		if cerr := q.deleteProgramJobsBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteProgramJobsBeforeStmt: %w", cerr)
+		}
+	}
+	if q.deleteProgramRunsBeforeStmt != nil {
+		if cerr := q.deleteProgramRunsBeforeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteProgramRunsBeforeStmt: %w", cerr)
+		}
+	}
 	if q.deleteThingStmt != nil {
 		if cerr := q.deleteThingStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteThingStmt: %w", cerr)
 		}
 	}
+	if q.deleteThingStateTransitionsStmt != nil {
+		if cerr := q.deleteThingStateTransitionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteThingStateTransitionsStmt: %w", cerr)
+		}
+	}
+	if q.deleteThingTemplateStmt != nil {
+		if cerr := q.deleteThingTemplateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteThingTemplateStmt: %w", cerr)
+		}
+	}
+	if q.deleteThingTypeSchemaStmt != nil {
+		if cerr := q.deleteThingTypeSchemaStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteThingTypeSchemaStmt: %w", cerr)
+		}
+	}
 	if q.deleteTimeseriesStmt != nil {
 		if cerr := q.deleteTimeseriesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteTimeseriesStmt: %w", cerr)
 		}
 	}
+	if q.deleteTimeseriesHealthRuleStmt != nil {
+		if cerr := q.deleteTimeseriesHealthRuleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTimeseriesHealthRuleStmt: %w", cerr)
+		}
+	}
+	if q.deleteTimeseriesMonitorStmt != nil {
+		if cerr := q.deleteTimeseriesMonitorStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing deleteTimeseriesMonitorStmt: %w", cerr)
+		}
+	}
 	if q.deleteTokenFromUserStmt != nil {
 		if cerr := q.deleteTokenFromUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing deleteTokenFromUserStmt: %w", cerr)
@@ -565,11 +1202,56 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing existsUserStmt: %w", cerr)
 		}
 	}
+	if q.expireAlertsStmt != nil {
+		if cerr := q.expireAlertsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing expireAlertsStmt: %w", cerr)
+		}
+	}
+	if q.failQueuedProgramRunsStmt != nil {
+		if cerr := q.failQueuedProgramRunsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing failQueuedProgramRunsStmt: %w", cerr)
+		}
+	}
+	if q.failStaleProgramRunsStmt != nil {
+		if cerr := q.failStaleProgramRunsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing failStaleProgramRunsStmt: %w", cerr)
+		}
+	}
+	if q.findActiveNotificationRulesStmt != nil {
+		if cerr := q.findActiveNotificationRulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findActiveNotificationRulesStmt: %w", cerr)
+		}
+	}
 	if q.findAlertByUUIDStmt != nil {
 		if cerr := q.findAlertByUUIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findAlertByUUIDStmt: %w", cerr)
 		}
 	}
+	if q.findAlertGroupByUUIDStmt != nil {
+		if cerr := q.findAlertGroupByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findAlertGroupByUUIDStmt: %w", cerr)
+		}
+	}
+	if q.findAlertGroupsStmt != nil {
+		if cerr := q.findAlertGroupsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findAlertGroupsStmt: %w", cerr)
+		}
+	}
+	if q.findAlertHistoryStmt != nil {
+		if cerr := q.findAlertHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findAlertHistoryStmt: %w", cerr)
+		}
+	}
+	if q.findAlertSilenceByUUIDStmt != nil {
+		if cerr := q.findAlertSilenceByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findAlertSilenceByUUIDStmt: %w", cerr)
+		}
+	}
+	if q.findAlertSilencesStmt != nil {
+		if cerr := q.findAlertSilencesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findAlertSilencesStmt: %w", cerr)
+		}
+	}
 	if q.findAlertsStmt != nil {
 		if cerr := q.findAlertsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findAlertsStmt: %w", cerr)
@@ -585,6 +1267,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing findAllRoutineRevisionsStmt: %w", cerr)
 		}
 	}
+	if q.findAllThingStateTransitionsStmt != nil {
+		if cerr := q.findAllThingStateTransitionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findAllThingStateTransitionsStmt: %w", cerr)
+		}
+	}
+	if q.findCurrentAlertSilencesStmt != nil {
+		if cerr := q.findCurrentAlertSilencesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findCurrentAlertSilencesStmt: %w", cerr)
+		}
+	}
+	if q.findCurrentAlertStatusChangesStmt != nil {
+		if cerr := q.findCurrentAlertStatusChangesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findCurrentAlertStatusChangesStmt: %w", cerr)
+		}
+	}
 	if q.findDatasetByThingStmt != nil {
 		if cerr := q.findDatasetByThingStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findDatasetByThingStmt: %w", cerr)
@@ -595,16 +1292,46 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing findDatasetByUUIDStmt: %w", cerr)
 		}
 	}
+	if q.findDatasetRevisionsStmt != nil {
+		if cerr := q.findDatasetRevisionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findDatasetRevisionsStmt: %w", cerr)
+		}
+	}
+	if q.findDatasetRevisionsByStorageStmt != nil {
+		if cerr := q.findDatasetRevisionsByStorageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findDatasetRevisionsByStorageStmt: %w", cerr)
+		}
+	}
 	if q.findDatasetsStmt != nil {
 		if cerr := q.findDatasetsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findDatasetsStmt: %w", cerr)
 		}
 	}
+	if q.findDatasetsByStorageStmt != nil {
+		if cerr := q.findDatasetsByStorageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findDatasetsByStorageStmt: %w", cerr)
+		}
+	}
 	if q.findDatasetsByTagsStmt != nil {
 		if cerr := q.findDatasetsByTagsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findDatasetsByTagsStmt: %w", cerr)
 		}
 	}
+	if q.findDatasetsByThingsStmt != nil {
+		if cerr := q.findDatasetsByThingsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findDatasetsByThingsStmt: %w", cerr)
+		}
+	}
+	if q.findEnabledAlertGroupsStmt != nil {
+		if cerr := q.findEnabledAlertGroupsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findEnabledAlertGroupsStmt: %w", cerr)
+		}
+	}
+	if q.findEnabledTimeseriesHealthRulesStmt != nil {
+		if cerr := q.findEnabledTimeseriesHealthRulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findEnabledTimeseriesHealthRulesStmt: %w", cerr)
+		}
+	}
 	if q.findGroupByUuidStmt != nil {
 		if cerr := q.findGroupByUuidStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findGroupByUuidStmt: %w", cerr)
@@ -620,6 +1347,61 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing findGroupsByUserStmt: %w", cerr)
 		}
 	}
+	if q.findIncidentAlertsStmt != nil {
+		if cerr := q.findIncidentAlertsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findIncidentAlertsStmt: %w", cerr)
+		}
+	}
+	if q.findIncidentByUUIDStmt != nil {
+		if cerr := q.findIncidentByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findIncidentByUUIDStmt: %w", cerr)
+		}
+	}
+	if q.findIncidentHistoryStmt != nil {
+		if cerr := q.findIncidentHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findIncidentHistoryStmt: %w", cerr)
+		}
+	}
+	if q.findIncidentsStmt != nil {
+		if cerr := q.findIncidentsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findIncidentsStmt: %w", cerr)
+		}
+	}
+	if q.findLatestIncidentByAlertStmt != nil {
+		if cerr := q.findLatestIncidentByAlertStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findLatestIncidentByAlertStmt: %w", cerr)
+		}
+	}
+	if q.findNotificationChannelByUUIDStmt != nil {
+		if cerr := q.findNotificationChannelByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findNotificationChannelByUUIDStmt: %w", cerr)
+		}
+	}
+	if q.findNotificationChannelsStmt != nil {
+		if cerr := q.findNotificationChannelsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findNotificationChannelsStmt: %w", cerr)
+		}
+	}
+	if q.findNotificationDeliveriesStmt != nil {
+		if cerr := q.findNotificationDeliveriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findNotificationDeliveriesStmt: %w", cerr)
+		}
+	}
+	if q.findNotificationRuleByUUIDStmt != nil {
+		if cerr := q.findNotificationRuleByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findNotificationRuleByUUIDStmt: %w", cerr)
+		}
+	}
+	if q.findNotificationRulesStmt != nil {
+		if cerr := q.findNotificationRulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findNotificationRulesStmt: %w", cerr)
+		}
+	}
+	if q.findOpenIncidentByKeyStmt != nil {
+		if cerr := q.findOpenIncidentByKeyStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findOpenIncidentByKeyStmt: %w", cerr)
+		}
+	}
 	if q.findPoliciesStmt != nil {
 		if cerr := q.findPoliciesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findPoliciesStmt: %w", cerr)
@@ -650,6 +1432,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing findProgramCodeRevisionsStmt: %w", cerr)
 		}
 	}
+	if q.findProgramJobByIDStmt != nil {
+		if cerr := q.findProgramJobByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findProgramJobByIDStmt: %w", cerr)
+		}
+	}
+	if q.findProgramJobsStmt != nil {
+		if cerr := q.findProgramJobsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findProgramJobsStmt: %w", cerr)
+		}
+	}
+	if q.findProgramRunByIDStmt != nil {
+		if cerr := q.findProgramRunByIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findProgramRunByIDStmt: %w", cerr)
+		}
+	}
+	if q.findProgramRunsStmt != nil {
+		if cerr := q.findProgramRunsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findProgramRunsStmt: %w", cerr)
+		}
+	}
+	if q.findProgramRunsByJobStmt != nil {
+		if cerr := q.findProgramRunsByJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findProgramRunsByJobStmt: %w", cerr)
+		}
+	}
 	if q.findProgramsStmt != nil {
 		if cerr := q.findProgramsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findProgramsStmt: %w", cerr)
@@ -660,11 +1467,56 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing findProgramsByTagsStmt: %w", cerr)
 		}
 	}
+	if q.findRoutineRevisionsByProgramStmt != nil {
+		if cerr := q.findRoutineRevisionsByProgramStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findRoutineRevisionsByProgramStmt: %w", cerr)
+		}
+	}
+	if q.findThingAncestorsStmt != nil {
+		if cerr := q.findThingAncestorsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findThingAncestorsStmt: %w", cerr)
+		}
+	}
 	if q.findThingByUUIDStmt != nil {
 		if cerr := q.findThingByUUIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findThingByUUIDStmt: %w", cerr)
 		}
 	}
+	if q.findThingDescendantsStmt != nil {
+		if cerr := q.findThingDescendantsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findThingDescendantsStmt: %w", cerr)
+		}
+	}
+	if q.findThingParentStmt != nil {
+		if cerr := q.findThingParentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findThingParentStmt: %w", cerr)
+		}
+	}
+	if q.findThingStateHistoryStmt != nil {
+		if cerr := q.findThingStateHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findThingStateHistoryStmt: %w", cerr)
+		}
+	}
+	if q.findThingStateTransitionsStmt != nil {
+		if cerr := q.findThingStateTransitionsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findThingStateTransitionsStmt: %w", cerr)
+		}
+	}
+	if q.findThingTemplateByUUIDStmt != nil {
+		if cerr := q.findThingTemplateByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findThingTemplateByUUIDStmt: %w", cerr)
+		}
+	}
+	if q.findThingTemplatesStmt != nil {
+		if cerr := q.findThingTemplatesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findThingTemplatesStmt: %w", cerr)
+		}
+	}
+	if q.findThingTypeSchemasStmt != nil {
+		if cerr := q.findThingTypeSchemasStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findThingTypeSchemasStmt: %w", cerr)
+		}
+	}
 	if q.findThingsStmt != nil {
 		if cerr := q.findThingsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findThingsStmt: %w", cerr)
@@ -675,6 +1527,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing findThingsByTagsStmt: %w", cerr)
 		}
 	}
+	if q.findThingsByTypeStmt != nil {
+		if cerr := q.findThingsByTypeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findThingsByTypeStmt: %w", cerr)
+		}
+	}
+	if q.findThingsWithinStmt != nil {
+		if cerr := q.findThingsWithinStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findThingsWithinStmt: %w", cerr)
+		}
+	}
 	if q.findTimeseriesStmt != nil {
 		if cerr := q.findTimeseriesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findTimeseriesStmt: %w", cerr)
@@ -690,16 +1552,51 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing findTimeseriesByThingStmt: %w", cerr)
 		}
 	}
+	if q.findTimeseriesByThingsStmt != nil {
+		if cerr := q.findTimeseriesByThingsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTimeseriesByThingsStmt: %w", cerr)
+		}
+	}
 	if q.findTimeseriesByUUIDStmt != nil {
 		if cerr := q.findTimeseriesByUUIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findTimeseriesByUUIDStmt: %w", cerr)
 		}
 	}
+	if q.findTimeseriesHealthStmt != nil {
+		if cerr := q.findTimeseriesHealthStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTimeseriesHealthStmt: %w", cerr)
+		}
+	}
+	if q.findTimeseriesHealthRuleByUUIDStmt != nil {
+		if cerr := q.findTimeseriesHealthRuleByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTimeseriesHealthRuleByUUIDStmt: %w", cerr)
+		}
+	}
+	if q.findTimeseriesHealthRulesStmt != nil {
+		if cerr := q.findTimeseriesHealthRulesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTimeseriesHealthRulesStmt: %w", cerr)
+		}
+	}
+	if q.findTimeseriesMonitorByUUIDStmt != nil {
+		if cerr := q.findTimeseriesMonitorByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTimeseriesMonitorByUUIDStmt: %w", cerr)
+		}
+	}
+	if q.findTimeseriesMonitorsStmt != nil {
+		if cerr := q.findTimeseriesMonitorsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTimeseriesMonitorsStmt: %w", cerr)
+		}
+	}
 	if q.findTokensByUserStmt != nil {
 		if cerr := q.findTokensByUserStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findTokensByUserStmt: %w", cerr)
 		}
 	}
+	if q.findTsDataHistoryStmt != nil {
+		if cerr := q.findTsDataHistoryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing findTsDataHistoryStmt: %w", cerr)
+		}
+	}
 	if q.findUserByUUIDStmt != nil {
 		if cerr := q.findUserByUUIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing findUserByUUIDStmt: %w", cerr)
@@ -710,11 +1607,41 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing findUsersStmt: %w", cerr)
 		}
 	}
+	if q.getDatasetContentAtHeadStmt != nil {
+		if cerr := q.getDatasetContentAtHeadStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDatasetContentAtHeadStmt: %w", cerr)
+		}
+	}
+	if q.getDatasetContentAtRevisionStmt != nil {
+		if cerr := q.getDatasetContentAtRevisionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDatasetContentAtRevisionStmt: %w", cerr)
+		}
+	}
 	if q.getDatasetContentByUUIDStmt != nil {
 		if cerr := q.getDatasetContentByUUIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getDatasetContentByUUIDStmt: %w", cerr)
 		}
 	}
+	if q.getDatasetContentInfoByUUIDStmt != nil {
+		if cerr := q.getDatasetContentInfoByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDatasetContentInfoByUUIDStmt: %w", cerr)
+		}
+	}
+	if q.getDatasetContentPartStmt != nil {
+		if cerr := q.getDatasetContentPartStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDatasetContentPartStmt: %w", cerr)
+		}
+	}
+	if q.getDatasetSchemaByUUIDStmt != nil {
+		if cerr := q.getDatasetSchemaByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getDatasetSchemaByUUIDStmt: %w", cerr)
+		}
+	}
+	if q.getIncidentActiveAlertsStmt != nil {
+		if cerr := q.getIncidentActiveAlertsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getIncidentActiveAlertsStmt: %w", cerr)
+		}
+	}
 	if q.getNamedModuleCodeAtHeadStmt != nil {
 		if cerr := q.getNamedModuleCodeAtHeadStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getNamedModuleCodeAtHeadStmt: %w", cerr)
@@ -735,16 +1662,36 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getProgramCodeAtRevisionStmt: %w", cerr)
 		}
 	}
+	if q.getProgramCodeRevisionStmt != nil {
+		if cerr := q.getProgramCodeRevisionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getProgramCodeRevisionStmt: %w", cerr)
+		}
+	}
 	if q.getSignedProgramCodeAtHeadStmt != nil {
 		if cerr := q.getSignedProgramCodeAtHeadStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getSignedProgramCodeAtHeadStmt: %w", cerr)
 		}
 	}
+	if q.getThingStateByTimeseriesStmt != nil {
+		if cerr := q.getThingStateByTimeseriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getThingStateByTimeseriesStmt: %w", cerr)
+		}
+	}
+	if q.getThingTypeSchemaStmt != nil {
+		if cerr := q.getThingTypeSchemaStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getThingTypeSchemaStmt: %w", cerr)
+		}
+	}
 	if q.getTimeseriesByUUIDStmt != nil {
 		if cerr := q.getTimeseriesByUUIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getTimeseriesByUUIDStmt: %w", cerr)
 		}
 	}
+	if q.getTsDataHealthStmt != nil {
+		if cerr := q.getTsDataHealthStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTsDataHealthStmt: %w", cerr)
+		}
+	}
 	if q.getTsDataRangeStmt != nil {
 		if cerr := q.getTsDataRangeStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getTsDataRangeStmt: %w", cerr)
@@ -755,6 +1702,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getTsDataRangeAggStmt: %w", cerr)
 		}
 	}
+	if q.getTsDataWindowAggStmt != nil {
+		if cerr := q.getTsDataWindowAggStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getTsDataWindowAggStmt: %w", cerr)
+		}
+	}
 	if q.getUnitFromTimeseriesStmt != nil {
 		if cerr := q.getUnitFromTimeseriesStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing getUnitFromTimeseriesStmt: %w", cerr)
@@ -765,6 +1717,51 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getUserUuidFromTokenStmt: %w", cerr)
 		}
 	}
+	if q.heartbeatProgramJobStmt != nil {
+		if cerr := q.heartbeatProgramJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing heartbeatProgramJobStmt: %w", cerr)
+		}
+	}
+	if q.initTimeseriesHealthStmt != nil {
+		if cerr := q.initTimeseriesHealthStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing initTimeseriesHealthStmt: %w", cerr)
+		}
+	}
+	if q.killProgramJobStmt != nil {
+		if cerr := q.killProgramJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing killProgramJobStmt: %w", cerr)
+		}
+	}
+	if q.lockDatasetBlobStmt != nil {
+		if cerr := q.lockDatasetBlobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing lockDatasetBlobStmt: %w", cerr)
+		}
+	}
+	if q.markTimeseriesMonitorsDueStmt != nil {
+		if cerr := q.markTimeseriesMonitorsDueStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing markTimeseriesMonitorsDueStmt: %w", cerr)
+		}
+	}
+	if q.queueProgramRunStmt != nil {
+		if cerr := q.queueProgramRunStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing queueProgramRunStmt: %w", cerr)
+		}
+	}
+	if q.releaseProgramManagerLeaseStmt != nil {
+		if cerr := q.releaseProgramManagerLeaseStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing releaseProgramManagerLeaseStmt: %w", cerr)
+		}
+	}
+	if q.releaseSilencedAlertsStmt != nil {
+		if cerr := q.releaseSilencedAlertsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing releaseSilencedAlertsStmt: %w", cerr)
+		}
+	}
+	if q.removeThingDepStmt != nil {
+		if cerr := q.removeThingDepStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing removeThingDepStmt: %w", cerr)
+		}
+	}
 	if q.removeUserFromAllGroupsStmt != nil {
 		if cerr := q.removeUserFromAllGroupsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing removeUserFromAllGroupsStmt: %w", cerr)
@@ -775,6 +1772,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing removeUserFromGroupsStmt: %w", cerr)
 		}
 	}
+	if q.requeueProgramJobStmt != nil {
+		if cerr := q.requeueProgramJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing requeueProgramJobStmt: %w", cerr)
+		}
+	}
+	if q.restoreTsDataChangeStmt != nil {
+		if cerr := q.restoreTsDataChangeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing restoreTsDataChangeStmt: %w", cerr)
+		}
+	}
+	if q.retryProgramJobStmt != nil {
+		if cerr := q.retryProgramJobStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing retryProgramJobStmt: %w", cerr)
+		}
+	}
+	if q.rollbackDatasetToRevisionStmt != nil {
+		if cerr := q.rollbackDatasetToRevisionStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing rollbackDatasetToRevisionStmt: %w", cerr)
+		}
+	}
+	if q.setAuditContextStmt != nil {
+		if cerr := q.setAuditContextStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setAuditContextStmt: %w", cerr)
+		}
+	}
 	if q.setDatasetContentByUUIDStmt != nil {
 		if cerr := q.setDatasetContentByUUIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setDatasetContentByUUIDStmt: %w", cerr)
@@ -785,11 +1807,31 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing setDatasetFormatByUUIDStmt: %w", cerr)
 		}
 	}
+	if q.setDatasetMaxRevisionsByUUIDStmt != nil {
+		if cerr := q.setDatasetMaxRevisionsByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setDatasetMaxRevisionsByUUIDStmt: %w", cerr)
+		}
+	}
 	if q.setDatasetNameByUUIDStmt != nil {
 		if cerr := q.setDatasetNameByUUIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setDatasetNameByUUIDStmt: %w", cerr)
 		}
 	}
+	if q.setDatasetRevisionStorageStmt != nil {
+		if cerr := q.setDatasetRevisionStorageStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setDatasetRevisionStorageStmt: %w", cerr)
+		}
+	}
+	if q.setDatasetSchemaByUUIDStmt != nil {
+		if cerr := q.setDatasetSchemaByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setDatasetSchemaByUUIDStmt: %w", cerr)
+		}
+	}
+	if q.setDatasetStorageByUUIDStmt != nil {
+		if cerr := q.setDatasetStorageByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setDatasetStorageByUUIDStmt: %w", cerr)
+		}
+	}
 	if q.setDatasetTagsStmt != nil {
 		if cerr := q.setDatasetTagsStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setDatasetTagsStmt: %w", cerr)
@@ -805,6 +1847,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing setGroupNameByUUIDStmt: %w", cerr)
 		}
 	}
+	if q.setNotificationDeliveryFailedStmt != nil {
+		if cerr := q.setNotificationDeliveryFailedStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setNotificationDeliveryFailedStmt: %w", cerr)
+		}
+	}
+	if q.setNotificationDeliveryRetryStmt != nil {
+		if cerr := q.setNotificationDeliveryRetryStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setNotificationDeliveryRetryStmt: %w", cerr)
+		}
+	}
+	if q.setNotificationDeliverySentStmt != nil {
+		if cerr := q.setNotificationDeliverySentStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setNotificationDeliverySentStmt: %w", cerr)
+		}
+	}
 	if q.setPolicyActionStmt != nil {
 		if cerr := q.setPolicyActionStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setPolicyActionStmt: %w", cerr)
@@ -865,6 +1922,21 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing setProgramTypeByUUIDStmt: %w", cerr)
 		}
 	}
+	if q.setThingAreaByUUIDStmt != nil {
+		if cerr := q.setThingAreaByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setThingAreaByUUIDStmt: %w", cerr)
+		}
+	}
+	if q.setThingAttributesByUUIDStmt != nil {
+		if cerr := q.setThingAttributesByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setThingAttributesByUUIDStmt: %w", cerr)
+		}
+	}
+	if q.setThingLocationByUUIDStmt != nil {
+		if cerr := q.setThingLocationByUUIDStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setThingLocationByUUIDStmt: %w", cerr)
+		}
+	}
 	if q.setThingNameByUUIDStmt != nil {
 		if cerr := q.setThingNameByUUIDStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setThingNameByUUIDStmt: %w", cerr)
@@ -885,6 +1957,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing setThingTypeByUUIDStmt: %w", cerr)
 		}
 	}
+	if q.setThingTypeSchemaStmt != nil {
+		if cerr := q.setThingTypeSchemaStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setThingTypeSchemaStmt: %w", cerr)
+		}
+	}
+	if q.setTimeseriesAttributesStmt != nil {
+		if cerr := q.setTimeseriesAttributesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing setTimeseriesAttributesStmt: %w", cerr)
+		}
+	}
 	if q.setTimeseriesLowerBoundStmt != nil {
 		if cerr := q.setTimeseriesLowerBoundStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing setTimeseriesLowerBoundStmt: %w", cerr)
@@ -925,6 +2007,26 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing signProgramCodeRevisionStmt: %w", cerr)
 		}
 	}
+	if q.silenceAlertStmt != nil {
+		if cerr := q.silenceAlertStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing silenceAlertStmt: %w", cerr)
+		}
+	}
+	if q.startProgramRunStmt != nil {
+		if cerr := q.startProgramRunStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing startProgramRunStmt: %w", cerr)
+		}
+	}
+	if q.unshelveAlertsStmt != nil {
+		if cerr := q.unshelveAlertsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing unshelveAlertsStmt: %w", cerr)
+		}
+	}
+	if q.updateAlertGroupStmt != nil {
+		if cerr := q.updateAlertGroupStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateAlertGroupStmt: %w", cerr)
+		}
+	}
 	if q.updateAlertIncDuplicateStmt != nil {
 		if cerr := q.updateAlertIncDuplicateStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateAlertIncDuplicateStmt: %w", cerr)
@@ -975,6 +2077,11 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateAlertSetSeverityStmt: %w", cerr)
 		}
 	}
+	if q.updateAlertSetShelvedUntilStmt != nil {
+		if cerr := q.updateAlertSetShelvedUntilStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateAlertSetShelvedUntilStmt: %w", cerr)
+		}
+	}
 	if q.updateAlertSetStatusStmt != nil {
 		if cerr := q.updateAlertSetStatusStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing updateAlertSetStatusStmt: %w", cerr)
@@ -995,6 +2102,51 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing updateAlertSetValueStmt: %w", cerr)
 		}
 	}
+	if q.updateAlertSilenceStmt != nil {
+		if cerr := q.updateAlertSilenceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateAlertSilenceStmt: %w", cerr)
+		}
+	}
+	if q.updateIncidentStateStmt != nil {
+		if cerr := q.updateIncidentStateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateIncidentStateStmt: %w", cerr)
+		}
+	}
+	if q.updateNotificationChannelStmt != nil {
+		if cerr := q.updateNotificationChannelStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateNotificationChannelStmt: %w", cerr)
+		}
+	}
+	if q.updateNotificationRuleStmt != nil {
+		if cerr := q.updateNotificationRuleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateNotificationRuleStmt: %w", cerr)
+		}
+	}
+	if q.updateThingTemplateStmt != nil {
+		if cerr := q.updateThingTemplateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateThingTemplateStmt: %w", cerr)
+		}
+	}
+	if q.updateTimeseriesHealthStmt != nil {
+		if cerr := q.updateTimeseriesHealthStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateTimeseriesHealthStmt: %w", cerr)
+		}
+	}
+	if q.updateTimeseriesHealthRuleStmt != nil {
+		if cerr := q.updateTimeseriesHealthRuleStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateTimeseriesHealthRuleStmt: %w", cerr)
+		}
+	}
+	if q.updateTimeseriesMonitorStmt != nil {
+		if cerr := q.updateTimeseriesMonitorStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateTimeseriesMonitorStmt: %w", cerr)
+		}
+	}
+	if q.updateTimeseriesMonitorStateStmt != nil {
+		if cerr := q.updateTimeseriesMonitorStateStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing updateTimeseriesMonitorStateStmt: %w", cerr)
+		}
+	}
 	return err
 }
 
@@ -1032,255 +2184,543 @@ func (q *Queries) queryRow(ctx context.Context, stmt *sql.Stmt, query string, ar
 }
 
 type Queries struct {
-	db                                 DBTX
-	tx                                 *sql.Tx
-	addTokenToUserStmt                 *sql.Stmt
-	addUserToGroupStmt                 *sql.Stmt
-	checkUserTokenHasAccessStmt        *sql.Stmt
-	checkUserTokenHasAccessManyStmt    *sql.Stmt
-	createAlertStmt                    *sql.Stmt
-	createCodeRevisionStmt             *sql.Stmt
-	createDatasetStmt                  *sql.Stmt
-	createGroupStmt                    *sql.Stmt
-	createPolicyStmt                   *sql.Stmt
-	createProgramStmt                  *sql.Stmt
-	createThingStmt                    *sql.Stmt
-	createTimeseriesStmt               *sql.Stmt
-	createTsDataStmt                   *sql.Stmt
-	createUserStmt                     *sql.Stmt
-	createUserTokenStmt                *sql.Stmt
-	deleteAlertStmt                    *sql.Stmt
-	deleteAllTsDataStmt                *sql.Stmt
-	deleteDatasetStmt                  *sql.Stmt
-	deleteGroupStmt                    *sql.Stmt
-	deletePolicyByUUIDStmt             *sql.Stmt
-	deleteProgramStmt                  *sql.Stmt
-	deleteProgramCodeRevisionStmt      *sql.Stmt
-	deleteThingStmt                    *sql.Stmt
-	deleteTimeseriesStmt               *sql.Stmt
-	deleteTokenFromUserStmt            *sql.Stmt
-	deleteTsDataRangeStmt              *sql.Stmt
-	deleteUserStmt                     *sql.Stmt
-	existsAlertStmt                    *sql.Stmt
-	existsDatasetStmt                  *sql.Stmt
-	existsGroupStmt                    *sql.Stmt
-	existsPolicyStmt                   *sql.Stmt
-	existsProgramStmt                  *sql.Stmt
-	existsThingStmt                    *sql.Stmt
-	existsTimeseriesStmt               *sql.Stmt
-	existsUserStmt                     *sql.Stmt
-	findAlertByUUIDStmt                *sql.Stmt
-	findAlertsStmt                     *sql.Stmt
-	findAllModulesStmt                 *sql.Stmt
-	findAllRoutineRevisionsStmt        *sql.Stmt
-	findDatasetByThingStmt             *sql.Stmt
-	findDatasetByUUIDStmt              *sql.Stmt
-	findDatasetsStmt                   *sql.Stmt
-	findDatasetsByTagsStmt             *sql.Stmt
-	findGroupByUuidStmt                *sql.Stmt
-	findGroupsStmt                     *sql.Stmt
-	findGroupsByUserStmt               *sql.Stmt
-	findPoliciesStmt                   *sql.Stmt
-	findPoliciesByGroupStmt            *sql.Stmt
-	findPoliciesByUserStmt             *sql.Stmt
-	findPolicyByUUIDStmt               *sql.Stmt
-	findProgramByUUIDStmt              *sql.Stmt
-	findProgramCodeRevisionsStmt       *sql.Stmt
-	findProgramsStmt                   *sql.Stmt
-	findProgramsByTagsStmt             *sql.Stmt
-	findThingByUUIDStmt                *sql.Stmt
-	findThingsStmt                     *sql.Stmt
-	findThingsByTagsStmt               *sql.Stmt
-	findTimeseriesStmt                 *sql.Stmt
-	findTimeseriesByTagsStmt           *sql.Stmt
-	findTimeseriesByThingStmt          *sql.Stmt
-	findTimeseriesByUUIDStmt           *sql.Stmt
-	findTokensByUserStmt               *sql.Stmt
-	findUserByUUIDStmt                 *sql.Stmt
-	findUsersStmt                      *sql.Stmt
-	getDatasetContentByUUIDStmt        *sql.Stmt
-	getNamedModuleCodeAtHeadStmt       *sql.Stmt
-	getNamedModuleCodeAtRevisionStmt   *sql.Stmt
-	getProgramCodeAtHeadStmt           *sql.Stmt
-	getProgramCodeAtRevisionStmt       *sql.Stmt
-	getSignedProgramCodeAtHeadStmt     *sql.Stmt
-	getTimeseriesByUUIDStmt            *sql.Stmt
-	getTsDataRangeStmt                 *sql.Stmt
-	getTsDataRangeAggStmt              *sql.Stmt
-	getUnitFromTimeseriesStmt          *sql.Stmt
-	getUserUuidFromTokenStmt           *sql.Stmt
-	removeUserFromAllGroupsStmt        *sql.Stmt
-	removeUserFromGroupsStmt           *sql.Stmt
-	setDatasetContentByUUIDStmt        *sql.Stmt
-	setDatasetFormatByUUIDStmt         *sql.Stmt
-	setDatasetNameByUUIDStmt           *sql.Stmt
-	setDatasetTagsStmt                 *sql.Stmt
-	setDatasetThingByUUIDStmt          *sql.Stmt
-	setGroupNameByUUIDStmt             *sql.Stmt
-	setPolicyActionStmt                *sql.Stmt
-	setPolicyEffectStmt                *sql.Stmt
-	setPolicyGroupStmt                 *sql.Stmt
-	setPolicyPriorityStmt              *sql.Stmt
-	setPolicyResourceStmt              *sql.Stmt
-	setProgramDeadlineByUUIDStmt       *sql.Stmt
-	setProgramLanguageByUUIDStmt       *sql.Stmt
-	setProgramNameByUUIDStmt           *sql.Stmt
-	setProgramScheduleByUUIDStmt       *sql.Stmt
-	setProgramStateByUUIDStmt          *sql.Stmt
-	setProgramTagsStmt                 *sql.Stmt
-	setProgramTypeByUUIDStmt           *sql.Stmt
-	setThingNameByUUIDStmt             *sql.Stmt
-	setThingStateByUUIDStmt            *sql.Stmt
-	setThingTagsStmt                   *sql.Stmt
-	setThingTypeByUUIDStmt             *sql.Stmt
-	setTimeseriesLowerBoundStmt        *sql.Stmt
-	setTimeseriesNameStmt              *sql.Stmt
-	setTimeseriesSiUnitStmt            *sql.Stmt
-	setTimeseriesTagsStmt              *sql.Stmt
-	setTimeseriesThingStmt             *sql.Stmt
-	setTimeseriesUpperBoundStmt        *sql.Stmt
-	setUserNameStmt                    *sql.Stmt
-	signProgramCodeRevisionStmt        *sql.Stmt
-	updateAlertIncDuplicateStmt        *sql.Stmt
-	updateAlertSetDescriptionStmt      *sql.Stmt
-	updateAlertSetEnvironmentStmt      *sql.Stmt
-	updateAlertSetEventStmt            *sql.Stmt
-	updateAlertSetLastReceivedTimeStmt *sql.Stmt
-	updateAlertSetOriginStmt           *sql.Stmt
-	updateAlertSetRawdataStmt          *sql.Stmt
-	updateAlertSetResourceStmt         *sql.Stmt
-	updateAlertSetServiceStmt          *sql.Stmt
-	updateAlertSetSeverityStmt         *sql.Stmt
-	updateAlertSetStatusStmt           *sql.Stmt
-	updateAlertSetTagsStmt             *sql.Stmt
-	updateAlertSetTimeoutStmt          *sql.Stmt
-	updateAlertSetValueStmt            *sql.Stmt
+	db                                   DBTX
+	tx                                   *sql.Tx
+	acquireProgramManagerLeaseStmt       *sql.Stmt
+	addIncidentAlertStmt                 *sql.Stmt
+	addThingDepStmt                      *sql.Stmt
+	addTokenToUserStmt                   *sql.Stmt
+	addUserToGroupStmt                   *sql.Stmt
+	checkUserTokenHasAccessStmt          *sql.Stmt
+	checkUserTokenHasAccessManyStmt      *sql.Stmt
+	claimDatasetBlobDeletionStmt         *sql.Stmt
+	claimDueTimeseriesMonitorsStmt       *sql.Stmt
+	claimNotificationDeliveriesStmt      *sql.Stmt
+	claimProgramJobsStmt                 *sql.Stmt
+	claimTimeseriesHealthStmt            *sql.Stmt
+	closeIncidentAlertsStmt              *sql.Stmt
+	completeProgramJobStmt               *sql.Stmt
+	completeProgramRunStmt               *sql.Stmt
+	countDatasetBlobReferencesStmt       *sql.Stmt
+	countIncidentAlertsStmt              *sql.Stmt
+	createAlertStmt                      *sql.Stmt
+	createAlertGroupStmt                 *sql.Stmt
+	createAlertHistoryStmt               *sql.Stmt
+	createAlertSilenceStmt               *sql.Stmt
+	createCodeRevisionStmt               *sql.Stmt
+	createDatasetStmt                    *sql.Stmt
+	createGroupStmt                      *sql.Stmt
+	createIncidentStmt                   *sql.Stmt
+	createIncidentHistoryStmt            *sql.Stmt
+	createNotificationChannelStmt        *sql.Stmt
+	createNotificationDeliveryStmt       *sql.Stmt
+	createNotificationRuleStmt           *sql.Stmt
+	createPolicyStmt                     *sql.Stmt
+	createProgramStmt                    *sql.Stmt
+	createProgramJobStmt                 *sql.Stmt
+	createProgramRunStmt                 *sql.Stmt
+	createThingStmt                      *sql.Stmt
+	createThingStateChangeStmt           *sql.Stmt
+	createThingStateTransitionStmt       *sql.Stmt
+	createThingTemplateStmt              *sql.Stmt
+	createTimeseriesStmt                 *sql.Stmt
+	createTimeseriesHealthRuleStmt       *sql.Stmt
+	createTimeseriesMonitorStmt          *sql.Stmt
+	createTsDataStmt                     *sql.Stmt
+	createUserStmt                       *sql.Stmt
+	createUserTokenStmt                  *sql.Stmt
+	deleteAlertStmt                      *sql.Stmt
+	deleteAlertGroupStmt                 *sql.Stmt
+	deleteAlertSilenceStmt               *sql.Stmt
+	deleteAllTsDataStmt                  *sql.Stmt
+	deleteDatasetStmt                    *sql.Stmt
+	deleteDatasetBlobDeletionStmt        *sql.Stmt
+	deleteDatasetSchemaByUUIDStmt        *sql.Stmt
+	deleteGroupStmt                      *sql.Stmt
+	deleteNotificationChannelStmt        *sql.Stmt
+	deleteNotificationRuleStmt           *sql.Stmt
+	deletePolicyByUUIDStmt               *sql.Stmt
+	deleteProgramStmt                    *sql.Stmt
+	deleteProgramCodeRevisionStmt        *sql.Stmt
+	deleteProgramJobsBeforeStmt          *sql.Stmt
+	deleteProgramRunsBeforeStmt          *sql.Stmt
+	deleteThingStmt                      *sql.Stmt
+	deleteThingStateTransitionsStmt      *sql.Stmt
+	deleteThingTemplateStmt              *sql.Stmt
+	deleteThingTypeSchemaStmt            *sql.Stmt
+	deleteTimeseriesStmt                 *sql.Stmt
+	deleteTimeseriesHealthRuleStmt       *sql.Stmt
+	deleteTimeseriesMonitorStmt          *sql.Stmt
+	deleteTokenFromUserStmt              *sql.Stmt
+	deleteTsDataRangeStmt                *sql.Stmt
+	deleteUserStmt                       *sql.Stmt
+	existsAlertStmt                      *sql.Stmt
+	existsDatasetStmt                    *sql.Stmt
+	existsGroupStmt                      *sql.Stmt
+	existsPolicyStmt                     *sql.Stmt
+	existsProgramStmt                    *sql.Stmt
+	existsThingStmt                      *sql.Stmt
+	existsTimeseriesStmt                 *sql.Stmt
+	existsUserStmt                       *sql.Stmt
+	expireAlertsStmt                     *sql.Stmt
+	failQueuedProgramRunsStmt            *sql.Stmt
+	failStaleProgramRunsStmt             *sql.Stmt
+	findActiveNotificationRulesStmt      *sql.Stmt
+	findAlertByUUIDStmt                  *sql.Stmt
+	findAlertGroupByUUIDStmt             *sql.Stmt
+	findAlertGroupsStmt                  *sql.Stmt
+	findAlertHistoryStmt                 *sql.Stmt
+	findAlertSilenceByUUIDStmt           *sql.Stmt
+	findAlertSilencesStmt                *sql.Stmt
+	findAlertsStmt                       *sql.Stmt
+	findAllModulesStmt                   *sql.Stmt
+	findAllRoutineRevisionsStmt          *sql.Stmt
+	findAllThingStateTransitionsStmt     *sql.Stmt
+	findCurrentAlertSilencesStmt         *sql.Stmt
+	findCurrentAlertStatusChangesStmt    *sql.Stmt
+	findDatasetByThingStmt               *sql.Stmt
+	findDatasetByUUIDStmt                *sql.Stmt
+	findDatasetRevisionsStmt             *sql.Stmt
+	findDatasetRevisionsByStorageStmt    *sql.Stmt
+	findDatasetsStmt                     *sql.Stmt
+	findDatasetsByStorageStmt            *sql.Stmt
+	findDatasetsByTagsStmt               *sql.Stmt
+	findDatasetsByThingsStmt             *sql.Stmt
+	findEnabledAlertGroupsStmt           *sql.Stmt
+	findEnabledTimeseriesHealthRulesStmt *sql.Stmt
+	findGroupByUuidStmt                  *sql.Stmt
+	findGroupsStmt                       *sql.Stmt
+	findGroupsByUserStmt                 *sql.Stmt
+	findIncidentAlertsStmt               *sql.Stmt
+	findIncidentByUUIDStmt               *sql.Stmt
+	findIncidentHistoryStmt              *sql.Stmt
+	findIncidentsStmt                    *sql.Stmt
+	findLatestIncidentByAlertStmt        *sql.Stmt
+	findNotificationChannelByUUIDStmt    *sql.Stmt
+	findNotificationChannelsStmt         *sql.Stmt
+	findNotificationDeliveriesStmt       *sql.Stmt
+	findNotificationRuleByUUIDStmt       *sql.Stmt
+	findNotificationRulesStmt            *sql.Stmt
+	findOpenIncidentByKeyStmt            *sql.Stmt
+	findPoliciesStmt                     *sql.Stmt
+	findPoliciesByGroupStmt              *sql.Stmt
+	findPoliciesByUserStmt               *sql.Stmt
+	findPolicyByUUIDStmt                 *sql.Stmt
+	findProgramByUUIDStmt                *sql.Stmt
+	findProgramCodeRevisionsStmt         *sql.Stmt
+	findProgramJobByIDStmt               *sql.Stmt
+	findProgramJobsStmt                  *sql.Stmt
+	findProgramRunByIDStmt               *sql.Stmt
+	findProgramRunsStmt                  *sql.Stmt
+	findProgramRunsByJobStmt             *sql.Stmt
+	findProgramsStmt                     *sql.Stmt
+	findProgramsByTagsStmt               *sql.Stmt
+	findRoutineRevisionsByProgramStmt    *sql.Stmt
+	findThingAncestorsStmt               *sql.Stmt
+	findThingByUUIDStmt                  *sql.Stmt
+	findThingDescendantsStmt             *sql.Stmt
+	findThingParentStmt                  *sql.Stmt
+	findThingStateHistoryStmt            *sql.Stmt
+	findThingStateTransitionsStmt        *sql.Stmt
+	findThingTemplateByUUIDStmt          *sql.Stmt
+	findThingTemplatesStmt               *sql.Stmt
+	findThingTypeSchemasStmt             *sql.Stmt
+	findThingsStmt                       *sql.Stmt
+	findThingsByTagsStmt                 *sql.Stmt
+	findThingsByTypeStmt                 *sql.Stmt
+	findThingsWithinStmt                 *sql.Stmt
+	findTimeseriesStmt                   *sql.Stmt
+	findTimeseriesByTagsStmt             *sql.Stmt
+	findTimeseriesByThingStmt            *sql.Stmt
+	findTimeseriesByThingsStmt           *sql.Stmt
+	findTimeseriesByUUIDStmt             *sql.Stmt
+	findTimeseriesHealthStmt             *sql.Stmt
+	findTimeseriesHealthRuleByUUIDStmt   *sql.Stmt
+	findTimeseriesHealthRulesStmt        *sql.Stmt
+	findTimeseriesMonitorByUUIDStmt      *sql.Stmt
+	findTimeseriesMonitorsStmt           *sql.Stmt
+	findTokensByUserStmt                 *sql.Stmt
+	findTsDataHistoryStmt                *sql.Stmt
+	findUserByUUIDStmt                   *sql.Stmt
+	findUsersStmt                        *sql.Stmt
+	getDatasetContentAtHeadStmt          *sql.Stmt
+	getDatasetContentAtRevisionStmt      *sql.Stmt
+	getDatasetContentByUUIDStmt          *sql.Stmt
+	getDatasetContentInfoByUUIDStmt      *sql.Stmt
+	getDatasetContentPartStmt            *sql.Stmt
+	getDatasetSchemaByUUIDStmt           *sql.Stmt
+	getIncidentActiveAlertsStmt          *sql.Stmt
+	getNamedModuleCodeAtHeadStmt         *sql.Stmt
+	getNamedModuleCodeAtRevisionStmt     *sql.Stmt
+	getProgramCodeAtHeadStmt             *sql.Stmt
+	getProgramCodeAtRevisionStmt         *sql.Stmt
+	getProgramCodeRevisionStmt           *sql.Stmt
+	getSignedProgramCodeAtHeadStmt       *sql.Stmt
+	getThingStateByTimeseriesStmt        *sql.Stmt
+	getThingTypeSchemaStmt               *sql.Stmt
+	getTimeseriesByUUIDStmt              *sql.Stmt
+	getTsDataHealthStmt                  *sql.Stmt
+	getTsDataRangeStmt                   *sql.Stmt
+	getTsDataRangeAggStmt                *sql.Stmt
+	getTsDataWindowAggStmt               *sql.Stmt
+	getUnitFromTimeseriesStmt            *sql.Stmt
+	getUserUuidFromTokenStmt             *sql.Stmt
+	heartbeatProgramJobStmt              *sql.Stmt
+	initTimeseriesHealthStmt             *sql.Stmt
+	killProgramJobStmt                   *sql.Stmt
+	lockDatasetBlobStmt                  *sql.Stmt
+	markTimeseriesMonitorsDueStmt        *sql.Stmt
+	queueProgramRunStmt                  *sql.Stmt
+	releaseProgramManagerLeaseStmt       *sql.Stmt
+	releaseSilencedAlertsStmt            *sql.Stmt
+	removeThingDepStmt                   *sql.Stmt
+	removeUserFromAllGroupsStmt          *sql.Stmt
+	removeUserFromGroupsStmt             *sql.Stmt
+	requeueProgramJobStmt                *sql.Stmt
+	restoreTsDataChangeStmt              *sql.Stmt
+	retryProgramJobStmt                  *sql.Stmt
+	rollbackDatasetToRevisionStmt        *sql.Stmt
+	setAuditContextStmt                  *sql.Stmt
+	setDatasetContentByUUIDStmt          *sql.Stmt
+	setDatasetFormatByUUIDStmt           *sql.Stmt
+	setDatasetMaxRevisionsByUUIDStmt     *sql.Stmt
+	setDatasetNameByUUIDStmt             *sql.Stmt
+	setDatasetRevisionStorageStmt        *sql.Stmt
+	setDatasetSchemaByUUIDStmt           *sql.Stmt
+	setDatasetStorageByUUIDStmt          *sql.Stmt
+	setDatasetTagsStmt                   *sql.Stmt
+	setDatasetThingByUUIDStmt            *sql.Stmt
+	setGroupNameByUUIDStmt               *sql.Stmt
+	setNotificationDeliveryFailedStmt    *sql.Stmt
+	setNotificationDeliveryRetryStmt     *sql.Stmt
+	setNotificationDeliverySentStmt      *sql.Stmt
+	setPolicyActionStmt                  *sql.Stmt
+	setPolicyEffectStmt                  *sql.Stmt
+	setPolicyGroupStmt                   *sql.Stmt
+	setPolicyPriorityStmt                *sql.Stmt
+	setPolicyResourceStmt                *sql.Stmt
+	setProgramDeadlineByUUIDStmt         *sql.Stmt
+	setProgramLanguageByUUIDStmt         *sql.Stmt
+	setProgramNameByUUIDStmt             *sql.Stmt
+	setProgramScheduleByUUIDStmt         *sql.Stmt
+	setProgramStateByUUIDStmt            *sql.Stmt
+	setProgramTagsStmt                   *sql.Stmt
+	setProgramTypeByUUIDStmt             *sql.Stmt
+	setThingAreaByUUIDStmt               *sql.Stmt
+	setThingAttributesByUUIDStmt         *sql.Stmt
+	setThingLocationByUUIDStmt           *sql.Stmt
+	setThingNameByUUIDStmt               *sql.Stmt
+	setThingStateByUUIDStmt              *sql.Stmt
+	setThingTagsStmt                     *sql.Stmt
+	setThingTypeByUUIDStmt               *sql.Stmt
+	setThingTypeSchemaStmt               *sql.Stmt
+	setTimeseriesAttributesStmt          *sql.Stmt
+	setTimeseriesLowerBoundStmt          *sql.Stmt
+	setTimeseriesNameStmt                *sql.Stmt
+	setTimeseriesSiUnitStmt              *sql.Stmt
+	setTimeseriesTagsStmt                *sql.Stmt
+	setTimeseriesThingStmt               *sql.Stmt
+	setTimeseriesUpperBoundStmt          *sql.Stmt
+	setUserNameStmt                      *sql.Stmt
+	signProgramCodeRevisionStmt          *sql.Stmt
+	silenceAlertStmt                     *sql.Stmt
+	startProgramRunStmt                  *sql.Stmt
+	unshelveAlertsStmt                   *sql.Stmt
+	updateAlertGroupStmt                 *sql.Stmt
+	updateAlertIncDuplicateStmt          *sql.Stmt
+	updateAlertSetDescriptionStmt        *sql.Stmt
+	updateAlertSetEnvironmentStmt        *sql.Stmt
+	updateAlertSetEventStmt              *sql.Stmt
+	updateAlertSetLastReceivedTimeStmt   *sql.Stmt
+	updateAlertSetOriginStmt             *sql.Stmt
+	updateAlertSetRawdataStmt            *sql.Stmt
+	updateAlertSetResourceStmt           *sql.Stmt
+	updateAlertSetServiceStmt            *sql.Stmt
+	updateAlertSetSeverityStmt           *sql.Stmt
+	updateAlertSetShelvedUntilStmt       *sql.Stmt
+	updateAlertSetStatusStmt             *sql.Stmt
+	updateAlertSetTagsStmt               *sql.Stmt
+	updateAlertSetTimeoutStmt            *sql.Stmt
+	updateAlertSetValueStmt              *sql.Stmt
+	updateAlertSilenceStmt               *sql.Stmt
+	updateIncidentStateStmt              *sql.Stmt
+	updateNotificationChannelStmt        *sql.Stmt
+	updateNotificationRuleStmt           *sql.Stmt
+	updateThingTemplateStmt              *sql.Stmt
+	updateTimeseriesHealthStmt           *sql.Stmt
+	updateTimeseriesHealthRuleStmt       *sql.Stmt
+	updateTimeseriesMonitorStmt          *sql.Stmt
+	updateTimeseriesMonitorStateStmt     *sql.Stmt
 }
 
 func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 	return &Queries{
-		db:                                 tx,
-		tx:                                 tx,
-		addTokenToUserStmt:                 q.addTokenToUserStmt,
-		addUserToGroupStmt:                 q.addUserToGroupStmt,
-		checkUserTokenHasAccessStmt:        q.checkUserTokenHasAccessStmt,
-		checkUserTokenHasAccessManyStmt:    q.checkUserTokenHasAccessManyStmt,
-		createAlertStmt:                    q.createAlertStmt,
-		createCodeRevisionStmt:             q.createCodeRevisionStmt,
-		createDatasetStmt:                  q.createDatasetStmt,
-		createGroupStmt:                    q.createGroupStmt,
-		createPolicyStmt:                   q.createPolicyStmt,
-		createProgramStmt:                  q.createProgramStmt,
-		createThingStmt:                    q.createThingStmt,
-		createTimeseriesStmt:               q.createTimeseriesStmt,
-		createTsDataStmt:                   q.createTsDataStmt,
-		createUserStmt:                     q.createUserStmt,
-		createUserTokenStmt:                q.createUserTokenStmt,
-		deleteAlertStmt:                    q.deleteAlertStmt,
-		deleteAllTsDataStmt:                q.deleteAllTsDataStmt,
-		deleteDatasetStmt:                  q.deleteDatasetStmt,
-		deleteGroupStmt:                    q.deleteGroupStmt,
-		deletePolicyByUUIDStmt:             q.deletePolicyByUUIDStmt,
-		deleteProgramStmt:                  q.deleteProgramStmt,
-		deleteProgramCodeRevisionStmt:      q.deleteProgramCodeRevisionStmt,
-		deleteThingStmt:                    q.deleteThingStmt,
-		deleteTimeseriesStmt:               q.deleteTimeseriesStmt,
-		deleteTokenFromUserStmt:            q.deleteTokenFromUserStmt,
-		deleteTsDataRangeStmt:              q.deleteTsDataRangeStmt,
-		deleteUserStmt:                     q.deleteUserStmt,
-		existsAlertStmt:                    q.existsAlertStmt,
-		existsDatasetStmt:                  q.existsDatasetStmt,
-		existsGroupStmt:                    q.existsGroupStmt,
-		existsPolicyStmt:                   q.existsPolicyStmt,
-		existsProgramStmt:                  q.existsProgramStmt,
-		existsThingStmt:                    q.existsThingStmt,
-		existsTimeseriesStmt:               q.existsTimeseriesStmt,
-		existsUserStmt:                     q.existsUserStmt,
-		findAlertByUUIDStmt:                q.findAlertByUUIDStmt,
-		findAlertsStmt:                     q.findAlertsStmt,
-		findAllModulesStmt:                 q.findAllModulesStmt,
-		findAllRoutineRevisionsStmt:        q.findAllRoutineRevisionsStmt,
-		findDatasetByThingStmt:             q.findDatasetByThingStmt,
-		findDatasetByUUIDStmt:              q.findDatasetByUUIDStmt,
-		findDatasetsStmt:                   q.findDatasetsStmt,
-		findDatasetsByTagsStmt:             q.findDatasetsByTagsStmt,
-		findGroupByUuidStmt:                q.findGroupByUuidStmt,
-		findGroupsStmt:                     q.findGroupsStmt,
-		findGroupsByUserStmt:               q.findGroupsByUserStmt,
-		findPoliciesStmt:                   q.findPoliciesStmt,
-		findPoliciesByGroupStmt:            q.findPoliciesByGroupStmt,
-		findPoliciesByUserStmt:             q.findPoliciesByUserStmt,
-		findPolicyByUUIDStmt:               q.findPolicyByUUIDStmt,
-		findProgramByUUIDStmt:              q.findProgramByUUIDStmt,
-		findProgramCodeRevisionsStmt:       q.findProgramCodeRevisionsStmt,
-		findProgramsStmt:                   q.findProgramsStmt,
-		findProgramsByTagsStmt:             q.findProgramsByTagsStmt,
-		findThingByUUIDStmt:                q.findThingByUUIDStmt,
-		findThingsStmt:                     q.findThingsStmt,
-		findThingsByTagsStmt:               q.findThingsByTagsStmt,
-		findTimeseriesStmt:                 q.findTimeseriesStmt,
-		findTimeseriesByTagsStmt:           q.findTimeseriesByTagsStmt,
-		findTimeseriesByThingStmt:          q.findTimeseriesByThingStmt,
-		findTimeseriesByUUIDStmt:           q.findTimeseriesByUUIDStmt,
-		findTokensByUserStmt:               q.findTokensByUserStmt,
-		findUserByUUIDStmt:                 q.findUserByUUIDStmt,
-		findUsersStmt:                      q.findUsersStmt,
-		getDatasetContentByUUIDStmt:        q.getDatasetContentByUUIDStmt,
-		getNamedModuleCodeAtHeadStmt:       q.getNamedModuleCodeAtHeadStmt,
-		getNamedModuleCodeAtRevisionStmt:   q.getNamedModuleCodeAtRevisionStmt,
-		getProgramCodeAtHeadStmt:           q.getProgramCodeAtHeadStmt,
-		getProgramCodeAtRevisionStmt:       q.getProgramCodeAtRevisionStmt,
-		getSignedProgramCodeAtHeadStmt:     q.getSignedProgramCodeAtHeadStmt,
-		getTimeseriesByUUIDStmt:            q.getTimeseriesByUUIDStmt,
-		getTsDataRangeStmt:                 q.getTsDataRangeStmt,
-		getTsDataRangeAggStmt:              q.getTsDataRangeAggStmt,
-		getUnitFromTimeseriesStmt:          q.getUnitFromTimeseriesStmt,
-		getUserUuidFromTokenStmt:           q.getUserUuidFromTokenStmt,
-		removeUserFromAllGroupsStmt:        q.removeUserFromAllGroupsStmt,
-		removeUserFromGroupsStmt:           q.removeUserFromGroupsStmt,
-		setDatasetContentByUUIDStmt:        q.setDatasetContentByUUIDStmt,
-		setDatasetFormatByUUIDStmt:         q.setDatasetFormatByUUIDStmt,
-		setDatasetNameByUUIDStmt:           q.setDatasetNameByUUIDStmt,
-		setDatasetTagsStmt:                 q.setDatasetTagsStmt,
-		setDatasetThingByUUIDStmt:          q.setDatasetThingByUUIDStmt,
-		setGroupNameByUUIDStmt:             q.setGroupNameByUUIDStmt,
-		setPolicyActionStmt:                q.setPolicyActionStmt,
-		setPolicyEffectStmt:                q.setPolicyEffectStmt,
-		setPolicyGroupStmt:                 q.setPolicyGroupStmt,
-		setPolicyPriorityStmt:              q.setPolicyPriorityStmt,
-		setPolicyResourceStmt:              q.setPolicyResourceStmt,
-		setProgramDeadlineByUUIDStmt:       q.setProgramDeadlineByUUIDStmt,
-		setProgramLanguageByUUIDStmt:       q.setProgramLanguageByUUIDStmt,
-		setProgramNameByUUIDStmt:           q.setProgramNameByUUIDStmt,
-		setProgramScheduleByUUIDStmt:       q.setProgramScheduleByUUIDStmt,
-		setProgramStateByUUIDStmt:          q.setProgramStateByUUIDStmt,
-		setProgramTagsStmt:                 q.setProgramTagsStmt,
-		setProgramTypeByUUIDStmt:           q.setProgramTypeByUUIDStmt,
-		setThingNameByUUIDStmt:             q.setThingNameByUUIDStmt,
-		setThingStateByUUIDStmt:            q.setThingStateByUUIDStmt,
-		setThingTagsStmt:                   q.setThingTagsStmt,
-		setThingTypeByUUIDStmt:             q.setThingTypeByUUIDStmt,
-		setTimeseriesLowerBoundStmt:        q.setTimeseriesLowerBoundStmt,
-		setTimeseriesNameStmt:              q.setTimeseriesNameStmt,
-		setTimeseriesSiUnitStmt:            q.setTimeseriesSiUnitStmt,
-		setTimeseriesTagsStmt:              q.setTimeseriesTagsStmt,
-		setTimeseriesThingStmt:             q.setTimeseriesThingStmt,
-		setTimeseriesUpperBoundStmt:        q.setTimeseriesUpperBoundStmt,
-		setUserNameStmt:                    q.setUserNameStmt,
-		signProgramCodeRevisionStmt:        q.signProgramCodeRevisionStmt,
-		updateAlertIncDuplicateStmt:        q.updateAlertIncDuplicateStmt,
-		updateAlertSetDescriptionStmt:      q.updateAlertSetDescriptionStmt,
-		updateAlertSetEnvironmentStmt:      q.updateAlertSetEnvironmentStmt,
-		updateAlertSetEventStmt:            q.updateAlertSetEventStmt,
-		updateAlertSetLastReceivedTimeStmt: q.updateAlertSetLastReceivedTimeStmt,
-		updateAlertSetOriginStmt:           q.updateAlertSetOriginStmt,
-		updateAlertSetRawdataStmt:          q.updateAlertSetRawdataStmt,
-		updateAlertSetResourceStmt:         q.updateAlertSetResourceStmt,
-		updateAlertSetServiceStmt:          q.updateAlertSetServiceStmt,
-		updateAlertSetSeverityStmt:         q.updateAlertSetSeverityStmt,
-		updateAlertSetStatusStmt:           q.updateAlertSetStatusStmt,
-		updateAlertSetTagsStmt:             q.updateAlertSetTagsStmt,
-		updateAlertSetTimeoutStmt:          q.updateAlertSetTimeoutStmt,
-		updateAlertSetValueStmt:            q.updateAlertSetValueStmt,
+		db:                                   tx,
+		tx:                                   tx,
+		acquireProgramManagerLeaseStmt:       q.acquireProgramManagerLeaseStmt,
+		addIncidentAlertStmt:                 q.addIncidentAlertStmt,
+		addThingDepStmt:                      q.addThingDepStmt,
+		addTokenToUserStmt:                   q.addTokenToUserStmt,
+		addUserToGroupStmt:                   q.addUserToGroupStmt,
+		checkUserTokenHasAccessStmt:          q.checkUserTokenHasAccessStmt,
+		checkUserTokenHasAccessManyStmt:      q.checkUserTokenHasAccessManyStmt,
+		claimDatasetBlobDeletionStmt:         q.claimDatasetBlobDeletionStmt,
+		claimDueTimeseriesMonitorsStmt:       q.claimDueTimeseriesMonitorsStmt,
+		claimNotificationDeliveriesStmt:      q.claimNotificationDeliveriesStmt,
+		claimProgramJobsStmt:                 q.claimProgramJobsStmt,
+		claimTimeseriesHealthStmt:            q.claimTimeseriesHealthStmt,
+		closeIncidentAlertsStmt:              q.closeIncidentAlertsStmt,
+		completeProgramJobStmt:               q.completeProgramJobStmt,
+		completeProgramRunStmt:               q.completeProgramRunStmt,
+		countDatasetBlobReferencesStmt:       q.countDatasetBlobReferencesStmt,
+		countIncidentAlertsStmt:              q.countIncidentAlertsStmt,
+		createAlertStmt:                      q.createAlertStmt,
+		createAlertGroupStmt:                 q.createAlertGroupStmt,
+		createAlertHistoryStmt:               q.createAlertHistoryStmt,
+		createAlertSilenceStmt:               q.createAlertSilenceStmt,
+		createCodeRevisionStmt:               q.createCodeRevisionStmt,
+		createDatasetStmt:                    q.createDatasetStmt,
+		createGroupStmt:                      q.createGroupStmt,
+		createIncidentStmt:                   q.createIncidentStmt,
+		createIncidentHistoryStmt:            q.createIncidentHistoryStmt,
+		createNotificationChannelStmt:        q.createNotificationChannelStmt,
+		createNotificationDeliveryStmt:       q.createNotificationDeliveryStmt,
+		createNotificationRuleStmt:           q.createNotificationRuleStmt,
+		createPolicyStmt:                     q.createPolicyStmt,
+		createProgramStmt:                    q.createProgramStmt,
+		createProgramJobStmt:                 q.createProgramJobStmt,
+		createProgramRunStmt:                 q.createProgramRunStmt,
+		createThingStmt:                      q.createThingStmt,
+		createThingStateChangeStmt:           q.createThingStateChangeStmt,
+		createThingStateTransitionStmt:       q.createThingStateTransitionStmt,
+		createThingTemplateStmt:              q.createThingTemplateStmt,
+		createTimeseriesStmt:                 q.createTimeseriesStmt,
+		createTimeseriesHealthRuleStmt:       q.createTimeseriesHealthRuleStmt,
+		createTimeseriesMonitorStmt:          q.createTimeseriesMonitorStmt,
+		createTsDataStmt:                     q.createTsDataStmt,
+		createUserStmt:                       q.createUserStmt,
+		createUserTokenStmt:                  q.createUserTokenStmt,
+		deleteAlertStmt:                      q.deleteAlertStmt,
+		deleteAlertGroupStmt:                 q.deleteAlertGroupStmt,
+		deleteAlertSilenceStmt:               q.deleteAlertSilenceStmt,
+		deleteAllTsDataStmt:                  q.deleteAllTsDataStmt,
+		deleteDatasetStmt:                    q.deleteDatasetStmt,
+		deleteDatasetBlobDeletionStmt:        q.deleteDatasetBlobDeletionStmt,
+		deleteDatasetSchemaByUUIDStmt:        q.deleteDatasetSchemaByUUIDStmt,
+		deleteGroupStmt:                      q.deleteGroupStmt,
+		deleteNotificationChannelStmt:        q.deleteNotificationChannelStmt,
+		deleteNotificationRuleStmt:           q.deleteNotificationRuleStmt,
+		deletePolicyByUUIDStmt:               q.deletePolicyByUUIDStmt,
+		deleteProgramStmt:                    q.deleteProgramStmt,
+		deleteProgramCodeRevisionStmt:        q.deleteProgramCodeRevisionStmt,
+		deleteProgramJobsBeforeStmt:          q.deleteProgramJobsBeforeStmt,
+		deleteProgramRunsBeforeStmt:          q.deleteProgramRunsBeforeStmt,
+		deleteThingStmt:                      q.deleteThingStmt,
+		deleteThingStateTransitionsStmt:      q.deleteThingStateTransitionsStmt,
+		deleteThingTemplateStmt:              q.deleteThingTemplateStmt,
+		deleteThingTypeSchemaStmt:            q.deleteThingTypeSchemaStmt,
+		deleteTimeseriesStmt:                 q.deleteTimeseriesStmt,
+		deleteTimeseriesHealthRuleStmt:       q.deleteTimeseriesHealthRuleStmt,
+		deleteTimeseriesMonitorStmt:          q.deleteTimeseriesMonitorStmt,
+		deleteTokenFromUserStmt:              q.deleteTokenFromUserStmt,
+		deleteTsDataRangeStmt:                q.deleteTsDataRangeStmt,
+		deleteUserStmt:                       q.deleteUserStmt,
+		existsAlertStmt:                      q.existsAlertStmt,
+		existsDatasetStmt:                    q.existsDatasetStmt,
+		existsGroupStmt:                      q.existsGroupStmt,
+		existsPolicyStmt:                     q.existsPolicyStmt,
+		existsProgramStmt:                    q.existsProgramStmt,
+		existsThingStmt:                      q.existsThingStmt,
+		existsTimeseriesStmt:                 q.existsTimeseriesStmt,
+		existsUserStmt:                       q.existsUserStmt,
+		expireAlertsStmt:                     q.expireAlertsStmt,
+		failQueuedProgramRunsStmt:            q.failQueuedProgramRunsStmt,
+		failStaleProgramRunsStmt:             q.failStaleProgramRunsStmt,
+		findActiveNotificationRulesStmt:      q.findActiveNotificationRulesStmt,
+		findAlertByUUIDStmt:                  q.findAlertByUUIDStmt,
+		findAlertGroupByUUIDStmt:             q.findAlertGroupByUUIDStmt,
+		findAlertGroupsStmt:                  q.findAlertGroupsStmt,
+		findAlertHistoryStmt:                 q.findAlertHistoryStmt,
+		findAlertSilenceByUUIDStmt:           q.findAlertSilenceByUUIDStmt,
+		findAlertSilencesStmt:                q.findAlertSilencesStmt,
+		findAlertsStmt:                       q.findAlertsStmt,
+		findAllModulesStmt:                   q.findAllModulesStmt,
+		findAllRoutineRevisionsStmt:          q.findAllRoutineRevisionsStmt,
+		findAllThingStateTransitionsStmt:     q.findAllThingStateTransitionsStmt,
+		findCurrentAlertSilencesStmt:         q.findCurrentAlertSilencesStmt,
+		findCurrentAlertStatusChangesStmt:    q.findCurrentAlertStatusChangesStmt,
+		findDatasetByThingStmt:               q.findDatasetByThingStmt,
+		findDatasetByUUIDStmt:                q.findDatasetByUUIDStmt,
+		findDatasetRevisionsStmt:             q.findDatasetRevisionsStmt,
+		findDatasetRevisionsByStorageStmt:    q.findDatasetRevisionsByStorageStmt,
+		findDatasetsStmt:                     q.findDatasetsStmt,
+		findDatasetsByStorageStmt:            q.findDatasetsByStorageStmt,
+		findDatasetsByTagsStmt:               q.findDatasetsByTagsStmt,
+		findDatasetsByThingsStmt:             q.findDatasetsByThingsStmt,
+		findEnabledAlertGroupsStmt:           q.findEnabledAlertGroupsStmt,
+		findEnabledTimeseriesHealthRulesStmt: q.findEnabledTimeseriesHealthRulesStmt,
+		findGroupByUuidStmt:                  q.findGroupByUuidStmt,
+		findGroupsStmt:                       q.findGroupsStmt,
+		findGroupsByUserStmt:                 q.findGroupsByUserStmt,
+		findIncidentAlertsStmt:               q.findIncidentAlertsStmt,
+		findIncidentByUUIDStmt:               q.findIncidentByUUIDStmt,
+		findIncidentHistoryStmt:              q.findIncidentHistoryStmt,
+		findIncidentsStmt:                    q.findIncidentsStmt,
+		findLatestIncidentByAlertStmt:        q.findLatestIncidentByAlertStmt,
+		findNotificationChannelByUUIDStmt:    q.findNotificationChannelByUUIDStmt,
+		findNotificationChannelsStmt:         q.findNotificationChannelsStmt,
+		findNotificationDeliveriesStmt:       q.findNotificationDeliveriesStmt,
+		findNotificationRuleByUUIDStmt:       q.findNotificationRuleByUUIDStmt,
+		findNotificationRulesStmt:            q.findNotificationRulesStmt,
+		findOpenIncidentByKeyStmt:            q.findOpenIncidentByKeyStmt,
+		findPoliciesStmt:                     q.findPoliciesStmt,
+		findPoliciesByGroupStmt:              q.findPoliciesByGroupStmt,
+		findPoliciesByUserStmt:               q.findPoliciesByUserStmt,
+		findPolicyByUUIDStmt:                 q.findPolicyByUUIDStmt,
+		findProgramByUUIDStmt:                q.findProgramByUUIDStmt,
+		findProgramCodeRevisionsStmt:         q.findProgramCodeRevisionsStmt,
+		findProgramJobByIDStmt:               q.findProgramJobByIDStmt,
+		findProgramJobsStmt:                  q.findProgramJobsStmt,
+		findProgramRunByIDStmt:               q.findProgramRunByIDStmt,
+		findProgramRunsStmt:                  q.findProgramRunsStmt,
+		findProgramRunsByJobStmt:             q.findProgramRunsByJobStmt,
+		findProgramsStmt:                     q.findProgramsStmt,
+		findProgramsByTagsStmt:               q.findProgramsByTagsStmt,
+		findRoutineRevisionsByProgramStmt:    q.findRoutineRevisionsByProgramStmt,
+		findThingAncestorsStmt:               q.findThingAncestorsStmt,
+		findThingByUUIDStmt:                  q.findThingByUUIDStmt,
+		findThingDescendantsStmt:             q.findThingDescendantsStmt,
+		findThingParentStmt:                  q.findThingParentStmt,
+		findThingStateHistoryStmt:            q.findThingStateHistoryStmt,
+		findThingStateTransitionsStmt:        q.findThingStateTransitionsStmt,
+		findThingTemplateByUUIDStmt:          q.findThingTemplateByUUIDStmt,
+		findThingTemplatesStmt:               q.findThingTemplatesStmt,
+		findThingTypeSchemasStmt:             q.findThingTypeSchemasStmt,
+		findThingsStmt:                       q.findThingsStmt,
+		findThingsByTagsStmt:                 q.findThingsByTagsStmt,
+		findThingsByTypeStmt:                 q.findThingsByTypeStmt,
+		findThingsWithinStmt:                 q.findThingsWithinStmt,
+		findTimeseriesStmt:                   q.findTimeseriesStmt,
+		findTimeseriesByTagsStmt:             q.findTimeseriesByTagsStmt,
+		findTimeseriesByThingStmt:            q.findTimeseriesByThingStmt,
+		findTimeseriesByThingsStmt:           q.findTimeseriesByThingsStmt,
+		findTimeseriesByUUIDStmt:             q.findTimeseriesByUUIDStmt,
+		findTimeseriesHealthStmt:             q.findTimeseriesHealthStmt,
+		findTimeseriesHealthRuleByUUIDStmt:   q.findTimeseriesHealthRuleByUUIDStmt,
+		findTimeseriesHealthRulesStmt:        q.findTimeseriesHealthRulesStmt,
+		findTimeseriesMonitorByUUIDStmt:      q.findTimeseriesMonitorByUUIDStmt,
+		findTimeseriesMonitorsStmt:           q.findTimeseriesMonitorsStmt,
+		findTokensByUserStmt:                 q.findTokensByUserStmt,
+		findTsDataHistoryStmt:                q.findTsDataHistoryStmt,
+		findUserByUUIDStmt:                   q.findUserByUUIDStmt,
+		findUsersStmt:                        q.findUsersStmt,
+		getDatasetContentAtHeadStmt:          q.getDatasetContentAtHeadStmt,
+		getDatasetContentAtRevisionStmt:      q.getDatasetContentAtRevisionStmt,
+		getDatasetContentByUUIDStmt:          q.getDatasetContentByUUIDStmt,
+		getDatasetContentInfoByUUIDStmt:      q.getDatasetContentInfoByUUIDStmt,
+		getDatasetContentPartStmt:            q.getDatasetContentPartStmt,
+		getDatasetSchemaByUUIDStmt:           q.getDatasetSchemaByUUIDStmt,
+		getIncidentActiveAlertsStmt:          q.getIncidentActiveAlertsStmt,
+		getNamedModuleCodeAtHeadStmt:         q.getNamedModuleCodeAtHeadStmt,
+		getNamedModuleCodeAtRevisionStmt:     q.getNamedModuleCodeAtRevisionStmt,
+		getProgramCodeAtHeadStmt:             q.getProgramCodeAtHeadStmt,
+		getProgramCodeAtRevisionStmt:         q.getProgramCodeAtRevisionStmt,
+		getProgramCodeRevisionStmt:           q.getProgramCodeRevisionStmt,
+		getSignedProgramCodeAtHeadStmt:       q.getSignedProgramCodeAtHeadStmt,
+		getThingStateByTimeseriesStmt:        q.getThingStateByTimeseriesStmt,
+		getThingTypeSchemaStmt:               q.getThingTypeSchemaStmt,
+		getTimeseriesByUUIDStmt:              q.getTimeseriesByUUIDStmt,
+		getTsDataHealthStmt:                  q.getTsDataHealthStmt,
+		getTsDataRangeStmt:                   q.getTsDataRangeStmt,
+		getTsDataRangeAggStmt:                q.getTsDataRangeAggStmt,
+		getTsDataWindowAggStmt:               q.getTsDataWindowAggStmt,
+		getUnitFromTimeseriesStmt:            q.getUnitFromTimeseriesStmt,
+		getUserUuidFromTokenStmt:             q.getUserUuidFromTokenStmt,
+		heartbeatProgramJobStmt:              q.heartbeatProgramJobStmt,
+		initTimeseriesHealthStmt:             q.initTimeseriesHealthStmt,
+		killProgramJobStmt:                   q.killProgramJobStmt,
+		lockDatasetBlobStmt:                  q.lockDatasetBlobStmt,
+		markTimeseriesMonitorsDueStmt:        q.markTimeseriesMonitorsDueStmt,
+		queueProgramRunStmt:                  q.queueProgramRunStmt,
+		releaseProgramManagerLeaseStmt:       q.releaseProgramManagerLeaseStmt,
+		releaseSilencedAlertsStmt:            q.releaseSilencedAlertsStmt,
+		removeThingDepStmt:                   q.removeThingDepStmt,
+		removeUserFromAllGroupsStmt:          q.removeUserFromAllGroupsStmt,
+		removeUserFromGroupsStmt:             q.removeUserFromGroupsStmt,
+		requeueProgramJobStmt:                q.requeueProgramJobStmt,
+		restoreTsDataChangeStmt:              q.restoreTsDataChangeStmt,
+		retryProgramJobStmt:                  q.retryProgramJobStmt,
+		rollbackDatasetToRevisionStmt:        q.rollbackDatasetToRevisionStmt,
+		setAuditContextStmt:                  q.setAuditContextStmt,
+		setDatasetContentByUUIDStmt:          q.setDatasetContentByUUIDStmt,
+		setDatasetFormatByUUIDStmt:           q.setDatasetFormatByUUIDStmt,
+		setDatasetMaxRevisionsByUUIDStmt:     q.setDatasetMaxRevisionsByUUIDStmt,
+		setDatasetNameByUUIDStmt:             q.setDatasetNameByUUIDStmt,
+		setDatasetRevisionStorageStmt:        q.setDatasetRevisionStorageStmt,
+		setDatasetSchemaByUUIDStmt:           q.setDatasetSchemaByUUIDStmt,
+		setDatasetStorageByUUIDStmt:          q.setDatasetStorageByUUIDStmt,
+		setDatasetTagsStmt:                   q.setDatasetTagsStmt,
+		setDatasetThingByUUIDStmt:            q.setDatasetThingByUUIDStmt,
+		setGroupNameByUUIDStmt:               q.setGroupNameByUUIDStmt,
+		setNotificationDeliveryFailedStmt:    q.setNotificationDeliveryFailedStmt,
+		setNotificationDeliveryRetryStmt:     q.setNotificationDeliveryRetryStmt,
+		setNotificationDeliverySentStmt:      q.setNotificationDeliverySentStmt,
+		setPolicyActionStmt:                  q.setPolicyActionStmt,
+		setPolicyEffectStmt:                  q.setPolicyEffectStmt,
+		setPolicyGroupStmt:                   q.setPolicyGroupStmt,
+		setPolicyPriorityStmt:                q.setPolicyPriorityStmt,
+		setPolicyResourceStmt:                q.setPolicyResourceStmt,
+		setProgramDeadlineByUUIDStmt:         q.setProgramDeadlineByUUIDStmt,
+		setProgramLanguageByUUIDStmt:         q.setProgramLanguageByUUIDStmt,
+		setProgramNameByUUIDStmt:             q.setProgramNameByUUIDStmt,
+		setProgramScheduleByUUIDStmt:         q.setProgramScheduleByUUIDStmt,
+		setProgramStateByUUIDStmt:            q.setProgramStateByUUIDStmt,
+		setProgramTagsStmt:                   q.setProgramTagsStmt,
+		setProgramTypeByUUIDStmt:             q.setProgramTypeByUUIDStmt,
+		setThingAreaByUUIDStmt:               q.setThingAreaByUUIDStmt,
+		setThingAttributesByUUIDStmt:         q.setThingAttributesByUUIDStmt,
+		setThingLocationByUUIDStmt:           q.setThingLocationByUUIDStmt,
+		setThingNameByUUIDStmt:               q.setThingNameByUUIDStmt,
+		setThingStateByUUIDStmt:              q.setThingStateByUUIDStmt,
+		setThingTagsStmt:                     q.setThingTagsStmt,
+		setThingTypeByUUIDStmt:               q.setThingTypeByUUIDStmt,
+		setThingTypeSchemaStmt:               q.setThingTypeSchemaStmt,
+		setTimeseriesAttributesStmt:          q.setTimeseriesAttributesStmt,
+		setTimeseriesLowerBoundStmt:          q.setTimeseriesLowerBoundStmt,
+		setTimeseriesNameStmt:                q.setTimeseriesNameStmt,
+		setTimeseriesSiUnitStmt:              q.setTimeseriesSiUnitStmt,
+		setTimeseriesTagsStmt:                q.setTimeseriesTagsStmt,
+		setTimeseriesThingStmt:               q.setTimeseriesThingStmt,
+		setTimeseriesUpperBoundStmt:          q.setTimeseriesUpperBoundStmt,
+		setUserNameStmt:                      q.setUserNameStmt,
+		signProgramCodeRevisionStmt:          q.signProgramCodeRevisionStmt,
+		silenceAlertStmt:                     q.silenceAlertStmt,
+		startProgramRunStmt:                  q.startProgramRunStmt,
+		unshelveAlertsStmt:                   q.unshelveAlertsStmt,
+		updateAlertGroupStmt:                 q.updateAlertGroupStmt,
+		updateAlertIncDuplicateStmt:          q.updateAlertIncDuplicateStmt,
+		updateAlertSetDescriptionStmt:        q.updateAlertSetDescriptionStmt,
+		updateAlertSetEnvironmentStmt:        q.updateAlertSetEnvironmentStmt,
+		updateAlertSetEventStmt:              q.updateAlertSetEventStmt,
+		updateAlertSetLastReceivedTimeStmt:   q.updateAlertSetLastReceivedTimeStmt,
+		updateAlertSetOriginStmt:             q.updateAlertSetOriginStmt,
+		updateAlertSetRawdataStmt:            q.updateAlertSetRawdataStmt,
+		updateAlertSetResourceStmt:           q.updateAlertSetResourceStmt,
+		updateAlertSetServiceStmt:            q.updateAlertSetServiceStmt,
+		updateAlertSetSeverityStmt:           q.updateAlertSetSeverityStmt,
+		updateAlertSetShelvedUntilStmt:       q.updateAlertSetShelvedUntilStmt,
+		updateAlertSetStatusStmt:             q.updateAlertSetStatusStmt,
+		updateAlertSetTagsStmt:               q.updateAlertSetTagsStmt,
+		updateAlertSetTimeoutStmt:            q.updateAlertSetTimeoutStmt,
+		updateAlertSetValueStmt:              q.updateAlertSetValueStmt,
+		updateAlertSilenceStmt:               q.updateAlertSilenceStmt,
+		updateIncidentStateStmt:              q.updateIncidentStateStmt,
+		updateNotificationChannelStmt:        q.updateNotificationChannelStmt,
+		updateNotificationRuleStmt:           q.updateNotificationRuleStmt,
+		updateThingTemplateStmt:              q.updateThingTemplateStmt,
+		updateTimeseriesHealthStmt:           q.updateTimeseriesHealthStmt,
+		updateTimeseriesHealthRuleStmt:       q.updateTimeseriesHealthRuleStmt,
+		updateTimeseriesMonitorStmt:          q.updateTimeseriesMonitorStmt,
+		updateTimeseriesMonitorStateStmt:     q.updateTimeseriesMonitorStateStmt,
 	}
 }
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TRIGGER tsdata_audit_trigger ON tsdata;
+
+DROP FUNCTION tsdata_audit_change;
+
+DROP TABLE tsdata_audit;
+
+DROP TYPE tsdata_audit_action;
+
+COMMIT;
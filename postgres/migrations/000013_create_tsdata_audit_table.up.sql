@@ -0,0 +1,62 @@
+BEGIN;
+
+CREATE TYPE tsdata_audit_action AS ENUM ('delete', 'overwrite');
+
+--
+-- Every deleted or overwritten data point ends up here together with the
+-- old value. All rows changed by the same transaction share a change_id.
+--
+CREATE TABLE tsdata_audit (
+  id BIGSERIAL PRIMARY KEY,
+  change_id BIGINT NOT NULL DEFAULT txid_current(),
+  action tsdata_audit_action NOT NULL,
+  ts_uuid UUID NOT NULL REFERENCES timeseries(uuid) ON DELETE CASCADE,
+  value DOUBLE PRECISION NOT NULL,
+  ts TIMESTAMPTZ NOT NULL,
+  created_by UUID REFERENCES users(uuid) ON DELETE SET NULL,
+  changed TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  changed_by UUID REFERENCES users(uuid) ON DELETE SET NULL,
+  token_uuid UUID REFERENCES user_tokens(uuid) ON DELETE SET NULL,
+  restored TIMESTAMPTZ
+);
+
+CREATE INDEX tsdata_audit_ts_uuid_changed_idx ON tsdata_audit(ts_uuid, changed);
+CREATE INDEX tsdata_audit_change_id_idx ON tsdata_audit(change_id);
+
+--
+-- The user and token responsible for a change are read from the transaction
+-- local settings selfhost.user_uuid and selfhost.token_uuid.
+--
+CREATE FUNCTION tsdata_audit_change() RETURNS TRIGGER AS $$
+DECLARE
+  v_action tsdata_audit_action;
+BEGIN
+  IF TG_OP = 'UPDATE' THEN
+    IF NEW.value IS NOT DISTINCT FROM OLD.value THEN
+      RETURN NULL;
+    END IF;
+    v_action := 'overwrite';
+  ELSE
+    v_action := 'delete';
+  END IF;
+
+  INSERT INTO tsdata_audit(action, ts_uuid, value, ts, created_by, changed_by, token_uuid)
+  VALUES (
+    v_action,
+    OLD.ts_uuid,
+    OLD.value,
+    OLD.ts,
+    OLD.created_by,
+    NULLIF(current_setting('selfhost.user_uuid', true), '')::uuid,
+    NULLIF(current_setting('selfhost.token_uuid', true), '')::uuid
+  );
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tsdata_audit_trigger
+AFTER UPDATE OR DELETE ON tsdata
+FOR EACH ROW EXECUTE PROCEDURE tsdata_audit_change();
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+CREATE OR REPLACE FUNCTION user_has_access(uid UUID, act policy_action, res TEXT) RETURNS boolean AS $$
+	WITH policies AS (
+	        SELECT group_policies.effect, group_policies.priority, group_policies.resource
+        	FROM group_policies, user_groups
+	        WHERE user_groups.group_uuid = group_policies.group_uuid
+	        AND user_groups.user_uuid = $1
+        	AND action = $2::policy_action
+	), c AS (
+		SELECT res AS resource
+	), has_access AS (
+		SELECT *
+		FROM c
+		WHERE c.resource LIKE ANY((SELECT resource FROM policies WHERE effect = 'allow'))
+		EXCEPT
+		SELECT *
+		FROM c
+		WHERE c.resource LIKE ANY((SELECT resource FROM policies WHERE effect = 'deny'))
+	)
+	SELECT COUNT(*) > 0 FROM has_access;
+$$ LANGUAGE sql;
+
+ALTER TABLE thing_deps DROP COLUMN inherit_policies;
+
+ALTER TABLE thing_deps
+  DROP CONSTRAINT thing_deps_parent_fkey,
+  DROP CONSTRAINT thing_deps_child_fkey,
+  ADD CONSTRAINT thing_deps_parent_fkey FOREIGN KEY (parent) REFERENCES things(uuid),
+  ADD CONSTRAINT thing_deps_child_fkey FOREIGN KEY (child) REFERENCES things(uuid);
+
+COMMIT;
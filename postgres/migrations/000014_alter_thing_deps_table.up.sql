@@ -0,0 +1,66 @@
+BEGIN;
+
+--
+-- Remove dependencies together with the things they reference
+--
+ALTER TABLE thing_deps
+  DROP CONSTRAINT thing_deps_parent_fkey,
+  DROP CONSTRAINT thing_deps_child_fkey,
+  ADD CONSTRAINT thing_deps_parent_fkey FOREIGN KEY (parent) REFERENCES things(uuid) ON DELETE CASCADE,
+  ADD CONSTRAINT thing_deps_child_fkey FOREIGN KEY (child) REFERENCES things(uuid) ON DELETE CASCADE;
+
+--
+-- When set, policies on the parent thing also apply to the child
+--
+ALTER TABLE thing_deps ADD COLUMN inherit_policies BOOLEAN NOT NULL DEFAULT false;
+
+--
+-- Replaces the function from 000004. A resource on the form things/<uuid>[/...]
+-- is also checked against the same resource on every ancestor reachable
+-- through dependencies with inherit_policies set. A deny on any of them wins.
+--
+CREATE OR REPLACE FUNCTION user_has_access(uid UUID, act policy_action, res TEXT) RETURNS boolean AS $$
+DECLARE
+	thing UUID;
+BEGIN
+	IF res ~* '^things/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}(/|$)' THEN
+		thing := substring(res from 8 for 36)::uuid;
+	END IF;
+
+	RETURN (
+		WITH RECURSIVE policies AS (
+			SELECT group_policies.effect, group_policies.priority, group_policies.resource
+			FROM group_policies, user_groups
+			WHERE user_groups.group_uuid = group_policies.group_uuid
+			AND user_groups.user_uuid = uid
+			AND action = act
+		), ancestors(uuid) AS (
+			SELECT thing_deps.parent
+			FROM thing_deps
+			WHERE thing_deps.child = thing
+			AND thing_deps.inherit_policies
+			UNION
+			SELECT thing_deps.parent
+			FROM ancestors, thing_deps
+			WHERE thing_deps.child = ancestors.uuid
+			AND thing_deps.inherit_policies
+		), c AS (
+			SELECT res AS resource
+			UNION
+			SELECT 'things/'||ancestors.uuid||substring(res from 44) AS resource
+			FROM ancestors
+		)
+		SELECT EXISTS (
+			SELECT 1
+			FROM c
+			WHERE c.resource LIKE ANY((SELECT resource FROM policies WHERE effect = 'allow'))
+		) AND NOT EXISTS (
+			SELECT 1
+			FROM c
+			WHERE c.resource LIKE ANY((SELECT resource FROM policies WHERE effect = 'deny'))
+		)
+	);
+END;
+$$ LANGUAGE plpgsql;
+
+COMMIT;
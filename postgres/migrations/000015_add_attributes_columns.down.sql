@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TABLE IF EXISTS thing_type_schemas;
+
+DROP INDEX IF EXISTS timeseries_attributes_idx;
+DROP INDEX IF EXISTS things_attributes_idx;
+
+ALTER TABLE timeseries DROP COLUMN IF EXISTS attributes;
+ALTER TABLE things DROP COLUMN IF EXISTS attributes;
+
+COMMIT;
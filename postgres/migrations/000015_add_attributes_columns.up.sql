@@ -0,0 +1,22 @@
+BEGIN;
+
+--
+-- Free form attributes, validated by the service against thing_type_schemas
+--
+ALTER TABLE things ADD COLUMN attributes JSONB NOT NULL DEFAULT '{}'::JSONB;
+ALTER TABLE timeseries ADD COLUMN attributes JSONB NOT NULL DEFAULT '{}'::JSONB;
+
+-- jsonb_path_ops only supports @> but is smaller and faster than the default
+-- https://www.postgresql.org/docs/current/datatype-json.html#JSON-INDEXING
+CREATE INDEX things_attributes_idx ON things USING GIN("attributes" jsonb_path_ops) WITH (fastupdate = false);
+CREATE INDEX timeseries_attributes_idx ON timeseries USING GIN("attributes" jsonb_path_ops) WITH (fastupdate = false);
+
+--
+-- Optional JSON Schema for the attributes of things of a given type
+--
+CREATE TABLE thing_type_schemas (
+  type TEXT NOT NULL PRIMARY KEY,
+  schema JSONB NOT NULL
+);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS things_type_idx;
+DROP TABLE IF EXISTS thing_templates;
+
+COMMIT;
@@ -0,0 +1,25 @@
+BEGIN;
+
+--
+-- Templates describe the time series and datasets to provision for things
+-- of a type. The lists are stored as JSON arrays;
+--   timeseries: [{"name", "si_unit", "lower_bound", "upper_bound", "tags"}]
+--   datasets:   [{"name", "format", "content", "tags"}]
+--
+CREATE TABLE thing_templates (
+  uuid UUID NOT NULL DEFAULT uuid_generate_v4 () PRIMARY KEY,
+  name TEXT NOT NULL,
+  thing_type TEXT NOT NULL,
+
+  timeseries JSONB NOT NULL DEFAULT '[]'::JSONB,
+  datasets JSONB NOT NULL DEFAULT '[]'::JSONB,
+
+  created_by UUID REFERENCES users(uuid) ON DELETE SET NULL
+);
+
+CREATE INDEX thing_templates_thing_type_idx ON thing_templates(thing_type);
+
+-- Used when applying a template to the existing things of a type
+CREATE INDEX things_type_idx ON things(type);
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+DROP FUNCTION IF EXISTS geo_distance(DOUBLE PRECISION, DOUBLE PRECISION, DOUBLE PRECISION, DOUBLE PRECISION);
+
+DROP INDEX IF EXISTS things_location_idx;
+
+ALTER TABLE things
+  DROP CONSTRAINT IF EXISTS things_location_check,
+  DROP COLUMN IF EXISTS area,
+  DROP COLUMN IF EXISTS latitude,
+  DROP COLUMN IF EXISTS longitude;
+
+COMMIT;
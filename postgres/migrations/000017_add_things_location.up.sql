@@ -0,0 +1,25 @@
+BEGIN;
+
+--
+-- Optional position (WGS 84) and area (GeoJSON Polygon) of a thing
+--
+ALTER TABLE things
+  ADD COLUMN longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+  ADD COLUMN latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+  ADD COLUMN area JSONB,
+  ADD CONSTRAINT things_location_check CHECK ((longitude IS NULL) = (latitude IS NULL));
+
+-- Searches use the <@ operator on the same expression to make use of the index
+CREATE INDEX things_location_idx ON things USING GIST(point(longitude, latitude));
+
+--
+-- Great-circle distance in meters between two positions
+--
+CREATE OR REPLACE FUNCTION geo_distance(lon1 DOUBLE PRECISION, lat1 DOUBLE PRECISION, lon2 DOUBLE PRECISION, lat2 DOUBLE PRECISION) RETURNS DOUBLE PRECISION AS $$
+	SELECT 2 * 6371008.8 * asin(sqrt(
+		power(sin(radians(lat2 - lat1) / 2), 2) +
+		cos(radians(lat1)) * cos(radians(lat2)) * power(sin(radians(lon2 - lon1) / 2), 2)
+	));
+$$ LANGUAGE SQL IMMUTABLE STRICT;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS thing_state_history;
+DROP TABLE IF EXISTS thing_state_transitions;
+
+COMMIT;
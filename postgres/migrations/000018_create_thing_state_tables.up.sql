@@ -0,0 +1,31 @@
+BEGIN;
+
+--
+-- Allowed state transitions for things of a type. A type without any rows
+-- here may move freely between states.
+--
+CREATE TABLE thing_state_transitions (
+  thing_type TEXT NOT NULL,
+  from_state thing_state NOT NULL,
+  to_state thing_state NOT NULL,
+
+  PRIMARY KEY(thing_type, from_state, to_state),
+  CHECK(from_state <> to_state)
+);
+
+--
+-- Every state change of a thing
+--
+CREATE TABLE thing_state_history (
+  id BIGSERIAL PRIMARY KEY,
+  thing_uuid UUID NOT NULL REFERENCES things(uuid) ON DELETE CASCADE,
+  from_state thing_state NOT NULL,
+  to_state thing_state NOT NULL,
+  reason TEXT NOT NULL DEFAULT '',
+  changed TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  changed_by UUID REFERENCES users(uuid) ON DELETE SET NULL
+);
+
+CREATE INDEX thing_state_history_thing_uuid_changed_idx ON thing_state_history(thing_uuid, changed);
+
+COMMIT;
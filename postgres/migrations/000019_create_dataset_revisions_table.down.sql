@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS dataset_revision_update_trigger ON datasets;
+DROP TRIGGER IF EXISTS dataset_revision_insert_trigger ON datasets;
+DROP FUNCTION IF EXISTS dataset_revision_change;
+DROP TABLE IF EXISTS dataset_revisions;
+ALTER TABLE datasets DROP COLUMN IF EXISTS max_revisions;
+
+COMMIT;
@@ -0,0 +1,67 @@
+BEGIN;
+
+-- NULL keeps every revision
+ALTER TABLE datasets ADD COLUMN max_revisions INTEGER CHECK (max_revisions > 0);
+
+--
+-- Every version of the content of a dataset. New revisions are added by the
+-- trigger below whenever the content changes, no matter the source.
+--
+CREATE TABLE dataset_revisions (
+	dataset_uuid UUID NOT NULL REFERENCES datasets(uuid) ON DELETE CASCADE,
+	revision INTEGER NOT NULL DEFAULT 0,
+	content BYTEA NOT NULL,
+	checksum BYTEA NOT NULL,
+	size INTEGER NOT NULL,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	created_by UUID REFERENCES users(uuid) ON DELETE SET NULL,
+	PRIMARY KEY (dataset_uuid, revision)
+);
+
+CREATE FUNCTION dataset_revision_change() RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'INSERT' OR NEW.checksum IS DISTINCT FROM OLD.checksum THEN
+    INSERT INTO dataset_revisions(dataset_uuid, revision, content, checksum, size, created_by)
+    VALUES (
+      NEW.uuid,
+      COALESCE((
+        SELECT MAX(dr.revision) + 1
+        FROM dataset_revisions AS dr
+        WHERE dr.dataset_uuid = NEW.uuid
+      ), 0),
+      NEW.content,
+      NEW.checksum,
+      NEW.size,
+      CASE WHEN TG_OP = 'INSERT' THEN NEW.created_by ELSE NEW.updated_by END
+    );
+  END IF;
+
+  -- Drop the oldest revisions beyond the cap
+  IF NEW.max_revisions IS NOT NULL THEN
+    DELETE FROM dataset_revisions
+    WHERE dataset_uuid = NEW.uuid
+    AND revision <= (
+      SELECT MAX(dr.revision)
+      FROM dataset_revisions AS dr
+      WHERE dr.dataset_uuid = NEW.uuid
+    ) - NEW.max_revisions;
+  END IF;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER dataset_revision_insert_trigger
+AFTER INSERT ON datasets
+FOR EACH ROW EXECUTE PROCEDURE dataset_revision_change();
+
+CREATE TRIGGER dataset_revision_update_trigger
+AFTER UPDATE OF content, max_revisions ON datasets
+FOR EACH ROW EXECUTE PROCEDURE dataset_revision_change();
+
+-- The current content is the first revision of existing datasets
+INSERT INTO dataset_revisions(dataset_uuid, revision, content, checksum, size, created, created_by)
+SELECT uuid, 0, content, checksum, size, updated, updated_by
+FROM datasets;
+
+COMMIT;
@@ -0,0 +1,58 @@
+BEGIN;
+
+--
+-- The storage backend keeping the content. Only the database backend keeps
+-- the content in the row, the others address it by the checksum.
+--
+ALTER TABLE datasets ADD COLUMN storage TEXT NOT NULL DEFAULT 'database';
+ALTER TABLE dataset_revisions ADD COLUMN storage TEXT NOT NULL DEFAULT 'database';
+
+-- The size is set by the application when the content is kept elsewhere
+CREATE OR REPLACE FUNCTION update_dataset_content_change()
+RETURNS TRIGGER AS $$
+BEGIN
+   IF NEW.storage = 'database' THEN
+     NEW.size = length(NEW.content);
+   END IF;
+   RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE OR REPLACE FUNCTION dataset_revision_change() RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'INSERT' OR NEW.checksum IS DISTINCT FROM OLD.checksum THEN
+    INSERT INTO dataset_revisions(dataset_uuid, revision, content, checksum, size, storage, created_by)
+    VALUES (
+      NEW.uuid,
+      COALESCE((
+        SELECT MAX(dr.revision) + 1
+        FROM dataset_revisions AS dr
+        WHERE dr.dataset_uuid = NEW.uuid
+      ), 0),
+      NEW.content,
+      NEW.checksum,
+      NEW.size,
+      NEW.storage,
+      CASE WHEN TG_OP = 'INSERT' THEN NEW.created_by ELSE NEW.updated_by END
+    );
+  END IF;
+
+  -- Drop the oldest revisions beyond the cap
+  IF NEW.max_revisions IS NOT NULL THEN
+    DELETE FROM dataset_revisions
+    WHERE dataset_uuid = NEW.uuid
+    AND revision <= (
+      SELECT MAX(dr.revision)
+      FROM dataset_revisions AS dr
+      WHERE dr.dataset_uuid = NEW.uuid
+    ) - NEW.max_revisions;
+  END IF;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE INDEX datasets_storage_idx ON datasets(storage);
+CREATE INDEX dataset_revisions_storage_idx ON dataset_revisions(storage);
+
+COMMIT;
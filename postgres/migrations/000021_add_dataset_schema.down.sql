@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE datasets DROP COLUMN IF EXISTS schema;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+-- JSON Schema the content of the dataset must satisfy. NULL for none.
+ALTER TABLE datasets ADD COLUMN schema JSONB;
+
+COMMIT;
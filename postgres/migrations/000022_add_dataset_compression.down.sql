@@ -0,0 +1,55 @@
+BEGIN;
+
+-- Compressed content can not be read once the compression is dropped. Store
+-- it again uncompressed before this step.
+
+CREATE OR REPLACE FUNCTION dataset_revision_change() RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'INSERT' OR NEW.checksum IS DISTINCT FROM OLD.checksum THEN
+    INSERT INTO dataset_revisions(dataset_uuid, revision, content, checksum, size, storage, created_by)
+    VALUES (
+      NEW.uuid,
+      COALESCE((
+        SELECT MAX(dr.revision) + 1
+        FROM dataset_revisions AS dr
+        WHERE dr.dataset_uuid = NEW.uuid
+      ), 0),
+      NEW.content,
+      NEW.checksum,
+      NEW.size,
+      NEW.storage,
+      CASE WHEN TG_OP = 'INSERT' THEN NEW.created_by ELSE NEW.updated_by END
+    );
+  END IF;
+
+  -- Drop the oldest revisions beyond the cap
+  IF NEW.max_revisions IS NOT NULL THEN
+    DELETE FROM dataset_revisions
+    WHERE dataset_uuid = NEW.uuid
+    AND revision <= (
+      SELECT MAX(dr.revision)
+      FROM dataset_revisions AS dr
+      WHERE dr.dataset_uuid = NEW.uuid
+    ) - NEW.max_revisions;
+  END IF;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION update_dataset_content_change()
+RETURNS TRIGGER AS $$
+BEGIN
+   IF NEW.storage = 'database' THEN
+     NEW.size = length(NEW.content);
+   END IF;
+   RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+ALTER TABLE dataset_revisions DROP COLUMN IF EXISTS stored_size;
+ALTER TABLE dataset_revisions DROP COLUMN IF EXISTS compression;
+ALTER TABLE datasets DROP COLUMN IF EXISTS stored_size;
+ALTER TABLE datasets DROP COLUMN IF EXISTS compression;
+
+COMMIT;
@@ -0,0 +1,65 @@
+BEGIN;
+
+--
+-- The compression of the content as kept by the storage backend. The
+-- checksum and size are always those of the uncompressed content, the
+-- stored size is what the backend keeps.
+--
+ALTER TABLE datasets ADD COLUMN compression TEXT NOT NULL DEFAULT 'none';
+ALTER TABLE datasets ADD COLUMN stored_size INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE dataset_revisions ADD COLUMN compression TEXT NOT NULL DEFAULT 'none';
+ALTER TABLE dataset_revisions ADD COLUMN stored_size INTEGER NOT NULL DEFAULT 0;
+
+UPDATE datasets SET stored_size = size;
+UPDATE dataset_revisions SET stored_size = size;
+
+CREATE OR REPLACE FUNCTION update_dataset_content_change()
+RETURNS TRIGGER AS $$
+BEGIN
+   IF NEW.storage = 'database' THEN
+     NEW.stored_size = length(NEW.content);
+     IF NEW.compression = 'none' THEN
+       NEW.size = length(NEW.content);
+     END IF;
+   END IF;
+   RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE OR REPLACE FUNCTION dataset_revision_change() RETURNS TRIGGER AS $$
+BEGIN
+  IF TG_OP = 'INSERT' OR NEW.checksum IS DISTINCT FROM OLD.checksum THEN
+    INSERT INTO dataset_revisions(dataset_uuid, revision, content, checksum, size, storage, compression, stored_size, created_by)
+    VALUES (
+      NEW.uuid,
+      COALESCE((
+        SELECT MAX(dr.revision) + 1
+        FROM dataset_revisions AS dr
+        WHERE dr.dataset_uuid = NEW.uuid
+      ), 0),
+      NEW.content,
+      NEW.checksum,
+      NEW.size,
+      NEW.storage,
+      NEW.compression,
+      NEW.stored_size,
+      CASE WHEN TG_OP = 'INSERT' THEN NEW.created_by ELSE NEW.updated_by END
+    );
+  END IF;
+
+  -- Drop the oldest revisions beyond the cap
+  IF NEW.max_revisions IS NOT NULL THEN
+    DELETE FROM dataset_revisions
+    WHERE dataset_uuid = NEW.uuid
+    AND revision <= (
+      SELECT MAX(dr.revision)
+      FROM dataset_revisions AS dr
+      WHERE dr.dataset_uuid = NEW.uuid
+    ) - NEW.max_revisions;
+  END IF;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS notification_deliveries;
+DROP TABLE IF EXISTS notification_rules;
+DROP TABLE IF EXISTS notification_channels;
+
+COMMIT;
@@ -0,0 +1,67 @@
+BEGIN;
+
+--
+-- Where notifications of alerts are sent. The config depends on the kind;
+--   webhook: {"url", "method", "headers", "content_type", "body"}
+--   email:   {"host", "port", "username", "password", "from", "to", "subject", "body"}
+--   slack:   {"url", "text"}
+--
+CREATE TABLE notification_channels (
+  uuid UUID NOT NULL DEFAULT uuid_generate_v4 () PRIMARY KEY,
+  name TEXT NOT NULL,
+  kind TEXT NOT NULL,
+  config JSONB NOT NULL DEFAULT '{}'::JSONB,
+  enabled BOOLEAN NOT NULL DEFAULT TRUE,
+  created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  created_by UUID REFERENCES users(uuid) ON DELETE SET NULL,
+
+  CHECK(kind IN ('webhook', 'email', 'slack'))
+);
+
+--
+-- Routing rules send the alerts they match to a channel. An empty list, or
+-- resource pattern, matches any alert.
+--
+CREATE TABLE notification_rules (
+  uuid UUID NOT NULL DEFAULT uuid_generate_v4 () PRIMARY KEY,
+  name TEXT NOT NULL,
+  channel_uuid UUID NOT NULL REFERENCES notification_channels(uuid) ON DELETE CASCADE,
+  triggers TEXT[] NOT NULL DEFAULT ARRAY[]::TEXT[],
+  environment TEXT[] NOT NULL DEFAULT ARRAY[]::TEXT[],
+  severity TEXT[] NOT NULL DEFAULT ARRAY[]::TEXT[],
+  service TEXT[] NOT NULL DEFAULT ARRAY[]::TEXT[],
+  tags TEXT[] NOT NULL DEFAULT ARRAY[]::TEXT[],
+  resource TEXT NOT NULL DEFAULT '',
+  enabled BOOLEAN NOT NULL DEFAULT TRUE,
+  created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  created_by UUID REFERENCES users(uuid) ON DELETE SET NULL
+);
+
+CREATE INDEX notification_rules_channel_uuid_idx ON notification_rules(channel_uuid);
+
+--
+-- Every notification to send, and the outcome of sending it. The payload is
+-- the alert as it was when the notification was triggered.
+--
+CREATE TABLE notification_deliveries (
+  uuid UUID NOT NULL DEFAULT uuid_generate_v4 () PRIMARY KEY,
+  channel_uuid UUID NOT NULL REFERENCES notification_channels(uuid) ON DELETE CASCADE,
+  rule_uuid UUID REFERENCES notification_rules(uuid) ON DELETE SET NULL,
+  alert_uuid UUID REFERENCES alerts(uuid) ON DELETE SET NULL,
+  trigger TEXT NOT NULL,
+  payload JSONB NOT NULL,
+  status TEXT NOT NULL DEFAULT 'pending',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  last_error TEXT NOT NULL DEFAULT '',
+  created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  delivered TIMESTAMPTZ,
+
+  CHECK(status IN ('pending', 'sent', 'failed'))
+);
+
+CREATE INDEX notification_deliveries_pending_idx ON notification_deliveries(next_attempt) WHERE status = 'pending';
+CREATE INDEX notification_deliveries_channel_uuid_created_idx ON notification_deliveries(channel_uuid, created);
+CREATE INDEX notification_deliveries_alert_uuid_idx ON notification_deliveries(alert_uuid);
+
+COMMIT;
@@ -0,0 +1,74 @@
+BEGIN;
+
+CREATE OR REPLACE FUNCTION public.alert_merge(
+        resource text,
+        environment text,
+        event text,
+        origin text,
+        severity alert_severity,
+        status alert_status,
+        service text[],
+        value text,
+        description text,
+        tags text[],
+        timeout integer,
+        rawdata bytea)
+    RETURNS alerts.uuid%TYPE
+    LANGUAGE 'plpgsql'
+    COST 100
+    VOLATILE PARALLEL UNSAFE
+AS $BODY$
+DECLARE
+	res_id alerts.uuid%TYPE;
+BEGIN
+    -- first try to update the key
+    UPDATE alerts SET
+        duplicate = alerts.duplicate + 1,
+        last_receive_time = now(),
+        previous_severity = alerts.severity,
+        severity = alert_merge.severity,
+        service = alert_merge.service,
+        description = alert_merge.description,
+        value = alert_merge.value,
+        timeout = alert_merge.timeout,
+        tags = alert_merge.tags,
+        rawdata = alert_merge.rawdata
+    WHERE alerts.status IN (
+        'open'::alert_status,
+        'acknowledge'::alert_status,
+        'shelve'::alert_status
+    )
+    AND COALESCE(alerts.last_receive_time, alerts.created) + make_interval(secs => alerts.timeout) >= NOW()
+    AND alerts.resource = alert_merge.resource
+    AND alerts.environment = alert_merge.environment
+    AND alerts.event = alert_merge.event
+    AND alerts.origin = alert_merge.origin
+	RETURNING uuid INTO res_id;
+    IF found THEN
+        RETURN res_id;
+    END IF;
+
+    INSERT INTO alerts (resource, environment, event, severity, status,
+                        value, description, origin, service, tags, timeout, rawdata)
+    VALUES (
+        resource,
+        environment,
+        event,
+        COALESCE(severity, 'indeterminate'::alert_severity),
+        COALESCE(status, 'open'::alert_status),
+        value,
+        description,
+        origin,
+        service,
+        tags,
+        timeout,
+        rawdata
+    ) RETURNING uuid INTO res_id;
+	RETURN res_id;
+END;
+$BODY$;
+
+DROP INDEX IF EXISTS alerts_merge_idx;
+DROP TABLE IF EXISTS alert_transitions;
+
+COMMIT;
@@ -0,0 +1,120 @@
+BEGIN;
+
+--
+-- Status changes made by the system rather than by a user; an alert expired
+-- by its timeout, or re-opened by a duplicate.
+--
+CREATE TABLE alert_transitions (
+  uuid UUID NOT NULL DEFAULT uuid_generate_v4 () PRIMARY KEY,
+  alert_uuid UUID NOT NULL REFERENCES alerts(uuid) ON DELETE CASCADE,
+  status_from alert_status NOT NULL,
+  status_to alert_status NOT NULL,
+  reason TEXT NOT NULL,
+  created TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX alert_transitions_alert_uuid_idx ON alert_transitions(alert_uuid, created);
+
+CREATE INDEX alerts_merge_idx ON alerts(resource, environment, event, origin);
+
+--
+-- A duplicate of an expired alert re-opens it, instead of adding a new alert.
+--
+CREATE OR REPLACE FUNCTION public.alert_merge(
+        resource text,
+        environment text,
+        event text,
+        origin text,
+        severity alert_severity,
+        status alert_status,
+        service text[],
+        value text,
+        description text,
+        tags text[],
+        timeout integer,
+        rawdata bytea)
+    RETURNS alerts.uuid%TYPE
+    LANGUAGE 'plpgsql'
+    COST 100
+    VOLATILE PARALLEL UNSAFE
+AS $BODY$
+DECLARE
+	res_id alerts.uuid%TYPE;
+	res_status alert_status;
+	res_expired boolean;
+BEGIN
+    -- the latest matching alert which has not been closed
+    SELECT alerts.uuid,
+        alerts.status,
+        alerts.status = 'expire'::alert_status OR (
+            alerts.status IN ('open'::alert_status, 'acknowledge'::alert_status)
+            AND COALESCE(alerts.last_receive_time, alerts.created) + make_interval(secs => alerts.timeout) < NOW()
+        )
+    INTO res_id, res_status, res_expired
+    FROM alerts
+    WHERE alerts.status IN (
+        'open'::alert_status,
+        'acknowledge'::alert_status,
+        'shelve'::alert_status,
+        'expire'::alert_status
+    )
+    AND alerts.resource = alert_merge.resource
+    AND alerts.environment = alert_merge.environment
+    AND alerts.event = alert_merge.event
+    AND alerts.origin = alert_merge.origin
+    ORDER BY COALESCE(alerts.last_receive_time, alerts.created) DESC
+    LIMIT 1
+    FOR UPDATE;
+
+    IF found THEN
+        UPDATE alerts SET
+            duplicate = alerts.duplicate + 1,
+            last_receive_time = now(),
+            previous_severity = alerts.severity,
+            severity = alert_merge.severity,
+            service = alert_merge.service,
+            description = alert_merge.description,
+            value = alert_merge.value,
+            timeout = alert_merge.timeout,
+            tags = alert_merge.tags,
+            rawdata = alert_merge.rawdata,
+            status = (CASE
+                WHEN res_expired THEN 'open'::alert_status
+                ELSE alerts.status
+            END)
+        WHERE alerts.uuid = res_id;
+
+        IF res_expired THEN
+            -- an alert not yet expired by the sweeper is expired first
+            IF res_status <> 'expire'::alert_status THEN
+                INSERT INTO alert_transitions (alert_uuid, status_from, status_to, reason)
+                VALUES (res_id, res_status, 'expire'::alert_status, 'timeout');
+            END IF;
+            INSERT INTO alert_transitions (alert_uuid, status_from, status_to, reason)
+            VALUES (res_id, 'expire'::alert_status, 'open'::alert_status, 'duplicate');
+        END IF;
+
+        RETURN res_id;
+    END IF;
+
+    INSERT INTO alerts (resource, environment, event, severity, status,
+                        value, description, origin, service, tags, timeout, rawdata)
+    VALUES (
+        resource,
+        environment,
+        event,
+        COALESCE(severity, 'indeterminate'::alert_severity),
+        COALESCE(status, 'open'::alert_status),
+        value,
+        description,
+        origin,
+        service,
+        tags,
+        timeout,
+        rawdata
+    ) RETURNING uuid INTO res_id;
+	RETURN res_id;
+END;
+$BODY$;
+
+COMMIT;
@@ -0,0 +1,169 @@
+BEGIN;
+
+--
+-- Values can not be removed from an enum, so 'silence' is kept but no longer used.
+--
+UPDATE alerts SET status = 'open'::alert_status WHERE status = 'silence'::alert_status;
+
+CREATE OR REPLACE FUNCTION public.alert_merge(
+        resource text,
+        environment text,
+        event text,
+        origin text,
+        severity alert_severity,
+        status alert_status,
+        service text[],
+        value text,
+        description text,
+        tags text[],
+        timeout integer,
+        rawdata bytea,
+        created_by uuid)
+    RETURNS alerts.uuid%TYPE
+    LANGUAGE 'plpgsql'
+    COST 100
+    VOLATILE PARALLEL UNSAFE
+AS $BODY$
+DECLARE
+	res_id alerts.uuid%TYPE;
+	res_status alert_status;
+	res_severity alert_severity;
+	res_value text;
+	res_duplicate integer;
+	res_expired boolean;
+BEGIN
+    -- the latest matching alert which has not been closed
+    SELECT alerts.uuid,
+        alerts.status,
+        alerts.severity,
+        alerts.value,
+        alerts.duplicate,
+        alerts.status = 'expire'::alert_status OR (
+            alerts.status IN ('open'::alert_status, 'acknowledge'::alert_status)
+            AND COALESCE(alerts.last_receive_time, alerts.created) + make_interval(secs => alerts.timeout) < NOW()
+        )
+    INTO res_id, res_status, res_severity, res_value, res_duplicate, res_expired
+    FROM alerts
+    WHERE alerts.status IN (
+        'open'::alert_status,
+        'acknowledge'::alert_status,
+        'shelve'::alert_status,
+        'expire'::alert_status
+    )
+    AND alerts.resource = alert_merge.resource
+    AND alerts.environment = alert_merge.environment
+    AND alerts.event = alert_merge.event
+    AND alerts.origin = alert_merge.origin
+    ORDER BY COALESCE(alerts.last_receive_time, alerts.created) DESC
+    LIMIT 1
+    FOR UPDATE;
+
+    IF found THEN
+        UPDATE alerts SET
+            duplicate = alerts.duplicate + 1,
+            last_receive_time = now(),
+            previous_severity = alerts.severity,
+            severity = alert_merge.severity,
+            service = alert_merge.service,
+            description = alert_merge.description,
+            value = alert_merge.value,
+            timeout = alert_merge.timeout,
+            tags = alert_merge.tags,
+            rawdata = alert_merge.rawdata,
+            status = (CASE
+                WHEN res_expired THEN 'open'::alert_status
+                ELSE alerts.status
+            END)
+        WHERE alerts.uuid = res_id;
+
+        INSERT INTO alert_history (alert_uuid, change, from_value, to_value, changed_by)
+        VALUES (res_id, 'duplicate', res_duplicate::text, (res_duplicate + 1)::text, created_by);
+
+        IF res_severity <> alert_merge.severity THEN
+            INSERT INTO alert_history (alert_uuid, change, from_value, to_value, changed_by)
+            VALUES (res_id, 'severity', res_severity::text, alert_merge.severity::text, created_by);
+        END IF;
+
+        IF res_value <> alert_merge.value THEN
+            INSERT INTO alert_history (alert_uuid, change, from_value, to_value, changed_by)
+            VALUES (res_id, 'value', res_value, alert_merge.value, created_by);
+        END IF;
+
+        IF res_expired THEN
+            -- an alert not yet expired by the sweeper is expired first
+            IF res_status <> 'expire'::alert_status THEN
+                INSERT INTO alert_history (alert_uuid, change, from_value, to_value, note)
+                VALUES (res_id, 'status', res_status::text, 'expire', 'Expired by timeout');
+            END IF;
+            INSERT INTO alert_history (alert_uuid, change, from_value, to_value, note)
+            VALUES (res_id, 'status', 'expire', 'open', 'Re-opened by duplicate');
+        END IF;
+
+        RETURN res_id;
+    END IF;
+
+    INSERT INTO alerts (resource, environment, event, severity, status,
+                        value, description, origin, service, tags, timeout, rawdata)
+    VALUES (
+        resource,
+        environment,
+        event,
+        COALESCE(severity, 'indeterminate'::alert_severity),
+        COALESCE(status, 'open'::alert_status),
+        value,
+        description,
+        origin,
+        service,
+        tags,
+        timeout,
+        rawdata
+    ) RETURNING uuid INTO res_id;
+
+    INSERT INTO alert_history (alert_uuid, change, to_value, changed_by)
+    VALUES (res_id, 'create', COALESCE(status, 'open'::alert_status)::text, created_by);
+
+	RETURN res_id;
+END;
+$BODY$;
+
+DROP VIEW v_alerts;
+
+CREATE VIEW v_alerts
+AS
+ SELECT
+    alerts.uuid,
+    alerts.resource,
+    alerts.environment,
+    alerts.event,
+    alerts.severity,
+    alerts.previous_severity,
+    (CASE
+        WHEN alerts.status IN ('open'::alert_status, 'acknowledge'::alert_status)
+            AND (
+                COALESCE(alerts.last_receive_time, alerts.created)
+                + make_interval(secs => alerts.timeout)
+            ) < now() THEN
+                'expire'::alert_status
+        WHEN alerts.status = 'shelve'::alert_status
+            AND alerts.shelved_until < now() THEN
+                'open'::alert_status
+        ELSE alerts.status
+    END)::alert_status AS status,
+    alerts.description,
+    alerts.value,
+    alerts.origin,
+    alerts.created,
+    alerts.last_receive_time,
+    alerts.timeout,
+    alerts.duplicate,
+    alerts.service,
+    alerts.tags,
+    alerts.rawdata,
+    alerts.shelved_until
+ FROM alerts;
+
+ALTER TABLE alerts DROP COLUMN silence_uuid;
+
+DROP TABLE IF EXISTS alert_silences;
+
+COMMIT;
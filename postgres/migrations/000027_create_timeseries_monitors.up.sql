@@ -0,0 +1,48 @@
+BEGIN;
+
+--
+-- Monitors raise an alert when the aggregate of a time series over a window
+-- crosses a threshold, or changes faster than a rate (per second) from the
+-- previous window. The alert is resolved once the value is back beyond the
+-- hysteresis.
+--
+-- Monitors evaluated on ingest are marked as due when data is added to their
+-- time series, those evaluated on schedule every interval.
+--
+CREATE TABLE timeseries_monitors (
+  uuid UUID NOT NULL DEFAULT uuid_generate_v4 () PRIMARY KEY,
+  ts_uuid UUID NOT NULL REFERENCES timeseries(uuid) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  kind TEXT NOT NULL,
+  threshold DOUBLE PRECISION NOT NULL,
+  hysteresis DOUBLE PRECISION NOT NULL DEFAULT 0,
+  aggregate TEXT NOT NULL DEFAULT 'avg',
+  window_seconds INTEGER NOT NULL DEFAULT 300,
+  evaluate TEXT NOT NULL DEFAULT 'ingest',
+  interval_seconds INTEGER NOT NULL DEFAULT 60,
+  severity alert_severity NOT NULL DEFAULT 'major',
+  environment TEXT NOT NULL DEFAULT '',
+  event TEXT NOT NULL DEFAULT '',
+  enabled BOOLEAN NOT NULL DEFAULT TRUE,
+
+  -- state of the evaluation
+  due BOOLEAN NOT NULL DEFAULT FALSE,
+  firing BOOLEAN NOT NULL DEFAULT FALSE,
+  alert_uuid UUID REFERENCES alerts(uuid) ON DELETE SET NULL,
+  value DOUBLE PRECISION,
+  evaluated TIMESTAMPTZ,
+
+  created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  created_by UUID REFERENCES users(uuid) ON DELETE SET NULL,
+
+  CHECK(kind IN ('above', 'below', 'rate')),
+  CHECK(aggregate IN ('avg', 'min', 'max', 'sum', 'count')),
+  CHECK(evaluate IN ('ingest', 'schedule')),
+  CHECK(hysteresis >= 0),
+  CHECK(window_seconds > 0),
+  CHECK(interval_seconds > 0)
+);
+
+CREATE INDEX timeseries_monitors_ts_uuid_idx ON timeseries_monitors(ts_uuid);
+
+COMMIT;
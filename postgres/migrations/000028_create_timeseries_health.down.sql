@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS timeseries_health;
+DROP TABLE IF EXISTS timeseries_health_rules;
+
+COMMIT;
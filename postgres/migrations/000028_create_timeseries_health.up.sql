@@ -0,0 +1,48 @@
+BEGIN;
+
+--
+-- Health rules set how often a time series is expected to report, and for
+-- how long its value may stay the same. A rule is either for a time series,
+-- or for all time series with a tag. The rule of a time series overrides
+-- those of its tags, of which the first by name applies.
+--
+CREATE TABLE timeseries_health_rules (
+  uuid UUID NOT NULL DEFAULT uuid_generate_v4 () PRIMARY KEY,
+  name TEXT NOT NULL,
+  ts_uuid UUID REFERENCES timeseries(uuid) ON DELETE CASCADE,
+  tag TEXT NOT NULL DEFAULT '',
+  expected_interval INTEGER,
+  flatline INTEGER,
+  severity alert_severity NOT NULL DEFAULT 'major',
+  environment TEXT NOT NULL DEFAULT '',
+  enabled BOOLEAN NOT NULL DEFAULT TRUE,
+  created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  created_by UUID REFERENCES users(uuid) ON DELETE SET NULL,
+
+  CHECK((ts_uuid IS NULL) <> (tag = '')),
+  CHECK(expected_interval IS NOT NULL OR flatline IS NOT NULL),
+  CHECK(expected_interval IS NULL OR expected_interval > 0),
+  CHECK(flatline IS NULL OR flatline > 0)
+);
+
+CREATE INDEX timeseries_health_rules_ts_uuid_idx ON timeseries_health_rules(ts_uuid);
+
+--
+-- The health of every time series as of its last evaluation.
+--
+CREATE TABLE timeseries_health (
+  ts_uuid UUID NOT NULL PRIMARY KEY REFERENCES timeseries(uuid) ON DELETE CASCADE,
+  state TEXT NOT NULL DEFAULT 'unknown',
+  since TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  last_seen TIMESTAMPTZ,
+  last_value DOUBLE PRECISION,
+  rule_uuid UUID REFERENCES timeseries_health_rules(uuid) ON DELETE SET NULL,
+  alert_uuid UUID REFERENCES alerts(uuid) ON DELETE SET NULL,
+  evaluated TIMESTAMPTZ,
+
+  CHECK(state IN ('unknown', 'ok', 'stale', 'flatline'))
+);
+
+CREATE INDEX timeseries_health_evaluated_idx ON timeseries_health(evaluated NULLS FIRST);
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE notification_deliveries DROP COLUMN IF EXISTS incident_uuid;
+
+DROP TABLE IF EXISTS incident_history;
+DROP TABLE IF EXISTS incident_alerts;
+DROP TABLE IF EXISTS incidents;
+DROP TABLE IF EXISTS alert_groups;
+
+COMMIT;
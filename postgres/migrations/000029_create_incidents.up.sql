@@ -0,0 +1,86 @@
+BEGIN;
+
+--
+-- Alert groups gather open alerts which share the values of their keys into
+-- one incident. The keys are any of
+--   environment: the environment of the alert
+--   event:       the event of the alert
+--   tag:         the first tag of the alert starting with tag_prefix
+--   parent:      the parent, in thing_deps, of the thing whose UUID starts the
+--                resource of the alert
+-- An alert without a value for one of the keys is not grouped.
+--
+CREATE TABLE alert_groups (
+  uuid UUID NOT NULL DEFAULT uuid_generate_v4 () PRIMARY KEY,
+  name TEXT NOT NULL,
+  keys TEXT[] NOT NULL,
+  tag_prefix TEXT NOT NULL DEFAULT '',
+  enabled BOOLEAN NOT NULL DEFAULT TRUE,
+  created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  created_by UUID REFERENCES users(uuid) ON DELETE SET NULL,
+
+  CHECK(cardinality(keys) > 0),
+  CHECK(keys <@ ARRAY['environment', 'event', 'tag', 'parent']::TEXT[]),
+  CHECK(('tag' = ANY(keys)) = (tag_prefix <> ''))
+);
+
+--
+-- An incident has its own status, and the severity of the most severe of its
+-- alerts which have not cleared. There is at most one incident which is not
+-- closed for each group and key.
+--
+CREATE TABLE incidents (
+  uuid UUID NOT NULL DEFAULT uuid_generate_v4 () PRIMARY KEY,
+  group_uuid UUID REFERENCES alert_groups(uuid) ON DELETE SET NULL,
+  key TEXT NOT NULL,
+  title TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'open',
+  severity alert_severity NOT NULL,
+  created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  closed TIMESTAMPTZ,
+
+  CHECK(status IN ('open', 'acknowledge', 'close'))
+);
+
+CREATE UNIQUE INDEX incidents_group_uuid_key_idx ON incidents(group_uuid, key) WHERE status <> 'close';
+CREATE INDEX incidents_created_idx ON incidents(created);
+
+CREATE TABLE incident_alerts (
+  incident_uuid UUID NOT NULL REFERENCES incidents(uuid) ON DELETE CASCADE,
+  alert_uuid UUID NOT NULL REFERENCES alerts(uuid) ON DELETE CASCADE,
+  added TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  PRIMARY KEY(incident_uuid, alert_uuid)
+);
+
+CREATE INDEX incident_alerts_alert_uuid_idx ON incident_alerts(alert_uuid);
+
+--
+-- The timeline of an incident; when it was created, an alert was added to it,
+-- or it changed status or severity.
+--
+CREATE TABLE incident_history (
+  id BIGSERIAL PRIMARY KEY,
+  incident_uuid UUID NOT NULL REFERENCES incidents(uuid) ON DELETE CASCADE,
+  change TEXT NOT NULL,
+  from_value TEXT NOT NULL DEFAULT '',
+  to_value TEXT NOT NULL DEFAULT '',
+  note TEXT NOT NULL DEFAULT '',
+  alert_uuid UUID REFERENCES alerts(uuid) ON DELETE SET NULL,
+  changed TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  changed_by UUID REFERENCES users(uuid) ON DELETE SET NULL,
+
+  CHECK(change IN ('create', 'alert', 'status', 'severity'))
+);
+
+CREATE INDEX incident_history_incident_uuid_changed_idx ON incident_history(incident_uuid, changed);
+
+--
+-- Notifications of the alerts of an incident are sent for the incident.
+--
+ALTER TABLE notification_deliveries ADD COLUMN incident_uuid UUID REFERENCES incidents(uuid) ON DELETE SET NULL;
+
+CREATE INDEX notification_deliveries_incident_uuid_idx ON notification_deliveries(incident_uuid);
+
+COMMIT;
@@ -0,0 +1,29 @@
+BEGIN;
+
+--
+-- Every execution of a program, with its outcome and the output written by
+-- its log module. A run is recorded as running when it is handed to a
+-- worker, and completed when the worker returns.
+--
+CREATE TABLE program_runs (
+  id BIGSERIAL PRIMARY KEY,
+  program_uuid UUID NOT NULL REFERENCES programs(uuid) ON DELETE CASCADE,
+  revision INTEGER NOT NULL,
+  worker TEXT NOT NULL DEFAULT '',
+  trigger TEXT NOT NULL DEFAULT 'schedule',
+  started TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  ended TIMESTAMPTZ,
+  duration INTEGER,
+  outcome TEXT NOT NULL DEFAULT 'running',
+  error TEXT NOT NULL DEFAULT '',
+  output TEXT NOT NULL DEFAULT '',
+
+  CHECK(trigger IN ('schedule', 'webhook', 'manual')),
+  CHECK(outcome IN ('running', 'success', 'failure', 'timeout')),
+  CHECK((outcome = 'running') = (ended IS NULL))
+);
+
+CREATE INDEX program_runs_program_uuid_started_idx ON program_runs(program_uuid, started DESC);
+CREATE INDEX program_runs_started_idx ON program_runs(started);
+
+COMMIT;
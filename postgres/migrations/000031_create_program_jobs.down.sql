@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE program_runs DROP COLUMN IF EXISTS job_id;
+DROP TABLE IF EXISTS program_jobs;
+
+COMMIT;
@@ -0,0 +1,39 @@
+BEGIN;
+
+--
+-- The queue of program runs. A job is claimed for a worker with a lease,
+-- which is extended while the worker runs it. A job whose lease expires is
+-- claimed again, so every job runs at least once. A failed job is retried
+-- with a growing delay until it has been attempted max_attempts times, when
+-- it is marked dead.
+--
+CREATE TABLE program_jobs (
+  id BIGSERIAL PRIMARY KEY,
+  program_uuid UUID NOT NULL REFERENCES programs(uuid) ON DELETE CASCADE,
+  revision INTEGER NOT NULL,
+  trigger TEXT NOT NULL DEFAULT 'schedule',
+  status TEXT NOT NULL DEFAULT 'queued',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  max_attempts INTEGER NOT NULL DEFAULT 5,
+  run_after TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  lease_until TIMESTAMPTZ,
+  worker TEXT NOT NULL DEFAULT '',
+  last_error TEXT NOT NULL DEFAULT '',
+  created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CHECK(trigger IN ('schedule', 'webhook', 'manual')),
+  CHECK(status IN ('queued', 'running', 'done', 'dead')),
+  CHECK(max_attempts > 0)
+);
+
+CREATE INDEX program_jobs_queued_idx ON program_jobs(run_after) WHERE status = 'queued';
+CREATE INDEX program_jobs_running_idx ON program_jobs(lease_until) WHERE status = 'running';
+CREATE INDEX program_jobs_program_uuid_idx ON program_jobs(program_uuid, created DESC);
+CREATE INDEX program_jobs_updated_idx ON program_jobs(updated);
+
+ALTER TABLE program_runs ADD COLUMN job_id BIGINT REFERENCES program_jobs(id) ON DELETE SET NULL;
+
+CREATE INDEX program_runs_job_id_idx ON program_runs(job_id);
+
+COMMIT;
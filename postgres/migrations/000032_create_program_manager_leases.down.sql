@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS program_jobs_scheduled_idx;
+ALTER TABLE program_jobs DROP COLUMN IF EXISTS scheduled;
+
+DROP TABLE IF EXISTS program_manager_leases;
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+--
+-- Leases held by Program Managers. Only the holder of the scheduler lease of
+-- a domain queues its routines, while the other Program Managers stand by to
+-- take over once the lease expires.
+--
+CREATE TABLE program_manager_leases (
+  name TEXT PRIMARY KEY,
+  holder TEXT NOT NULL,
+  acquired TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  expires TIMESTAMPTZ NOT NULL
+);
+
+--
+-- The time a scheduled job was due by the schedule of its program. A routine
+-- is queued once per tick of its schedule, even when two Program Managers
+-- both believe they hold the lease.
+--
+ALTER TABLE program_jobs ADD COLUMN scheduled TIMESTAMPTZ;
+
+CREATE UNIQUE INDEX program_jobs_scheduled_idx ON program_jobs(program_uuid, scheduled) WHERE scheduled IS NOT NULL;
+
+COMMIT;
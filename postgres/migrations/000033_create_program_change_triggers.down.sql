@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TRIGGER program_code_revisions_change_trigger ON program_code_revisions;
+DROP TRIGGER programs_change_trigger ON programs;
+
+DROP FUNCTION program_change_notify;
+
+COMMIT;
@@ -0,0 +1,41 @@
+BEGIN;
+
+--
+-- Notifies the Program Managers listening on the program_changes channel of
+-- the UUID of a program which was added, changed or removed, or whose code
+-- revisions changed. Notifications are only delivered once the transaction
+-- commits, and the same UUID is delivered once per transaction.
+--
+CREATE FUNCTION program_change_notify() RETURNS TRIGGER AS $$
+DECLARE
+  v_uuid UUID;
+BEGIN
+  IF TG_TABLE_NAME = 'programs' THEN
+    IF TG_OP = 'DELETE' THEN
+      v_uuid := OLD.uuid;
+    ELSE
+      v_uuid := NEW.uuid;
+    END IF;
+  ELSE
+    IF TG_OP = 'DELETE' THEN
+      v_uuid := OLD.program_uuid;
+    ELSE
+      v_uuid := NEW.program_uuid;
+    END IF;
+  END IF;
+
+  PERFORM pg_notify('program_changes', v_uuid::text);
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER programs_change_trigger
+AFTER INSERT OR UPDATE OR DELETE ON programs
+FOR EACH ROW EXECUTE PROCEDURE program_change_notify();
+
+CREATE TRIGGER program_code_revisions_change_trigger
+AFTER INSERT OR UPDATE OR DELETE ON program_code_revisions
+FOR EACH ROW EXECUTE PROCEDURE program_change_notify();
+
+COMMIT;
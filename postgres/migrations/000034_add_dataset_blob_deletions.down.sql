@@ -0,0 +1,14 @@
+BEGIN;
+
+DROP TRIGGER IF EXISTS dataset_revisions_blob_release_trigger ON dataset_revisions;
+DROP TRIGGER IF EXISTS datasets_blob_release_trigger ON datasets;
+DROP FUNCTION IF EXISTS dataset_blob_release();
+DROP TABLE IF EXISTS dataset_blob_deletions;
+
+-- Content larger than 2 GiB must be removed before this step
+ALTER TABLE dataset_revisions ALTER COLUMN stored_size TYPE INTEGER;
+ALTER TABLE dataset_revisions ALTER COLUMN size TYPE INTEGER;
+ALTER TABLE datasets ALTER COLUMN stored_size TYPE INTEGER;
+ALTER TABLE datasets ALTER COLUMN size TYPE INTEGER;
+
+COMMIT;
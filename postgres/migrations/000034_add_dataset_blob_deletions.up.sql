@@ -0,0 +1,51 @@
+BEGIN;
+
+-- Content kept outside of the database may be larger than 2 GiB
+ALTER TABLE datasets ALTER COLUMN size TYPE BIGINT;
+ALTER TABLE datasets ALTER COLUMN stored_size TYPE BIGINT;
+ALTER TABLE dataset_revisions ALTER COLUMN size TYPE BIGINT;
+ALTER TABLE dataset_revisions ALTER COLUMN stored_size TYPE BIGINT;
+
+--
+-- Content kept outside of the database which may no longer be referenced,
+-- because the dataset or the revision referring to it was removed or
+-- changed. Content is shared by every row with the same checksum, so it is
+-- only removed from the storage backend once no row refers to it. The
+-- checksum is hex encoded, as it addresses the content.
+--
+CREATE TABLE dataset_blob_deletions (
+	storage TEXT NOT NULL,
+	checksum TEXT NOT NULL,
+	compression TEXT NOT NULL,
+	created TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (storage, checksum, compression)
+);
+
+CREATE FUNCTION dataset_blob_release() RETURNS TRIGGER AS $$
+BEGIN
+  IF OLD.storage = 'database' THEN
+    RETURN NULL;
+  END IF;
+
+  IF TG_OP = 'UPDATE' AND
+    (NEW.storage, NEW.checksum, NEW.compression) IS NOT DISTINCT FROM (OLD.storage, OLD.checksum, OLD.compression) THEN
+    RETURN NULL;
+  END IF;
+
+  INSERT INTO dataset_blob_deletions(storage, checksum, compression)
+  VALUES (OLD.storage, encode(OLD.checksum, 'hex'), OLD.compression)
+  ON CONFLICT DO NOTHING;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER datasets_blob_release_trigger
+AFTER UPDATE OR DELETE ON datasets
+FOR EACH ROW EXECUTE PROCEDURE dataset_blob_release();
+
+CREATE TRIGGER dataset_revisions_blob_release_trigger
+AFTER UPDATE OR DELETE ON dataset_revisions
+FOR EACH ROW EXECUTE PROCEDURE dataset_blob_release();
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DELETE FROM group_policies
+WHERE resource ~ '^templates/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$';
+
+COMMIT;
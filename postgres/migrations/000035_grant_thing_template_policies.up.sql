@@ -0,0 +1,16 @@
+BEGIN;
+
+--
+-- Templates are accessed through the resource templates/<uuid>. Grant the
+-- user who added an existing template the same access as to a new template.
+--
+INSERT INTO group_policies(group_uuid, priority, effect, action, resource)
+SELECT groups.uuid, 0, 'allow', a.action, 'templates/'||thing_templates.uuid
+FROM thing_templates, users, groups, user_groups,
+	(VALUES ('read'::policy_action), ('update'::policy_action), ('delete'::policy_action)) AS a(action)
+WHERE users.uuid = thing_templates.created_by
+AND groups.name = users.name
+AND user_groups.group_uuid = groups.uuid
+AND user_groups.user_uuid = users.uuid;
+
+COMMIT;
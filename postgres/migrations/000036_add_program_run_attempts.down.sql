@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE program_runs DROP COLUMN IF EXISTS attempt;
+
+COMMIT;
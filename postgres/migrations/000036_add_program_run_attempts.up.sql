@@ -0,0 +1,9 @@
+BEGIN;
+
+--
+-- The attempt of the job which made the run. A run left behind by an attempt
+-- whose lease expired is failed by a later attempt, and only by a later one.
+--
+ALTER TABLE program_runs ADD COLUMN attempt INTEGER NOT NULL DEFAULT 0;
+
+COMMIT;
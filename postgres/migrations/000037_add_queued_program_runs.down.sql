@@ -0,0 +1,15 @@
+BEGIN;
+
+UPDATE program_runs
+SET ended = NOW(),
+	outcome = 'failure',
+	error = 'the run was never started'
+WHERE outcome = 'queued';
+
+ALTER TABLE program_runs DROP CONSTRAINT program_runs_outcome_check;
+ALTER TABLE program_runs DROP CONSTRAINT program_runs_check;
+
+ALTER TABLE program_runs ADD CHECK(outcome IN ('running', 'success', 'failure', 'timeout'));
+ALTER TABLE program_runs ADD CHECK((outcome = 'running') = (ended IS NULL));
+
+COMMIT;
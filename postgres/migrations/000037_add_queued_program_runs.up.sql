@@ -0,0 +1,13 @@
+BEGIN;
+
+--
+-- A run queued with its job, before a worker starts it. A routine run now is
+-- given its run when it is queued, so that the run can be followed at once.
+--
+ALTER TABLE program_runs DROP CONSTRAINT program_runs_outcome_check;
+ALTER TABLE program_runs DROP CONSTRAINT program_runs_check;
+
+ALTER TABLE program_runs ADD CHECK(outcome IN ('queued', 'running', 'success', 'failure', 'timeout'));
+ALTER TABLE program_runs ADD CHECK((outcome IN ('queued', 'running')) = (ended IS NULL));
+
+COMMIT;
@@ -0,0 +1,63 @@
+BEGIN;
+
+DROP TRIGGER tsdata_audit_delete_trigger ON tsdata;
+DROP TRIGGER tsdata_audit_trigger ON tsdata;
+
+DROP FUNCTION tsdata_audit_delete;
+
+CREATE OR REPLACE FUNCTION tsdata_audit_change() RETURNS TRIGGER AS $$
+DECLARE
+  v_action tsdata_audit_action;
+BEGIN
+  IF TG_OP = 'UPDATE' THEN
+    IF NEW.value IS NOT DISTINCT FROM OLD.value THEN
+      RETURN NULL;
+    END IF;
+    v_action := 'overwrite';
+  ELSE
+    v_action := 'delete';
+  END IF;
+
+  INSERT INTO tsdata_audit(action, ts_uuid, value, ts, created_by, changed_by, token_uuid)
+  VALUES (
+    v_action,
+    OLD.ts_uuid,
+    OLD.value,
+    OLD.ts,
+    OLD.created_by,
+    NULLIF(current_setting('selfhost.user_uuid', true), '')::uuid,
+    NULLIF(current_setting('selfhost.token_uuid', true), '')::uuid
+  );
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tsdata_audit_trigger
+AFTER UPDATE OR DELETE ON tsdata
+FOR EACH ROW EXECUTE PROCEDURE tsdata_audit_change();
+
+-- Each deleted range goes back to a change for each data point
+INSERT INTO tsdata_audit(change_id, action, ts_uuid, value, ts, created_by, changed, changed_by, token_uuid, restored)
+SELECT
+  tsdata_audit.change_id,
+  tsdata_audit.action,
+  tsdata_audit.ts_uuid,
+  p.v,
+  p.ts,
+  (SELECT users.uuid FROM users WHERE users.uuid = p.created_by),
+  tsdata_audit.changed,
+  tsdata_audit.changed_by,
+  tsdata_audit.token_uuid,
+  tsdata_audit.restored
+FROM tsdata_audit, jsonb_to_recordset(tsdata_audit.points) AS p(v DOUBLE PRECISION, ts TIMESTAMPTZ, created_by UUID)
+WHERE tsdata_audit.points IS NOT NULL;
+
+DELETE FROM tsdata_audit WHERE points IS NOT NULL;
+
+ALTER TABLE tsdata_audit DROP COLUMN IF EXISTS points;
+ALTER TABLE tsdata_audit DROP COLUMN IF EXISTS count;
+ALTER TABLE tsdata_audit DROP COLUMN IF EXISTS ts_end;
+ALTER TABLE tsdata_audit ALTER COLUMN value SET NOT NULL;
+
+COMMIT;
@@ -0,0 +1,68 @@
+BEGIN;
+
+--
+-- A delete is recorded as a single change for each time series, over the range
+-- from ts to ts_end, holding the deleted data points in points for undo. An
+-- overwrite is still recorded one data point at a time, with its old value.
+--
+ALTER TABLE tsdata_audit ALTER COLUMN value DROP NOT NULL;
+ALTER TABLE tsdata_audit ADD COLUMN ts_end TIMESTAMPTZ;
+ALTER TABLE tsdata_audit ADD COLUMN count INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE tsdata_audit ADD COLUMN points JSONB;
+
+CREATE OR REPLACE FUNCTION tsdata_audit_change() RETURNS TRIGGER AS $$
+BEGIN
+  IF NEW.value IS NOT DISTINCT FROM OLD.value THEN
+    RETURN NULL;
+  END IF;
+
+  INSERT INTO tsdata_audit(action, ts_uuid, value, ts, created_by, changed_by, token_uuid)
+  VALUES (
+    'overwrite',
+    OLD.ts_uuid,
+    OLD.value,
+    OLD.ts,
+    OLD.created_by,
+    NULLIF(current_setting('selfhost.user_uuid', true), '')::uuid,
+    NULLIF(current_setting('selfhost.token_uuid', true), '')::uuid
+  );
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION tsdata_audit_delete() RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO tsdata_audit(action, ts_uuid, ts, ts_end, count, points, changed_by, token_uuid)
+  SELECT
+    'delete',
+    deleted.ts_uuid,
+    MIN(deleted.ts),
+    MAX(deleted.ts),
+    COUNT(*),
+    jsonb_agg(jsonb_build_object(
+      'v', deleted.value,
+      'ts', deleted.ts,
+      'created_by', deleted.created_by
+    ) ORDER BY deleted.ts),
+    NULLIF(current_setting('selfhost.user_uuid', true), '')::uuid,
+    NULLIF(current_setting('selfhost.token_uuid', true), '')::uuid
+  FROM deleted
+  GROUP BY deleted.ts_uuid;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER tsdata_audit_trigger ON tsdata;
+
+CREATE TRIGGER tsdata_audit_trigger
+AFTER UPDATE ON tsdata
+FOR EACH ROW EXECUTE PROCEDURE tsdata_audit_change();
+
+CREATE TRIGGER tsdata_audit_delete_trigger
+AFTER DELETE ON tsdata
+REFERENCING OLD TABLE AS deleted
+FOR EACH STATEMENT EXECUTE PROCEDURE tsdata_audit_delete();
+
+COMMIT;
@@ -4,6 +4,7 @@ package postgres
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -140,6 +141,25 @@ func (e *ThingState) Scan(src interface{}) error {
 	return nil
 }
 
+type TsdataAuditAction string
+
+const (
+	TsdataAuditActionDelete    TsdataAuditAction = "delete"
+	TsdataAuditActionOverwrite TsdataAuditAction = "overwrite"
+)
+
+func (e *TsdataAuditAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TsdataAuditAction(s)
+	case string:
+		*e = TsdataAuditAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TsdataAuditAction: %T", src)
+	}
+	return nil
+}
+
 type Alert struct {
 	Uuid             uuid.UUID
 	Resource         string
@@ -158,21 +178,87 @@ type Alert struct {
 	Duplicate        int32
 	PreviousSeverity AlertSeverity
 	LastReceiveTime  sql.NullTime
+	ShelvedUntil     sql.NullTime
+	SilenceUuid      uuid.UUID
 }
 
-type Dataset struct {
+type AlertGroup struct {
 	Uuid      uuid.UUID
 	Name      string
-	Format    string
-	Content   []byte
-	Checksum  []byte
-	Size      int32
-	BelongsTo uuid.UUID
+	Keys      []string
+	TagPrefix string
+	Enabled   bool
 	Created   time.Time
-	Updated   time.Time
 	CreatedBy uuid.UUID
-	UpdatedBy uuid.UUID
-	Tags      []string
+}
+
+type AlertHistory struct {
+	ID        int64
+	AlertUuid uuid.UUID
+	Change    string
+	FromValue string
+	ToValue   string
+	Note      string
+	Changed   time.Time
+	ChangedBy uuid.UUID
+}
+
+type AlertSilence struct {
+	Uuid        uuid.UUID
+	Comment     string
+	Resource    []string
+	Environment []string
+	Event       []string
+	Service     []string
+	Tags        []string
+	Starts      time.Time
+	Ends        time.Time
+	Weekdays    []string
+	WindowStart string
+	WindowEnd   string
+	Timezone    string
+	Created     time.Time
+	CreatedBy   uuid.UUID
+}
+
+type Dataset struct {
+	Uuid         uuid.UUID
+	Name         string
+	Format       string
+	Content      []byte
+	Checksum     []byte
+	Size         int64
+	BelongsTo    uuid.UUID
+	Created      time.Time
+	Updated      time.Time
+	CreatedBy    uuid.UUID
+	UpdatedBy    uuid.UUID
+	Tags         []string
+	MaxRevisions sql.NullInt32
+	Storage      string
+	Schema       json.RawMessage
+	Compression  string
+	StoredSize   int64
+}
+
+type DatasetBlobDeletion struct {
+	Storage     string
+	Checksum    string
+	Compression string
+	Created     time.Time
+}
+
+type DatasetRevision struct {
+	DatasetUuid uuid.UUID
+	Revision    int32
+	Content     []byte
+	Checksum    []byte
+	Size        int64
+	Created     time.Time
+	CreatedBy   uuid.UUID
+	Storage     string
+	Compression string
+	StoredSize  int64
 }
 
 type Group struct {
@@ -189,6 +275,77 @@ type GroupPolicy struct {
 	Resource  string
 }
 
+type Incident struct {
+	Uuid      uuid.UUID
+	GroupUuid uuid.UUID
+	Key       string
+	Title     string
+	Status    string
+	Severity  AlertSeverity
+	Created   time.Time
+	Updated   time.Time
+	Closed    sql.NullTime
+}
+
+type IncidentAlert struct {
+	IncidentUuid uuid.UUID
+	AlertUuid    uuid.UUID
+	Added        time.Time
+}
+
+type IncidentHistory struct {
+	ID           int64
+	IncidentUuid uuid.UUID
+	Change       string
+	FromValue    string
+	ToValue      string
+	Note         string
+	AlertUuid    uuid.UUID
+	Changed      time.Time
+	ChangedBy    uuid.UUID
+}
+
+type NotificationChannel struct {
+	Uuid      uuid.UUID
+	Name      string
+	Kind      string
+	Config    json.RawMessage
+	Enabled   bool
+	Created   time.Time
+	CreatedBy uuid.UUID
+}
+
+type NotificationDelivery struct {
+	Uuid         uuid.UUID
+	ChannelUuid  uuid.UUID
+	RuleUuid     uuid.UUID
+	AlertUuid    uuid.UUID
+	Trigger      string
+	Payload      json.RawMessage
+	Status       string
+	Attempts     int32
+	NextAttempt  time.Time
+	LastError    string
+	Created      time.Time
+	Delivered    sql.NullTime
+	IncidentUuid uuid.UUID
+}
+
+type NotificationRule struct {
+	Uuid        uuid.UUID
+	Name        string
+	ChannelUuid uuid.UUID
+	Triggers    []string
+	Environment []string
+	Severity    []string
+	Service     []string
+	Tags        []string
+	Resource    string
+	Enabled     bool
+	Created     time.Time
+	CreatedBy   uuid.UUID
+}
+
 type Program struct {
 	Uuid     uuid.UUID
 	Name     string
@@ -200,6 +357,46 @@ type Program struct {
 	Tags     []string
 }
 
+type ProgramJob struct {
+	ID          int64
+	ProgramUuid uuid.UUID
+	Revision    int32
+	Trigger     string
+	Status      string
+	Attempts    int32
+	MaxAttempts int32
+	RunAfter    time.Time
+	LeaseUntil  sql.NullTime
+	Worker      string
+	LastError   string
+	Created     time.Time
+	Updated     time.Time
+	Scheduled   sql.NullTime
+}
+
+type ProgramManagerLease struct {
+	Name     string
+	Holder   string
+	Acquired time.Time
+	Expires  time.Time
+}
+
+type ProgramRun struct {
+	ID          int64
+	ProgramUuid uuid.UUID
+	Revision    int32
+	Worker      string
+	Trigger     string
+	Started     time.Time
+	Ended       sql.NullTime
+	Duration    sql.NullInt32
+	Outcome     string
+	Error       string
+	Output      string
+	JobID       sql.NullInt64
+	Attempt     int32
+}
+
 type ProgramCodeRevision struct {
 	ProgramUuid uuid.UUID
 	Revision    int32
@@ -212,17 +409,52 @@ type ProgramCodeRevision struct {
 }
 
 type Thing struct {
-	Uuid      uuid.UUID
-	Name      string
-	Type      sql.NullString
-	State     ThingState
-	CreatedBy uuid.UUID
-	Tags      []string
+	Uuid       uuid.UUID
+	Name       string
+	Type       sql.NullString
+	State      ThingState
+	CreatedBy  uuid.UUID
+	Tags       []string
+	Attributes json.RawMessage
+	Longitude  sql.NullFloat64
+	Latitude   sql.NullFloat64
+	Area       json.RawMessage
 }
 
 type ThingDep struct {
-	Parent uuid.UUID
-	Child  uuid.UUID
+	Parent          uuid.UUID
+	Child           uuid.UUID
+	InheritPolicies bool
+}
+
+type ThingStateHistory struct {
+	ID        int64
+	ThingUuid uuid.UUID
+	FromState ThingState
+	ToState   ThingState
+	Reason    string
+	Changed   time.Time
+	ChangedBy uuid.UUID
+}
+
+type ThingStateTransition struct {
+	ThingType string
+	FromState ThingState
+	ToState   ThingState
+}
+
+type ThingTemplate struct {
+	Uuid       uuid.UUID
+	Name       string
+	ThingType  string
+	Timeseries json.RawMessage
+	Datasets   json.RawMessage
+	CreatedBy  uuid.UUID
+}
+
+type ThingTypeSchema struct {
+	Type   string
+	Schema json.RawMessage
 }
 
 type Timeseries struct {
@@ -234,6 +466,56 @@ type Timeseries struct {
 	UpperBound sql.NullFloat64
 	CreatedBy  uuid.UUID
 	Tags       []string
+	Attributes json.RawMessage
+}
+
+type TimeseriesHealth struct {
+	TsUuid    uuid.UUID
+	State     string
+	Since     time.Time
+	LastSeen  sql.NullTime
+	LastValue sql.NullFloat64
+	RuleUuid  uuid.UUID
+	AlertUuid uuid.UUID
+	Evaluated sql.NullTime
+}
+
+type TimeseriesHealthRule struct {
+	Uuid             uuid.UUID
+	Name             string
+	TsUuid           uuid.UUID
+	Tag              string
+	ExpectedInterval sql.NullInt32
+	Flatline         sql.NullInt32
+	Severity         AlertSeverity
+	Environment      string
+	Enabled          bool
+	Created          time.Time
+	CreatedBy        uuid.UUID
+}
+
+type TimeseriesMonitor struct {
+	Uuid            uuid.UUID
+	TsUuid          uuid.UUID
+	Name            string
+	Kind            string
+	Threshold       float64
+	Hysteresis      float64
+	Aggregate       string
+	WindowSeconds   int32
+	Evaluate        string
+	IntervalSeconds int32
+	Severity        AlertSeverity
+	Environment     string
+	Event           string
+	Enabled         bool
+	Due             bool
+	Firing          bool
+	AlertUuid       uuid.UUID
+	Value           sql.NullFloat64
+	Evaluated       sql.NullTime
+	Created         time.Time
+	CreatedBy       uuid.UUID
 }
 
 type Tsdata0 struct {
@@ -1004,6 +1286,23 @@ type Tsdata98 struct {
 type Tsdata99 struct {
 }
 
+type TsdataAudit struct {
+	ID        int64
+	ChangeID  int64
+	Action    TsdataAuditAction
+	TsUuid    uuid.UUID
+	Value     sql.NullFloat64
+	Ts        time.Time
+	CreatedBy uuid.UUID
+	Changed   time.Time
+	ChangedBy uuid.UUID
+	TokenUuid uuid.UUID
+	Restored  sql.NullTime
+	TsEnd     sql.NullTime
+	Count     int32
+	Points    json.RawMessage
+}
+
 type Tsdatum struct {
 	TsUuid    uuid.UUID
 	Value     float64
@@ -1048,4 +1347,6 @@ type VAlert struct {
 	Service          []string
 	Tags             []string
 	Rawdata          []byte
+	ShelvedUntil     sql.NullTime
+	SilenceUuid      uuid.UUID
 }
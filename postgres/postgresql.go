@@ -221,6 +221,23 @@ func GetDB(domain string) (*sql.DB, error) {
 	return c, nil
 }
 
+// GetConnectionString returns the connection string of the DB of the domain,
+// for those who need a connection of their own.
+func GetConnectionString(domain string) (string, error) {
+	dbCacheMux.RLock()
+	res, ok := dbCache[domain]
+	dbCacheMux.RUnlock()
+
+	if ok == false {
+		return "", fmt.Errorf("no such domain '%v'", domain)
+	}
+
+	res.RLock()
+	defer res.RUnlock()
+
+	return res.ConnectionString, nil
+}
+
 func GetAllDB() []DomainDB {
 	dbCacheMux.RLock()
 	dbs := make([]DomainDB, 0)
@@ -555,6 +555,83 @@ func (q *Queries) FindProgramsByTags(ctx context.Context, arg FindProgramsByTags
 	return items, nil
 }
 
+const findRoutineRevisionsByProgram = `-- name: FindRoutineRevisionsByProgram :many
+WITH p AS (
+	SELECT
+		pcr.*,
+		programs.*,
+		RANK () OVER (
+			PARTITION BY pcr.program_uuid
+			ORDER BY revision DESC
+		) revision_rank
+	FROM program_code_revisions AS pcr
+	INNER JOIN programs ON programs.uuid = pcr.program_uuid
+	WHERE pcr.signed IS NOT NULL
+	AND programs.type IN ('routine', 'webhook')
+	AND programs.state = 'active'
+	AND programs.uuid = $1
+)
+SELECT
+	p.name,
+	p.program_uuid,
+	p.type,
+	p.schedule,
+	p.deadline,
+	p.language,
+	p.revision,
+	p.code,
+	encode(p.checksum, 'hex') AS checksum
+FROM p
+WHERE revision_rank = 1
+`
+
+type FindRoutineRevisionsByProgramRow struct {
+	Name        string
+	ProgramUuid uuid.UUID
+	Type        string
+	Schedule    string
+	Deadline    int32
+	Language    string
+	Revision    int32
+	Code        []byte
+	Checksum    string
+}
+
+// The latest signed revision of a program, like FindAllRoutineRevisions,
+// for when a single program changed.
+func (q *Queries) FindRoutineRevisionsByProgram(ctx context.Context, programUuid uuid.UUID) ([]FindRoutineRevisionsByProgramRow, error) {
+	rows, err := q.query(ctx, q.findRoutineRevisionsByProgramStmt, findRoutineRevisionsByProgram, programUuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindRoutineRevisionsByProgramRow{}
+	for rows.Next() {
+		var i FindRoutineRevisionsByProgramRow
+		if err := rows.Scan(
+			&i.Name,
+			&i.ProgramUuid,
+			&i.Type,
+			&i.Schedule,
+			&i.Deadline,
+			&i.Language,
+			&i.Revision,
+			&i.Code,
+			&i.Checksum,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getNamedModuleCodeAtHead = `-- name: GetNamedModuleCodeAtHead :one
 SELECT
 	code
@@ -650,6 +727,33 @@ func (q *Queries) GetProgramCodeAtRevision(ctx context.Context, arg GetProgramCo
 	return code, err
 }
 
+const getProgramCodeRevision = `-- name: GetProgramCodeRevision :one
+SELECT
+	code,
+	signed IS NOT NULL AS signed
+FROM program_code_revisions
+WHERE program_uuid = $1
+AND revision = $2
+LIMIT 1
+`
+
+type GetProgramCodeRevisionParams struct {
+	ProgramUuid uuid.UUID
+	Revision    int32
+}
+
+type GetProgramCodeRevisionRow struct {
+	Code   []byte
+	Signed bool
+}
+
+func (q *Queries) GetProgramCodeRevision(ctx context.Context, arg GetProgramCodeRevisionParams) (GetProgramCodeRevisionRow, error) {
+	row := q.queryRow(ctx, q.getProgramCodeRevisionStmt, getProgramCodeRevision, arg.ProgramUuid, arg.Revision)
+	var i GetProgramCodeRevisionRow
+	err := row.Scan(&i.Code, &i.Signed)
+	return i, err
+}
+
 const getSignedProgramCodeAtHead = `-- name: GetSignedProgramCodeAtHead :one
 SELECT
 	code, revision
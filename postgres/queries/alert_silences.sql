@@ -0,0 +1,88 @@
+-- name: CreateAlertSilence :one
+INSERT INTO alert_silences(comment, resource, environment, event, service, tags,
+	starts, ends, weekdays, window_start, window_end, timezone, created_by)
+VALUES (
+	sqlc.arg(comment),
+	sqlc.arg(resource),
+	sqlc.arg(environment),
+	sqlc.arg(event),
+	sqlc.arg(service),
+	sqlc.arg(tags),
+	sqlc.arg(starts),
+	sqlc.arg(ends),
+	sqlc.arg(weekdays),
+	sqlc.arg(window_start),
+	sqlc.arg(window_end),
+	sqlc.arg(timezone),
+	sqlc.arg(created_by)
+)
+RETURNING *;
+
+-- name: FindAlertSilences :many
+SELECT *
+FROM alert_silences
+WHERE (sqlc.arg(include_expired)::BOOLEAN OR ends > NOW())
+ORDER BY starts DESC, uuid
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
+
+-- name: FindAlertSilenceByUUID :one
+SELECT *
+FROM alert_silences
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: FindCurrentAlertSilences :many
+SELECT *
+FROM alert_silences
+WHERE starts <= NOW()
+AND ends > NOW()
+ORDER BY starts, uuid;
+
+-- name: UpdateAlertSilence :execrows
+UPDATE alert_silences
+SET comment = sqlc.arg(comment),
+	resource = sqlc.arg(resource),
+	environment = sqlc.arg(environment),
+	event = sqlc.arg(event),
+	service = sqlc.arg(service),
+	tags = sqlc.arg(tags),
+	starts = sqlc.arg(starts),
+	ends = sqlc.arg(ends),
+	weekdays = sqlc.arg(weekdays),
+	window_start = sqlc.arg(window_start),
+	window_end = sqlc.arg(window_end),
+	timezone = sqlc.arg(timezone)
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: DeleteAlertSilence :execrows
+DELETE FROM alert_silences
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: SilenceAlert :execrows
+UPDATE alerts
+SET status = 'silence'::alert_status, silence_uuid = sqlc.arg(silence_uuid)
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: ReleaseSilencedAlerts :many
+WITH released AS (
+	SELECT uuid
+	FROM alerts
+	WHERE status = 'silence'::alert_status
+	AND (
+		silence_uuid IS NULL
+		OR
+		NOT (silence_uuid = ANY(sqlc.arg(active)::UUID[]))
+	)
+	LIMIT sqlc.arg(arg_limit)::BIGINT
+	FOR UPDATE SKIP LOCKED
+), updated AS (
+	UPDATE alerts
+	SET status = 'open'::alert_status, silence_uuid = NULL
+	FROM released
+	WHERE alerts.uuid = released.uuid
+	RETURNING alerts.uuid
+)
+INSERT INTO alert_history (alert_uuid, change, from_value, to_value, note)
+SELECT updated.uuid, 'status', 'silence', 'open', 'Silence ended'
+FROM updated
+RETURNING alert_uuid;
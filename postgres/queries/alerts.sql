@@ -16,7 +16,8 @@ SELECT  alert_merge(
         sqlc.arg(description)::text,
 	sqlc.arg(tags)::text[],
 	sqlc.arg(timeout)::integer,
-	sqlc.arg(rawdata)::bytea
+	sqlc.arg(rawdata)::bytea,
+	NULLIF(sqlc.arg(created_by)::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
 )::UUID AS uuid LIMIT 1;
 
 -- name: FindAlertByUUID :one
@@ -88,6 +89,11 @@ UPDATE alerts
 SET rawdata = sqlc.arg(rawdata)
 WHERE uuid = sqlc.arg(uuid);
 
+-- name: UpdateAlertSetShelvedUntil :execrows
+UPDATE alerts
+SET shelved_until = sqlc.narg(shelved_until)
+WHERE uuid = sqlc.arg(uuid);
+
 -- name: UpdateAlertIncDuplicate :execrows
 UPDATE alerts
 SET duplicate = duplicate + 1
@@ -130,7 +136,9 @@ SELECT
 	v_alerts.tags,
 	v_alerts.timeout,
 	v_alerts.uuid,
-	v_alerts.value
+	v_alerts.value,
+	v_alerts.shelved_until,
+	v_alerts.silence_uuid
 FROM v_alerts, severity_levels
 WHERE v_alerts.severity = severity_levels.name
 AND (
@@ -195,6 +203,11 @@ AND (
 	OR
 	sqlc.arg(tags)::TEXT[] && v_alerts.tags
 )
+AND (
+	sqlc.narg(silenced)::BOOLEAN IS NULL
+	OR
+	(v_alerts.status = 'silence'::alert_status) = sqlc.narg(silenced)::BOOLEAN
+)
 ORDER BY resource, environment, event
 LIMIT sqlc.arg(arg_limit)::BIGINT
 OFFSET sqlc.arg(arg_offset)::BIGINT
@@ -203,3 +216,73 @@ OFFSET sqlc.arg(arg_offset)::BIGINT
 -- name: DeleteAlert :execrows
 DELETE FROM alerts
 WHERE alerts.uuid = sqlc.arg(uuid);
+
+-- name: ExpireAlerts :many
+WITH expired AS (
+	SELECT uuid, status
+	FROM alerts
+	WHERE status IN ('open'::alert_status, 'acknowledge'::alert_status)
+	AND COALESCE(last_receive_time, created) + make_interval(secs => timeout) < NOW()
+	ORDER BY COALESCE(last_receive_time, created) + make_interval(secs => timeout)
+	LIMIT sqlc.arg(arg_limit)::BIGINT
+	FOR UPDATE SKIP LOCKED
+), updated AS (
+	UPDATE alerts
+	SET status = 'expire'::alert_status
+	FROM expired
+	WHERE alerts.uuid = expired.uuid
+	RETURNING alerts.uuid, expired.status
+)
+INSERT INTO alert_history (alert_uuid, change, from_value, to_value, note)
+SELECT updated.uuid, 'status', updated.status::TEXT, 'expire', 'Expired by timeout'
+FROM updated
+RETURNING alert_uuid;
+
+-- name: UnshelveAlerts :many
+WITH unshelved AS (
+	SELECT uuid
+	FROM alerts
+	WHERE status = 'shelve'::alert_status
+	AND shelved_until < NOW()
+	ORDER BY shelved_until
+	LIMIT sqlc.arg(arg_limit)::BIGINT
+	FOR UPDATE SKIP LOCKED
+), updated AS (
+	UPDATE alerts
+	SET status = 'open'::alert_status, shelved_until = NULL
+	FROM unshelved
+	WHERE alerts.uuid = unshelved.uuid
+	RETURNING alerts.uuid
+)
+INSERT INTO alert_history (alert_uuid, change, from_value, to_value, note)
+SELECT updated.uuid, 'status', 'shelve', 'open', 'Shelve expired'
+FROM updated
+RETURNING alert_uuid;
+
+-- name: CreateAlertHistory :exec
+INSERT INTO alert_history (alert_uuid, change, from_value, to_value, note, changed_by)
+VALUES (
+	sqlc.arg(alert_uuid),
+	sqlc.arg(change),
+	sqlc.arg(from_value),
+	sqlc.arg(to_value),
+	sqlc.arg(note),
+	NULLIF(sqlc.arg(changed_by)::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
+);
+
+-- name: FindAlertHistory :many
+SELECT *
+FROM alert_history
+WHERE alert_history.alert_uuid = sqlc.arg(alert_uuid)
+ORDER BY changed DESC, id DESC
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
+
+-- name: FindCurrentAlertStatusChanges :many
+-- The status changes made by the current transaction
+SELECT *
+FROM alert_history
+WHERE alert_uuid = sqlc.arg(alert_uuid)
+AND change = 'status'
+AND changed = NOW()
+ORDER BY id;
@@ -0,0 +1,34 @@
+-- name: LockDatasetBlob :exec
+-- Locks the content of the storage backend by its key, until the end of the
+-- transaction. Content is stored, and removed, while holding the lock, so that
+-- content about to be referenced is never removed.
+SELECT pg_advisory_xact_lock(hashtext(sqlc.arg(storage)::TEXT || '/' || sqlc.arg(key)::TEXT));
+
+-- name: ClaimDatasetBlobDeletion :one
+SELECT storage, checksum, compression, created
+FROM dataset_blob_deletions
+WHERE storage = sqlc.arg(storage)
+ORDER BY created
+LIMIT 1
+FOR UPDATE SKIP LOCKED;
+
+-- name: CountDatasetBlobReferences :one
+SELECT (
+	SELECT COUNT(*)
+	FROM datasets
+	WHERE datasets.storage = sqlc.arg(storage)
+	AND datasets.checksum = decode(sqlc.arg(checksum)::TEXT, 'hex')
+	AND datasets.compression = sqlc.arg(compression)
+) + (
+	SELECT COUNT(*)
+	FROM dataset_revisions
+	WHERE dataset_revisions.storage = sqlc.arg(storage)
+	AND dataset_revisions.checksum = decode(sqlc.arg(checksum)::TEXT, 'hex')
+	AND dataset_revisions.compression = sqlc.arg(compression)
+) AS count;
+
+-- name: DeleteDatasetBlobDeletion :execrows
+DELETE FROM dataset_blob_deletions
+WHERE storage = sqlc.arg(storage)
+AND checksum = sqlc.arg(checksum)
+AND compression = sqlc.arg(compression);
@@ -5,17 +5,21 @@ WHERE datasets.uuid = sqlc.arg(uuid);
 
 -- name: CreateDataset :one
 WITH ds AS (
-	INSERT INTO datasets (name, format, content, checksum, size, belongs_to, created_by, updated_by, tags)
+	INSERT INTO datasets (name, format, content, checksum, size, storage, compression, stored_size, belongs_to, created_by, updated_by, tags, max_revisions)
 	VALUES(
 		sqlc.arg(name)::text,
 		sqlc.arg(format)::text,
 		sqlc.arg(content)::bytea,
-		sha256(sqlc.arg(content)::bytea),
-		length(sqlc.arg(content))::integer,
+		decode(sqlc.arg(checksum)::text, 'hex'),
+		sqlc.arg(size)::bigint,
+		sqlc.arg(storage)::text,
+		sqlc.arg(compression)::text,
+		sqlc.arg(stored_size)::bigint,
 		NULLIF(sqlc.arg(belongs_to)::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
 		sqlc.arg(created_by)::uuid,
 		sqlc.arg(created_by)::uuid,
-		sqlc.arg(tags)
+		sqlc.arg(tags),
+		NULLIF(sqlc.arg(max_revisions)::integer, 0)
 	)
 	RETURNING
 		uuid,
@@ -28,7 +32,10 @@ WITH ds AS (
 		updated,
 		created_by,
 		updated_by,
-		tags
+		tags,
+		max_revisions,
+		stored_size,
+		compression
 ), grp AS (
 	SELECT groups.uuid
 	FROM groups, user_groups
@@ -80,7 +87,10 @@ SELECT
 	updated,
 	created_by,
 	updated_by,
-	tags
+	tags,
+	max_revisions,
+	stored_size,
+	compression
 FROM datasets
 WHERE 'datasets/'||datasets.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'allow')
@@ -97,7 +107,10 @@ SELECT
 	updated,
 	created_by,
 	updated_by,
-	tags
+	tags,
+	max_revisions,
+	stored_size,
+	compression
 FROM datasets
 WHERE 'datasets/'||datasets.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'deny')
@@ -132,7 +145,10 @@ SELECT
 	updated,
 	created_by,
 	updated_by,
-	tags
+	tags,
+	max_revisions,
+	stored_size,
+	compression
 FROM datasets
 WHERE 'datasets/'||datasets.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'allow')
@@ -150,7 +166,10 @@ SELECT
 	updated,
 	created_by,
 	updated_by,
-	tags
+	tags,
+	max_revisions,
+	stored_size,
+	compression
 FROM datasets
 WHERE 'datasets/'||datasets.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'deny')
@@ -173,7 +192,10 @@ SELECT
 	updated,
 	created_by,
 	updated_by,
-	tags
+	tags,
+	max_revisions,
+	stored_size,
+	compression
 FROM datasets
 WHERE datasets.uuid = sqlc.arg(uuid)
 LIMIT 1;
@@ -190,18 +212,66 @@ SELECT
 	updated,
 	created_by,
 	updated_by,
-	tags
+	tags,
+	max_revisions,
+	stored_size,
+	compression
 FROM datasets
 WHERE datasets.belongs_to = sqlc.arg(thing_uuid)
 ORDER BY name
 ;
 
+-- name: FindDatasetsByThings :many
+-- The datasets of the things, which the user of the token may list as those
+-- of the thing and read.
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256(sqlc.arg(token))
+	LIMIT 1
+)
+SELECT
+	uuid,
+	name,
+	format,
+	encode(checksum, 'hex') AS checksum,
+	size,
+	belongs_to,
+	created,
+	updated,
+	created_by,
+	updated_by,
+	tags,
+	max_revisions,
+	stored_size,
+	compression
+FROM datasets
+WHERE datasets.belongs_to = ANY(sqlc.arg(thing_uuids)::UUID[])
+AND user_has_access((SELECT uuid FROM usr), 'read', 'things/'||datasets.belongs_to||'/datasets')
+AND user_has_access((SELECT uuid FROM usr), 'read', 'datasets/'||datasets.uuid)
+ORDER BY name
+;
+
 -- name: GetDatasetContentByUUID :one
-SELECT format, content, encode(checksum, 'hex') AS checksum
+SELECT format, content, encode(checksum, 'hex') AS checksum, storage, compression
 FROM datasets
 WHERE datasets.uuid = sqlc.arg(uuid)
 LIMIT 1;
 
+-- name: GetDatasetContentInfoByUUID :one
+SELECT format, encode(checksum, 'hex') AS checksum, size, storage, compression, stored_size, updated
+FROM datasets
+WHERE datasets.uuid = sqlc.arg(uuid)
+LIMIT 1;
+
+-- name: GetDatasetContentPart :one
+SELECT substring(content FROM sqlc.arg(arg_offset)::integer + 1 FOR sqlc.arg(arg_length)::integer)::bytea AS content
+FROM datasets
+WHERE datasets.uuid = sqlc.arg(uuid)
+AND datasets.checksum = decode(sqlc.arg(checksum)::text, 'hex')
+LIMIT 1;
+
 -- name: SetDatasetNameByUUID :execrows
 UPDATE datasets
 SET name = sqlc.arg(name)
@@ -215,9 +285,53 @@ WHERE datasets.uuid = sqlc.arg(uuid);
 -- name: SetDatasetContentByUUID :execrows
 UPDATE datasets
 SET content = sqlc.arg(content)::bytea,
-    checksum = sha256(sqlc.arg(content)::bytea)
+    checksum = decode(sqlc.arg(checksum)::text, 'hex'),
+    size = sqlc.arg(size)::bigint,
+    storage = sqlc.arg(storage)::text,
+    compression = sqlc.arg(compression)::text,
+    stored_size = sqlc.arg(stored_size)::bigint,
+    updated = NOW(),
+    updated_by = sqlc.arg(updated_by)
 WHERE datasets.uuid = sqlc.arg(uuid);
 
+-- name: SetDatasetMaxRevisionsByUUID :execrows
+UPDATE datasets
+SET max_revisions = NULLIF(sqlc.arg(max_revisions)::integer, 0)
+WHERE datasets.uuid = sqlc.arg(uuid);
+
+-- name: RollbackDatasetToRevision :execrows
+UPDATE datasets
+SET content = dataset_revisions.content,
+    checksum = dataset_revisions.checksum,
+    size = dataset_revisions.size,
+    storage = dataset_revisions.storage,
+    compression = dataset_revisions.compression,
+    stored_size = dataset_revisions.stored_size,
+    updated = NOW(),
+    updated_by = sqlc.arg(updated_by)
+FROM dataset_revisions
+WHERE datasets.uuid = sqlc.arg(uuid)
+AND dataset_revisions.dataset_uuid = datasets.uuid
+AND dataset_revisions.revision = sqlc.arg(revision);
+
+-- name: GetDatasetSchemaByUUID :one
+SELECT schema
+FROM datasets
+WHERE datasets.uuid = sqlc.arg(uuid)
+AND datasets.schema IS NOT NULL
+LIMIT 1;
+
+-- name: SetDatasetSchemaByUUID :execrows
+UPDATE datasets
+SET schema = sqlc.arg(schema)::jsonb
+WHERE datasets.uuid = sqlc.arg(uuid);
+
+-- name: DeleteDatasetSchemaByUUID :execrows
+UPDATE datasets
+SET schema = NULL
+WHERE datasets.uuid = sqlc.arg(uuid)
+AND datasets.schema IS NOT NULL;
+
 -- name: SetDatasetThingByUUID :execrows
 UPDATE datasets
 SET belongs_to = sqlc.arg(thing_uuid)
@@ -228,6 +342,58 @@ UPDATE datasets
 SET tags = sqlc.arg(tags)
 WHERE datasets.uuid = sqlc.arg(uuid);
 
+-- name: FindDatasetRevisions :many
+SELECT
+	revision, created, created_by, encode(checksum, 'hex') AS checksum, size
+FROM
+	dataset_revisions
+WHERE
+	dataset_uuid = sqlc.arg(dataset_uuid)
+ORDER BY revision ASC;
+
+-- name: GetDatasetContentAtRevision :one
+SELECT datasets.format, dataset_revisions.content, encode(dataset_revisions.checksum, 'hex') AS checksum, dataset_revisions.storage, dataset_revisions.compression
+FROM dataset_revisions, datasets
+WHERE dataset_revisions.dataset_uuid = datasets.uuid
+AND dataset_revisions.dataset_uuid = sqlc.arg(dataset_uuid)
+AND dataset_revisions.revision = sqlc.arg(revision)
+LIMIT 1;
+
+-- name: GetDatasetContentAtHead :one
+SELECT datasets.format, dataset_revisions.content, encode(dataset_revisions.checksum, 'hex') AS checksum, dataset_revisions.storage, dataset_revisions.compression, dataset_revisions.revision
+FROM dataset_revisions, datasets
+WHERE dataset_revisions.dataset_uuid = datasets.uuid
+AND dataset_revisions.dataset_uuid = sqlc.arg(dataset_uuid)
+ORDER BY dataset_revisions.revision DESC
+LIMIT 1;
+
+-- name: FindDatasetsByStorage :many
+SELECT uuid, encode(checksum, 'hex') AS checksum
+FROM datasets
+WHERE datasets.storage = sqlc.arg(storage)
+ORDER BY uuid;
+
+-- name: FindDatasetRevisionsByStorage :many
+SELECT dataset_uuid, revision, encode(checksum, 'hex') AS checksum
+FROM dataset_revisions
+WHERE dataset_revisions.storage = sqlc.arg(storage)
+ORDER BY dataset_uuid, revision;
+
+-- name: SetDatasetStorageByUUID :execrows
+UPDATE datasets
+SET content = sqlc.arg(content)::bytea,
+    storage = sqlc.arg(storage)::text
+WHERE datasets.uuid = sqlc.arg(uuid)
+AND datasets.checksum = decode(sqlc.arg(checksum)::text, 'hex');
+
+-- name: SetDatasetRevisionStorage :execrows
+UPDATE dataset_revisions
+SET content = sqlc.arg(content)::bytea,
+    storage = sqlc.arg(storage)::text
+WHERE dataset_revisions.dataset_uuid = sqlc.arg(dataset_uuid)
+AND dataset_revisions.revision = sqlc.arg(revision)
+AND dataset_revisions.checksum = decode(sqlc.arg(checksum)::text, 'hex');
+
 -- name: DeleteDataset :execrows
 DELETE FROM datasets
 WHERE datasets.uuid = sqlc.arg(uuid);
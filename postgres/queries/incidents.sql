@@ -0,0 +1,172 @@
+-- name: CreateAlertGroup :one
+INSERT INTO alert_groups(name, keys, tag_prefix, enabled, created_by)
+VALUES (
+	sqlc.arg(name),
+	sqlc.arg(keys),
+	sqlc.arg(tag_prefix),
+	sqlc.arg(enabled),
+	sqlc.arg(created_by)
+)
+RETURNING *;
+
+-- name: FindAlertGroups :many
+SELECT *
+FROM alert_groups
+ORDER BY name, uuid
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
+
+-- name: FindEnabledAlertGroups :many
+SELECT *
+FROM alert_groups
+WHERE enabled = TRUE
+ORDER BY name, uuid;
+
+-- name: FindAlertGroupByUUID :one
+SELECT *
+FROM alert_groups
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: UpdateAlertGroup :execrows
+UPDATE alert_groups
+SET name = sqlc.arg(name),
+	keys = sqlc.arg(keys),
+	tag_prefix = sqlc.arg(tag_prefix),
+	enabled = sqlc.arg(enabled)
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: DeleteAlertGroup :execrows
+DELETE FROM alert_groups
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: CreateIncident :one
+-- Returns no rows when the group already has an incident for the key which is
+-- not closed.
+INSERT INTO incidents(group_uuid, key, title, severity)
+VALUES (
+	sqlc.arg(group_uuid),
+	sqlc.arg(key),
+	sqlc.arg(title),
+	sqlc.arg(severity)
+)
+ON CONFLICT (group_uuid, key) WHERE status <> 'close' DO NOTHING
+RETURNING *;
+
+-- name: FindOpenIncidentByKey :one
+SELECT *
+FROM incidents
+WHERE group_uuid = sqlc.arg(group_uuid)
+AND key = sqlc.arg(key)
+AND status <> 'close'
+FOR UPDATE;
+
+-- name: FindLatestIncidentByAlert :one
+SELECT incidents.*
+FROM incidents, incident_alerts
+WHERE incident_alerts.incident_uuid = incidents.uuid
+AND incident_alerts.alert_uuid = sqlc.arg(alert_uuid)
+ORDER BY incidents.created DESC
+LIMIT 1
+FOR UPDATE OF incidents;
+
+-- name: FindIncidentByUUID :one
+SELECT *
+FROM incidents
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: FindIncidents :many
+SELECT *
+FROM incidents
+WHERE (
+	NULLIF(sqlc.arg(status)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(status)::TEXT = incidents.status
+)
+AND (
+	NULLIF(sqlc.arg(group_uuid)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(group_uuid)::TEXT = incidents.group_uuid::TEXT
+)
+ORDER BY incidents.created DESC, incidents.uuid
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
+
+-- name: CountIncidentAlerts :many
+SELECT incident_uuid, COUNT(*)::BIGINT AS alerts
+FROM incident_alerts
+WHERE incident_uuid = ANY(sqlc.arg(incident_uuids)::uuid[])
+GROUP BY incident_uuid;
+
+-- name: AddIncidentAlert :execrows
+INSERT INTO incident_alerts(incident_uuid, alert_uuid)
+VALUES (
+	sqlc.arg(incident_uuid),
+	sqlc.arg(alert_uuid)
+)
+ON CONFLICT DO NOTHING;
+
+-- name: GetIncidentActiveAlerts :one
+-- The alerts of an incident which have not cleared, and the most severe of
+-- their severities.
+SELECT COUNT(*)::BIGINT AS active,
+	COALESCE(MIN(v_alerts.severity), 'indeterminate'::alert_severity)::alert_severity AS severity
+FROM incident_alerts, v_alerts
+WHERE v_alerts.uuid = incident_alerts.alert_uuid
+AND incident_alerts.incident_uuid = sqlc.arg(incident_uuid)
+AND v_alerts.status NOT IN ('close'::alert_status, 'expire'::alert_status, 'silence'::alert_status);
+
+-- name: FindIncidentAlerts :many
+SELECT v_alerts.*
+FROM v_alerts, incident_alerts
+WHERE incident_alerts.alert_uuid = v_alerts.uuid
+AND incident_alerts.incident_uuid = sqlc.arg(incident_uuid)
+ORDER BY incident_alerts.added, v_alerts.uuid
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
+
+-- name: CloseIncidentAlerts :many
+-- Closes the alerts of an incident, returning their status before.
+WITH closing AS (
+	SELECT alerts.uuid, alerts.status
+	FROM alerts, incident_alerts
+	WHERE incident_alerts.alert_uuid = alerts.uuid
+	AND incident_alerts.incident_uuid = sqlc.arg(incident_uuid)
+	AND alerts.status <> 'close'::alert_status
+	FOR UPDATE OF alerts
+)
+UPDATE alerts
+SET status = 'close'::alert_status
+FROM closing
+WHERE alerts.uuid = closing.uuid
+RETURNING alerts.uuid, closing.status;
+
+-- name: UpdateIncidentState :execrows
+UPDATE incidents
+SET status = sqlc.arg(status),
+	severity = sqlc.arg(severity),
+	updated = NOW(),
+	closed = (CASE
+		WHEN sqlc.arg(status)::TEXT = 'close' THEN COALESCE(closed, NOW())
+		ELSE NULL
+	END)
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: CreateIncidentHistory :exec
+INSERT INTO incident_history (incident_uuid, change, from_value, to_value, note, alert_uuid, changed_by)
+VALUES (
+	sqlc.arg(incident_uuid),
+	sqlc.arg(change),
+	sqlc.arg(from_value),
+	sqlc.arg(to_value),
+	sqlc.arg(note),
+	NULLIF(sqlc.arg(alert_uuid)::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
+	NULLIF(sqlc.arg(changed_by)::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
+);
+
+-- name: FindIncidentHistory :many
+SELECT *
+FROM incident_history
+WHERE incident_history.incident_uuid = sqlc.arg(incident_uuid)
+ORDER BY changed DESC, id DESC
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
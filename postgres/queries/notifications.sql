@@ -0,0 +1,171 @@
+-- name: CreateNotificationChannel :one
+INSERT INTO notification_channels(name, kind, config, enabled, created_by)
+VALUES (
+	sqlc.arg(name),
+	sqlc.arg(kind),
+	sqlc.arg(config),
+	sqlc.arg(enabled),
+	sqlc.arg(created_by)
+)
+RETURNING *;
+
+-- name: FindNotificationChannels :many
+SELECT *
+FROM notification_channels
+ORDER BY name
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
+
+-- name: FindNotificationChannelByUUID :one
+SELECT *
+FROM notification_channels
+WHERE notification_channels.uuid = sqlc.arg(uuid)
+LIMIT 1;
+
+-- name: UpdateNotificationChannel :execrows
+UPDATE notification_channels
+SET name = sqlc.arg(name),
+	kind = sqlc.arg(kind),
+	config = sqlc.arg(config),
+	enabled = sqlc.arg(enabled)
+WHERE notification_channels.uuid = sqlc.arg(uuid);
+
+-- name: DeleteNotificationChannel :execrows
+DELETE FROM notification_channels
+WHERE notification_channels.uuid = sqlc.arg(uuid);
+
+-- name: CreateNotificationRule :one
+INSERT INTO notification_rules(name, channel_uuid, triggers, environment, severity, service, tags, resource, enabled, created_by)
+VALUES (
+	sqlc.arg(name),
+	sqlc.arg(channel_uuid),
+	sqlc.arg(triggers),
+	sqlc.arg(environment),
+	sqlc.arg(severity),
+	sqlc.arg(service),
+	sqlc.arg(tags),
+	sqlc.arg(resource),
+	sqlc.arg(enabled),
+	sqlc.arg(created_by)
+)
+RETURNING *;
+
+-- name: FindNotificationRules :many
+SELECT *
+FROM notification_rules
+ORDER BY name
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
+
+-- name: FindNotificationRuleByUUID :one
+SELECT *
+FROM notification_rules
+WHERE notification_rules.uuid = sqlc.arg(uuid)
+LIMIT 1;
+
+-- name: FindActiveNotificationRules :many
+SELECT notification_rules.*
+FROM notification_rules
+INNER JOIN notification_channels ON notification_channels.uuid = notification_rules.channel_uuid
+WHERE notification_rules.enabled
+AND notification_channels.enabled
+ORDER BY notification_rules.name;
+
+-- name: UpdateNotificationRule :execrows
+UPDATE notification_rules
+SET name = sqlc.arg(name),
+	channel_uuid = sqlc.arg(channel_uuid),
+	triggers = sqlc.arg(triggers),
+	environment = sqlc.arg(environment),
+	severity = sqlc.arg(severity),
+	service = sqlc.arg(service),
+	tags = sqlc.arg(tags),
+	resource = sqlc.arg(resource),
+	enabled = sqlc.arg(enabled)
+WHERE notification_rules.uuid = sqlc.arg(uuid);
+
+-- name: DeleteNotificationRule :execrows
+DELETE FROM notification_rules
+WHERE notification_rules.uuid = sqlc.arg(uuid);
+
+-- name: CreateNotificationDelivery :exec
+INSERT INTO notification_deliveries(channel_uuid, rule_uuid, alert_uuid, incident_uuid, trigger, payload)
+VALUES (
+	sqlc.arg(channel_uuid),
+	sqlc.arg(rule_uuid),
+	NULLIF(sqlc.arg(alert_uuid)::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
+	NULLIF(sqlc.arg(incident_uuid)::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
+	sqlc.arg(trigger),
+	sqlc.arg(payload)
+);
+
+-- name: ClaimNotificationDeliveries :many
+UPDATE notification_deliveries
+SET attempts = notification_deliveries.attempts + 1,
+	next_attempt = NOW() + make_interval(secs => sqlc.arg(lease)::INTEGER)
+FROM notification_channels
+WHERE notification_channels.uuid = notification_deliveries.channel_uuid
+AND notification_deliveries.uuid IN (
+	SELECT pending.uuid
+	FROM notification_deliveries AS pending
+	WHERE pending.status = 'pending'
+	AND pending.next_attempt <= NOW()
+	ORDER BY pending.next_attempt
+	LIMIT sqlc.arg(arg_limit)::BIGINT
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING
+	notification_deliveries.uuid,
+	notification_deliveries.trigger,
+	notification_deliveries.payload,
+	notification_deliveries.incident_uuid,
+	notification_deliveries.attempts,
+	notification_channels.kind,
+	notification_channels.config,
+	notification_channels.enabled;
+
+-- name: SetNotificationDeliverySent :execrows
+UPDATE notification_deliveries
+SET status = 'sent',
+	last_error = '',
+	delivered = NOW()
+WHERE notification_deliveries.uuid = sqlc.arg(uuid);
+
+-- name: SetNotificationDeliveryRetry :execrows
+UPDATE notification_deliveries
+SET last_error = sqlc.arg(last_error),
+	next_attempt = NOW() + make_interval(secs => sqlc.arg(retry_in)::INTEGER)
+WHERE notification_deliveries.uuid = sqlc.arg(uuid);
+
+-- name: SetNotificationDeliveryFailed :execrows
+UPDATE notification_deliveries
+SET status = 'failed',
+	last_error = sqlc.arg(last_error)
+WHERE notification_deliveries.uuid = sqlc.arg(uuid);
+
+-- name: FindNotificationDeliveries :many
+SELECT *
+FROM notification_deliveries
+WHERE (
+	NULLIF(sqlc.arg(channel_uuid)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(channel_uuid)::TEXT = notification_deliveries.channel_uuid::TEXT
+)
+AND (
+	NULLIF(sqlc.arg(alert_uuid)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(alert_uuid)::TEXT = notification_deliveries.alert_uuid::TEXT
+)
+AND (
+	NULLIF(sqlc.arg(status)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(status)::TEXT = notification_deliveries.status
+)
+AND (
+	NULLIF(sqlc.arg(incident_uuid)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(incident_uuid)::TEXT = notification_deliveries.incident_uuid::TEXT
+)
+ORDER BY notification_deliveries.created DESC
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
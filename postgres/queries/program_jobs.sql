@@ -0,0 +1,118 @@
+-- name: CreateProgramJob :one
+-- Queues a job. A job scheduled for a time which is already queued is left
+-- out, and no row is returned.
+INSERT INTO program_jobs(program_uuid, revision, trigger, max_attempts, scheduled)
+VALUES (
+	sqlc.arg(program_uuid),
+	sqlc.arg(revision),
+	sqlc.arg(trigger),
+	sqlc.arg(max_attempts),
+	sqlc.narg(scheduled)
+)
+ON CONFLICT (program_uuid, scheduled) WHERE scheduled IS NOT NULL DO NOTHING
+RETURNING id;
+
+-- name: ClaimProgramJobs :many
+-- Claims the queued jobs which are due, and the running jobs whose lease has
+-- expired, for the lease (in seconds). Jobs claimed by others are skipped.
+WITH due AS (
+	SELECT program_jobs.id
+	FROM program_jobs
+	WHERE (program_jobs.status = 'queued' AND program_jobs.run_after <= NOW())
+	OR (program_jobs.status = 'running' AND program_jobs.lease_until < NOW())
+	ORDER BY program_jobs.run_after, program_jobs.id
+	LIMIT sqlc.arg(arg_limit)::BIGINT
+	FOR UPDATE SKIP LOCKED
+)
+UPDATE program_jobs
+SET status = 'running',
+	attempts = program_jobs.attempts + 1,
+	lease_until = NOW() + sqlc.arg(lease)::INTEGER * INTERVAL '1 second',
+	worker = '',
+	updated = NOW()
+FROM due
+WHERE program_jobs.id = due.id
+RETURNING program_jobs.*;
+
+-- name: HeartbeatProgramJob :execrows
+-- Extends the lease (in seconds) of a running job, for the worker running it.
+-- The attempts of the claim tell it apart from a later claim of the job, once
+-- its lease expired; the same goes for completing, retrying and killing it.
+UPDATE program_jobs
+SET lease_until = NOW() + sqlc.arg(lease)::INTEGER * INTERVAL '1 second',
+	worker = sqlc.arg(worker),
+	updated = NOW()
+WHERE id = sqlc.arg(id)
+AND status = 'running'
+AND attempts = sqlc.arg(attempts);
+
+-- name: CompleteProgramJob :execrows
+UPDATE program_jobs
+SET status = 'done',
+	lease_until = NULL,
+	last_error = '',
+	updated = NOW()
+WHERE id = sqlc.arg(id)
+AND status = 'running'
+AND attempts = sqlc.arg(attempts);
+
+-- name: RetryProgramJob :execrows
+-- Queues a running job again after the delay (in seconds).
+UPDATE program_jobs
+SET status = 'queued',
+	run_after = NOW() + sqlc.arg(retry_in)::INTEGER * INTERVAL '1 second',
+	lease_until = NULL,
+	last_error = sqlc.arg(last_error),
+	updated = NOW()
+WHERE id = sqlc.arg(id)
+AND status = 'running'
+AND attempts = sqlc.arg(attempts);
+
+-- name: KillProgramJob :execrows
+UPDATE program_jobs
+SET status = 'dead',
+	lease_until = NULL,
+	last_error = sqlc.arg(last_error),
+	updated = NOW()
+WHERE id = sqlc.arg(id)
+AND status = 'running'
+AND attempts = sqlc.arg(attempts);
+
+-- name: RequeueProgramJob :execrows
+-- Queues a dead job again, with all of its attempts.
+UPDATE program_jobs
+SET status = 'queued',
+	attempts = 0,
+	run_after = NOW(),
+	updated = NOW()
+WHERE id = sqlc.arg(id)
+AND status = 'dead';
+
+-- name: FindProgramJobs :many
+SELECT *
+FROM program_jobs
+WHERE (
+	NULLIF(sqlc.arg(status)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(status)::TEXT = program_jobs.status
+)
+AND (
+	NULLIF(sqlc.arg(program_uuid)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(program_uuid)::TEXT = program_jobs.program_uuid::TEXT
+)
+ORDER BY program_jobs.created DESC, program_jobs.id DESC
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
+
+-- name: FindProgramJobByID :one
+SELECT *
+FROM program_jobs
+WHERE id = sqlc.arg(id);
+
+-- name: DeleteProgramJobsBefore :execrows
+-- Removes the jobs which are done or dead, and were last updated before the
+-- time.
+DELETE FROM program_jobs
+WHERE status IN ('done', 'dead')
+AND updated < sqlc.arg(before);
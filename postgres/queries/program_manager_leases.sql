@@ -0,0 +1,25 @@
+-- name: AcquireProgramManagerLease :execrows
+-- Acquires the lease for the holder, or extends it when already held by the
+-- holder, for the time to live (in seconds). A lease held by another is only
+-- taken over once it has expired.
+INSERT INTO program_manager_leases(name, holder, acquired, expires)
+VALUES (
+	sqlc.arg(name),
+	sqlc.arg(holder),
+	NOW(),
+	NOW() + sqlc.arg(ttl)::INTEGER * INTERVAL '1 second'
+)
+ON CONFLICT (name) DO UPDATE
+SET holder = EXCLUDED.holder,
+	acquired = CASE
+		WHEN program_manager_leases.holder = EXCLUDED.holder THEN program_manager_leases.acquired
+		ELSE EXCLUDED.acquired
+	END,
+	expires = EXCLUDED.expires
+WHERE program_manager_leases.holder = EXCLUDED.holder
+OR program_manager_leases.expires < NOW();
+
+-- name: ReleaseProgramManagerLease :execrows
+DELETE FROM program_manager_leases
+WHERE name = sqlc.arg(name)
+AND holder = sqlc.arg(holder);
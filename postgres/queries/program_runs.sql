@@ -0,0 +1,107 @@
+-- name: CreateProgramRun :one
+INSERT INTO program_runs(program_uuid, revision, worker, trigger, job_id, attempt)
+VALUES (
+	sqlc.arg(program_uuid),
+	sqlc.arg(revision),
+	sqlc.arg(worker),
+	sqlc.arg(trigger),
+	NULLIF(sqlc.arg(job_id)::BIGINT, 0),
+	sqlc.arg(attempt)
+)
+RETURNING id;
+
+-- name: QueueProgramRun :one
+-- Queues the run of the first attempt of a job, which is started when a
+-- worker is handed the job.
+INSERT INTO program_runs(program_uuid, revision, trigger, job_id, attempt, outcome)
+VALUES (
+	sqlc.arg(program_uuid),
+	sqlc.arg(revision),
+	sqlc.arg(trigger),
+	sqlc.arg(job_id),
+	1,
+	'queued'
+)
+RETURNING id;
+
+-- name: StartProgramRun :one
+-- Starts the run queued for the attempt of a job, on the worker.
+UPDATE program_runs
+SET started = NOW(),
+	worker = sqlc.arg(worker),
+	outcome = 'running'
+WHERE job_id = sqlc.arg(job_id)
+AND attempt = sqlc.arg(attempt)
+AND outcome = 'queued'
+RETURNING id;
+
+-- name: CompleteProgramRun :execrows
+UPDATE program_runs
+SET ended = NOW(),
+	duration = sqlc.arg(duration),
+	outcome = sqlc.arg(outcome),
+	error = sqlc.arg(error),
+	output = sqlc.arg(output)
+WHERE id = sqlc.arg(id)
+AND outcome = 'running';
+
+-- name: FailStaleProgramRuns :execrows
+-- Fails the runs of the earlier attempts of a job still running, whose lease
+-- expired before the worker returned.
+UPDATE program_runs
+SET ended = NOW(),
+	outcome = 'failure',
+	error = sqlc.arg(error)
+WHERE job_id = sqlc.arg(job_id)
+AND attempt < sqlc.arg(attempt)
+AND outcome = 'running';
+
+-- name: FailQueuedProgramRuns :execrows
+-- Fails the runs of a job which was killed before they were started.
+UPDATE program_runs
+SET ended = NOW(),
+	outcome = 'failure',
+	error = sqlc.arg(error)
+WHERE job_id = sqlc.arg(job_id)
+AND outcome = 'queued';
+
+-- name: FindProgramRuns :many
+SELECT *
+FROM program_runs
+WHERE program_uuid = sqlc.arg(program_uuid)
+AND (
+	NULLIF(sqlc.arg(outcome)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(outcome)::TEXT = program_runs.outcome
+)
+AND (
+	sqlc.narg(start_time)::TIMESTAMPTZ IS NULL
+	OR
+	program_runs.started >= sqlc.narg(start_time)::TIMESTAMPTZ
+)
+AND (
+	sqlc.narg(end_time)::TIMESTAMPTZ IS NULL
+	OR
+	program_runs.started <= sqlc.narg(end_time)::TIMESTAMPTZ
+)
+ORDER BY program_runs.started DESC, program_runs.id DESC
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
+
+-- name: FindProgramRunByID :one
+SELECT *
+FROM program_runs
+WHERE program_uuid = sqlc.arg(program_uuid)
+AND id = sqlc.arg(id);
+
+-- name: FindProgramRunsByJob :many
+-- Returns the runs of a job, one for each attempt, the latest first.
+SELECT *
+FROM program_runs
+WHERE job_id = sqlc.arg(job_id)
+ORDER BY program_runs.started DESC, program_runs.id DESC;
+
+-- name: DeleteProgramRunsBefore :execrows
+-- Removes the runs started before the time, whether they completed or not.
+DELETE FROM program_runs
+WHERE started < sqlc.arg(before);
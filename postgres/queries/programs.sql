@@ -154,6 +154,37 @@ SELECT
 FROM p
 WHERE revision_rank = 1;
 
+-- name: FindRoutineRevisionsByProgram :many
+-- The latest signed revision of a program, like FindAllRoutineRevisions,
+-- for when a single program changed.
+WITH p AS (
+	SELECT
+		pcr.*,
+		programs.*,
+		RANK () OVER (
+			PARTITION BY pcr.program_uuid
+			ORDER BY revision DESC
+		) revision_rank
+	FROM program_code_revisions AS pcr
+	INNER JOIN programs ON programs.uuid = pcr.program_uuid
+	WHERE pcr.signed IS NOT NULL
+	AND programs.type IN ('routine', 'webhook')
+	AND programs.state = 'active'
+	AND programs.uuid = sqlc.arg(program_uuid)
+)
+SELECT
+	p.name,
+	p.program_uuid,
+	p.type,
+	p.schedule,
+	p.deadline,
+	p.language,
+	p.revision,
+	p.code,
+	encode(p.checksum, 'hex') AS checksum
+FROM p
+WHERE revision_rank = 1;
+
 -- name: FindAllModules :many
 SELECT
 	programs.name,
@@ -225,6 +256,15 @@ WHERE program_uuid = sqlc.arg(program_uuid)
 AND revision = sqlc.arg(revision)
 LIMIT 1;
 
+-- name: GetProgramCodeRevision :one
+SELECT
+	code,
+	signed IS NOT NULL AS signed
+FROM program_code_revisions
+WHERE program_uuid = sqlc.arg(program_uuid)
+AND revision = sqlc.arg(revision)
+LIMIT 1;
+
 -- name: GetProgramCodeAtHead :one
 SELECT
 	code, revision
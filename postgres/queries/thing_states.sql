@@ -0,0 +1,38 @@
+-- name: FindAllThingStateTransitions :many
+SELECT *
+FROM thing_state_transitions
+ORDER BY thing_type, from_state, to_state;
+
+-- name: FindThingStateTransitions :many
+SELECT *
+FROM thing_state_transitions
+WHERE thing_state_transitions.thing_type = sqlc.arg(thing_type)
+ORDER BY from_state, to_state;
+
+-- name: CreateThingStateTransition :exec
+INSERT INTO thing_state_transitions(thing_type, from_state, to_state)
+VALUES (sqlc.arg(thing_type), sqlc.arg(from_state), sqlc.arg(to_state));
+
+-- name: DeleteThingStateTransitions :execrows
+DELETE FROM thing_state_transitions
+WHERE thing_state_transitions.thing_type = sqlc.arg(thing_type);
+
+-- name: CreateThingStateChange :one
+INSERT INTO thing_state_history(thing_uuid, from_state, to_state, reason, changed_by)
+VALUES (sqlc.arg(thing_uuid), sqlc.arg(from_state), sqlc.arg(to_state), sqlc.arg(reason), sqlc.arg(changed_by))
+RETURNING *;
+
+-- name: FindThingStateHistory :many
+SELECT *
+FROM thing_state_history
+WHERE thing_state_history.thing_uuid = sqlc.arg(thing_uuid)
+ORDER BY changed DESC, id DESC
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
+
+-- name: GetThingStateByTimeseries :one
+SELECT things.state
+FROM things
+INNER JOIN timeseries ON timeseries.thing_uuid = things.uuid
+WHERE timeseries.uuid = sqlc.arg(ts_uuid)
+LIMIT 1;
@@ -0,0 +1,56 @@
+-- name: CreateThingTemplate :one
+WITH t AS (
+	INSERT INTO thing_templates(name, thing_type, timeseries, datasets, created_by)
+	VALUES (
+		sqlc.arg(name),
+		sqlc.arg(thing_type),
+		sqlc.arg(timeseries),
+		sqlc.arg(datasets),
+		sqlc.arg(created_by)
+	)
+	RETURNING *
+), grp AS (
+	SELECT groups.uuid
+	FROM groups, user_groups, users
+	WHERE user_groups.group_uuid = groups.uuid
+	AND user_groups.user_uuid = users.uuid
+	AND users.uuid = (SELECT created_by FROM t)
+	AND groups.name = users.name
+	LIMIT 1
+), grp_policies AS (
+	INSERT INTO group_policies(group_uuid, priority, effect, action, resource)
+	VALUES (
+		(SELECT uuid FROM grp), 0, 'allow', 'read','templates/'||(SELECT uuid FROM t)
+	), (
+		(SELECT uuid FROM grp), 0, 'allow', 'update','templates/'||(SELECT uuid FROM t)
+	), (
+		(SELECT uuid FROM grp), 0, 'allow', 'delete','templates/'||(SELECT uuid FROM t)
+	)
+)
+SELECT *
+FROM t LIMIT 1;
+
+-- name: FindThingTemplates :many
+SELECT *
+FROM thing_templates
+ORDER BY name
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
+
+-- name: FindThingTemplateByUUID :one
+SELECT *
+FROM thing_templates
+WHERE thing_templates.uuid = sqlc.arg(uuid)
+LIMIT 1;
+
+-- name: UpdateThingTemplate :execrows
+UPDATE thing_templates
+SET name = sqlc.arg(name),
+	thing_type = sqlc.arg(thing_type),
+	timeseries = sqlc.arg(timeseries),
+	datasets = sqlc.arg(datasets)
+WHERE thing_templates.uuid = sqlc.arg(uuid);
+
+-- name: DeleteThingTemplate :execrows
+DELETE FROM thing_templates
+WHERE thing_templates.uuid = sqlc.arg(uuid);
@@ -0,0 +1,21 @@
+-- name: FindThingTypeSchemas :many
+SELECT *
+FROM thing_type_schemas
+ORDER BY type;
+
+-- name: GetThingTypeSchema :one
+SELECT *
+FROM thing_type_schemas
+WHERE thing_type_schemas.type = sqlc.arg(type)
+LIMIT 1;
+
+-- name: SetThingTypeSchema :one
+INSERT INTO thing_type_schemas(type, schema)
+VALUES (sqlc.arg(type), sqlc.arg(schema))
+ON CONFLICT (type) DO UPDATE
+SET schema = EXCLUDED.schema
+RETURNING *;
+
+-- name: DeleteThingTypeSchema :execrows
+DELETE FROM thing_type_schemas
+WHERE thing_type_schemas.type = sqlc.arg(type);
@@ -6,12 +6,16 @@ WHERE things.uuid = sqlc.arg(uuid);
 -- name: CreateThing :one
 WITH t AS (
 	INSERT INTO things (
-		name, type, created_by, tags
+		name, type, created_by, tags, attributes, longitude, latitude, area
 	) VALUES (
 		sqlc.arg(name),
 		sqlc.arg(type),
 		sqlc.arg(created_by),
-		sqlc.arg(tags)
+		sqlc.arg(tags),
+		sqlc.arg(attributes),
+		sqlc.arg(longitude),
+		sqlc.arg(latitude),
+		sqlc.arg(area)
 	)
 	RETURNING *
 ), grp AS (
@@ -53,24 +57,11 @@ WITH usr AS (
 	WHERE user_tokens.user_uuid = users.uuid
 	AND user_tokens.token_hash = sha256(sqlc.arg(token))
 	LIMIT 1
-), policies AS (
-	SELECT group_policies.effect, group_policies.priority, group_policies.resource
-	FROM group_policies, user_groups
-	WHERE user_groups.group_uuid = group_policies.group_uuid
-	AND user_groups.user_uuid = (SELECT uuid FROM usr)
-	AND action = 'read'
 )
 SELECT *
 FROM things
-WHERE 'things/'||things.uuid LIKE ANY(
-	(SELECT resource FROM policies WHERE effect = 'allow')
-)
-EXCEPT
-SELECT *
-FROM things
-WHERE 'things/'||things.uuid LIKE ANY(
-	(SELECT resource FROM policies WHERE effect = 'deny')
-)
+WHERE user_has_access((SELECT uuid FROM usr), 'read', 'things/'||things.uuid)
+AND things.attributes @> sqlc.arg(attributes)::jsonb
 ORDER BY name
 LIMIT sqlc.arg(arg_limit)::BIGINT
 OFFSET sqlc.arg(arg_offset)::BIGINT
@@ -83,31 +74,47 @@ WITH usr AS (
 	WHERE user_tokens.user_uuid = users.uuid
 	AND user_tokens.token_hash = sha256(sqlc.arg(token))
 	LIMIT 1
-), policies AS (
-	SELECT group_policies.effect, group_policies.priority, group_policies.resource
-	FROM group_policies, user_groups
-	WHERE user_groups.group_uuid = group_policies.group_uuid
-	AND user_groups.user_uuid = (SELECT uuid FROM usr)
-	AND action = 'read'
 )
 SELECT *
 FROM things
-WHERE 'things/'||things.uuid LIKE ANY(
-	(SELECT resource FROM policies WHERE effect = 'allow')
-)
+WHERE user_has_access((SELECT uuid FROM usr), 'read', 'things/'||things.uuid)
+AND things.attributes @> sqlc.arg(attributes)::jsonb
 AND sqlc.arg(tags) && things.tags
-EXCEPT
+ORDER BY name
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT
+;
+
+-- name: FindThingsWithin :many
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256(sqlc.arg(token))
+	LIMIT 1
+)
 SELECT *
 FROM things
-WHERE 'things/'||things.uuid LIKE ANY(
-	(SELECT resource FROM policies WHERE effect = 'deny')
+WHERE user_has_access((SELECT uuid FROM usr), 'read', 'things/'||things.uuid)
+AND point(things.longitude, things.latitude) <@ box(
+	point(sqlc.arg(min_lon)::FLOAT8, sqlc.arg(min_lat)::FLOAT8),
+	point(sqlc.arg(max_lon)::FLOAT8, sqlc.arg(max_lat)::FLOAT8)
 )
-AND sqlc.arg(tags) && things.tags
+AND (sqlc.arg(radius)::FLOAT8 = 0
+	OR geo_distance(sqlc.arg(lon)::FLOAT8, sqlc.arg(lat)::FLOAT8, things.longitude, things.latitude) <= sqlc.arg(radius)::FLOAT8)
+AND (cardinality(sqlc.arg(tags)::TEXT[]) = 0 OR sqlc.arg(tags)::TEXT[] && things.tags)
+AND things.attributes @> sqlc.arg(attributes)::jsonb
 ORDER BY name
 LIMIT sqlc.arg(arg_limit)::BIGINT
 OFFSET sqlc.arg(arg_offset)::BIGINT
 ;
 
+-- name: FindThingsByType :many
+SELECT *
+FROM things
+WHERE things.type = sqlc.arg(type)
+ORDER BY name;
+
 -- name: SetThingNameByUUID :execrows
 UPDATE things
 SET name = sqlc.arg(name)
@@ -128,6 +135,104 @@ UPDATE things
 SET tags = sqlc.arg(tags)
 WHERE things.uuid = sqlc.arg(uuid);
 
+-- name: SetThingAttributesByUUID :execrows
+UPDATE things
+SET attributes = sqlc.arg(attributes)
+WHERE things.uuid = sqlc.arg(uuid);
+
+-- name: SetThingLocationByUUID :execrows
+UPDATE things
+SET longitude = sqlc.arg(longitude),
+	latitude = sqlc.arg(latitude)
+WHERE things.uuid = sqlc.arg(uuid);
+
+-- name: SetThingAreaByUUID :execrows
+UPDATE things
+SET area = sqlc.arg(area)
+WHERE things.uuid = sqlc.arg(uuid);
+
 -- name: DeleteThing :execrows
 DELETE FROM things
 WHERE things.uuid = sqlc.arg(uuid);
+
+-- name: AddThingDep :execrows
+INSERT INTO thing_deps(parent, child, inherit_policies)
+VALUES (
+	sqlc.arg(parent),
+	sqlc.arg(child),
+	sqlc.arg(inherit_policies)
+)
+ON CONFLICT (parent, child) DO UPDATE
+SET inherit_policies = EXCLUDED.inherit_policies;
+
+-- name: RemoveThingDep :execrows
+DELETE FROM thing_deps
+WHERE thing_deps.parent = sqlc.arg(parent)
+AND thing_deps.child = sqlc.arg(child);
+
+-- name: FindThingParent :one
+-- The first parent of a thing by name.
+SELECT things.uuid, things.name
+FROM thing_deps, things
+WHERE things.uuid = thing_deps.parent
+AND thing_deps.child = sqlc.arg(child)
+ORDER BY things.name, things.uuid
+LIMIT 1;
+
+-- name: FindThingAncestors :many
+WITH RECURSIVE usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256(sqlc.arg(token))
+	LIMIT 1
+), a(uuid, via, inherit_policies, depth) AS (
+	SELECT thing_deps.parent, thing_deps.child, thing_deps.inherit_policies, 1
+	FROM thing_deps
+	WHERE thing_deps.child = sqlc.arg(uuid)
+	UNION
+	SELECT thing_deps.parent, thing_deps.child, thing_deps.inherit_policies, a.depth + 1
+	FROM a, thing_deps
+	WHERE thing_deps.child = a.uuid
+	AND (sqlc.arg(max_depth)::INTEGER = 0 OR a.depth < sqlc.arg(max_depth)::INTEGER)
+), nearest AS (
+	SELECT DISTINCT ON (a.uuid) a.uuid, a.via, a.inherit_policies, a.depth
+	FROM a
+	ORDER BY a.uuid, a.depth
+)
+SELECT things.uuid, things.name, things.type, things.state, things.created_by, things.tags, things.attributes,
+	things.longitude, things.latitude, things.area,
+	nearest.via, nearest.inherit_policies, nearest.depth
+FROM nearest, things
+WHERE things.uuid = nearest.uuid
+AND user_has_access((SELECT uuid FROM usr), 'read', 'things/'||things.uuid)
+ORDER BY nearest.depth, things.name;
+
+-- name: FindThingDescendants :many
+WITH RECURSIVE usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256(sqlc.arg(token))
+	LIMIT 1
+), d(uuid, via, inherit_policies, depth) AS (
+	SELECT thing_deps.child, thing_deps.parent, thing_deps.inherit_policies, 1
+	FROM thing_deps
+	WHERE thing_deps.parent = sqlc.arg(uuid)
+	UNION
+	SELECT thing_deps.child, thing_deps.parent, thing_deps.inherit_policies, d.depth + 1
+	FROM d, thing_deps
+	WHERE thing_deps.parent = d.uuid
+	AND (sqlc.arg(max_depth)::INTEGER = 0 OR d.depth < sqlc.arg(max_depth)::INTEGER)
+), nearest AS (
+	SELECT DISTINCT ON (d.uuid) d.uuid, d.via, d.inherit_policies, d.depth
+	FROM d
+	ORDER BY d.uuid, d.depth
+)
+SELECT things.uuid, things.name, things.type, things.state, things.created_by, things.tags, things.attributes,
+	things.longitude, things.latitude, things.area,
+	nearest.via, nearest.inherit_policies, nearest.depth
+FROM nearest, things
+WHERE things.uuid = nearest.uuid
+AND user_has_access((SELECT uuid FROM usr), 'read', 'things/'||things.uuid)
+ORDER BY nearest.depth, things.name;
@@ -12,7 +12,8 @@ WITH t AS (
 		lower_bound,
 		upper_bound,
 		created_by,
-		tags
+		tags,
+		attributes
 	) VALUES (
 		NULLIF(sqlc.arg(thing_uuid)::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
 		sqlc.arg(name),
@@ -20,7 +21,8 @@ WITH t AS (
 		sqlc.arg(lower_bound),
 		sqlc.arg(upper_bound),
 		sqlc.arg(created_by),
-		sqlc.arg(tags)
+		sqlc.arg(tags),
+		sqlc.arg(attributes)
 	) RETURNING *
 ), grp AS (
 	SELECT groups.uuid
@@ -77,6 +79,7 @@ FROM timeseries
 WHERE 'timeseries/'||timeseries.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'allow')
 )
+AND timeseries.attributes @> sqlc.arg(attributes)::jsonb
 EXCEPT
 SELECT *
 FROM timeseries
@@ -107,6 +110,7 @@ FROM timeseries
 WHERE 'timeseries/'||timeseries.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'allow')
 )
+AND timeseries.attributes @> sqlc.arg(attributes)::jsonb
 AND sqlc.arg(tags) && timeseries.tags
 EXCEPT
 SELECT *
@@ -126,6 +130,21 @@ WHERE sqlc.arg(thing_uuid) = timeseries.thing_uuid
 ORDER BY name
 ;
 
+-- name: FindTimeseriesByThings :many
+-- The time series of the things, which the user of the token may read.
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256(sqlc.arg(token))
+	LIMIT 1
+)
+SELECT * FROM timeseries
+WHERE timeseries.thing_uuid = ANY(sqlc.arg(thing_uuids)::UUID[])
+AND user_has_access((SELECT uuid FROM usr), 'read', 'timeseries/'||timeseries.uuid)
+ORDER BY name
+;
+
 -- name: FindTimeseriesByUUID :one
 SELECT * FROM timeseries
 WHERE sqlc.arg(ts_uuid) = timeseries.uuid
@@ -161,6 +180,11 @@ UPDATE timeseries
 SET tags = sqlc.arg(tags)
 WHERE timeseries.uuid = sqlc.arg(uuid);
 
+-- name: SetTimeseriesAttributes :execrows
+UPDATE timeseries
+SET attributes = sqlc.arg(attributes)
+WHERE timeseries.uuid = sqlc.arg(uuid);
+
 -- name: DeleteTimeseries :execrows
 DELETE FROM timeseries
 WHERE uuid = sqlc.arg(uuid);
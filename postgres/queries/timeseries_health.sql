@@ -0,0 +1,142 @@
+-- name: CreateTimeseriesHealthRule :one
+INSERT INTO timeseries_health_rules(name, ts_uuid, tag, expected_interval, flatline,
+	severity, environment, enabled, created_by)
+VALUES (
+	sqlc.arg(name),
+	NULLIF(sqlc.arg(ts_uuid)::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
+	sqlc.arg(tag),
+	sqlc.arg(expected_interval),
+	sqlc.arg(flatline),
+	sqlc.arg(severity),
+	sqlc.arg(environment),
+	sqlc.arg(enabled),
+	sqlc.arg(created_by)
+)
+RETURNING *;
+
+-- name: FindTimeseriesHealthRules :many
+SELECT *
+FROM timeseries_health_rules
+ORDER BY name, uuid
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
+
+-- name: FindEnabledTimeseriesHealthRules :many
+SELECT *
+FROM timeseries_health_rules
+WHERE enabled = TRUE
+ORDER BY name, uuid;
+
+-- name: FindTimeseriesHealthRuleByUUID :one
+SELECT *
+FROM timeseries_health_rules
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: UpdateTimeseriesHealthRule :execrows
+UPDATE timeseries_health_rules
+SET name = sqlc.arg(name),
+	ts_uuid = NULLIF(sqlc.arg(ts_uuid)::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
+	tag = sqlc.arg(tag),
+	expected_interval = sqlc.arg(expected_interval),
+	flatline = sqlc.arg(flatline),
+	severity = sqlc.arg(severity),
+	environment = sqlc.arg(environment),
+	enabled = sqlc.arg(enabled)
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: DeleteTimeseriesHealthRule :execrows
+DELETE FROM timeseries_health_rules
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: InitTimeseriesHealth :execrows
+INSERT INTO timeseries_health(ts_uuid)
+SELECT uuid
+FROM timeseries
+ON CONFLICT (ts_uuid) DO NOTHING;
+
+-- name: ClaimTimeseriesHealth :many
+WITH claimed AS (
+	SELECT ts_uuid
+	FROM timeseries_health
+	WHERE evaluated IS NULL
+	OR evaluated + make_interval(secs => sqlc.arg(interval)::INTEGER) <= NOW()
+	ORDER BY evaluated ASC NULLS FIRST
+	LIMIT sqlc.arg(arg_limit)::BIGINT
+	FOR UPDATE SKIP LOCKED
+)
+UPDATE timeseries_health
+SET evaluated = NOW()
+FROM claimed, timeseries
+WHERE timeseries_health.ts_uuid = claimed.ts_uuid
+AND timeseries.uuid = claimed.ts_uuid
+RETURNING timeseries_health.ts_uuid,
+	timeseries_health.state,
+	timeseries_health.since,
+	timeseries_health.alert_uuid,
+	timeseries.name,
+	timeseries.si_unit,
+	timeseries.thing_uuid,
+	timeseries.tags;
+
+-- name: UpdateTimeseriesHealth :execrows
+UPDATE timeseries_health
+SET state = sqlc.arg(state),
+	since = sqlc.arg(since),
+	last_seen = sqlc.arg(last_seen),
+	last_value = sqlc.arg(last_value),
+	rule_uuid = NULLIF(sqlc.arg(rule_uuid)::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
+	alert_uuid = NULLIF(sqlc.arg(alert_uuid)::uuid, '00000000-0000-0000-0000-000000000000'::uuid)
+WHERE ts_uuid = sqlc.arg(ts_uuid);
+
+-- name: FindTimeseriesHealth :many
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256(sqlc.arg(token))
+	LIMIT 1
+), policies AS (
+	SELECT group_policies.effect, group_policies.priority, group_policies.resource
+	FROM group_policies, user_groups
+	WHERE user_groups.group_uuid = group_policies.group_uuid
+	AND user_groups.user_uuid = (SELECT uuid FROM usr)
+	AND action = 'read'
+), visible AS (
+	SELECT uuid
+	FROM timeseries
+	WHERE 'timeseries/'||timeseries.uuid LIKE ANY(
+		(SELECT resource FROM policies WHERE effect = 'allow')
+	)
+	EXCEPT
+	SELECT uuid
+	FROM timeseries
+	WHERE 'timeseries/'||timeseries.uuid LIKE ANY(
+		(SELECT resource FROM policies WHERE effect = 'deny')
+	)
+)
+SELECT timeseries.uuid,
+	timeseries.name,
+	timeseries.thing_uuid,
+	COALESCE(timeseries_health.state, 'unknown')::TEXT AS state,
+	timeseries_health.since,
+	timeseries_health.last_seen,
+	timeseries_health.last_value,
+	timeseries_health.rule_uuid,
+	timeseries_health.alert_uuid,
+	timeseries_health.evaluated
+FROM visible
+JOIN timeseries ON timeseries.uuid = visible.uuid
+LEFT JOIN timeseries_health ON timeseries_health.ts_uuid = timeseries.uuid
+WHERE (
+	NULLIF(sqlc.arg(state)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(state)::TEXT = COALESCE(timeseries_health.state, 'unknown')
+)
+AND (
+	NULLIF(sqlc.arg(thing_uuid)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(thing_uuid)::TEXT = timeseries.thing_uuid::TEXT
+)
+ORDER BY timeseries.name, timeseries.uuid
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
@@ -0,0 +1,93 @@
+-- name: CreateTimeseriesMonitor :one
+INSERT INTO timeseries_monitors(ts_uuid, name, kind, threshold, hysteresis, aggregate,
+	window_seconds, evaluate, interval_seconds, severity, environment, event, enabled, created_by)
+VALUES (
+	sqlc.arg(ts_uuid),
+	sqlc.arg(name),
+	sqlc.arg(kind),
+	sqlc.arg(threshold),
+	sqlc.arg(hysteresis),
+	sqlc.arg(aggregate),
+	sqlc.arg(window_seconds),
+	sqlc.arg(evaluate),
+	sqlc.arg(interval_seconds),
+	sqlc.arg(severity),
+	sqlc.arg(environment),
+	sqlc.arg(event),
+	sqlc.arg(enabled),
+	sqlc.arg(created_by)
+)
+RETURNING *;
+
+-- name: FindTimeseriesMonitors :many
+SELECT *
+FROM timeseries_monitors
+WHERE (
+	NULLIF(sqlc.arg(ts_uuid)::TEXT, '') IS NULL
+	OR
+	sqlc.arg(ts_uuid)::TEXT = timeseries_monitors.ts_uuid::TEXT
+)
+ORDER BY name, uuid
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
+
+-- name: FindTimeseriesMonitorByUUID :one
+SELECT *
+FROM timeseries_monitors
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: UpdateTimeseriesMonitor :execrows
+UPDATE timeseries_monitors
+SET name = sqlc.arg(name),
+	kind = sqlc.arg(kind),
+	threshold = sqlc.arg(threshold),
+	hysteresis = sqlc.arg(hysteresis),
+	aggregate = sqlc.arg(aggregate),
+	window_seconds = sqlc.arg(window_seconds),
+	evaluate = sqlc.arg(evaluate),
+	interval_seconds = sqlc.arg(interval_seconds),
+	severity = sqlc.arg(severity),
+	environment = sqlc.arg(environment),
+	event = sqlc.arg(event),
+	enabled = sqlc.arg(enabled)
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: DeleteTimeseriesMonitor :execrows
+DELETE FROM timeseries_monitors
+WHERE uuid = sqlc.arg(uuid);
+
+-- name: MarkTimeseriesMonitorsDue :execrows
+UPDATE timeseries_monitors
+SET due = TRUE
+WHERE ts_uuid = sqlc.arg(ts_uuid)
+AND evaluate = 'ingest'
+AND enabled = TRUE
+AND due = FALSE;
+
+-- name: ClaimDueTimeseriesMonitors :many
+UPDATE timeseries_monitors
+SET due = FALSE,
+	evaluated = NOW()
+WHERE uuid IN (
+	SELECT uuid
+	FROM timeseries_monitors
+	WHERE enabled = TRUE
+	AND (
+		due = TRUE
+		OR (
+			evaluate = 'schedule'
+			AND (evaluated IS NULL OR evaluated + make_interval(secs => interval_seconds) <= NOW())
+		)
+	)
+	ORDER BY evaluated ASC NULLS FIRST
+	LIMIT sqlc.arg(arg_limit)::BIGINT
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: UpdateTimeseriesMonitorState :execrows
+UPDATE timeseries_monitors
+SET firing = sqlc.arg(firing),
+	alert_uuid = NULLIF(sqlc.arg(alert_uuid)::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
+	value = sqlc.arg(value)
+WHERE uuid = sqlc.arg(uuid);
@@ -45,6 +45,29 @@ FROM tsdata_trunc
 GROUP BY ts_uuid, ts
 ORDER BY ts ASC;
 
+-- name: GetTsDataHealth :one
+SELECT
+	(SELECT ts FROM tsdata WHERE ts_uuid = sqlc.arg(ts_uuid) ORDER BY ts DESC LIMIT 1)::TIMESTAMPTZ AS last_seen,
+	(SELECT value FROM tsdata WHERE ts_uuid = sqlc.arg(ts_uuid) ORDER BY ts DESC LIMIT 1)::DOUBLE PRECISION AS last_value,
+	(SELECT value FROM tsdata WHERE ts_uuid = sqlc.arg(ts_uuid) AND ts <= sqlc.arg(since) ORDER BY ts DESC LIMIT 1)::DOUBLE PRECISION AS previous_value,
+	(SELECT MIN(value) FROM tsdata WHERE ts_uuid = sqlc.arg(ts_uuid) AND ts > sqlc.arg(since))::DOUBLE PRECISION AS window_min,
+	(SELECT MAX(value) FROM tsdata WHERE ts_uuid = sqlc.arg(ts_uuid) AND ts > sqlc.arg(since))::DOUBLE PRECISION AS window_max;
+
+-- name: GetTsDataWindowAgg :one
+SELECT
+	(CASE
+		WHEN sqlc.arg(aggregate)::text = 'avg'::text THEN COALESCE(AVG(value), 0)
+		WHEN sqlc.arg(aggregate)::text = 'min'::text THEN COALESCE(MIN(value), 0)
+		WHEN sqlc.arg(aggregate)::text = 'max'::text THEN COALESCE(MAX(value), 0)
+		WHEN sqlc.arg(aggregate)::text = 'count'::text THEN COUNT(value)
+		WHEN sqlc.arg(aggregate)::text = 'sum'::text THEN COALESCE(SUM(value), 0)
+	END)::DOUBLE PRECISION AS value,
+	COUNT(value)::BIGINT AS points
+FROM tsdata
+WHERE ts_uuid = sqlc.arg(ts_uuid)
+AND ts > sqlc.arg(start)
+AND ts <= sqlc.arg(stop);
+
 -- name: CreateTsData :execrows
 INSERT INTO tsdata(ts_uuid, value, ts, created_by)
 VALUES (
@@ -65,3 +88,42 @@ AND ts BETWEEN sqlc.arg(start) AND sqlc.arg(stop)
 AND (sqlc.arg(ge_null)::boolean = true OR tsdata.value >= sqlc.arg(ge))
 AND (sqlc.arg(le_null)::boolean = true OR tsdata.value <= sqlc.arg(le))
 ;
+
+-- name: FindTsDataHistory :many
+SELECT id, change_id, action, ts_uuid, value, ts, COALESCE(ts_end, ts)::TIMESTAMPTZ AS ts_end, count, created_by, changed, changed_by, token_uuid, restored
+FROM tsdata_audit
+WHERE ts_uuid = sqlc.arg(ts_uuid)
+AND changed BETWEEN sqlc.arg(start) AND sqlc.arg(stop)
+ORDER BY changed DESC, id DESC
+LIMIT sqlc.arg(arg_limit)::BIGINT
+OFFSET sqlc.arg(arg_offset)::BIGINT;
+
+-- name: RestoreTsDataChange :execrows
+WITH restored AS (
+	UPDATE tsdata_audit
+	SET restored = NOW()
+	WHERE tsdata_audit.ts_uuid = sqlc.arg(ts_uuid)
+	AND tsdata_audit.change_id = sqlc.arg(change_id)
+	AND tsdata_audit.restored IS NULL
+	AND tsdata_audit.changed >= NOW() - (sqlc.arg(window_seconds)::BIGINT * INTERVAL '1 second')
+	RETURNING id, value, ts, created_by, points
+), changed AS (
+	SELECT restored.id, restored.value, restored.ts, restored.created_by
+	FROM restored
+	WHERE restored.points IS NULL
+	UNION ALL
+	-- A deleted range holds its data points
+	SELECT restored.id, p.v, p.ts, users.uuid
+	FROM restored
+	CROSS JOIN jsonb_to_recordset(restored.points) AS p(v DOUBLE PRECISION, ts TIMESTAMPTZ, created_by UUID)
+	LEFT JOIN users ON users.uuid = p.created_by
+), originals AS (
+	-- A point may have been changed more than once by the same change
+	SELECT DISTINCT ON (ts) value, ts, created_by
+	FROM changed
+	ORDER BY ts, id ASC
+)
+INSERT INTO tsdata(ts_uuid, value, ts, created_by)
+SELECT sqlc.arg(ts_uuid)::uuid, value, ts, created_by
+FROM originals
+ON CONFLICT (ts_uuid, ts) DO UPDATE SET value = EXCLUDED.value;
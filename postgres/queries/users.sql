@@ -139,3 +139,9 @@ WHERE users.uuid = sqlc.arg(uuid);
 DELETE FROM user_tokens
 WHERE user_tokens.uuid = sqlc.arg(token_uuid)
 AND user_tokens.user_uuid = sqlc.arg(user_uuid);
+
+-- name: SetAuditContext :exec
+SELECT set_config('selfhost.user_uuid', user_tokens.user_uuid::text, true),
+	set_config('selfhost.token_uuid', user_tokens.uuid::text, true)
+FROM user_tokens
+WHERE user_tokens.token_hash = sha256(sqlc.arg(token));
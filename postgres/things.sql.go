@@ -6,20 +6,50 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
 
+const addThingDep = `-- name: AddThingDep :execrows
+INSERT INTO thing_deps(parent, child, inherit_policies)
+VALUES (
+	$1,
+	$2,
+	$3
+)
+ON CONFLICT (parent, child) DO UPDATE
+SET inherit_policies = EXCLUDED.inherit_policies
+`
+
+type AddThingDepParams struct {
+	Parent          uuid.UUID
+	Child           uuid.UUID
+	InheritPolicies bool
+}
+
+func (q *Queries) AddThingDep(ctx context.Context, arg AddThingDepParams) (int64, error) {
+	result, err := q.exec(ctx, q.addThingDepStmt, addThingDep, arg.Parent, arg.Child, arg.InheritPolicies)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const createThing = `-- name: CreateThing :one
 WITH t AS (
 	INSERT INTO things (
-		name, type, created_by, tags
+		name, type, created_by, tags, attributes, longitude, latitude, area
 	) VALUES (
 		$1,
 		$2,
 		$3,
-		$4
+		$4,
+		$5,
+		$6,
+		$7,
+		$8
 	)
 	RETURNING uuid, name, type, state, created_by, tags
 ), grp AS (
@@ -45,24 +75,32 @@ WITH t AS (
 		(SELECT uuid FROM grp), 0, 'allow', 'delete','things/'||(SELECT uuid FROM t)||'/%'
 	)
 )
-SELECT uuid, name, type, state, created_by, tags
+SELECT uuid, name, type, state, created_by, tags, attributes, longitude, latitude, area
 FROM t LIMIT 1
 `
 
 type CreateThingParams struct {
-	Name      string
-	Type      sql.NullString
-	CreatedBy uuid.UUID
-	Tags      []string
+	Name       string
+	Type       sql.NullString
+	CreatedBy  uuid.UUID
+	Tags       []string
+	Attributes json.RawMessage
+	Longitude  sql.NullFloat64
+	Latitude   sql.NullFloat64
+	Area       json.RawMessage
 }
 
 type CreateThingRow struct {
-	Uuid      uuid.UUID
-	Name      string
-	Type      sql.NullString
-	State     ThingState
-	CreatedBy uuid.UUID
-	Tags      []string
+	Uuid       uuid.UUID
+	Name       string
+	Type       sql.NullString
+	State      ThingState
+	CreatedBy  uuid.UUID
+	Tags       []string
+	Attributes json.RawMessage
+	Longitude  sql.NullFloat64
+	Latitude   sql.NullFloat64
+	Area       json.RawMessage
 }
 
 func (q *Queries) CreateThing(ctx context.Context, arg CreateThingParams) (CreateThingRow, error) {
@@ -71,6 +109,10 @@ func (q *Queries) CreateThing(ctx context.Context, arg CreateThingParams) (Creat
 		arg.Type,
 		arg.CreatedBy,
 		pq.Array(arg.Tags),
+		arg.Attributes,
+		arg.Longitude,
+		arg.Latitude,
+		arg.Area,
 	)
 	var i CreateThingRow
 	err := row.Scan(
@@ -80,6 +122,10 @@ func (q *Queries) CreateThing(ctx context.Context, arg CreateThingParams) (Creat
 		&i.State,
 		&i.CreatedBy,
 		pq.Array(&i.Tags),
+		&i.Attributes,
+		&i.Longitude,
+		&i.Latitude,
+		&i.Area,
 	)
 	return i, err
 }
@@ -110,8 +156,97 @@ func (q *Queries) ExistsThing(ctx context.Context, uuid uuid.UUID) (int64, error
 	return count, err
 }
 
+const findThingAncestors = `-- name: FindThingAncestors :many
+WITH RECURSIVE usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256($1)
+	LIMIT 1
+), a(uuid, via, inherit_policies, depth) AS (
+	SELECT thing_deps.parent, thing_deps.child, thing_deps.inherit_policies, 1
+	FROM thing_deps
+	WHERE thing_deps.child = $2
+	UNION
+	SELECT thing_deps.parent, thing_deps.child, thing_deps.inherit_policies, a.depth + 1
+	FROM a, thing_deps
+	WHERE thing_deps.child = a.uuid
+	AND ($3::INTEGER = 0 OR a.depth < $3::INTEGER)
+), nearest AS (
+	SELECT DISTINCT ON (a.uuid) a.uuid, a.via, a.inherit_policies, a.depth
+	FROM a
+	ORDER BY a.uuid, a.depth
+)
+SELECT things.uuid, things.name, things.type, things.state, things.created_by, things.tags, things.attributes,
+	things.longitude, things.latitude, things.area,
+	nearest.via, nearest.inherit_policies, nearest.depth
+FROM nearest, things
+WHERE things.uuid = nearest.uuid
+AND user_has_access((SELECT uuid FROM usr), 'read', 'things/'||things.uuid)
+ORDER BY nearest.depth, things.name
+`
+
+type FindThingAncestorsParams struct {
+	Token    []byte
+	Uuid     uuid.UUID
+	MaxDepth int32
+}
+
+type FindThingAncestorsRow struct {
+	Uuid            uuid.UUID
+	Name            string
+	Type            sql.NullString
+	State           ThingState
+	CreatedBy       uuid.UUID
+	Tags            []string
+	Attributes      json.RawMessage
+	Longitude       sql.NullFloat64
+	Latitude        sql.NullFloat64
+	Area            json.RawMessage
+	Via             uuid.UUID
+	InheritPolicies bool
+	Depth           int32
+}
+
+func (q *Queries) FindThingAncestors(ctx context.Context, arg FindThingAncestorsParams) ([]FindThingAncestorsRow, error) {
+	rows, err := q.query(ctx, q.findThingAncestorsStmt, findThingAncestors, arg.Token, arg.Uuid, arg.MaxDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindThingAncestorsRow{}
+	for rows.Next() {
+		var i FindThingAncestorsRow
+		if err := rows.Scan(
+			&i.Uuid,
+			&i.Name,
+			&i.Type,
+			&i.State,
+			&i.CreatedBy,
+			pq.Array(&i.Tags),
+			&i.Attributes,
+			&i.Longitude,
+			&i.Latitude,
+			&i.Area,
+			&i.Via,
+			&i.InheritPolicies,
+			&i.Depth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const findThingByUUID = `-- name: FindThingByUUID :one
-SELECT uuid, name, type, state, created_by, tags
+SELECT uuid, name, type, state, created_by, tags, attributes, longitude, latitude, area
 FROM things
 WHERE things.uuid = $1
 LIMIT 1
@@ -127,10 +262,125 @@ func (q *Queries) FindThingByUUID(ctx context.Context, uuid uuid.UUID) (Thing, e
 		&i.State,
 		&i.CreatedBy,
 		pq.Array(&i.Tags),
+		&i.Attributes,
+		&i.Longitude,
+		&i.Latitude,
+		&i.Area,
 	)
 	return i, err
 }
 
+const findThingDescendants = `-- name: FindThingDescendants :many
+WITH RECURSIVE usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256($1)
+	LIMIT 1
+), d(uuid, via, inherit_policies, depth) AS (
+	SELECT thing_deps.child, thing_deps.parent, thing_deps.inherit_policies, 1
+	FROM thing_deps
+	WHERE thing_deps.parent = $2
+	UNION
+	SELECT thing_deps.child, thing_deps.parent, thing_deps.inherit_policies, d.depth + 1
+	FROM d, thing_deps
+	WHERE thing_deps.parent = d.uuid
+	AND ($3::INTEGER = 0 OR d.depth < $3::INTEGER)
+), nearest AS (
+	SELECT DISTINCT ON (d.uuid) d.uuid, d.via, d.inherit_policies, d.depth
+	FROM d
+	ORDER BY d.uuid, d.depth
+)
+SELECT things.uuid, things.name, things.type, things.state, things.created_by, things.tags, things.attributes,
+	things.longitude, things.latitude, things.area,
+	nearest.via, nearest.inherit_policies, nearest.depth
+FROM nearest, things
+WHERE things.uuid = nearest.uuid
+AND user_has_access((SELECT uuid FROM usr), 'read', 'things/'||things.uuid)
+ORDER BY nearest.depth, things.name
+`
+
+type FindThingDescendantsParams struct {
+	Token    []byte
+	Uuid     uuid.UUID
+	MaxDepth int32
+}
+
+type FindThingDescendantsRow struct {
+	Uuid            uuid.UUID
+	Name            string
+	Type            sql.NullString
+	State           ThingState
+	CreatedBy       uuid.UUID
+	Tags            []string
+	Attributes      json.RawMessage
+	Longitude       sql.NullFloat64
+	Latitude        sql.NullFloat64
+	Area            json.RawMessage
+	Via             uuid.UUID
+	InheritPolicies bool
+	Depth           int32
+}
+
+func (q *Queries) FindThingDescendants(ctx context.Context, arg FindThingDescendantsParams) ([]FindThingDescendantsRow, error) {
+	rows, err := q.query(ctx, q.findThingDescendantsStmt, findThingDescendants, arg.Token, arg.Uuid, arg.MaxDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindThingDescendantsRow{}
+	for rows.Next() {
+		var i FindThingDescendantsRow
+		if err := rows.Scan(
+			&i.Uuid,
+			&i.Name,
+			&i.Type,
+			&i.State,
+			&i.CreatedBy,
+			pq.Array(&i.Tags),
+			&i.Attributes,
+			&i.Longitude,
+			&i.Latitude,
+			&i.Area,
+			&i.Via,
+			&i.InheritPolicies,
+			&i.Depth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findThingParent = `-- name: FindThingParent :one
+SELECT things.uuid, things.name
+FROM thing_deps, things
+WHERE things.uuid = thing_deps.parent
+AND thing_deps.child = $1
+ORDER BY things.name, things.uuid
+LIMIT 1
+`
+
+type FindThingParentRow struct {
+	Uuid uuid.UUID
+	Name string
+}
+
+// The first parent of a thing by name.
+func (q *Queries) FindThingParent(ctx context.Context, child uuid.UUID) (FindThingParentRow, error) {
+	row := q.queryRow(ctx, q.findThingParentStmt, findThingParent, child)
+	var i FindThingParentRow
+	err := row.Scan(&i.Uuid, &i.Name)
+	return i, err
+}
+
 const findThings = `-- name: FindThings :many
 WITH usr AS (
 	SELECT users.uuid
@@ -138,37 +388,30 @@ WITH usr AS (
 	WHERE user_tokens.user_uuid = users.uuid
 	AND user_tokens.token_hash = sha256($3)
 	LIMIT 1
-), policies AS (
-	SELECT group_policies.effect, group_policies.priority, group_policies.resource
-	FROM group_policies, user_groups
-	WHERE user_groups.group_uuid = group_policies.group_uuid
-	AND user_groups.user_uuid = (SELECT uuid FROM usr)
-	AND action = 'read'
-)
-SELECT uuid, name, type, state, created_by, tags
-FROM things
-WHERE 'things/'||things.uuid LIKE ANY(
-	(SELECT resource FROM policies WHERE effect = 'allow')
 )
-EXCEPT
-SELECT uuid, name, type, state, created_by, tags
+SELECT uuid, name, type, state, created_by, tags, attributes, longitude, latitude, area
 FROM things
-WHERE 'things/'||things.uuid LIKE ANY(
-	(SELECT resource FROM policies WHERE effect = 'deny')
-)
+WHERE user_has_access((SELECT uuid FROM usr), 'read', 'things/'||things.uuid)
+AND things.attributes @> $4::jsonb
 ORDER BY name
 LIMIT $2::BIGINT
 OFFSET $1::BIGINT
 `
 
 type FindThingsParams struct {
-	ArgOffset int64
-	ArgLimit  int64
-	Token     []byte
+	ArgOffset  int64
+	ArgLimit   int64
+	Token      []byte
+	Attributes json.RawMessage
 }
 
 func (q *Queries) FindThings(ctx context.Context, arg FindThingsParams) ([]Thing, error) {
-	rows, err := q.query(ctx, q.findThingsStmt, findThings, arg.ArgOffset, arg.ArgLimit, arg.Token)
+	rows, err := q.query(ctx, q.findThingsStmt, findThings,
+		arg.ArgOffset,
+		arg.ArgLimit,
+		arg.Token,
+		arg.Attributes,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -183,6 +426,10 @@ func (q *Queries) FindThings(ctx context.Context, arg FindThingsParams) ([]Thing
 			&i.State,
 			&i.CreatedBy,
 			pq.Array(&i.Tags),
+			&i.Attributes,
+			&i.Longitude,
+			&i.Latitude,
+			&i.Area,
 		); err != nil {
 			return nil, err
 		}
@@ -204,25 +451,11 @@ WITH usr AS (
 	WHERE user_tokens.user_uuid = users.uuid
 	AND user_tokens.token_hash = sha256($3)
 	LIMIT 1
-), policies AS (
-	SELECT group_policies.effect, group_policies.priority, group_policies.resource
-	FROM group_policies, user_groups
-	WHERE user_groups.group_uuid = group_policies.group_uuid
-	AND user_groups.user_uuid = (SELECT uuid FROM usr)
-	AND action = 'read'
 )
-SELECT uuid, name, type, state, created_by, tags
+SELECT uuid, name, type, state, created_by, tags, attributes, longitude, latitude, area
 FROM things
-WHERE 'things/'||things.uuid LIKE ANY(
-	(SELECT resource FROM policies WHERE effect = 'allow')
-)
-AND $4 && things.tags
-EXCEPT
-SELECT uuid, name, type, state, created_by, tags
-FROM things
-WHERE 'things/'||things.uuid LIKE ANY(
-	(SELECT resource FROM policies WHERE effect = 'deny')
-)
+WHERE user_has_access((SELECT uuid FROM usr), 'read', 'things/'||things.uuid)
+AND things.attributes @> $5::jsonb
 AND $4 && things.tags
 ORDER BY name
 LIMIT $2::BIGINT
@@ -230,10 +463,11 @@ OFFSET $1::BIGINT
 `
 
 type FindThingsByTagsParams struct {
-	ArgOffset int64
-	ArgLimit  int64
-	Token     []byte
-	Tags      interface{}
+	ArgOffset  int64
+	ArgLimit   int64
+	Token      []byte
+	Tags       interface{}
+	Attributes json.RawMessage
 }
 
 func (q *Queries) FindThingsByTags(ctx context.Context, arg FindThingsByTagsParams) ([]Thing, error) {
@@ -242,6 +476,134 @@ func (q *Queries) FindThingsByTags(ctx context.Context, arg FindThingsByTagsPara
 		arg.ArgLimit,
 		arg.Token,
 		arg.Tags,
+		arg.Attributes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Thing{}
+	for rows.Next() {
+		var i Thing
+		if err := rows.Scan(
+			&i.Uuid,
+			&i.Name,
+			&i.Type,
+			&i.State,
+			&i.CreatedBy,
+			pq.Array(&i.Tags),
+			&i.Attributes,
+			&i.Longitude,
+			&i.Latitude,
+			&i.Area,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findThingsByType = `-- name: FindThingsByType :many
+SELECT uuid, name, type, state, created_by, tags, attributes, longitude, latitude, area
+FROM things
+WHERE things.type = $1
+ORDER BY name
+`
+
+func (q *Queries) FindThingsByType(ctx context.Context, type_ sql.NullString) ([]Thing, error) {
+	rows, err := q.query(ctx, q.findThingsByTypeStmt, findThingsByType, type_)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Thing{}
+	for rows.Next() {
+		var i Thing
+		if err := rows.Scan(
+			&i.Uuid,
+			&i.Name,
+			&i.Type,
+			&i.State,
+			&i.CreatedBy,
+			pq.Array(&i.Tags),
+			&i.Attributes,
+			&i.Longitude,
+			&i.Latitude,
+			&i.Area,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findThingsWithin = `-- name: FindThingsWithin :many
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256($1)
+	LIMIT 1
+)
+SELECT uuid, name, type, state, created_by, tags, attributes, longitude, latitude, area
+FROM things
+WHERE user_has_access((SELECT uuid FROM usr), 'read', 'things/'||things.uuid)
+AND point(things.longitude, things.latitude) <@ box(
+	point($2::FLOAT8, $3::FLOAT8),
+	point($4::FLOAT8, $5::FLOAT8)
+)
+AND ($6::FLOAT8 = 0
+	OR geo_distance($7::FLOAT8, $8::FLOAT8, things.longitude, things.latitude) <= $6::FLOAT8)
+AND (cardinality($9::TEXT[]) = 0 OR $9::TEXT[] && things.tags)
+AND things.attributes @> $10::jsonb
+ORDER BY name
+LIMIT $11::BIGINT
+OFFSET $12::BIGINT
+`
+
+type FindThingsWithinParams struct {
+	Token      []byte
+	MinLon     float64
+	MinLat     float64
+	MaxLon     float64
+	MaxLat     float64
+	Radius     float64
+	Lon        float64
+	Lat        float64
+	Tags       []string
+	Attributes json.RawMessage
+	ArgLimit   int64
+	ArgOffset  int64
+}
+
+func (q *Queries) FindThingsWithin(ctx context.Context, arg FindThingsWithinParams) ([]Thing, error) {
+	rows, err := q.query(ctx, q.findThingsWithinStmt, findThingsWithin,
+		arg.Token,
+		arg.MinLon,
+		arg.MinLat,
+		arg.MaxLon,
+		arg.MaxLat,
+		arg.Radius,
+		arg.Lon,
+		arg.Lat,
+		pq.Array(arg.Tags),
+		arg.Attributes,
+		arg.ArgLimit,
+		arg.ArgOffset,
 	)
 	if err != nil {
 		return nil, err
@@ -257,6 +619,10 @@ func (q *Queries) FindThingsByTags(ctx context.Context, arg FindThingsByTagsPara
 			&i.State,
 			&i.CreatedBy,
 			pq.Array(&i.Tags),
+			&i.Attributes,
+			&i.Longitude,
+			&i.Latitude,
+			&i.Area,
 		); err != nil {
 			return nil, err
 		}
@@ -271,6 +637,84 @@ func (q *Queries) FindThingsByTags(ctx context.Context, arg FindThingsByTagsPara
 	return items, nil
 }
 
+const removeThingDep = `-- name: RemoveThingDep :execrows
+DELETE FROM thing_deps
+WHERE thing_deps.parent = $1
+AND thing_deps.child = $2
+`
+
+type RemoveThingDepParams struct {
+	Parent uuid.UUID
+	Child  uuid.UUID
+}
+
+func (q *Queries) RemoveThingDep(ctx context.Context, arg RemoveThingDepParams) (int64, error) {
+	result, err := q.exec(ctx, q.removeThingDepStmt, removeThingDep, arg.Parent, arg.Child)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setThingAreaByUUID = `-- name: SetThingAreaByUUID :execrows
+UPDATE things
+SET area = $1
+WHERE things.uuid = $2
+`
+
+type SetThingAreaByUUIDParams struct {
+	Area json.RawMessage
+	Uuid uuid.UUID
+}
+
+func (q *Queries) SetThingAreaByUUID(ctx context.Context, arg SetThingAreaByUUIDParams) (int64, error) {
+	result, err := q.exec(ctx, q.setThingAreaByUUIDStmt, setThingAreaByUUID, arg.Area, arg.Uuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setThingAttributesByUUID = `-- name: SetThingAttributesByUUID :execrows
+UPDATE things
+SET attributes = $1
+WHERE things.uuid = $2
+`
+
+type SetThingAttributesByUUIDParams struct {
+	Attributes json.RawMessage
+	Uuid       uuid.UUID
+}
+
+func (q *Queries) SetThingAttributesByUUID(ctx context.Context, arg SetThingAttributesByUUIDParams) (int64, error) {
+	result, err := q.exec(ctx, q.setThingAttributesByUUIDStmt, setThingAttributesByUUID, arg.Attributes, arg.Uuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setThingLocationByUUID = `-- name: SetThingLocationByUUID :execrows
+UPDATE things
+SET longitude = $1,
+	latitude = $2
+WHERE things.uuid = $3
+`
+
+type SetThingLocationByUUIDParams struct {
+	Longitude sql.NullFloat64
+	Latitude  sql.NullFloat64
+	Uuid      uuid.UUID
+}
+
+func (q *Queries) SetThingLocationByUUID(ctx context.Context, arg SetThingLocationByUUIDParams) (int64, error) {
+	result, err := q.exec(ctx, q.setThingLocationByUUIDStmt, setThingLocationByUUID, arg.Longitude, arg.Latitude, arg.Uuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setThingNameByUUID = `-- name: SetThingNameByUUID :execrows
 UPDATE things
 SET name = $1
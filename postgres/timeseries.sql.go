@@ -6,6 +6,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -20,7 +21,8 @@ WITH t AS (
 		lower_bound,
 		upper_bound,
 		created_by,
-		tags
+		tags,
+		attributes
 	) VALUES (
 		NULLIF($1::uuid, '00000000-0000-0000-0000-000000000000'::uuid),
 		$2,
@@ -28,8 +30,9 @@ WITH t AS (
 		$4,
 		$5,
 		$6,
-		$7
-	) RETURNING uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags
+		$7,
+		$8
+	) RETURNING uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, attributes
 ), grp AS (
 	SELECT groups.uuid
 	FROM groups, user_groups
@@ -53,7 +56,7 @@ WITH t AS (
 		(SELECT uuid FROM grp), 0, 'allow', 'delete','timeseries/'||(SELECT uuid FROM t)||'/%'
 	)
 )
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, attributes
 FROM t LIMIT 1
 `
 
@@ -65,6 +68,7 @@ type CreateTimeseriesParams struct {
 	UpperBound sql.NullFloat64
 	CreatedBy  uuid.UUID
 	Tags       []string
+	Attributes json.RawMessage
 }
 
 type CreateTimeseriesRow struct {
@@ -76,6 +80,7 @@ type CreateTimeseriesRow struct {
 	UpperBound sql.NullFloat64
 	CreatedBy  uuid.UUID
 	Tags       []string
+	Attributes json.RawMessage
 }
 
 func (q *Queries) CreateTimeseries(ctx context.Context, arg CreateTimeseriesParams) (CreateTimeseriesRow, error) {
@@ -87,6 +92,7 @@ func (q *Queries) CreateTimeseries(ctx context.Context, arg CreateTimeseriesPara
 		arg.UpperBound,
 		arg.CreatedBy,
 		pq.Array(arg.Tags),
+		arg.Attributes,
 	)
 	var i CreateTimeseriesRow
 	err := row.Scan(
@@ -98,6 +104,7 @@ func (q *Queries) CreateTimeseries(ctx context.Context, arg CreateTimeseriesPara
 		&i.UpperBound,
 		&i.CreatedBy,
 		pq.Array(&i.Tags),
+		&i.Attributes,
 	)
 	return i, err
 }
@@ -142,13 +149,14 @@ WITH usr AS (
 	AND user_groups.user_uuid = (SELECT uuid FROM usr)
 	AND action = 'read'
 )
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, attributes
 FROM timeseries
 WHERE 'timeseries/'||timeseries.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'allow')
 )
+AND timeseries.attributes @> $4::jsonb
 EXCEPT
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, attributes
 FROM timeseries
 WHERE 'timeseries/'||timeseries.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'deny')
@@ -159,13 +167,19 @@ OFFSET $1::BIGINT
 `
 
 type FindTimeseriesParams struct {
-	ArgOffset int64
-	ArgLimit  int64
-	Token     []byte
+	ArgOffset  int64
+	ArgLimit   int64
+	Token      []byte
+	Attributes json.RawMessage
 }
 
 func (q *Queries) FindTimeseries(ctx context.Context, arg FindTimeseriesParams) ([]Timeseries, error) {
-	rows, err := q.query(ctx, q.findTimeseriesStmt, findTimeseries, arg.ArgOffset, arg.ArgLimit, arg.Token)
+	rows, err := q.query(ctx, q.findTimeseriesStmt, findTimeseries,
+		arg.ArgOffset,
+		arg.ArgLimit,
+		arg.Token,
+		arg.Attributes,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -182,6 +196,7 @@ func (q *Queries) FindTimeseries(ctx context.Context, arg FindTimeseriesParams)
 			&i.UpperBound,
 			&i.CreatedBy,
 			pq.Array(&i.Tags),
+			&i.Attributes,
 		); err != nil {
 			return nil, err
 		}
@@ -210,14 +225,15 @@ WITH usr AS (
 	AND user_groups.user_uuid = (SELECT uuid FROM usr)
 	AND action = 'read'
 )
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, attributes
 FROM timeseries
 WHERE 'timeseries/'||timeseries.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'allow')
 )
+AND timeseries.attributes @> $5::jsonb
 AND $4 && timeseries.tags
 EXCEPT
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, attributes
 FROM timeseries
 WHERE 'timeseries/'||timeseries.uuid LIKE ANY(
 	(SELECT resource FROM policies WHERE effect = 'deny')
@@ -229,10 +245,11 @@ OFFSET $1::BIGINT
 `
 
 type FindTimeseriesByTagsParams struct {
-	ArgOffset int64
-	ArgLimit  int64
-	Token     []byte
-	Tags      interface{}
+	ArgOffset  int64
+	ArgLimit   int64
+	Token      []byte
+	Tags       interface{}
+	Attributes json.RawMessage
 }
 
 func (q *Queries) FindTimeseriesByTags(ctx context.Context, arg FindTimeseriesByTagsParams) ([]Timeseries, error) {
@@ -241,6 +258,7 @@ func (q *Queries) FindTimeseriesByTags(ctx context.Context, arg FindTimeseriesBy
 		arg.ArgLimit,
 		arg.Token,
 		arg.Tags,
+		arg.Attributes,
 	)
 	if err != nil {
 		return nil, err
@@ -258,6 +276,7 @@ func (q *Queries) FindTimeseriesByTags(ctx context.Context, arg FindTimeseriesBy
 			&i.UpperBound,
 			&i.CreatedBy,
 			pq.Array(&i.Tags),
+			&i.Attributes,
 		); err != nil {
 			return nil, err
 		}
@@ -273,7 +292,7 @@ func (q *Queries) FindTimeseriesByTags(ctx context.Context, arg FindTimeseriesBy
 }
 
 const findTimeseriesByThing = `-- name: FindTimeseriesByThing :many
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags FROM timeseries
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, attributes FROM timeseries
 WHERE $1 = timeseries.thing_uuid
 ORDER BY name
 `
@@ -296,6 +315,60 @@ func (q *Queries) FindTimeseriesByThing(ctx context.Context, thingUuid interface
 			&i.UpperBound,
 			&i.CreatedBy,
 			pq.Array(&i.Tags),
+			&i.Attributes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findTimeseriesByThings = `-- name: FindTimeseriesByThings :many
+WITH usr AS (
+	SELECT users.uuid
+	FROM users, user_tokens
+	WHERE user_tokens.user_uuid = users.uuid
+	AND user_tokens.token_hash = sha256($1)
+	LIMIT 1
+)
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, attributes FROM timeseries
+WHERE timeseries.thing_uuid = ANY($2::UUID[])
+AND user_has_access((SELECT uuid FROM usr), 'read', 'timeseries/'||timeseries.uuid)
+ORDER BY name
+`
+
+type FindTimeseriesByThingsParams struct {
+	Token      []byte
+	ThingUuids []uuid.UUID
+}
+
+// The time series of the things, which the user of the token may read.
+func (q *Queries) FindTimeseriesByThings(ctx context.Context, arg FindTimeseriesByThingsParams) ([]Timeseries, error) {
+	rows, err := q.query(ctx, q.findTimeseriesByThingsStmt, findTimeseriesByThings, arg.Token, pq.Array(arg.ThingUuids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Timeseries{}
+	for rows.Next() {
+		var i Timeseries
+		if err := rows.Scan(
+			&i.Uuid,
+			&i.ThingUuid,
+			&i.Name,
+			&i.SiUnit,
+			&i.LowerBound,
+			&i.UpperBound,
+			&i.CreatedBy,
+			pq.Array(&i.Tags),
+			&i.Attributes,
 		); err != nil {
 			return nil, err
 		}
@@ -311,7 +384,7 @@ func (q *Queries) FindTimeseriesByThing(ctx context.Context, thingUuid interface
 }
 
 const findTimeseriesByUUID = `-- name: FindTimeseriesByUUID :one
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags FROM timeseries
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, attributes FROM timeseries
 WHERE $1 = timeseries.uuid
 LIMIT 1
 `
@@ -328,12 +401,13 @@ func (q *Queries) FindTimeseriesByUUID(ctx context.Context, tsUuid interface{})
 		&i.UpperBound,
 		&i.CreatedBy,
 		pq.Array(&i.Tags),
+		&i.Attributes,
 	)
 	return i, err
 }
 
 const getTimeseriesByUUID = `-- name: GetTimeseriesByUUID :one
-SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags FROM timeseries
+SELECT uuid, thing_uuid, name, si_unit, lower_bound, upper_bound, created_by, tags, attributes FROM timeseries
 WHERE uuid = $1
 LIMIT 1
 `
@@ -350,6 +424,7 @@ func (q *Queries) GetTimeseriesByUUID(ctx context.Context, uuid uuid.UUID) (Time
 		&i.UpperBound,
 		&i.CreatedBy,
 		pq.Array(&i.Tags),
+		&i.Attributes,
 	)
 	return i, err
 }
@@ -367,6 +442,25 @@ func (q *Queries) GetUnitFromTimeseries(ctx context.Context, uuid uuid.UUID) (st
 	return si_unit, err
 }
 
+const setTimeseriesAttributes = `-- name: SetTimeseriesAttributes :execrows
+UPDATE timeseries
+SET attributes = $1
+WHERE timeseries.uuid = $2
+`
+
+type SetTimeseriesAttributesParams struct {
+	Attributes json.RawMessage
+	Uuid       uuid.UUID
+}
+
+func (q *Queries) SetTimeseriesAttributes(ctx context.Context, arg SetTimeseriesAttributesParams) (int64, error) {
+	result, err := q.exec(ctx, q.setTimeseriesAttributesStmt, setTimeseriesAttributes, arg.Attributes, arg.Uuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setTimeseriesLowerBound = `-- name: SetTimeseriesLowerBound :execrows
 UPDATE timeseries
 SET lower_bound = $1
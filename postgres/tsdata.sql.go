@@ -5,6 +5,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
@@ -88,6 +89,118 @@ func (q *Queries) DeleteTsDataRange(ctx context.Context, arg DeleteTsDataRangePa
 	return result.RowsAffected()
 }
 
+const findTsDataHistory = `-- name: FindTsDataHistory :many
+SELECT id, change_id, action, ts_uuid, value, ts, COALESCE(ts_end, ts)::TIMESTAMPTZ AS ts_end, count, created_by, changed, changed_by, token_uuid, restored
+FROM tsdata_audit
+WHERE ts_uuid = $1
+AND changed BETWEEN $2 AND $3
+ORDER BY changed DESC, id DESC
+LIMIT $4::BIGINT
+OFFSET $5::BIGINT
+`
+
+type FindTsDataHistoryParams struct {
+	TsUuid    uuid.UUID
+	Start     time.Time
+	Stop      time.Time
+	ArgLimit  int64
+	ArgOffset int64
+}
+
+type FindTsDataHistoryRow struct {
+	ID        int64
+	ChangeID  int64
+	Action    TsdataAuditAction
+	TsUuid    uuid.UUID
+	Value     sql.NullFloat64
+	Ts        time.Time
+	TsEnd     time.Time
+	Count     int32
+	CreatedBy uuid.UUID
+	Changed   time.Time
+	ChangedBy uuid.UUID
+	TokenUuid uuid.UUID
+	Restored  sql.NullTime
+}
+
+func (q *Queries) FindTsDataHistory(ctx context.Context, arg FindTsDataHistoryParams) ([]FindTsDataHistoryRow, error) {
+	rows, err := q.query(ctx, q.findTsDataHistoryStmt, findTsDataHistory,
+		arg.TsUuid,
+		arg.Start,
+		arg.Stop,
+		arg.ArgLimit,
+		arg.ArgOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FindTsDataHistoryRow{}
+	for rows.Next() {
+		var i FindTsDataHistoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ChangeID,
+			&i.Action,
+			&i.TsUuid,
+			&i.Value,
+			&i.Ts,
+			&i.TsEnd,
+			&i.Count,
+			&i.CreatedBy,
+			&i.Changed,
+			&i.ChangedBy,
+			&i.TokenUuid,
+			&i.Restored,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTsDataHealth = `-- name: GetTsDataHealth :one
+SELECT
+	(SELECT ts FROM tsdata WHERE ts_uuid = $1 ORDER BY ts DESC LIMIT 1)::TIMESTAMPTZ AS last_seen,
+	(SELECT value FROM tsdata WHERE ts_uuid = $1 ORDER BY ts DESC LIMIT 1)::DOUBLE PRECISION AS last_value,
+	(SELECT value FROM tsdata WHERE ts_uuid = $1 AND ts <= $2 ORDER BY ts DESC LIMIT 1)::DOUBLE PRECISION AS previous_value,
+	(SELECT MIN(value) FROM tsdata WHERE ts_uuid = $1 AND ts > $2)::DOUBLE PRECISION AS window_min,
+	(SELECT MAX(value) FROM tsdata WHERE ts_uuid = $1 AND ts > $2)::DOUBLE PRECISION AS window_max
+`
+
+type GetTsDataHealthParams struct {
+	TsUuid uuid.UUID
+	Since  time.Time
+}
+
+type GetTsDataHealthRow struct {
+	LastSeen      sql.NullTime
+	LastValue     sql.NullFloat64
+	PreviousValue sql.NullFloat64
+	WindowMin     sql.NullFloat64
+	WindowMax     sql.NullFloat64
+}
+
+func (q *Queries) GetTsDataHealth(ctx context.Context, arg GetTsDataHealthParams) (GetTsDataHealthRow, error) {
+	row := q.queryRow(ctx, q.getTsDataHealthStmt, getTsDataHealth, arg.TsUuid, arg.Since)
+	var i GetTsDataHealthRow
+	err := row.Scan(
+		&i.LastSeen,
+		&i.LastValue,
+		&i.PreviousValue,
+		&i.WindowMin,
+		&i.WindowMax,
+	)
+	return i, err
+}
+
 const getTsDataRange = `-- name: GetTsDataRange :many
 SELECT	ts_uuid,
 	value,
@@ -216,3 +329,88 @@ func (q *Queries) GetTsDataRangeAgg(ctx context.Context, arg GetTsDataRangeAggPa
 	}
 	return items, nil
 }
+
+const getTsDataWindowAgg = `-- name: GetTsDataWindowAgg :one
+SELECT
+	(CASE
+		WHEN $1::text = 'avg'::text THEN COALESCE(AVG(value), 0)
+		WHEN $1::text = 'min'::text THEN COALESCE(MIN(value), 0)
+		WHEN $1::text = 'max'::text THEN COALESCE(MAX(value), 0)
+		WHEN $1::text = 'count'::text THEN COUNT(value)
+		WHEN $1::text = 'sum'::text THEN COALESCE(SUM(value), 0)
+	END)::DOUBLE PRECISION AS value,
+	COUNT(value)::BIGINT AS points
+FROM tsdata
+WHERE ts_uuid = $2
+AND ts > $3
+AND ts <= $4
+`
+
+type GetTsDataWindowAggParams struct {
+	Aggregate string
+	TsUuid    uuid.UUID
+	Start     time.Time
+	Stop      time.Time
+}
+
+type GetTsDataWindowAggRow struct {
+	Value  float64
+	Points int64
+}
+
+func (q *Queries) GetTsDataWindowAgg(ctx context.Context, arg GetTsDataWindowAggParams) (GetTsDataWindowAggRow, error) {
+	row := q.queryRow(ctx, q.getTsDataWindowAggStmt, getTsDataWindowAgg,
+		arg.Aggregate,
+		arg.TsUuid,
+		arg.Start,
+		arg.Stop,
+	)
+	var i GetTsDataWindowAggRow
+	err := row.Scan(&i.Value, &i.Points)
+	return i, err
+}
+
+const restoreTsDataChange = `-- name: RestoreTsDataChange :execrows
+WITH restored AS (
+	UPDATE tsdata_audit
+	SET restored = NOW()
+	WHERE tsdata_audit.ts_uuid = $1
+	AND tsdata_audit.change_id = $2
+	AND tsdata_audit.restored IS NULL
+	AND tsdata_audit.changed >= NOW() - ($3::BIGINT * INTERVAL '1 second')
+	RETURNING id, value, ts, created_by, points
+), changed AS (
+	SELECT restored.id, restored.value, restored.ts, restored.created_by
+	FROM restored
+	WHERE restored.points IS NULL
+	UNION ALL
+	-- A deleted range holds its data points
+	SELECT restored.id, p.v, p.ts, users.uuid
+	FROM restored
+	CROSS JOIN jsonb_to_recordset(restored.points) AS p(v DOUBLE PRECISION, ts TIMESTAMPTZ, created_by UUID)
+	LEFT JOIN users ON users.uuid = p.created_by
+), originals AS (
+	-- A point may have been changed more than once by the same change
+	SELECT DISTINCT ON (ts) value, ts, created_by
+	FROM changed
+	ORDER BY ts, id ASC
+)
+INSERT INTO tsdata(ts_uuid, value, ts, created_by)
+SELECT $1::uuid, value, ts, created_by
+FROM originals
+ON CONFLICT (ts_uuid, ts) DO UPDATE SET value = EXCLUDED.value
+`
+
+type RestoreTsDataChangeParams struct {
+	TsUuid        uuid.UUID
+	ChangeID      int64
+	WindowSeconds int64
+}
+
+func (q *Queries) RestoreTsDataChange(ctx context.Context, arg RestoreTsDataChangeParams) (int64, error) {
+	result, err := q.exec(ctx, q.restoreTsDataChangeStmt, restoreTsDataChange, arg.TsUuid, arg.ChangeID, arg.WindowSeconds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
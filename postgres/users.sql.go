@@ -336,6 +336,18 @@ func (q *Queries) RemoveUserFromGroups(ctx context.Context, arg RemoveUserFromGr
 	return result.RowsAffected()
 }
 
+const setAuditContext = `-- name: SetAuditContext :exec
+SELECT set_config('selfhost.user_uuid', user_tokens.user_uuid::text, true),
+	set_config('selfhost.token_uuid', user_tokens.uuid::text, true)
+FROM user_tokens
+WHERE user_tokens.token_hash = sha256($1)
+`
+
+func (q *Queries) SetAuditContext(ctx context.Context, token []byte) error {
+	_, err := q.exec(ctx, q.setAuditContextStmt, setAuditContext, token)
+	return err
+}
+
 const setUserName = `-- name: SetUserName :execrows
 UPDATE users SET name = $1
 WHERE uuid = $2